@@ -8,6 +8,7 @@ Package main реализует точку входа клиентского п
 - Создание и настройка менеджера авторизации (AuthManager) с хранением токена.
 - Организация обработки системных сигналов для корректного завершения.
 - Запуск TUI-интерфейса приложения с переданными сервисами.
+- Экспорт и импорт записей без запуска TUI (флаги -export и -import).
 
 Основные функции:
 - main: вызывает run и обрабатывает критические ошибки.
@@ -2,20 +2,35 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"os"
 
+	"github.com/charmbracelet/x/term"
 	"github.com/ryabkov82/gophkeeper/internal/client/app"
 	"github.com/ryabkov82/gophkeeper/internal/client/config"
 	"github.com/ryabkov82/gophkeeper/internal/client/tui"
 	"github.com/ryabkov82/gophkeeper/internal/client/tuiiface"
 )
 
+// passphraseEnv — переменная окружения с парольной фразой экспорта
+// для запуска без терминала.
+const passphraseEnv = "EXPORT_PASSPHRASE"
+
 func main() {
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("failed to load config: %v", err)
 	}
 
+	if cfg.ExportPath != "" || cfg.ImportPath != "" {
+		if err := runTransfer(cfg); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	progFactory := tui.DefaultProgramFactory
 
 	err = app.RunWithServices(cfg,
@@ -29,3 +44,59 @@ func main() {
 		log.Fatal(err)
 	}
 }
+
+// runTransfer выполняет экспорт или импорт записей без запуска TUI.
+func runTransfer(cfg *config.ClientConfig) error {
+	passphrase, err := readPassphrase(cfg.ExportPath != "")
+	if err != nil {
+		return err
+	}
+
+	n, err := app.RunVaultTransfer(cfg, passphrase)
+	if err != nil {
+		return err
+	}
+	if cfg.ExportPath != "" {
+		fmt.Printf("Exported %d items to %s\n", n, cfg.ExportPath)
+	} else {
+		fmt.Printf("Imported %d items from %s\n", n, cfg.ImportPath)
+	}
+	return nil
+}
+
+// readPassphrase возвращает парольную фразу экспорта из переменной окружения
+// EXPORT_PASSPHRASE или запрашивает её в терминале. При экспорте (confirm)
+// фраза запрашивается дважды.
+func readPassphrase(confirm bool) (string, error) {
+	if p := os.Getenv(passphraseEnv); p != "" {
+		return p, nil
+	}
+	fd := os.Stdin.Fd()
+	if !term.IsTerminal(fd) {
+		return "", fmt.Errorf("passphrase required: set %s or run in a terminal", passphraseEnv)
+	}
+
+	prompt := func(label string) (string, error) {
+		fmt.Fprint(os.Stderr, label)
+		b, err := term.ReadPassword(fd)
+		fmt.Fprintln(os.Stderr)
+		return string(b), err
+	}
+	p, err := prompt("Export passphrase: ")
+	if err != nil {
+		return "", err
+	}
+	if p == "" {
+		return "", errors.New("export passphrase cannot be empty")
+	}
+	if confirm {
+		again, err := prompt("Repeat passphrase: ")
+		if err != nil {
+			return "", err
+		}
+		if again != p {
+			return "", errors.New("passphrases do not match")
+		}
+	}
+	return p, nil
+}
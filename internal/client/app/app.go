@@ -3,19 +3,26 @@ package app
 import (
 	"context"
 	"fmt"
+	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
 	"github.com/ryabkov82/gophkeeper/internal/client/config"
 	"github.com/ryabkov82/gophkeeper/internal/client/connection"
 	"github.com/ryabkov82/gophkeeper/internal/client/service/auth"
 	"github.com/ryabkov82/gophkeeper/internal/client/service/bankcard"
+	"github.com/ryabkov82/gophkeeper/internal/client/service/batch"
 	"github.com/ryabkov82/gophkeeper/internal/client/service/binarydata"
+	"github.com/ryabkov82/gophkeeper/internal/client/service/changes"
 	"github.com/ryabkov82/gophkeeper/internal/client/service/credential"
 	"github.com/ryabkov82/gophkeeper/internal/client/service/cryptokey"
+	"github.com/ryabkov82/gophkeeper/internal/client/service/item"
 	"github.com/ryabkov82/gophkeeper/internal/client/service/textdata"
 	"github.com/ryabkov82/gophkeeper/internal/client/storage"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/logger"
+	"github.com/ryabkov82/gophkeeper/internal/server/embedded"
 	"go.uber.org/zap"
 )
 
@@ -29,10 +36,19 @@ import (
 //   - AuthManager: управление регистрацией, аутентификацией и токенами доступа пользователей.
 //   - CredentialManager: управление учётными данными (создание, получение, обновление, удаление).
 //   - BankCardManager: управление банковскими картами (создание, получение, обновление, удаление).
+//   - ItemManager: избранное и история открытия записей всех типов.
+//   - BatchManager: пакетное создание, обновление и удаление записей в одной транзакции.
+//   - ChangeManager: получение изменений записей для разностной синхронизации.
+//   - SyncCursorStore: хранение курсора синхронизации (номера последнего полученного изменения).
+//   - Cache: локальный зашифрованный кэш записей и очередь изменений, сделанных без связи
+//     (nil — работа только с сервером).
 //   - CryptoKeyManager: генерация, хранение и загрузка криптографических ключей для шифрования.
 //   - ConnManager: управление gRPC подключениями к серверу.
 //   - Logger: структурированный логгер для записи отладочной, диагностической и системной информации.
 //
+// В персональном режиме контейнер также владеет встроенным сервером и
+// останавливает его в Close.
+//
 // Для корректного закрытия ресурсов (например, gRPC соединений) используется sync.Once.
 type AppServices struct {
 	AuthManager       auth.AuthManagerIface
@@ -40,15 +56,30 @@ type AppServices struct {
 	BankCardManager   bankcard.BankCardManagerIface
 	TextDataManager   textdata.TextDataManagerIface
 	BinaryDataManager binarydata.BinaryDataManagerIface
+	ItemManager       item.ItemManagerIface
+	BatchManager      batch.BatchManagerIface
+	ChangeManager     changes.ChangeManagerIface
+	SyncCursorStore   storage.SyncCursorStorage
+	Cache             *cache.Cache
 	CryptoKeyManager  cryptokey.CryptoKeyManagerIface
 	ConnManager       connection.ConnManager
 	Logger            *zap.Logger
 
+	// TransferConcurrency — число одновременных передач файлов в TUI.
+	TransferConcurrency int
+
+	localServer io.Closer // сервер персонального режима (nil — отдельный сервер)
+
 	closeOnce sync.Once
+	replaying atomic.Bool // выполняется воспроизведение офлайн-изменений
 }
 
 // NewAppServices создаёт контейнер зависимостей клиента.
 // cfg — конфигурация клиента.
+//
+// В персональном режиме (cfg.LocalMode) в процессе клиента запускается
+// встроенный сервер (см. пакет embedded) с данными в cfg.LocalDataDir,
+// и соединение устанавливается с ним, а не по сети.
 func NewAppServices(cfg *config.ClientConfig) (*AppServices, error) {
 
 	if err := logger.InitializeWithTimestamp(cfg.LogLevel, cfg.LogDirPath); err != nil {
@@ -65,6 +96,18 @@ func NewAppServices(cfg *config.ClientConfig) (*AppServices, error) {
 		ConnectTimeout: cfg.Timeout,
 	}
 
+	var localServer *embedded.Server
+	if cfg.LocalMode {
+		srv, err := embedded.Start(log.Named("embedded"), cfg.LocalDataDir)
+		if err != nil {
+			return nil, fmt.Errorf("failed to start embedded server: %w", err)
+		}
+		localServer = srv
+		connConfig.ServerAddress = embedded.Address
+		connConfig.UseTLS = false
+		connConfig.Dialer = srv.Dial
+	}
+
 	tokenStore := storage.NewFileTokenStorage(cfg.TokenFilePath)
 
 	cryptoStore := storage.NewFileCryptoKeyStorage(cfg.KeyFilePath)
@@ -83,21 +126,47 @@ func NewAppServices(cfg *config.ClientConfig) (*AppServices, error) {
 	// Создаем BinaryDataManager, передав logger
 	binarydataManager := binarydata.NewBinaryDataManager(log)
 
+	// Создаем ItemManager, передав logger
+	itemManager := item.NewItemManager(log)
+
+	// Создаем BatchManager, передав logger
+	batchManager := batch.NewBatchManager(log)
+
+	// Создаем ChangeManager и хранилище курсора синхронизации
+	changeManager := changes.NewChangeManager(log)
+	syncCursorStore := storage.NewFileSyncCursorStorage(cfg.SyncCursorFilePath)
+
+	// Локальный кэш шифруется тем же ключом, что и данные на сервере
+	localCache := cache.New(cfg.CacheFilePath, cryptoKeyManager.LoadKey)
+
 	connManager := connection.New(connConfig, log, authManager)
 
-	return &AppServices{
+	services := &AppServices{
 		AuthManager:       authManager,
 		CredentialManager: credentialManager,
 		BankCardManager:   bankcardManager,
 		TextDataManager:   textdataManager,
 		BinaryDataManager: binarydataManager,
+		ItemManager:       itemManager,
+		BatchManager:      batchManager,
+		ChangeManager:     changeManager,
+		SyncCursorStore:   syncCursorStore,
+		Cache:             localCache,
 		CryptoKeyManager:  cryptoKeyManager,
 		ConnManager:       connManager,
 		Logger:            log,
-	}, nil
+
+		TransferConcurrency: cfg.TransferConcurrency,
+	}
+	if localServer != nil {
+		services.localServer = localServer
+	}
+	return services, nil
 }
 
 // getGRPCConn возвращает активное gRPC соединение, обеспечивая его создание и восстановление при необходимости.
+// Если в локальном кэше есть изменения, сделанные без связи, они отправляются
+// на сервер до возврата соединения.
 //
 // ctx — контекст запроса, используется для контроля таймаута и отмены операции.
 //
@@ -106,8 +175,9 @@ func (s *AppServices) getGRPCConn(ctx context.Context) (connection.GrpcConn, err
 	conn, err := s.ConnManager.Connect(ctx)
 	if err != nil {
 		s.Logger.Error("Failed to connect gRPC", zap.Error(err))
-		return nil, err
+		return nil, &connError{err: err}
 	}
+	s.replayIfNeeded(ctx)
 	return conn, nil
 }
 
@@ -132,6 +202,19 @@ func (s *AppServices) Close() error {
 		case <-ctx.Done():
 			s.Logger.Warn("Превышено время ожидания закрытия ресурсов")
 		}
+
+		// Встроенный сервер останавливается после закрытия соединения с ним
+		if s.localServer != nil {
+			if serr := s.localServer.Close(); serr != nil {
+				s.Logger.Warn("Failed to stop embedded server", zap.Error(serr))
+			}
+		}
+
+		if s.Cache != nil {
+			if cerr := s.Cache.Close(); cerr != nil {
+				s.Logger.Warn("Failed to close local cache", zap.Error(cerr))
+			}
+		}
 	})
 	return err
 }
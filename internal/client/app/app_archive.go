@@ -0,0 +1,110 @@
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/archive"
+)
+
+// archiveSuffix — расширение файла, в который скачивается архив папки до
+// распаковки.
+const archiveSuffix = ".tar"
+
+// uploadSource — загружаемое содержимое: обычный файл или папка, которая
+// отправляется одним архивом tar.
+type uploadSource struct {
+	path       string
+	clientPath string // путь, сохраняемый в BinaryData.ClientPath
+	dir        bool
+	entries    []archive.Entry
+}
+
+// newUploadSource определяет, что находится по пути path. Для папки
+// составляется список файлов; архив строится по нему при каждом чтении.
+func newUploadSource(path string) (*uploadSource, error) {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return &uploadSource{path: path, clientPath: path}, nil
+	}
+	entries, err := archive.List(path)
+	if err != nil {
+		return nil, err
+	}
+	if entries == nil {
+		entries = []archive.Entry{} // пустая папка — тоже архив
+	}
+	return &uploadSource{path: path, clientPath: path, dir: true, entries: entries}, nil
+}
+
+// fileList возвращает список файлов папки в виде JSON для поля
+// BinaryData.Archive или пустую строку для обычного файла.
+func (u *uploadSource) fileList() (string, error) {
+	if !u.dir {
+		return "", nil
+	}
+	b, err := json.Marshal(u.entries)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// open открывает содержимое для последовательного чтения. Повторные
+// открытия неизменившейся папки дают один и тот же архив.
+func (u *uploadSource) open() (io.ReadCloser, error) {
+	if !u.dir {
+		return os.Open(u.path)
+	}
+	return archive.Open(u.path, u.entries), nil
+}
+
+// openSeekable открывает содержимое с возможностью перемотки. Архив папки
+// для этого записывается во временный файл, который удаляет cleanup.
+func (u *uploadSource) openSeekable() (f *os.File, cleanup func(), err error) {
+	if !u.dir {
+		f, err := os.Open(u.path)
+		if err != nil {
+			return nil, nil, err
+		}
+		return f, func() { f.Close() }, nil
+	}
+
+	tmp, err := os.CreateTemp("", "gophkeeper-*"+archiveSuffix)
+	if err != nil {
+		return nil, nil, err
+	}
+	cleanup = func() {
+		tmp.Close()
+		os.Remove(tmp.Name())
+	}
+	if err := archive.Write(tmp, u.path, u.entries); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		cleanup()
+		return nil, nil, err
+	}
+	return tmp, cleanup, nil
+}
+
+// extractArchive распаковывает скачанный архив tarPath в папку destPath и
+// удаляет архив.
+func extractArchive(tarPath, destPath string) error {
+	f, err := os.Open(tarPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if err := archive.Extract(f, destPath); err != nil {
+		return err
+	}
+	f.Close()
+	return os.Remove(tarPath)
+}
@@ -0,0 +1,84 @@
+package app_test
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/app"
+	"github.com/ryabkov82/gophkeeper/internal/client/archive"
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestUploadDownloadBinaryData_Folder(t *testing.T) {
+	key := []byte("1234567890123456")
+	mockMgr := &mockBinaryDataManager{}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	src := filepath.Join(t.TempDir(), "photos")
+	require.NoError(t, os.MkdirAll(filepath.Join(src, "2024"), 0o750))
+	big := writeRandomFile(t, filepath.Join(src, "2024", "big.bin"), 3<<20, 4)
+	require.NoError(t, os.WriteFile(filepath.Join(src, "notes.txt"), []byte("notes"), 0o640))
+	mtime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	require.NoError(t, os.Chtimes(filepath.Join(src, "notes.txt"), mtime, mtime))
+
+	data := &model.BinaryData{Title: "photos"}
+	require.NoError(t, svc.UploadBinaryData(context.Background(), data, src, nil))
+	assert.NotEmpty(t, mockMgr.manifest)
+
+	// Список файлов хранится на сервере в зашифрованном виде
+	stored := *mockMgr.info
+	assert.NotContains(t, stored.Archive, "notes.txt")
+	wrapper := &cryptowrap.BinaryDataCryptoWrapper{BinaryData: &stored}
+	require.NoError(t, wrapper.Decrypt(key))
+	var entries []archive.Entry
+	require.NoError(t, json.Unmarshal([]byte(stored.Archive), &entries))
+	require.Len(t, entries, 3)
+	assert.Equal(t, "2024/big.bin", entries[1].Path)
+	assert.Equal(t, "notes.txt", entries[2].Path)
+
+	dest := filepath.Join(t.TempDir(), "restored")
+	require.NoError(t, svc.DownloadBinaryData(context.Background(), "chunked", dest, nil))
+
+	got, err := os.ReadFile(filepath.Join(dest, "2024", "big.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, big, got)
+	info, err := os.Stat(filepath.Join(dest, "notes.txt"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 0o640, info.Mode().Perm())
+	assert.True(t, info.ModTime().Equal(mtime))
+	assert.NoFileExists(t, dest+".tar")
+	assert.NoFileExists(t, dest+".tar.part")
+}
+
+func TestUploadBinaryData_FolderFallsBackToStream(t *testing.T) {
+	key := []byte("1234567890123456")
+	mockMgr := &mockBinaryDataManager{chunksUnsupported: true}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	src := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(src, "a.txt"), []byte("a"), 0o600))
+
+	data := &model.BinaryData{}
+	require.NoError(t, svc.UploadBinaryData(context.Background(), data, src, nil))
+	assert.True(t, mockMgr.uploadCalled)
+	assert.NotEmpty(t, mockMgr.info.Archive)
+	assert.NotEmpty(t, mockMgr.info.PlainChecksum)
+}
@@ -52,6 +52,20 @@ func (s *AppServices) LoginUser(ctx context.Context, login, password string) err
 		return fmt.Errorf("failed to generate encryption key: %w", err)
 	}
 
+	// Курсор синхронизации относится к предыдущей сессии (возможно, другого пользователя)
+	if err := s.ResetSyncCursor(); err != nil {
+		s.Logger.Warn("Failed to reset sync cursor", zap.Error(err))
+	}
+
+	// Кэш другого пользователя (или зашифрованный прежним ключом) очищается
+	if s.Cache != nil {
+		if cleared, err := s.Cache.Bind(login); err != nil {
+			s.Logger.Warn("Failed to bind local cache", zap.Error(err))
+		} else if cleared {
+			s.Logger.Info("Local cache reset for new user")
+		}
+	}
+
 	s.Logger.Info("User logged in and encryption key saved", zap.String("login", login))
 	return nil
 }
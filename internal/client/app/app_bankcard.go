@@ -2,7 +2,9 @@ package app
 
 import (
 	"context"
+	"time"
 
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
 	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
@@ -20,8 +22,125 @@ func (s *AppServices) ensureBankCardClient(ctx context.Context) error {
 	return nil
 }
 
-// CreateBankCard создаёт новую банковскую карту на сервере с шифрованием данных
+// CreateBankCard создаёт новую банковскую карту на сервере с шифрованием данных.
+// Если сервер недоступен, карта сохраняется в локальном кэше с временным
+// идентификатором и будет отправлена при восстановлении связи.
 func (s *AppServices) CreateBankCard(ctx context.Context, card *model.BankCard) error {
+	plain := *card
+	now := time.Now()
+	plain.CreatedAt, plain.UpdatedAt = now, now
+
+	err := s.createBankCard(ctx, card)
+	if s.isOffline(err) {
+		plain.ID = cache.NewLocalID()
+		*card = plain
+		return s.queueOffline(cache.OpCreate, bankCardChange(card), err)
+	}
+	if err != nil {
+		return err
+	}
+
+	plain.ID, plain.Version = card.ID, card.Version
+	s.cacheApply(bankCardChange(&plain))
+	return nil
+}
+
+// GetBankCardByID получает банковскую карту по ID с расшифровкой данных.
+// Если сервер недоступен, карта возвращается из локального кэша.
+func (s *AppServices) GetBankCardByID(ctx context.Context, id string) (*model.BankCard, error) {
+	if s.Cache != nil && cache.IsLocalID(id) {
+		return s.cachedBankCard(id, errNotSynced)
+	}
+
+	card, err := s.getBankCardByID(ctx, id)
+	if s.isOffline(err) {
+		return s.cachedBankCard(id, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.cacheApply(bankCardChange(card))
+	return card, nil
+}
+
+// GetBankCards возвращает страницу банковских карт, удовлетворяющих фильтру
+// по папке и тегам, с расшифровкой данных, и токен следующей страницы.
+// Если сервер недоступен, возвращаются все подходящие карты из локального
+// кэша одной страницей.
+func (s *AppServices) GetBankCards(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
+	cards, next, err := s.getBankCards(ctx, filter, page)
+	if s.isOffline(err) {
+		items, cerr := s.cachedList(model.ItemTypeBankCard, filter, page)
+		if cerr != nil {
+			return nil, "", err
+		}
+		cards = make([]model.BankCard, 0, len(items))
+		for _, item := range items {
+			cards = append(cards, *item.BankCard)
+		}
+		return cards, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	changes := make([]model.Change, 0, len(cards))
+	for i := range cards {
+		changes = append(changes, bankCardChange(&cards[i]))
+	}
+	s.cacheApply(changes...)
+	return cards, next, nil
+}
+
+// UpdateBankCard обновляет данные банковской карты с шифрованием.
+// Если сервер недоступен, изменение сохраняется в локальном кэше и будет
+// отправлено при восстановлении связи с проверкой версии карты.
+func (s *AppServices) UpdateBankCard(ctx context.Context, card *model.BankCard) error {
+	plain := *card
+	plain.UpdatedAt = time.Now()
+
+	var err error
+	if s.Cache != nil && cache.IsLocalID(card.ID) {
+		err = errNotSynced
+	} else {
+		err = s.updateBankCard(ctx, card)
+	}
+	if s.isOffline(err) {
+		*card = plain
+		return s.queueOffline(cache.OpUpdate, bankCardChange(card), err)
+	}
+	if err != nil {
+		return err
+	}
+
+	plain.Version = card.Version
+	s.cacheApply(bankCardChange(&plain))
+	return nil
+}
+
+// DeleteBankCard удаляет банковскую карту по ID.
+// Если сервер недоступен, удаление будет выполнено при восстановлении связи.
+func (s *AppServices) DeleteBankCard(ctx context.Context, id string) error {
+	var err error
+	if s.Cache != nil && cache.IsLocalID(id) {
+		err = errNotSynced
+	} else {
+		err = s.deleteBankCard(ctx, id)
+	}
+	if s.isOffline(err) {
+		return s.queueOffline(cache.OpDelete, model.Change{Type: model.ItemTypeBankCard, ItemID: id}, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.cacheRemove(model.ItemTypeBankCard, id)
+	return nil
+}
+
+// createBankCard шифрует данные карты на месте и создаёт её на сервере.
+func (s *AppServices) createBankCard(ctx context.Context, card *model.BankCard) error {
 	err := s.ensureBankCardClient(ctx)
 	if err != nil {
 		return err
@@ -40,8 +159,8 @@ func (s *AppServices) CreateBankCard(ctx context.Context, card *model.BankCard)
 	return s.BankCardManager.CreateBankCard(ctx, card)
 }
 
-// GetBankCardByID получает банковскую карту по ID с расшифровкой данных
-func (s *AppServices) GetBankCardByID(ctx context.Context, id string) (*model.BankCard, error) {
+// getBankCardByID получает банковскую карту с сервера и расшифровывает её.
+func (s *AppServices) getBankCardByID(ctx context.Context, id string) (*model.BankCard, error) {
 	err := s.ensureBankCardClient(ctx)
 	if err != nil {
 		return nil, err
@@ -65,35 +184,40 @@ func (s *AppServices) GetBankCardByID(ctx context.Context, id string) (*model.Ba
 	return card, nil
 }
 
-// GetBankCards возвращает список банковских карт с расшифровкой данных
-func (s *AppServices) GetBankCards(ctx context.Context) ([]model.BankCard, error) {
+// getBankCards получает страницу банковских карт с сервера и расшифровывает их.
+func (s *AppServices) getBankCards(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
 	err := s.ensureBankCardClient(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	cards, err := s.BankCardManager.GetBankCards(ctx)
+	filter, err = s.encryptListFilter(filter)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	cards, next, err := s.BankCardManager.GetBankCards(ctx, filter, page)
+	if err != nil {
+		return nil, "", err
 	}
 
 	key, err := s.CryptoKeyManager.LoadKey()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	for i := range cards {
 		wrapper := &cryptowrap.BankcardCryptoWrapper{BankCard: &cards[i]}
 		if err := wrapper.Decrypt(key); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
-	return cards, nil
+	return cards, next, nil
 }
 
-// UpdateBankCard обновляет данные банковской карты с шифрованием
-func (s *AppServices) UpdateBankCard(ctx context.Context, card *model.BankCard) error {
+// updateBankCard шифрует данные карты на месте и обновляет её на сервере.
+func (s *AppServices) updateBankCard(ctx context.Context, card *model.BankCard) error {
 	err := s.ensureBankCardClient(ctx)
 	if err != nil {
 		return err
@@ -112,11 +236,59 @@ func (s *AppServices) UpdateBankCard(ctx context.Context, card *model.BankCard)
 	return s.BankCardManager.UpdateBankCard(ctx, card)
 }
 
-// DeleteBankCard удаляет банковскую карту по ID
-func (s *AppServices) DeleteBankCard(ctx context.Context, id string) error {
+// deleteBankCard удаляет банковскую карту на сервере.
+func (s *AppServices) deleteBankCard(ctx context.Context, id string) error {
 	err := s.ensureBankCardClient(ctx)
 	if err != nil {
 		return err
 	}
 	return s.BankCardManager.DeleteBankCard(ctx, id)
 }
+
+// cachedBankCard возвращает банковскую карту из локального кэша или cause, если её там нет.
+func (s *AppServices) cachedBankCard(id string, cause error) (*model.BankCard, error) {
+	item, err := s.cachedItem(model.ItemTypeBankCard, id, cause)
+	if err != nil {
+		return nil, err
+	}
+	return item.BankCard, nil
+}
+
+// bankCardChange представляет банковскую карту как запись кэша.
+func bankCardChange(card *model.BankCard) model.Change {
+	c := *card
+	return model.Change{Type: model.ItemTypeBankCard, ItemID: c.ID, BankCard: &c, ChangedAt: c.UpdatedAt}
+}
+
+// bankCardReplay возвращает операции воспроизведения офлайн-изменений банковских карт.
+func (s *AppServices) bankCardReplay() replayHandler {
+	return replayHandler{
+		create: func(ctx context.Context, item model.Change) (model.Change, error) {
+			plain := *item.BankCard
+			card := plain
+			card.ID, card.Version = "", 0 // постоянный идентификатор назначит сервер
+			if err := s.createBankCard(ctx, &card); err != nil {
+				return model.Change{}, err
+			}
+			plain.ID, plain.Version = card.ID, card.Version
+			return bankCardChange(&plain), nil
+		},
+		update: func(ctx context.Context, item model.Change) (model.Change, error) {
+			plain := *item.BankCard
+			card := plain
+			if err := s.updateBankCard(ctx, &card); err != nil {
+				return model.Change{}, err
+			}
+			plain.Version = card.Version
+			return bankCardChange(&plain), nil
+		},
+		get: func(ctx context.Context, id string) (model.Change, error) {
+			card, err := s.getBankCardByID(ctx, id)
+			if err != nil {
+				return model.Change{}, err
+			}
+			return bankCardChange(card), nil
+		},
+		delete: s.deleteBankCard,
+	}
+}
@@ -149,7 +149,7 @@ func TestGetBankCards(t *testing.T) {
 		Logger:           zap.NewNop(),
 	}
 
-	cards, err := appSvc.GetBankCards(ctx)
+	cards, _, err := appSvc.GetBankCards(ctx, model.ListFilter{}, model.PageRequest{})
 	require.NoError(t, err)
 	require.Len(t, cards, 2)
 
@@ -168,7 +168,7 @@ func TestGetBankCards(t *testing.T) {
 
 	// Ошибка подключения
 	appSvc.ConnManager = &mockConnManager{connectErr: errors.New("connect error")}
-	cards, err = appSvc.GetBankCards(ctx)
+	cards, _, err = appSvc.GetBankCards(ctx, model.ListFilter{}, model.PageRequest{})
 	require.Error(t, err)
 	require.Nil(t, cards)
 	require.EqualError(t, err, "connect error")
@@ -0,0 +1,123 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+)
+
+// ensureBatchClient гарантирует создание gRPC клиента для Batch сервиса и установку его в BatchManager.
+//
+// ctx — контекст запроса.
+//
+// Возвращает ошибку при сбое подключения.
+func (s *AppServices) ensureBatchClient(ctx context.Context) error {
+	conn, err := s.getGRPCConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := proto.NewBatchServiceClient(conn)
+	s.BatchManager.SetClient(client)
+	return nil
+}
+
+// BatchMutate отправляет на сервер пакет операций создания, обновления и
+// удаления записей, которые сервер применяет в одной транзакции. Записи
+// передаются в открытом виде и шифруются перед отправкой (исходные значения
+// не изменяются).
+//
+// Успешно применённые операции сразу отражаются в локальном кэше. Без связи
+// с сервером пакет не ставится в очередь, а возвращается ошибка.
+//
+// ctx — контекст запроса.
+// ops — операции пакета (не более model.MaxBatchOps).
+// atomic — всё или ничего: ошибка любой операции отменяет весь пакет.
+//
+// Возвращает результат каждой операции в порядке ops или ошибку, если пакет
+// не был обработан сервером.
+func (s *AppServices) BatchMutate(ctx context.Context, ops []model.BatchOp, atomic bool) ([]model.BatchResult, error) {
+	if err := s.ensureBatchClient(ctx); err != nil {
+		return nil, err
+	}
+	key, err := s.CryptoKeyManager.LoadKey()
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted := make([]model.BatchOp, len(ops))
+	for i, op := range ops {
+		if encrypted[i], err = encryptBatchOp(op, key); err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+	}
+
+	results, err := s.BatchManager.Mutate(ctx, encrypted, atomic)
+	if err != nil {
+		return nil, err
+	}
+
+	var applied []model.Change
+	for i, res := range results {
+		if res.Err != nil {
+			continue
+		}
+		if ops[i].Kind == model.BatchDelete {
+			s.cacheRemove(ops[i].Type, ops[i].ItemID)
+			continue
+		}
+		if ch, ok := batchOpChange(ops[i], res); ok {
+			applied = append(applied, ch)
+		}
+	}
+	s.cacheApply(applied...)
+	return results, nil
+}
+
+// encryptBatchOp возвращает копию операции с зашифрованной записью.
+func encryptBatchOp(op model.BatchOp, key []byte) (model.BatchOp, error) {
+	switch {
+	case op.Credential != nil:
+		c := *op.Credential
+		if err := cryptowrap.EncryptCredential(&c, key); err != nil {
+			return op, err
+		}
+		op.Credential = &c
+	case op.BankCard != nil:
+		c := *op.BankCard
+		if err := cryptowrap.EncryptBankCard(&c, key); err != nil {
+			return op, err
+		}
+		op.BankCard = &c
+	case op.TextData != nil:
+		d := *op.TextData
+		if err := cryptowrap.EncryptTextData(&d, key); err != nil {
+			return op, err
+		}
+		op.TextData = &d
+	}
+	return op, nil
+}
+
+// batchOpChange представляет запись применённой операции (в открытом виде,
+// с назначенными сервером идентификатором и версией) как запись кэша.
+func batchOpChange(op model.BatchOp, res model.BatchResult) (model.Change, bool) {
+	switch {
+	case op.Credential != nil:
+		c := *op.Credential
+		c.ID, c.Version = res.ItemID, res.Version
+		return credentialChange(&c), true
+	case op.BankCard != nil:
+		c := *op.BankCard
+		c.ID, c.Version = res.ItemID, res.Version
+		return bankCardChange(&c), true
+	case op.TextData != nil:
+		d := *op.TextData
+		d.ID, d.Version = res.ItemID, res.Version
+		return textDataChange(&d), true
+	}
+	return model.Change{}, false
+}
@@ -0,0 +1,75 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeBatchManager запоминает отправленные операции и возвращает заданные результаты
+type fakeBatchManager struct {
+	sent    []model.BatchOp
+	results []model.BatchResult
+	err     error
+}
+
+func (f *fakeBatchManager) SetClient(proto.BatchServiceClient) {}
+
+func (f *fakeBatchManager) Mutate(_ context.Context, ops []model.BatchOp, _ bool) ([]model.BatchResult, error) {
+	f.sent = ops
+	return f.results, f.err
+}
+
+func TestBatchMutate_EncryptsAndCaches(t *testing.T) {
+	ctx := context.Background()
+	conn := &mockConnManager{}
+	srv := newFakeCredentialServer()
+	svc := newCachedApp(t, conn, srv)
+	batch := &fakeBatchManager{results: []model.BatchResult{
+		{ItemID: "new1", Version: 1},
+		{ItemID: "c2", Err: status.Error(codes.Aborted, "version conflict")},
+	}}
+	svc.BatchManager = batch
+
+	ops := []model.BatchOp{
+		{Kind: model.BatchCreate, Type: model.ItemTypeCredential, Credential: &model.Credential{Title: "Gmail", Password: "secret"}},
+		{Kind: model.BatchUpdate, Type: model.ItemTypeCredential, Credential: &model.Credential{ID: "c2", Title: "Bank", Password: "pin", Version: 1}},
+	}
+
+	results, err := svc.BatchMutate(ctx, ops, false)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	// На сервер уходят зашифрованные копии, исходные операции не меняются
+	require.Len(t, batch.sent, 2)
+	assert.NotEqual(t, "secret", batch.sent[0].Credential.Password)
+	assert.Equal(t, "secret", ops[0].Credential.Password)
+
+	// Успешная операция сразу видна в кэше, неудачная — нет
+	conn.connectErr = errors.New("connect failed")
+	cred, err := svc.GetCredentialByID(ctx, "new1")
+	require.NoError(t, err)
+	assert.Equal(t, "secret", cred.Password)
+	assert.Equal(t, int64(1), cred.Version)
+
+	_, err = svc.GetCredentialByID(ctx, "c2")
+	assert.Error(t, err)
+}
+
+func TestBatchMutate_Offline(t *testing.T) {
+	conn := &mockConnManager{connectErr: errors.New("connect failed")}
+	svc := newCachedApp(t, conn, newFakeCredentialServer())
+	batch := &fakeBatchManager{}
+	svc.BatchManager = batch
+
+	_, err := svc.BatchMutate(context.Background(), []model.BatchOp{{Kind: model.BatchDelete, Type: model.ItemTypeCredential, ItemID: "c1"}}, true)
+	assert.Error(t, err)
+	assert.Nil(t, batch.sent)
+}
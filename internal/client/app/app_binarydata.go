@@ -1,14 +1,24 @@
 package app
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
 	"os"
 
+	"github.com/ryabkov82/gophkeeper/internal/client/chunker"
 	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
 	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // progressReader оборачивает io.Reader и отправляет прогресс в канал
@@ -33,6 +43,20 @@ func (r *progressReader) Read(p []byte) (int, error) {
 	return n, err
 }
 
+// Seek перематывает источник и выставляет прогресс в новую позицию,
+// чтобы при докачке уже переданные байты не учитывались повторно.
+func (r *progressReader) Seek(offset int64, whence int) (int64, error) {
+	seeker, ok := r.reader.(io.Seeker)
+	if !ok {
+		return 0, errors.New("progress reader: source is not seekable")
+	}
+	pos, err := seeker.Seek(offset, whence)
+	if err == nil {
+		r.sent = pos
+	}
+	return pos, err
+}
+
 // progressWriter считает суммарно записанные байты и шлёт прогресс в канал.
 type progressWriter struct {
 	w  io.Writer
@@ -81,8 +105,27 @@ func (s *AppServices) ensureBinaryDataClient(ctx context.Context) error {
 	return nil
 }
 
-// GetBinaryDataInfo получает метаданные бинарных данных по ID (без содержимого)
+// GetBinaryDataInfo получает метаданные бинарных данных по ID (без содержимого).
+// Если сервер недоступен, метаданные возвращаются из локального кэша.
 func (s *AppServices) GetBinaryDataInfo(ctx context.Context, id string) (*model.BinaryData, error) {
+	data, err := s.getBinaryDataInfo(ctx, id)
+	if s.isOffline(err) {
+		item, cerr := s.cachedItem(model.ItemTypeBinaryData, id, err)
+		if cerr != nil {
+			return nil, cerr
+		}
+		return item.BinaryData, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.cacheApply(binaryDataChange(data))
+	return data, nil
+}
+
+// getBinaryDataInfo получает метаданные бинарных данных с сервера и расшифровывает их.
+func (s *AppServices) getBinaryDataInfo(ctx context.Context, id string) (*model.BinaryData, error) {
 	if err := s.ensureBinaryDataClient(ctx); err != nil {
 		return nil, err
 	}
@@ -105,25 +148,59 @@ func (s *AppServices) GetBinaryDataInfo(ctx context.Context, id string) (*model.
 	return data, nil
 }
 
-// ListBinaryData возвращает список всех бинарных данных пользователя (только метаданные)
-func (s *AppServices) ListBinaryData(ctx context.Context) ([]model.BinaryData, error) {
+// ListBinaryData возвращает страницу бинарных данных пользователя, удовлетворяющих
+// фильтру по папке и тегам (только метаданные), и токен следующей страницы.
+// Если сервер недоступен, возвращаются все подходящие записи из локального
+// кэша одной страницей; содержимое файлов без связи недоступно.
+func (s *AppServices) ListBinaryData(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BinaryData, string, error) {
+	list, next, err := s.listBinaryData(ctx, filter, page)
+	if s.isOffline(err) {
+		items, cerr := s.cachedList(model.ItemTypeBinaryData, filter, page)
+		if cerr != nil {
+			return nil, "", err
+		}
+		list = make([]model.BinaryData, 0, len(items))
+		for _, item := range items {
+			list = append(list, *item.BinaryData)
+		}
+		return list, "", nil
+	}
+	// Метаданные в списке расшифрованы не полностью, поэтому в кэш не записываются
+	return list, next, err
+}
+
+// listBinaryData получает страницу метаданных бинарных данных с сервера.
+func (s *AppServices) listBinaryData(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BinaryData, string, error) {
 	if err := s.ensureBinaryDataClient(ctx); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	list, err := s.BinaryDataManager.List(ctx)
+	filter, err := s.encryptListFilter(filter)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return list, nil
+	list, next, err := s.BinaryDataManager.List(ctx, filter, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tags := make([]*model.Tags, 0, len(list))
+	for i := range list {
+		tags = append(tags, &list[i].Tags)
+	}
+	if err := s.decryptTagsInPlace(tags); err != nil {
+		return nil, "", err
+	}
+
+	return list, next, nil
 }
 
 // sendBinaryData загружает или обновляет бинарные данные на сервер с шифрованием и прогрессом
 func (s *AppServices) sendBinaryData(
 	ctx context.Context,
 	data *model.BinaryData,
-	filePath string,
+	source *uploadSource,
 	progressChan chan<- int64,
 	method func(ctx context.Context, data *model.BinaryData, content io.Reader) error, // upload или update
 ) error {
@@ -131,7 +208,7 @@ func (s *AppServices) sendBinaryData(
 		return err
 	}
 
-	data.ClientPath = filePath
+	data.ClientPath = source.clientPath
 	key, err := s.CryptoKeyManager.LoadKey()
 	if err != nil {
 		return err
@@ -143,11 +220,11 @@ func (s *AppServices) sendBinaryData(
 		return err
 	}
 
-	src, err := os.Open(filePath)
+	src, cleanup, err := source.openSeekable()
 	if err != nil {
 		return err
 	}
-	defer src.Close()
+	defer cleanup()
 
 	fi, err := src.Stat()
 	if err != nil {
@@ -161,32 +238,218 @@ func (s *AppServices) sendBinaryData(
 		progressChan: progressChan,
 	}
 
-	pr, pw := io.Pipe()
-	go func() {
-		defer pw.Close()
-		if err := crypto.EncryptStream(progReader, pw, key); err != nil {
-			_ = pw.CloseWithError(err)
-		}
-	}()
+	// Зашифрованный поток поддерживает перемотку, поэтому прерванная
+	// загрузка продолжается с места, до которого её принял сервер
+	newReader := crypto.NewEncryptReader
+	if data.Compress {
+		newReader = crypto.NewEncryptReaderCompressed
+	}
+	enc, err := newReader(progReader, key)
+	if err != nil {
+		return err
+	}
 
 	done := make(chan error, 1)
 	go func() {
-		done <- method(ctx, data, pr)
+		done <- method(ctx, data, enc)
 	}()
 
 	select {
 	case <-ctx.Done():
-		_ = pr.CloseWithError(ctx.Err())
 		return ctx.Err()
 	case err := <-done:
-		_ = pr.CloseWithError(err)
 		return err
 	}
 }
 
-// UploadBinaryData загружает файл на сервер с потоковым шифрованием
+// chunkQueryBatch — сколько идентификаторов фрагментов отправляется в
+// одном запросе FindMissingChunks.
+const chunkQueryBatch = 1000
+
+// maxCommitAttempts — число попыток зафиксировать загрузку фрагментами.
+// Повтор нужен, если между проверкой и фиксацией сервер удалил фрагмент,
+// на который перестали ссылаться другие файлы.
+const maxCommitAttempts = 2
+
+// errFileChanged — файл изменился, пока шла его загрузка.
+var errFileChanged = errors.New("file changed during upload")
+
+// UploadBinaryData загружает файл на сервер с дедупликацией.
+//
+// Файл режется на фрагменты по содержимому (см. пакет chunker), каждый
+// фрагмент детерминированно шифруется ключом пользователя. Сначала клиент
+// вычисляет идентификаторы фрагментов и узнаёт у сервера, каких из них нет,
+// затем шифрует и отправляет только недостающие и фиксирует список
+// фрагментов файла. Прогресс — число обработанных байт исходного файла.
+//
+// Если сервер не поддерживает загрузку фрагментами, файл отправляется
+// одним зашифрованным потоком.
+//
+// SHA-256 исходного файла записывается в data.PlainChecksum и хранится
+// на сервере в зашифрованном виде; по ней проверяется скачанный файл.
+//
+// Если filePath — папка, она отправляется одним архивом tar (см. пакет
+// archive), а список её файлов записывается в data.Archive и хранится на
+// сервере в зашифрованном виде.
+//
+// Если задан data.Compress, фрагменты (или поток) перед шифрованием
+// сжимаются zstd; то, что сжатие не уменьшает, отправляется как есть.
 func (s *AppServices) UploadBinaryData(ctx context.Context, data *model.BinaryData, filePath string, progressChan chan<- int64) error {
-	return s.sendBinaryData(ctx, data, filePath, progressChan, s.BinaryDataManager.Upload)
+	return s.uploadBinaryData(ctx, data, filePath, filePath, progressChan)
+}
+
+// uploadBinaryData реализует UploadBinaryData. clientPath записывается
+// в data.ClientPath вместо filePath: при импорте содержимое загружается
+// из временного файла, а путь сохраняется исходный.
+func (s *AppServices) uploadBinaryData(ctx context.Context, data *model.BinaryData, filePath, clientPath string, progressChan chan<- int64) error {
+	if err := s.ensureBinaryDataClient(ctx); err != nil {
+		return err
+	}
+
+	key, err := s.CryptoKeyManager.LoadKey()
+	if err != nil {
+		return err
+	}
+
+	source, err := newUploadSource(filePath)
+	if err != nil {
+		return err
+	}
+	source.clientPath = clientPath
+	if data.Archive, err = source.fileList(); err != nil {
+		return err
+	}
+
+	ids, checksum, err := fileChunkIDs(ctx, source, key)
+	if err != nil {
+		return err
+	}
+	data.PlainChecksum = checksum
+
+	missing, err := s.findMissingChunks(ctx, ids)
+	if status.Code(err) == codes.Unimplemented {
+		s.Logger.Info("Server does not support chunked uploads, sending file as a stream")
+		return s.sendBinaryData(ctx, data, source, progressChan, s.BinaryDataManager.Upload)
+	}
+	if err != nil {
+		return err
+	}
+
+	data.ClientPath = clientPath
+	wrapper := &cryptowrap.BinaryDataCryptoWrapper{BinaryData: data}
+	if err := wrapper.Encrypt(key); err != nil {
+		return err
+	}
+
+	for attempt := 1; ; attempt++ {
+		if err := s.uploadChunks(ctx, source, key, ids, missing, data.Compress, progressChan); err != nil {
+			return err
+		}
+		err = s.BinaryDataManager.CommitChunked(ctx, data, ids)
+		if status.Code(err) != codes.FailedPrecondition || attempt == maxCommitAttempts {
+			return err
+		}
+		s.Logger.Warn("Some chunks disappeared before commit, uploading them again", zap.Error(err))
+		if missing, err = s.findMissingChunks(ctx, ids); err != nil {
+			return err
+		}
+	}
+}
+
+// fileChunkIDs разбивает содержимое на фрагменты и возвращает их
+// идентификаторы по порядку и SHA-256 содержимого в шестнадцатеричном виде.
+func fileChunkIDs(ctx context.Context, source *uploadSource, key []byte) ([]string, string, error) {
+	f, err := source.open()
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	var ids []string
+	digest := sha256.New()
+	c := chunker.New(&ctxReader{ctx: ctx, r: io.TeeReader(f, digest)})
+	for {
+		plain, err := c.Next()
+		if err == io.EOF {
+			return ids, hex.EncodeToString(digest.Sum(nil)), nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		ids = append(ids, crypto.ChunkID(plain, key))
+	}
+}
+
+// findMissingChunks запрашивает у сервера, каких фрагментов из ids у него нет.
+// Хотя бы один запрос выполняется и для пустого файла: по его ответу
+// определяется, поддерживает ли сервер загрузку фрагментами.
+func (s *AppServices) findMissingChunks(ctx context.Context, ids []string) ([]string, error) {
+	var missing []string
+	for start := 0; start == 0 || start < len(ids); start += chunkQueryBatch {
+		batch, err := s.BinaryDataManager.FindMissingChunks(ctx, ids[start:min(start+chunkQueryBatch, len(ids))])
+		if err != nil {
+			return nil, err
+		}
+		missing = append(missing, batch...)
+	}
+	return missing, nil
+}
+
+// uploadChunks повторно читает содержимое, шифрует (при compress — предварительно
+// сжимая) и отправляет фрагменты из missing. Идентификаторы всех фрагментов
+// сверяются с ids: если файл успел измениться, возвращается ошибка.
+func (s *AppServices) uploadChunks(ctx context.Context, source *uploadSource, key []byte, ids, missing []string, compress bool, progressChan chan<- int64) error {
+	encrypt := crypto.EncryptChunk
+	if compress {
+		encrypt = crypto.EncryptChunkCompressed
+	}
+
+	need := make(map[string]bool, len(missing))
+	for _, id := range missing {
+		need[id] = true
+	}
+
+	f, err := source.open()
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var sent int64
+	c := chunker.New(&ctxReader{ctx: ctx, r: f})
+	for i := 0; ; i++ {
+		plain, err := c.Next()
+		if err == io.EOF {
+			if i != len(ids) {
+				return errFileChanged
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if i >= len(ids) || crypto.ChunkID(plain, key) != ids[i] {
+			return errFileChanged
+		}
+
+		if need[ids[i]] {
+			id, ct, err := encrypt(plain, key)
+			if err != nil {
+				return err
+			}
+			if err := s.BinaryDataManager.UploadChunk(ctx, id, ct); err != nil {
+				return err
+			}
+			// Повторы фрагмента внутри файла отправляются один раз
+			delete(need, id)
+		}
+
+		sent += int64(len(plain))
+		select {
+		case progressChan <- sent:
+		default:
+		}
+	}
 }
 
 // UpdateBinaryDataInfo обновляет только метаданные бинарных данных без пересылки содержимого
@@ -227,45 +490,391 @@ func (s *AppServices) CreateBinaryDataInfo(ctx context.Context, data *model.Bina
 	return s.BinaryDataManager.CreateInfo(ctx, data)
 }
 
-// DownloadBinaryData скачивает файл с сервера, расшифровывает его и отправляет прогресс через канал
+// partSuffix — расширение файла, в который скачивается зашифрованное
+// содержимое до его расшифровки.
+const partSuffix = ".part"
+
+// DownloadBinaryData скачивает файл с сервера, расшифровывает его и отправляет прогресс через канал.
+//
+// Файл, загруженный фрагментами, скачивается по фрагментам (см.
+// downloadChunked). Остальные файлы скачиваются одним потоком.
+//
+// Зашифрованное содержимое сначала сохраняется в destPath+".part". Если такой
+// файл остался от прерванного скачивания, он обрезается до последнего целого
+// кадра потока, прошедшего проверку подлинности, и скачивание продолжается
+// с этого места. После получения всего содержимого .part расшифровывается
+// в destPath и удаляется. Прогресс — число байт зашифрованного содержимого,
+// включая полученные ранее.
+//
+// Если при загрузке была записана контрольная сумма файла, SHA-256
+// расшифрованного содержимого сверяется с ней; при расхождении destPath
+// удаляется и возвращается model.ErrChecksumMismatch.
+//
+// Папка, загруженная архивом (непустой Archive), скачивается в
+// destPath+".tar" и после проверки распаковывается в папку destPath
+// с восстановлением прав доступа и времени изменения файлов.
 func (s *AppServices) DownloadBinaryData(
 	ctx context.Context,
 	dataID, destPath string,
 	progressCh chan<- int64,
 ) error {
-	if err := s.ensureBinaryDataClient(ctx); err != nil {
+	info, err := s.getBinaryDataInfo(ctx, dataID)
+	if err != nil {
 		return err
 	}
+	return s.downloadContent(ctx, dataID, "", info.PlainChecksum, info.Archive, destPath, progressCh)
+}
 
+// downloadContent скачивает содержимое versionID записи dataID (пустой
+// versionID — текущее) в destPath, сверяет его с контрольной суммой
+// plainChecksum и распаковывает, если это архив папки (непустой archive).
+func (s *AppServices) downloadContent(
+	ctx context.Context,
+	dataID, versionID, plainChecksum, archive, destPath string,
+	progressCh chan<- int64,
+) error {
 	key, err := s.CryptoKeyManager.LoadKey()
 	if err != nil {
 		return err
 	}
 
-	src, err := s.BinaryDataManager.Download(ctx, dataID)
-	if err != nil {
+	refs, chunked, err := s.BinaryDataManager.GetVersionManifest(ctx, dataID, versionID)
+	if err != nil && status.Code(err) != codes.Unimplemented {
 		return err
 	}
-	defer src.Close()
 
-	dst, err := os.Create(destPath)
+	filePath := destPath
+	if archive != "" {
+		filePath = destPath + archiveSuffix
+	}
+
+	var checksum string
+	if chunked {
+		checksum, err = s.downloadChunked(ctx, refs, filePath, key, progressCh)
+	} else {
+		checksum, err = s.downloadStream(ctx, dataID, versionID, filePath, key, progressCh)
+	}
 	if err != nil {
 		return err
 	}
-	defer dst.Close()
+
+	// Записи, загруженные до появления контрольных сумм, не проверяются
+	if plainChecksum != "" && checksum != plainChecksum {
+		s.Logger.Error("Downloaded file checksum mismatch", zap.String("id", dataID), zap.String("versionID", versionID))
+		_ = os.Remove(filePath)
+		return fmt.Errorf("%w: expected %s, got %s", model.ErrChecksumMismatch, plainChecksum, checksum)
+	}
+
+	if archive != "" {
+		return extractArchive(filePath, destPath)
+	}
+	return nil
+}
+
+// downloadStream скачивает файл, хранящийся одним потоком, через .part и
+// расшифровывает его в destPath. Возвращает SHA-256 расшифрованного содержимого.
+func (s *AppServices) downloadStream(ctx context.Context, dataID, versionID, destPath string, key []byte, progressCh chan<- int64) (string, error) {
+	resumed, err := s.downloadPart(ctx, dataID, versionID, destPath+partSuffix, key, progressCh)
+	if err != nil {
+		return "", err
+	}
+
+	checksum, err := decryptPart(destPath+partSuffix, destPath, key)
+	if err != nil && resumed {
+		// Начало .part могло остаться от другой версии файла — скачиваем заново
+		s.Logger.Warn("Resumed download is corrupted, restarting", zap.String("id", dataID), zap.Error(err))
+		_ = os.Remove(destPath + partSuffix)
+		if _, err = s.downloadPart(ctx, dataID, versionID, destPath+partSuffix, key, progressCh); err != nil {
+			return "", err
+		}
+		checksum, err = decryptPart(destPath+partSuffix, destPath, key)
+	}
+	if err != nil {
+		return "", err
+	}
+	return checksum, os.Remove(destPath + partSuffix)
+}
+
+// downloadPart докачивает зашифрованное содержимое в partPath, продолжая
+// с конца проверенной части уже скачанного. Возвращает true, если скачивание
+// продолжено, а не начато с нуля.
+func (s *AppServices) downloadPart(ctx context.Context, dataID, versionID, partPath string, key []byte, progressCh chan<- int64) (bool, error) {
+	part, err := os.OpenFile(partPath, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return false, err
+	}
+	defer part.Close()
+
+	offset, err := crypto.ValidStreamPrefix(part, key)
+	if err != nil {
+		return false, err
+	}
+	if err := part.Truncate(offset); err != nil {
+		return false, err
+	}
+	if _, err := part.Seek(offset, io.SeekStart); err != nil {
+		return false, err
+	}
+
+	src, err := s.BinaryDataManager.DownloadVersionRange(ctx, dataID, versionID, offset, 0)
+	if err != nil {
+		return false, err
+	}
+	defer src.Close()
 
 	// Оборачиваем вход и выход: вход — реагирует на cancel контекста,
 	// выход — считает записанные байты и репортит прогресс.
 	in := &ctxReader{ctx: ctx, r: src}
-	out := &progressWriter{w: dst, ch: progressCh}
+	out := &progressWriter{w: part, n: offset, ch: progressCh}
+	if _, err := io.Copy(out, in); err != nil {
+		return false, err
+	}
+	return offset > 0, part.Close()
+}
+
+// downloadChunked скачивает файл по фрагментам манифеста refs и пишет
+// расшифрованное содержимое в destPath+".part", который после получения
+// всех фрагментов переименовывается в destPath.
+//
+// Если .part остался от прерванного скачивания, его содержимое заново
+// режется на фрагменты, они сверяются с идентификаторами манифеста, и
+// скачивание продолжается с первого несовпадения. Размеры зашифрованных
+// фрагментов для этого не подходят: сжатые фрагменты короче исходных. Прогресс — суммарный размер зашифрованных
+// фрагментов, как и при скачивании одним потоком. Возвращает SHA-256
+// собранного файла.
+func (s *AppServices) downloadChunked(ctx context.Context, refs []model.ChunkRef, destPath string, key []byte, progressCh chan<- int64) (string, error) {
+	partPath := destPath + partSuffix
+	part, err := os.OpenFile(partPath, os.O_RDWR|os.O_CREATE, 0o600)
+	if err != nil {
+		return "", err
+	}
+	defer part.Close()
+
+	digest := sha256.New()
+	var offset, done int64
+	next := 0
+	c := chunker.New(part)
+	for ; next < len(refs); next++ {
+		plain, err := c.Next()
+		if err != nil || crypto.ChunkID(plain, key) != refs[next].ID {
+			break
+		}
+		digest.Write(plain)
+		offset += int64(len(plain))
+		done += refs[next].Size
+	}
+	if err := part.Truncate(offset); err != nil {
+		return "", err
+	}
+	if _, err := part.Seek(offset, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	for _, ref := range refs[next:] {
+		ct, err := s.BinaryDataManager.DownloadChunk(ctx, ref.ID)
+		if err != nil {
+			return "", err
+		}
+		plain, err := crypto.DecryptChunk(ref.ID, ct, key)
+		if err != nil {
+			return "", err
+		}
+		if _, err := part.Write(plain); err != nil {
+			return "", err
+		}
+		digest.Write(plain)
+
+		done += ref.Size
+		select {
+		case progressCh <- done:
+		default:
+		}
+	}
+
+	if err := part.Close(); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(digest.Sum(nil)), os.Rename(partPath, destPath)
+}
+
+// decryptPart расшифровывает скачанное содержимое partPath в destPath и
+// возвращает SHA-256 расшифрованного содержимого.
+func decryptPart(partPath, destPath string, key []byte) (string, error) {
+	src, err := os.Open(partPath)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	dst, err := os.Create(destPath)
+	if err != nil {
+		return "", err
+	}
+	defer dst.Close()
+
+	digest := sha256.New()
+	if err := crypto.DecryptStream(bufio.NewReader(src), io.MultiWriter(dst, digest), key); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(digest.Sum(nil)), dst.Close()
+}
+
+// previewWriter накапливает расшифрованное содержимое в памяти и прерывает
+// расшифровку, как только оно превышает limit байт.
+type previewWriter struct {
+	buf   bytes.Buffer
+	limit int64
+}
+
+func (w *previewWriter) Write(p []byte) (int, error) {
+	if int64(w.buf.Len()+len(p)) > w.limit {
+		return 0, model.ErrPreviewTooLarge
+	}
+	return w.buf.Write(p)
+}
+
+// ReadBinaryData скачивает и расшифровывает содержимое записи id в память,
+// не записывая его на диск, — для просмотра небольших файлов прямо в TUI.
+//
+// Содержимое больше limit байт не читается до конца: возвращается
+// model.ErrPreviewTooLarge. Папки, загруженные архивом, не просматриваются
+// (model.ErrPreviewArchive). Если записана контрольная сумма, содержимое
+// сверяется с ней, как и при скачивании.
+func (s *AppServices) ReadBinaryData(ctx context.Context, id string, limit int64) ([]byte, error) {
+	info, err := s.getBinaryDataInfo(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if info.Archive != "" {
+		return nil, model.ErrPreviewArchive
+	}
+
+	key, err := s.CryptoKeyManager.LoadKey()
+	if err != nil {
+		return nil, err
+	}
+
+	refs, chunked, err := s.BinaryDataManager.GetManifest(ctx, id)
+	if err != nil && status.Code(err) != codes.Unimplemented {
+		return nil, err
+	}
+
+	buf := &previewWriter{limit: limit}
+	digest := sha256.New()
+	out := io.MultiWriter(buf, digest)
+	if chunked {
+		for _, ref := range refs {
+			ct, err := s.BinaryDataManager.DownloadChunk(ctx, ref.ID)
+			if err != nil {
+				return nil, err
+			}
+			plain, err := crypto.DecryptChunk(ref.ID, ct, key)
+			if err != nil {
+				return nil, err
+			}
+			if _, err := out.Write(plain); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		src, err := s.BinaryDataManager.Download(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		defer src.Close()
+		if err := crypto.DecryptStream(bufio.NewReader(&ctxReader{ctx: ctx, r: src}), out, key); err != nil {
+			return nil, err
+		}
+	}
+
+	if checksum := hex.EncodeToString(digest.Sum(nil)); info.PlainChecksum != "" && checksum != info.PlainChecksum {
+		return nil, fmt.Errorf("%w: expected %s, got %s", model.ErrChecksumMismatch, info.PlainChecksum, checksum)
+	}
+	return buf.buf.Bytes(), nil
+}
 
-	// Потоковая дешифровка напрямую в файл без промежуточного буфера/пайпа.
-	if err := crypto.DecryptStream(in, out, key); err != nil {
+// VerifyBinaryData просит сервер проверить целостность хранимого содержимого
+// записи. Если содержимое повреждено, возвращается ошибка, оборачивающая
+// model.ErrChecksumMismatch.
+func (s *AppServices) VerifyBinaryData(ctx context.Context, id string) error {
+	if err := s.ensureBinaryDataClient(ctx); err != nil {
 		return err
 	}
 
+	valid, detail, err := s.BinaryDataManager.Verify(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !valid {
+		return fmt.Errorf("%w: %s", model.ErrChecksumMismatch, detail)
+	}
 	return nil
+}
+
+// ListBinaryVersions возвращает прежние версии содержимого файла id,
+// начиная с последней, с расшифрованными атрибутами.
+func (s *AppServices) ListBinaryVersions(ctx context.Context, id string) ([]model.BinaryVersion, error) {
+	if err := s.ensureBinaryDataClient(ctx); err != nil {
+		return nil, err
+	}
 
+	versions, err := s.BinaryDataManager.ListVersions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := s.CryptoKeyManager.LoadKey()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range versions {
+		wrapper := &cryptowrap.BinaryVersionCryptoWrapper{BinaryVersion: &versions[i]}
+		if err := wrapper.Decrypt(key); err != nil {
+			return nil, err
+		}
+	}
+	return versions, nil
+}
+
+// DownloadBinaryVersion скачивает прежнюю версию versionID содержимого
+// файла dataID в destPath так же, как DownloadBinaryData скачивает текущее.
+func (s *AppServices) DownloadBinaryVersion(
+	ctx context.Context,
+	dataID, versionID, destPath string,
+	progressCh chan<- int64,
+) error {
+	versions, err := s.ListBinaryVersions(ctx, dataID)
+	if err != nil {
+		return err
+	}
+	for _, v := range versions {
+		if v.ID == versionID {
+			return s.downloadContent(ctx, dataID, versionID, v.PlainChecksum, v.Archive, destPath, progressCh)
+		}
+	}
+	return model.ErrBinaryVersionNotFound
+}
+
+// RestoreBinaryVersion делает прежнюю версию versionID текущим содержимым
+// файла id; version — ожидаемая версия записи (0 — без проверки). Заменённое
+// содержимое сервер сохраняет прежней версией. Возвращает обновлённые
+// метаданные записи.
+func (s *AppServices) RestoreBinaryVersion(ctx context.Context, id, versionID string, version int64) (*model.BinaryData, error) {
+	if err := s.ensureBinaryDataClient(ctx); err != nil {
+		return nil, err
+	}
+	if _, err := s.BinaryDataManager.RestoreVersion(ctx, id, versionID, version); err != nil {
+		return nil, err
+	}
+
+	// У записи сменились размер, путь и контрольная сумма — перечитываем её
+	data, err := s.getBinaryDataInfo(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("version restored, but failed to reload binary data info: %w", err)
+	}
+	s.cacheApply(binaryDataChange(data))
+	return data, nil
 }
 
 // DeleteBinaryData удаляет бинарные данные по ID
@@ -273,5 +882,15 @@ func (s *AppServices) DeleteBinaryData(ctx context.Context, id string) error {
 	if err := s.ensureBinaryDataClient(ctx); err != nil {
 		return err
 	}
-	return s.BinaryDataManager.Delete(ctx, id)
+	if err := s.BinaryDataManager.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.cacheRemove(model.ItemTypeBinaryData, id)
+	return nil
+}
+
+// binaryDataChange представляет метаданные бинарных данных как запись кэша.
+func binaryDataChange(data *model.BinaryData) model.Change {
+	d := *data
+	return model.Change{Type: model.ItemTypeBinaryData, ItemID: d.ID, BinaryData: &d, ChangedAt: d.UpdatedAt}
 }
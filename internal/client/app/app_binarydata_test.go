@@ -3,15 +3,24 @@ package app_test
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"io"
+	"math/rand"
 	"os"
+	"path/filepath"
 	"testing"
+	"testing/iotest"
 
 	"github.com/ryabkov82/gophkeeper/internal/client/app"
+	"github.com/ryabkov82/gophkeeper/internal/client/chunker"
 	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
 	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 )
 
@@ -67,6 +76,7 @@ func TestDownloadBinaryData(t *testing.T) {
 			// Возвращаем зашифрованный поток
 			return io.NopCloser(bytes.NewReader(encryptedBuf.Bytes())), nil
 		},
+		getInfoFn: encryptedInfo(t, key, plainContent),
 	}
 	mockCrypto := &mockCryptoKeyManager{
 		loadKeyData: key,
@@ -86,6 +96,73 @@ func TestDownloadBinaryData(t *testing.T) {
 	assert.Equal(t, plainContent, got) // проверяем расшифрованный результат
 }
 
+// encryptedInfo возвращает функцию GetInfo, отдающую метаданные файла с
+// содержимым content, зашифрованные ключом key. Для nil контрольная сумма
+// не записывается, как у файлов, загруженных до её появления.
+func encryptedInfo(t *testing.T, key, content []byte) func(ctx context.Context, id string) (*model.BinaryData, error) {
+	t.Helper()
+	return func(ctx context.Context, id string) (*model.BinaryData, error) {
+		data := &model.BinaryData{ID: id}
+		if content != nil {
+			sum := sha256.Sum256(content)
+			data.PlainChecksum = hex.EncodeToString(sum[:])
+		}
+		wrapper := &cryptowrap.BinaryDataCryptoWrapper{BinaryData: data}
+		if err := wrapper.Encrypt(key); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+}
+
+// Тест скачивания файла, не совпадающего с записанной контрольной суммой
+func TestDownloadBinaryData_ChecksumMismatch(t *testing.T) {
+	key := []byte("1234567890123456")
+	enc := new(bytes.Buffer)
+	require.NoError(t, crypto.EncryptStream(bytes.NewReader([]byte("tampered")), enc, key))
+
+	dest := filepath.Join(t.TempDir(), "out.txt")
+	mockMgr := &mockBinaryDataManager{
+		downloadFn: func(ctx context.Context, id string) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(enc.Bytes())), nil
+		},
+		getInfoFn: encryptedInfo(t, key, []byte("original")),
+	}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	err := svc.DownloadBinaryData(context.Background(), "id1", dest, nil)
+	assert.ErrorIs(t, err, model.ErrChecksumMismatch)
+	assert.NoFileExists(t, dest)
+	assert.NoFileExists(t, dest+".part")
+
+	// Без записанной суммы файл не проверяется
+	mockMgr.getInfoFn = encryptedInfo(t, key, nil)
+	require.NoError(t, svc.DownloadBinaryData(context.Background(), "id1", dest, nil))
+	assert.FileExists(t, dest)
+}
+
+// Тест VerifyBinaryData
+func TestVerifyBinaryData(t *testing.T) {
+	mockMgr := &mockBinaryDataManager{}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		Logger:            zap.NewNop(),
+	}
+
+	assert.NoError(t, svc.VerifyBinaryData(context.Background(), "id1"))
+
+	mockMgr.invalid = "chunk abc is corrupted"
+	err := svc.VerifyBinaryData(context.Background(), "id1")
+	assert.ErrorIs(t, err, model.ErrChecksumMismatch)
+	assert.ErrorContains(t, err, "chunk abc is corrupted")
+}
+
 // Тест ListBinaryData
 func TestListBinaryData(t *testing.T) {
 	mockMgr := &mockBinaryDataManager{
@@ -101,7 +178,7 @@ func TestListBinaryData(t *testing.T) {
 		Logger:            zap.NewNop(),
 	}
 
-	list, err := svc.ListBinaryData(context.Background())
+	list, _, err := svc.ListBinaryData(context.Background(), model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, list, 2)
 }
@@ -211,3 +288,379 @@ func TestCreateBinaryDataInfo(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, "meta", data.Metadata)
 }
+
+// Тест докачки: скачивание продолжается с границы последнего целого кадра .part
+func TestDownloadBinaryData_ResumesPart(t *testing.T) {
+	key := []byte("1234567890123456")
+	plain := bytes.Repeat([]byte("resumable download "), 5000) // больше двух чанков
+
+	enc := new(bytes.Buffer)
+	assert.NoError(t, crypto.EncryptStream(bytes.NewReader(plain), enc, key))
+	full := enc.Bytes()
+	frame := 4 + 32*1024 + 16
+
+	dest := t.TempDir() + "/out.bin"
+	// Первый кадр целиком и обрывок второго
+	assert.NoError(t, os.WriteFile(dest+".part", full[:12+frame+500], 0o600))
+
+	var offsets []int64
+	mockMgr := &mockBinaryDataManager{
+		downloadRangeFn: func(ctx context.Context, id string, offset, length int64) (io.ReadCloser, error) {
+			offsets = append(offsets, offset)
+			return io.NopCloser(bytes.NewReader(full[offset:])), nil
+		},
+		getInfoFn: encryptedInfo(t, key, plain),
+	}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	progressCh := make(chan int64, 1000)
+	err := svc.DownloadBinaryData(context.Background(), "id1", dest, progressCh)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{int64(12 + frame)}, offsets)
+
+	got, err := os.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, plain, got)
+
+	_, err = os.Stat(dest + ".part")
+	assert.True(t, os.IsNotExist(err), ".part должен быть удалён")
+}
+
+// Тест устаревшего .part: начало от другой версии файла, скачивание начинается заново
+func TestDownloadBinaryData_StalePartRestarts(t *testing.T) {
+	key := []byte("1234567890123456")
+	plain := bytes.Repeat([]byte("new version "), 8000)
+
+	old, current := new(bytes.Buffer), new(bytes.Buffer)
+	assert.NoError(t, crypto.EncryptStream(bytes.NewReader(plain), old, key))
+	assert.NoError(t, crypto.EncryptStream(bytes.NewReader(plain), current, key))
+	frame := 4 + 32*1024 + 16
+
+	dest := t.TempDir() + "/out.bin"
+	assert.NoError(t, os.WriteFile(dest+".part", old.Bytes()[:12+frame], 0o600))
+
+	var offsets []int64
+	mockMgr := &mockBinaryDataManager{
+		downloadRangeFn: func(ctx context.Context, id string, offset, length int64) (io.ReadCloser, error) {
+			offsets = append(offsets, offset)
+			return io.NopCloser(bytes.NewReader(current.Bytes()[offset:])), nil
+		},
+		getInfoFn: encryptedInfo(t, key, plain),
+	}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	err := svc.DownloadBinaryData(context.Background(), "id1", dest, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{int64(12 + frame), 0}, offsets)
+
+	got, err := os.ReadFile(dest)
+	assert.NoError(t, err)
+	assert.Equal(t, plain, got)
+}
+
+// Тест обрыва скачивания: полученная часть остаётся в .part
+func TestDownloadBinaryData_InterruptedKeepsPart(t *testing.T) {
+	key := []byte("1234567890123456")
+	enc := new(bytes.Buffer)
+	assert.NoError(t, crypto.EncryptStream(bytes.NewReader(bytes.Repeat([]byte("x"), 100000)), enc, key))
+
+	dest := t.TempDir() + "/out.bin"
+	mockMgr := &mockBinaryDataManager{
+		downloadRangeFn: func(ctx context.Context, id string, offset, length int64) (io.ReadCloser, error) {
+			r := io.MultiReader(bytes.NewReader(enc.Bytes()[:40000]), iotest.ErrReader(errors.New("connection lost")))
+			return io.NopCloser(r), nil
+		},
+		getInfoFn: encryptedInfo(t, key, nil),
+	}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	err := svc.DownloadBinaryData(context.Background(), "id1", dest, nil)
+	assert.ErrorContains(t, err, "connection lost")
+
+	fi, err := os.Stat(dest + ".part")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 40000, fi.Size())
+}
+
+// writeRandomFile создаёт файл из size случайных байт и возвращает его содержимое.
+func writeRandomFile(t *testing.T, path string, size int, seed int64) []byte {
+	t.Helper()
+	content := make([]byte, size)
+	rand.New(rand.NewSource(seed)).Read(content)
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+	return content
+}
+
+func TestUploadBinaryData_ChunkedDeduplicates(t *testing.T) {
+	key := []byte("1234567890123456")
+	dir := t.TempDir()
+	mockMgr := &mockBinaryDataManager{}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	content := writeRandomFile(t, filepath.Join(dir, "v1.bin"), 8<<20, 1)
+	progressCh := make(chan int64, 100)
+	err := svc.UploadBinaryData(context.Background(), &model.BinaryData{Title: "file"}, filepath.Join(dir, "v1.bin"), progressCh)
+	require.NoError(t, err)
+	assert.False(t, mockMgr.uploadCalled)
+	firstUploads := mockMgr.chunkUploads
+	assert.Greater(t, firstUploads, 2)
+
+	var last int64
+	for len(progressCh) > 0 {
+		last = <-progressCh
+	}
+	assert.EqualValues(t, len(content), last)
+
+	// Новая версия с вставкой в начало: повторно отправляются лишь первые фрагменты
+	v2 := append([]byte("new header"), content...)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "v2.bin"), v2, 0o600))
+	err = svc.UploadBinaryData(context.Background(), &model.BinaryData{Title: "file"}, filepath.Join(dir, "v2.bin"), nil)
+	require.NoError(t, err)
+	assert.LessOrEqual(t, mockMgr.chunkUploads-firstUploads, 2)
+
+	// Скачивание собирает файл из фрагментов
+	dest := filepath.Join(dir, "out.bin")
+	require.NoError(t, svc.DownloadBinaryData(context.Background(), "chunked", dest, nil))
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, v2, got)
+	assert.NoFileExists(t, dest+".part")
+}
+
+func TestUploadBinaryData_FallsBackToStream(t *testing.T) {
+	key := []byte("1234567890123456")
+	path := filepath.Join(t.TempDir(), "file.bin")
+	require.NoError(t, os.WriteFile(path, []byte("content"), 0o600))
+
+	mockMgr := &mockBinaryDataManager{chunksUnsupported: true}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	data := &model.BinaryData{Metadata: "meta"}
+	require.NoError(t, svc.UploadBinaryData(context.Background(), data, path, nil))
+	assert.True(t, mockMgr.uploadCalled)
+	assert.Zero(t, mockMgr.chunkUploads)
+
+	// Метаданные зашифрованы ровно один раз
+	wrapper := &cryptowrap.BinaryDataCryptoWrapper{BinaryData: data}
+	require.NoError(t, wrapper.Decrypt(key))
+	assert.Equal(t, "meta", data.Metadata)
+	sum := sha256.Sum256([]byte("content"))
+	assert.Equal(t, hex.EncodeToString(sum[:]), data.PlainChecksum)
+}
+
+func TestDownloadBinaryData_ChunkedResumesPart(t *testing.T) {
+	key := []byte("1234567890123456")
+	dir := t.TempDir()
+	mockMgr := &mockBinaryDataManager{}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	content := writeRandomFile(t, filepath.Join(dir, "src.bin"), 8<<20, 2)
+	require.NoError(t, svc.UploadBinaryData(context.Background(), &model.BinaryData{}, filepath.Join(dir, "src.bin"), nil))
+	require.Greater(t, len(mockMgr.manifest), 2)
+
+	// Прерванное скачивание: первый фрагмент целиком и часть второго
+	firstSize := len(mockMgr.chunks[mockMgr.manifest[0]]) - crypto.ChunkOverhead
+	dest := filepath.Join(dir, "out.bin")
+	require.NoError(t, os.WriteFile(dest+".part", content[:firstSize+100], 0o600))
+
+	require.NoError(t, svc.DownloadBinaryData(context.Background(), "chunked", dest, nil))
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+	assert.Equal(t, len(mockMgr.manifest)-1, mockMgr.chunkDownloads)
+}
+
+func TestUploadBinaryData_CompressedChunks(t *testing.T) {
+	key := []byte("1234567890123456")
+	dir := t.TempDir()
+	mockMgr := &mockBinaryDataManager{}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	// Хорошо сжимаемый, но разнообразный текст, чтобы файл резался на фрагменты
+	rnd := rand.New(rand.NewSource(3))
+	var buf bytes.Buffer
+	for buf.Len() < 4<<20 {
+		fmt.Fprintf(&buf, "2025-01-01 12:%02d:%02d INFO request %d handled\n", rnd.Intn(60), rnd.Intn(60), rnd.Intn(1000))
+	}
+	content := buf.Bytes()
+	path := filepath.Join(dir, "app.log")
+	require.NoError(t, os.WriteFile(path, content, 0o600))
+
+	require.NoError(t, svc.UploadBinaryData(context.Background(), &model.BinaryData{Compress: true}, path, nil))
+	require.Greater(t, len(mockMgr.manifest), 1)
+	var stored int
+	for _, ct := range mockMgr.chunks {
+		stored += len(ct)
+	}
+	assert.Less(t, stored, len(content)/2)
+
+	// Докачка прерванного скачивания не зависит от размеров сжатых фрагментов
+	first, err := chunker.New(bytes.NewReader(content)).Next()
+	require.NoError(t, err)
+	dest := filepath.Join(dir, "out.log")
+	require.NoError(t, os.WriteFile(dest+".part", content[:len(first)+100], 0o600))
+
+	require.NoError(t, svc.DownloadBinaryData(context.Background(), "chunked", dest, nil))
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+	assert.Equal(t, len(mockMgr.manifest)-1, mockMgr.chunkDownloads)
+}
+
+// encryptedVersion возвращает прежнюю версию с содержимым content, атрибуты
+// которой зашифрованы ключом key, как их хранит сервер.
+func encryptedVersion(t *testing.T, key []byte, id string, content []byte) model.BinaryVersion {
+	t.Helper()
+	sum := sha256.Sum256(content)
+	v := model.BinaryVersion{ID: id, ClientPath: "/tmp/old.txt", PlainChecksum: hex.EncodeToString(sum[:])}
+	require.NoError(t, (&cryptowrap.BinaryVersionCryptoWrapper{BinaryVersion: &v}).Encrypt(key))
+	return v
+}
+
+// Тест скачивания и восстановления прежней версии файла
+func TestBinaryVersions(t *testing.T) {
+	key := []byte("1234567890123456")
+	content := []byte("old content")
+	enc := new(bytes.Buffer)
+	require.NoError(t, crypto.EncryptStream(bytes.NewReader(content), enc, key))
+
+	mockMgr := &mockBinaryDataManager{
+		downloadFn: func(ctx context.Context, id string) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(enc.Bytes())), nil
+		},
+		getInfoFn: encryptedInfo(t, key, []byte("new content")),
+		versions:  []model.BinaryVersion{encryptedVersion(t, key, "v1", content)},
+	}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+	ctx := context.Background()
+
+	versions, err := svc.ListBinaryVersions(ctx, "id1")
+	require.NoError(t, err)
+	require.Len(t, versions, 1)
+	assert.Equal(t, "/tmp/old.txt", versions[0].ClientPath)
+
+	// Содержимое версии сверяется с её собственной контрольной суммой
+	dest := filepath.Join(t.TempDir(), "old.txt")
+	require.NoError(t, svc.DownloadBinaryVersion(ctx, "id1", "v1", dest, nil))
+	assert.Equal(t, "v1", mockMgr.versionID)
+	got, err := os.ReadFile(dest)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	err = svc.DownloadBinaryVersion(ctx, "id1", "v2", dest, nil)
+	assert.ErrorIs(t, err, model.ErrBinaryVersionNotFound)
+
+	data, err := svc.RestoreBinaryVersion(ctx, "id1", "v1", 3)
+	require.NoError(t, err)
+	assert.Equal(t, "id1", data.ID)
+	assert.Equal(t, "v1", mockMgr.restored)
+}
+
+// Тест чтения содержимого файла в память для просмотра
+func TestReadBinaryData(t *testing.T) {
+	key := []byte("1234567890123456")
+	content := []byte("recovery codes")
+	enc := new(bytes.Buffer)
+	require.NoError(t, crypto.EncryptStream(bytes.NewReader(content), enc, key))
+
+	mockMgr := &mockBinaryDataManager{
+		downloadFn: func(ctx context.Context, id string) (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(enc.Bytes())), nil
+		},
+		getInfoFn: encryptedInfo(t, key, content),
+	}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	got, err := svc.ReadBinaryData(context.Background(), "id1", 1024)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	// Содержимое больше лимита не читается
+	_, err = svc.ReadBinaryData(context.Background(), "id1", 4)
+	assert.ErrorIs(t, err, model.ErrPreviewTooLarge)
+
+	// Несовпадение с контрольной суммой
+	mockMgr.getInfoFn = encryptedInfo(t, key, []byte("other"))
+	_, err = svc.ReadBinaryData(context.Background(), "id1", 1024)
+	assert.ErrorIs(t, err, model.ErrChecksumMismatch)
+
+	// Папки не просматриваются
+	mockMgr.getInfoFn = func(ctx context.Context, id string) (*model.BinaryData, error) {
+		data := &model.BinaryData{ID: id, Archive: "[]"}
+		return data, (&cryptowrap.BinaryDataCryptoWrapper{BinaryData: data}).Encrypt(key)
+	}
+	_, err = svc.ReadBinaryData(context.Background(), "id1", 1024)
+	assert.ErrorIs(t, err, model.ErrPreviewArchive)
+}
+
+// Тест чтения в память файла, хранящегося фрагментами
+func TestReadBinaryData_Chunked(t *testing.T) {
+	key := []byte("1234567890123456")
+	dir := t.TempDir()
+	mockMgr := &mockBinaryDataManager{}
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		BinaryDataManager: mockMgr,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		Logger:            zap.NewNop(),
+	}
+
+	content := writeRandomFile(t, filepath.Join(dir, "src.bin"), 4<<20, 5)
+	require.NoError(t, svc.UploadBinaryData(context.Background(), &model.BinaryData{}, filepath.Join(dir, "src.bin"), nil))
+	require.Greater(t, len(mockMgr.manifest), 1)
+
+	got, err := svc.ReadBinaryData(context.Background(), "chunked", 8<<20)
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	// Превышение лимита обнаруживается без скачивания всех фрагментов
+	mockMgr.chunkDownloads = 0
+	_, err = svc.ReadBinaryData(context.Background(), "chunked", 1<<10)
+	assert.ErrorIs(t, err, model.ErrPreviewTooLarge)
+	assert.Equal(t, 1, mockMgr.chunkDownloads)
+}
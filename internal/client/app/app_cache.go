@@ -0,0 +1,417 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errNotSynced возвращается для записей, созданных без связи с сервером
+// и ещё не отправленных на него (с временным идентификатором).
+var errNotSynced = errors.New("item is not synchronized with server yet")
+
+// connError — ошибка установки соединения с сервером.
+// Сообщение совпадает с исходной ошибкой; тип нужен лишь для того,
+// чтобы отличать недоступность сервера от ошибок самих операций.
+type connError struct{ err error }
+
+func (e *connError) Error() string { return e.err.Error() }
+func (e *connError) Unwrap() error { return e.err }
+
+// replayHandler — операции над записями одного типа, используемые при
+// воспроизведении очереди офлайн-изменений. Записи передаются в открытом виде.
+type replayHandler struct {
+	create func(ctx context.Context, item model.Change) (model.Change, error)
+	update func(ctx context.Context, item model.Change) (model.Change, error)
+	get    func(ctx context.Context, id string) (model.Change, error)
+	delete func(ctx context.Context, id string) error
+}
+
+// isOffline сообщает, что операция не выполнена из-за недоступности сервера
+// и может быть обслужена локальным кэшем. Без кэша всегда возвращает false.
+func (s *AppServices) isOffline(err error) bool {
+	if err == nil || s.Cache == nil {
+		return false
+	}
+	var ce *connError
+	return errors.As(err, &ce) || errors.Is(err, errNotSynced) || status.Code(err) == codes.Unavailable
+}
+
+// ReplayPending отправляет на сервер изменения, сделанные без связи.
+//
+// Обновления отправляются с версией, которую видел пользователь; если запись
+// на сервере за это время изменилась или была удалена, изменение не
+// применяется, а сохраняется как конфликт (см. Conflicts). Удаление также
+// не выполняется, если версия записи на сервере отличается от известной.
+// Изменения, отклонённые сервером по другим причинам, остаются в очереди.
+//
+// ctx — контекст запроса.
+//
+// Возвращает количество обработанных изменений или ошибку, если связь с
+// сервером снова пропала.
+func (s *AppServices) ReplayPending(ctx context.Context) (int, error) {
+	if s.Cache == nil {
+		return 0, nil
+	}
+	ops, err := s.Cache.Pending()
+	if err != nil {
+		return 0, err
+	}
+
+	done := 0
+	for _, op := range ops {
+		err := s.replayOp(ctx, op)
+		if s.isOffline(err) {
+			return done, err
+		}
+		if err != nil {
+			s.Logger.Warn("Failed to replay offline change",
+				zap.String("type", string(op.Item.Type)),
+				zap.String("id", op.Item.ItemID),
+				zap.String("op", string(op.Kind)),
+				zap.Error(err),
+			)
+			continue
+		}
+		done++
+	}
+
+	if done > 0 {
+		s.Logger.Info("Offline changes replayed", zap.Int("count", done))
+	}
+	return done, nil
+}
+
+// Conflicts возвращает конфликты версий, обнаруженные при воспроизведении
+// офлайн-изменений и ещё не разрешённые.
+func (s *AppServices) Conflicts() ([]cache.Conflict, error) {
+	if s.Cache == nil {
+		return nil, nil
+	}
+	return s.Cache.Conflicts()
+}
+
+// ResolveConflict разрешает конфликт версий, записывая выбранный
+// пользователем результат обычным путём создания, обновления или удаления.
+//
+// result — итоговая запись: локальная версия, серверная версия или их
+// объединение (надгробие — запись должна быть удалена). Обновление
+// отправляется с текущей версией записи на сервере. Если запись на сервере
+// удалена, а пользователь сохраняет свою версию, она создаётся заново.
+//
+// Если за время разрешения запись на сервере снова изменилась, конфликт
+// обновляется её новым состоянием и возвращается ошибка ErrVersionConflict.
+//
+// ctx — контекст запроса.
+// conflict — разрешаемый конфликт (из Conflicts).
+//
+// Возвращает ошибку при сбое записи или обновления кэша.
+func (s *AppServices) ResolveConflict(ctx context.Context, conflict cache.Conflict, result model.Change) error {
+	if s.Cache == nil {
+		return errors.New("local cache is disabled")
+	}
+	h, ok := s.replayHandler(conflict.Type)
+	if !ok {
+		return fmt.Errorf("conflicts of type %q are not supported", conflict.Type)
+	}
+
+	err := s.writeResolved(ctx, conflict, result)
+	if status.Code(err) == codes.Aborted {
+		op := cache.PendingOp{Kind: cache.OpUpdate, Item: conflict.Local}
+		if rerr := s.recordConflict(ctx, h, op); rerr != nil {
+			return rerr
+		}
+		return model.ErrVersionConflict
+	}
+	if err != nil {
+		return err
+	}
+	return s.Cache.ResolveConflict(conflict.Type, conflict.ItemID)
+}
+
+// writeResolved записывает результат разрешения конфликта.
+func (s *AppServices) writeResolved(ctx context.Context, conflict cache.Conflict, result model.Change) error {
+	switch {
+	case result.Deleted && conflict.Server.Deleted:
+		return nil // запись уже удалена на сервере
+	case result.Deleted:
+		return s.deleteItem(ctx, conflict.Type, conflict.ItemID)
+	case conflict.Server.Deleted:
+		return s.createItem(ctx, result)
+	}
+	return s.updateItem(ctx, result, cache.ItemVersion(conflict.Server))
+}
+
+// createItem создаёт запись заново (с новым идентификатором).
+func (s *AppServices) createItem(ctx context.Context, item model.Change) error {
+	switch {
+	case item.Credential != nil:
+		c := *item.Credential
+		c.ID, c.Version = "", 0
+		return s.CreateCredential(ctx, &c)
+	case item.BankCard != nil:
+		c := *item.BankCard
+		c.ID, c.Version = "", 0
+		return s.CreateBankCard(ctx, &c)
+	case item.TextData != nil:
+		d := *item.TextData
+		d.ID, d.Version = "", 0
+		return s.CreateTextData(ctx, &d)
+	}
+	return fmt.Errorf("unsupported item type %q", item.Type)
+}
+
+// updateItem обновляет запись, указывая версию, на основе которой сделано изменение.
+func (s *AppServices) updateItem(ctx context.Context, item model.Change, version int64) error {
+	switch {
+	case item.Credential != nil:
+		c := *item.Credential
+		c.Version = version
+		return s.UpdateCredential(ctx, &c)
+	case item.BankCard != nil:
+		c := *item.BankCard
+		c.Version = version
+		return s.UpdateBankCard(ctx, &c)
+	case item.TextData != nil:
+		d := *item.TextData
+		d.Version = version
+		return s.UpdateTextData(ctx, &d)
+	}
+	return fmt.Errorf("unsupported item type %q", item.Type)
+}
+
+// deleteItem удаляет запись заданного типа.
+func (s *AppServices) deleteItem(ctx context.Context, t model.ItemType, id string) error {
+	switch t {
+	case model.ItemTypeCredential:
+		return s.DeleteCredential(ctx, id)
+	case model.ItemTypeBankCard:
+		return s.DeleteBankCard(ctx, id)
+	case model.ItemTypeTextData:
+		return s.DeleteTextData(ctx, id)
+	}
+	return fmt.Errorf("unsupported item type %q", t)
+}
+
+// replayIfNeeded воспроизводит очередь офлайн-изменений после восстановления
+// связи. Вызывается при каждом успешном подключении; повторный вход во время
+// воспроизведения (операции очереди сами подключаются к серверу) пропускается.
+func (s *AppServices) replayIfNeeded(ctx context.Context) {
+	if s.Cache == nil || !s.replaying.CompareAndSwap(false, true) {
+		return
+	}
+	defer s.replaying.Store(false)
+
+	has, err := s.Cache.HasPending()
+	if err != nil || !has {
+		return
+	}
+	if _, err := s.ReplayPending(ctx); err != nil {
+		s.Logger.Warn("Offline changes replay interrupted", zap.Error(err))
+	}
+}
+
+// replayOp отправляет на сервер одно изменение из очереди.
+func (s *AppServices) replayOp(ctx context.Context, op cache.PendingOp) error {
+	h, ok := s.replayHandler(op.Item.Type)
+	if !ok {
+		return fmt.Errorf("offline changes of type %q are not supported", op.Item.Type)
+	}
+
+	switch op.Kind {
+	case cache.OpCreate:
+		result, err := h.create(ctx, op.Item)
+		if err != nil {
+			return err
+		}
+		return s.Cache.Complete(op, result)
+
+	case cache.OpUpdate:
+		result, err := h.update(ctx, op.Item)
+		if code := status.Code(err); code == codes.Aborted || code == codes.NotFound {
+			return s.recordConflict(ctx, h, op)
+		}
+		if err != nil {
+			return err
+		}
+		return s.Cache.Complete(op, result)
+
+	case cache.OpDelete:
+		server, err := h.get(ctx, op.Item.ItemID)
+		if status.Code(err) == codes.NotFound {
+			return s.Cache.Complete(op, op.Item) // уже удалена на сервере
+		}
+		if err != nil {
+			return err
+		}
+		if op.BaseVersion != 0 && cache.ItemVersion(server) != op.BaseVersion {
+			s.logConflict(op)
+			return s.Cache.AddConflict(op, server)
+		}
+		if err := h.delete(ctx, op.Item.ItemID); err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		return s.Cache.Complete(op, op.Item)
+	}
+	return fmt.Errorf("unknown offline operation %q", op.Kind)
+}
+
+// recordConflict сохраняет конфликт изменения с текущим состоянием записи на сервере.
+func (s *AppServices) recordConflict(ctx context.Context, h replayHandler, op cache.PendingOp) error {
+	server, err := h.get(ctx, op.Item.ItemID)
+	if status.Code(err) == codes.NotFound {
+		server = model.Change{Type: op.Item.Type, ItemID: op.Item.ItemID, Deleted: true, ChangedAt: time.Now()}
+	} else if err != nil {
+		return err
+	}
+	s.logConflict(op)
+	return s.Cache.AddConflict(op, server)
+}
+
+func (s *AppServices) logConflict(op cache.PendingOp) {
+	s.Logger.Warn("Version conflict on offline change",
+		zap.String("type", string(op.Item.Type)),
+		zap.String("id", op.Item.ItemID),
+		zap.String("op", string(op.Kind)),
+		zap.Int64("baseVersion", op.BaseVersion),
+	)
+}
+
+// replayHandler возвращает операции для типа записи.
+// Бинарные данные без связи не изменяются, поэтому для них обработчика нет.
+func (s *AppServices) replayHandler(t model.ItemType) (replayHandler, bool) {
+	switch t {
+	case model.ItemTypeCredential:
+		return s.credentialReplay(), true
+	case model.ItemTypeBankCard:
+		return s.bankCardReplay(), true
+	case model.ItemTypeTextData:
+		return s.textDataReplay(), true
+	}
+	return replayHandler{}, false
+}
+
+// queueOffline сохраняет изменение, сделанное без связи, в очереди кэша.
+func (s *AppServices) queueOffline(kind cache.OpKind, item model.Change, cause error) error {
+	if err := s.Cache.Enqueue(kind, item); err != nil {
+		return fmt.Errorf("failed to queue offline change: %w", err)
+	}
+	s.Logger.Info("Server unavailable, change queued",
+		zap.String("type", string(item.Type)),
+		zap.String("id", item.ItemID),
+		zap.String("op", string(kind)),
+		zap.NamedError("cause", cause),
+	)
+	return nil
+}
+
+// cacheApply сохраняет в кэше записи, полученные от сервера.
+// Ошибки кэша не прерывают операцию и только логируются.
+func (s *AppServices) cacheApply(items ...model.Change) {
+	if s.Cache == nil {
+		return
+	}
+	if err := s.Cache.Apply(items...); err != nil {
+		s.Logger.Warn("Failed to update local cache", zap.Error(err))
+	}
+}
+
+// cacheRemove удаляет запись из кэша после её удаления на сервере.
+func (s *AppServices) cacheRemove(t model.ItemType, id string) {
+	if s.Cache == nil {
+		return
+	}
+	if err := s.Cache.Remove(t, id); err != nil {
+		s.Logger.Warn("Failed to update local cache", zap.Error(err))
+	}
+}
+
+// cachedItem возвращает запись из кэша или cause, если её там нет.
+func (s *AppServices) cachedItem(t model.ItemType, id string, cause error) (model.Change, error) {
+	item, ok, err := s.Cache.Get(t, id)
+	if err != nil {
+		return model.Change{}, err
+	}
+	if !ok {
+		return model.Change{}, cause
+	}
+	return item, nil
+}
+
+// cachedList возвращает записи типа t из кэша, удовлетворяющие фильтру,
+// отсортированные так же, как их отсортировал бы сервер.
+// Пагинация не применяется: кэш возвращает все записи одной страницей.
+func (s *AppServices) cachedList(t model.ItemType, filter model.ListFilter, page model.PageRequest) ([]model.Change, error) {
+	all, err := s.Cache.List(t)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]model.Change, 0, len(all))
+	fields := make(map[string]itemFields, len(all))
+	for _, item := range all {
+		f, ok := fieldsOf(item)
+		if !ok || !filter.Match(f.folder, f.tags) {
+			continue
+		}
+		fields[item.ItemID] = f
+		items = append(items, item)
+	}
+
+	page = page.Normalize()
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := fields[items[i].ItemID], fields[items[j].ItemID]
+		var cmp int
+		switch page.SortBy {
+		case model.SortByTitle:
+			cmp = strings.Compare(a.title, b.title)
+		case model.SortByUpdated:
+			cmp = a.updated.Compare(b.updated)
+		default:
+			cmp = a.created.Compare(b.created)
+		}
+		if cmp == 0 {
+			cmp = strings.Compare(items[i].ItemID, items[j].ItemID)
+		}
+		if page.Desc {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+	return items, nil
+}
+
+// itemFields — поля записи, по которым фильтруются и сортируются списки.
+type itemFields struct {
+	title, folder    string
+	tags             model.Tags
+	created, updated time.Time
+}
+
+// fieldsOf извлекает поля списка из записи кэша.
+func fieldsOf(item model.Change) (itemFields, bool) {
+	switch {
+	case item.Credential != nil:
+		c := item.Credential
+		return itemFields{c.Title, c.Folder, c.Tags, c.CreatedAt, c.UpdatedAt}, true
+	case item.BankCard != nil:
+		c := item.BankCard
+		return itemFields{c.Title, c.Folder, c.Tags, c.CreatedAt, c.UpdatedAt}, true
+	case item.TextData != nil:
+		d := item.TextData
+		return itemFields{d.Title, d.Folder, d.Tags, d.CreatedAt, d.UpdatedAt}, true
+	case item.BinaryData != nil:
+		d := item.BinaryData
+		return itemFields{d.Title, d.Folder, d.Tags, d.CreatedAt, d.UpdatedAt}, true
+	}
+	return itemFields{}, false
+}
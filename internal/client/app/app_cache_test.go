@@ -0,0 +1,346 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/app"
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var cacheTestKey = []byte("12345678901234567890123456789012")
+
+// fakeCredentialServer — мок CredentialManagerIface, имитирующий сервер:
+// назначает идентификаторы и версии, проверяет версию при обновлении.
+type fakeCredentialServer struct {
+	items   map[string]model.Credential // в зашифрованном виде
+	nextID  int
+	created int
+	updated int
+	deleted []string
+	err     error // ошибка всех вызовов (например, Unavailable)
+}
+
+func newFakeCredentialServer() *fakeCredentialServer {
+	return &fakeCredentialServer{items: make(map[string]model.Credential)}
+}
+
+func (f *fakeCredentialServer) SetClient(proto.CredentialServiceClient) {}
+
+func (f *fakeCredentialServer) CreateCredential(_ context.Context, cred *model.Credential) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.nextID++
+	f.created++
+	cred.ID = "srv-" + string(rune('0'+f.nextID))
+	cred.Version = 1
+	f.items[cred.ID] = *cred
+	return nil
+}
+
+func (f *fakeCredentialServer) GetCredentialByID(_ context.Context, id string) (*model.Credential, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	cred, ok := f.items[id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "credential not found")
+	}
+	return &cred, nil
+}
+
+func (f *fakeCredentialServer) GetCredentials(context.Context, model.ListFilter, model.PageRequest) ([]model.Credential, string, error) {
+	if f.err != nil {
+		return nil, "", f.err
+	}
+	list := make([]model.Credential, 0, len(f.items))
+	for _, c := range f.items {
+		list = append(list, c)
+	}
+	return list, "", nil
+}
+
+func (f *fakeCredentialServer) UpdateCredential(_ context.Context, cred *model.Credential) error {
+	if f.err != nil {
+		return f.err
+	}
+	stored, ok := f.items[cred.ID]
+	if !ok {
+		return status.Error(codes.NotFound, "credential not found")
+	}
+	if cred.Version != 0 && cred.Version != stored.Version {
+		return status.Error(codes.Aborted, model.ErrVersionConflict.Error())
+	}
+	f.updated++
+	cred.Version = stored.Version + 1
+	f.items[cred.ID] = *cred
+	return nil
+}
+
+func (f *fakeCredentialServer) DeleteCredential(_ context.Context, id string) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.deleted = append(f.deleted, id)
+	delete(f.items, id)
+	return nil
+}
+
+// put сохраняет запись на «сервере» в зашифрованном виде.
+func (f *fakeCredentialServer) put(t *testing.T, cred model.Credential) {
+	t.Helper()
+	require.NoError(t, cryptowrap.EncryptCredential(&cred, cacheTestKey))
+	f.items[cred.ID] = cred
+}
+
+func newCachedApp(t *testing.T, conn *mockConnManager, srv *fakeCredentialServer) *app.AppServices {
+	t.Helper()
+	c := cache.New(filepath.Join(t.TempDir(), "cache.db"), func() ([]byte, error) { return cacheTestKey, nil })
+	t.Cleanup(func() { _ = c.Close() })
+	return &app.AppServices{
+		ConnManager:       conn,
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: cacheTestKey},
+		CredentialManager: srv,
+		Cache:             c,
+		Logger:            zap.NewNop(),
+	}
+}
+
+func TestOffline_ReadsFromCache(t *testing.T) {
+	ctx := context.Background()
+	conn := &mockConnManager{}
+	srv := newFakeCredentialServer()
+	srv.put(t, model.Credential{ID: "c1", Title: "Gmail", Password: "secret", Folder: "work", Version: 2})
+	srv.put(t, model.Credential{ID: "c2", Title: "Bank", Password: "pin", Version: 1})
+	svc := newCachedApp(t, conn, srv)
+
+	// Онлайн-чтение наполняет кэш
+	_, _, err := svc.GetCredentials(ctx, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+
+	// Сервер недоступен
+	conn.connectErr = errors.New("connect failed")
+
+	list, next, err := svc.GetCredentials(ctx, model.ListFilter{Folder: "work"}, model.PageRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	require.Len(t, list, 1)
+	assert.Equal(t, "secret", list[0].Password)
+
+	cred, err := svc.GetCredentialByID(ctx, "c2")
+	require.NoError(t, err)
+	assert.Equal(t, "pin", cred.Password)
+
+	// Записи нет в кэше — возвращается исходная ошибка
+	_, err = svc.GetCredentialByID(ctx, "missing")
+	assert.EqualError(t, err, "connect failed")
+}
+
+func TestOffline_UnavailableRPCUsesCache(t *testing.T) {
+	ctx := context.Background()
+	srv := newFakeCredentialServer()
+	svc := newCachedApp(t, &mockConnManager{}, srv)
+
+	srv.err = status.Error(codes.Unavailable, "server shutting down")
+	cred := &model.Credential{Title: "Offline", Password: "pw"}
+	require.NoError(t, svc.CreateCredential(ctx, cred))
+	assert.True(t, cache.IsLocalID(cred.ID))
+	assert.Equal(t, "pw", cred.Password, "queued record stays in plain text")
+
+	ops, err := svc.Cache.Pending()
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, cache.OpCreate, ops[0].Kind)
+}
+
+func TestOffline_ReplayOnReconnect(t *testing.T) {
+	ctx := context.Background()
+	conn := &mockConnManager{}
+	srv := newFakeCredentialServer()
+	srv.put(t, model.Credential{ID: "c1", Title: "Gmail", Password: "old", Version: 2})
+	srv.put(t, model.Credential{ID: "c2", Title: "Bank", Password: "pin", Version: 5})
+	svc := newCachedApp(t, conn, srv)
+
+	c1, err := svc.GetCredentialByID(ctx, "c1")
+	require.NoError(t, err)
+	c2, err := svc.GetCredentialByID(ctx, "c2")
+	require.NoError(t, err)
+
+	// Без связи: создание, правка и удаление
+	conn.connectErr = errors.New("connect failed")
+
+	created := &model.Credential{Title: "New", Password: "fresh"}
+	require.NoError(t, svc.CreateCredential(ctx, created))
+	created.Password = "fresher"
+	require.NoError(t, svc.UpdateCredential(ctx, created))
+
+	c1.Password = "mine"
+	require.NoError(t, svc.UpdateCredential(ctx, c1))
+	require.NoError(t, svc.DeleteCredential(ctx, c2.ID))
+
+	list, _, err := svc.GetCredentials(ctx, model.ListFilter{}, model.PageRequest{SortBy: model.SortByTitle})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "Gmail", list[0].Title)
+	assert.Equal(t, "New", list[1].Title)
+	assert.Equal(t, "fresher", list[1].Password)
+
+	// Тем временем c1 изменили на другом устройстве
+	srv.put(t, model.Credential{ID: "c1", Title: "Gmail", Password: "theirs", Version: 3})
+
+	// Связь восстановлена: очередь воспроизводится при первом обращении
+	conn.connectErr = nil
+	_, _, err = svc.GetCredentials(ctx, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, srv.created)
+	assert.Equal(t, 0, srv.updated, "conflicting update must not be applied")
+	assert.Equal(t, []string{"c2"}, srv.deleted)
+
+	ops, err := svc.Cache.Pending()
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	conflicts, err := svc.Conflicts()
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "c1", conflicts[0].ItemID)
+	assert.Equal(t, "mine", conflicts[0].Local.Credential.Password)
+	assert.Equal(t, "theirs", conflicts[0].Server.Credential.Password)
+	assert.Equal(t, int64(3), conflicts[0].Server.Credential.Version)
+
+	// Созданная без связи запись получила постоянный идентификатор
+	_, ok, err := svc.Cache.Get(model.ItemTypeCredential, created.ID)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	item, ok, err := svc.Cache.Get(model.ItemTypeCredential, "srv-1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "fresher", item.Credential.Password)
+	assert.Equal(t, int64(1), item.Credential.Version)
+}
+
+func TestOffline_DeleteConflict(t *testing.T) {
+	ctx := context.Background()
+	conn := &mockConnManager{}
+	srv := newFakeCredentialServer()
+	srv.put(t, model.Credential{ID: "c1", Title: "Gmail", Version: 2})
+	svc := newCachedApp(t, conn, srv)
+
+	_, err := svc.GetCredentialByID(ctx, "c1")
+	require.NoError(t, err)
+
+	conn.connectErr = errors.New("connect failed")
+	require.NoError(t, svc.DeleteCredential(ctx, "c1"))
+
+	srv.put(t, model.Credential{ID: "c1", Title: "Gmail (edited)", Version: 3})
+	conn.connectErr = nil
+
+	n, err := svc.ReplayPending(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	assert.Empty(t, srv.deleted)
+
+	conflicts, err := svc.Conflicts()
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	assert.True(t, conflicts[0].Local.Deleted)
+	assert.Equal(t, "Gmail (edited)", conflicts[0].Server.Credential.Title)
+}
+
+func TestOffline_NoCacheKeepsErrors(t *testing.T) {
+	svc := &app.AppServices{
+		ConnManager:       &mockConnManager{connectErr: errors.New("connect failed")},
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: cacheTestKey},
+		CredentialManager: newFakeCredentialServer(),
+		Logger:            zap.NewNop(),
+	}
+	err := svc.UpdateCredential(context.Background(), &model.Credential{ID: "c1"})
+	assert.EqualError(t, err, "connect failed")
+
+	n, err := svc.ReplayPending(context.Background())
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+}
+
+func TestResolveConflict_UpdatesWithServerVersion(t *testing.T) {
+	ctx := context.Background()
+	conn := &mockConnManager{}
+	srv := newFakeCredentialServer()
+	srv.put(t, model.Credential{ID: "c1", Title: "Gmail", Password: "old", Version: 2})
+	svc := newCachedApp(t, conn, srv)
+
+	c1, err := svc.GetCredentialByID(ctx, "c1")
+	require.NoError(t, err)
+	conn.connectErr = errors.New("connect failed")
+	c1.Password = "mine"
+	require.NoError(t, svc.UpdateCredential(ctx, c1))
+
+	srv.put(t, model.Credential{ID: "c1", Title: "Gmail", Password: "theirs", Version: 3})
+	conn.connectErr = nil
+	_, err = svc.ReplayPending(ctx)
+	require.NoError(t, err)
+
+	conflicts, err := svc.Conflicts()
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+
+	// Сервер изменил запись ещё раз — конфликт обновляется
+	srv.put(t, model.Credential{ID: "c1", Title: "Gmail", Password: "theirs again", Version: 4})
+	err = svc.ResolveConflict(ctx, conflicts[0], conflicts[0].Local)
+	require.ErrorIs(t, err, model.ErrVersionConflict)
+
+	conflicts, err = svc.Conflicts()
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, int64(4), conflicts[0].Server.Credential.Version)
+
+	require.NoError(t, svc.ResolveConflict(ctx, conflicts[0], conflicts[0].Local))
+	assert.Equal(t, 1, srv.updated)
+	assert.Equal(t, int64(5), srv.items["c1"].Version)
+
+	conflicts, err = svc.Conflicts()
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+
+	item, ok, err := svc.Cache.Get(model.ItemTypeCredential, "c1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "mine", item.Credential.Password)
+}
+
+func TestResolveConflict_RecreatesDeletedItem(t *testing.T) {
+	ctx := context.Background()
+	srv := newFakeCredentialServer()
+	svc := newCachedApp(t, &mockConnManager{}, srv)
+
+	local := model.Change{Type: model.ItemTypeCredential, ItemID: "c1",
+		Credential: &model.Credential{ID: "c1", Title: "Gmail", Password: "mine", Version: 2}}
+	require.NoError(t, svc.Cache.Enqueue(cache.OpUpdate, local))
+	ops, err := svc.Cache.Pending()
+	require.NoError(t, err)
+	require.NoError(t, svc.Cache.AddConflict(ops[0], model.Change{Deleted: true}))
+
+	conflicts, err := svc.Conflicts()
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+
+	require.NoError(t, svc.ResolveConflict(ctx, conflicts[0], conflicts[0].Local))
+	assert.Equal(t, 1, srv.created)
+	assert.Equal(t, "Gmail", srv.items["srv-1"].Title)
+
+	conflicts, err = svc.Conflicts()
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+}
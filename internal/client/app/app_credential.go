@@ -2,7 +2,9 @@ package app
 
 import (
 	"context"
+	"time"
 
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
 	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
@@ -28,11 +30,160 @@ func (s *AppServices) ensureCredentialClient(ctx context.Context) error {
 // Перед отправкой данные логина, пароля и метаданных шифруются с помощью
 // симметричного ключа, загружаемого из CryptoKeyManager.
 //
+// Если сервер недоступен и включён локальный кэш, запись сохраняется в кэше
+// с временным идентификатором и будет отправлена при восстановлении связи.
+//
 // ctx — контекст запроса.
 // cred — данные учётных данных для создания.
 //
 // Возвращает ошибку при сбое RPC вызова или шифрования.
 func (s *AppServices) CreateCredential(ctx context.Context, cred *model.Credential) error {
+	plain := *cred
+	now := time.Now()
+	plain.CreatedAt, plain.UpdatedAt = now, now
+
+	err := s.createCredential(ctx, cred)
+	if s.isOffline(err) {
+		plain.ID = cache.NewLocalID()
+		*cred = plain
+		return s.queueOffline(cache.OpCreate, credentialChange(cred), err)
+	}
+	if err != nil {
+		return err
+	}
+
+	plain.ID, plain.Version = cred.ID, cred.Version
+	s.cacheApply(credentialChange(&plain))
+	return nil
+}
+
+// GetCredentialByID получает учётные данные по их уникальному идентификатору.
+// После получения с сервера происходит расшифровка полей логина, пароля и метаданных
+// с помощью симметричного ключа из CryptoKeyManager.
+//
+// Если сервер недоступен, запись возвращается из локального кэша.
+//
+// ctx — контекст запроса.
+// id — идентификатор учётных данных.
+//
+// Возвращает найденные учётные данные или ошибку при RPC вызове или дешифровании.
+func (s *AppServices) GetCredentialByID(ctx context.Context, id string) (*model.Credential, error) {
+	if s.Cache != nil && cache.IsLocalID(id) {
+		return s.cachedCredential(id, errNotSynced)
+	}
+
+	cred, err := s.getCredentialByID(ctx, id)
+	if s.isOffline(err) {
+		return s.cachedCredential(id, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.cacheApply(credentialChange(cred))
+	return cred, nil
+}
+
+// GetCredentials возвращает страницу учётных данных для заданного пользователя (из контекста).
+// Все записи, полученные с сервера, расшифровываются по отдельности.
+//
+// Если сервер недоступен, возвращаются все подходящие записи из локального
+// кэша одной страницей.
+//
+// ctx — контекст запроса.
+// filter — фильтр по папке и тегам (теги шифруются перед отправкой).
+// page — размер страницы, токен и поле сортировки.
+//
+// Возвращает срез учётных данных и токен следующей страницы (пустой, если
+// страница последняя) или ошибку при RPC вызове или дешифровании.
+func (s *AppServices) GetCredentials(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
+	creds, next, err := s.getCredentials(ctx, filter, page)
+	if s.isOffline(err) {
+		items, cerr := s.cachedList(model.ItemTypeCredential, filter, page)
+		if cerr != nil {
+			return nil, "", err
+		}
+		creds = make([]model.Credential, 0, len(items))
+		for _, item := range items {
+			creds = append(creds, *item.Credential)
+		}
+		return creds, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	changes := make([]model.Change, 0, len(creds))
+	for i := range creds {
+		changes = append(changes, credentialChange(&creds[i]))
+	}
+	s.cacheApply(changes...)
+	return creds, next, nil
+}
+
+// UpdateCredential обновляет существующую учётную запись.
+// Перед отправкой обновлённые данные логина, пароля и метаданных шифруются
+// с помощью ключа из CryptoKeyManager.
+//
+// Если сервер недоступен, изменение сохраняется в локальном кэше и будет
+// отправлено при восстановлении связи с проверкой версии записи.
+//
+// ctx — контекст запроса.
+// cred — обновлённые данные учётных данных.
+//
+// Возвращает ошибку при сбое RPC вызова или шифрования.
+func (s *AppServices) UpdateCredential(ctx context.Context, cred *model.Credential) error {
+	plain := *cred
+	plain.UpdatedAt = time.Now()
+
+	var err error
+	if s.Cache != nil && cache.IsLocalID(cred.ID) {
+		err = errNotSynced
+	} else {
+		err = s.updateCredential(ctx, cred)
+	}
+	if s.isOffline(err) {
+		*cred = plain
+		return s.queueOffline(cache.OpUpdate, credentialChange(cred), err)
+	}
+	if err != nil {
+		return err
+	}
+
+	plain.Version = cred.Version
+	s.cacheApply(credentialChange(&plain))
+	return nil
+}
+
+// DeleteCredential удаляет учётные данные по идентификатору.
+//
+// Если сервер недоступен, удаление сохраняется в локальном кэше и будет
+// выполнено при восстановлении связи.
+//
+// ctx — контекст запроса.
+// id — идентификатор учётных данных для удаления.
+//
+// Возвращает ошибку при сбое RPC вызова.
+func (s *AppServices) DeleteCredential(ctx context.Context, id string) error {
+	var err error
+	if s.Cache != nil && cache.IsLocalID(id) {
+		err = errNotSynced
+	} else {
+		err = s.deleteCredential(ctx, id)
+	}
+	if s.isOffline(err) {
+		return s.queueOffline(cache.OpDelete, model.Change{Type: model.ItemTypeCredential, ItemID: id}, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.cacheRemove(model.ItemTypeCredential, id)
+	return nil
+}
+
+// createCredential шифрует учётные данные на месте и создаёт запись на сервере.
+func (s *AppServices) createCredential(ctx context.Context, cred *model.Credential) error {
 	err := s.ensureCredentialClient(ctx)
 	if err != nil {
 		return err
@@ -51,15 +202,8 @@ func (s *AppServices) CreateCredential(ctx context.Context, cred *model.Credenti
 	return s.CredentialManager.CreateCredential(ctx, cred)
 }
 
-// GetCredentialByID получает учётные данные по их уникальному идентификатору.
-// После получения с сервера происходит расшифровка полей логина, пароля и метаданных
-// с помощью симметричного ключа из CryptoKeyManager.
-//
-// ctx — контекст запроса.
-// id — идентификатор учётных данных.
-//
-// Возвращает найденные учётные данные или ошибку при RPC вызове или дешифровании.
-func (s *AppServices) GetCredentialByID(ctx context.Context, id string) (*model.Credential, error) {
+// getCredentialByID получает учётные данные с сервера и расшифровывает их.
+func (s *AppServices) getCredentialByID(ctx context.Context, id string) (*model.Credential, error) {
 	err := s.ensureCredentialClient(ctx)
 	if err != nil {
 		return nil, err
@@ -82,46 +226,38 @@ func (s *AppServices) GetCredentialByID(ctx context.Context, id string) (*model.
 	return cred, nil
 }
 
-// GetCredentials возвращает список учётных данных для заданного пользователя (из контекста).
-// Все записи, полученные с сервера, расшифровываются по отдельности.
-//
-// ctx — контекст запроса.
-//
-// Возвращает срез учётных данных или ошибку при RPC вызове или дешифровании.
-func (s *AppServices) GetCredentials(ctx context.Context) ([]model.Credential, error) {
+// getCredentials получает страницу учётных данных с сервера и расшифровывает их.
+func (s *AppServices) getCredentials(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
 	err := s.ensureCredentialClient(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	creds, err := s.CredentialManager.GetCredentials(ctx)
+	filter, err = s.encryptListFilter(filter)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	creds, next, err := s.CredentialManager.GetCredentials(ctx, filter, page)
+	if err != nil {
+		return nil, "", err
 	}
 
 	key, err := s.CryptoKeyManager.LoadKey()
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	for i := range creds {
 		wrapper := &cryptowrap.CredentialCryptoWrapper{Credential: &creds[i]}
 		if err := wrapper.Decrypt(key); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
-	return creds, nil
+	return creds, next, nil
 }
 
-// UpdateCredential обновляет существующую учётную запись.
-// Перед отправкой обновлённые данные логина, пароля и метаданных шифруются
-// с помощью ключа из CryptoKeyManager.
-//
-// ctx — контекст запроса.
-// cred — обновлённые данные учётных данных.
-//
-// Возвращает ошибку при сбое RPC вызова или шифрования.
-func (s *AppServices) UpdateCredential(ctx context.Context, cred *model.Credential) error {
+// updateCredential шифрует учётные данные на месте и обновляет запись на сервере.
+func (s *AppServices) updateCredential(ctx context.Context, cred *model.Credential) error {
 	err := s.ensureCredentialClient(ctx)
 	if err != nil {
 		return err
@@ -139,16 +275,59 @@ func (s *AppServices) UpdateCredential(ctx context.Context, cred *model.Credenti
 	return s.CredentialManager.UpdateCredential(ctx, cred)
 }
 
-// DeleteCredential удаляет учётные данные по идентификатору.
-//
-// ctx — контекст запроса.
-// id — идентификатор учётных данных для удаления.
-//
-// Возвращает ошибку при сбое RPC вызова.
-func (s *AppServices) DeleteCredential(ctx context.Context, id string) error {
+// deleteCredential удаляет учётные данные на сервере.
+func (s *AppServices) deleteCredential(ctx context.Context, id string) error {
 	err := s.ensureCredentialClient(ctx)
 	if err != nil {
 		return err
 	}
 	return s.CredentialManager.DeleteCredential(ctx, id)
 }
+
+// cachedCredential возвращает учётные данные из локального кэша или cause, если их там нет.
+func (s *AppServices) cachedCredential(id string, cause error) (*model.Credential, error) {
+	item, err := s.cachedItem(model.ItemTypeCredential, id, cause)
+	if err != nil {
+		return nil, err
+	}
+	return item.Credential, nil
+}
+
+// credentialChange представляет учётные данные как запись кэша.
+func credentialChange(cred *model.Credential) model.Change {
+	c := *cred
+	return model.Change{Type: model.ItemTypeCredential, ItemID: c.ID, Credential: &c, ChangedAt: c.UpdatedAt}
+}
+
+// credentialReplay возвращает операции воспроизведения офлайн-изменений учётных данных.
+func (s *AppServices) credentialReplay() replayHandler {
+	return replayHandler{
+		create: func(ctx context.Context, item model.Change) (model.Change, error) {
+			plain := *item.Credential
+			cred := plain
+			cred.ID, cred.Version = "", 0 // постоянный идентификатор назначит сервер
+			if err := s.createCredential(ctx, &cred); err != nil {
+				return model.Change{}, err
+			}
+			plain.ID, plain.Version = cred.ID, cred.Version
+			return credentialChange(&plain), nil
+		},
+		update: func(ctx context.Context, item model.Change) (model.Change, error) {
+			plain := *item.Credential
+			cred := plain
+			if err := s.updateCredential(ctx, &cred); err != nil {
+				return model.Change{}, err
+			}
+			plain.Version = cred.Version
+			return credentialChange(&plain), nil
+		},
+		get: func(ctx context.Context, id string) (model.Change, error) {
+			cred, err := s.getCredentialByID(ctx, id)
+			if err != nil {
+				return model.Change{}, err
+			}
+			return credentialChange(cred), nil
+		},
+		delete: s.deleteCredential,
+	}
+}
@@ -127,7 +127,7 @@ func TestGetCredentials(t *testing.T) {
 		Logger:            zap.NewNop(),
 	}
 
-	creds, err := appSvc.GetCredentials(ctx)
+	creds, _, err := appSvc.GetCredentials(ctx, model.ListFilter{}, model.PageRequest{})
 	require.NoError(t, err)
 	require.Len(t, creds, 2)
 
@@ -141,12 +141,56 @@ func TestGetCredentials(t *testing.T) {
 
 	// Ошибка подключения
 	appSvc.ConnManager = &mockConnManager{connectErr: errors.New("connect error")}
-	creds, err = appSvc.GetCredentials(ctx)
+	creds, _, err = appSvc.GetCredentials(ctx, model.ListFilter{}, model.PageRequest{})
 	require.Error(t, err)
 	require.Nil(t, creds)
 	require.EqualError(t, err, "connect error")
 }
 
+func TestGetCredentials_EncryptsFilterTags(t *testing.T) {
+	ctx := context.Background()
+	key := []byte("12345678901234567890123456789012")
+
+	cred := model.Credential{ID: "1", Login: "l", Password: "p", Folder: "Work", Tags: model.Tags{"mail"}}
+	require.NoError(t, cryptowrap.EncryptCredential(&cred, key))
+
+	mockCredMgr := &mockCredentialManager{getByUserIDResult: []model.Credential{cred}}
+	appSvc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		CredentialManager: mockCredMgr,
+		Logger:            zap.NewNop(),
+	}
+
+	creds, _, err := appSvc.GetCredentials(ctx, model.ListFilter{Folder: "Work", Tags: []string{"mail"}}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	require.Equal(t, model.Tags{"mail"}, creds[0].Tags)
+
+	// На сервер уходит папка в открытом виде и зашифрованный тег
+	require.Equal(t, "Work", mockCredMgr.lastFilter.Folder)
+	require.Equal(t, []string(cred.Tags), mockCredMgr.lastFilter.Tags)
+}
+
+func TestGetCredentials_Page(t *testing.T) {
+	ctx := context.Background()
+	key := []byte("12345678901234567890123456789012")
+
+	mockCredMgr := &mockCredentialManager{nextToken: "next"}
+	appSvc := &app.AppServices{
+		ConnManager:       &mockConnManager{},
+		CryptoKeyManager:  &mockCryptoKeyManager{loadKeyData: key},
+		CredentialManager: mockCredMgr,
+		Logger:            zap.NewNop(),
+	}
+
+	page := model.PageRequest{Size: 10, Token: "tok", SortBy: model.SortByTitle}
+	_, next, err := appSvc.GetCredentials(ctx, model.ListFilter{}, page)
+	require.NoError(t, err)
+	require.Equal(t, "next", next)
+	require.Equal(t, page, mockCredMgr.lastPage)
+}
+
 func TestUpdateCredential(t *testing.T) {
 	ctx := context.Background()
 	mockKeyMgr := &mockCryptoKeyManager{
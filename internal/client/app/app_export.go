@@ -0,0 +1,336 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/client/vaultfile"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"go.uber.org/zap"
+)
+
+// ExportVault выгружает все записи пользователя в файл экспорта (см. пакет
+// vaultfile), зашифрованный ключом из парольной фразы passphrase.
+//
+// Записи читаются с сервера через журнал изменений и расшифровываются
+// ключом пользователя. Содержимое файлов скачивается во временную
+// директорию, копируется в экспорт и сразу удаляется; папки попадают
+// в экспорт архивом. Прежние версии файлов не выгружаются.
+//
+// Возвращает число выгруженных записей.
+func (s *AppServices) ExportVault(ctx context.Context, w io.Writer, passphrase string) (int, error) {
+	changes, err := s.allItems(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	manifest := &vaultfile.Manifest{CreatedAt: time.Now().UTC()}
+	var files []*model.BinaryData
+	for _, ch := range changes {
+		switch {
+		case ch.Credential != nil:
+			c := ch.Credential
+			manifest.Credentials = append(manifest.Credentials, vaultfile.Credential{
+				Title: c.Title, Login: c.Login, Password: c.Password, Metadata: c.Metadata,
+				Folder: c.Folder, Tags: c.Tags, Favorite: c.Favorite,
+			})
+		case ch.BankCard != nil:
+			c := ch.BankCard
+			manifest.BankCards = append(manifest.BankCards, vaultfile.BankCard{
+				Title: c.Title, CardholderName: c.CardholderName, CardNumber: c.CardNumber,
+				ExpiryDate: c.ExpiryDate, CVV: c.CVV, Metadata: c.Metadata,
+				Folder: c.Folder, Tags: c.Tags, Favorite: c.Favorite,
+			})
+		case ch.TextData != nil:
+			d := ch.TextData
+			manifest.TextData = append(manifest.TextData, vaultfile.TextData{
+				Title: d.Title, Content: d.Content, Metadata: d.Metadata,
+				Folder: d.Folder, Tags: d.Tags, Favorite: d.Favorite,
+			})
+		case ch.BinaryData != nil:
+			d := ch.BinaryData
+			files = append(files, d)
+			manifest.Files = append(manifest.Files, vaultfile.File{
+				ID: d.ID, Title: d.Title, ClientPath: d.ClientPath, Directory: d.Archive != "",
+				Metadata: d.Metadata, Folder: d.Folder, Tags: d.Tags, Favorite: d.Favorite,
+			})
+		}
+	}
+
+	vw, err := vaultfile.NewWriter(w, passphrase)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.writeExport(ctx, vw, manifest, files); err != nil {
+		_ = vw.Close()
+		return 0, err
+	}
+	if err := vw.Close(); err != nil {
+		return 0, err
+	}
+
+	count := len(manifest.Credentials) + len(manifest.BankCards) + len(manifest.TextData) + len(manifest.Files)
+	s.Logger.Info("Vault exported", zap.Int("items", count))
+	return count, nil
+}
+
+// writeExport записывает манифест и содержимое файлов в экспорт.
+func (s *AppServices) writeExport(ctx context.Context, vw *vaultfile.Writer, manifest *vaultfile.Manifest, files []*model.BinaryData) error {
+	if err := vw.WriteManifest(manifest); err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return nil
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gophkeeper-export-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	for _, d := range files {
+		if err := s.exportFile(ctx, vw, d, filepath.Join(tmpDir, d.ID)); err != nil {
+			return fmt.Errorf("file %q: %w", d.Title, err)
+		}
+	}
+	return nil
+}
+
+// exportFile скачивает содержимое файла d во временный файл tmpPath
+// (папку — архивом, без распаковки) и копирует его в экспорт.
+func (s *AppServices) exportFile(ctx context.Context, vw *vaultfile.Writer, d *model.BinaryData, tmpPath string) error {
+	if err := s.downloadContent(ctx, d.ID, "", d.PlainChecksum, "", tmpPath, nil); err != nil {
+		return err
+	}
+	defer os.Remove(tmpPath)
+
+	f, err := os.Open(tmpPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	return vw.WriteFile(d.ID, fi.Size(), f)
+}
+
+// allItems возвращает текущие записи пользователя всех типов с расшифрованным
+// содержимым, читая журнал изменений с начала. Сохранённый курсор
+// синхронизации не используется и не изменяется.
+func (s *AppServices) allItems(ctx context.Context) ([]model.Change, error) {
+	if err := s.ensureSyncClient(ctx); err != nil {
+		return nil, err
+	}
+	key, err := s.CryptoKeyManager.LoadKey()
+	if err != nil {
+		return nil, err
+	}
+
+	var items []model.Change
+	var cursor int64
+	for {
+		batch, err := s.ChangeManager.ListChanges(ctx, cursor, 0)
+		if err != nil {
+			return nil, err
+		}
+		for i := range batch.Changes {
+			ch := batch.Changes[i]
+			if ch.Deleted {
+				continue
+			}
+			if err := cryptowrap.DecryptChange(&ch, key); err != nil {
+				return nil, err
+			}
+			items = append(items, ch)
+		}
+		cursor = batch.Cursor
+		if !batch.HasMore {
+			return items, nil
+		}
+	}
+}
+
+// ImportVault загружает записи из файла экспорта, зашифрованного ключом из
+// парольной фразы passphrase, в хранилище текущего пользователя. Записи
+// создаются заново и шифруются ключом пользователя, как созданные вручную;
+// отметки избранного переносятся.
+//
+// Импорт не проверяет, есть ли такие записи у пользователя: повторный
+// импорт того же файла создаст копии. При ошибке уже созданные записи
+// остаются.
+//
+// Возвращает число созданных записей.
+func (s *AppServices) ImportVault(ctx context.Context, r io.Reader, passphrase string) (int, error) {
+	vr, err := vaultfile.NewReader(r, passphrase)
+	if err != nil {
+		return 0, err
+	}
+	defer vr.Close()
+	manifest := vr.Manifest()
+
+	count := 0
+	favorite := func(itemType model.ItemType, id string, fav bool) error {
+		if !fav {
+			return nil
+		}
+		return s.SetFavorite(ctx, itemType, id, true)
+	}
+
+	for _, c := range manifest.Credentials {
+		cred := &model.Credential{
+			Title: c.Title, Login: c.Login, Password: c.Password, Metadata: c.Metadata,
+			Folder: c.Folder, Tags: c.Tags,
+		}
+		if err := s.createCredential(ctx, cred); err != nil {
+			return count, fmt.Errorf("credential %q: %w", c.Title, err)
+		}
+		count++
+		if err := favorite(model.ItemTypeCredential, cred.ID, c.Favorite); err != nil {
+			return count, err
+		}
+	}
+
+	for _, c := range manifest.BankCards {
+		card := &model.BankCard{
+			Title: c.Title, CardholderName: c.CardholderName, CardNumber: c.CardNumber,
+			ExpiryDate: c.ExpiryDate, CVV: c.CVV, Metadata: c.Metadata,
+			Folder: c.Folder, Tags: c.Tags,
+		}
+		if err := s.createBankCard(ctx, card); err != nil {
+			return count, fmt.Errorf("bank card %q: %w", c.Title, err)
+		}
+		count++
+		if err := favorite(model.ItemTypeBankCard, card.ID, c.Favorite); err != nil {
+			return count, err
+		}
+	}
+
+	for _, t := range manifest.TextData {
+		text := &model.TextData{
+			Title: t.Title, Content: t.Content, Metadata: t.Metadata,
+			Folder: t.Folder, Tags: t.Tags,
+		}
+		if err := s.createTextData(ctx, text); err != nil {
+			return count, fmt.Errorf("text data %q: %w", t.Title, err)
+		}
+		count++
+		if err := favorite(model.ItemTypeTextData, text.ID, t.Favorite); err != nil {
+			return count, err
+		}
+	}
+
+	n, err := s.importFiles(ctx, vr, manifest.Files, favorite)
+	count += n
+	if err != nil {
+		return count, err
+	}
+
+	s.Logger.Info("Vault imported", zap.Int("items", count))
+	return count, nil
+}
+
+// importFiles загружает на сервер содержимое файлов из экспорта. Каждый файл
+// записывается во временную директорию (папка — распаковывается) и
+// загружается оттуда; в записи сохраняется исходный ClientPath.
+func (s *AppServices) importFiles(
+	ctx context.Context,
+	vr *vaultfile.Reader,
+	files []vaultfile.File,
+	favorite func(model.ItemType, string, bool) error,
+) (int, error) {
+	if len(files) == 0 {
+		return 0, nil
+	}
+	byID := make(map[string]vaultfile.File, len(files))
+	for _, f := range files {
+		byID[f.ID] = f
+	}
+
+	tmpDir, err := os.MkdirTemp("", "gophkeeper-import-*")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	count := 0
+	for {
+		id, content, err := vr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return count, err
+		}
+		f, ok := byID[id]
+		if !ok {
+			return count, fmt.Errorf("%w: file %s is not in manifest", vaultfile.ErrFormat, id)
+		}
+		delete(byID, id)
+
+		data, err := s.importFile(ctx, f, content, filepath.Join(tmpDir, id))
+		if err != nil {
+			return count, fmt.Errorf("file %q: %w", f.Title, err)
+		}
+		count++
+		if err := favorite(model.ItemTypeBinaryData, data.ID, f.Favorite); err != nil {
+			return count, err
+		}
+	}
+
+	// Обрыв файла экспорта на границе записей архива обнаруживается здесь
+	if len(byID) > 0 {
+		return count, fmt.Errorf("export is incomplete: %d files missing", len(byID))
+	}
+	return count, nil
+}
+
+// importFile сохраняет содержимое файла f в tmpPath и загружает его на сервер.
+func (s *AppServices) importFile(ctx context.Context, f vaultfile.File, content io.Reader, tmpPath string) (*model.BinaryData, error) {
+	contentPath := tmpPath
+	if f.Directory {
+		contentPath = tmpPath + archiveSuffix
+	}
+	if err := writeFile(contentPath, content); err != nil {
+		return nil, err
+	}
+	defer os.Remove(contentPath)
+
+	uploadPath := contentPath
+	if f.Directory {
+		if err := extractArchive(contentPath, tmpPath); err != nil {
+			return nil, err
+		}
+		defer os.RemoveAll(tmpPath)
+		uploadPath = tmpPath
+	}
+
+	data := &model.BinaryData{
+		Title: f.Title, Metadata: f.Metadata, Folder: f.Folder, Tags: f.Tags,
+	}
+	if err := s.uploadBinaryData(ctx, data, uploadPath, f.ClientPath, nil); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// writeFile записывает содержимое r в новый файл path с правами 0600.
+func writeFile(path string, r io.Reader) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
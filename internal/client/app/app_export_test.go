@@ -0,0 +1,140 @@
+package app_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/app"
+	"github.com/ryabkov82/gophkeeper/internal/client/config"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// localConfig возвращает конфигурацию клиента персонального режима
+// со всеми данными в dir.
+func localConfig(dir string) *config.ClientConfig {
+	return &config.ClientConfig{
+		LocalMode:          true,
+		LocalDataDir:       filepath.Join(dir, "server"),
+		LogLevel:           "error",
+		LogDirPath:         filepath.Join(dir, "logs"),
+		Timeout:            5 * time.Second,
+		TokenFilePath:      filepath.Join(dir, "token"),
+		KeyFilePath:        filepath.Join(dir, "key"),
+		SyncCursorFilePath: filepath.Join(dir, "sync_cursor"),
+		CacheFilePath:      filepath.Join(dir, "cache.db"),
+	}
+}
+
+// newLocalServices создаёт клиент персонального режима с данными в dir
+// и регистрирует в нём пользователя.
+func newLocalServices(t *testing.T, dir, password string) *app.AppServices {
+	t.Helper()
+	services, err := app.NewAppServices(localConfig(dir))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = services.Close() })
+
+	require.NoError(t, services.RegisterUser(context.Background(), "alice", password))
+	return services
+}
+
+func TestExportImportVault_LocalMode(t *testing.T) {
+	ctx := context.Background()
+
+	// Исходное хранилище: по записи каждого типа, файл и папка
+	src := newLocalServices(t, t.TempDir(), "first-password")
+	require.NoError(t, src.CreateCredential(ctx, &model.Credential{
+		Title: "GitHub", Login: "octo", Password: "p@ss", Folder: "work", Tags: model.Tags{"dev"},
+	}))
+	require.NoError(t, src.CreateBankCard(ctx, &model.BankCard{
+		Title: "Visa", CardholderName: "ALICE", CardNumber: "4111111111111111", ExpiryDate: "12/30", CVV: "123",
+	}))
+	note := &model.TextData{Title: "note", Content: []byte("secret note")}
+	require.NoError(t, src.CreateTextData(ctx, note))
+	require.NoError(t, src.SetFavorite(ctx, model.ItemTypeTextData, note.ID, true))
+
+	input := t.TempDir()
+	filePath := filepath.Join(input, "photo.jpg")
+	require.NoError(t, os.WriteFile(filePath, []byte("jpeg bytes"), 0o600))
+	require.NoError(t, src.UploadBinaryData(ctx, &model.BinaryData{Title: "photo"}, filePath, nil))
+
+	dirPath := filepath.Join(input, "docs")
+	require.NoError(t, os.MkdirAll(filepath.Join(dirPath, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dirPath, "sub", "a.txt"), []byte("inside"), 0o600))
+	require.NoError(t, src.UploadBinaryData(ctx, &model.BinaryData{Title: "docs"}, dirPath, nil))
+
+	var export bytes.Buffer
+	n, err := src.ExportVault(ctx, &export, "export passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+	assert.NotContains(t, export.String(), "p@ss")
+
+	// Другое хранилище с другим паролем — и, значит, другим ключом данных
+	dst := newLocalServices(t, t.TempDir(), "second-password")
+
+	_, err = dst.ImportVault(ctx, bytes.NewReader(export.Bytes()), "wrong passphrase")
+	require.Error(t, err)
+
+	n, err = dst.ImportVault(ctx, bytes.NewReader(export.Bytes()), "export passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, 5, n)
+
+	creds, _, err := dst.GetCredentials(ctx, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, "octo", creds[0].Login)
+	assert.Equal(t, "p@ss", creds[0].Password)
+	assert.Equal(t, "work", creds[0].Folder)
+	assert.Equal(t, model.Tags{"dev"}, creds[0].Tags)
+
+	cards, _, err := dst.GetBankCards(ctx, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, cards, 1)
+	assert.Equal(t, "4111111111111111", cards[0].CardNumber)
+
+	favorites, err := dst.ListFavoritesRecent(ctx, 10)
+	require.NoError(t, err)
+	var favorite []model.ItemSummary
+	for _, it := range favorites {
+		if it.Favorite {
+			favorite = append(favorite, it)
+		}
+	}
+	require.Len(t, favorite, 1)
+	assert.Equal(t, model.ItemTypeTextData, favorite[0].Type)
+	text, err := dst.GetTextDataByID(ctx, favorite[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("secret note"), text.Content)
+
+	files, _, err := dst.ListBinaryData(ctx, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	out := t.TempDir()
+	for _, item := range files {
+		// В списке метаданные расшифрованы не полностью
+		f, err := dst.GetBinaryDataInfo(ctx, item.ID)
+		require.NoError(t, err)
+		dest := filepath.Join(out, f.Title)
+		require.NoError(t, dst.DownloadBinaryData(ctx, f.ID, dest, nil))
+		switch f.Title {
+		case "photo":
+			assert.Equal(t, filePath, f.ClientPath)
+			got, err := os.ReadFile(dest)
+			require.NoError(t, err)
+			assert.Equal(t, "jpeg bytes", string(got))
+		case "docs":
+			assert.NotEmpty(t, f.Archive)
+			got, err := os.ReadFile(filepath.Join(dest, "sub", "a.txt"))
+			require.NoError(t, err)
+			assert.Equal(t, "inside", string(got))
+		default:
+			t.Fatalf("unexpected file %q", f.Title)
+		}
+	}
+}
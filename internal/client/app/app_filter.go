@@ -0,0 +1,45 @@
+package app
+
+import (
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// encryptListFilter подготавливает фильтр списка к отправке на сервер:
+// теги шифруются детерминированно, папка передаётся как есть.
+// Ключ загружается только если в фильтре есть теги.
+func (s *AppServices) encryptListFilter(filter model.ListFilter) (model.ListFilter, error) {
+	if len(filter.Tags) == 0 {
+		return filter, nil
+	}
+	key, err := s.CryptoKeyManager.LoadKey()
+	if err != nil {
+		return model.ListFilter{}, err
+	}
+	return cryptowrap.EncryptListFilter(filter, key)
+}
+
+// decryptTagsInPlace расшифровывает теги элементов, полученных облегчённым
+// списочным запросом (без остальных зашифрованных полей).
+// Ключ загружается только если хотя бы у одного элемента есть теги.
+func (s *AppServices) decryptTagsInPlace(tags []*model.Tags) error {
+	var key []byte
+	for _, t := range tags {
+		if len(*t) == 0 {
+			continue
+		}
+		if key == nil {
+			k, err := s.CryptoKeyManager.LoadKey()
+			if err != nil {
+				return err
+			}
+			key = k
+		}
+		dec, err := cryptowrap.DecryptTags(*t, key)
+		if err != nil {
+			return err
+		}
+		*t = dec
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+)
+
+// ensureItemClient гарантирует создание gRPC клиента для Item сервиса и установку его в ItemManager.
+//
+// ctx — контекст запроса.
+//
+// Возвращает ошибку при сбое подключения.
+func (s *AppServices) ensureItemClient(ctx context.Context) error {
+	conn, err := s.getGRPCConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := proto.NewItemServiceClient(conn)
+	s.ItemManager.SetClient(client)
+	return nil
+}
+
+// SetFavorite устанавливает или снимает отметку «избранное» у записи.
+//
+// ctx — контекст запроса.
+// itemType — тип записи.
+// id — идентификатор записи.
+// favorite — новое значение флага.
+//
+// Возвращает ошибку при сбое подключения или RPC вызова.
+func (s *AppServices) SetFavorite(ctx context.Context, itemType model.ItemType, id string, favorite bool) error {
+	if err := s.ensureItemClient(ctx); err != nil {
+		return err
+	}
+	return s.ItemManager.SetFavorite(ctx, itemType, id, favorite)
+}
+
+// MarkAccessed фиксирует на сервере время последнего открытия записи.
+//
+// ctx — контекст запроса.
+// itemType — тип записи.
+// id — идентификатор записи.
+//
+// Возвращает ошибку при сбое подключения или RPC вызова.
+func (s *AppServices) MarkAccessed(ctx context.Context, itemType model.ItemType, id string) error {
+	if err := s.ensureItemClient(ctx); err != nil {
+		return err
+	}
+	return s.ItemManager.MarkAccessed(ctx, itemType, id)
+}
+
+// ListFavoritesRecent возвращает объединённый список избранных и недавно открытых
+// записей всех типов. Заголовки записей не шифруются, поэтому расшифровка не требуется.
+//
+// ctx — контекст запроса.
+// limit — максимальное количество записей (0 — значение по умолчанию на сервере).
+//
+// Возвращает список записей или ошибку при сбое подключения или RPC вызова.
+func (s *AppServices) ListFavoritesRecent(ctx context.Context, limit int) ([]model.ItemSummary, error) {
+	if err := s.ensureItemClient(ctx); err != nil {
+		return nil, err
+	}
+	return s.ItemManager.ListFavoritesRecent(ctx, limit)
+}
+
+// GetUsage возвращает занятое пользователем место на сервере и действующие
+// ограничения на объём файлов и число записей.
+//
+// ctx — контекст запроса.
+//
+// Возвращает сведения о занятом месте или ошибку при сбое подключения или RPC вызова.
+func (s *AppServices) GetUsage(ctx context.Context) (*model.Usage, error) {
+	if err := s.ensureItemClient(ctx); err != nil {
+		return nil, err
+	}
+	return s.ItemManager.GetUsage(ctx)
+}
@@ -0,0 +1,98 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/app"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSetFavorite(t *testing.T) {
+	ctx := context.Background()
+
+	// Ошибка подключения
+	appSvc := &app.AppServices{
+		ConnManager: &mockConnManager{connectErr: errors.New("connect failed")},
+		ItemManager: &mockItemManager{},
+		Logger:      zap.NewNop(),
+	}
+	err := appSvc.SetFavorite(ctx, model.ItemTypeCredential, "c1", true)
+	require.EqualError(t, err, "connect failed")
+
+	// Успешный кейс
+	itemMgr := &mockItemManager{}
+	appSvc = &app.AppServices{
+		ConnManager: &mockConnManager{},
+		ItemManager: itemMgr,
+		Logger:      zap.NewNop(),
+	}
+	err = appSvc.SetFavorite(ctx, model.ItemTypeBankCard, "b1", true)
+	require.NoError(t, err)
+	require.True(t, itemMgr.setClientCalled)
+	require.Equal(t, model.ItemTypeBankCard, itemMgr.lastType)
+	require.Equal(t, "b1", itemMgr.lastID)
+	require.True(t, itemMgr.lastFavorite)
+}
+
+func TestMarkAccessed(t *testing.T) {
+	ctx := context.Background()
+
+	itemMgr := &mockItemManager{markAccessedErr: errors.New("not found")}
+	appSvc := &app.AppServices{
+		ConnManager: &mockConnManager{},
+		ItemManager: itemMgr,
+		Logger:      zap.NewNop(),
+	}
+	err := appSvc.MarkAccessed(ctx, model.ItemTypeTextData, "t1")
+	require.EqualError(t, err, "not found")
+	require.Equal(t, model.ItemTypeTextData, itemMgr.lastType)
+	require.Equal(t, "t1", itemMgr.lastID)
+}
+
+func TestListFavoritesRecent(t *testing.T) {
+	ctx := context.Background()
+
+	items := []model.ItemSummary{
+		{Type: model.ItemTypeCredential, ID: "c1", Title: "GitHub", Favorite: true},
+		{Type: model.ItemTypeBinaryData, ID: "f1", Title: "passport.pdf"},
+	}
+	itemMgr := &mockItemManager{listResult: items}
+	appSvc := &app.AppServices{
+		ConnManager: &mockConnManager{},
+		ItemManager: itemMgr,
+		Logger:      zap.NewNop(),
+	}
+	result, err := appSvc.ListFavoritesRecent(ctx, 20)
+	require.NoError(t, err)
+	require.Equal(t, items, result)
+	require.Equal(t, 20, itemMgr.lastLimit)
+
+	// Ошибка подключения
+	appSvc.ConnManager = &mockConnManager{connectErr: errors.New("connect failed")}
+	_, err = appSvc.ListFavoritesRecent(ctx, 20)
+	require.EqualError(t, err, "connect failed")
+}
+
+func TestGetUsage(t *testing.T) {
+	ctx := context.Background()
+
+	usage := &model.Usage{Bytes: 1024, Items: 3, Limits: model.Quota{MaxBytes: 4096}}
+	itemMgr := &mockItemManager{usage: usage}
+	appSvc := &app.AppServices{
+		ConnManager: &mockConnManager{},
+		ItemManager: itemMgr,
+		Logger:      zap.NewNop(),
+	}
+	got, err := appSvc.GetUsage(ctx)
+	require.NoError(t, err)
+	require.Equal(t, usage, got)
+	require.True(t, itemMgr.setClientCalled)
+
+	appSvc.ConnManager = &mockConnManager{connectErr: errors.New("connect failed")}
+	_, err = appSvc.GetUsage(ctx)
+	require.EqualError(t, err, "connect failed")
+}
@@ -0,0 +1,110 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+)
+
+// ensureSyncClient гарантирует создание gRPC клиента для Sync сервиса и установку его в ChangeManager.
+//
+// ctx — контекст запроса.
+//
+// Возвращает ошибку при сбое подключения.
+func (s *AppServices) ensureSyncClient(ctx context.Context) error {
+	conn, err := s.getGRPCConn(ctx)
+	if err != nil {
+		return err
+	}
+
+	client := proto.NewSyncServiceClient(conn)
+	s.ChangeManager.SetClient(client)
+	return nil
+}
+
+// SyncChanges получает с сервера изменения записей после сохранённого курсора.
+//
+// Содержимое созданных и обновлённых записей расшифровывается ключом из
+// CryptoKeyManager; удалённые записи приходят как «надгробия» без содержимого.
+// Полученные изменения применяются к локальному кэшу (кроме записей с
+// неотправленными офлайн-изменениями).
+// После успешной обработки порции новый курсор сохраняется в SyncCursorStore,
+// поэтому следующий вызов вернёт только более поздние изменения. Если
+// batch.HasMore == true, на сервере остались изменения и метод следует вызвать снова.
+//
+// ctx — контекст запроса.
+//
+// Возвращает порцию изменений или ошибку при сбое подключения, RPC вызова,
+// дешифрования или сохранения курсора.
+func (s *AppServices) SyncChanges(ctx context.Context) (model.ChangeBatch, error) {
+	if err := s.ensureSyncClient(ctx); err != nil {
+		return model.ChangeBatch{}, err
+	}
+
+	cursor, err := s.SyncCursorStore.Load()
+	if err != nil {
+		return model.ChangeBatch{}, fmt.Errorf("failed to load sync cursor: %w", err)
+	}
+
+	batch, err := s.ChangeManager.ListChanges(ctx, cursor, 0)
+	if err != nil {
+		return model.ChangeBatch{}, err
+	}
+
+	if len(batch.Changes) > 0 {
+		key, err := s.CryptoKeyManager.LoadKey()
+		if err != nil {
+			return model.ChangeBatch{}, err
+		}
+		for i := range batch.Changes {
+			if err := cryptowrap.DecryptChange(&batch.Changes[i], key); err != nil {
+				return model.ChangeBatch{}, err
+			}
+		}
+		// Курсор сохраняется только после обновления кэша, иначе изменения были бы потеряны для него
+		if s.Cache != nil {
+			if err := s.Cache.Apply(batch.Changes...); err != nil {
+				return model.ChangeBatch{}, fmt.Errorf("failed to update local cache: %w", err)
+			}
+		}
+	}
+
+	if err := s.SyncCursorStore.Save(batch.Cursor); err != nil {
+		return model.ChangeBatch{}, fmt.Errorf("failed to save sync cursor: %w", err)
+	}
+
+	s.Logger.Debug("Changes synchronized",
+		zap.Int("count", len(batch.Changes)),
+		zap.Int64("cursor", batch.Cursor),
+	)
+	return batch, nil
+}
+
+// ResetSyncCursor сбрасывает сохранённый курсор синхронизации:
+// следующий вызов SyncChanges получит все записи пользователя с начала.
+func (s *AppServices) ResetSyncCursor() error {
+	if s.SyncCursorStore == nil {
+		return nil
+	}
+	return s.SyncCursorStore.Clear()
+}
+
+// WatchChanges подписывается на уведомления сервера об изменениях записей
+// пользователя, в том числе сделанных на других устройствах.
+//
+// Канал закрывается после отмены ctx или обрыва соединения; для продолжения
+// подписки метод следует вызвать снова.
+//
+// ctx — контекст подписки.
+//
+// Возвращает канал уведомлений или ошибку при сбое подключения или RPC вызова.
+func (s *AppServices) WatchChanges(ctx context.Context) (<-chan model.ChangeEvent, error) {
+	if err := s.ensureSyncClient(ctx); err != nil {
+		return nil, err
+	}
+	return s.ChangeManager.WatchChanges(ctx)
+}
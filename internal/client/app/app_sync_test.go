@@ -0,0 +1,141 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/app"
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSyncChanges(t *testing.T) {
+	ctx := context.Background()
+	key := []byte("12345678901234567890123456789012")
+
+	cred := &model.Credential{ID: "c1", Login: "user", Password: "pass"}
+	require.NoError(t, cryptowrap.EncryptCredential(cred, key))
+
+	changeMgr := &mockChangeManager{batch: model.ChangeBatch{
+		Changes: []model.Change{
+			{Seq: 11, Type: model.ItemTypeCredential, ItemID: "c1", Credential: cred},
+			{Seq: 12, Type: model.ItemTypeBankCard, ItemID: "b1", Deleted: true},
+		},
+		Cursor: 12,
+	}}
+	cursorStore := &mockSyncCursorStore{cursor: 10}
+	appSvc := &app.AppServices{
+		ConnManager:      &mockConnManager{},
+		ChangeManager:    changeMgr,
+		SyncCursorStore:  cursorStore,
+		CryptoKeyManager: &mockCryptoKeyManager{loadKeyData: key},
+		Logger:           zap.NewNop(),
+	}
+
+	batch, err := appSvc.SyncChanges(ctx)
+	require.NoError(t, err)
+	require.True(t, changeMgr.setClientCalled)
+	require.Equal(t, int64(10), changeMgr.lastCursor, "request should start from the stored cursor")
+	require.Equal(t, int64(12), cursorStore.cursor, "new cursor should be stored")
+	require.Len(t, batch.Changes, 2)
+	require.Equal(t, "user", batch.Changes[0].Credential.Login)
+	require.True(t, batch.Changes[1].Deleted)
+}
+
+func TestSyncChanges_Errors(t *testing.T) {
+	ctx := context.Background()
+
+	// Ошибка подключения
+	appSvc := &app.AppServices{
+		ConnManager:     &mockConnManager{connectErr: errors.New("connect failed")},
+		ChangeManager:   &mockChangeManager{},
+		SyncCursorStore: &mockSyncCursorStore{},
+		Logger:          zap.NewNop(),
+	}
+	_, err := appSvc.SyncChanges(ctx)
+	require.EqualError(t, err, "connect failed")
+
+	// Ошибка RPC — курсор не меняется
+	cursorStore := &mockSyncCursorStore{cursor: 5}
+	appSvc = &app.AppServices{
+		ConnManager:     &mockConnManager{},
+		ChangeManager:   &mockChangeManager{err: errors.New("rpc failed")},
+		SyncCursorStore: cursorStore,
+		Logger:          zap.NewNop(),
+	}
+	_, err = appSvc.SyncChanges(ctx)
+	require.EqualError(t, err, "rpc failed")
+	require.Equal(t, int64(5), cursorStore.cursor)
+
+	// Ошибка дешифрования — курсор не меняется, чтобы изменения были получены повторно
+	cursorStore = &mockSyncCursorStore{cursor: 5}
+	appSvc = &app.AppServices{
+		ConnManager: &mockConnManager{},
+		ChangeManager: &mockChangeManager{batch: model.ChangeBatch{
+			Changes: []model.Change{{Seq: 6, Type: model.ItemTypeTextData, TextData: &model.TextData{Content: []byte("garbage")}}},
+			Cursor:  6,
+		}},
+		SyncCursorStore:  cursorStore,
+		CryptoKeyManager: &mockCryptoKeyManager{loadKeyData: []byte("12345678901234567890123456789012")},
+		Logger:           zap.NewNop(),
+	}
+	_, err = appSvc.SyncChanges(ctx)
+	require.Error(t, err)
+	require.Equal(t, int64(5), cursorStore.cursor)
+
+	// Ошибка чтения курсора
+	appSvc = &app.AppServices{
+		ConnManager:     &mockConnManager{},
+		ChangeManager:   &mockChangeManager{},
+		SyncCursorStore: &mockSyncCursorStore{loadErr: errors.New("corrupted")},
+		Logger:          zap.NewNop(),
+	}
+	_, err = appSvc.SyncChanges(ctx)
+	require.ErrorContains(t, err, "failed to load sync cursor")
+}
+
+func TestLoginUser_ResetsSyncCursor(t *testing.T) {
+	cursorStore := &mockSyncCursorStore{cursor: 42}
+	appSvc := &app.AppServices{
+		AuthManager:      &mockAuthManager{saltToReturn: []byte("salt")},
+		CryptoKeyManager: &mockCryptoKeyManager{},
+		ConnManager:      &mockConnManager{},
+		SyncCursorStore:  cursorStore,
+		Logger:           zap.NewNop(),
+	}
+
+	require.NoError(t, appSvc.LoginUser(context.Background(), "user", "pass"))
+	require.True(t, cursorStore.cleared)
+	require.Equal(t, int64(0), cursorStore.cursor)
+}
+
+func TestWatchChanges(t *testing.T) {
+	events := make(chan model.ChangeEvent, 1)
+	events <- model.ChangeEvent{Seq: 3, Type: model.ItemTypeTextData, ItemID: "t1"}
+
+	changeMgr := &mockChangeManager{events: events}
+	appSvc := &app.AppServices{
+		ConnManager:   &mockConnManager{},
+		ChangeManager: changeMgr,
+		Logger:        zap.NewNop(),
+	}
+
+	ch, err := appSvc.WatchChanges(context.Background())
+	require.NoError(t, err)
+	require.True(t, changeMgr.setClientCalled)
+	require.Equal(t, "t1", (<-ch).ItemID)
+
+	// Ошибка подключения
+	appSvc.ConnManager = &mockConnManager{connectErr: errors.New("connect failed")}
+	_, err = appSvc.WatchChanges(context.Background())
+	require.EqualError(t, err, "connect failed")
+
+	// Ошибка RPC
+	appSvc.ConnManager = &mockConnManager{}
+	appSvc.ChangeManager = &mockChangeManager{watchErr: errors.New("rpc failed")}
+	_, err = appSvc.WatchChanges(context.Background())
+	require.EqualError(t, err, "rpc failed")
+}
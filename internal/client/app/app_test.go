@@ -2,6 +2,7 @@ package app_test
 
 import (
 	"context"
+	"errors"
 	"io"
 	"os"
 	"testing"
@@ -15,7 +16,9 @@ import (
 	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
 )
 
 type mockGrpcConn struct{}
@@ -126,6 +129,9 @@ type mockCredentialManager struct {
 	updateErr         error
 	deleteErr         error
 	setClientCalled   bool
+	lastFilter        model.ListFilter
+	lastPage          model.PageRequest
+	nextToken         string
 }
 
 func (m *mockCredentialManager) CreateCredential(ctx context.Context, cred *model.Credential) error {
@@ -136,8 +142,10 @@ func (m *mockCredentialManager) GetCredentialByID(ctx context.Context, id string
 	return m.getByIDResult, m.getByIDErr
 }
 
-func (m *mockCredentialManager) GetCredentials(ctx context.Context) ([]model.Credential, error) {
-	return m.getByUserIDResult, m.getByUserIDErr
+func (m *mockCredentialManager) GetCredentials(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
+	m.lastFilter = filter
+	m.lastPage = page
+	return m.getByUserIDResult, m.nextToken, m.getByUserIDErr
 }
 
 func (m *mockCredentialManager) UpdateCredential(ctx context.Context, cred *model.Credential) error {
@@ -179,8 +187,8 @@ func (m *mockBankCardManager) GetBankCardByID(ctx context.Context, id string) (*
 	return m.getByIDResult, m.getByIDErr
 }
 
-func (m *mockBankCardManager) GetBankCards(ctx context.Context) ([]model.BankCard, error) {
-	return m.getAllResult, m.getAllErr
+func (m *mockBankCardManager) GetBankCards(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
+	return m.getAllResult, "", m.getAllErr
 }
 
 func (m *mockBankCardManager) UpdateBankCard(ctx context.Context, card *model.BankCard) error {
@@ -211,8 +219,8 @@ func (m *mockTextDataManager) GetTextDataByID(ctx context.Context, id string) (*
 	return m.getByIDResult, m.getByIDErr
 }
 
-func (m *mockTextDataManager) GetTextDataTitles(ctx context.Context) ([]*model.TextData, error) {
-	return m.getTitlesResult, m.getTitlesErr
+func (m *mockTextDataManager) GetTextDataTitles(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	return m.getTitlesResult, "", m.getTitlesErr
 }
 
 func (m *mockTextDataManager) UpdateTextData(ctx context.Context, td *model.TextData) error {
@@ -237,10 +245,34 @@ type mockBinaryDataManager struct {
 	updateInfoErr error
 	createInfoErr error
 	downloadFn    func(ctx context.Context, id string) (io.ReadCloser, error)
-	deleteErr     error
-	listResult    []model.BinaryData
-	listErr       error
-	getInfoFn     func(ctx context.Context, id string) (*model.BinaryData, error)
+	// downloadRangeFn — скачивание диапазона; если не задан, используется downloadFn
+	downloadRangeFn func(ctx context.Context, id string, offset, length int64) (io.ReadCloser, error)
+	deleteErr       error
+	listResult      []model.BinaryData
+	listErr         error
+	getInfoFn       func(ctx context.Context, id string) (*model.BinaryData, error)
+
+	// Хранилище фрагментов: chunks — загруженные фрагменты, manifest —
+	// фрагменты последнего зафиксированного файла (nil — файл хранится потоком)
+	chunks            map[string][]byte
+	manifest          []string
+	chunkUploads      int
+	chunkDownloads    int
+	chunksUnsupported bool
+	uploadCalled      bool
+
+	// info — метаданные последнего загруженного файла в том виде, в каком
+	// они ушли на сервер; возвращаются GetInfo, если не задан getInfoFn
+	info      *model.BinaryData
+	verifyErr error
+	invalid   string // непустое — Verify сообщает о повреждении с этим описанием
+
+	// Прежние версии: versions возвращаются ListVersions, versionID —
+	// версия, запрошенная последним скачиванием, restored — последней
+	// RestoreVersion
+	versions  []model.BinaryVersion
+	versionID string
+	restored  string
 }
 
 func (m *mockBinaryDataManager) SetClient(client proto.BinaryDataServiceClient) {
@@ -249,9 +281,68 @@ func (m *mockBinaryDataManager) SetClient(client proto.BinaryDataServiceClient)
 }
 
 func (m *mockBinaryDataManager) Upload(ctx context.Context, data *model.BinaryData, content io.Reader) error {
+	m.uploadCalled = true
+	m.info = m.copyInfo(data)
 	return m.uploadErr
 }
 
+func (m *mockBinaryDataManager) FindMissingChunks(ctx context.Context, ids []string) ([]string, error) {
+	if m.chunksUnsupported {
+		return nil, status.Error(codes.Unimplemented, "unknown method")
+	}
+	var missing []string
+	for _, id := range ids {
+		if _, ok := m.chunks[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+func (m *mockBinaryDataManager) UploadChunk(ctx context.Context, id string, data []byte) error {
+	if m.chunks == nil {
+		m.chunks = make(map[string][]byte)
+	}
+	m.chunks[id] = append([]byte(nil), data...)
+	m.chunkUploads++
+	return nil
+}
+
+func (m *mockBinaryDataManager) CommitChunked(ctx context.Context, data *model.BinaryData, ids []string) error {
+	for _, id := range ids {
+		if _, ok := m.chunks[id]; !ok {
+			return status.Error(codes.FailedPrecondition, "missing chunks")
+		}
+	}
+	m.manifest = append([]string{}, ids...)
+	data.ID = "chunked"
+	m.info = m.copyInfo(data)
+	return nil
+}
+
+func (m *mockBinaryDataManager) GetManifest(ctx context.Context, id string) ([]model.ChunkRef, bool, error) {
+	if m.chunksUnsupported {
+		return nil, false, status.Error(codes.Unimplemented, "unknown method")
+	}
+	if m.manifest == nil {
+		return nil, false, nil
+	}
+	refs := make([]model.ChunkRef, 0, len(m.manifest))
+	for _, id := range m.manifest {
+		refs = append(refs, model.ChunkRef{ID: id, Size: int64(len(m.chunks[id]))})
+	}
+	return refs, true, nil
+}
+
+func (m *mockBinaryDataManager) DownloadChunk(ctx context.Context, id string) ([]byte, error) {
+	m.chunkDownloads++
+	data, ok := m.chunks[id]
+	if !ok {
+		return nil, errors.New("chunk not found")
+	}
+	return data, nil
+}
+
 func (m *mockBinaryDataManager) Update(ctx context.Context, data *model.BinaryData, content io.Reader) error {
 	return m.updateErr
 }
@@ -264,6 +355,13 @@ func (m *mockBinaryDataManager) CreateInfo(ctx context.Context, data *model.Bina
 	return m.createInfoErr
 }
 
+func (m *mockBinaryDataManager) DownloadRange(ctx context.Context, id string, offset, length int64) (io.ReadCloser, error) {
+	if m.downloadRangeFn != nil {
+		return m.downloadRangeFn(ctx, id, offset, length)
+	}
+	return m.Download(ctx, id)
+}
+
 func (m *mockBinaryDataManager) Download(ctx context.Context, id string) (io.ReadCloser, error) {
 	if m.downloadFn != nil {
 		return m.downloadFn(ctx, id)
@@ -275,15 +373,136 @@ func (m *mockBinaryDataManager) Delete(ctx context.Context, id string) error {
 	return m.deleteErr
 }
 
-func (m *mockBinaryDataManager) List(ctx context.Context) ([]model.BinaryData, error) {
-	return m.listResult, m.listErr
+func (m *mockBinaryDataManager) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BinaryData, string, error) {
+	return m.listResult, "", m.listErr
 }
 
 func (m *mockBinaryDataManager) GetInfo(ctx context.Context, id string) (*model.BinaryData, error) {
 	if m.getInfoFn != nil {
 		return m.getInfoFn(ctx, id)
 	}
-	return nil, nil
+	return m.copyInfo(m.info), nil
+}
+
+func (m *mockBinaryDataManager) Verify(ctx context.Context, id string) (bool, string, error) {
+	return m.invalid == "", m.invalid, m.verifyErr
+}
+
+func (m *mockBinaryDataManager) ListVersions(ctx context.Context, id string) ([]model.BinaryVersion, error) {
+	return append([]model.BinaryVersion(nil), m.versions...), nil
+}
+
+func (m *mockBinaryDataManager) GetVersionManifest(ctx context.Context, id, versionID string) ([]model.ChunkRef, bool, error) {
+	m.versionID = versionID
+	return m.GetManifest(ctx, id)
+}
+
+func (m *mockBinaryDataManager) DownloadVersionRange(ctx context.Context, id, versionID string, offset, length int64) (io.ReadCloser, error) {
+	m.versionID = versionID
+	return m.DownloadRange(ctx, id, offset, length)
+}
+
+func (m *mockBinaryDataManager) RestoreVersion(ctx context.Context, id, versionID string, version int64) (int64, error) {
+	m.restored = versionID
+	return version + 1, nil
+}
+
+// copyInfo возвращает копию метаданных, чтобы расшифровка не меняла сохранённое.
+func (m *mockBinaryDataManager) copyInfo(data *model.BinaryData) *model.BinaryData {
+	if data == nil {
+		return nil
+	}
+	d := *data
+	return &d
+}
+
+// mockItemManager - мок ItemManagerIface
+type mockItemManager struct {
+	setFavoriteErr  error
+	markAccessedErr error
+	listResult      []model.ItemSummary
+	listErr         error
+	usage           *model.Usage
+	usageErr        error
+	setClientCalled bool
+	lastType        model.ItemType
+	lastID          string
+	lastFavorite    bool
+	lastLimit       int
+}
+
+func (m *mockItemManager) SetFavorite(ctx context.Context, itemType model.ItemType, id string, favorite bool) error {
+	m.lastType, m.lastID, m.lastFavorite = itemType, id, favorite
+	return m.setFavoriteErr
+}
+
+func (m *mockItemManager) MarkAccessed(ctx context.Context, itemType model.ItemType, id string) error {
+	m.lastType, m.lastID = itemType, id
+	return m.markAccessedErr
+}
+
+func (m *mockItemManager) ListFavoritesRecent(ctx context.Context, limit int) ([]model.ItemSummary, error) {
+	m.lastLimit = limit
+	return m.listResult, m.listErr
+}
+
+func (m *mockItemManager) GetUsage(ctx context.Context) (*model.Usage, error) {
+	return m.usage, m.usageErr
+}
+
+func (m *mockItemManager) SetClient(client proto.ItemServiceClient) {
+	m.setClientCalled = true
+}
+
+// mockChangeManager - мок ChangeManagerIface
+type mockChangeManager struct {
+	batch           model.ChangeBatch
+	err             error
+	setClientCalled bool
+	lastCursor      int64
+	events          chan model.ChangeEvent
+	watchErr        error
+}
+
+func (m *mockChangeManager) ListChanges(ctx context.Context, cursor int64, limit int) (model.ChangeBatch, error) {
+	m.lastCursor = cursor
+	return m.batch, m.err
+}
+
+func (m *mockChangeManager) WatchChanges(ctx context.Context) (<-chan model.ChangeEvent, error) {
+	if m.watchErr != nil {
+		return nil, m.watchErr
+	}
+	return m.events, nil
+}
+
+func (m *mockChangeManager) SetClient(client proto.SyncServiceClient) {
+	m.setClientCalled = true
+}
+
+// mockSyncCursorStore - мок SyncCursorStorage в памяти
+type mockSyncCursorStore struct {
+	cursor  int64
+	loadErr error
+	saveErr error
+	cleared bool
+}
+
+func (m *mockSyncCursorStore) Save(cursor int64) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.cursor = cursor
+	return nil
+}
+
+func (m *mockSyncCursorStore) Load() (int64, error) {
+	return m.cursor, m.loadErr
+}
+
+func (m *mockSyncCursorStore) Clear() error {
+	m.cursor, m.cleared = 0, true
+	return nil
 }
 
 func TestNewAppServices_Success(t *testing.T) {
@@ -305,6 +524,7 @@ func TestNewAppServices_Success(t *testing.T) {
 	assert.NotNil(t, services.Logger, "Logger должен быть инициализирован")
 	assert.NotNil(t, services.AuthManager, "AuthManager должен быть инициализирован")
 	assert.NotNil(t, services.ConnManager, "ConnManager должен быть инициализирован")
+	assert.NotNil(t, services.ItemManager, "ItemManager должен быть инициализирован")
 
 	// Проверяем, что каталог логов существует
 	_, err = os.Stat(tempLogDir)
@@ -2,7 +2,9 @@ package app
 
 import (
 	"context"
+	"time"
 
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
 	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/proto"
@@ -20,8 +22,117 @@ func (s *AppServices) ensureTextDataClient(ctx context.Context) error {
 	return nil
 }
 
-// CreateTextData создаёт новый текстовый объект на сервере с шифрованием содержимого
+// CreateTextData создаёт новый текстовый объект на сервере с шифрованием содержимого.
+// Если сервер недоступен, заметка сохраняется в локальном кэше с временным
+// идентификатором и будет отправлена при восстановлении связи.
 func (s *AppServices) CreateTextData(ctx context.Context, text *model.TextData) error {
+	plain := *text
+	now := time.Now()
+	plain.CreatedAt, plain.UpdatedAt = now, now
+
+	err := s.createTextData(ctx, text)
+	if s.isOffline(err) {
+		plain.ID = cache.NewLocalID()
+		*text = plain
+		return s.queueOffline(cache.OpCreate, textDataChange(text), err)
+	}
+	if err != nil {
+		return err
+	}
+
+	plain.ID, plain.Version = text.ID, text.Version
+	s.cacheApply(textDataChange(&plain))
+	return nil
+}
+
+// GetTextDataByID получает текстовые данные по ID с расшифровкой содержимого.
+// Если сервер недоступен, заметка возвращается из локального кэша.
+func (s *AppServices) GetTextDataByID(ctx context.Context, id string) (*model.TextData, error) {
+	if s.Cache != nil && cache.IsLocalID(id) {
+		return s.cachedTextData(id, errNotSynced)
+	}
+
+	text, err := s.getTextDataByID(ctx, id)
+	if s.isOffline(err) {
+		return s.cachedTextData(id, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	s.cacheApply(textDataChange(text))
+	return text, nil
+}
+
+// GetTextDataTitles получает страницу заголовков текстовых данных, удовлетворяющих фильтру
+// (без расшифровки контента; расшифровываются только теги), и токен следующей страницы.
+// Если сервер недоступен, возвращаются все подходящие заметки из локального
+// кэша одной страницей.
+func (s *AppServices) GetTextDataTitles(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	list, next, err := s.getTextDataTitles(ctx, filter, page)
+	if s.isOffline(err) {
+		items, cerr := s.cachedList(model.ItemTypeTextData, filter, page)
+		if cerr != nil {
+			return nil, "", err
+		}
+		list = make([]*model.TextData, 0, len(items))
+		for _, item := range items {
+			list = append(list, item.TextData)
+		}
+		return list, "", nil
+	}
+	// Список содержит только заголовки, поэтому в кэш не записывается
+	return list, next, err
+}
+
+// UpdateTextData обновляет текстовые данные с шифрованием содержимого.
+// Если сервер недоступен, изменение сохраняется в локальном кэше и будет
+// отправлено при восстановлении связи с проверкой версии заметки.
+func (s *AppServices) UpdateTextData(ctx context.Context, text *model.TextData) error {
+	plain := *text
+	plain.UpdatedAt = time.Now()
+
+	var err error
+	if s.Cache != nil && cache.IsLocalID(text.ID) {
+		err = errNotSynced
+	} else {
+		err = s.updateTextData(ctx, text)
+	}
+	if s.isOffline(err) {
+		*text = plain
+		return s.queueOffline(cache.OpUpdate, textDataChange(text), err)
+	}
+	if err != nil {
+		return err
+	}
+
+	plain.Version = text.Version
+	s.cacheApply(textDataChange(&plain))
+	return nil
+}
+
+// DeleteTextData удаляет текстовые данные по ID.
+// Если сервер недоступен, удаление будет выполнено при восстановлении связи.
+func (s *AppServices) DeleteTextData(ctx context.Context, id string) error {
+	var err error
+	if s.Cache != nil && cache.IsLocalID(id) {
+		err = errNotSynced
+	} else {
+		err = s.deleteTextData(ctx, id)
+	}
+	if s.isOffline(err) {
+		return s.queueOffline(cache.OpDelete, model.Change{Type: model.ItemTypeTextData, ItemID: id}, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.cacheRemove(model.ItemTypeTextData, id)
+	return nil
+}
+
+// createTextData шифрует содержимое заметки на месте и создаёт её на сервере.
+func (s *AppServices) createTextData(ctx context.Context, text *model.TextData) error {
 	if err := s.ensureTextDataClient(ctx); err != nil {
 		return err
 	}
@@ -39,8 +150,8 @@ func (s *AppServices) CreateTextData(ctx context.Context, text *model.TextData)
 	return s.TextDataManager.CreateTextData(ctx, text)
 }
 
-// GetTextDataByID получает текстовые данные по ID с расшифровкой содержимого
-func (s *AppServices) GetTextDataByID(ctx context.Context, id string) (*model.TextData, error) {
+// getTextDataByID получает текстовые данные с сервера и расшифровывает их.
+func (s *AppServices) getTextDataByID(ctx context.Context, id string) (*model.TextData, error) {
 	if err := s.ensureTextDataClient(ctx); err != nil {
 		return nil, err
 	}
@@ -63,16 +174,35 @@ func (s *AppServices) GetTextDataByID(ctx context.Context, id string) (*model.Te
 	return text, nil
 }
 
-// GetTextDataTitles получает только заголовки текстовых данных (без расшифровки контента)
-func (s *AppServices) GetTextDataTitles(ctx context.Context) ([]*model.TextData, error) {
+// getTextDataTitles получает страницу заголовков текстовых данных с сервера.
+func (s *AppServices) getTextDataTitles(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
 	if err := s.ensureTextDataClient(ctx); err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	filter, err := s.encryptListFilter(filter)
+	if err != nil {
+		return nil, "", err
+	}
+
+	list, next, err := s.TextDataManager.GetTextDataTitles(ctx, filter, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	tags := make([]*model.Tags, 0, len(list))
+	for _, td := range list {
+		tags = append(tags, &td.Tags)
 	}
-	return s.TextDataManager.GetTextDataTitles(ctx)
+	if err := s.decryptTagsInPlace(tags); err != nil {
+		return nil, "", err
+	}
+
+	return list, next, nil
 }
 
-// UpdateTextData обновляет текстовые данные с шифрованием содержимого
-func (s *AppServices) UpdateTextData(ctx context.Context, text *model.TextData) error {
+// updateTextData шифрует содержимое заметки на месте и обновляет её на сервере.
+func (s *AppServices) updateTextData(ctx context.Context, text *model.TextData) error {
 	if err := s.ensureTextDataClient(ctx); err != nil {
 		return err
 	}
@@ -90,10 +220,58 @@ func (s *AppServices) UpdateTextData(ctx context.Context, text *model.TextData)
 	return s.TextDataManager.UpdateTextData(ctx, text)
 }
 
-// DeleteTextData удаляет текстовые данные по ID
-func (s *AppServices) DeleteTextData(ctx context.Context, id string) error {
+// deleteTextData удаляет текстовые данные на сервере.
+func (s *AppServices) deleteTextData(ctx context.Context, id string) error {
 	if err := s.ensureTextDataClient(ctx); err != nil {
 		return err
 	}
 	return s.TextDataManager.DeleteTextData(ctx, id)
 }
+
+// cachedTextData возвращает текстовые данные из локального кэша или cause, если их там нет.
+func (s *AppServices) cachedTextData(id string, cause error) (*model.TextData, error) {
+	item, err := s.cachedItem(model.ItemTypeTextData, id, cause)
+	if err != nil {
+		return nil, err
+	}
+	return item.TextData, nil
+}
+
+// textDataChange представляет текстовые данные как запись кэша.
+func textDataChange(text *model.TextData) model.Change {
+	t := *text
+	return model.Change{Type: model.ItemTypeTextData, ItemID: t.ID, TextData: &t, ChangedAt: t.UpdatedAt}
+}
+
+// textDataReplay возвращает операции воспроизведения офлайн-изменений текстовых данных.
+func (s *AppServices) textDataReplay() replayHandler {
+	return replayHandler{
+		create: func(ctx context.Context, item model.Change) (model.Change, error) {
+			plain := *item.TextData
+			text := plain
+			text.ID, text.Version = "", 0 // постоянный идентификатор назначит сервер
+			if err := s.createTextData(ctx, &text); err != nil {
+				return model.Change{}, err
+			}
+			plain.ID, plain.Version = text.ID, text.Version
+			return textDataChange(&plain), nil
+		},
+		update: func(ctx context.Context, item model.Change) (model.Change, error) {
+			plain := *item.TextData
+			text := plain
+			if err := s.updateTextData(ctx, &text); err != nil {
+				return model.Change{}, err
+			}
+			plain.Version = text.Version
+			return textDataChange(&plain), nil
+		},
+		get: func(ctx context.Context, id string) (model.Change, error) {
+			text, err := s.getTextDataByID(ctx, id)
+			if err != nil {
+				return model.Change{}, err
+			}
+			return textDataChange(text), nil
+		},
+		delete: s.deleteTextData,
+	}
+}
@@ -116,7 +116,7 @@ func TestGetTextDataTitles(t *testing.T) {
 		Logger:           zap.NewNop(),
 	}
 
-	list, err := appSvc.GetTextDataTitles(ctx)
+	list, _, err := appSvc.GetTextDataTitles(ctx, model.ListFilter{}, model.PageRequest{})
 	require.NoError(t, err)
 	require.Len(t, list, 2)
 	require.Equal(t, "t1", list[0].Title)
@@ -124,7 +124,7 @@ func TestGetTextDataTitles(t *testing.T) {
 
 	// Ошибка подключения
 	appSvc.ConnManager = &mockConnManager{connectErr: errors.New("connect error")}
-	list, err = appSvc.GetTextDataTitles(ctx)
+	list, _, err = appSvc.GetTextDataTitles(ctx, model.ListFilter{}, model.PageRequest{})
 	require.Error(t, err)
 	require.Nil(t, list)
 	require.EqualError(t, err, "connect error")
@@ -14,6 +14,13 @@
 //   - TextDataManager   — CRUD для текстовых заметок.
 //   - BinaryDataManager — загрузка/обновление/скачивание/удаление бинарных файлов,
 //     а также выдача списка и метаданных.
+//   - ItemManager       — избранное, история открытия записей всех типов и
+//     занятое на сервере место.
+//   - BatchManager      — пакетное создание/обновление/удаление записей.
+//   - ChangeManager     — получение изменений записей для разностной синхронизации.
+//   - SyncCursorStore   — файловое хранилище курсора синхронизации.
+//   - Cache             — локальный зашифрованный кэш записей и очередь
+//     изменений, сделанных без связи с сервером (nil — кэш отключён).
 //   - CryptoKeyManager  — генерация/сохранение/загрузка симметричного ключа,
 //     используемого для шифрования пользовательских данных.
 //   - ConnManager       — управление gRPC‑подключением к серверу (TLS/без TLS).
@@ -24,6 +31,10 @@
 //	NewAppServices(cfg) поднимает логгер, настраивает ConnManager, подготавливает
 //	файловые стораджи для токена и ключа шифрования и создаёт менеджеры доменов.
 //
+//	В персональном режиме (cfg.LocalMode) NewAppServices запускает в процессе
+//	встроенный сервер (internal/server/embedded) с данными в cfg.LocalDataDir,
+//	и ConnManager соединяется с ним через буфер в памяти.
+//
 //	getGRPCConn(ctx) скрывает логику установления/восстановления соединения
 //	(через ConnManager) и используется всеми ensure*Client.
 //
@@ -38,7 +49,8 @@
 //	AuthManager.
 //
 //	LoginUser(ctx, login, password) — логинится, получает от сервера соль,
-//	генерирует и сохраняет симметричный ключ шифрования через CryptoKeyManager.
+//	генерирует и сохраняет симметричный ключ шифрования через CryptoKeyManager
+//	и сбрасывает курсор синхронизации предыдущей сессии.
 //
 //	RegisterUser(ctx, login, password) — регистрирует пользователя и затем
 //	вызывает LoginUser.
@@ -63,15 +75,85 @@
 //	    что позволяет отдавать списки заголовков без расшифровки.
 //
 //	Binary files:
-//	  - UploadBinaryData / UpdateBinaryData — потоковое шифрование содержимого файла
-//	    при отправке (EncryptStream). Метаданные шифруются перед RPC.
-//	  - DownloadBinaryData — потоковая расшифровка (DecryptStream) и запись в файл.
+//	  - UploadBinaryData — загрузка с дедупликацией: файл режется на фрагменты
+//	    по содержимому (chunker), сервер сообщает, каких фрагментов у него нет
+//	    (FindMissingChunks), и только они шифруются (EncryptChunk) и
+//	    отправляются; затем фиксируется список фрагментов файла. SHA-256
+//	    исходного файла сохраняется в PlainChecksum и шифруется вместе с
+//	    метаданными.
+//	  - Если сервер не поддерживает фрагменты — потоковое шифрование
+//	    содержимого файла при отправке (EncryptReader, формат EncryptStream).
+//	    Зашифрованный поток можно перемотать, поэтому оборванная загрузка
+//	    продолжается с места, принятого сервером. Метаданные шифруются перед RPC.
+//	  - При data.Compress фрагменты и кадры потока перед шифрованием сжимаются
+//	    zstd (EncryptChunkCompressed, NewEncryptReaderCompressed); несжимаемые
+//	    данные отправляются как есть. Сжатие отмечается в зашифрованном
+//	    содержимом, поэтому при скачивании распознаётся автоматически.
+//	  - DownloadBinaryData — файл из фрагментов скачивается по манифесту
+//	    (GetManifest, DownloadChunk) в .part с проверкой уже записанных
+//	    фрагментов; остальные — скачивание зашифрованного содержимого в файл
+//	    .part с докачкой (начало .part проверяется по границам кадров потока),
+//	    затем расшифровка (DecryptStream) в файл назначения. Собранный файл
+//	    сверяется с PlainChecksum; при расхождении он удаляется.
+//	  - Папка загружается одним архивом tar (пакет archive) тем же путём, что
+//	    и файл; список её файлов шифруется в поле Archive. При скачивании архив
+//	    распаковывается в папку назначения с правами и временем изменения
+//	    файлов; пути, выходящие за её пределы, отвергаются.
+//	  - ReadBinaryData — расшифровка содержимого в память для просмотра без
+//	    записи на диск: поток Download через DecryptStream (или фрагменты по
+//	    манифесту) с ограничением размера (ErrPreviewTooLarge) и сверкой
+//	    с PlainChecksum.
+//	  - VerifyBinaryData — проверка хранимого на сервере содержимого по запросу.
+//	  - ListBinaryVersions / DownloadBinaryVersion / RestoreBinaryVersion —
+//	    прежние версии содержимого: список с расшифрованными атрибутами,
+//	    скачивание тем же путём, что и DownloadBinaryData (со сверкой
+//	    с PlainChecksum версии), и восстановление версии текущим содержимым.
 //	  - GetBinaryDataInfo — получение и расшифровка только метаданных.
 //	  - ListBinaryData / DeleteBinaryData — работа со списком и удалением.
 //	  - Для отображения прогресса используются каналы:
 //	      * при upload/update — chan ProgressMsg { Done, Total },
 //	      * при download — chan int64 (накопленный байт‑каунтер).
 //
+//	Избранное и недавние:
+//	  - SetFavorite / MarkAccessed / ListFavoritesRecent — общие для всех типов
+//	    операции. Заголовки не шифруются, поэтому объединённый список
+//	    возвращается без расшифровки.
+//	  - GetUsage — занятое пользователем место и ограничения сервера.
+//
+//	Разностная синхронизация:
+//	  - SyncChanges — получает записи всех типов, созданные, изменённые или
+//	    удалённые после сохранённого курсора, расшифровывает их и сохраняет
+//	    новый курсор. Удалённые записи приходят как «надгробия» (Deleted).
+//	  - ResetSyncCursor — сбрасывает курсор для полной повторной синхронизации.
+//	  - WatchChanges — подписка на уведомления об изменениях записей, сделанных
+//	    в том числе на других устройствах.
+//
+//	Работа без связи:
+//	  - Если сервер недоступен, чтение учётных данных, карт, заметок и
+//	    метаданных файлов обслуживается из локального кэша (Cache), а
+//	    создание, изменение и удаление записей (кроме бинарных файлов)
+//	    ставятся в очередь. Новые записи получают временный идентификатор.
+//	  - ReplayPending — отправляет очередь на сервер; вызывается автоматически
+//	    при первом успешном подключении. Изменения отправляются с версией,
+//	    которую видел пользователь, и не затирают чужие правки.
+//	  - Conflicts — конфликты версий, обнаруженные при воспроизведении очереди.
+//	  - ResolveConflict — записывает выбранную пользователем версию (локальную,
+//	    серверную или объединённую) обычным путём обновления и удаляет конфликт.
+//
+//	Пакетные изменения:
+//	  - BatchMutate — отправляет до model.MaxBatchOps операций над учётками,
+//	    картами и заметками одним запросом; сервер применяет их в одной
+//	    транзакции и возвращает результат каждой операции. В атомарном режиме
+//	    ошибка любой операции отменяет весь пакет. Успешные операции сразу
+//	    отражаются в кэше; без связи пакет в очередь не ставится.
+//
+//	Экспорт и импорт:
+//	  - ExportVault — выгружает текущие записи всех типов и содержимое файлов
+//	    в файл экспорта (пакет vaultfile), зашифрованный ключом из отдельной
+//	    парольной фразы: ключ данных у каждого сервера свой.
+//	  - ImportVault — создаёт записи из файла экспорта заново, шифруя их
+//	    ключом текущего пользователя.
+//
 // Клиенты gRPC
 //
 //	Каждый доменный метод начинается с ensure*Client(ctx), который запрашивает
@@ -87,6 +169,9 @@
 //	  3) вызывает предоставленную функцию runTUI(ctx, services, progFactory);
 //	  4) гарантирует закрытие ресурсов (services.Close, logger.Close).
 //
+//	RunVaultTransfer(cfg, passphrase) — экспорт в cfg.ExportPath или импорт
+//	из cfg.ImportPath без запуска TUI.
+//
 // Обработка ошибок и логирование
 //
 //	Ошибки подключения и криптоопераций прозрачно возвращаются вызывающему коду.
@@ -103,7 +188,7 @@
 //	    if err := s.CreateCredential(ctx, cred); err != nil {
 //	        return err
 //	    }
-//	    list, err := s.GetCredentials(ctx)
+//	    list, next, err := s.GetCredentials(ctx, model.ListFilter{}, model.PageRequest{})
 //	    if err != nil {
 //	        return err
 //	    }
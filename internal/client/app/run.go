@@ -1,7 +1,9 @@
 package app
 
 import (
+	"bufio"
 	"context"
+	"errors"
 	"os"
 	"os/signal"
 	"syscall"
@@ -54,3 +56,54 @@ func RunWithServices(
 
 	return runTUI(ctx, services, nil)
 }
+
+// RunVaultTransfer выполняет без запуска TUI экспорт записей в файл
+// cfg.ExportPath или импорт из файла cfg.ImportPath (см. ExportVault и
+// ImportVault). Пользователь должен быть авторизован заранее — через TUI.
+//
+// Файл экспорта создаётся с правами 0600 и не перезаписывается, если уже
+// существует; при ошибке недописанный файл удаляется.
+//
+// Возвращает число выгруженных или загруженных записей.
+func RunVaultTransfer(cfg *config.ClientConfig, passphrase string) (int, error) {
+	if cfg.ExportPath == "" && cfg.ImportPath == "" {
+		return 0, errors.New("export or import path is required")
+	}
+
+	services, err := NewAppServices(cfg)
+	if err != nil {
+		return 0, err
+	}
+	defer logger.Close()
+	defer services.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if cfg.ImportPath != "" {
+		f, err := os.Open(cfg.ImportPath)
+		if err != nil {
+			return 0, err
+		}
+		defer f.Close()
+		return services.ImportVault(ctx, bufio.NewReader(f), passphrase)
+	}
+
+	f, err := os.OpenFile(cfg.ExportPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	w := bufio.NewWriter(f)
+	n, err := services.ExportVault(ctx, w, passphrase)
+	if err == nil {
+		err = w.Flush()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		_ = os.Remove(cfg.ExportPath)
+		return 0, err
+	}
+	return n, nil
+}
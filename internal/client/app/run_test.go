@@ -3,13 +3,17 @@ package app_test
 import (
 	"context"
 	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/ryabkov82/gophkeeper/internal/client/app"
 	"github.com/ryabkov82/gophkeeper/internal/client/config"
 	"github.com/ryabkov82/gophkeeper/internal/client/tuiiface"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRunWithServices(t *testing.T) {
@@ -71,3 +75,38 @@ func TestRunWithServices_RunTUIFail(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "TUI error")
 }
+
+func TestRunVaultTransfer(t *testing.T) {
+	ctx := context.Background()
+
+	srcDir := t.TempDir()
+	src := newLocalServices(t, srcDir, "first-password")
+	require.NoError(t, src.CreateCredential(ctx, &model.Credential{Title: "GitHub", Login: "octo", Password: "p@ss"}))
+	require.NoError(t, src.Close())
+
+	exportPath := filepath.Join(t.TempDir(), "vault.gkx")
+	cfg := localConfig(srcDir)
+	cfg.ExportPath = exportPath
+	n, err := app.RunVaultTransfer(cfg, "passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	info, err := os.Stat(exportPath)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// Существующий файл не перезаписывается
+	_, err = app.RunVaultTransfer(cfg, "passphrase")
+	assert.Error(t, err)
+
+	dstDir := t.TempDir()
+	require.NoError(t, newLocalServices(t, dstDir, "second-password").Close())
+	cfg = localConfig(dstDir)
+	cfg.ImportPath = exportPath
+	n, err = app.RunVaultTransfer(cfg, "passphrase")
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	_, err = app.RunVaultTransfer(localConfig(dstDir), "passphrase")
+	assert.Error(t, err)
+}
@@ -0,0 +1,262 @@
+// Package archive упаковывает папку в поток tar для загрузки одним
+// зашифрованным файлом и распаковывает его обратно.
+//
+// Архив строится детерминированно по списку файлов (List): записи идут
+// в лексическом порядке, заголовки берутся из списка, а не из повторного
+// чтения атрибутов, и не содержат владельцев. Поэтому два прохода по
+// неизменившейся папке дают одинаковые байты, что нужно для дедупликации
+// фрагментами. В архив попадают только каталоги и обычные файлы;
+// символические ссылки и специальные файлы пропускаются.
+//
+// Extract принимает только каталоги и обычные файлы с относительными путями
+// внутри папки назначения и не пишет через символические ссылки.
+package archive
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrUnsafePath возвращается при распаковке записи, путь которой выходит
+// за пределы папки назначения или проходит через символическую ссылку.
+var ErrUnsafePath = errors.New("unsafe path in archive")
+
+// ErrChanged возвращается, если файл изменился после составления списка.
+var ErrChanged = errors.New("file changed while archiving")
+
+// Entry описывает файл или каталог архива.
+type Entry struct {
+	Path    string      `json:"path"` // путь относительно корня архива через "/"
+	Dir     bool        `json:"dir,omitempty"`
+	Size    int64       `json:"size,omitempty"`
+	Mode    fs.FileMode `json:"mode"` // права доступа
+	ModTime time.Time   `json:"mtime"`
+}
+
+// List возвращает каталоги и обычные файлы папки root в лексическом порядке.
+// Сама root в список не входит.
+func List(root string) ([]Entry, error) {
+	var entries []Entry
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if p == root {
+			return nil
+		}
+		if !d.IsDir() && !d.Type().IsRegular() {
+			return nil // ссылки и специальные файлы не архивируются
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		e := Entry{
+			Path:    filepath.ToSlash(rel),
+			Dir:     d.IsDir(),
+			Mode:    info.Mode().Perm(),
+			ModTime: info.ModTime().Truncate(time.Second),
+		}
+		if !e.Dir {
+			e.Size = info.Size()
+		}
+		entries = append(entries, e)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Size возвращает суммарный размер файлов списка.
+func Size(entries []Entry) int64 {
+	var n int64
+	for _, e := range entries {
+		n += e.Size
+	}
+	return n
+}
+
+// Write пишет в w архив tar с файлами entries из папки root.
+// Если размер файла не совпадает со списком, возвращается ErrChanged.
+func Write(w io.Writer, root string, entries []Entry) error {
+	tw := tar.NewWriter(w)
+	for _, e := range entries {
+		hdr := &tar.Header{
+			Name:    e.Path,
+			Mode:    int64(e.Mode.Perm()),
+			ModTime: e.ModTime,
+		}
+		if e.Dir {
+			hdr.Typeflag = tar.TypeDir
+			hdr.Name += "/"
+		} else {
+			hdr.Typeflag = tar.TypeReg
+			hdr.Size = e.Size
+		}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if !e.Dir {
+			if err := copyFile(tw, filepath.Join(root, filepath.FromSlash(e.Path)), e.Size); err != nil {
+				return err
+			}
+		}
+	}
+	return tw.Close()
+}
+
+// copyFile пишет в w ровно size байт файла p.
+func copyFile(w io.Writer, p string, size int64) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	n, err := io.Copy(w, io.LimitReader(f, size))
+	if err != nil {
+		return err
+	}
+	if n != size {
+		return fmt.Errorf("%w: %s", ErrChanged, p)
+	}
+	// Файл не должен был вырасти
+	if m, _ := f.Read(make([]byte, 1)); m > 0 {
+		return fmt.Errorf("%w: %s", ErrChanged, p)
+	}
+	return nil
+}
+
+// Open возвращает поток архива папки root (см. Write). Архив формируется
+// в фоне по мере чтения; закрытие потока прерывает формирование.
+func Open(root string, entries []Entry) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(Write(pw, root, entries))
+	}()
+	return pr
+}
+
+// Extract распаковывает архив из r в папку dest, создавая её при
+// необходимости. Права доступа и время изменения восстанавливаются;
+// существующие файлы перезаписываются.
+func Extract(r io.Reader, dest string) error {
+	if err := os.MkdirAll(dest, 0o700); err != nil {
+		return err
+	}
+
+	// Права и время каталогов выставляются в конце: запрет записи в каталог
+	// не должен мешать распаковке его файлов, а запись файла меняет mtime
+	type dirAttrs struct {
+		path    string
+		mode    fs.FileMode
+		modTime time.Time
+	}
+	var dirs []dirAttrs
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		name := path.Clean(strings.TrimSuffix(hdr.Name, "/"))
+		if !filepath.IsLocal(filepath.FromSlash(name)) {
+			return fmt.Errorf("%w: %s", ErrUnsafePath, hdr.Name)
+		}
+		if err := checkNoSymlinks(dest, name); err != nil {
+			return err
+		}
+		target := filepath.Join(dest, filepath.FromSlash(name))
+		mode := fs.FileMode(hdr.Mode).Perm()
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0o700); err != nil {
+				return err
+			}
+			dirs = append(dirs, dirAttrs{path: target, mode: mode, modTime: hdr.ModTime})
+		case tar.TypeReg:
+			if err := extractFile(tr, target, mode, hdr.ModTime); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: unsupported entry type %q for %s", ErrUnsafePath, hdr.Typeflag, hdr.Name)
+		}
+	}
+
+	// Вложенные каталоги обрабатываются раньше родительских
+	sort.Slice(dirs, func(i, j int) bool { return dirs[i].path > dirs[j].path })
+	for _, d := range dirs {
+		if err := os.Chmod(d.path, d.mode); err != nil {
+			return err
+		}
+		if err := os.Chtimes(d.path, d.modTime, d.modTime); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// extractFile записывает содержимое r в файл target с правами mode и
+// временем изменения modTime.
+func extractFile(r io.Reader, target string, mode fs.FileMode, modTime time.Time) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(target, mode); err != nil {
+		return err
+	}
+	return os.Chtimes(target, modTime, modTime)
+}
+
+// checkNoSymlinks проверяет, что ни один существующий компонент пути name
+// внутри dest не является символической ссылкой: иначе запись ушла бы за
+// пределы папки назначения.
+func checkNoSymlinks(dest, name string) error {
+	cur := dest
+	for _, part := range strings.Split(name, "/") {
+		cur = filepath.Join(cur, part)
+		info, err := os.Lstat(cur)
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if info.Mode()&fs.ModeSymlink != 0 {
+			return fmt.Errorf("%w: %s", ErrUnsafePath, name)
+		}
+	}
+	return nil
+}
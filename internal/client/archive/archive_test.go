@@ -0,0 +1,147 @@
+package archive_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/archive"
+)
+
+// makeTree создаёт тестовую папку с вложенным каталогом и ссылкой.
+func makeTree(t *testing.T) string {
+	t.Helper()
+	root := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "docs", "old"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.txt"), []byte("hello"), 0o640))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "docs", "run.sh"), []byte("#!/bin/sh\n"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "docs", "old", "b.bin"), bytes.Repeat([]byte{7}, 3000), 0o600))
+	require.NoError(t, os.Symlink("/etc/passwd", filepath.Join(root, "link")))
+
+	mtime := time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	require.NoError(t, os.Chtimes(filepath.Join(root, "docs", "run.sh"), mtime, mtime))
+	require.NoError(t, os.Chtimes(filepath.Join(root, "docs", "old"), mtime, mtime))
+	return root
+}
+
+func TestList(t *testing.T) {
+	root := makeTree(t)
+
+	entries, err := archive.List(root)
+	require.NoError(t, err)
+
+	var paths []string
+	for _, e := range entries {
+		paths = append(paths, e.Path)
+	}
+	assert.Equal(t, []string{"a.txt", "docs", "docs/old", "docs/old/b.bin", "docs/run.sh"}, paths)
+	assert.True(t, entries[1].Dir)
+	assert.EqualValues(t, 3000, entries[3].Size)
+	assert.EqualValues(t, 0o755, entries[4].Mode)
+	assert.EqualValues(t, 3015, archive.Size(entries))
+}
+
+func TestWriteExtract_RoundTrip(t *testing.T) {
+	root := makeTree(t)
+	entries, err := archive.List(root)
+	require.NoError(t, err)
+
+	var first, second bytes.Buffer
+	require.NoError(t, archive.Write(&first, root, entries))
+	// Повторный проход даёт те же байты
+	r := archive.Open(root, entries)
+	_, err = io.Copy(&second, r)
+	require.NoError(t, err)
+	require.NoError(t, r.Close())
+	assert.Equal(t, first.Bytes(), second.Bytes())
+
+	dest := filepath.Join(t.TempDir(), "out")
+	require.NoError(t, archive.Extract(bytes.NewReader(first.Bytes()), dest))
+
+	got, err := os.ReadFile(filepath.Join(dest, "docs", "old", "b.bin"))
+	require.NoError(t, err)
+	assert.Equal(t, bytes.Repeat([]byte{7}, 3000), got)
+
+	info, err := os.Stat(filepath.Join(dest, "docs", "run.sh"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 0o755, info.Mode().Perm())
+	assert.True(t, info.ModTime().Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)))
+
+	info, err = os.Stat(filepath.Join(dest, "docs", "old"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 0o750, info.Mode().Perm())
+	assert.True(t, info.ModTime().Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)))
+
+	_, err = os.Lstat(filepath.Join(dest, "link"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestWrite_FileChanged(t *testing.T) {
+	root := makeTree(t)
+	entries, err := archive.List(root)
+	require.NoError(t, err)
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "a.txt"), []byte("hello, world"), 0o640))
+	err = archive.Write(io.Discard, root, entries)
+	assert.ErrorIs(t, err, archive.ErrChanged)
+}
+
+// tarOf строит архив из заголовков с содержимым body для обычных файлов.
+func tarOf(t *testing.T, hdrs ...*tar.Header) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	for _, h := range hdrs {
+		if h.Typeflag == tar.TypeReg {
+			h.Size = 4
+		}
+		require.NoError(t, tw.WriteHeader(h))
+		if h.Typeflag == tar.TypeReg {
+			_, err := tw.Write([]byte("evil"))
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, tw.Close())
+	return buf.Bytes()
+}
+
+func TestExtract_RejectsUnsafeEntries(t *testing.T) {
+	parent := t.TempDir()
+	dest := filepath.Join(parent, "out")
+
+	cases := map[string]*tar.Header{
+		"parent dir":    {Name: "../evil.txt", Typeflag: tar.TypeReg, Mode: 0o644},
+		"nested parent": {Name: "docs/../../evil.txt", Typeflag: tar.TypeReg, Mode: 0o644},
+		"absolute":      {Name: "/tmp/evil.txt", Typeflag: tar.TypeReg, Mode: 0o644},
+		"symlink":       {Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc", Mode: 0o777},
+		"hardlink":      {Name: "hard", Typeflag: tar.TypeLink, Linkname: "/etc/passwd"},
+	}
+	for name, hdr := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := archive.Extract(bytes.NewReader(tarOf(t, hdr)), dest)
+			assert.ErrorIs(t, err, archive.ErrUnsafePath)
+		})
+	}
+	_, err := os.Stat(filepath.Join(parent, "evil.txt"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestExtract_DoesNotFollowSymlinks(t *testing.T) {
+	outside := t.TempDir()
+	dest := t.TempDir()
+	require.NoError(t, os.Symlink(outside, filepath.Join(dest, "docs")))
+
+	data := tarOf(t, &tar.Header{Name: "docs/evil.txt", Typeflag: tar.TypeReg, Mode: 0o644})
+	err := archive.Extract(bytes.NewReader(data), dest)
+	assert.ErrorIs(t, err, archive.ErrUnsafePath)
+
+	_, err = os.Stat(filepath.Join(outside, "evil.txt"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
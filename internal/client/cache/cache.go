@@ -0,0 +1,293 @@
+package cache
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	bolt "go.etcd.io/bbolt"
+)
+
+// LocalIDPrefix — префикс временного идентификатора записи, созданной без связи с сервером.
+const LocalIDPrefix = "local-"
+
+// openTimeout — время ожидания блокировки файла кэша (например, если он
+// открыт другим экземпляром клиента).
+const openTimeout = time.Second
+
+var (
+	bucketItems     = []byte("items")
+	bucketQueue     = []byte("queue")
+	bucketConflicts = []byte("conflicts")
+	bucketMeta      = []byte("meta")
+
+	keyOwner = []byte("owner")
+)
+
+// Cache — локальный зашифрованный кэш записей и очередь офлайн-изменений.
+//
+// Файл открывается лениво — при первом обращении, ключ шифрования
+// запрашивается через loadKey при каждой операции с содержимым.
+// Безопасен для конкурентного использования.
+type Cache struct {
+	path    string
+	loadKey func() ([]byte, error)
+
+	mu sync.Mutex
+	db *bolt.DB
+}
+
+// New создаёт кэш в файле path.
+// loadKey возвращает ключ шифрования пользователя (обычно CryptoKeyManager.LoadKey).
+func New(path string, loadKey func() ([]byte, error)) *Cache {
+	return &Cache{path: path, loadKey: loadKey}
+}
+
+// NewLocalID возвращает временный идентификатор для записи, созданной без связи.
+func NewLocalID() string {
+	return LocalIDPrefix + uuid.NewString()
+}
+
+// IsLocalID сообщает, что идентификатор временный и запись ещё не передана на сервер.
+func IsLocalID(id string) bool {
+	return strings.HasPrefix(id, LocalIDPrefix)
+}
+
+// Close закрывает файл кэша. Повторный вызов безопасен.
+func (c *Cache) Close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.db == nil {
+		return nil
+	}
+	err := c.db.Close()
+	c.db = nil
+	return err
+}
+
+// Bind закрепляет кэш за пользователем owner.
+//
+// Если кэш принадлежал другому пользователю (или был зашифрован другим
+// ключом), всё его содержимое, включая неотправленные изменения, удаляется.
+// Возвращает true, если кэш был очищен.
+func (c *Cache) Bind(owner string) (bool, error) {
+	var cleared bool
+	err := c.update(func(tx *bolt.Tx, key []byte) error {
+		var current string
+		if raw := tx.Bucket(bucketMeta).Get(keyOwner); raw != nil {
+			// Ошибка расшифровки означает, что кэш создан с другим ключом
+			_ = unseal(raw, key, &current)
+		}
+		if current == owner {
+			return nil
+		}
+		if err := resetBuckets(tx); err != nil {
+			return err
+		}
+		cleared = true
+		return putSealed(tx.Bucket(bucketMeta), keyOwner, owner, key)
+	})
+	return cleared, err
+}
+
+// Clear удаляет всё содержимое кэша.
+func (c *Cache) Clear() error {
+	db, err := c.open()
+	if err != nil {
+		return err
+	}
+	return db.Update(resetBuckets)
+}
+
+// Get возвращает запись из кэша. Второе значение равно false, если записи нет.
+func (c *Cache) Get(t model.ItemType, id string) (model.Change, bool, error) {
+	var (
+		item  model.Change
+		found bool
+	)
+	err := c.view(func(tx *bolt.Tx, key []byte) error {
+		raw := tx.Bucket(bucketItems).Get(itemKey(t, id))
+		if raw == nil {
+			return nil
+		}
+		found = true
+		return unseal(raw, key, &item)
+	})
+	return item, found, err
+}
+
+// List возвращает все записи типа t в порядке идентификаторов.
+func (c *Cache) List(t model.ItemType) ([]model.Change, error) {
+	var items []model.Change
+	err := c.view(func(tx *bolt.Tx, key []byte) error {
+		prefix := typePrefix(t)
+		cur := tx.Bucket(bucketItems).Cursor()
+		for k, v := cur.Seek(prefix); k != nil && bytes.HasPrefix(k, prefix); k, v = cur.Next() {
+			var item model.Change
+			if err := unseal(v, key, &item); err != nil {
+				return err
+			}
+			items = append(items, item)
+		}
+		return nil
+	})
+	return items, err
+}
+
+// Put сохраняет актуальное состояние записи, полученное от сервера в ответ на операцию.
+// Надгробие (Deleted) удаляет запись из кэша.
+func (c *Cache) Put(item model.Change) error {
+	return c.update(func(tx *bolt.Tx, key []byte) error {
+		return storeItem(tx, item, key)
+	})
+}
+
+// Remove удаляет запись из кэша.
+func (c *Cache) Remove(t model.ItemType, id string) error {
+	return c.update(func(tx *bolt.Tx, _ []byte) error {
+		return tx.Bucket(bucketItems).Delete(itemKey(t, id))
+	})
+}
+
+// Apply применяет изменения, полученные при синхронизации.
+//
+// Записи, для которых в очереди есть неотправленные изменения, не
+// перезаписываются: их судьбу решит воспроизведение очереди.
+func (c *Cache) Apply(changes ...model.Change) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	return c.update(func(tx *bolt.Tx, key []byte) error {
+		queue := tx.Bucket(bucketQueue)
+		for _, ch := range changes {
+			if queue.Get(itemKey(ch.Type, ch.ItemID)) != nil {
+				continue
+			}
+			if err := storeItem(tx, ch, key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// open открывает файл кэша при первом обращении и создаёт разделы.
+// Родительская директория создаётся с правами 0700, файл — с правами 0600.
+func (c *Cache) open() (*bolt.DB, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.db != nil {
+		return c.db, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0700); err != nil {
+		return nil, err
+	}
+	db, err := bolt.Open(c.path, 0600, &bolt.Options{Timeout: openTimeout})
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, name := range [][]byte{bucketItems, bucketQueue, bucketConflicts, bucketMeta} {
+			if _, err := tx.CreateBucketIfNotExists(name); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	c.db = db
+	return db, nil
+}
+
+// update выполняет fn в транзакции записи, передавая ключ шифрования.
+func (c *Cache) update(fn func(tx *bolt.Tx, key []byte) error) error {
+	db, err := c.open()
+	if err != nil {
+		return err
+	}
+	key, err := c.loadKey()
+	if err != nil {
+		return err
+	}
+	return db.Update(func(tx *bolt.Tx) error { return fn(tx, key) })
+}
+
+// view выполняет fn в транзакции чтения, передавая ключ шифрования.
+func (c *Cache) view(fn func(tx *bolt.Tx, key []byte) error) error {
+	db, err := c.open()
+	if err != nil {
+		return err
+	}
+	key, err := c.loadKey()
+	if err != nil {
+		return err
+	}
+	return db.View(func(tx *bolt.Tx) error { return fn(tx, key) })
+}
+
+// resetBuckets очищает все разделы кэша.
+func resetBuckets(tx *bolt.Tx) error {
+	for _, name := range [][]byte{bucketItems, bucketQueue, bucketConflicts, bucketMeta} {
+		if err := tx.DeleteBucket(name); err != nil && err != bolt.ErrBucketNotFound {
+			return err
+		}
+		if _, err := tx.CreateBucket(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// storeItem сохраняет запись в разделе items или удаляет её, если это надгробие.
+func storeItem(tx *bolt.Tx, item model.Change, key []byte) error {
+	items := tx.Bucket(bucketItems)
+	k := itemKey(item.Type, item.ItemID)
+	if item.Deleted {
+		return items.Delete(k)
+	}
+	return putSealed(items, k, item, key)
+}
+
+// itemKey формирует ключ записи: "<тип>/<идентификатор>".
+func itemKey(t model.ItemType, id string) []byte {
+	return []byte(string(t) + "/" + id)
+}
+
+// typePrefix возвращает общий префикс ключей записей типа t.
+func typePrefix(t model.ItemType) []byte {
+	return []byte(string(t) + "/")
+}
+
+// putSealed сериализует v в JSON, шифрует и сохраняет под ключом k.
+func putSealed(b *bolt.Bucket, k []byte, v any, key []byte) error {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	sealed, err := crypto.EncryptAESGCM(raw, key)
+	if err != nil {
+		return err
+	}
+	return b.Put(k, sealed)
+}
+
+// unseal расшифровывает значение и разбирает JSON в v.
+func unseal(data, key []byte, v any) error {
+	raw, err := crypto.DecryptAESGCM(data, key)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
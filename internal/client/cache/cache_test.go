@@ -0,0 +1,223 @@
+package cache_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCache(t *testing.T, key []byte) (*cache.Cache, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "cache.db")
+	c := cache.New(path, func() ([]byte, error) { return key, nil })
+	t.Cleanup(func() { _ = c.Close() })
+	return c, path
+}
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func credChange(id, title string, version int64) model.Change {
+	return model.Change{
+		Type:       model.ItemTypeCredential,
+		ItemID:     id,
+		Credential: &model.Credential{ID: id, Title: title, Password: "secret-" + title, Version: version},
+	}
+}
+
+func TestCache_PutGetList(t *testing.T) {
+	c, path := newTestCache(t, testKey(1))
+
+	require.NoError(t, c.Put(credChange("c1", "Gmail", 1)))
+	require.NoError(t, c.Put(credChange("c2", "GitHub", 3)))
+	require.NoError(t, c.Put(model.Change{
+		Type:     model.ItemTypeBankCard,
+		ItemID:   "b1",
+		BankCard: &model.BankCard{ID: "b1", Title: "Visa"},
+	}))
+
+	item, ok, err := c.Get(model.ItemTypeCredential, "c2")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "GitHub", item.Credential.Title)
+	assert.Equal(t, int64(3), item.Credential.Version)
+
+	list, err := c.List(model.ItemTypeCredential)
+	require.NoError(t, err)
+	assert.Len(t, list, 2)
+
+	// Надгробие удаляет запись
+	require.NoError(t, c.Put(model.Change{Type: model.ItemTypeCredential, ItemID: "c1", Deleted: true}))
+	_, ok, err = c.Get(model.ItemTypeCredential, "c1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	// Содержимое записей зашифровано
+	require.NoError(t, c.Close())
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "secret-GitHub")
+	assert.NotContains(t, string(raw), "GitHub")
+}
+
+func TestCache_WrongKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cache.db")
+	c := cache.New(path, func() ([]byte, error) { return testKey(1), nil })
+	require.NoError(t, c.Put(credChange("c1", "Gmail", 1)))
+	require.NoError(t, c.Close())
+
+	other := cache.New(path, func() ([]byte, error) { return testKey(2), nil })
+	defer other.Close()
+	_, _, err := other.Get(model.ItemTypeCredential, "c1")
+	assert.Error(t, err)
+}
+
+func TestCache_Bind(t *testing.T) {
+	c, _ := newTestCache(t, testKey(1))
+
+	cleared, err := c.Bind("alice")
+	require.NoError(t, err)
+	assert.True(t, cleared)
+	require.NoError(t, c.Put(credChange("c1", "Gmail", 1)))
+
+	// Повторный вход того же пользователя сохраняет кэш
+	cleared, err = c.Bind("alice")
+	require.NoError(t, err)
+	assert.False(t, cleared)
+	_, ok, _ := c.Get(model.ItemTypeCredential, "c1")
+	assert.True(t, ok)
+
+	// Другой пользователь получает пустой кэш
+	cleared, err = c.Bind("bob")
+	require.NoError(t, err)
+	assert.True(t, cleared)
+	_, ok, _ = c.Get(model.ItemTypeCredential, "c1")
+	assert.False(t, ok)
+}
+
+func TestCache_EnqueueCoalesces(t *testing.T) {
+	c, _ := newTestCache(t, testKey(1))
+	require.NoError(t, c.Put(credChange("c1", "Gmail", 4)))
+
+	// Обновление + обновление = одно обновление с базовой версией первого
+	require.NoError(t, c.Enqueue(cache.OpUpdate, credChange("c1", "Gmail 2", 4)))
+	require.NoError(t, c.Enqueue(cache.OpUpdate, credChange("c1", "Gmail 3", 4)))
+
+	// Создание + обновление = создание
+	localID := cache.NewLocalID()
+	assert.True(t, cache.IsLocalID(localID))
+	require.NoError(t, c.Enqueue(cache.OpCreate, credChange(localID, "New", 0)))
+	require.NoError(t, c.Enqueue(cache.OpUpdate, credChange(localID, "New 2", 0)))
+
+	ops, err := c.Pending()
+	require.NoError(t, err)
+	require.Len(t, ops, 2)
+	assert.Equal(t, cache.OpUpdate, ops[0].Kind)
+	assert.Equal(t, "Gmail 3", ops[0].Item.Credential.Title)
+	assert.Equal(t, int64(4), ops[0].BaseVersion)
+	assert.Equal(t, cache.OpCreate, ops[1].Kind)
+	assert.Equal(t, "New 2", ops[1].Item.Credential.Title)
+
+	// Изменения видны при чтении
+	item, ok, err := c.Get(model.ItemTypeCredential, "c1")
+	require.NoError(t, err)
+	require.True(t, ok)
+	assert.Equal(t, "Gmail 3", item.Credential.Title)
+
+	// Создание + удаление взаимно уничтожаются
+	require.NoError(t, c.Enqueue(cache.OpDelete, model.Change{Type: model.ItemTypeCredential, ItemID: localID}))
+	_, ok, _ = c.Get(model.ItemTypeCredential, localID)
+	assert.False(t, ok)
+
+	// Обновление + удаление = удаление
+	require.NoError(t, c.Enqueue(cache.OpDelete, model.Change{Type: model.ItemTypeCredential, ItemID: "c1"}))
+	ops, err = c.Pending()
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, cache.OpDelete, ops[0].Kind)
+	assert.True(t, ops[0].Item.Deleted)
+	assert.Equal(t, int64(4), ops[0].BaseVersion)
+}
+
+func TestCache_DeleteTakesVersionFromCache(t *testing.T) {
+	c, _ := newTestCache(t, testKey(1))
+	require.NoError(t, c.Put(credChange("c1", "Gmail", 7)))
+
+	require.NoError(t, c.Enqueue(cache.OpDelete, model.Change{Type: model.ItemTypeCredential, ItemID: "c1"}))
+
+	ops, err := c.Pending()
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	assert.Equal(t, int64(7), ops[0].BaseVersion)
+}
+
+func TestCache_ApplySkipsPending(t *testing.T) {
+	c, _ := newTestCache(t, testKey(1))
+	require.NoError(t, c.Enqueue(cache.OpUpdate, credChange("c1", "Local", 2)))
+
+	require.NoError(t, c.Apply(credChange("c1", "Server", 3), credChange("c2", "Other", 1)))
+
+	item, _, err := c.Get(model.ItemTypeCredential, "c1")
+	require.NoError(t, err)
+	assert.Equal(t, "Local", item.Credential.Title)
+	_, ok, _ := c.Get(model.ItemTypeCredential, "c2")
+	assert.True(t, ok)
+}
+
+func TestCache_CompleteCreate(t *testing.T) {
+	c, _ := newTestCache(t, testKey(1))
+	localID := cache.NewLocalID()
+	require.NoError(t, c.Enqueue(cache.OpCreate, credChange(localID, "New", 0)))
+
+	has, err := c.HasPending()
+	require.NoError(t, err)
+	assert.True(t, has)
+
+	ops, err := c.Pending()
+	require.NoError(t, err)
+	require.NoError(t, c.Complete(ops[0], credChange("srv-1", "New", 1)))
+
+	has, err = c.HasPending()
+	require.NoError(t, err)
+	assert.False(t, has)
+	_, ok, _ := c.Get(model.ItemTypeCredential, localID)
+	assert.False(t, ok)
+	item, ok, _ := c.Get(model.ItemTypeCredential, "srv-1")
+	require.True(t, ok)
+	assert.Equal(t, int64(1), item.Credential.Version)
+}
+
+func TestCache_Conflicts(t *testing.T) {
+	c, _ := newTestCache(t, testKey(1))
+	require.NoError(t, c.Enqueue(cache.OpUpdate, credChange("c1", "Local", 2)))
+	ops, err := c.Pending()
+	require.NoError(t, err)
+
+	require.NoError(t, c.AddConflict(ops[0], credChange("c1", "Server", 3)))
+
+	ops, err = c.Pending()
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	// В кэше остаётся серверная версия, изменение пользователя — в конфликте
+	item, _, _ := c.Get(model.ItemTypeCredential, "c1")
+	assert.Equal(t, "Server", item.Credential.Title)
+
+	conflicts, err := c.Conflicts()
+	require.NoError(t, err)
+	require.Len(t, conflicts, 1)
+	assert.Equal(t, "Local", conflicts[0].Local.Credential.Title)
+	assert.Equal(t, "Server", conflicts[0].Server.Credential.Title)
+
+	require.NoError(t, c.ResolveConflict(model.ItemTypeCredential, "c1"))
+	conflicts, err = c.Conflicts()
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+}
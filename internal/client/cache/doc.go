@@ -0,0 +1,25 @@
+// Package cache реализует локальный зашифрованный кэш записей клиента GophKeeper,
+// позволяющий работать без связи с сервером.
+//
+// Кэш хранится в одном файле bbolt (по умолчанию рядом с файлом ключа,
+// см. paths.DefaultCacheFilePath) и состоит из четырёх разделов:
+//
+//   - items — последние известные версии записей всех типов;
+//   - queue — очередь изменений, сделанных без связи с сервером;
+//   - conflicts — конфликты версий, обнаруженные при воспроизведении очереди;
+//   - meta — служебные данные (владелец кэша).
+//
+// Значения сериализуются в JSON и целиком шифруются AES-GCM ключом пользователя
+// (тем же, которым шифруются данные для сервера), поэтому файл кэша без ключа
+// не раскрывает ни содержимого, ни заголовков записей. Ключи разделов —
+// тип и идентификатор записи — хранятся в открытом виде.
+//
+// Очередь содержит не более одной операции на запись: последовательные
+// изменения одной записи объединяются (например, создание и последующее
+// обновление дают одно создание, а создание и удаление взаимно уничтожаются).
+// Записи, созданные без связи, получают временный идентификатор с префиксом
+// LocalIDPrefix до тех пор, пока сервер не присвоит постоянный.
+//
+// Воспроизведение очереди и разрешение конфликтов выполняет прикладной слой
+// (internal/client/app); пакет cache отвечает только за хранение.
+package cache
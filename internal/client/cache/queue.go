@@ -0,0 +1,225 @@
+package cache
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	bolt "go.etcd.io/bbolt"
+)
+
+// OpKind — вид изменения, ожидающего отправки на сервер.
+type OpKind string
+
+const (
+	// OpCreate — создание записи.
+	OpCreate OpKind = "create"
+	// OpUpdate — обновление записи.
+	OpUpdate OpKind = "update"
+	// OpDelete — удаление записи.
+	OpDelete OpKind = "delete"
+)
+
+// PendingOp — изменение, сделанное без связи с сервером.
+//
+// Item содержит запись в открытом виде (для удаления — только тип и
+// идентификатор). BaseVersion — версия записи на сервере, на основе которой
+// сделано изменение; при воспроизведении она сравнивается с текущей версией
+// на сервере для обнаружения конфликтов.
+type PendingOp struct {
+	Seq         uint64       // Порядковый номер в очереди
+	Kind        OpKind       // Вид изменения
+	Item        model.Change // Изменённая запись
+	BaseVersion int64        // Версия записи, которую видел пользователь
+	QueuedAt    time.Time    // Время постановки в очередь
+}
+
+// Conflict — конфликт версий, обнаруженный при воспроизведении очереди:
+// запись была изменена на сервере (или удалена) после того, как пользователь
+// изменил её без связи.
+//
+// Local — изменение пользователя (для удаления — надгробие), Server —
+// текущее состояние записи на сервере (надгробие, если запись удалена).
+type Conflict struct {
+	Type       model.ItemType
+	ItemID     string
+	Local      model.Change
+	Server     model.Change
+	DetectedAt time.Time
+}
+
+// Enqueue ставит изменение записи в очередь и сразу применяет его к кэшу,
+// чтобы оно было видно при чтении без связи.
+//
+// Изменения одной записи объединяются в одну операцию:
+//   - создание + обновление — создание с новым содержимым;
+//   - создание + удаление — операция удаляется из очереди;
+//   - обновление + обновление — обновление с новым содержимым;
+//   - обновление + удаление — удаление.
+//
+// Базовая версия сохраняется от первого изменения записи.
+func (c *Cache) Enqueue(kind OpKind, item model.Change) error {
+	return c.update(func(tx *bolt.Tx, key []byte) error {
+		queue := tx.Bucket(bucketQueue)
+		k := itemKey(item.Type, item.ItemID)
+
+		op := PendingOp{Kind: kind, Item: item, QueuedAt: time.Now()}
+		if raw := queue.Get(k); raw != nil {
+			var prev PendingOp
+			if err := unseal(raw, key, &prev); err != nil {
+				return err
+			}
+			op.Seq = prev.Seq
+			op.BaseVersion = prev.BaseVersion
+			if prev.Kind == OpCreate {
+				if kind == OpDelete {
+					if err := queue.Delete(k); err != nil {
+						return err
+					}
+					return tx.Bucket(bucketItems).Delete(k)
+				}
+				op.Kind = OpCreate
+			}
+		} else {
+			seq, err := queue.NextSequence()
+			if err != nil {
+				return err
+			}
+			op.Seq = seq
+			op.BaseVersion = ItemVersion(item)
+			if kind == OpDelete {
+				// У надгробия нет версии — берём её из кэшированной записи
+				if raw := tx.Bucket(bucketItems).Get(k); raw != nil {
+					var cached model.Change
+					if err := unseal(raw, key, &cached); err != nil {
+						return err
+					}
+					op.BaseVersion = ItemVersion(cached)
+				}
+			}
+		}
+
+		if kind == OpDelete {
+			op.Item = model.Change{Type: item.Type, ItemID: item.ItemID, Deleted: true, ChangedAt: op.QueuedAt}
+		}
+		if err := storeItem(tx, op.Item, key); err != nil {
+			return err
+		}
+		return putSealed(queue, k, op, key)
+	})
+}
+
+// Pending возвращает неотправленные изменения в порядке постановки в очередь.
+func (c *Cache) Pending() ([]PendingOp, error) {
+	var ops []PendingOp
+	err := c.view(func(tx *bolt.Tx, key []byte) error {
+		return tx.Bucket(bucketQueue).ForEach(func(_, v []byte) error {
+			var op PendingOp
+			if err := unseal(v, key, &op); err != nil {
+				return err
+			}
+			ops = append(ops, op)
+			return nil
+		})
+	})
+	sort.Slice(ops, func(i, j int) bool { return ops[i].Seq < ops[j].Seq })
+	return ops, err
+}
+
+// HasPending сообщает, есть ли в очереди неотправленные изменения.
+// Не требует ключа шифрования.
+func (c *Cache) HasPending() (bool, error) {
+	db, err := c.open()
+	if err != nil {
+		return false, err
+	}
+	var has bool
+	err = db.View(func(tx *bolt.Tx) error {
+		k, _ := tx.Bucket(bucketQueue).Cursor().First()
+		has = k != nil
+		return nil
+	})
+	return has, err
+}
+
+// Complete удаляет из очереди успешно отправленное изменение и сохраняет
+// состояние записи, которое вернул сервер. Для созданной записи result
+// содержит постоянный идентификатор, и запись с временным идентификатором
+// удаляется из кэша.
+func (c *Cache) Complete(op PendingOp, result model.Change) error {
+	return c.update(func(tx *bolt.Tx, key []byte) error {
+		k := itemKey(op.Item.Type, op.Item.ItemID)
+		if err := tx.Bucket(bucketQueue).Delete(k); err != nil {
+			return err
+		}
+		if result.ItemID != op.Item.ItemID {
+			if err := tx.Bucket(bucketItems).Delete(k); err != nil {
+				return err
+			}
+		}
+		return storeItem(tx, result, key)
+	})
+}
+
+// AddConflict удаляет изменение из очереди и сохраняет конфликт с текущим
+// состоянием записи на сервере. В кэше остаётся серверная версия записи;
+// изменение пользователя доступно через Conflicts до разрешения конфликта.
+func (c *Cache) AddConflict(op PendingOp, server model.Change) error {
+	return c.update(func(tx *bolt.Tx, key []byte) error {
+		k := itemKey(op.Item.Type, op.Item.ItemID)
+		if err := tx.Bucket(bucketQueue).Delete(k); err != nil {
+			return err
+		}
+		server.Type, server.ItemID = op.Item.Type, op.Item.ItemID
+		if err := storeItem(tx, server, key); err != nil {
+			return err
+		}
+		conflict := Conflict{
+			Type:       op.Item.Type,
+			ItemID:     op.Item.ItemID,
+			Local:      op.Item,
+			Server:     server,
+			DetectedAt: time.Now(),
+		}
+		return putSealed(tx.Bucket(bucketConflicts), k, conflict, key)
+	})
+}
+
+// Conflicts возвращает неразрешённые конфликты версий.
+func (c *Cache) Conflicts() ([]Conflict, error) {
+	var conflicts []Conflict
+	err := c.view(func(tx *bolt.Tx, key []byte) error {
+		return tx.Bucket(bucketConflicts).ForEach(func(_, v []byte) error {
+			var conflict Conflict
+			if err := unseal(v, key, &conflict); err != nil {
+				return err
+			}
+			conflicts = append(conflicts, conflict)
+			return nil
+		})
+	})
+	sort.Slice(conflicts, func(i, j int) bool { return conflicts[i].DetectedAt.Before(conflicts[j].DetectedAt) })
+	return conflicts, err
+}
+
+// ResolveConflict удаляет конфликт записи после его разрешения.
+func (c *Cache) ResolveConflict(t model.ItemType, id string) error {
+	return c.update(func(tx *bolt.Tx, _ []byte) error {
+		return tx.Bucket(bucketConflicts).Delete(itemKey(t, id))
+	})
+}
+
+// ItemVersion возвращает версию записи, содержащейся в изменении (0 — неизвестна).
+func ItemVersion(item model.Change) int64 {
+	switch {
+	case item.Credential != nil:
+		return item.Credential.Version
+	case item.BankCard != nil:
+		return item.BankCard.Version
+	case item.TextData != nil:
+		return item.TextData.Version
+	case item.BinaryData != nil:
+		return item.BinaryData.Version
+	}
+	return 0
+}
@@ -0,0 +1,94 @@
+// Package chunker разбивает поток на фрагменты переменной длины, границы
+// которых определяются содержимым (content-defined chunking, gear-хеш).
+//
+// Вставка или удаление байт в начале файла сдвигает лишь ближайшие границы,
+// поэтому остальные фрагменты изменённого файла совпадают с фрагментами
+// прежней версии. На этом основана дедупликация при загрузке файлов:
+// клиент отправляет на сервер только фрагменты, которых там ещё нет.
+package chunker
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	// MinSize — минимальный размер фрагмента (кроме последнего).
+	MinSize = 256 << 10
+	// MaxSize — максимальный размер фрагмента.
+	MaxSize = 2 << 20
+
+	// cutMask задаёт вероятность границы 1/2^20 после MinSize байт, что даёт
+	// фрагменты в среднем около 1 МиБ. Проверяются старшие биты хеша: в них
+	// учтено больше байт окна.
+	cutMask = uint64(1<<20-1) << 44
+)
+
+// gear — таблица случайных 64-битных значений для каждого байта. Таблица
+// фиксирована: от неё зависят границы фрагментов, а значит, и дедупликация
+// между версиями клиента.
+var gear = gearTable(0x676f70686b656570)
+
+// gearTable заполняет таблицу генератором splitmix64.
+func gearTable(seed uint64) [256]uint64 {
+	var table [256]uint64
+	for i := range table {
+		seed += 0x9e3779b97f4a7c15
+		z := seed
+		z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+		z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+		table[i] = z ^ (z >> 31)
+	}
+	return table
+}
+
+// Chunker последовательно выделяет фрагменты из потока.
+type Chunker struct {
+	r          io.Reader
+	buf        []byte
+	start, end int
+	eof        bool
+}
+
+// New создаёт Chunker для чтения из r.
+func New(r io.Reader) *Chunker {
+	return &Chunker{r: r, buf: make([]byte, MaxSize)}
+}
+
+// Next возвращает следующий фрагмент или io.EOF, когда поток исчерпан.
+// Возвращаемый срез действителен до следующего вызова Next.
+func (c *Chunker) Next() ([]byte, error) {
+	// Переносим непрочитанный остаток в начало буфера и дочитываем поток
+	c.end = copy(c.buf, c.buf[c.start:c.end])
+	c.start = 0
+	if !c.eof && c.end < len(c.buf) {
+		n, err := io.ReadFull(c.r, c.buf[c.end:])
+		c.end += n
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			c.eof = true
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	if c.end == 0 {
+		return nil, io.EOF
+	}
+
+	c.start = cut(c.buf[:c.end])
+	return c.buf[:c.start], nil
+}
+
+// cut возвращает длину первого фрагмента data.
+func cut(data []byte) int {
+	if len(data) <= MinSize {
+		return len(data)
+	}
+	var h uint64
+	for i := MinSize; i < len(data); i++ {
+		h = (h << 1) + gear[data[i]]
+		if h&cutMask == 0 {
+			return i + 1
+		}
+	}
+	return len(data)
+}
@@ -0,0 +1,71 @@
+package chunker_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/chunker"
+)
+
+// split возвращает хеши фрагментов data и проверяет ограничения размеров.
+func split(t *testing.T, data []byte) [][32]byte {
+	t.Helper()
+	c := chunker.New(bytes.NewReader(data))
+	var (
+		sums   [][32]byte
+		joined []byte
+	)
+	for {
+		chunk, err := c.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(chunk), chunker.MaxSize)
+		if len(joined)+len(chunk) < len(data) {
+			require.GreaterOrEqual(t, len(chunk), chunker.MinSize)
+		}
+		joined = append(joined, chunk...)
+		sums = append(sums, sha256.Sum256(chunk))
+	}
+	require.Equal(t, data, joined)
+	return sums
+}
+
+func TestChunker_SplitsByContent(t *testing.T) {
+	data := make([]byte, 16<<20)
+	rand.New(rand.NewSource(1)).Read(data)
+
+	sums := split(t, data)
+	assert.Greater(t, len(sums), 4)
+	assert.Equal(t, sums, split(t, data), "boundaries must be deterministic")
+
+	// Вставка в начало файла меняет только первые фрагменты
+	shifted := append([]byte("inserted bytes"), data...)
+	known := make(map[[32]byte]bool, len(sums))
+	for _, s := range sums {
+		known[s] = true
+	}
+	var reused int
+	for _, s := range split(t, shifted) {
+		if known[s] {
+			reused++
+		}
+	}
+	assert.GreaterOrEqual(t, reused, len(sums)-2)
+}
+
+func TestChunker_SmallAndEmpty(t *testing.T) {
+	assert.Empty(t, split(t, nil))
+	assert.Len(t, split(t, []byte("small file")), 1)
+
+	// Однородные данные без границ режутся по MaxSize
+	sums := split(t, make([]byte, 5<<20))
+	assert.Len(t, sums, 3)
+}
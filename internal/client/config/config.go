@@ -16,6 +16,7 @@
 //   - Структуру ClientConfig — описание всех параметров клиента
 //   - Логику слияния конфигураций из разных источников (mergeConfigs)
 //   - Проверку корректности адреса сервера и других параметров (validateServerAddress)
+//   - Персональный режим со встроенным сервером (LocalMode, applyLocalMode)
 //   - Обработку флагов и переменных окружения
 //   - Поддержку пользовательских путей к ключам, токенам и логам через пакет internal/client/paths
 //
@@ -30,6 +31,7 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -69,13 +71,42 @@ type ClientConfig struct {
 	// TokenFilePath — путь к файлу, в котором хранится токен аутентификации.
 	TokenFilePath string `json:"token_file_path" env:"TOKEN_FILE_PATH"`
 
+	// SyncCursorFilePath — путь к файлу с курсором разностной синхронизации.
+	SyncCursorFilePath string `json:"sync_cursor_file_path" env:"SYNC_CURSOR_FILE_PATH"`
+
+	// CacheFilePath — путь к файлу локального зашифрованного кэша записей.
+	CacheFilePath string `json:"cache_file_path" env:"CACHE_FILE_PATH"`
+
 	// LogDirPath — путь к директории для хранения логов клиента.
 	LogDirPath string `json:"log_dir_path" env:"LOG_DIR_PATH"`
+
+	// TransferConcurrency — число одновременно выполняемых передач файлов в TUI.
+	TransferConcurrency int `json:"transfer_concurrency" env:"TRANSFER_CONCURRENCY"`
+
+	// LocalMode — персональный режим: клиент запускает сервер в своём процессе
+	// (база SQLite и файлы в LocalDataDir) и не подключается к ServerAddress.
+	LocalMode bool `json:"local_mode" env:"LOCAL_MODE"`
+
+	// LocalDataDir — директория данных персонального режима. В ней же хранятся
+	// токен, ключ, курсор синхронизации и кэш клиента в этом режиме, чтобы они
+	// не смешивались с данными работы с отдельным сервером.
+	LocalDataDir string `json:"local_data_dir" env:"LOCAL_DATA_DIR"`
+
+	// ExportPath — выгрузить записи пользователя в файл экспорта и завершить
+	// работу без запуска TUI.
+	ExportPath string `json:"-"`
+
+	// ImportPath — загрузить записи из файла экспорта и завершить работу
+	// без запуска TUI.
+	ImportPath string `json:"-"`
 }
 
 const (
 	minDynamicPort = 49152
 	maxPort        = 65535
+
+	// defaultTransferConcurrency — число одновременных передач файлов по умолчанию.
+	defaultTransferConcurrency = 3
 )
 
 // DefaultConfig возвращает конфигурацию по умолчанию
@@ -91,21 +122,41 @@ func DefaultConfig() (*ClientConfig, error) {
 		return nil, fmt.Errorf("failed to get default token file path: %w", err)
 	}
 
+	syncCursorPath, err := paths.DefaultSyncCursorFilePath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default sync cursor file path: %w", err)
+	}
+
+	cachePath, err := paths.DefaultCacheFilePath()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default cache file path: %w", err)
+	}
+
+	localDataDir, err := paths.DefaultLocalDataDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get default local data dir path: %w", err)
+	}
+
 	logDirPath, err := paths.DefaultLogDir()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get default log dir path: %w", err)
 	}
 
 	return &ClientConfig{
-		ServerAddress: "localhost:50051",
-		UseTLS:        false,
-		TLSSkipVerify: false,
-		CACertPath:    "certs/ca.crt",
-		Timeout:       10 * time.Second,
-		LogLevel:      "info",
-		KeyFilePath:   keyPath,
-		TokenFilePath: tokenPath,
-		LogDirPath:    logDirPath,
+		ServerAddress:      "localhost:50051",
+		UseTLS:             false,
+		TLSSkipVerify:      false,
+		CACertPath:         "certs/ca.crt",
+		Timeout:            10 * time.Second,
+		LogLevel:           "info",
+		KeyFilePath:        keyPath,
+		TokenFilePath:      tokenPath,
+		LogDirPath:         logDirPath,
+		SyncCursorFilePath: syncCursorPath,
+		CacheFilePath:      cachePath,
+		LocalDataDir:       localDataDir,
+
+		TransferConcurrency: defaultTransferConcurrency,
 	}, nil
 }
 
@@ -140,6 +191,18 @@ func Load() (*ClientConfig, error) {
 		return nil, fmt.Errorf("failed to load env vars: %w", err)
 	}
 
+	if cfg.ExportPath != "" && cfg.ImportPath != "" {
+		return nil, errors.New("export and import cannot be run together")
+	}
+
+	// В персональном режиме сервер работает в процессе клиента
+	if cfg.LocalMode {
+		if err := applyLocalMode(cfg); err != nil {
+			return nil, err
+		}
+		return cfg, nil
+	}
+
 	// Валидация
 	if err := validateServerAddress(cfg.ServerAddress); err != nil {
 		return nil, fmt.Errorf("server address validation failed: %w", err)
@@ -154,6 +217,21 @@ func Load() (*ClientConfig, error) {
 	return cfg, nil
 }
 
+// applyLocalMode настраивает клиент на работу со встроенным сервером:
+// сетевые параметры не используются, а токен, ключ, курсор синхронизации
+// и кэш хранятся в LocalDataDir.
+func applyLocalMode(cfg *ClientConfig) error {
+	if cfg.LocalDataDir == "" {
+		return errors.New("local data dir cannot be empty")
+	}
+	cfg.UseTLS = false
+	cfg.TokenFilePath = filepath.Join(cfg.LocalDataDir, ".token")
+	cfg.KeyFilePath = filepath.Join(cfg.LocalDataDir, "key.json")
+	cfg.SyncCursorFilePath = filepath.Join(cfg.LocalDataDir, "sync_cursor")
+	cfg.CacheFilePath = filepath.Join(cfg.LocalDataDir, "cache.db")
+	return nil
+}
+
 // Валидация адреса сервера
 func validateServerAddress(addr string) error {
 	if addr == "" {
@@ -214,6 +292,9 @@ func loadFromJSON(path string) (*ClientConfig, error) {
 	if cfg.Timeout < 0 {
 		return nil, errors.New("timeout cannot be negative")
 	}
+	if cfg.TransferConcurrency < 0 {
+		return nil, errors.New("transfer concurrency cannot be negative")
+	}
 	return &cfg, nil
 }
 
@@ -236,6 +317,15 @@ func mergeConfigs(dst, src *ClientConfig) {
 	if src.LogLevel != "" {
 		dst.LogLevel = src.LogLevel
 	}
+	if src.TransferConcurrency != 0 {
+		dst.TransferConcurrency = src.TransferConcurrency
+	}
+	if src.LocalMode {
+		dst.LocalMode = src.LocalMode
+	}
+	if src.LocalDataDir != "" {
+		dst.LocalDataDir = src.LocalDataDir
+	}
 }
 
 func loadFromFlags(cfg *ClientConfig) error {
@@ -258,6 +348,19 @@ func loadFromFlags(cfg *ClientConfig) error {
 	flagset.StringVar(&cfg.CACertPath, "ca-cert", cfg.CACertPath, "Path to CA certificate")
 	flagset.DurationVar(&cfg.Timeout, "timeout", cfg.Timeout, "Connection timeout")
 	flagset.StringVar(&cfg.LogLevel, "log-level", cfg.LogLevel, "Logging level")
+	flagset.Func("transfers", "Number of concurrent file transfers", func(s string) error {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 {
+			validationErr = fmt.Errorf("invalid transfers value: %q", s)
+			return validationErr
+		}
+		cfg.TransferConcurrency = n
+		return nil
+	})
+	flagset.BoolVar(&cfg.LocalMode, "local", cfg.LocalMode, "Personal mode: run the server inside the client")
+	flagset.StringVar(&cfg.LocalDataDir, "local-dir", cfg.LocalDataDir, "Data directory for personal mode")
+	flagset.StringVar(&cfg.ExportPath, "export", cfg.ExportPath, "Export records to file and exit")
+	flagset.StringVar(&cfg.ImportPath, "import", cfg.ImportPath, "Import records from export file and exit")
 	flagset.StringVar(&cfg.ConfigPath, "config", cfg.ConfigPath, "Path to config file")
 	flagset.StringVar(&cfg.ConfigPath, "c", cfg.ConfigPath, "Path to config file (shorthand)")
 
@@ -312,6 +415,26 @@ func loadFromEnv(cfg *ClientConfig) error {
 		cfg.LogLevel = val
 	}
 
+	if val := os.Getenv("LOCAL_MODE"); val != "" {
+		v, err := strconv.ParseBool(val)
+		if err != nil {
+			return fmt.Errorf("invalid LOCAL_MODE value: %w", err)
+		}
+		cfg.LocalMode = v
+	}
+
+	if val := os.Getenv("LOCAL_DATA_DIR"); val != "" {
+		cfg.LocalDataDir = val
+	}
+
+	if val := os.Getenv("TRANSFER_CONCURRENCY"); val != "" {
+		n, err := strconv.Atoi(val)
+		if err != nil || n <= 0 {
+			return fmt.Errorf("invalid TRANSFER_CONCURRENCY value: %q", val)
+		}
+		cfg.TransferConcurrency = n
+	}
+
 	return nil
 }
 
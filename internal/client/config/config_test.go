@@ -23,6 +23,7 @@ func TestConfig(t *testing.T) {
 		require.Equal(t, "certs/ca.crt", cfg.CACertPath)
 		require.Equal(t, 10*time.Second, cfg.Timeout)
 		require.Equal(t, "info", cfg.LogLevel)
+		require.Equal(t, 3, cfg.TransferConcurrency)
 	})
 
 	t.Run("JSON config", func(t *testing.T) {
@@ -87,6 +88,28 @@ func TestConfig(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("Transfer concurrency", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("transfers", flag.PanicOnError)
+		os.Args = []string{"cmd", "-transfers=5"}
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.Equal(t, 5, cfg.TransferConcurrency)
+
+		t.Setenv("TRANSFER_CONCURRENCY", "2")
+		cfg, err = Load()
+		require.NoError(t, err)
+		require.Equal(t, 2, cfg.TransferConcurrency)
+
+		t.Setenv("TRANSFER_CONCURRENCY", "0")
+		_, err = Load()
+		require.Error(t, err)
+
+		os.Args = []string{"cmd", "-transfers=-1"}
+		_, err = Load()
+		require.Error(t, err)
+	})
+
 	t.Run("Flag override", func(t *testing.T) {
 		flag.CommandLine = flag.NewFlagSet("flags", flag.PanicOnError)
 		os.Args = []string{"cmd",
@@ -105,6 +128,49 @@ func TestConfig(t *testing.T) {
 		require.Equal(t, "error", cfg.LogLevel)
 	})
 
+	t.Run("Local mode", func(t *testing.T) {
+		dir := t.TempDir()
+		flag.CommandLine = flag.NewFlagSet("local", flag.PanicOnError)
+		// Адрес сервера и TLS в персональном режиме не используются
+		os.Args = []string{"cmd", "-local", "-local-dir", dir, "-tls=true", "-ca-cert=nonexistent.crt"}
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.True(t, cfg.LocalMode)
+		require.False(t, cfg.UseTLS)
+		require.Equal(t, dir, cfg.LocalDataDir)
+		require.Equal(t, filepath.Join(dir, ".token"), cfg.TokenFilePath)
+		require.Equal(t, filepath.Join(dir, "key.json"), cfg.KeyFilePath)
+		require.Equal(t, filepath.Join(dir, "sync_cursor"), cfg.SyncCursorFilePath)
+		require.Equal(t, filepath.Join(dir, "cache.db"), cfg.CacheFilePath)
+
+		os.Args = []string{"cmd"}
+		t.Setenv("LOCAL_MODE", "true")
+		t.Setenv("LOCAL_DATA_DIR", dir)
+		cfg, err = Load()
+		require.NoError(t, err)
+		require.True(t, cfg.LocalMode)
+		require.Equal(t, filepath.Join(dir, ".token"), cfg.TokenFilePath)
+
+		t.Setenv("LOCAL_MODE", "maybe")
+		_, err = Load()
+		require.Error(t, err)
+	})
+
+	t.Run("Export and import", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("export", flag.PanicOnError)
+		os.Args = []string{"cmd", "-export", "vault.gkx"}
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.Equal(t, "vault.gkx", cfg.ExportPath)
+		require.Empty(t, cfg.ImportPath)
+
+		os.Args = []string{"cmd", "-export", "a.gkx", "-import", "b.gkx"}
+		_, err = Load()
+		require.Error(t, err)
+	})
+
 	t.Run("validateServerAddress", func(t *testing.T) {
 		cases := []struct {
 			addr    string
@@ -142,6 +208,10 @@ func TestConfig(t *testing.T) {
 			TLSSkipVerify: true,
 			LogLevel:      "debug",
 			Timeout:       20 * time.Second,
+
+			TransferConcurrency: 4,
+			LocalMode:           true,
+			LocalDataDir:        "/data/local",
 		}
 
 		mergeConfigs(dst, src)
@@ -151,6 +221,9 @@ func TestConfig(t *testing.T) {
 		require.True(t, dst.TLSSkipVerify)
 		require.Equal(t, "debug", dst.LogLevel)
 		require.Equal(t, 20*time.Second, dst.Timeout)
+		require.Equal(t, 4, dst.TransferConcurrency)
+		require.True(t, dst.LocalMode)
+		require.Equal(t, "/data/local", dst.LocalDataDir)
 	})
 
 	t.Run("CA cert validation", func(t *testing.T) {
@@ -34,6 +34,11 @@ type Config struct {
 
 	// ConnectTimeout — максимальное время ожидания установления соединения.
 	ConnectTimeout time.Duration
+
+	// Dialer — функция установки соединения вместо сетевого подключения
+	// к ServerAddress (например, с сервером, встроенным в процесс клиента).
+	// nil — обычное подключение по сети.
+	Dialer func(ctx context.Context, addr string) (net.Conn, error)
 }
 
 // ConnManager определяет интерфейс для менеджера подключений к gRPC серверу.
@@ -187,8 +192,15 @@ func (m *Manager) createConnection(ctx context.Context) (GrpcConn, error) {
 		NewAuthPerRPCCredentials(m.authManager, m.logger, m.config.UseTLS),
 	))
 
-	m.logger.Debug("Dialing gRPC server", zap.String("address", m.config.ServerAddress))
-	conn, err := m.dialFunc(m.config.ServerAddress, dialOpts...)
+	target := m.config.ServerAddress
+	if m.config.Dialer != nil {
+		// Адрес передаётся в Dialer как есть, без разрешения имён
+		dialOpts = append(dialOpts, grpc.WithContextDialer(m.config.Dialer))
+		target = "passthrough:///" + target
+	}
+
+	m.logger.Debug("Dialing gRPC server", zap.String("address", target))
+	conn, err := m.dialFunc(target, dialOpts...)
 	if err != nil {
 		m.logger.Error("Failed to dial gRPC server", zap.Error(err))
 		return nil, err
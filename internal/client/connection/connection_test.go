@@ -3,6 +3,7 @@ package connection
 import (
 	"context"
 	"errors"
+	"net"
 	"testing"
 	"time"
 
@@ -81,6 +82,29 @@ func TestConnect_Success(t *testing.T) {
 	require.NotNil(t, conn)
 }
 
+func TestConnect_CustomDialer(t *testing.T) {
+	cfg := &Config{
+		ServerAddress: "embedded",
+		Dialer: func(ctx context.Context, addr string) (net.Conn, error) {
+			return nil, errors.New("not used")
+		},
+	}
+	mgr := New(cfg, zap.NewNop(), &mockAuthManager{})
+
+	var gotTarget string
+	var gotOpts int
+	mgr.dialFunc = func(target string, opts ...grpc.DialOption) (GrpcConn, error) {
+		gotTarget, gotOpts = target, len(opts)
+		return &fakeConn{}, nil
+	}
+
+	_, err := mgr.Connect(context.Background())
+	require.NoError(t, err)
+	// Адрес не разрешается через DNS, соединение устанавливает Dialer
+	require.Equal(t, "passthrough:///embedded", gotTarget)
+	require.Equal(t, 3, gotOpts)
+}
+
 func TestConnect_DialError(t *testing.T) {
 	logger := zap.NewNop()
 	cfg := &Config{
@@ -0,0 +1,120 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+// chunkIDLabel — метка для вывода ключа, на котором вычисляются
+// идентификаторы фрагментов файлов. Отделяет его от ключа шифрования данных.
+const chunkIDLabel = "gophkeeper/chunk-id"
+
+// ChunkOverhead — на сколько байт зашифрованный без сжатия фрагмент длиннее
+// исходного (nonce и тег аутентификации AES-GCM).
+const ChunkOverhead = 12 + 16
+
+// ChunkID возвращает идентификатор фрагмента plain: HMAC-SHA256 от его
+// содержимого на производном от key ключе в шестнадцатеричном виде.
+// Одинаковые фрагменты одного пользователя получают одинаковый
+// идентификатор, а сервер не может проверить догадку о содержимом, не зная ключа.
+func ChunkID(plain, key []byte) string {
+	return hex.EncodeToString(chunkMAC(plain, key))
+}
+
+// chunkMAC вычисляет HMAC фрагмента на производном ключе.
+func chunkMAC(plain, key []byte) []byte {
+	kdf := hmac.New(sha256.New, key)
+	kdf.Write([]byte(chunkIDLabel))
+	mac := hmac.New(sha256.New, kdf.Sum(nil))
+	mac.Write(plain)
+	return mac.Sum(nil)
+}
+
+// EncryptChunk детерминированно шифрует фрагмент файла и возвращает его
+// идентификатор и шифртекст вида [nonce][ct]. Nonce берётся из HMAC
+// фрагмента, поэтому одинаковые фрагменты дают одинаковый шифртекст и
+// хранятся на сервере один раз. Идентификатор входит в шифртекст как
+// дополнительные данные AES-GCM, и подменить фрагмент под чужим
+// идентификатором нельзя.
+func EncryptChunk(plain, key []byte) (string, []byte, error) {
+	gcm, err := chunkGCM(key)
+	if err != nil {
+		return "", nil, err
+	}
+	mac := chunkMAC(plain, key)
+	id := hex.EncodeToString(mac)
+	nonce := mac[:gcm.NonceSize()]
+	return id, gcm.Seal(append([]byte(nil), nonce...), nonce, plain, []byte(id)), nil
+}
+
+// EncryptChunkCompressed шифрует фрагмент так же, как EncryptChunk, но
+// предварительно сжимает его zstd, если это уменьшает размер. Nonce сжатого
+// фрагмента берётся из другой части HMAC, поэтому одни и те же данные в
+// разном виде не шифруются на одном nonce, а DecryptChunk различает их по
+// nonce. Идентификатор от сжатия не зависит: сервер хранит одну копию
+// фрагмента в любом из видов.
+func EncryptChunkCompressed(plain, key []byte) (string, []byte, error) {
+	z := compress(plain)
+	if z == nil {
+		return EncryptChunk(plain, key)
+	}
+	gcm, err := chunkGCM(key)
+	if err != nil {
+		return "", nil, err
+	}
+	mac := chunkMAC(plain, key)
+	id := hex.EncodeToString(mac)
+	nonce := compressedChunkNonce(mac, gcm.NonceSize())
+	return id, gcm.Seal(append([]byte(nil), nonce...), nonce, z, []byte(id)), nil
+}
+
+// compressedChunkNonce возвращает nonce сжатого фрагмента — следующие за
+// nonce несжатого байты HMAC.
+func compressedChunkNonce(mac []byte, size int) []byte {
+	return mac[size : 2*size]
+}
+
+// DecryptChunk расшифровывает фрагмент, зашифрованный EncryptChunk или
+// EncryptChunkCompressed, и проверяет, что его содержимое соответствует
+// идентификатору id.
+func DecryptChunk(id string, ciphertext, key []byte) ([]byte, error) {
+	gcm, err := chunkGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce := ciphertext[:gcm.NonceSize()]
+	plain, err := gcm.Open(nil, nonce, ciphertext[gcm.NonceSize():], []byte(id))
+	if err != nil {
+		return nil, err
+	}
+	if mac, err := hex.DecodeString(id); err == nil && len(mac) >= 2*gcm.NonceSize() &&
+		bytes.Equal(nonce, compressedChunkNonce(mac, gcm.NonceSize())) {
+		if plain, err = decompress(plain, maxDecompressed); err != nil {
+			return nil, err
+		}
+	}
+	if !hmac.Equal([]byte(ChunkID(plain, key)), []byte(id)) {
+		return nil, errors.New("chunk id mismatch")
+	}
+	return plain, nil
+}
+
+// chunkGCM создаёт AES-GCM для шифрования фрагментов.
+func chunkGCM(key []byte) (cipher.AEAD, error) {
+	if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+		return nil, errors.New("invalid AES key size")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
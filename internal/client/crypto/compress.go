@@ -0,0 +1,69 @@
+package crypto
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+)
+
+// maxDecompressed — наибольший размер, до которого распаковываются сжатые
+// кадры потока и фрагменты файлов. Защищает от «zip-бомб» в содержимом.
+const maxDecompressed = 4 << 20
+
+// errDecompressedTooLarge — распакованные данные длиннее допустимого.
+var errDecompressedTooLarge = errors.New("decompressed data too large")
+
+// Кодер и декодер zstd безопасны для параллельных EncodeAll/DecodeAll и
+// создаются один раз. Одинаковые настройки кодера дают одинаковый результат
+// для одинаковых данных: от этого зависят перемотка EncryptReader и
+// дедупликация фрагментов.
+var (
+	zstdEncoder = sync.OnceValue(func() *zstd.Encoder {
+		enc, err := zstd.NewWriter(nil,
+			zstd.WithEncoderConcurrency(1),
+			// Целостность обеспечивает AES-GCM
+			zstd.WithEncoderCRC(false),
+		)
+		if err != nil {
+			panic(err)
+		}
+		return enc
+	})
+	zstdDecoder = sync.OnceValue(func() *zstd.Decoder {
+		dec, err := zstd.NewReader(nil,
+			zstd.WithDecoderConcurrency(1),
+			zstd.WithDecoderMaxMemory(maxDecompressed),
+		)
+		if err != nil {
+			panic(err)
+		}
+		return dec
+	})
+)
+
+// compress сжимает p с помощью zstd. Возвращает nil, если сжатие не
+// уменьшает размер данных и их выгоднее хранить как есть.
+func compress(p []byte) []byte {
+	if len(p) == 0 {
+		return nil
+	}
+	z := zstdEncoder().EncodeAll(p, make([]byte, 0, len(p)))
+	if len(z) >= len(p) {
+		return nil
+	}
+	return z
+}
+
+// decompress распаковывает данные, сжатые compress, ограничивая результат
+// limit байтами.
+func decompress(z []byte, limit int) ([]byte, error) {
+	p, err := zstdDecoder().DecodeAll(z, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(p) > limit {
+		return nil, errDecompressedTooLarge
+	}
+	return p, nil
+}
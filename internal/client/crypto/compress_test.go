@@ -0,0 +1,146 @@
+package crypto_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// textPlain возвращает хорошо сжимаемые данные размером около n байт.
+func textPlain(n int) []byte {
+	return []byte(strings.Repeat("2025-01-01 12:00:00 INFO request handled\n", n/41+1))[:n]
+}
+
+func TestEncryptStreamCompressed_RoundTrip(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+
+	// Сжимаемые кадры вперемешку с несжимаемыми
+	random := make([]byte, 40*1024)
+	_, _ = rand.Read(random)
+	plain := append(textPlain(100*1024), random...)
+
+	var plainEnc, zEnc bytes.Buffer
+	require.NoError(t, crypto.EncryptStream(bytes.NewReader(plain), &plainEnc, key))
+	require.NoError(t, crypto.EncryptStreamCompressed(bytes.NewReader(plain), &zEnc, key))
+	assert.Less(t, zEnc.Len(), plainEnc.Len()/2)
+
+	var out bytes.Buffer
+	require.NoError(t, crypto.DecryptStream(bytes.NewReader(zEnc.Bytes()), &out, key))
+	assert.Equal(t, plain, out.Bytes())
+}
+
+func TestEncryptStreamCompressed_Incompressible(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	plain := make([]byte, 70*1024)
+	_, _ = rand.Read(plain)
+
+	var plainEnc, zEnc bytes.Buffer
+	require.NoError(t, crypto.EncryptStream(bytes.NewReader(plain), &plainEnc, key))
+	require.NoError(t, crypto.EncryptStreamCompressed(bytes.NewReader(plain), &zEnc, key))
+	// Кадры хранятся как есть, добавляется только метка в заголовке
+	assert.Equal(t, plainEnc.Len()+8, zEnc.Len())
+
+	var out bytes.Buffer
+	require.NoError(t, crypto.DecryptStream(bytes.NewReader(zEnc.Bytes()), &out, key))
+	assert.Equal(t, plain, out.Bytes())
+}
+
+func TestDecryptStream_CompressedFlagTampered(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+
+	var enc bytes.Buffer
+	require.NoError(t, crypto.EncryptStreamCompressed(bytes.NewReader(textPlain(1000)), &enc, key))
+	broken := enc.Bytes()
+	broken[8+12] &^= 0x80 // снимаем флаг сжатия первого кадра
+
+	assert.Error(t, crypto.DecryptStream(bytes.NewReader(broken), io.Discard, key))
+}
+
+func TestEncryptReaderCompressed_Seek(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	plain := textPlain(200*1024 + 77)
+
+	r, err := crypto.NewEncryptReaderCompressed(bytes.NewReader(plain), key)
+	require.NoError(t, err)
+	enc, err := io.ReadAll(r)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+	require.NoError(t, crypto.DecryptStream(bytes.NewReader(enc), &out, key))
+	assert.Equal(t, plain, out.Bytes())
+
+	offsets := []int64{3, 20, int64(len(enc)) / 2, int64(len(enc)) - 5, int64(len(enc)), 30}
+	for _, off := range offsets {
+		pos, err := r.Seek(off, io.SeekStart)
+		require.NoError(t, err)
+		assert.Equal(t, off, pos)
+
+		rest, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, enc[off:], rest, "offset %d", off)
+	}
+
+	// Перемотка вперёд без прочитанных ранее кадров
+	fresh, err := crypto.NewEncryptReaderCompressed(bytes.NewReader(plain), key)
+	require.NoError(t, err)
+	_, err = fresh.Seek(int64(len(enc))/2, io.SeekStart)
+	require.NoError(t, err)
+	tail, err := io.ReadAll(fresh)
+	require.NoError(t, err)
+	assert.Len(t, tail, len(enc)-len(enc)/2)
+}
+
+func TestValidStreamPrefix_Compressed(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+
+	var enc bytes.Buffer
+	require.NoError(t, crypto.EncryptStreamCompressed(bytes.NewReader(textPlain(100*1024)), &enc, key))
+	full := enc.Bytes()
+
+	n, err := crypto.ValidStreamPrefix(bytes.NewReader(full), key)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(full), n)
+
+	n, err = crypto.ValidStreamPrefix(bytes.NewReader(full[:len(full)-3]), key)
+	require.NoError(t, err)
+	assert.Less(t, n, int64(len(full)-3))
+	assert.Greater(t, n, int64(20))
+}
+
+func TestEncryptChunkCompressed(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	plain := textPlain(64 * 1024)
+
+	id, ct, err := crypto.EncryptChunkCompressed(plain, key)
+	require.NoError(t, err)
+	assert.Equal(t, crypto.ChunkID(plain, key), id)
+	assert.Less(t, len(ct), len(plain)/2)
+
+	got, err := crypto.DecryptChunk(id, ct, key)
+	require.NoError(t, err)
+	assert.Equal(t, plain, got)
+
+	// Несжимаемый фрагмент шифруется как есть
+	random := make([]byte, 4096)
+	_, _ = rand.Read(random)
+	_, rawCT, err := crypto.EncryptChunk(random, key)
+	require.NoError(t, err)
+	rid, zCT, err := crypto.EncryptChunkCompressed(random, key)
+	require.NoError(t, err)
+	assert.Equal(t, rawCT, zCT)
+	got, err = crypto.DecryptChunk(rid, zCT, key)
+	require.NoError(t, err)
+	assert.Equal(t, random, got)
+}
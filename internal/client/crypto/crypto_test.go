@@ -3,6 +3,7 @@ package crypto_test
 import (
 	"bytes"
 	"crypto/rand"
+	"io"
 	"testing"
 
 	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
@@ -121,6 +122,42 @@ func TestEncryptDecryptAESGCM_WrongKey(t *testing.T) {
 	}
 }
 
+func TestEncryptDeterministicAESGCM(t *testing.T) {
+	key := []byte("0123456789ABCDEF0123456789ABCDEF")
+
+	ct1, err := crypto.EncryptDeterministicAESGCM([]byte("work"), key)
+	if err != nil {
+		t.Fatalf("EncryptDeterministicAESGCM returned error: %v", err)
+	}
+	ct2, err := crypto.EncryptDeterministicAESGCM([]byte("work"), key)
+	if err != nil {
+		t.Fatalf("EncryptDeterministicAESGCM returned error: %v", err)
+	}
+	if !bytes.Equal(ct1, ct2) {
+		t.Error("expected identical ciphertexts for identical plaintexts")
+	}
+
+	other, err := crypto.EncryptDeterministicAESGCM([]byte("home"), key)
+	if err != nil {
+		t.Fatalf("EncryptDeterministicAESGCM returned error: %v", err)
+	}
+	if bytes.Equal(ct1, other) {
+		t.Error("expected different ciphertexts for different plaintexts")
+	}
+
+	decrypted, err := crypto.DecryptAESGCM(ct1, key)
+	if err != nil {
+		t.Fatalf("DecryptAESGCM returned error: %v", err)
+	}
+	if string(decrypted) != "work" {
+		t.Errorf("decrypted data mismatch: got %q", decrypted)
+	}
+
+	if _, err := crypto.EncryptDeterministicAESGCM([]byte("x"), []byte("short")); err == nil {
+		t.Error("expected error for invalid key size, got nil")
+	}
+}
+
 func TestEncryptDecryptStream_Success(t *testing.T) {
 	key := make([]byte, 32) // AES-256
 	_, err := rand.Read(key)
@@ -195,3 +232,119 @@ func TestEncryptDecryptStream_LargeData(t *testing.T) {
 
 	assert.Equal(t, plaintext, decrypted.Bytes())
 }
+
+func TestEncryptReader_RoundTrip(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	plain := make([]byte, 80*1024+123) // несколько полных чанков и хвост
+	_, _ = rand.Read(plain)
+
+	r, err := crypto.NewEncryptReader(bytes.NewReader(plain), key)
+	assert.NoError(t, err)
+	enc, err := io.ReadAll(r)
+	assert.NoError(t, err)
+
+	var out bytes.Buffer
+	assert.NoError(t, crypto.DecryptStream(bytes.NewReader(enc), &out, key))
+	assert.Equal(t, plain, out.Bytes())
+}
+
+func TestEncryptReader_SeekRepeatsBytes(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	plain := make([]byte, 80*1024+123)
+	_, _ = rand.Read(plain)
+
+	r, err := crypto.NewEncryptReader(bytes.NewReader(plain), key)
+	assert.NoError(t, err)
+	enc, err := io.ReadAll(r)
+	assert.NoError(t, err)
+
+	// Середина заголовка, граница кадра, середина кадра, последний кадр, конец
+	for _, off := range []int64{5, 12, 12 + 4 + 32*1024 + 16, 40000, int64(len(enc)) - 7, int64(len(enc))} {
+		pos, err := r.Seek(off, io.SeekStart)
+		assert.NoError(t, err)
+		assert.Equal(t, off, pos)
+
+		rest, err := io.ReadAll(r)
+		assert.NoError(t, err)
+		assert.Equal(t, enc[off:], rest, "offset %d", off)
+	}
+}
+
+func TestValidStreamPrefix(t *testing.T) {
+	key := make([]byte, 32)
+	_, _ = rand.Read(key)
+	plain := make([]byte, 70*1024)
+	_, _ = rand.Read(plain)
+
+	var enc bytes.Buffer
+	assert.NoError(t, crypto.EncryptStream(bytes.NewReader(plain), &enc, key))
+	full := enc.Bytes()
+	frame := 4 + 32*1024 + 16
+
+	n, err := crypto.ValidStreamPrefix(bytes.NewReader(full), key)
+	assert.NoError(t, err)
+	assert.EqualValues(t, len(full), n)
+
+	// Оборванный кадр отбрасывается до границы предыдущего
+	n, err = crypto.ValidStreamPrefix(bytes.NewReader(full[:12+frame+100]), key)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 12+frame, n)
+
+	// Неполный заголовок
+	n, err = crypto.ValidStreamPrefix(bytes.NewReader(full[:5]), key)
+	assert.NoError(t, err)
+	assert.Zero(t, n)
+
+	// Повреждённый второй кадр
+	broken := append([]byte(nil), full...)
+	broken[12+frame+10] ^= 0xff
+	n, err = crypto.ValidStreamPrefix(bytes.NewReader(broken), key)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 12+frame, n)
+}
+
+func TestEncryptDecryptChunk(t *testing.T) {
+	key := []byte("0123456789ABCDEF0123456789ABCDEF")
+	plain := []byte("chunk of file content")
+
+	id1, ct1, err := crypto.EncryptChunk(plain, key)
+	if err != nil {
+		t.Fatalf("EncryptChunk returned error: %v", err)
+	}
+	id2, ct2, err := crypto.EncryptChunk(plain, key)
+	if err != nil {
+		t.Fatalf("EncryptChunk returned error: %v", err)
+	}
+	if id1 != id2 || !bytes.Equal(ct1, ct2) {
+		t.Error("expected identical id and ciphertext for identical chunks")
+	}
+	if id1 != crypto.ChunkID(plain, key) || len(id1) != 64 {
+		t.Errorf("unexpected chunk id %q", id1)
+	}
+	if len(ct1) != len(plain)+crypto.ChunkOverhead {
+		t.Errorf("unexpected ciphertext size %d", len(ct1))
+	}
+
+	decrypted, err := crypto.DecryptChunk(id1, ct1, key)
+	if err != nil {
+		t.Fatalf("DecryptChunk returned error: %v", err)
+	}
+	if !bytes.Equal(decrypted, plain) {
+		t.Errorf("decrypted data mismatch: got %q", decrypted)
+	}
+
+	otherID, _, err := crypto.EncryptChunk([]byte("another chunk"), key)
+	if err != nil {
+		t.Fatalf("EncryptChunk returned error: %v", err)
+	}
+	if _, err := crypto.DecryptChunk(otherID, ct1, key); err == nil {
+		t.Error("expected error when chunk id does not match content")
+	}
+
+	otherKey := []byte("FEDCBA9876543210FEDCBA9876543210")
+	if crypto.ChunkID(plain, otherKey) == id1 {
+		t.Error("expected different ids for different keys")
+	}
+}
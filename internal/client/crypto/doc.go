@@ -1,7 +1,10 @@
 // Package crypto предоставляет клиентские криптографические утилиты:
 //
 // - генерацию симметричных ключей из пароля и соли с помощью Argon2id;
-// - шифрование и расшифровку данных с использованием AES-GCM.
+// - шифрование и расшифровку данных с использованием AES-GCM;
+// - детерминированное шифрование коротких значений (теги), допускающее сравнение на сервере;
+// - детерминированное шифрование фрагментов файлов с идентификатором-HMAC для дедупликации;
+// - потоковое шифрование файлов (EncryptStream, EncryptReader) с необязательным сжатием zstd.
 //
 // Основное предназначение — формирование и использование ключа для шифрования приватных данных
 // перед отправкой их на сервер и после получения с сервера.
@@ -3,11 +3,14 @@ package crypto
 import (
 	"crypto/aes"
 	"crypto/cipher"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
 )
 
 // EncryptAESGCM шифрует переданные данные plaintext с помощью ключа key,
@@ -35,6 +38,39 @@ func EncryptAESGCM(plaintext, key []byte) ([]byte, error) {
 	return gcm.Seal(nonce, nonce, plaintext, nil), nil
 }
 
+// deterministicNonceLabel — метка для вывода ключа, из которого строится nonce
+// детерминированного шифрования. Отделяет его от ключа шифрования данных.
+const deterministicNonceLabel = "gophkeeper/deterministic-nonce"
+
+// EncryptDeterministicAESGCM шифрует plaintext так же, как EncryptAESGCM, но nonce
+// вычисляется как HMAC-SHA256 от plaintext на производном ключе. Одинаковые
+// значения при одном ключе дают одинаковый шифртекст, что позволяет серверу
+// сравнивать их (например, фильтровать по тегам), не видя открытого текста.
+//
+// Результат совместим с DecryptAESGCM. Использовать только для коротких
+// значений, равенство которых допустимо раскрывать серверу.
+func EncryptDeterministicAESGCM(plaintext, key []byte) ([]byte, error) {
+	if len(key) != 16 && len(key) != 24 && len(key) != 32 {
+		return nil, errors.New("invalid AES key size")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	kdf := hmac.New(sha256.New, key)
+	kdf.Write([]byte(deterministicNonceLabel))
+	mac := hmac.New(sha256.New, kdf.Sum(nil))
+	mac.Write(plaintext)
+	nonce := mac.Sum(nil)[:gcm.NonceSize()]
+
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
 // DecryptAESGCM расшифровывает данные ciphertext с помощью ключа key,
 // ожидая, что nonce записан в начале ciphertext.
 //
@@ -62,9 +98,35 @@ func DecryptAESGCM(ciphertext, key []byte) ([]byte, error) {
 // размер исходного (plaintext) чанка
 const chunkSize = 32 * 1024 // 32 KiB
 
+// streamMagic начинает заголовок потока со сжатием. Поток без сжатия
+// начинается сразу со случайного базового nonce, совпадение которого
+// с меткой практически исключено.
+const streamMagic = "GKSTRMZ1"
+
+// frameCompressed — флаг в старшем бите длины кадра: содержимое кадра сжато
+// перед шифрованием. Флаг также входит в дополнительные данные AES-GCM,
+// поэтому снять или поставить его незаметно нельзя.
+const frameCompressed uint32 = 1 << 31
+
+// compressedFrameAAD — дополнительные данные AES-GCM сжатого кадра.
+var compressedFrameAAD = []byte{1}
+
 // EncryptStream шифрует r -> w при помощи AES-GCM.
 // Формат: [baseNonce(12)] { [len(ct):u32][ct] }*
 func EncryptStream(r io.Reader, w io.Writer, key []byte) error {
+	return encryptStream(r, w, key, false)
+}
+
+// EncryptStreamCompressed шифрует r -> w так же, как EncryptStream, но
+// предварительно сжимает каждый чанк zstd. Чанки, которые сжатие не
+// уменьшает, шифруются как есть.
+// Формат: [streamMagic(8)][baseNonce(12)] { [flag|len(ct):u32][ct] }*
+func EncryptStreamCompressed(r io.Reader, w io.Writer, key []byte) error {
+	return encryptStream(r, w, key, true)
+}
+
+// encryptStream реализует EncryptStream и EncryptStreamCompressed.
+func encryptStream(r io.Reader, w io.Writer, key []byte, compressed bool) error {
 
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -84,7 +146,7 @@ func EncryptStream(r io.Reader, w io.Writer, key []byte) error {
 	if _, err := rand.Read(base); err != nil {
 		return fmt.Errorf("rand base nonce: %w", err)
 	}
-	if _, err := w.Write(base); err != nil {
+	if _, err := w.Write(streamHeader(base, compressed)); err != nil {
 		return fmt.Errorf("write base nonce: %w", err)
 	}
 
@@ -99,13 +161,14 @@ func EncryptStream(r io.Reader, w io.Writer, key []byte) error {
 			copy(nonce, base)
 			binary.BigEndian.PutUint64(nonce[nonceSize-8:], index)
 
-			ct := gcm.Seal(nil, nonce, buf[:n], nil)
+			pt, aad, flag := frameContent(buf[:n], compressed)
+			ct := gcm.Seal(nil, nonce, pt, aad)
 
 			// пишем длину и сам шифрокусок
-			if len(ct) > int(^uint32(0)) {
+			if len(ct) >= int(frameCompressed) {
 				return fmt.Errorf("ciphertext too large")
 			}
-			var clen = uint32(len(ct))
+			var clen = uint32(len(ct)) | flag
 			if err := binary.Write(w, binary.BigEndian, clen); err != nil {
 				return fmt.Errorf("write clen: %w", err)
 			}
@@ -126,7 +189,276 @@ func EncryptStream(r io.Reader, w io.Writer, key []byte) error {
 	return nil
 }
 
-// DecryptStream расшифровывает поток формата EncryptStream.
+// streamHeader возвращает заголовок потока с базовым nonce base.
+func streamHeader(base []byte, compressed bool) []byte {
+	if !compressed {
+		return base
+	}
+	return append([]byte(streamMagic), base...)
+}
+
+// frameContent возвращает шифруемое содержимое кадра с исходным текстом
+// plain, дополнительные данные AES-GCM и флаг для длины кадра. Если сжатие
+// включено и уменьшает размер, содержимое сжимается.
+func frameContent(plain []byte, compressed bool) ([]byte, []byte, uint32) {
+	if compressed {
+		if z := compress(plain); z != nil {
+			return z, compressedFrameAAD, frameCompressed
+		}
+	}
+	return plain, nil, 0
+}
+
+// readStreamHeader читает заголовок потока формата EncryptStream или
+// EncryptStreamCompressed. Возвращает базовый nonce, признак сжатия и
+// длину заголовка. Для пустого потока возвращает io.EOF.
+func readStreamHeader(r io.Reader, nonceSize int) ([]byte, bool, int64, error) {
+	head := make([]byte, len(streamMagic))
+	if _, err := io.ReadFull(r, head); err != nil {
+		return nil, false, 0, err
+	}
+	if string(head) == streamMagic {
+		base := make([]byte, nonceSize)
+		if _, err := io.ReadFull(r, base); err != nil {
+			return nil, false, 0, err
+		}
+		return base, true, int64(len(head) + nonceSize), nil
+	}
+	// Заголовок потока без сжатия — сам базовый nonce
+	base := make([]byte, nonceSize)
+	copy(base, head)
+	if _, err := io.ReadFull(r, base[len(head):]); err != nil {
+		return nil, false, 0, err
+	}
+	return base, false, int64(nonceSize), nil
+}
+
+// openFrame проверяет и расшифровывает кадр ct с длиной clen (вместе с
+// флагом сжатия) и при необходимости распаковывает его.
+func openFrame(gcm cipher.AEAD, nonce, ct []byte, clen uint32, compressed bool) ([]byte, error) {
+	if clen&frameCompressed == 0 {
+		return gcm.Open(nil, nonce, ct, nil)
+	}
+	if !compressed {
+		return nil, errors.New("compressed frame in uncompressed stream")
+	}
+	z, err := gcm.Open(nil, nonce, ct, compressedFrameAAD)
+	if err != nil {
+		return nil, err
+	}
+	return decompress(z, chunkSize)
+}
+
+// EncryptReader шифрует данные источника в формате EncryptStream по мере
+// чтения и позволяет перемотать зашифрованный поток на любую позицию.
+//
+// Базовый nonce выбирается один раз при создании, а источник режется на чанки
+// ровно по chunkSize байт (кроме последнего), поэтому повторное чтение с той же
+// позиции даёт те же байты. Это позволяет продолжить прерванную загрузку
+// с места, до которого сервер успел принять данные.
+//
+// При сжатии (NewEncryptReaderCompressed) поток имеет формат
+// EncryptStreamCompressed. Длины кадров тогда зависят от содержимого, и для
+// перемотки запоминаются границы уже сформированных кадров.
+type EncryptReader struct {
+	src    io.ReadSeeker
+	gcm    cipher.AEAD
+	base   []byte
+	header []byte
+	plain  []byte
+
+	compressed bool
+	ends       []int64 // позиции концов сформированных кадров (только при сжатии)
+
+	index uint64 // номер следующего чанка источника
+	buf   []byte // ещё не прочитанная часть текущего кадра (или заголовка)
+	pos   int64  // позиция в зашифрованном потоке
+	eof   bool   // источник дочитан
+}
+
+// NewEncryptReader создаёт EncryptReader для источника src, читаемого с начала.
+func NewEncryptReader(src io.ReadSeeker, key []byte) (*EncryptReader, error) {
+	return newEncryptReader(src, key, false)
+}
+
+// NewEncryptReaderCompressed создаёт EncryptReader, сжимающий чанки источника
+// src перед шифрованием (формат EncryptStreamCompressed).
+func NewEncryptReaderCompressed(src io.ReadSeeker, key []byte) (*EncryptReader, error) {
+	return newEncryptReader(src, key, true)
+}
+
+// newEncryptReader реализует NewEncryptReader и NewEncryptReaderCompressed.
+func newEncryptReader(src io.ReadSeeker, key []byte, compressed bool) (*EncryptReader, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("gcm: %w", err)
+	}
+	if gcm.NonceSize() < 8 {
+		return nil, fmt.Errorf("nonce too small: %d", gcm.NonceSize())
+	}
+
+	base := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(base); err != nil {
+		return nil, fmt.Errorf("rand base nonce: %w", err)
+	}
+
+	r := &EncryptReader{
+		src:        src,
+		gcm:        gcm,
+		base:       base,
+		header:     streamHeader(base, compressed),
+		plain:      make([]byte, chunkSize),
+		compressed: compressed,
+	}
+	if _, err := r.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Read реализует io.Reader.
+func (r *EncryptReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if err := r.nextFrame(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	r.pos += int64(n)
+	return n, nil
+}
+
+// Seek реализует io.Seeker для позиций зашифрованного потока
+// (поддерживаются io.SeekStart и io.SeekCurrent).
+func (r *EncryptReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += r.pos
+	default:
+		return r.pos, errors.New("encrypt reader: unsupported whence")
+	}
+	if offset < 0 {
+		return r.pos, errors.New("encrypt reader: negative position")
+	}
+
+	header := int64(len(r.header))
+	if offset < header {
+		if err := r.rewind(0); err != nil {
+			return r.pos, err
+		}
+		r.buf = r.header[offset:]
+		r.pos = offset
+		return offset, nil
+	}
+	if r.compressed {
+		return r.seekCompressed(offset)
+	}
+
+	frame := int64(4 + chunkSize + r.gcm.Overhead())
+	index := (offset - header) / frame
+	skip := (offset - header) % frame
+	if err := r.rewind(index); err != nil {
+		return r.pos, err
+	}
+	r.pos = offset
+
+	if skip > 0 {
+		if err := r.nextFrame(); err != nil && err != io.EOF {
+			return r.pos, err
+		}
+		if skip > int64(len(r.buf)) {
+			skip = int64(len(r.buf)) // позиция за концом потока
+		}
+		r.buf = r.buf[skip:]
+	}
+	return offset, nil
+}
+
+// seekCompressed перематывает поток со сжатием на позицию offset за
+// заголовком. Кадры до offset, границы которых ещё неизвестны, формируются
+// заново.
+func (r *EncryptReader) seekCompressed(offset int64) (int64, error) {
+	index := sort.Search(len(r.ends), func(i int) bool { return r.ends[i] > offset })
+	start := int64(len(r.header))
+	if index > 0 {
+		start = r.ends[index-1]
+	}
+	if err := r.rewind(int64(index)); err != nil {
+		return r.pos, err
+	}
+	r.pos = offset
+
+	for {
+		if err := r.nextFrame(); err != nil {
+			if err == io.EOF {
+				return offset, nil // позиция за концом потока
+			}
+			return r.pos, err
+		}
+		end := start + int64(len(r.buf))
+		if end > offset {
+			r.buf = r.buf[offset-start:]
+			return offset, nil
+		}
+		start = end
+	}
+}
+
+// rewind устанавливает источник на начало чанка index.
+func (r *EncryptReader) rewind(index int64) error {
+	if _, err := r.src.Seek(index*chunkSize, io.SeekStart); err != nil {
+		return fmt.Errorf("seek plaintext: %w", err)
+	}
+	r.index = uint64(index)
+	r.eof = false
+	r.buf = nil
+	return nil
+}
+
+// nextFrame шифрует очередной чанк источника в r.buf.
+func (r *EncryptReader) nextFrame() error {
+	if r.eof {
+		return io.EOF
+	}
+	n, err := io.ReadFull(r.src, r.plain)
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		r.eof = true
+	} else if err != nil {
+		return fmt.Errorf("read plaintext: %w", err)
+	}
+	if n == 0 {
+		return io.EOF
+	}
+
+	nonce := make([]byte, len(r.base))
+	copy(nonce, r.base)
+	binary.BigEndian.PutUint64(nonce[len(nonce)-8:], r.index)
+
+	pt, aad, flag := frameContent(r.plain[:n], r.compressed)
+	out := make([]byte, 4, 4+len(pt)+r.gcm.Overhead())
+	out = r.gcm.Seal(out, nonce, pt, aad)
+	binary.BigEndian.PutUint32(out[:4], uint32(len(out)-4)|flag)
+	r.buf = out
+
+	if r.compressed && r.index == uint64(len(r.ends)) {
+		start := int64(len(r.header))
+		if len(r.ends) > 0 {
+			start = r.ends[len(r.ends)-1]
+		}
+		r.ends = append(r.ends, start+int64(len(out)))
+	}
+	r.index++
+	return nil
+}
+
+// DecryptStream расшифровывает поток формата EncryptStream или
+// EncryptStreamCompressed; формат определяется по заголовку.
 func DecryptStream(r io.Reader, w io.Writer, key []byte) error {
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -141,9 +473,9 @@ func DecryptStream(r io.Reader, w io.Writer, key []byte) error {
 		return fmt.Errorf("nonce too small: %d", nonceSize)
 	}
 
-	// читаем общий базовый nonce
-	base := make([]byte, nonceSize)
-	if _, err := io.ReadFull(r, base); err != nil {
+	// читаем заголовок с общим базовым nonce
+	base, compressed, _, err := readStreamHeader(r, nonceSize)
+	if err != nil {
 		if err == io.EOF {
 			return nil // пустой поток
 		}
@@ -160,13 +492,14 @@ func DecryptStream(r io.Reader, w io.Writer, key []byte) error {
 			}
 			return fmt.Errorf("read clen: %w", err)
 		}
-		if clen == 0 {
+		size := clen &^ frameCompressed
+		if size == 0 {
 			// допустим пустой чанк и просто идём дальше
 			index++
 			continue
 		}
 
-		ct := make([]byte, clen)
+		ct := make([]byte, size)
 		if _, err := io.ReadFull(r, ct); err != nil {
 			return fmt.Errorf("read ct: %w", err)
 		}
@@ -175,7 +508,7 @@ func DecryptStream(r io.Reader, w io.Writer, key []byte) error {
 		copy(nonce, base)
 		binary.BigEndian.PutUint64(nonce[nonceSize-8:], index)
 
-		pt, err := gcm.Open(nil, nonce, ct, nil)
+		pt, err := openFrame(gcm, nonce, ct, clen, compressed)
 		if err != nil {
 			return fmt.Errorf("open: %w", err) // тут раньше падало "cipher: message authentication failed"
 		}
@@ -188,3 +521,52 @@ func DecryptStream(r io.Reader, w io.Writer, key []byte) error {
 
 	return nil
 }
+
+// ValidStreamPrefix возвращает длину начала потока формата EncryptStream
+// (или EncryptStreamCompressed), состоящего из заголовка и целых кадров,
+// которые успешно проходят проверку подлинности. Используется при докачке:
+// всё, что дальше этой границы (оборванный или повреждённый кадр), нужно
+// скачать заново.
+//
+// Возвращает 0, если в r нет даже полного заголовка.
+func ValidStreamPrefix(r io.Reader, key []byte) (int64, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return 0, fmt.Errorf("cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return 0, fmt.Errorf("gcm: %w", err)
+	}
+	nonceSize := gcm.NonceSize()
+
+	base, compressed, valid, err := readStreamHeader(r, nonceSize)
+	if err != nil {
+		return 0, nil
+	}
+
+	maxFrame := uint32(chunkSize + gcm.Overhead())
+	ct := make([]byte, maxFrame)
+	nonce := make([]byte, nonceSize)
+	for index := uint64(0); ; index++ {
+		var clen uint32
+		if err := binary.Read(r, binary.BigEndian, &clen); err != nil {
+			return valid, nil
+		}
+		size := clen &^ frameCompressed
+		if size > maxFrame {
+			return valid, nil // граница кадра не совпадает с форматом
+		}
+		if _, err := io.ReadFull(r, ct[:size]); err != nil {
+			return valid, nil
+		}
+		if size > 0 {
+			copy(nonce, base)
+			binary.BigEndian.PutUint64(nonce[nonceSize-8:], index)
+			if _, err := openFrame(gcm, nonce, ct[:size], clen, compressed); err != nil {
+				return valid, nil
+			}
+		}
+		valid += 4 + int64(size)
+	}
+}
@@ -14,7 +14,7 @@ type BankcardCryptoWrapper struct {
 }
 
 // Encrypt шифрует чувствительные поля банковской карты:
-// CardholderName, CardNumber, ExpiryDate, CVV и Metadata
+// CardholderName, CardNumber, ExpiryDate, CVV, Metadata и Tags
 // с использованием ключа key. Поля заменяются на base64-кодированные
 // зашифрованные данные.
 //
@@ -58,12 +58,17 @@ func EncryptBankCard(card *model.BankCard, key []byte) error {
 	if err != nil {
 		return err
 	}
+	encTags, err := EncryptTags(card.Tags, key)
+	if err != nil {
+		return err
+	}
 
 	card.CardholderName = base64.StdEncoding.EncodeToString(encCardholder)
 	card.CardNumber = base64.StdEncoding.EncodeToString(encCardNumber)
 	card.ExpiryDate = base64.StdEncoding.EncodeToString(encExpiry)
 	card.CVV = base64.StdEncoding.EncodeToString(encCVV)
 	card.Metadata = base64.StdEncoding.EncodeToString(encMetadata)
+	card.Tags = encTags
 
 	return nil
 }
@@ -119,11 +124,17 @@ func DecryptBankCard(card *model.BankCard, key []byte) error {
 		return err
 	}
 
+	decTags, err := DecryptTags(card.Tags, key)
+	if err != nil {
+		return err
+	}
+
 	card.CardholderName = string(decCardholder)
 	card.CardNumber = string(decCardNumber)
 	card.ExpiryDate = string(decExpiry)
 	card.CVV = string(decCVV)
 	card.Metadata = string(decMetadata)
+	card.Tags = decTags
 
 	return nil
 }
@@ -8,12 +8,14 @@ import (
 )
 
 // BinaryDataCryptoWrapper — обёртка для модели BinaryData,
-// предоставляющая методы шифрования и дешифрования только Metadata.
+// предоставляющая методы шифрования и дешифрования метаданных файла.
 type BinaryDataCryptoWrapper struct {
 	*model.BinaryData
 }
 
-// Encrypt шифрует только Metadata и кодирует в Base64.
+// Encrypt шифрует Metadata, ClientPath, Tags, PlainChecksum и Archive и кодирует
+// их в Base64. Пустые PlainChecksum и Archive остаются пустыми, чтобы записи
+// без контрольной суммы и записи с обычным файлом отличались от остальных.
 func (b *BinaryDataCryptoWrapper) Encrypt(key []byte) error {
 
 	encMetadata, err := crypto.EncryptAESGCM([]byte(b.Metadata), key)
@@ -26,12 +28,34 @@ func (b *BinaryDataCryptoWrapper) Encrypt(key []byte) error {
 		return err
 	}
 
+	encTags, err := EncryptTags(b.Tags, key)
+	if err != nil {
+		return err
+	}
+
+	if b.PlainChecksum != "" {
+		encChecksum, err := crypto.EncryptAESGCM([]byte(b.PlainChecksum), key)
+		if err != nil {
+			return err
+		}
+		b.PlainChecksum = base64.StdEncoding.EncodeToString(encChecksum)
+	}
+
+	if b.Archive != "" {
+		encArchive, err := crypto.EncryptAESGCM([]byte(b.Archive), key)
+		if err != nil {
+			return err
+		}
+		b.Archive = base64.StdEncoding.EncodeToString(encArchive)
+	}
+
 	b.Metadata = base64.StdEncoding.EncodeToString(encMetadata)
 	b.ClientPath = base64.StdEncoding.EncodeToString(encClientPath)
+	b.Tags = encTags
 	return nil
 }
 
-// Decrypt расшифровывает Metadata из Base64.
+// Decrypt расшифровывает Metadata, ClientPath, Tags, PlainChecksum и Archive из Base64.
 func (b *BinaryDataCryptoWrapper) Decrypt(key []byte) error {
 	encMetadataBytes, err := base64.StdEncoding.DecodeString(b.Metadata)
 	if err != nil {
@@ -53,5 +77,35 @@ func (b *BinaryDataCryptoWrapper) Decrypt(key []byte) error {
 	}
 	b.ClientPath = string(decClientPath)
 
+	decTags, err := DecryptTags(b.Tags, key)
+	if err != nil {
+		return err
+	}
+	b.Tags = decTags
+
+	if b.PlainChecksum != "" {
+		encChecksum, err := base64.StdEncoding.DecodeString(b.PlainChecksum)
+		if err != nil {
+			return err
+		}
+		decChecksum, err := crypto.DecryptAESGCM(encChecksum, key)
+		if err != nil {
+			return err
+		}
+		b.PlainChecksum = string(decChecksum)
+	}
+
+	if b.Archive != "" {
+		encArchive, err := base64.StdEncoding.DecodeString(b.Archive)
+		if err != nil {
+			return err
+		}
+		decArchive, err := crypto.DecryptAESGCM(encArchive, key)
+		if err != nil {
+			return err
+		}
+		b.Archive = string(decArchive)
+	}
+
 	return nil
 }
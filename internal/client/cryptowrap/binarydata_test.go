@@ -31,3 +31,23 @@ func TestBinaryDataCryptoWrapper_EncryptDecrypt(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, originalMetadata, data.Metadata, "Metadata после расшифровки должна совпадать с исходной")
 }
+
+func TestBinaryDataCryptoWrapper_PlainChecksum(t *testing.T) {
+	key := []byte("0123456789ABCDEF0123456789ABCDEF")
+	checksum := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+	data := &model.BinaryData{PlainChecksum: checksum}
+	wrapper := &cryptowrap.BinaryDataCryptoWrapper{BinaryData: data}
+	assert.NoError(t, wrapper.Encrypt(key))
+	assert.NotEqual(t, checksum, data.PlainChecksum)
+	assert.NoError(t, wrapper.Decrypt(key))
+	assert.Equal(t, checksum, data.PlainChecksum)
+
+	// Запись без контрольной суммы остаётся без неё
+	legacy := &model.BinaryData{}
+	wrapper = &cryptowrap.BinaryDataCryptoWrapper{BinaryData: legacy}
+	assert.NoError(t, wrapper.Encrypt(key))
+	assert.Empty(t, legacy.PlainChecksum)
+	assert.NoError(t, wrapper.Decrypt(key))
+	assert.Empty(t, legacy.PlainChecksum)
+}
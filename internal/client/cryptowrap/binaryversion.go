@@ -0,0 +1,93 @@
+package cryptowrap
+
+import (
+	"encoding/base64"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// BinaryVersionCryptoWrapper — обёртка для модели BinaryVersion,
+// предоставляющая методы шифрования и дешифрования атрибутов прежней
+// версии содержимого файла.
+type BinaryVersionCryptoWrapper struct {
+	*model.BinaryVersion
+}
+
+// Encrypt шифрует ClientPath, PlainChecksum и Archive и кодирует их
+// в Base64. Пустые PlainChecksum и Archive остаются пустыми, как и
+// у BinaryDataCryptoWrapper.
+func (v *BinaryVersionCryptoWrapper) Encrypt(key []byte) error {
+	clientPath, err := encryptString(v.ClientPath, key)
+	if err != nil {
+		return err
+	}
+	checksum, err := encryptOptional(v.PlainChecksum, key)
+	if err != nil {
+		return err
+	}
+	archive, err := encryptOptional(v.Archive, key)
+	if err != nil {
+		return err
+	}
+
+	v.ClientPath, v.PlainChecksum, v.Archive = clientPath, checksum, archive
+	return nil
+}
+
+// Decrypt расшифровывает ClientPath, PlainChecksum и Archive из Base64.
+func (v *BinaryVersionCryptoWrapper) Decrypt(key []byte) error {
+	clientPath, err := decryptString(v.ClientPath, key)
+	if err != nil {
+		return err
+	}
+	checksum, err := decryptOptional(v.PlainChecksum, key)
+	if err != nil {
+		return err
+	}
+	archive, err := decryptOptional(v.Archive, key)
+	if err != nil {
+		return err
+	}
+
+	v.ClientPath, v.PlainChecksum, v.Archive = clientPath, checksum, archive
+	return nil
+}
+
+// encryptString шифрует s и кодирует результат в Base64.
+func encryptString(s string, key []byte) (string, error) {
+	enc, err := crypto.EncryptAESGCM([]byte(s), key)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(enc), nil
+}
+
+// decryptString расшифровывает строку, зашифрованную encryptString.
+func decryptString(s string, key []byte) (string, error) {
+	enc, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	dec, err := crypto.DecryptAESGCM(enc, key)
+	if err != nil {
+		return "", err
+	}
+	return string(dec), nil
+}
+
+// encryptOptional — encryptString, оставляющая пустую строку пустой.
+func encryptOptional(s string, key []byte) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	return encryptString(s, key)
+}
+
+// decryptOptional — decryptString, оставляющая пустую строку пустой.
+func decryptOptional(s string, key []byte) (string, error) {
+	if s == "" {
+		return "", nil
+	}
+	return decryptString(s, key)
+}
@@ -0,0 +1,31 @@
+package cryptowrap_test
+
+import (
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryVersionCryptoWrapper_EncryptDecrypt(t *testing.T) {
+	key := []byte("0123456789ABCDEF0123456789ABCDEF")
+
+	// Версия хранит атрибуты в том же виде, что и запись
+	data := &model.BinaryData{ClientPath: "/home/user/report.pdf", PlainChecksum: "abc"}
+	require.NoError(t, (&cryptowrap.BinaryDataCryptoWrapper{BinaryData: data}).Encrypt(key))
+
+	v := model.NewBinaryVersion(data)
+	wrapper := &cryptowrap.BinaryVersionCryptoWrapper{BinaryVersion: v}
+	require.NoError(t, wrapper.Decrypt(key))
+	assert.Equal(t, "/home/user/report.pdf", v.ClientPath)
+	assert.Equal(t, "abc", v.PlainChecksum)
+	assert.Empty(t, v.Archive)
+
+	require.NoError(t, wrapper.Encrypt(key))
+	assert.NotEqual(t, "abc", v.PlainChecksum)
+	assert.Empty(t, v.Archive)
+	require.NoError(t, wrapper.Decrypt(key))
+	assert.Equal(t, "abc", v.PlainChecksum)
+}
@@ -0,0 +1,23 @@
+package cryptowrap
+
+import "github.com/ryabkov82/gophkeeper/internal/domain/model"
+
+// DecryptChange расшифровывает содержимое записи, пришедшей в изменении.
+// Для «надгробий» (удалённых записей) содержимого нет, и функция ничего не делает.
+func DecryptChange(c *model.Change, key []byte) error {
+	if c.Deleted {
+		return nil
+	}
+	switch {
+	case c.Credential != nil:
+		return DecryptCredential(c.Credential, key)
+	case c.BankCard != nil:
+		return DecryptBankCard(c.BankCard, key)
+	case c.TextData != nil:
+		return DecryptTextData(c.TextData, key)
+	case c.BinaryData != nil:
+		wrapper := &BinaryDataCryptoWrapper{BinaryData: c.BinaryData}
+		return wrapper.Decrypt(key)
+	}
+	return nil
+}
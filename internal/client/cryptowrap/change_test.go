@@ -0,0 +1,31 @@
+package cryptowrap_test
+
+import (
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptChange(t *testing.T) {
+	key := []byte("12345678901234567890123456789012")
+
+	cred := &model.Credential{Login: "user", Password: "pass", Metadata: "meta", Tags: model.Tags{"work"}}
+	require.NoError(t, cryptowrap.EncryptCredential(cred, key))
+
+	ch := &model.Change{Type: model.ItemTypeCredential, ItemID: "c1", Credential: cred}
+	require.NoError(t, cryptowrap.DecryptChange(ch, key))
+	assert.Equal(t, "user", ch.Credential.Login)
+	assert.Equal(t, "pass", ch.Credential.Password)
+	assert.Equal(t, model.Tags{"work"}, ch.Credential.Tags)
+
+	// Надгробие не содержит данных и не расшифровывается
+	tombstone := &model.Change{Type: model.ItemTypeTextData, ItemID: "t1", Deleted: true}
+	assert.NoError(t, cryptowrap.DecryptChange(tombstone, key))
+
+	// Повреждённые данные приводят к ошибке
+	broken := &model.Change{Type: model.ItemTypeTextData, TextData: &model.TextData{Content: []byte("garbage")}}
+	assert.Error(t, cryptowrap.DecryptChange(broken, key))
+}
@@ -13,7 +13,7 @@ type CredentialCryptoWrapper struct {
 	*model.Credential
 }
 
-// Encrypt шифрует поля Login, Password и Metadata структуры Credential
+// Encrypt шифрует поля Login, Password, Metadata и Tags структуры Credential
 // с использованием ключа key. Поля заменяются на base64-кодированные
 // зашифрованные данные.
 //
@@ -22,7 +22,7 @@ func (c *CredentialCryptoWrapper) Encrypt(key []byte) error {
 	return EncryptCredential(c.Credential, key)
 }
 
-// Decrypt расшифровывает поля Login, Password и Metadata структуры Credential,
+// Decrypt расшифровывает поля Login, Password, Metadata и Tags структуры Credential,
 // предполагая, что они содержат base64-кодированные зашифрованные данные.
 // Расшифрованные значения записываются обратно в поля структуры.
 //
@@ -31,7 +31,7 @@ func (c *CredentialCryptoWrapper) Decrypt(key []byte) error {
 	return DecryptCredential(c.Credential, key)
 }
 
-// EncryptCredential шифрует данные полей Login, Password и Metadata переданной
+// EncryptCredential шифрует данные полей Login, Password, Metadata и Tags переданной
 // Credential, используя ключ key. Результат кодируется в base64 и записывается
 // обратно в соответствующие поля.
 //
@@ -49,7 +49,12 @@ func EncryptCredential(c *model.Credential, key []byte) error {
 	if err != nil {
 		return err
 	}
+	encTags, err := EncryptTags(c.Tags, key)
+	if err != nil {
+		return err
+	}
 
+	c.Tags = encTags
 	c.Login = base64.StdEncoding.EncodeToString(encLogin)
 	c.Password = base64.StdEncoding.EncodeToString(encPassword)
 	c.Metadata = base64.StdEncoding.EncodeToString(encMetadata)
@@ -57,7 +62,7 @@ func EncryptCredential(c *model.Credential, key []byte) error {
 	return nil
 }
 
-// DecryptCredential расшифровывает данные полей Login, Password и Metadata
+// DecryptCredential расшифровывает данные полей Login, Password, Metadata и Tags
 // переданной Credential, предполагая, что они содержат base64-кодированные
 // зашифрованные данные. После расшифровки значения записываются обратно.
 //
@@ -90,6 +95,12 @@ func DecryptCredential(c *model.Credential, key []byte) error {
 		return err
 	}
 
+	decTags, err := DecryptTags(c.Tags, key)
+	if err != nil {
+		return err
+	}
+
+	c.Tags = decTags
 	c.Login = string(decLogin)
 	c.Password = string(decPassword)
 	c.Metadata = string(decMetadata)
@@ -0,0 +1,61 @@
+package cryptowrap
+
+import (
+	"encoding/base64"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// EncryptTags шифрует каждый тег детерминированно и кодирует результат в base64.
+// Одинаковые теги дают одинаковый шифртекст, поэтому сервер может фильтровать
+// записи по тегам, не зная их значений.
+//
+// Возвращает новый срез; исходный не изменяется.
+func EncryptTags(tags []string, key []byte) (model.Tags, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	enc := make(model.Tags, 0, len(tags))
+	for _, tag := range tags {
+		ct, err := crypto.EncryptDeterministicAESGCM([]byte(tag), key)
+		if err != nil {
+			return nil, err
+		}
+		enc = append(enc, base64.StdEncoding.EncodeToString(ct))
+	}
+	return enc, nil
+}
+
+// DecryptTags расшифровывает теги, зашифрованные EncryptTags.
+//
+// Возвращает новый срез; исходный не изменяется.
+func DecryptTags(tags []string, key []byte) (model.Tags, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+	dec := make(model.Tags, 0, len(tags))
+	for _, tag := range tags {
+		ct, err := base64.StdEncoding.DecodeString(tag)
+		if err != nil {
+			return nil, err
+		}
+		pt, err := crypto.DecryptAESGCM(ct, key)
+		if err != nil {
+			return nil, err
+		}
+		dec = append(dec, string(pt))
+	}
+	return dec, nil
+}
+
+// EncryptListFilter возвращает копию фильтра с зашифрованными тегами,
+// пригодную для передачи на сервер. Папка передаётся в открытом виде.
+func EncryptListFilter(filter model.ListFilter, key []byte) (model.ListFilter, error) {
+	tags, err := EncryptTags(filter.Tags, key)
+	if err != nil {
+		return model.ListFilter{}, err
+	}
+	filter.Tags = tags
+	return filter, nil
+}
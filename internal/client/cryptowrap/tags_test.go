@@ -0,0 +1,57 @@
+package cryptowrap_test
+
+import (
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/cryptowrap"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncryptDecryptTags(t *testing.T) {
+	key := []byte("0123456789ABCDEF0123456789ABCDEF")
+
+	enc, err := cryptowrap.EncryptTags([]string{"work", "mail"}, key)
+	require.NoError(t, err)
+	require.Len(t, enc, 2)
+	assert.NotEqual(t, "work", enc[0])
+
+	again, err := cryptowrap.EncryptTags([]string{"work"}, key)
+	require.NoError(t, err)
+	assert.Equal(t, enc[0], again[0], "одинаковые теги должны шифроваться одинаково")
+
+	dec, err := cryptowrap.DecryptTags(enc, key)
+	require.NoError(t, err)
+	assert.Equal(t, model.Tags{"work", "mail"}, dec)
+
+	empty, err := cryptowrap.EncryptTags(nil, key)
+	require.NoError(t, err)
+	assert.Nil(t, empty)
+
+	_, err = cryptowrap.DecryptTags([]string{"not-base64!"}, key)
+	assert.Error(t, err)
+}
+
+func TestEncryptListFilter(t *testing.T) {
+	key := []byte("0123456789ABCDEF0123456789ABCDEF")
+
+	filter, err := cryptowrap.EncryptListFilter(model.ListFilter{Folder: "Work", Tags: []string{"mail"}}, key)
+	require.NoError(t, err)
+	assert.Equal(t, "Work", filter.Folder)
+
+	expected, err := cryptowrap.EncryptTags([]string{"mail"}, key)
+	require.NoError(t, err)
+	assert.Equal(t, []string(expected), filter.Tags)
+}
+
+func TestCredentialCryptoWrapper_Tags(t *testing.T) {
+	key := []byte("0123456789ABCDEF0123456789ABCDEF")
+	cred := &model.Credential{Login: "l", Password: "p", Tags: model.Tags{"work"}}
+
+	require.NoError(t, cryptowrap.EncryptCredential(cred, key))
+	assert.NotEqual(t, model.Tags{"work"}, cred.Tags)
+
+	require.NoError(t, cryptowrap.DecryptCredential(cred, key))
+	assert.Equal(t, model.Tags{"work"}, cred.Tags)
+}
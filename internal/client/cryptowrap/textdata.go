@@ -14,20 +14,20 @@ type TextDataCryptoWrapper struct {
 }
 
 // Encrypt шифрует поля TextData:
-// Content ([]byte) и Metadata (string).
+// Content ([]byte), Metadata (string) и Tags.
 // Content шифруется напрямую, Metadata шифруется и кодируется в Base64.
 func (t *TextDataCryptoWrapper) Encrypt(key []byte) error {
 	return EncryptTextData(t.TextData, key)
 }
 
 // Decrypt расшифровывает поля TextData:
-// Content ([]byte) и Metadata (string с Base64).
+// Content ([]byte), Metadata (string с Base64) и Tags.
 func (t *TextDataCryptoWrapper) Decrypt(key []byte) error {
 	return DecryptTextData(t.TextData, key)
 }
 
-// EncryptTextData шифрует Content и Metadata.
-// Content хранится как []byte, Metadata — Base64.
+// EncryptTextData шифрует Content, Metadata и Tags.
+// Content хранится как []byte, Metadata и теги — Base64.
 func EncryptTextData(td *model.TextData, key []byte) error {
 	encContent, err := crypto.EncryptAESGCM(td.Content, key)
 	if err != nil {
@@ -41,11 +41,17 @@ func EncryptTextData(td *model.TextData, key []byte) error {
 	}
 	td.Metadata = base64.StdEncoding.EncodeToString(encMetadata)
 
+	encTags, err := EncryptTags(td.Tags, key)
+	if err != nil {
+		return err
+	}
+	td.Tags = encTags
+
 	return nil
 }
 
-// DecryptTextData расшифровывает Content и Metadata.
-// Content хранится как []byte, Metadata декодируется из Base64.
+// DecryptTextData расшифровывает Content, Metadata и Tags.
+// Content хранится как []byte, Metadata и теги декодируются из Base64.
 func DecryptTextData(td *model.TextData, key []byte) error {
 	decContent, err := crypto.DecryptAESGCM(td.Content, key)
 	if err != nil {
@@ -63,5 +69,11 @@ func DecryptTextData(td *model.TextData, key []byte) error {
 	}
 	td.Metadata = string(decMetadata)
 
+	decTags, err := DecryptTags(td.Tags, key)
+	if err != nil {
+		return err
+	}
+	td.Tags = decTags
+
 	return nil
 }
@@ -60,6 +60,8 @@ func (a *BankCardAdapter) FormFields() []FormField {
 			Fullscreen:  false,
 			Placeholder: "Дополнительная информация о карте",
 		},
+		folderField(b.Folder),
+		tagsField(b.Tags),
 		{
 			Label:       "UpdatedAt",
 			Value:       b.UpdatedAt.String(),
@@ -72,7 +74,7 @@ func (a *BankCardAdapter) FormFields() []FormField {
 
 // UpdateFromFields обновляет BankCard по значениям из формы.
 func (a *BankCardAdapter) UpdateFromFields(fields []FormField) error {
-	if len(fields) != 7 {
+	if len(fields) != 9 {
 		return errors.New("unexpected number of fields")
 	}
 
@@ -103,6 +105,8 @@ func (a *BankCardAdapter) UpdateFromFields(fields []FormField) error {
 	b.ExpiryDate = expiryDate
 	b.CVV = fields[4].Value
 	b.Metadata = fields[5].Value
+	b.Folder = strings.TrimSpace(fields[6].Value)
+	b.Tags = parseTags(fields[7].Value)
 	b.UpdatedAt = time.Now()
 	return nil
 }
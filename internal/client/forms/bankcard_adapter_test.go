@@ -27,35 +27,47 @@ func TestBankCardAdapter_FormFields(t *testing.T) {
 	adapter := &BankCardAdapter{BankCard: card}
 	fields := adapter.FormFields()
 
-	assert.Len(t, fields, 7)
+	assert.Len(t, fields, 9)
 	assert.Equal(t, "Title", fields[0].Label)
 	assert.Equal(t, "Test Card", fields[0].Value)
 	assert.Equal(t, "Cardholder Name", fields[1].Label)
 	assert.Equal(t, "John Doe", fields[1].Value)
 }
 
+// validExpiry возвращает срок действия карты через год от текущей даты,
+// чтобы тесты не зависели от даты запуска.
+func validExpiry() string {
+	return time.Now().AddDate(1, 0, 0).Format("01/06")
+}
+
 func TestBankCardAdapter_UpdateFromFields(t *testing.T) {
+	expiry := validExpiry()
 	adapter := &BankCardAdapter{BankCard: &model.BankCard{}}
 	fields := []FormField{
 		{Value: "Updated Card"},
 		{Value: "Jane Doe"},
 		{Value: "5555555555554444"},
-		{Value: "06/26"},
+		{Value: expiry},
 		{Value: "456"},
 		{Value: "updated metadata"},
+		{Value: " Personal "},
+		{Value: "visa, main"},
 		{Value: "updated at"},
 	}
 	require.NoError(t, adapter.UpdateFromFields(fields))
 	assert.Equal(t, "Updated Card", adapter.Title)
 	assert.Equal(t, "Jane Doe", adapter.CardholderName)
 	assert.Equal(t, "5555555555554444", adapter.CardNumber)
-	assert.Equal(t, "06/26", adapter.ExpiryDate)
+	assert.Equal(t, expiry, adapter.ExpiryDate)
 	assert.Equal(t, "456", adapter.CVV)
 	assert.Equal(t, "updated metadata", adapter.Metadata)
+	assert.Equal(t, "Personal", adapter.Folder)
+	assert.Equal(t, model.Tags{"visa", "main"}, adapter.Tags)
 	assert.False(t, adapter.UpdatedAt.IsZero())
 }
 
 func TestBankCardAdapter_UpdateFromFields_Errors(t *testing.T) {
+	expiry := validExpiry()
 	tests := []struct {
 		name    string
 		fields  []FormField
@@ -63,22 +75,22 @@ func TestBankCardAdapter_UpdateFromFields_Errors(t *testing.T) {
 	}{
 		{
 			name:    "empty title",
-			fields:  []FormField{{Value: ""}, {Value: "Jane"}, {Value: "5555555555554444"}, {Value: "06/26"}, {Value: "456"}, {Value: "m"}, {Value: ""}},
+			fields:  []FormField{{Value: ""}, {Value: "Jane"}, {Value: "5555555555554444"}, {Value: expiry}, {Value: "456"}, {Value: "m"}, {Value: ""}, {Value: ""}, {Value: ""}},
 			wantErr: true,
 		},
 		{
 			name:    "invalid card number",
-			fields:  []FormField{{Value: "t"}, {Value: "Jane"}, {Value: "5555555555554445"}, {Value: "06/26"}, {Value: "456"}, {Value: "m"}, {Value: ""}},
+			fields:  []FormField{{Value: "t"}, {Value: "Jane"}, {Value: "5555555555554445"}, {Value: expiry}, {Value: "456"}, {Value: "m"}, {Value: ""}, {Value: ""}, {Value: ""}},
 			wantErr: true,
 		},
 		{
 			name:    "invalid expiry",
-			fields:  []FormField{{Value: "t"}, {Value: "Jane"}, {Value: "5555555555554444"}, {Value: "0626"}, {Value: "456"}, {Value: "m"}, {Value: ""}},
+			fields:  []FormField{{Value: "t"}, {Value: "Jane"}, {Value: "5555555555554444"}, {Value: "0626"}, {Value: "456"}, {Value: "m"}, {Value: ""}, {Value: ""}, {Value: ""}},
 			wantErr: true,
 		},
 		{
 			name:    "invalid cvv",
-			fields:  []FormField{{Value: "t"}, {Value: "Jane"}, {Value: "5555555555554444"}, {Value: "06/26"}, {Value: "45"}, {Value: "m"}, {Value: ""}},
+			fields:  []FormField{{Value: "t"}, {Value: "Jane"}, {Value: "5555555555554444"}, {Value: expiry}, {Value: "45"}, {Value: "m"}, {Value: ""}, {Value: ""}, {Value: ""}},
 			wantErr: true,
 		},
 		{
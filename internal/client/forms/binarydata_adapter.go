@@ -37,6 +37,8 @@ func (a *BinaryDataAdapter) FormFields() []FormField {
 			ReadOnly:    true,
 			Placeholder: "Путь к файлу на клиенте",
 		},
+		folderField(b.Folder),
+		tagsField(b.Tags),
 		{
 			Label:       "UpdatedAt",
 			Value:       b.UpdatedAt.String(),
@@ -49,7 +51,7 @@ func (a *BinaryDataAdapter) FormFields() []FormField {
 
 // UpdateFromFields обновляет BinaryData по значениям из формы.
 func (a *BinaryDataAdapter) UpdateFromFields(fields []FormField) error {
-	if len(fields) != 4 {
+	if len(fields) != 6 {
 		return errors.New("unexpected number of fields")
 	}
 
@@ -61,6 +63,8 @@ func (a *BinaryDataAdapter) UpdateFromFields(fields []FormField) error {
 	b.Title = fields[0].Value
 	b.Metadata = fields[1].Value
 	b.ClientPath = fields[2].Value
+	b.Folder = strings.TrimSpace(fields[3].Value)
+	b.Tags = parseTags(fields[4].Value)
 	b.UpdatedAt = time.Now()
 	return nil
 }
@@ -14,7 +14,7 @@ func TestBinaryDataAdapter_FormFields(t *testing.T) {
 	bd := &model.BinaryData{ID: "1", Title: "File", ClientPath: "/tmp/a", Metadata: "m", CreatedAt: now, UpdatedAt: now}
 	adapter := &BinaryDataAdapter{BinaryData: bd}
 	fields := adapter.FormFields()
-	assert.Len(t, fields, 4)
+	assert.Len(t, fields, 6)
 	assert.Equal(t, "Title", fields[0].Label)
 	assert.Equal(t, "File", fields[0].Value)
 }
@@ -25,17 +25,21 @@ func TestBinaryDataAdapter_UpdateFromFields(t *testing.T) {
 		{Value: "Title"},
 		{Value: "Meta"},
 		{Value: "/tmp"},
+		{Value: "Docs"},
+		{Value: "pdf"},
 		{Value: ""},
 	}
 	require.NoError(t, adapter.UpdateFromFields(fields))
 	assert.Equal(t, "Title", adapter.Title)
 	assert.Equal(t, "Meta", adapter.Metadata)
 	assert.Equal(t, "/tmp", adapter.ClientPath)
+	assert.Equal(t, "Docs", adapter.Folder)
+	assert.Equal(t, model.Tags{"pdf"}, adapter.Tags)
 	assert.False(t, adapter.UpdatedAt.IsZero())
 }
 
 func TestBinaryDataAdapter_UpdateFromFields_Errors(t *testing.T) {
 	adapter := &BinaryDataAdapter{BinaryData: &model.BinaryData{}}
-	err := adapter.UpdateFromFields([]FormField{{Value: ""}, {Value: ""}, {Value: ""}, {Value: ""}})
+	err := adapter.UpdateFromFields([]FormField{{Value: ""}, {Value: ""}, {Value: ""}, {Value: ""}, {Value: ""}, {Value: ""}})
 	require.Error(t, err)
 }
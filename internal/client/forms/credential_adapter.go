@@ -44,6 +44,8 @@ func (a *CredentialAdapter) FormFields() []FormField {
 			InputType:   "multiline",
 			Placeholder: "Дополнительные заметки",
 		},
+		folderField(c.Folder),
+		tagsField(c.Tags),
 		{
 			Label:       "UpdatedAt",
 			Value:       c.UpdatedAt.String(),
@@ -56,7 +58,7 @@ func (a *CredentialAdapter) FormFields() []FormField {
 
 // UpdateFromFields обновляет Credential по значениям из формы.
 func (a *CredentialAdapter) UpdateFromFields(fields []FormField) error {
-	if len(fields) != 5 {
+	if len(fields) != 7 {
 		return errors.New("unexpected number of fields")
 	}
 
@@ -69,6 +71,8 @@ func (a *CredentialAdapter) UpdateFromFields(fields []FormField) error {
 	c.Login = fields[1].Value
 	c.Password = fields[2].Value
 	c.Metadata = fields[3].Value
+	c.Folder = strings.TrimSpace(fields[4].Value)
+	c.Tags = parseTags(fields[5].Value)
 	c.UpdatedAt = time.Now()
 	return nil
 }
@@ -22,7 +22,7 @@ func TestCredentialAdapter_FormFields(t *testing.T) {
 	}
 	adapter := &CredentialAdapter{Credential: cred}
 	fields := adapter.FormFields()
-	assert.Len(t, fields, 5)
+	assert.Len(t, fields, 7)
 	assert.Equal(t, "Title", fields[0].Label)
 	assert.Equal(t, "Gmail", fields[0].Value)
 }
@@ -34,6 +34,8 @@ func TestCredentialAdapter_UpdateFromFields(t *testing.T) {
 		{Value: "login"},
 		{Value: "password"},
 		{Value: "meta"},
+		{Value: "Work"},
+		{Value: "git"},
 		{Value: ""},
 	}
 	require.NoError(t, adapter.UpdateFromFields(fields))
@@ -41,6 +43,8 @@ func TestCredentialAdapter_UpdateFromFields(t *testing.T) {
 	assert.Equal(t, "login", adapter.Login)
 	assert.Equal(t, "password", adapter.Password)
 	assert.Equal(t, "meta", adapter.Metadata)
+	assert.Equal(t, "Work", adapter.Folder)
+	assert.Equal(t, model.Tags{"git"}, adapter.Tags)
 	assert.False(t, adapter.UpdatedAt.IsZero())
 }
 
@@ -0,0 +1,44 @@
+package forms
+
+import (
+	"strings"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// folderField возвращает описание поля формы для папки записи.
+func folderField(folder string) FormField {
+	return FormField{
+		Label:       "Folder",
+		Value:       folder,
+		MaxLength:   255,
+		InputType:   "text",
+		Placeholder: "Папка (например, Work)",
+	}
+}
+
+// tagsField возвращает описание поля формы для тегов записи.
+// Теги отображаются одной строкой через запятую.
+func tagsField(tags model.Tags) FormField {
+	return FormField{
+		Label:       "Tags",
+		Value:       strings.Join(tags, ", "),
+		MaxLength:   500,
+		InputType:   "text",
+		Placeholder: "Теги через запятую",
+	}
+}
+
+// parseTags разбирает строку тегов, введённую через запятую.
+// Пробелы по краям отбрасываются, пустые и повторяющиеся теги пропускаются.
+func parseTags(value string) model.Tags {
+	var tags model.Tags
+	for _, part := range strings.Split(value, ",") {
+		tag := strings.TrimSpace(part)
+		if tag == "" || tags.Has(tag) {
+			continue
+		}
+		tags = append(tags, tag)
+	}
+	return tags
+}
@@ -37,6 +37,8 @@ func (a *TextDataAdapter) FormFields() []FormField {
 			InputType:   "multiline",
 			Placeholder: "Дополнительная информация",
 		},
+		folderField(t.Folder),
+		tagsField(t.Tags),
 		{
 			Label:       "UpdatedAt",
 			Value:       t.UpdatedAt.String(),
@@ -49,7 +51,7 @@ func (a *TextDataAdapter) FormFields() []FormField {
 
 // UpdateFromFields обновляет TextData по значениям из формы.
 func (a *TextDataAdapter) UpdateFromFields(fields []FormField) error {
-	if len(fields) != 4 {
+	if len(fields) != 6 {
 		return errors.New("unexpected number of fields")
 	}
 
@@ -61,6 +63,8 @@ func (a *TextDataAdapter) UpdateFromFields(fields []FormField) error {
 	t.Title = fields[0].Value
 	t.Content = []byte(fields[1].Value)
 	t.Metadata = fields[2].Value
+	t.Folder = strings.TrimSpace(fields[3].Value)
+	t.Tags = parseTags(fields[4].Value)
 	t.UpdatedAt = time.Now()
 	return nil
 }
@@ -14,7 +14,7 @@ func TestTextDataAdapter_FormFields(t *testing.T) {
 	td := &model.TextData{ID: "1", Title: "Note", Content: []byte("hello"), Metadata: "m", CreatedAt: now, UpdatedAt: now}
 	adapter := &TextDataAdapter{TextData: td}
 	fields := adapter.FormFields()
-	assert.Len(t, fields, 4)
+	assert.Len(t, fields, 6)
 	assert.Equal(t, "Title", fields[0].Label)
 	assert.Equal(t, "Note", fields[0].Value)
 }
@@ -25,17 +25,21 @@ func TestTextDataAdapter_UpdateFromFields(t *testing.T) {
 		{Value: "Title"},
 		{Value: "Content"},
 		{Value: "Meta"},
+		{Value: "Notes"},
+		{Value: "todo,  todo , home"},
 		{Value: ""},
 	}
 	require.NoError(t, adapter.UpdateFromFields(fields))
 	assert.Equal(t, "Title", adapter.Title)
 	assert.Equal(t, "Content", string(adapter.Content))
 	assert.Equal(t, "Meta", adapter.Metadata)
+	assert.Equal(t, "Notes", adapter.Folder)
+	assert.Equal(t, model.Tags{"todo", "home"}, adapter.Tags)
 	assert.False(t, adapter.UpdatedAt.IsZero())
 }
 
 func TestTextDataAdapter_UpdateFromFields_Errors(t *testing.T) {
 	adapter := &TextDataAdapter{TextData: &model.TextData{}}
-	err := adapter.UpdateFromFields([]FormField{{Value: ""}, {Value: ""}, {Value: ""}, {Value: ""}})
+	err := adapter.UpdateFromFields([]FormField{{Value: ""}, {Value: ""}, {Value: ""}, {Value: ""}, {Value: ""}, {Value: ""}})
 	require.Error(t, err)
 }
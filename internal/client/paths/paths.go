@@ -79,3 +79,49 @@ func DefaultKeyFilePath() (string, error) {
 	}
 	return filepath.Join(cfg, "gophkeeper", "key.json"), nil
 }
+
+// DefaultSyncCursorFilePath возвращает стандартный путь для хранения курсора синхронизации —
+// номера последнего полученного с сервера изменения.
+//
+// Обычно на Linux и macOS это ~/.config/gophkeeper/sync_cursor,
+// на Windows — соответствующий путь в AppData.
+//
+// Возвращает полный путь к файлу курсора и ошибку при неудаче.
+func DefaultSyncCursorFilePath() (string, error) {
+	cfg, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cfg, "gophkeeper", "sync_cursor"), nil
+}
+
+// DefaultCacheFilePath возвращает стандартный путь к локальному зашифрованному
+// кэшу записей, используемому при отсутствии связи с сервером.
+//
+// Обычно на Linux и macOS это ~/.config/gophkeeper/cache.db (рядом с файлом ключа),
+// на Windows — соответствующий путь в AppData.
+//
+// Возвращает полный путь к файлу кэша и ошибку при неудаче.
+func DefaultCacheFilePath() (string, error) {
+	cfg, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cfg, "gophkeeper", "cache.db"), nil
+}
+
+// DefaultLocalDataDir возвращает стандартную директорию данных персонального
+// режима — сервера, встроенного в клиент (база записей, файлы, а также
+// токен, ключ и кэш клиента этого режима).
+//
+// Обычно на Linux и macOS это ~/.config/gophkeeper/local,
+// на Windows — соответствующий путь в AppData.
+//
+// Возвращает полный путь к директории и ошибку при неудаче.
+func DefaultLocalDataDir() (string, error) {
+	cfg, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cfg, "gophkeeper", "local"), nil
+}
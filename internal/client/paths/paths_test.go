@@ -119,3 +119,51 @@ func containsIgnoreCase(s, substr string) bool {
 	s, substr = strings.ToLower(s), strings.ToLower(substr)
 	return strings.Contains(s, substr)
 }
+
+// Тест для DefaultSyncCursorFilePath
+func TestDefaultSyncCursorFilePath(t *testing.T) {
+	path, err := paths.DefaultSyncCursorFilePath()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if filepath.Base(path) != "sync_cursor" {
+		t.Errorf("expected cursor filename 'sync_cursor', got %q", filepath.Base(path))
+	}
+	if !containsIgnoreCase(path, "gophkeeper") {
+		t.Errorf("expected path to contain 'gophkeeper', got %q", path)
+	}
+}
+
+// Тест для DefaultCacheFilePath
+func TestDefaultCacheFilePath(t *testing.T) {
+	path, err := paths.DefaultCacheFilePath()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if filepath.Base(path) != "cache.db" {
+		t.Errorf("expected cache filename 'cache.db', got %q", filepath.Base(path))
+	}
+	// Кэш хранится рядом с файлом ключа
+	keyPath, _ := paths.DefaultKeyFilePath()
+	if filepath.Dir(path) != filepath.Dir(keyPath) {
+		t.Errorf("expected cache next to key file, got %q", path)
+	}
+}
+
+func TestDefaultLocalDataDir(t *testing.T) {
+	dir, err := paths.DefaultLocalDataDir()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if filepath.Base(dir) != "local" {
+		t.Errorf("expected directory basename 'local', got %q", filepath.Base(dir))
+	}
+	// Данные персонального режима отделены от файлов работы с отдельным сервером
+	keyPath, _ := paths.DefaultKeyFilePath()
+	if filepath.Dir(dir) != filepath.Dir(keyPath) {
+		t.Errorf("expected local data dir next to key file, got %q", dir)
+	}
+}
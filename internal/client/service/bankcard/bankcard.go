@@ -8,14 +8,13 @@ import (
 	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
 	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
 	"go.uber.org/zap"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // BankCardManagerIface описывает интерфейс управления банковскими картами.
 type BankCardManagerIface interface {
 	CreateBankCard(ctx context.Context, card *model.BankCard) error
 	GetBankCardByID(ctx context.Context, id string) (*model.BankCard, error)
-	GetBankCards(ctx context.Context) ([]model.BankCard, error)
+	GetBankCards(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error)
 	UpdateBankCard(ctx context.Context, card *model.BankCard) error
 	DeleteBankCard(ctx context.Context, id string) error
 	SetClient(client pb.BankCardServiceClient)
@@ -50,11 +49,15 @@ func (m *BankCardManager) CreateBankCard(ctx context.Context, card *model.BankCa
 	req := &pb.CreateBankCardRequest{}
 	req.SetBankCard(mapper.BankCardToPB(card))
 
-	_, err := m.client.CreateBankCard(ctx, req)
+	resp, err := m.client.CreateBankCard(ctx, req)
 	if err != nil {
 		m.logger.Error("CreateBankCard RPC failed", zap.Error(err))
 		return fmt.Errorf("CreateBankCard RPC failed: %w", err)
 	}
+	if created := resp.GetBankCard(); created.GetId() != "" {
+		card.ID = created.GetId()
+		card.Version = created.GetVersion()
+	}
 
 	m.logger.Info("CreateBankCard succeeded",
 		zap.String("userID", card.UserID),
@@ -86,14 +89,19 @@ func (m *BankCardManager) GetBankCardByID(ctx context.Context, id string) (*mode
 	return card, nil
 }
 
-// GetBankCards получает список банковских карт пользователя.
-func (m *BankCardManager) GetBankCards(ctx context.Context) ([]model.BankCard, error) {
+// GetBankCards получает страницу банковских карт пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы.
+func (m *BankCardManager) GetBankCards(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
 	m.logger.Debug("GetBankCards request started")
 
-	resp, err := m.client.GetBankCards(ctx, &emptypb.Empty{})
+	req := &pb.GetBankCardsRequest{}
+	req.SetFilter(mapper.ListFilterToPB(filter))
+	req.SetPage(mapper.PageRequestToPB(page))
+
+	resp, err := m.client.GetBankCards(ctx, req)
 	if err != nil {
 		m.logger.Error("GetBankCards RPC failed", zap.Error(err))
-		return nil, fmt.Errorf("GetBankCards RPC failed: %w", err)
+		return nil, "", fmt.Errorf("GetBankCards RPC failed: %w", err)
 	}
 
 	cards := make([]model.BankCard, 0, len(resp.GetBankCards()))
@@ -104,7 +112,7 @@ func (m *BankCardManager) GetBankCards(ctx context.Context) ([]model.BankCard, e
 	m.logger.Info("GetBankCards succeeded",
 		zap.Int("count", len(cards)),
 	)
-	return cards, nil
+	return cards, resp.GetNextPageToken(), nil
 }
 
 // UpdateBankCard обновляет существующую банковскую карту на сервере.
@@ -116,11 +124,14 @@ func (m *BankCardManager) UpdateBankCard(ctx context.Context, card *model.BankCa
 	req := &pb.UpdateBankCardRequest{}
 	req.SetBankCard(mapper.BankCardToPB(card))
 
-	_, err := m.client.UpdateBankCard(ctx, req)
+	resp, err := m.client.UpdateBankCard(ctx, req)
 	if err != nil {
 		m.logger.Error("UpdateBankCard RPC failed", zap.Error(err))
 		return fmt.Errorf("UpdateBankCard RPC failed: %w", err)
 	}
+	if v := resp.GetVersion(); v != 0 {
+		card.Version = v
+	}
 
 	m.logger.Info("UpdateBankCard succeeded",
 		zap.String("bankCardID", card.ID),
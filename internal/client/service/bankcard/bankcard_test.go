@@ -14,7 +14,6 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
-	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -155,10 +154,10 @@ func TestBankCardManager_GetBankCards(t *testing.T) {
 		resp.SetBankCards([]*pb.BankCard{pbCard1, pbCard2})
 
 		th.mockClient.EXPECT().
-			GetBankCards(gomock.Any(), &emptypb.Empty{}, gomock.Any()).
+			GetBankCards(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		cards, err := th.manager.GetBankCards(context.Background())
+		cards, _, err := th.manager.GetBankCards(context.Background(), model.ListFilter{}, model.PageRequest{})
 
 		assert.NoError(t, err)
 		assert.Len(t, cards, 2)
@@ -173,10 +172,10 @@ func TestBankCardManager_GetBankCards(t *testing.T) {
 		resp.SetBankCards([]*pb.BankCard{})
 
 		th.mockClient.EXPECT().
-			GetBankCards(gomock.Any(), &emptypb.Empty{}, gomock.Any()).
+			GetBankCards(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		cards, err := th.manager.GetBankCards(context.Background())
+		cards, _, err := th.manager.GetBankCards(context.Background(), model.ListFilter{}, model.PageRequest{})
 
 		assert.NoError(t, err)
 		assert.Empty(t, cards)
@@ -184,10 +183,10 @@ func TestBankCardManager_GetBankCards(t *testing.T) {
 
 	t.Run("Error from server", func(t *testing.T) {
 		th.mockClient.EXPECT().
-			GetBankCards(gomock.Any(), &emptypb.Empty{}, gomock.Any()).
+			GetBankCards(gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(nil, errors.New("server error"))
 
-		cards, err := th.manager.GetBankCards(context.Background())
+		cards, _, err := th.manager.GetBankCards(context.Background(), model.ListFilter{}, model.PageRequest{})
 
 		assert.Error(t, err)
 		assert.Nil(t, cards)
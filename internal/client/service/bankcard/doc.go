@@ -43,8 +43,8 @@
 //	    logger.Error("Ошибка создания карты", zap.Error(err))
 //	}
 //
-//	// Получение всех карт
-//	cards, err := manager.GetBankCards(ctx)
+//	// Получение первой страницы карт
+//	cards, next, err := manager.GetBankCards(ctx, model.ListFilter{}, model.PageRequest{})
 //	if err != nil {
 //	    logger.Error("Ошибка получения карт", zap.Error(err))
 //	}
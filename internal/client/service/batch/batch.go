@@ -0,0 +1,83 @@
+package batch
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BatchManagerIface описывает интерфейс пакетного изменения записей.
+type BatchManagerIface interface {
+	Mutate(ctx context.Context, ops []model.BatchOp, atomic bool) ([]model.BatchResult, error)
+	SetClient(client pb.BatchServiceClient)
+}
+
+// BatchManager отправляет пакеты операций над записями на сервер по gRPC и логирует операции.
+type BatchManager struct {
+	logger *zap.Logger
+	client pb.BatchServiceClient // для инъекции моков в тестах
+}
+
+// NewBatchManager создаёт новый BatchManager.
+func NewBatchManager(logger *zap.Logger) *BatchManager {
+	return &BatchManager{
+		logger: logger,
+	}
+}
+
+// SetClient позволяет установить кастомный (например, моковый) gRPC-клиент.
+func (m *BatchManager) SetClient(client pb.BatchServiceClient) {
+	m.client = client
+}
+
+// Mutate отправляет операции одним пакетом. Записи должны быть уже зашифрованы.
+//
+// Возвращает результаты операций в порядке ops; ошибка операции представлена
+// gRPC-статусом (например, codes.Aborted — конфликт версии). Ошибка Mutate
+// означает, что пакет не был обработан сервером.
+func (m *BatchManager) Mutate(ctx context.Context, ops []model.BatchOp, atomic bool) ([]model.BatchResult, error) {
+	m.logger.Debug("BatchMutate request started",
+		zap.Int("operations", len(ops)),
+		zap.Bool("atomic", atomic),
+	)
+
+	pbOps := make([]*pb.BatchOperation, 0, len(ops))
+	for _, op := range ops {
+		pbOps = append(pbOps, mapper.BatchOpToPB(op))
+	}
+	req := &pb.BatchMutateRequest{}
+	req.SetOperations(pbOps)
+	req.SetAtomic(atomic)
+
+	resp, err := m.client.BatchMutate(ctx, req)
+	if err != nil {
+		m.logger.Error("BatchMutate RPC failed", zap.Error(err))
+		return nil, fmt.Errorf("BatchMutate RPC failed: %w", err)
+	}
+	if len(resp.GetResults()) != len(ops) {
+		return nil, fmt.Errorf("BatchMutate returned %d results for %d operations", len(resp.GetResults()), len(ops))
+	}
+
+	results := make([]model.BatchResult, 0, len(ops))
+	failed := 0
+	for _, r := range resp.GetResults() {
+		res := model.BatchResult{ItemID: r.GetId(), Version: r.GetVersion()}
+		if code := codes.Code(r.GetCode()); code != codes.OK {
+			res.Err = status.Error(code, r.GetError())
+			failed++
+		}
+		results = append(results, res)
+	}
+
+	m.logger.Info("BatchMutate succeeded",
+		zap.Int("operations", len(ops)),
+		zap.Int("failed", failed),
+	)
+	return results, nil
+}
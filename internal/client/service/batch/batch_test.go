@@ -0,0 +1,92 @@
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/service/batch"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func setup(t *testing.T) (*batch.BatchManager, *mocks.MockBatchServiceClient) {
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockBatchServiceClient(ctrl)
+
+	manager := batch.NewBatchManager(zaptest.NewLogger(t))
+	manager.SetClient(mockClient)
+
+	return manager, mockClient
+}
+
+func batchResult(id string, version int64, code codes.Code, msg string) *pb.BatchResult {
+	r := &pb.BatchResult{}
+	r.SetId(id)
+	r.SetVersion(version)
+	r.SetCode(int32(code))
+	r.SetError(msg)
+	return r
+}
+
+func TestMutate_Success(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	ops := []model.BatchOp{
+		{Kind: model.BatchCreate, Type: model.ItemTypeCredential, Credential: &model.Credential{Title: "Gmail"}},
+		{Kind: model.BatchUpdate, Type: model.ItemTypeTextData, TextData: &model.TextData{ID: "note1", Version: 2}},
+	}
+
+	resp := &pb.BatchMutateResponse{}
+	resp.SetResults([]*pb.BatchResult{
+		batchResult("cred1", 1, codes.OK, ""),
+		batchResult("note1", 0, codes.Aborted, "version conflict"),
+	})
+
+	mockClient.EXPECT().
+		BatchMutate(gomock.Any(), gomock.Cond(func(x any) bool {
+			req := x.(*pb.BatchMutateRequest)
+			return req.GetAtomic() && len(req.GetOperations()) == 2 &&
+				req.GetOperations()[0].GetCredential().GetTitle() == "Gmail" &&
+				req.GetOperations()[1].GetTextData().GetVersion() == 2
+		})).
+		Return(resp, nil)
+
+	results, err := manager.Mutate(context.Background(), ops, true)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "cred1", results[0].ItemID)
+	assert.Equal(t, int64(1), results[0].Version)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, codes.Aborted, status.Code(results[1].Err))
+}
+
+func TestMutate_RPCError(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	mockClient.EXPECT().
+		BatchMutate(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("rpc error"))
+
+	_, err := manager.Mutate(context.Background(), []model.BatchOp{{Kind: model.BatchDelete}}, false)
+	assert.ErrorContains(t, err, "BatchMutate RPC failed")
+}
+
+func TestMutate_ResultCountMismatch(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	mockClient.EXPECT().
+		BatchMutate(gomock.Any(), gomock.Any()).
+		Return(&pb.BatchMutateResponse{}, nil)
+
+	_, err := manager.Mutate(context.Background(), []model.BatchOp{{Kind: model.BatchDelete}}, false)
+	assert.Error(t, err)
+}
@@ -0,0 +1,18 @@
+// Package batch предоставляет клиентский доступ к пакетному изменению записей
+// (BatchService) в приложении GophKeeper.
+//
+// BatchManager отправляет на сервер множество операций создания, обновления и
+// удаления записей одним RPC; сервер применяет их в одной транзакции и
+// возвращает результат каждой операции. Это используется для импорта и
+// перешифрования большого количества записей вместо тысяч отдельных вызовов.
+//
+// Типы:
+//   - BatchManagerIface — интерфейс менеджера, упрощающий мокирование.
+//   - BatchManager — конкретная реализация интерфейса.
+//
+// Пример использования:
+//
+//	manager := batch.NewBatchManager(logger)
+//	manager.SetClient(pb.NewBatchServiceClient(conn))
+//	results, err := manager.Mutate(ctx, ops, true)
+package batch
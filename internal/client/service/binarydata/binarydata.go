@@ -4,22 +4,38 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
 	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
 	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // BinaryDataManagerIface описывает интерфейс управления бинарными данными.
 type BinaryDataManagerIface interface {
 	Upload(ctx context.Context, data *model.BinaryData, r io.Reader) error
 	Download(ctx context.Context, id string) (io.ReadCloser, error)
-	List(ctx context.Context) ([]model.BinaryData, error)
+	DownloadRange(ctx context.Context, id string, offset, length int64) (io.ReadCloser, error)
+	List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BinaryData, string, error)
 	GetInfo(ctx context.Context, id string) (*model.BinaryData, error)
 	CreateInfo(ctx context.Context, data *model.BinaryData) error
 	UpdateInfo(ctx context.Context, data *model.BinaryData) error
 	Delete(ctx context.Context, id string) error
+	FindMissingChunks(ctx context.Context, ids []string) ([]string, error)
+	UploadChunk(ctx context.Context, id string, data []byte) error
+	CommitChunked(ctx context.Context, data *model.BinaryData, ids []string) error
+	GetManifest(ctx context.Context, id string) ([]model.ChunkRef, bool, error)
+	DownloadChunk(ctx context.Context, id string) ([]byte, error)
+	Verify(ctx context.Context, id string) (bool, string, error)
+	ListVersions(ctx context.Context, id string) ([]model.BinaryVersion, error)
+	GetVersionManifest(ctx context.Context, id, versionID string) ([]model.ChunkRef, bool, error)
+	DownloadVersionRange(ctx context.Context, id, versionID string, offset, length int64) (io.ReadCloser, error)
+	RestoreVersion(ctx context.Context, id, versionID string, version int64) (int64, error)
 	SetClient(client pb.BinaryDataServiceClient)
 }
 
@@ -41,10 +57,76 @@ func (m *BinaryDataManager) SetClient(client pb.BinaryDataServiceClient) {
 	m.client = client
 }
 
+// maxUploadAttempts — число попыток передачи файла при загрузке с докачкой.
+const maxUploadAttempts = 5
+
+// uploadRetryDelay — пауза перед повторной попыткой; растёт с номером попытки.
+const uploadRetryDelay = time.Second
+
+// uploadStatusTimeout ограничивает ожидание восстановления соединения
+// перед запросом состояния сессии докачки.
+const uploadStatusTimeout = 30 * time.Second
+
 // Upload загружает бинарные данные на сервер через поток.
+//
+// Если r реализует io.Seeker, загрузка идёт в сессии докачки: при обрыве
+// соединения Upload запрашивает у сервера принятое смещение (GetUploadStatus),
+// перематывает r и продолжает передачу, не начиная её заново.
 func (m *BinaryDataManager) Upload(ctx context.Context, data *model.BinaryData, r io.Reader) error {
 	m.logger.Debug("Upload started", zap.String("userID", data.UserID), zap.String("title", data.Title))
 
+	rs, ok := r.(io.ReadSeeker)
+	if !ok {
+		return m.upload(ctx, data, r, "", 0)
+	}
+
+	uploadID := uuid.NewString()
+	var offset int64
+	for attempt := 1; ; attempt++ {
+		err := m.upload(ctx, data, rs, uploadID, offset)
+		if err == nil || attempt == maxUploadAttempts || !resumableError(ctx, err) {
+			return err
+		}
+		m.logger.Warn("Upload interrupted, resuming",
+			zap.String("uploadID", uploadID),
+			zap.Int("attempt", attempt),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(time.Duration(attempt-1) * uploadRetryDelay):
+		}
+
+		if offset, err = m.UploadStatus(ctx, uploadID); err != nil {
+			return err
+		}
+		if _, err := rs.Seek(offset, io.SeekStart); err != nil {
+			return fmt.Errorf("failed to seek input: %w", err)
+		}
+	}
+}
+
+// UploadStatus возвращает число байт, принятых сервером в сессии загрузки
+// uploadID. Запрос ждёт восстановления соединения не дольше uploadStatusTimeout.
+func (m *BinaryDataManager) UploadStatus(ctx context.Context, uploadID string) (int64, error) {
+	ctx, cancel := context.WithTimeout(ctx, uploadStatusTimeout)
+	defer cancel()
+
+	req := &pb.GetUploadStatusRequest{}
+	req.SetUploadId(uploadID)
+
+	resp, err := m.client.GetUploadStatus(ctx, req, grpc.WaitForReady(true))
+	if err != nil {
+		return 0, fmt.Errorf("GetUploadStatus RPC failed: %w", err)
+	}
+	return resp.GetOffset(), nil
+}
+
+// upload выполняет одну попытку передачи. Непустой uploadID включает
+// сессию докачки, а r передаётся начиная со смещения offset.
+func (m *BinaryDataManager) upload(ctx context.Context, data *model.BinaryData, r io.Reader, uploadID string, offset int64) error {
 	stream, err := m.client.UploadBinaryData(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to create upload stream: %w", err)
@@ -53,6 +135,10 @@ func (m *BinaryDataManager) Upload(ctx context.Context, data *model.BinaryData,
 	// Отправляем метаданные первым сообщением
 	metaReq := &pb.UploadBinaryDataRequest{}
 	metaReq.SetInfo(mapper.BinaryDataToPB(data))
+	if uploadID != "" {
+		metaReq.SetUploadId(uploadID)
+		metaReq.SetOffset(offset)
+	}
 	if err := stream.Send(metaReq); err != nil {
 		// сервер мог сразу закрыть поток — заберём статус
 		if _, recvErr := stream.CloseAndRecv(); recvErr != nil {
@@ -91,10 +177,28 @@ func (m *BinaryDataManager) Upload(ctx context.Context, data *model.BinaryData,
 	}
 
 	data.ID = resp.GetId()
+	data.Version = resp.GetVersion()
 	m.logger.Info("Upload succeeded", zap.String("binaryDataID", data.ID))
 	return nil
 }
 
+// resumableError сообщает, вызвана ли ошибка загрузки обрывом соединения,
+// после которого имеет смысл продолжить передачу.
+func resumableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return false
+	}
+	switch st.Code() {
+	case codes.Unavailable, codes.Canceled, codes.DeadlineExceeded:
+		return true
+	}
+	return false
+}
+
 // CreateInfo сохраняет только метаданные бинарных данных без содержимого.
 func (m *BinaryDataManager) CreateInfo(ctx context.Context, data *model.BinaryData) error {
 	m.logger.Debug("CreateInfo started", zap.String("title", data.Title))
@@ -125,16 +229,38 @@ func (m *BinaryDataManager) UpdateInfo(ctx context.Context, data *model.BinaryDa
 	}
 
 	data.ID = resp.GetId()
+	data.Version = resp.GetVersion()
 	m.logger.Info("UpdateInfo succeeded", zap.String("binaryDataID", data.ID))
 	return nil
 }
 
 // Download возвращает поток бинарных данных с сервера.
 func (m *BinaryDataManager) Download(ctx context.Context, id string) (io.ReadCloser, error) {
-	m.logger.Debug("Download started", zap.String("binaryDataID", id))
+	return m.DownloadRange(ctx, id, 0, 0)
+}
+
+// DownloadRange возвращает поток части бинарных данных: length байт начиная
+// с offset (length 0 — до конца). Смещение отсчитывается в содержимом,
+// хранящемся на сервере, то есть в зашифрованном потоке.
+func (m *BinaryDataManager) DownloadRange(ctx context.Context, id string, offset, length int64) (io.ReadCloser, error) {
+	return m.DownloadVersionRange(ctx, id, "", offset, length)
+}
+
+// DownloadVersionRange — DownloadRange для прежней версии versionID
+// содержимого файла (пустой versionID — текущее содержимое).
+func (m *BinaryDataManager) DownloadVersionRange(ctx context.Context, id, versionID string, offset, length int64) (io.ReadCloser, error) {
+	m.logger.Debug("Download started",
+		zap.String("binaryDataID", id),
+		zap.String("versionID", versionID),
+		zap.Int64("offset", offset),
+		zap.Int64("length", length),
+	)
 
 	req := &pb.DownloadBinaryDataRequest{}
 	req.SetId(id)
+	req.SetVersionId(versionID)
+	req.SetOffset(offset)
+	req.SetLength(length)
 
 	stream, err := m.client.DownloadBinaryData(ctx, req)
 	if err != nil {
@@ -167,13 +293,18 @@ func (m *BinaryDataManager) Download(ctx context.Context, id string) (io.ReadClo
 	return pr, nil
 }
 
-// List возвращает список бинарных данных пользователя.
-func (m *BinaryDataManager) List(ctx context.Context) ([]model.BinaryData, error) {
+// List возвращает страницу бинарных данных пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы.
+func (m *BinaryDataManager) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BinaryData, string, error) {
 	m.logger.Debug("List started")
 
-	resp, err := m.client.ListBinaryData(ctx, &pb.ListBinaryDataRequest{})
+	req := &pb.ListBinaryDataRequest{}
+	req.SetFilter(mapper.ListFilterToPB(filter))
+	req.SetPage(mapper.PageRequestToPB(page))
+
+	resp, err := m.client.ListBinaryData(ctx, req)
 	if err != nil {
-		return nil, fmt.Errorf("ListBinaryData RPC failed: %w", err)
+		return nil, "", fmt.Errorf("ListBinaryData RPC failed: %w", err)
 	}
 
 	result := make([]model.BinaryData, 0, len(resp.GetItems()))
@@ -184,7 +315,7 @@ func (m *BinaryDataManager) List(ctx context.Context) ([]model.BinaryData, error
 	}
 
 	m.logger.Info("List succeeded", zap.Int("count", len(result)))
-	return result, nil
+	return result, resp.GetNextPageToken(), nil
 }
 
 // Delete удаляет бинарные данные по ID.
@@ -224,3 +355,142 @@ func (m *BinaryDataManager) GetInfo(ctx context.Context, id string) (*model.Bina
 	m.logger.Info("GetInfo succeeded", zap.String("binaryDataID", data.ID))
 	return data, nil
 }
+
+// FindMissingChunks возвращает идентификаторы фрагментов из ids, которых
+// ещё нет на сервере.
+func (m *BinaryDataManager) FindMissingChunks(ctx context.Context, ids []string) ([]string, error) {
+	req := &pb.FindMissingChunksRequest{}
+	req.SetIds(ids)
+
+	resp, err := m.client.FindMissingChunks(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("FindMissingChunks RPC failed: %w", err)
+	}
+	return resp.GetIds(), nil
+}
+
+// UploadChunk загружает на сервер один зашифрованный фрагмент файла.
+func (m *BinaryDataManager) UploadChunk(ctx context.Context, id string, data []byte) error {
+	req := &pb.UploadChunkRequest{}
+	req.SetId(id)
+	req.SetData(data)
+
+	if _, err := m.client.UploadChunk(ctx, req); err != nil {
+		return fmt.Errorf("UploadChunk RPC failed: %w", err)
+	}
+	return nil
+}
+
+// CommitChunked создаёт (пустой data.ID) или обновляет запись, содержимое
+// которой — загруженные фрагменты ids по порядку. Записывает в data
+// присвоенные сервером ID и версию.
+func (m *BinaryDataManager) CommitChunked(ctx context.Context, data *model.BinaryData, ids []string) error {
+	m.logger.Debug("CommitChunked started", zap.String("title", data.Title), zap.Int("chunks", len(ids)))
+
+	req := &pb.CommitChunkedUploadRequest{}
+	req.SetInfo(mapper.BinaryDataToPB(data))
+	req.SetChunkIds(ids)
+
+	resp, err := m.client.CommitChunkedUpload(ctx, req)
+	if err != nil {
+		return fmt.Errorf("CommitChunkedUpload RPC failed: %w", err)
+	}
+
+	data.ID = resp.GetId()
+	data.Version = resp.GetVersion()
+	m.logger.Info("CommitChunked succeeded", zap.String("binaryDataID", data.ID))
+	return nil
+}
+
+// GetManifest возвращает фрагменты файла по порядку и true, если файл
+// хранится фрагментами; иначе его скачивают через DownloadRange.
+func (m *BinaryDataManager) GetManifest(ctx context.Context, id string) ([]model.ChunkRef, bool, error) {
+	return m.GetVersionManifest(ctx, id, "")
+}
+
+// GetVersionManifest — GetManifest для прежней версии versionID содержимого
+// файла (пустой versionID — текущее содержимое).
+func (m *BinaryDataManager) GetVersionManifest(ctx context.Context, id, versionID string) ([]model.ChunkRef, bool, error) {
+	req := &pb.GetManifestRequest{}
+	req.SetId(id)
+	req.SetVersionId(versionID)
+
+	resp, err := m.client.GetManifest(ctx, req)
+	if err != nil {
+		return nil, false, fmt.Errorf("GetManifest RPC failed: %w", err)
+	}
+	if !resp.GetChunked() {
+		return nil, false, nil
+	}
+	return mapper.ChunkRefsFromPB(resp.GetChunks()), true, nil
+}
+
+// DownloadChunk возвращает содержимое одного зашифрованного фрагмента.
+func (m *BinaryDataManager) DownloadChunk(ctx context.Context, id string) ([]byte, error) {
+	req := &pb.DownloadChunkRequest{}
+	req.SetId(id)
+
+	resp, err := m.client.DownloadChunk(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("DownloadChunk RPC failed: %w", err)
+	}
+	return resp.GetData(), nil
+}
+
+// Verify просит сервер сверить хранимое содержимое записи с записанными
+// контрольными суммами. Возвращает результат проверки и описание
+// расхождения.
+func (m *BinaryDataManager) Verify(ctx context.Context, id string) (bool, string, error) {
+	req := &pb.VerifyBinaryDataRequest{}
+	req.SetId(id)
+
+	resp, err := m.client.VerifyBinaryData(ctx, req)
+	if err != nil {
+		return false, "", fmt.Errorf("VerifyBinaryData RPC failed: %w", err)
+	}
+	if !resp.GetValid() {
+		m.logger.Warn("Verify failed", zap.String("binaryDataID", id), zap.String("detail", resp.GetDetail()))
+	}
+	return resp.GetValid(), resp.GetDetail(), nil
+}
+
+// ListVersions возвращает прежние версии содержимого файла, начиная с
+// последней.
+func (m *BinaryDataManager) ListVersions(ctx context.Context, id string) ([]model.BinaryVersion, error) {
+	req := &pb.ListBinaryVersionsRequest{}
+	req.SetId(id)
+
+	resp, err := m.client.ListBinaryVersions(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("ListBinaryVersions RPC failed: %w", err)
+	}
+
+	result := make([]model.BinaryVersion, 0, len(resp.GetVersions()))
+	for _, info := range resp.GetVersions() {
+		if v := mapper.BinaryVersionFromPB(info); v != nil {
+			v.BinaryID = id
+			result = append(result, *v)
+		}
+	}
+	return result, nil
+}
+
+// RestoreVersion делает прежнюю версию versionID текущим содержимым файла;
+// version — ожидаемая версия записи (0 — без проверки). Возвращает новую
+// версию записи.
+func (m *BinaryDataManager) RestoreVersion(ctx context.Context, id, versionID string, version int64) (int64, error) {
+	m.logger.Debug("RestoreVersion started", zap.String("binaryDataID", id), zap.String("versionID", versionID))
+
+	req := &pb.RestoreBinaryVersionRequest{}
+	req.SetId(id)
+	req.SetVersionId(versionID)
+	req.SetVersion(version)
+
+	resp, err := m.client.RestoreBinaryVersion(ctx, req)
+	if err != nil {
+		return 0, fmt.Errorf("RestoreBinaryVersion RPC failed: %w", err)
+	}
+
+	m.logger.Info("RestoreVersion succeeded", zap.String("binaryDataID", id), zap.String("versionID", versionID))
+	return resp.GetVersion(), nil
+}
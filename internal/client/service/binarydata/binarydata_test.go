@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/ryabkov82/gophkeeper/internal/client/service/binarydata"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
@@ -118,7 +120,7 @@ func TestBinaryDataManager_List(t *testing.T) {
 	client := &mockBinaryDataClient{}
 	manager.SetClient(client)
 
-	list, err := manager.List(context.Background())
+	list, _, err := manager.List(context.Background(), model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, list, 2)
 	assert.Equal(t, "1", list[0].ID)
@@ -209,3 +211,262 @@ func TestBinaryDataManager_GetInfo(t *testing.T) {
 	assert.Equal(t, "FileMeta", info.Metadata)
 	assert.EqualValues(t, 1024, info.Size)
 }
+
+// --- Мок сервера с сессией докачки ---
+type resumeClient struct {
+	pb.BinaryDataServiceClient
+
+	failAt   int // первая попытка обрывается после приёма failAt байт
+	attempts int
+	received []byte
+	offsets  []int64
+	uploadID []string
+}
+
+func (c *resumeClient) UploadBinaryData(ctx context.Context, opts ...grpc.CallOption) (pb.BinaryDataService_UploadBinaryDataClient, error) {
+	c.attempts++
+	return &resumeStream{client: c}, nil
+}
+
+func (c *resumeClient) GetUploadStatus(ctx context.Context, req *pb.GetUploadStatusRequest, opts ...grpc.CallOption) (*pb.GetUploadStatusResponse, error) {
+	resp := &pb.GetUploadStatusResponse{}
+	resp.SetOffset(int64(len(c.received)))
+	return resp, nil
+}
+
+type resumeStream struct {
+	pb.BinaryDataService_UploadBinaryDataClient
+	client *resumeClient
+	broken bool
+}
+
+func (s *resumeStream) Send(req *pb.UploadBinaryDataRequest) error {
+	c := s.client
+	if req.HasInfo() {
+		c.offsets = append(c.offsets, req.GetOffset())
+		c.uploadID = append(c.uploadID, req.GetUploadId())
+		c.received = c.received[:req.GetOffset()]
+		return nil
+	}
+	chunk := req.GetChunk()
+	if c.attempts == 1 && len(c.received)+len(chunk) > c.failAt {
+		c.received = append(c.received, chunk[:c.failAt-len(c.received)]...)
+		s.broken = true
+		return io.EOF
+	}
+	c.received = append(c.received, chunk...)
+	return nil
+}
+
+func (s *resumeStream) CloseAndRecv() (*pb.UploadBinaryDataResponse, error) {
+	if s.broken {
+		return nil, status.Error(codes.Unavailable, "connection lost")
+	}
+	resp := &pb.UploadBinaryDataResponse{}
+	resp.SetId("123")
+	resp.SetVersion(1)
+	return resp, nil
+}
+
+func TestBinaryDataManager_UploadResumes(t *testing.T) {
+	manager := binarydata.NewBinaryDataManager(zap.NewNop())
+	client := &resumeClient{failAt: 40000}
+	manager.SetClient(client)
+
+	content := bytes.Repeat([]byte("0123456789"), 10000)
+	data := &model.BinaryData{Title: "big"}
+
+	err := manager.Upload(context.Background(), data, bytes.NewReader(content))
+	assert.NoError(t, err)
+	assert.Equal(t, "123", data.ID)
+	assert.Equal(t, 2, client.attempts)
+	assert.Equal(t, []int64{0, 40000}, client.offsets)
+	assert.NotEmpty(t, client.uploadID[0])
+	assert.Equal(t, client.uploadID[0], client.uploadID[1])
+	assert.Equal(t, content, client.received)
+}
+
+func TestBinaryDataManager_UploadNotSeekable(t *testing.T) {
+	manager := binarydata.NewBinaryDataManager(zap.NewNop())
+	client := &resumeClient{failAt: 10}
+	manager.SetClient(client)
+
+	// Поток без перемотки нельзя продолжить: ошибка возвращается сразу
+	err := manager.Upload(context.Background(), &model.BinaryData{}, io.LimitReader(bytes.NewReader(make([]byte, 100)), 100))
+	assert.Error(t, err)
+	assert.Equal(t, 1, client.attempts)
+	assert.Equal(t, []string{""}, client.uploadID)
+}
+
+// chunkClient — мок сервера, хранящего фрагменты файлов.
+type chunkClient struct {
+	pb.BinaryDataServiceClient
+	chunks   map[string][]byte
+	manifest []string
+}
+
+func (c *chunkClient) FindMissingChunks(ctx context.Context, req *pb.FindMissingChunksRequest, opts ...grpc.CallOption) (*pb.FindMissingChunksResponse, error) {
+	var missing []string
+	for _, id := range req.GetIds() {
+		if _, ok := c.chunks[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	resp := &pb.FindMissingChunksResponse{}
+	resp.SetIds(missing)
+	return resp, nil
+}
+
+func (c *chunkClient) UploadChunk(ctx context.Context, req *pb.UploadChunkRequest, opts ...grpc.CallOption) (*pb.UploadChunkResponse, error) {
+	c.chunks[req.GetId()] = req.GetData()
+	return &pb.UploadChunkResponse{}, nil
+}
+
+func (c *chunkClient) CommitChunkedUpload(ctx context.Context, req *pb.CommitChunkedUploadRequest, opts ...grpc.CallOption) (*pb.CommitChunkedUploadResponse, error) {
+	for _, id := range req.GetChunkIds() {
+		if _, ok := c.chunks[id]; !ok {
+			return nil, status.Error(codes.FailedPrecondition, "missing chunks")
+		}
+	}
+	c.manifest = req.GetChunkIds()
+	resp := &pb.CommitChunkedUploadResponse{}
+	resp.SetId("file1")
+	resp.SetVersion(1)
+	return resp, nil
+}
+
+func (c *chunkClient) GetManifest(ctx context.Context, req *pb.GetManifestRequest, opts ...grpc.CallOption) (*pb.GetManifestResponse, error) {
+	resp := &pb.GetManifestResponse{}
+	if c.manifest == nil {
+		return resp, nil
+	}
+	refs := make([]*pb.ChunkRef, 0, len(c.manifest))
+	for _, id := range c.manifest {
+		ref := &pb.ChunkRef{}
+		ref.SetId(id)
+		ref.SetSize(int64(len(c.chunks[id])))
+		refs = append(refs, ref)
+	}
+	resp.SetChunked(true)
+	resp.SetChunks(refs)
+	return resp, nil
+}
+
+func (c *chunkClient) DownloadChunk(ctx context.Context, req *pb.DownloadChunkRequest, opts ...grpc.CallOption) (*pb.DownloadChunkResponse, error) {
+	data, ok := c.chunks[req.GetId()]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "chunk not found")
+	}
+	resp := &pb.DownloadChunkResponse{}
+	resp.SetData(data)
+	return resp, nil
+}
+
+func TestBinaryDataManager_Chunks(t *testing.T) {
+	manager := binarydata.NewBinaryDataManager(zap.NewNop())
+	client := &chunkClient{chunks: map[string][]byte{"a": []byte("enc-a")}}
+	manager.SetClient(client)
+	ctx := context.Background()
+
+	// Файл ещё не загружен — манифеста нет
+	_, chunked, err := manager.GetManifest(ctx, "file1")
+	assert.NoError(t, err)
+	assert.False(t, chunked)
+
+	missing, err := manager.FindMissingChunks(ctx, []string{"a", "b"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"b"}, missing)
+
+	data := &model.BinaryData{Title: "file"}
+	err = manager.CommitChunked(ctx, data, []string{"a", "b"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	assert.NoError(t, manager.UploadChunk(ctx, "b", []byte("enc-b")))
+	assert.NoError(t, manager.CommitChunked(ctx, data, []string{"a", "b"}))
+	assert.Equal(t, "file1", data.ID)
+	assert.EqualValues(t, 1, data.Version)
+
+	refs, chunked, err := manager.GetManifest(ctx, "file1")
+	assert.NoError(t, err)
+	assert.True(t, chunked)
+	assert.Equal(t, []model.ChunkRef{{ID: "a", Size: 5}, {ID: "b", Size: 5}}, refs)
+
+	got, err := manager.DownloadChunk(ctx, "b")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("enc-b"), got)
+}
+
+func (c *chunkClient) VerifyBinaryData(ctx context.Context, req *pb.VerifyBinaryDataRequest, opts ...grpc.CallOption) (*pb.VerifyBinaryDataResponse, error) {
+	resp := &pb.VerifyBinaryDataResponse{}
+	if _, ok := c.chunks[req.GetId()]; !ok {
+		resp.SetDetail("chunk " + req.GetId() + " is corrupted")
+		return resp, nil
+	}
+	resp.SetValid(true)
+	return resp, nil
+}
+
+func TestBinaryDataManager_Verify(t *testing.T) {
+	manager := binarydata.NewBinaryDataManager(zap.NewNop())
+	manager.SetClient(&chunkClient{chunks: map[string][]byte{"a": []byte("enc-a")}})
+	ctx := context.Background()
+
+	valid, detail, err := manager.Verify(ctx, "a")
+	assert.NoError(t, err)
+	assert.True(t, valid)
+	assert.Empty(t, detail)
+
+	valid, detail, err = manager.Verify(ctx, "b")
+	assert.NoError(t, err)
+	assert.False(t, valid)
+	assert.Equal(t, "chunk b is corrupted", detail)
+}
+
+// versionClient — мок сервера, хранящего прежние версии файла.
+type versionClient struct {
+	pb.BinaryDataServiceClient
+	restored *pb.RestoreBinaryVersionRequest
+}
+
+func (c *versionClient) ListBinaryVersions(ctx context.Context, req *pb.ListBinaryVersionsRequest, opts ...grpc.CallOption) (*pb.ListBinaryVersionsResponse, error) {
+	info := &pb.BinaryVersionInfo{}
+	info.SetId("v1")
+	info.SetSize(10)
+	info.SetChunked(true)
+	resp := &pb.ListBinaryVersionsResponse{}
+	resp.SetVersions([]*pb.BinaryVersionInfo{info})
+	return resp, nil
+}
+
+func (c *versionClient) RestoreBinaryVersion(ctx context.Context, req *pb.RestoreBinaryVersionRequest, opts ...grpc.CallOption) (*pb.RestoreBinaryVersionResponse, error) {
+	if req.GetVersionId() != "v1" {
+		return nil, status.Error(codes.NotFound, "binary version not found")
+	}
+	c.restored = req
+	resp := &pb.RestoreBinaryVersionResponse{}
+	resp.SetVersion(req.GetVersion() + 1)
+	return resp, nil
+}
+
+func TestBinaryDataManager_Versions(t *testing.T) {
+	manager := binarydata.NewBinaryDataManager(zap.NewNop())
+	client := &versionClient{}
+	manager.SetClient(client)
+	ctx := context.Background()
+
+	versions, err := manager.ListVersions(ctx, "file1")
+	assert.NoError(t, err)
+	if assert.Len(t, versions, 1) {
+		assert.Equal(t, "v1", versions[0].ID)
+		assert.Equal(t, "file1", versions[0].BinaryID)
+		assert.True(t, versions[0].Chunked)
+	}
+
+	version, err := manager.RestoreVersion(ctx, "file1", "v1", 3)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 4, version)
+	assert.Equal(t, "file1", client.restored.GetId())
+
+	_, err = manager.RestoreVersion(ctx, "file1", "v2", 3)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
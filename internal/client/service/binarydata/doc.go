@@ -14,9 +14,16 @@
 //     Сначала отправляются метаданные (BinaryDataInfo), затем чанки байтов.
 //     В случае ошибки stream.Send() корректно запрашивается финальный статус
 //     через CloseAndRecv(), чтобы получить код/сообщение сервера.
+//     Если поток содержимого поддерживает перемотку (io.Seeker), загрузка идёт
+//     в сессии докачки: после обрыва соединения Upload узнаёт принятое сервером
+//     смещение (UploadStatus / GetUploadStatus) и продолжает передачу с него.
 //   - Download: потоковое скачивание содержимого; наружу возвращается io.ReadCloser,
 //     реализованный через io.Pipe — можно читать как обычный поток.
+//     DownloadRange скачивает диапазон (offset/length) — основа докачки.
+//   - Фрагменты файла (дедупликация): FindMissingChunks, UploadChunk,
+//     CommitChunked для загрузки и GetManifest, DownloadChunk для скачивания.
 //   - CRUD по метаданным: CreateInfo, UpdateInfo, GetInfo, List, Delete.
+//   - Проверка целостности хранимого содержимого: Verify.
 //   - Инъекция gRPC-клиента через SetClient — удобно для тестов и моков.
 //   - Подробное логирование всех операций (debug/info).
 //
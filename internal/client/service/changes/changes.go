@@ -0,0 +1,105 @@
+package changes
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+)
+
+// ChangeManagerIface описывает интерфейс получения изменений с сервера.
+type ChangeManagerIface interface {
+	ListChanges(ctx context.Context, cursor int64, limit int) (model.ChangeBatch, error)
+	WatchChanges(ctx context.Context) (<-chan model.ChangeEvent, error)
+	SetClient(client pb.SyncServiceClient)
+}
+
+// ChangeManager получает изменения записей с сервера по gRPC и логирует операции.
+type ChangeManager struct {
+	logger *zap.Logger
+	client pb.SyncServiceClient // для инъекции моков в тестах
+}
+
+// NewChangeManager создаёт новый ChangeManager.
+func NewChangeManager(logger *zap.Logger) *ChangeManager {
+	return &ChangeManager{
+		logger: logger,
+	}
+}
+
+// SetClient позволяет установить кастомный (например, моковый) gRPC-клиент.
+func (m *ChangeManager) SetClient(client pb.SyncServiceClient) {
+	m.client = client
+}
+
+// ListChanges получает порцию изменений после курсора cursor.
+// limit <= 0 означает значение по умолчанию на сервере.
+func (m *ChangeManager) ListChanges(ctx context.Context, cursor int64, limit int) (model.ChangeBatch, error) {
+	m.logger.Debug("ListChanges request started", zap.Int64("cursor", cursor), zap.Int("limit", limit))
+
+	req := &pb.ListChangesRequest{}
+	req.SetCursor(cursor)
+	if limit > 0 {
+		req.SetLimit(int32(limit))
+	}
+
+	resp, err := m.client.ListChanges(ctx, req)
+	if err != nil {
+		m.logger.Error("ListChanges RPC failed", zap.Error(err))
+		return model.ChangeBatch{}, fmt.Errorf("ListChanges RPC failed: %w", err)
+	}
+
+	batch := model.ChangeBatch{
+		Changes: make([]model.Change, 0, len(resp.GetChanges())),
+		Cursor:  resp.GetCursor(),
+		HasMore: resp.GetHasMore(),
+	}
+	for _, ch := range resp.GetChanges() {
+		batch.Changes = append(batch.Changes, mapper.ChangeFromPB(ch))
+	}
+
+	m.logger.Info("ListChanges succeeded",
+		zap.Int("count", len(batch.Changes)),
+		zap.Int64("cursor", batch.Cursor),
+		zap.Bool("hasMore", batch.HasMore),
+	)
+	return batch, nil
+}
+
+// WatchChanges открывает поток уведомлений об изменениях записей пользователя.
+//
+// Уведомления передаются в возвращаемый канал до отмены ctx или обрыва потока,
+// после чего канал закрывается. Причина обрыва записывается в лог; чтобы
+// продолжить получать уведомления, метод следует вызвать снова.
+func (m *ChangeManager) WatchChanges(ctx context.Context) (<-chan model.ChangeEvent, error) {
+	m.logger.Debug("WatchChanges request started")
+
+	stream, err := m.client.WatchChanges(ctx, &pb.WatchChangesRequest{})
+	if err != nil {
+		m.logger.Error("WatchChanges RPC failed", zap.Error(err))
+		return nil, fmt.Errorf("WatchChanges RPC failed: %w", err)
+	}
+
+	events := make(chan model.ChangeEvent)
+	go func() {
+		defer close(events)
+		for {
+			ev, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					m.logger.Warn("WatchChanges stream closed", zap.Error(err))
+				}
+				return
+			}
+			select {
+			case events <- mapper.ChangeEventFromPB(ev):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return events, nil
+}
@@ -0,0 +1,138 @@
+package changes_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/service/changes"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func setup(t *testing.T) (*changes.ChangeManager, *mocks.MockSyncServiceClient) {
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockSyncServiceClient(ctrl)
+
+	manager := changes.NewChangeManager(zaptest.NewLogger(t))
+	manager.SetClient(mockClient)
+
+	return manager, mockClient
+}
+
+func TestListChanges_Success(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	now := time.Now()
+	cred := &pb.Credential{}
+	cred.SetId("c1")
+	cred.SetTitle("GitHub")
+
+	upsert := &pb.Change{}
+	upsert.SetSeq(6)
+	upsert.SetType(pb.ItemType_ITEM_TYPE_CREDENTIAL)
+	upsert.SetId("c1")
+	upsert.SetChangedAt(timestamppb.New(now))
+	upsert.SetCredential(cred)
+
+	tombstone := &pb.Change{}
+	tombstone.SetSeq(7)
+	tombstone.SetType(pb.ItemType_ITEM_TYPE_TEXT_DATA)
+	tombstone.SetId("t1")
+	tombstone.SetDeleted(true)
+
+	resp := &pb.ListChangesResponse{}
+	resp.SetChanges([]*pb.Change{upsert, tombstone})
+	resp.SetCursor(7)
+	resp.SetHasMore(true)
+
+	mockClient.EXPECT().
+		ListChanges(gomock.Any(), gomock.Cond(func(x any) bool {
+			req := x.(*pb.ListChangesRequest)
+			return req.GetCursor() == 5 && req.GetLimit() == 100
+		})).
+		Return(resp, nil)
+
+	batch, err := manager.ListChanges(context.Background(), 5, 100)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), batch.Cursor)
+	assert.True(t, batch.HasMore)
+	require.Len(t, batch.Changes, 2)
+
+	assert.Equal(t, model.ItemTypeCredential, batch.Changes[0].Type)
+	require.NotNil(t, batch.Changes[0].Credential)
+	assert.Equal(t, "GitHub", batch.Changes[0].Credential.Title)
+	assert.True(t, batch.Changes[0].ChangedAt.Equal(now))
+
+	assert.True(t, batch.Changes[1].Deleted)
+	assert.Equal(t, model.ItemTypeTextData, batch.Changes[1].Type)
+	assert.Nil(t, batch.Changes[1].TextData)
+}
+
+func TestListChanges_RPCError(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	mockClient.EXPECT().ListChanges(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+	_, err := manager.ListChanges(context.Background(), 0, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "ListChanges RPC failed")
+}
+
+// мок потока уведомлений WatchChanges
+type mockWatchStream struct {
+	pb.SyncService_WatchChangesClient
+	events []*pb.ChangeEvent
+	idx    int
+}
+
+func (m *mockWatchStream) Recv() (*pb.ChangeEvent, error) {
+	if m.idx >= len(m.events) {
+		return nil, io.EOF
+	}
+	ev := m.events[m.idx]
+	m.idx++
+	return ev, nil
+}
+
+func TestWatchChanges_Success(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	ev := &pb.ChangeEvent{}
+	ev.SetSeq(9)
+	ev.SetType(pb.ItemType_ITEM_TYPE_BANK_CARD)
+	ev.SetId("b1")
+	ev.SetDeleted(true)
+
+	mockClient.EXPECT().WatchChanges(gomock.Any(), gomock.Any()).
+		Return(&mockWatchStream{events: []*pb.ChangeEvent{ev}}, nil)
+
+	events, err := manager.WatchChanges(context.Background())
+	require.NoError(t, err)
+
+	got, ok := <-events
+	require.True(t, ok)
+	assert.Equal(t, model.ChangeEvent{Seq: 9, Type: model.ItemTypeBankCard, ItemID: "b1", Deleted: true}, got)
+
+	// После окончания потока канал закрывается
+	_, ok = <-events
+	assert.False(t, ok)
+}
+
+func TestWatchChanges_RPCError(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	mockClient.EXPECT().WatchChanges(gomock.Any(), gomock.Any()).Return(nil, errors.New("unavailable"))
+
+	_, err := manager.WatchChanges(context.Background())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "WatchChanges RPC failed")
+}
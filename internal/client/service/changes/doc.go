@@ -0,0 +1,23 @@
+// Package changes предоставляет функционал разностной синхронизации
+// в клиентском приложении GophKeeper.
+//
+// ChangeManager взаимодействует с сервером по gRPC (SyncService) и получает
+// записи всех типов, созданные, изменённые или удалённые после курсора —
+// номера последнего полученного изменения. Удалённые записи приходят как
+// «надгробия» (Change.Deleted) без содержимого.
+//
+// WatchChanges открывает поток уведомлений об изменениях записей (в том числе
+// сделанных на других устройствах); по уведомлению клиент перечитывает данные.
+//
+// Сохранение курсора между запусками выполняет прикладной слой (см. пакет app).
+//
+// Типы:
+//   - ChangeManagerIface — интерфейс менеджера, упрощающий мокирование.
+//   - ChangeManager — конкретная реализация интерфейса.
+//
+// Пример использования:
+//
+//	manager := changes.NewChangeManager(logger)
+//	manager.SetClient(pb.NewSyncServiceClient(conn))
+//	batch, err := manager.ListChanges(ctx, cursor, 0)
+package changes
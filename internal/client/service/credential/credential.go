@@ -8,14 +8,13 @@ import (
 	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
 	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
 	"go.uber.org/zap"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // CredentialManagerIface описывает интерфейс управления учётными данными (логины/пароли).
 type CredentialManagerIface interface {
 	CreateCredential(ctx context.Context, cred *model.Credential) error
 	GetCredentialByID(ctx context.Context, id string) (*model.Credential, error)
-	GetCredentials(ctx context.Context) ([]model.Credential, error)
+	GetCredentials(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error)
 	UpdateCredential(ctx context.Context, cred *model.Credential) error
 	DeleteCredential(ctx context.Context, id string) error
 	SetClient(client pb.CredentialServiceClient)
@@ -51,11 +50,15 @@ func (m *CredentialManager) CreateCredential(ctx context.Context, cred *model.Cr
 	req := &pb.CreateCredentialRequest{}
 	req.SetCredential(mapper.CredentialToPB(cred))
 
-	_, err := m.client.CreateCredential(ctx, req)
+	resp, err := m.client.CreateCredential(ctx, req)
 	if err != nil {
 		m.logger.Error("CreateCredential RPC failed", zap.Error(err))
 		return fmt.Errorf("CreateCredential RPC failed: %w", err)
 	}
+	if created := resp.GetCredential(); created.GetId() != "" {
+		cred.ID = created.GetId()
+		cred.Version = created.GetVersion()
+	}
 
 	m.logger.Info("CreateCredential succeeded",
 		zap.String("userID", cred.UserID),
@@ -88,17 +91,22 @@ func (m *CredentialManager) GetCredentialByID(ctx context.Context, id string) (*
 	return cred, nil
 }
 
-// GetCredentialsByUserID получает список учётных данных по ID пользователя.
-func (m *CredentialManager) GetCredentials(ctx context.Context) ([]model.Credential, error) {
+// GetCredentialsByUserID получает страницу учётных данных пользователя,
+// удовлетворяющих фильтру по папке и тегам, и токен следующей страницы.
+func (m *CredentialManager) GetCredentials(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
 
 	m.logger.Debug("GetCredentialsB request started")
 
 	var creds []model.Credential
 
-	resp, err := m.client.GetCredentials(ctx, &emptypb.Empty{})
+	req := &pb.GetCredentialsRequest{}
+	req.SetFilter(mapper.ListFilterToPB(filter))
+	req.SetPage(mapper.PageRequestToPB(page))
+
+	resp, err := m.client.GetCredentials(ctx, req)
 	if err != nil {
 		m.logger.Error("GetCredentialsByUserID RPC failed", zap.Error(err))
-		return nil, fmt.Errorf("GetCredentialsByUserID RPC failed: %w", err)
+		return nil, "", fmt.Errorf("GetCredentialsByUserID RPC failed: %w", err)
 	}
 
 	creds = make([]model.Credential, 0, len(resp.GetCredentials()))
@@ -108,7 +116,7 @@ func (m *CredentialManager) GetCredentials(ctx context.Context) ([]model.Credent
 	m.logger.Info("GetCredentialsByUserID succeeded",
 		zap.Int("count", len(creds)),
 	)
-	return creds, nil
+	return creds, resp.GetNextPageToken(), nil
 }
 
 // UpdateCredential обновляет существующую учётную запись на сервере.
@@ -120,11 +128,14 @@ func (m *CredentialManager) UpdateCredential(ctx context.Context, cred *model.Cr
 	req := &pb.UpdateCredentialRequest{}
 	req.SetCredential(mapper.CredentialToPB(cred))
 
-	_, err := m.client.UpdateCredential(ctx, req)
+	resp, err := m.client.UpdateCredential(ctx, req)
 	if err != nil {
 		m.logger.Error("UpdateCredential RPC failed", zap.Error(err))
 		return fmt.Errorf("UpdateCredential RPC failed: %w", err)
 	}
+	if v := resp.GetVersion(); v != 0 {
+		cred.Version = v
+	}
 
 	m.logger.Info("UpdateCredential succeeded",
 		zap.String("credentialID", cred.ID),
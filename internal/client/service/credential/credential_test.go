@@ -104,7 +104,7 @@ func TestGetCredentials_Success(t *testing.T) {
 		GetCredentials(gomock.Any(), gomock.Any()).
 		Return(resp, nil)
 
-	creds, err := manager.GetCredentials(context.Background())
+	creds, _, err := manager.GetCredentials(context.Background(), model.ListFilter{}, model.PageRequest{})
 	if err != nil {
 		t.Fatalf("GetCredentialsByUserID failed: %v", err)
 	}
@@ -113,6 +113,57 @@ func TestGetCredentials_Success(t *testing.T) {
 	}
 }
 
+func TestGetCredentials_PassesFilter(t *testing.T) {
+	manager, ctrl, mockClient := setup(t)
+	defer ctrl.Finish()
+
+	mockClient.EXPECT().
+		GetCredentials(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *pb.GetCredentialsRequest, _ ...interface{}) (*pb.GetCredentialsResponse, error) {
+			if req.GetFilter().GetFolder() != "Work" {
+				t.Errorf("expected folder Work, got %q", req.GetFilter().GetFolder())
+			}
+			if tags := req.GetFilter().GetTags(); len(tags) != 1 || tags[0] != "tag" {
+				t.Errorf("unexpected tags %v", tags)
+			}
+			return &pb.GetCredentialsResponse{}, nil
+		})
+
+	_, _, err := manager.GetCredentials(context.Background(), model.ListFilter{Folder: "Work", Tags: []string{"tag"}}, model.PageRequest{})
+	if err != nil {
+		t.Fatalf("GetCredentials failed: %v", err)
+	}
+}
+
+func TestGetCredentials_PassesPage(t *testing.T) {
+	manager, ctrl, mockClient := setup(t)
+	defer ctrl.Finish()
+
+	mockClient.EXPECT().
+		GetCredentials(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *pb.GetCredentialsRequest, _ ...interface{}) (*pb.GetCredentialsResponse, error) {
+			page := req.GetPage()
+			if page.GetPageSize() != 25 || page.GetPageToken() != "tok" {
+				t.Errorf("unexpected page %v", page)
+			}
+			if page.GetSortBy() != pb.SortField_SORT_FIELD_UPDATED || !page.GetDescending() {
+				t.Errorf("unexpected sort %v", page)
+			}
+			resp := &pb.GetCredentialsResponse{}
+			resp.SetNextPageToken("next")
+			return resp, nil
+		})
+
+	page := model.PageRequest{Size: 25, Token: "tok", SortBy: model.SortByUpdated, Desc: true}
+	_, next, err := manager.GetCredentials(context.Background(), model.ListFilter{}, page)
+	if err != nil {
+		t.Fatalf("GetCredentials failed: %v", err)
+	}
+	if next != "next" {
+		t.Errorf("expected next page token %q, got %q", "next", next)
+	}
+}
+
 func TestUpdateCredential_Success(t *testing.T) {
 	manager, ctrl, mockClient := setup(t)
 	defer ctrl.Finish()
@@ -0,0 +1,20 @@
+// Package item предоставляет функционал для операций, общих для записей всех типов
+// (учётные данные, банковские карты, текстовые и бинарные данные), в клиентском
+// приложении GophKeeper.
+//
+// ItemManager взаимодействует с сервером по gRPC (ItemService) и позволяет:
+//   - отмечать записи как избранные и снимать отметку;
+//   - фиксировать время последнего открытия записи;
+//   - получать объединённый список избранных и недавно открытых записей;
+//   - узнавать занятое на сервере место и ограничения пользователя.
+//
+// Типы:
+//   - ItemManagerIface — интерфейс менеджера, упрощающий мокирование.
+//   - ItemManager — конкретная реализация интерфейса.
+//
+// Пример использования:
+//
+//	manager := item.NewItemManager(logger)
+//	manager.SetClient(pb.NewItemServiceClient(conn))
+//	items, err := manager.ListFavoritesRecent(ctx, 0)
+package item
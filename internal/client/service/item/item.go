@@ -0,0 +1,114 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+)
+
+// ItemManagerIface описывает интерфейс операций, общих для записей всех типов.
+type ItemManagerIface interface {
+	SetFavorite(ctx context.Context, itemType model.ItemType, id string, favorite bool) error
+	MarkAccessed(ctx context.Context, itemType model.ItemType, id string) error
+	ListFavoritesRecent(ctx context.Context, limit int) ([]model.ItemSummary, error)
+	GetUsage(ctx context.Context) (*model.Usage, error)
+	SetClient(client pb.ItemServiceClient)
+}
+
+// ItemManager управляет избранным и историей открытия записей,
+// взаимодействует с сервером по gRPC и логирует операции.
+type ItemManager struct {
+	logger *zap.Logger
+	client pb.ItemServiceClient // для инъекции моков в тестах
+}
+
+// NewItemManager создаёт новый ItemManager.
+func NewItemManager(logger *zap.Logger) *ItemManager {
+	return &ItemManager{
+		logger: logger,
+	}
+}
+
+// SetClient позволяет установить кастомный (например, моковый) gRPC-клиент.
+func (m *ItemManager) SetClient(client pb.ItemServiceClient) {
+	m.client = client
+}
+
+// SetFavorite устанавливает или снимает отметку «избранное» у записи.
+func (m *ItemManager) SetFavorite(ctx context.Context, itemType model.ItemType, id string, favorite bool) error {
+	m.logger.Debug("SetFavorite request started",
+		zap.String("itemType", string(itemType)),
+		zap.String("itemID", id),
+		zap.Bool("favorite", favorite),
+	)
+
+	req := &pb.SetFavoriteRequest{}
+	req.SetType(mapper.ItemTypeToPB(itemType))
+	req.SetId(id)
+	req.SetFavorite(favorite)
+
+	if _, err := m.client.SetFavorite(ctx, req); err != nil {
+		m.logger.Error("SetFavorite RPC failed", zap.Error(err))
+		return fmt.Errorf("SetFavorite RPC failed: %w", err)
+	}
+
+	m.logger.Info("SetFavorite succeeded", zap.String("itemID", id))
+	return nil
+}
+
+// MarkAccessed фиксирует на сервере время последнего открытия записи.
+func (m *ItemManager) MarkAccessed(ctx context.Context, itemType model.ItemType, id string) error {
+	m.logger.Debug("MarkAccessed request started",
+		zap.String("itemType", string(itemType)),
+		zap.String("itemID", id),
+	)
+
+	req := &pb.MarkAccessedRequest{}
+	req.SetType(mapper.ItemTypeToPB(itemType))
+	req.SetId(id)
+
+	if _, err := m.client.MarkAccessed(ctx, req); err != nil {
+		m.logger.Error("MarkAccessed RPC failed", zap.Error(err))
+		return fmt.Errorf("MarkAccessed RPC failed: %w", err)
+	}
+	return nil
+}
+
+// ListFavoritesRecent получает объединённый список избранных и недавно открытых записей.
+// limit <= 0 означает значение по умолчанию на сервере.
+func (m *ItemManager) ListFavoritesRecent(ctx context.Context, limit int) ([]model.ItemSummary, error) {
+	m.logger.Debug("ListFavoritesRecent request started", zap.Int("limit", limit))
+
+	req := &pb.ListFavoritesRecentRequest{}
+	if limit > 0 {
+		req.SetLimit(int32(limit))
+	}
+
+	resp, err := m.client.ListFavoritesRecent(ctx, req)
+	if err != nil {
+		m.logger.Error("ListFavoritesRecent RPC failed", zap.Error(err))
+		return nil, fmt.Errorf("ListFavoritesRecent RPC failed: %w", err)
+	}
+
+	items := make([]model.ItemSummary, 0, len(resp.GetItems()))
+	for _, pbItem := range resp.GetItems() {
+		items = append(items, mapper.ItemSummaryFromPB(pbItem))
+	}
+
+	m.logger.Info("ListFavoritesRecent succeeded", zap.Int("count", len(items)))
+	return items, nil
+}
+
+// GetUsage получает занятое пользователем место и ограничения сервера.
+func (m *ItemManager) GetUsage(ctx context.Context) (*model.Usage, error) {
+	resp, err := m.client.GetUsage(ctx, &pb.GetUsageRequest{})
+	if err != nil {
+		m.logger.Error("GetUsage RPC failed", zap.Error(err))
+		return nil, fmt.Errorf("GetUsage RPC failed: %w", err)
+	}
+	return mapper.UsageFromPB(resp), nil
+}
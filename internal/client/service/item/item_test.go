@@ -0,0 +1,96 @@
+package item_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/service/item"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/proto/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func setup(t *testing.T) (*item.ItemManager, *mocks.MockItemServiceClient) {
+	ctrl := gomock.NewController(t)
+	mockClient := mocks.NewMockItemServiceClient(ctrl)
+
+	manager := item.NewItemManager(zaptest.NewLogger(t))
+	manager.SetClient(mockClient)
+
+	return manager, mockClient
+}
+
+func TestSetFavorite_Success(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	mockClient.EXPECT().
+		SetFavorite(gomock.Any(), gomock.Cond(func(x any) bool {
+			req := x.(*pb.SetFavoriteRequest)
+			return req.GetType() == pb.ItemType_ITEM_TYPE_BANK_CARD && req.GetId() == "card1" && req.GetFavorite()
+		})).
+		Return(&pb.SetFavoriteResponse{}, nil)
+
+	err := manager.SetFavorite(context.Background(), model.ItemTypeBankCard, "card1", true)
+	require.NoError(t, err)
+}
+
+func TestMarkAccessed_Error(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	mockClient.EXPECT().
+		MarkAccessed(gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("rpc error"))
+
+	err := manager.MarkAccessed(context.Background(), model.ItemTypeTextData, "note1")
+	assert.ErrorContains(t, err, "MarkAccessed RPC failed")
+}
+
+func TestListFavoritesRecent_Success(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	accessed := time.Now()
+	pbItem := &pb.ItemSummary{}
+	pbItem.SetType(pb.ItemType_ITEM_TYPE_BINARY_DATA)
+	pbItem.SetId("file1")
+	pbItem.SetTitle("passport.pdf")
+	pbItem.SetLastAccessedAt(timestamppb.New(accessed))
+	resp := &pb.ListFavoritesRecentResponse{}
+	resp.SetItems([]*pb.ItemSummary{pbItem})
+
+	mockClient.EXPECT().
+		ListFavoritesRecent(gomock.Any(), gomock.Any()).
+		Return(resp, nil)
+
+	items, err := manager.ListFavoritesRecent(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, model.ItemTypeBinaryData, items[0].Type)
+	assert.Equal(t, "passport.pdf", items[0].Title)
+	require.NotNil(t, items[0].LastAccessedAt)
+	assert.WithinDuration(t, accessed, *items[0].LastAccessedAt, time.Second)
+}
+
+func TestGetUsage(t *testing.T) {
+	manager, mockClient := setup(t)
+
+	resp := &pb.GetUsageResponse{}
+	resp.SetBytes(2048)
+	resp.SetItems(12)
+	resp.SetMaxItems(100)
+	mockClient.EXPECT().GetUsage(gomock.Any(), gomock.Any()).Return(resp, nil)
+
+	usage, err := manager.GetUsage(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, &model.Usage{Bytes: 2048, Items: 12, Limits: model.Quota{MaxItems: 100}}, usage)
+
+	mockClient.EXPECT().GetUsage(gomock.Any(), gomock.Any()).Return(nil, errors.New("rpc error"))
+	_, err = manager.GetUsage(context.Background())
+	assert.ErrorContains(t, err, "GetUsage RPC failed")
+}
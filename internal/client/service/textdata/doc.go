@@ -40,6 +40,6 @@
 //	}
 //	err := tdManager.CreateTextData(ctx, text)
 //
-//	titles, err := tdManager.GetTextDataTitles(ctx)
+//	titles, next, err := tdManager.GetTextDataTitles(ctx, model.ListFilter{}, model.PageRequest{})
 //	note, err := tdManager.GetTextDataByID(ctx, "note-123")
 package textdata
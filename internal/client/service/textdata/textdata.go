@@ -21,8 +21,9 @@ type TextDataManagerIface interface {
 	// GetTextDataByID получает текстовую запись по её идентификатору.
 	GetTextDataByID(ctx context.Context, id string) (*model.TextData, error)
 
-	// GetTextDataTitles возвращает список всех заголовков текстовых данных пользователя.
-	GetTextDataTitles(ctx context.Context) ([]*model.TextData, error)
+	// GetTextDataTitles возвращает список заголовков текстовых данных пользователя,
+	// удовлетворяющих фильтру по папке и тегам.
+	GetTextDataTitles(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error)
 
 	// UpdateTextData обновляет текстовую запись на сервере.
 	UpdateTextData(ctx context.Context, data *model.TextData) error
@@ -60,11 +61,15 @@ func (m *TextDataManager) CreateTextData(ctx context.Context, data *model.TextDa
 	req := &pb.CreateTextDataRequest{}
 	req.SetTextData(mapper.TextDataToPB(data))
 
-	_, err := m.client.CreateTextData(ctx, req)
+	resp, err := m.client.CreateTextData(ctx, req)
 	if err != nil {
 		m.logger.Error("CreateTextData RPC failed", zap.Error(err))
 		return fmt.Errorf("CreateTextData RPC failed: %w", err)
 	}
+	if created := resp.GetTextData(); created.GetId() != "" {
+		data.ID = created.GetId()
+		data.Version = created.GetVersion()
+	}
 
 	m.logger.Info("CreateTextData succeeded", zap.String("textDataID", data.ID))
 	return nil
@@ -83,13 +88,18 @@ func (m *TextDataManager) GetTextDataByID(ctx context.Context, id string) (*mode
 	return mapper.TextDataFromPB(resp.GetTextData()), nil
 }
 
-// GetTextDataTitles возвращает список всех текстовых данных пользователя с их ID и Title.
-// Content и Metadata не возвращаются для экономии трафика.
-func (m *TextDataManager) GetTextDataTitles(ctx context.Context) ([]*model.TextData, error) {
-	resp, err := m.client.GetTextDataTitles(ctx, &pb.GetTextDataTitlesRequest{})
+// GetTextDataTitles возвращает страницу текстовых данных пользователя с их ID, Title,
+// Folder и Tags и токен следующей страницы. Content и Metadata не возвращаются
+// для экономии трафика.
+func (m *TextDataManager) GetTextDataTitles(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	req := &pb.GetTextDataTitlesRequest{}
+	req.SetFilter(mapper.ListFilterToPB(filter))
+	req.SetPage(mapper.PageRequestToPB(page))
+
+	resp, err := m.client.GetTextDataTitles(ctx, req)
 	if err != nil {
 		m.logger.Error("GetTextDataTitles RPC failed", zap.Error(err))
-		return nil, fmt.Errorf("GetTextDataTitles RPC failed: %w", err)
+		return nil, "", fmt.Errorf("GetTextDataTitles RPC failed: %w", err)
 	}
 
 	result := make([]*model.TextData, 0, len(resp.GetTextDataTitles()))
@@ -100,7 +110,7 @@ func (m *TextDataManager) GetTextDataTitles(ctx context.Context) ([]*model.TextD
 	m.logger.Info("GetTextDataTitles succeeded",
 		zap.Int("count", len(result)),
 	)
-	return result, nil
+	return result, resp.GetNextPageToken(), nil
 }
 
 // UpdateTextData обновляет текстовую запись на сервере.
@@ -113,10 +123,13 @@ func (m *TextDataManager) UpdateTextData(ctx context.Context, data *model.TextDa
 	req := &pb.UpdateTextDataRequest{}
 	req.SetTextData(mapper.TextDataToPB(data))
 
-	_, err := m.client.UpdateTextData(ctx, req)
+	resp, err := m.client.UpdateTextData(ctx, req)
 	if err != nil {
 		return fmt.Errorf("UpdateTextData RPC failed: %w", err)
 	}
+	if v := resp.GetVersion(); v != 0 {
+		data.Version = v
+	}
 
 	return nil
 }
@@ -126,7 +126,7 @@ func TestTextDataManager_GetTextDataTitles(t *testing.T) {
 			GetTextDataTitles(gomock.Any(), gomock.Any()).
 			Return(resp, nil)
 
-		result, err := th.manager.GetTextDataTitles(context.Background())
+		result, _, err := th.manager.GetTextDataTitles(context.Background(), model.ListFilter{}, model.PageRequest{})
 		assert.NoError(t, err)
 		assert.Len(t, result, 2)
 		assert.Equal(t, "1", result[0].ID)
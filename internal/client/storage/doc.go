@@ -1,13 +1,14 @@
 // Package storage предоставляет интерфейсы и реализации для
 // хранения чувствительных данных клиента, таких как токены
-// авторизации и ключи шифрования.
+// авторизации и ключи шифрования, а также состояния синхронизации.
 //
 // Интерфейсы TokenStorage и CryptoKeyStorage описывают абстракции
 // для сохранения, загрузки и удаления токенов и криптографических ключей.
 //
 // В пакете реализованы файловые варианты хранения: FileTokenStorage и
 // FileCryptoKeyStorage, которые сохраняют данные в локальной файловой системе
-// с безопасными правами доступа.
+// с безопасными правами доступа, а также FileSyncCursorStorage для курсора
+// разностной синхронизации (SyncCursorStorage).
 //
 // Благодаря разделению интерфейсов и реализаций пакет обеспечивает гибкость
 // и расширяемость, позволяя в будущем добавлять новые виды хранилищ, например,
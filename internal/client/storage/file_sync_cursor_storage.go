@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// FileSyncCursorStorage реализует интерфейс SyncCursorStorage,
+// храня курсор синхронизации в текстовом файле в виде десятичного числа.
+type FileSyncCursorStorage struct {
+	path string
+}
+
+// NewFileSyncCursorStorage создаёт новый экземпляр FileSyncCursorStorage.
+func NewFileSyncCursorStorage(path string) *FileSyncCursorStorage {
+	return &FileSyncCursorStorage{path: path}
+}
+
+// Save сохраняет курсор в файл с правами доступа 0600.
+// Если родительская директория отсутствует, она будет создана с правами 0700.
+func (f *FileSyncCursorStorage) Save(cursor int64) error {
+	if err := os.MkdirAll(filepath.Dir(f.path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(f.path, []byte(strconv.FormatInt(cursor, 10)), 0600)
+}
+
+// Load читает курсор из файла.
+// Если файл отсутствует (синхронизация ещё не выполнялась), возвращает 0.
+func (f *FileSyncCursorStorage) Load() (int64, error) {
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(strings.TrimSpace(string(data)), 10, 64)
+}
+
+// Clear удаляет файл курсора. Отсутствие файла ошибкой не считается.
+func (f *FileSyncCursorStorage) Clear() error {
+	if err := os.Remove(f.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileSyncCursorStorage_SaveLoadClear(t *testing.T) {
+	store := NewFileSyncCursorStorage(filepath.Join(t.TempDir(), "a", "sync_cursor"))
+
+	// До первой синхронизации курсор равен нулю
+	cursor, err := store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), cursor)
+
+	require.NoError(t, store.Save(42))
+	cursor, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(42), cursor)
+
+	require.NoError(t, store.Clear())
+	require.NoError(t, store.Clear(), "clearing a missing cursor should not fail")
+
+	cursor, err = store.Load()
+	require.NoError(t, err)
+	require.Equal(t, int64(0), cursor)
+}
+
+func TestFileSyncCursorStorage_LoadCorrupted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sync_cursor")
+	require.NoError(t, os.WriteFile(path, []byte("not-a-number"), 0600))
+
+	_, err := NewFileSyncCursorStorage(path).Load()
+	require.Error(t, err)
+}
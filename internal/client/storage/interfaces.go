@@ -17,6 +17,20 @@ type TokenStorage interface {
 	Clear() error
 }
 
+// SyncCursorStorage описывает интерфейс для хранения курсора синхронизации —
+// номера последнего изменения, полученного с сервера.
+type SyncCursorStorage interface {
+	// Save сохраняет курсор.
+	Save(cursor int64) error
+
+	// Load загружает курсор.
+	// Если курсор ещё не сохранялся, возвращает 0.
+	Load() (int64, error)
+
+	// Clear удаляет сохранённый курсор.
+	Clear() error
+}
+
 // CryptoKeyStorage описывает интерфейс для хранения ключа шифрования и параметров KDF.
 type CryptoKeyStorage interface {
 	// Save сохраняет ключ шифрования и параметры KDF.
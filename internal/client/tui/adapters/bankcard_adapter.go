@@ -18,21 +18,24 @@ func NewBankCardAdapter(svc contracts.BankCardService) *BankCardAdapter {
 	return &BankCardAdapter{svc: svc}
 }
 
-// List — возвращает список банковских карт в виде []ListItem
-func (a *BankCardAdapter) List(ctx context.Context) ([]contracts.ListItem, error) {
-	cards, err := a.svc.GetBankCards(ctx)
+// List — возвращает страницу банковских карт, подходящих под фильтр, в виде []ListItem и токен следующей страницы
+func (a *BankCardAdapter) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
+	cards, next, err := a.svc.GetBankCards(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list bank cards: %w", err)
+		return nil, "", fmt.Errorf("failed to list bank cards: %w", err)
 	}
 
 	items := make([]contracts.ListItem, 0, len(cards))
 	for _, c := range cards {
 		items = append(items, contracts.ListItem{
-			ID:    c.ID,
-			Title: c.Title,
+			ID:       c.ID,
+			Title:    c.Title,
+			Folder:   c.Folder,
+			Tags:     c.Tags,
+			Favorite: c.Favorite,
 		})
 	}
-	return items, nil
+	return items, next, nil
 }
 
 // Get — возвращает BankCard по ID
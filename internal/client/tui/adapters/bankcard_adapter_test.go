@@ -25,9 +25,9 @@ func (m *MockBankCardService) GetBankCardByID(ctx context.Context, id string) (*
 	return args.Get(0).(*model.BankCard), args.Error(1)
 }
 
-func (m *MockBankCardService) GetBankCards(ctx context.Context) ([]model.BankCard, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]model.BankCard), args.Error(1)
+func (m *MockBankCardService) GetBankCards(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
+	args := m.Called(ctx, filter, page)
+	return args.Get(0).([]model.BankCard), args.String(1), args.Error(2)
 }
 
 func (m *MockBankCardService) UpdateBankCard(ctx context.Context, card *model.BankCard) error {
@@ -49,9 +49,9 @@ func TestBankCardAdapter_List(t *testing.T) {
 		{ID: "2", Title: "Card 2"},
 	}
 
-	mockSvc.On("GetBankCards", mock.Anything).Return(cards, nil)
+	mockSvc.On("GetBankCards", mock.Anything, model.ListFilter{}, model.PageRequest{}).Return(cards, "", nil)
 
-	items, err := adapter.List(context.Background())
+	items, _, err := adapter.List(context.Background(), model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, items, 2)
 	assert.Equal(t, "Card 1", items[0].Title)
@@ -18,21 +18,25 @@ func NewBinaryDataAdapter(svc contracts.BinaryDataService) *BinaryDataAdapter {
 	return &BinaryDataAdapter{svc: svc}
 }
 
-// List возвращает список всех бинарных объектов пользователя в виде списка элементов.
-func (a *BinaryDataAdapter) List(ctx context.Context) ([]contracts.ListItem, error) {
-	dataList, err := a.svc.ListBinaryData(ctx)
+// List возвращает страницу бинарных объектов пользователя, подходящих под фильтр, в виде списка элементов
+// и токен следующей страницы.
+func (a *BinaryDataAdapter) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
+	dataList, next, err := a.svc.ListBinaryData(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list binary data: %w", err)
+		return nil, "", fmt.Errorf("failed to list binary data: %w", err)
 	}
 
 	items := make([]contracts.ListItem, 0, len(dataList))
 	for _, d := range dataList {
 		items = append(items, contracts.ListItem{
-			ID:    d.ID,
-			Title: d.Title,
+			ID:       d.ID,
+			Title:    d.Title,
+			Folder:   d.Folder,
+			Tags:     d.Tags,
+			Favorite: d.Favorite,
 		})
 	}
-	return items, nil
+	return items, next, nil
 }
 
 // Get получает метаданные бинарных данных по их идентификатору.
@@ -82,3 +86,23 @@ func (a *BinaryDataAdapter) UploadBinaryData(ctx context.Context, data *model.Bi
 func (a *BinaryDataAdapter) DownloadBinaryData(ctx context.Context, dataID, destPath string, progress chan<- int64) error {
 	return a.svc.DownloadBinaryData(ctx, dataID, destPath, progress)
 }
+
+// ListBinaryVersions возвращает прежние версии содержимого файла.
+func (a *BinaryDataAdapter) ListBinaryVersions(ctx context.Context, dataID string) ([]model.BinaryVersion, error) {
+	return a.svc.ListBinaryVersions(ctx, dataID)
+}
+
+// DownloadBinaryVersion скачивает прежнюю версию содержимого файла в указанное место.
+func (a *BinaryDataAdapter) DownloadBinaryVersion(ctx context.Context, dataID, versionID, destPath string, progress chan<- int64) error {
+	return a.svc.DownloadBinaryVersion(ctx, dataID, versionID, destPath, progress)
+}
+
+// RestoreBinaryVersion делает прежнюю версию текущим содержимым файла.
+func (a *BinaryDataAdapter) RestoreBinaryVersion(ctx context.Context, dataID, versionID string, version int64) (*model.BinaryData, error) {
+	return a.svc.RestoreBinaryVersion(ctx, dataID, versionID, version)
+}
+
+// ReadBinaryData расшифровывает содержимое файла в память для просмотра.
+func (a *BinaryDataAdapter) ReadBinaryData(ctx context.Context, dataID string, limit int64) ([]byte, error) {
+	return a.svc.ReadBinaryData(ctx, dataID, limit)
+}
@@ -32,9 +32,9 @@ func (m *MockBinaryDataService) GetBinaryDataInfo(ctx context.Context, id string
 	return args.Get(0).(*model.BinaryData), args.Error(1)
 }
 
-func (m *MockBinaryDataService) ListBinaryData(ctx context.Context) ([]model.BinaryData, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]model.BinaryData), args.Error(1)
+func (m *MockBinaryDataService) ListBinaryData(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BinaryData, string, error) {
+	args := m.Called(ctx, filter, page)
+	return args.Get(0).([]model.BinaryData), args.String(1), args.Error(2)
 }
 
 func (m *MockBinaryDataService) DeleteBinaryData(ctx context.Context, id string) error {
@@ -52,6 +52,25 @@ func (m *MockBinaryDataService) UpdateBinaryDataInfo(ctx context.Context, data *
 	return args.Error(0)
 }
 
+func (m *MockBinaryDataService) ListBinaryVersions(ctx context.Context, id string) ([]model.BinaryVersion, error) {
+	args := m.Called(ctx, id)
+	return args.Get(0).([]model.BinaryVersion), args.Error(1)
+}
+
+func (m *MockBinaryDataService) DownloadBinaryVersion(ctx context.Context, dataID, versionID, destPath string, progressCh chan<- int64) error {
+	return nil
+}
+
+func (m *MockBinaryDataService) RestoreBinaryVersion(ctx context.Context, id, versionID string, version int64) (*model.BinaryData, error) {
+	args := m.Called(ctx, id, versionID, version)
+	return args.Get(0).(*model.BinaryData), args.Error(1)
+}
+
+func (m *MockBinaryDataService) ReadBinaryData(ctx context.Context, id string, limit int64) ([]byte, error) {
+	args := m.Called(ctx, id, limit)
+	return args.Get(0).([]byte), args.Error(1)
+}
+
 func TestBinaryDataAdapter_List(t *testing.T) {
 	mockSvc := new(MockBinaryDataService)
 	adapter := adapters.NewBinaryDataAdapter(mockSvc)
@@ -61,9 +80,9 @@ func TestBinaryDataAdapter_List(t *testing.T) {
 		{ID: "2", Title: "Data 2"},
 	}
 
-	mockSvc.On("ListBinaryData", mock.Anything).Return(dataList, nil)
+	mockSvc.On("ListBinaryData", mock.Anything, model.ListFilter{}, model.PageRequest{}).Return(dataList, "", nil)
 
-	items, err := adapter.List(context.Background())
+	items, _, err := adapter.List(context.Background(), model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, items, 2)
 	assert.Equal(t, "Data 1", items[0].Title)
@@ -150,3 +169,33 @@ func TestBinaryDataAdapter_Update_IDMismatch(t *testing.T) {
 	assert.Equal(t, "1", data.ID)
 	mockSvc.AssertExpectations(t)
 }
+
+func TestBinaryDataAdapter_Versions(t *testing.T) {
+	mockSvc := new(MockBinaryDataService)
+	adapter := adapters.NewBinaryDataAdapter(mockSvc)
+	ctx := context.Background()
+
+	versions := []model.BinaryVersion{{ID: "v1", Size: 10}}
+	mockSvc.On("ListBinaryVersions", ctx, "1").Return(versions, nil)
+	got, err := adapter.ListBinaryVersions(ctx, "1")
+	assert.NoError(t, err)
+	assert.Equal(t, versions, got)
+
+	mockSvc.On("RestoreBinaryVersion", ctx, "1", "v1", int64(2)).Return(&model.BinaryData{ID: "1", Version: 3}, nil)
+	data, err := adapter.RestoreBinaryVersion(ctx, "1", "v1", 2)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, data.Version)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestBinaryDataAdapter_ReadBinaryData(t *testing.T) {
+	mockSvc := new(MockBinaryDataService)
+	adapter := adapters.NewBinaryDataAdapter(mockSvc)
+	ctx := context.Background()
+
+	mockSvc.On("ReadBinaryData", ctx, "1", int64(1024)).Return([]byte("codes"), nil)
+	got, err := adapter.ReadBinaryData(ctx, "1", 1024)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("codes"), got)
+	mockSvc.AssertExpectations(t)
+}
@@ -18,21 +18,24 @@ func NewCredentialAdapter(svc contracts.CredentialService) *CredentialAdapter {
 	return &CredentialAdapter{svc: svc}
 }
 
-// List — возвращает список учётных данных в виде []ListItem
-func (a *CredentialAdapter) List(ctx context.Context) ([]contracts.ListItem, error) {
-	creds, err := a.svc.GetCredentials(ctx)
+// List — возвращает страницу учётных данных, подходящих под фильтр, в виде []ListItem и токен следующей страницы
+func (a *CredentialAdapter) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
+	creds, next, err := a.svc.GetCredentials(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list credentials: %w", err)
+		return nil, "", fmt.Errorf("failed to list credentials: %w", err)
 	}
 
 	items := make([]contracts.ListItem, 0, len(creds))
 	for _, c := range creds {
 		items = append(items, contracts.ListItem{
-			ID:    c.ID,
-			Title: c.Title,
+			ID:       c.ID,
+			Title:    c.Title,
+			Folder:   c.Folder,
+			Tags:     c.Tags,
+			Favorite: c.Favorite,
 		})
 	}
-	return items, nil
+	return items, next, nil
 }
 
 // Get — возвращает Credential по ID
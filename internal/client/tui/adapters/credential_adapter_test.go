@@ -24,9 +24,9 @@ func (m *MockCredentialService) GetCredentialByID(ctx context.Context, id string
 	args := m.Called(ctx, id)
 	return args.Get(0).(*model.Credential), args.Error(1)
 }
-func (m *MockCredentialService) GetCredentials(ctx context.Context) ([]model.Credential, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]model.Credential), args.Error(1)
+func (m *MockCredentialService) GetCredentials(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
+	args := m.Called(ctx, filter, page)
+	return args.Get(0).([]model.Credential), args.String(1), args.Error(2)
 }
 func (m *MockCredentialService) UpdateCredential(ctx context.Context, cred *model.Credential) error {
 	args := m.Called(ctx, cred)
@@ -46,15 +46,51 @@ func TestCredentialAdapter_List(t *testing.T) {
 		{ID: "2", Title: "cred2"},
 	}
 
-	mockSvc.On("GetCredentials", mock.Anything).Return(creds, nil)
+	mockSvc.On("GetCredentials", mock.Anything, model.ListFilter{}, model.PageRequest{}).Return(creds, "", nil)
 
-	items, err := adapter.List(context.Background())
+	items, _, err := adapter.List(context.Background(), model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, items, 2)
 	assert.Equal(t, "cred1", items[0].Title)
 	mockSvc.AssertExpectations(t)
 }
 
+func TestCredentialAdapter_List_WithFilter(t *testing.T) {
+	mockSvc := new(MockCredentialService)
+	adapter := adapters.NewCredentialAdapter(mockSvc)
+
+	filter := model.ListFilter{Folder: "Work", Tags: []string{"prod"}}
+	creds := []model.Credential{
+		{ID: "1", Title: "cred1", Folder: "Work", Tags: model.Tags{"prod", "db"}, Favorite: true},
+	}
+
+	mockSvc.On("GetCredentials", mock.Anything, filter, model.PageRequest{}).Return(creds, "", nil)
+
+	items, _, err := adapter.List(context.Background(), filter, model.PageRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "Work", items[0].Folder)
+	assert.Equal(t, []string{"prod", "db"}, items[0].Tags)
+	assert.True(t, items[0].Favorite)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestCredentialAdapter_List_Page(t *testing.T) {
+	mockSvc := new(MockCredentialService)
+	adapter := adapters.NewCredentialAdapter(mockSvc)
+
+	page := model.PageRequest{Token: "tok", SortBy: model.SortByTitle}
+	creds := []model.Credential{{ID: "3", Title: "cred3"}}
+
+	mockSvc.On("GetCredentials", mock.Anything, model.ListFilter{}, page).Return(creds, "next", nil)
+
+	items, next, err := adapter.List(context.Background(), model.ListFilter{}, page)
+	assert.NoError(t, err)
+	assert.Len(t, items, 1)
+	assert.Equal(t, "next", next)
+	mockSvc.AssertExpectations(t)
+}
+
 func TestCredentialAdapter_Get(t *testing.T) {
 	mockSvc := new(MockCredentialService)
 	adapter := adapters.NewCredentialAdapter(mockSvc)
@@ -8,7 +8,7 @@
 // Пример использования:
 //
 //	credAdapter := adapters.NewCredentialAdapter(credService)
-//	items, err := credAdapter.List(ctx)
+//	items, next, err := credAdapter.List(ctx, model.ListFilter{}, model.PageRequest{})
 //	if err != nil {
 //	    // обработка ошибки
 //	}
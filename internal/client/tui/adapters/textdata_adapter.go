@@ -18,21 +18,24 @@ func NewTextDataAdapter(svc contracts.TextDataService) *TextDataAdapter {
 	return &TextDataAdapter{svc: svc}
 }
 
-// List — возвращает список текстовых данных (только заголовки) в виде []ListItem
-func (a *TextDataAdapter) List(ctx context.Context) ([]contracts.ListItem, error) {
-	texts, err := a.svc.GetTextDataTitles(ctx)
+// List — возвращает страницу текстовых данных (только заголовки), подходящих под фильтр, в виде []ListItem и токен следующей страницы
+func (a *TextDataAdapter) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
+	texts, next, err := a.svc.GetTextDataTitles(ctx, filter, page)
 	if err != nil {
-		return nil, fmt.Errorf("failed to list text data: %w", err)
+		return nil, "", fmt.Errorf("failed to list text data: %w", err)
 	}
 
 	items := make([]contracts.ListItem, 0, len(texts))
 	for _, t := range texts {
 		items = append(items, contracts.ListItem{
-			ID:    t.ID,
-			Title: t.Title,
+			ID:       t.ID,
+			Title:    t.Title,
+			Folder:   t.Folder,
+			Tags:     t.Tags,
+			Favorite: t.Favorite,
 		})
 	}
-	return items, nil
+	return items, next, nil
 }
 
 // Get — возвращает TextData по ID
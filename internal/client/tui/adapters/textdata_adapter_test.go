@@ -25,9 +25,9 @@ func (m *MockTextDataService) GetTextDataByID(ctx context.Context, id string) (*
 	return args.Get(0).(*model.TextData), args.Error(1)
 }
 
-func (m *MockTextDataService) GetTextDataTitles(ctx context.Context) ([]*model.TextData, error) {
-	args := m.Called(ctx)
-	return args.Get(0).([]*model.TextData), args.Error(1)
+func (m *MockTextDataService) GetTextDataTitles(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	args := m.Called(ctx, filter, page)
+	return args.Get(0).([]*model.TextData), args.String(1), args.Error(2)
 }
 
 func (m *MockTextDataService) UpdateTextData(ctx context.Context, text *model.TextData) error {
@@ -49,9 +49,9 @@ func TestTextDataAdapter_List(t *testing.T) {
 		{ID: "2", Title: "Text 2"},
 	}
 
-	mockSvc.On("GetTextDataTitles", mock.Anything).Return(texts, nil)
+	mockSvc.On("GetTextDataTitles", mock.Anything, model.ListFilter{}, model.PageRequest{}).Return(texts, "", nil)
 
-	items, err := adapter.List(context.Background())
+	items, _, err := adapter.List(context.Background(), model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, items, 2)
 	assert.Equal(t, "Text 1", items[0].Title)
@@ -0,0 +1,145 @@
+package tui
+
+import (
+	"context"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// watchRetryDelay — пауза перед повторной подпиской после обрыва потока уведомлений.
+const watchRetryDelay = 5 * time.Second
+
+// Сообщения подписки на уведомления об изменениях
+type changesWatchStartedMsg struct {
+	events <-chan model.ChangeEvent
+	cancel context.CancelFunc
+}
+type changesWatchFailedMsg struct{ err error }
+type changesWatchStoppedMsg struct{ events <-chan model.ChangeEvent }
+type changesWatchRetryMsg struct{}
+type changeEventMsg struct {
+	event  model.ChangeEvent
+	events <-chan model.ChangeEvent
+}
+
+// startWatching возвращает команду подписки на уведомления об изменениях,
+// если сервис подписки задан и подписка ещё не открыта.
+func (m *Model) startWatching() tea.Cmd {
+	if m.changeWatcher == nil || m.watching {
+		return nil
+	}
+	m.watching = true
+	watcher, parent := m.changeWatcher, m.ctx
+	return func() tea.Msg {
+		ctx, cancel := context.WithCancel(parent)
+		events, err := watcher.WatchChanges(ctx)
+		if err != nil {
+			cancel()
+			return changesWatchFailedMsg{err}
+		}
+		return changesWatchStartedMsg{events: events, cancel: cancel}
+	}
+}
+
+// stopWatching закрывает текущую подписку на уведомления.
+func (m *Model) stopWatching() {
+	if m.watchCancel != nil {
+		m.watchCancel()
+	}
+	m.watchCancel = nil
+	m.watchEvents = nil
+	m.watching = false
+}
+
+// waitChangeEvent возвращает команду ожидания следующего уведомления из канала.
+func waitChangeEvent(events <-chan model.ChangeEvent) tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-events
+		if !ok {
+			return changesWatchStoppedMsg{events: events}
+		}
+		return changeEventMsg{event: ev, events: events}
+	}
+}
+
+// updateChanges обрабатывает сообщения подписки на уведомления об изменениях
+// независимо от текущего экрана. Второе значение равно false, если сообщение
+// к подписке не относится.
+func updateChanges(m Model, msg tea.Msg) (Model, tea.Cmd, bool) {
+	switch msg := msg.(type) {
+	case changesWatchStartedMsg:
+		if !m.watching {
+			// Подписка была отменена (например, при смене пользователя), пока открывалась
+			msg.cancel()
+			return m, nil, true
+		}
+		m.watchEvents = msg.events
+		m.watchCancel = msg.cancel
+		return m, waitChangeEvent(msg.events), true
+
+	case changesWatchFailedMsg:
+		// Не удалось подписаться (нет связи или авторизации) — повторим при следующей загрузке списка
+		m.watching = false
+		return m, nil, true
+
+	case changesWatchStoppedMsg:
+		if msg.events != m.watchEvents {
+			return m, nil, true // закрылся канал прежней подписки
+		}
+		m.stopWatching()
+		return m, tea.Tick(watchRetryDelay, func(time.Time) tea.Msg { return changesWatchRetryMsg{} }), true
+
+	case changesWatchRetryMsg:
+		return m, m.startWatching(), true
+
+	case changeEventMsg:
+		if msg.events != m.watchEvents {
+			return m, nil, true
+		}
+		return m, tea.Batch(waitChangeEvent(msg.events), m.refreshOnChange(msg.event)), true
+	}
+	return m, nil, false
+}
+
+// refreshOnChange возвращает команду обновления текущего экрана после изменения записи:
+// список перечитывается, если изменилась запись отображаемого типа,
+// экран избранного — при любом изменении.
+func (m *Model) refreshOnChange(ev model.ChangeEvent) tea.Cmd {
+	switch m.currentState {
+	case "list":
+		if dataType, ok := contracts.DataTypeFromItemType(ev.Type); ok && dataType == m.currentType {
+			return m.refreshList()
+		}
+	case "favorites":
+		return m.loadFavorites()
+	}
+	return nil
+}
+
+// refreshList возвращает команду повторной загрузки списка с сохранением
+// позиции курсора. Страницы перечитываются, пока не покроют уже загруженные
+// элементы, чтобы обновление не отбрасывало догруженные пользователем страницы.
+func (m *Model) refreshList() tea.Cmd {
+	svc, ctx := m.services[m.currentType], m.ctx
+	filter := m.listFilter
+	dataType, sortIdx := m.currentType, m.listSortIdx
+	page := listSortModes[sortIdx].page
+	loaded := len(m.listItems)
+	return func() tea.Msg {
+		var items []contracts.ListItem
+		for {
+			batch, next, err := svc.List(ctx, filter, page)
+			if err != nil {
+				return errMsg{err}
+			}
+			items = append(items, batch...)
+			if next == "" || len(items) >= loaded {
+				return listLoadedMsg{items: items, filter: filter, next: next, refresh: true, dataType: dataType, sortIdx: sortIdx}
+			}
+			page.Token = next
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Фейковый ChangeWatcher ---
+type fakeChangeWatcher struct {
+	events chan model.ChangeEvent
+	err    error
+	calls  int
+}
+
+func (f *fakeChangeWatcher) WatchChanges(ctx context.Context) (<-chan model.ChangeEvent, error) {
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return f.events, nil
+}
+
+// newWatchingListModel возвращает модель на экране списка с открытой подпиской.
+func newWatchingListModel(t *testing.T, svc contracts.DataService, watcher *fakeChangeWatcher) Model {
+	t.Helper()
+	m := Model{
+		ctx:           context.Background(),
+		currentState:  "list",
+		currentType:   contracts.TypeNotes,
+		changeWatcher: watcher,
+		services:      map[contracts.DataType]contracts.DataService{contracts.TypeNotes: svc},
+	}
+
+	// Первая загрузка списка открывает подписку
+	updated, cmd := m.Update(listLoadedMsg{items: []contracts.ListItem{{ID: "a"}, {ID: "b"}}})
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	assert.True(t, m.watching)
+
+	updated, cmd = m.Update(cmd())
+	m = updated.(Model)
+	require.NotNil(t, cmd, "expected command waiting for the next event")
+	require.NotNil(t, m.watchEvents)
+	assert.Equal(t, 1, watcher.calls)
+	return m
+}
+
+func TestChanges_EventRefreshesCurrentList(t *testing.T) {
+	svc := &fakeDataService{data: map[string]interface{}{"a": nil, "b": nil, "c": nil}}
+	watcher := &fakeChangeWatcher{events: make(chan model.ChangeEvent, 1)}
+	m := newWatchingListModel(t, svc, watcher)
+	m.listCursor = 1
+
+	watcher.events <- model.ChangeEvent{Seq: 1, Type: model.ItemTypeTextData, ItemID: "c"}
+	msg := waitChangeEvent(m.watchEvents)()
+	require.IsType(t, changeEventMsg{}, msg)
+
+	updated, cmd := m.Update(msg)
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+
+	// Событие относится к отображаемому типу — список перечитывается с сохранением курсора
+	refresh := m.refreshOnChange(msg.(changeEventMsg).event)
+	require.NotNil(t, refresh)
+	loaded, ok := refresh().(listLoadedMsg)
+	require.True(t, ok)
+	assert.True(t, loaded.refresh)
+
+	updated, _ = m.Update(loaded)
+	m = updated.(Model)
+	assert.Len(t, m.listItems, 3)
+	assert.Equal(t, 1, m.listCursor)
+}
+
+// pagedDataService отдаёт список страницами из pages; токен — номер следующей страницы.
+type pagedDataService struct {
+	fakeDataService
+	pages [][]contracts.ListItem
+	calls int
+}
+
+func (p *pagedDataService) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
+	p.calls++
+	i := 0
+	if page.Token != "" {
+		i, _ = strconv.Atoi(page.Token)
+	}
+	next := ""
+	if i+1 < len(p.pages) {
+		next = strconv.Itoa(i + 1)
+	}
+	return p.pages[i], next, nil
+}
+
+func TestChanges_RefreshKeepsLoadedPages(t *testing.T) {
+	svc := &pagedDataService{pages: [][]contracts.ListItem{
+		{{ID: "a"}, {ID: "b"}},
+		{{ID: "c"}, {ID: "d"}},
+		{{ID: "e"}},
+	}}
+	m := Model{
+		ctx:           context.Background(),
+		currentState:  "list",
+		currentType:   contracts.TypeNotes,
+		services:      map[contracts.DataType]contracts.DataService{contracts.TypeNotes: svc},
+		listItems:     []contracts.ListItem{{ID: "a"}, {ID: "b"}, {ID: "c"}, {ID: "d"}},
+		listNextToken: "2",
+		listCursor:    3,
+	}
+
+	// Загружены две страницы — перечитываются обе, третья остаётся догружаемой
+	loaded, ok := m.refreshList()().(listLoadedMsg)
+	require.True(t, ok)
+	assert.Equal(t, 2, svc.calls)
+
+	updated, _ := m.Update(loaded)
+	m = updated.(Model)
+	assert.Len(t, m.listItems, 4)
+	assert.Equal(t, "2", m.listNextToken)
+	assert.Equal(t, 3, m.listCursor)
+}
+
+func TestChanges_EventOfOtherTypeIgnored(t *testing.T) {
+	m := Model{currentState: "list", currentType: contracts.TypeNotes}
+	assert.Nil(t, m.refreshOnChange(model.ChangeEvent{Type: model.ItemTypeBankCard}))
+
+	m.currentState = "menu"
+	assert.Nil(t, m.refreshOnChange(model.ChangeEvent{Type: model.ItemTypeTextData}))
+}
+
+func TestChanges_StaleRefreshDropped(t *testing.T) {
+	m := Model{
+		currentState: "list",
+		currentType:  contracts.TypeCards,
+		listItems:    []contracts.ListItem{{ID: "card"}},
+	}
+
+	// Список заметок перечитан, но пользователь уже перешёл к картам
+	updated, _ := m.Update(listLoadedMsg{items: []contracts.ListItem{{ID: "n1"}, {ID: "n2"}}, refresh: true, dataType: contracts.TypeNotes})
+	m = updated.(Model)
+	require.Len(t, m.listItems, 1)
+	assert.Equal(t, "card", m.listItems[0].ID)
+}
+
+func TestChanges_StreamClosedSchedulesRetry(t *testing.T) {
+	svc := &fakeDataService{data: map[string]interface{}{}}
+	watcher := &fakeChangeWatcher{events: make(chan model.ChangeEvent)}
+	m := newWatchingListModel(t, svc, watcher)
+
+	close(watcher.events)
+	msg := waitChangeEvent(m.watchEvents)()
+	require.IsType(t, changesWatchStoppedMsg{}, msg)
+
+	updated, cmd := m.Update(msg)
+	m = updated.(Model)
+	assert.False(t, m.watching)
+	assert.NotNil(t, cmd, "expected delayed resubscription")
+
+	watcher.events = make(chan model.ChangeEvent)
+	updated, cmd = m.Update(changesWatchRetryMsg{})
+	m = updated.(Model)
+	require.NotNil(t, cmd)
+	assert.True(t, m.watching)
+	require.IsType(t, changesWatchStartedMsg{}, cmd())
+	assert.Equal(t, 2, watcher.calls)
+}
+
+func TestChanges_SubscribeFailed(t *testing.T) {
+	watcher := &fakeChangeWatcher{err: errors.New("unauthenticated")}
+	m := Model{ctx: context.Background(), changeWatcher: watcher}
+
+	cmd := m.startWatching()
+	require.NotNil(t, cmd)
+	assert.Nil(t, m.startWatching(), "subscription is already in progress")
+
+	updated, cmd := m.Update(cmd())
+	m = updated.(Model)
+	assert.Nil(t, cmd)
+	assert.False(t, m.watching)
+}
+
+func TestChanges_LoginStopsWatching(t *testing.T) {
+	svc := &fakeDataService{data: map[string]interface{}{}}
+	watcher := &fakeChangeWatcher{events: make(chan model.ChangeEvent)}
+	m := newWatchingListModel(t, svc, watcher)
+	events := m.watchEvents
+
+	m.currentState = "login"
+	m, _ = updateLogin(m, LoginSuccessMsg{})
+	assert.False(t, m.watching)
+	assert.Nil(t, m.watchEvents)
+
+	// Событие прежней подписки игнорируется
+	updated, cmd := m.Update(changeEventMsg{events: events})
+	assert.Nil(t, cmd)
+	assert.False(t, updated.(Model).watching)
+}
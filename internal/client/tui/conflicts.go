@@ -0,0 +1,384 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
+	"github.com/ryabkov82/gophkeeper/internal/client/forms"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// conflictValueWidth — максимальная ширина значения поля в таблице сравнения.
+const conflictValueWidth = 30
+
+// conflictsLoadedMsg — загружен список неразрешённых конфликтов.
+type conflictsLoadedMsg struct{ items []cache.Conflict }
+
+// conflictResolvedMsg — результат записи разрешённого конфликта.
+type conflictResolvedMsg struct{ err error }
+
+// conflictRow — строка таблицы сравнения: одно поле записи в локальной
+// и серверной версиях и выбор пользователя для объединения.
+type conflictRow struct {
+	label     string
+	local     string
+	server    string
+	sensitive bool // значение скрывается, пока не включён показ (Ctrl+B)
+	useLocal  bool // при объединении берётся локальное значение
+}
+
+// differs сообщает, различаются ли значения поля в двух версиях.
+func (r conflictRow) differs() bool { return r.local != r.server }
+
+// initConflictsForm инициализирует экран списка конфликтов синхронизации.
+func initConflictsForm(m Model) Model {
+	m.currentState = "conflicts"
+	m.conflicts = nil
+	m.conflictCursor = 0
+	m.conflictErr = nil
+	m.conflictRows = nil
+	return m
+}
+
+// loadConflicts возвращает команду загрузки неразрешённых конфликтов.
+func (m *Model) loadConflicts() tea.Cmd {
+	svc := m.conflictService
+	return func() tea.Msg {
+		if svc == nil {
+			return errMsg{errors.New("conflict service is not configured")}
+		}
+		items, err := svc.Conflicts()
+		if err != nil {
+			return errMsg{err}
+		}
+		return conflictsLoadedMsg{items: items}
+	}
+}
+
+// updateConflicts обрабатывает сообщения на экране списка конфликтов.
+func updateConflicts(m Model, msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case conflictsLoadedMsg:
+		m.conflicts = msg.items
+		m.conflictCursor = min(m.conflictCursor, max(len(m.conflicts)-1, 0))
+		return m, nil
+
+	case errMsg:
+		m.conflictErr = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "shift+tab":
+			if m.conflictCursor > 0 {
+				m.conflictCursor--
+			}
+		case "down", "tab":
+			if m.conflictCursor < len(m.conflicts)-1 {
+				m.conflictCursor++
+			}
+		case "enter":
+			if len(m.conflicts) == 0 {
+				return m, nil
+			}
+			return initConflictForm(m, m.conflicts[m.conflictCursor]), nil
+		case "esc":
+			m.currentState = "menu"
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// renderConflicts отображает список конфликтов синхронизации.
+func renderConflicts(m Model) string {
+	var b strings.Builder
+	b.WriteString(lipgloss.NewStyle().Bold(true).Render("Конфликты синхронизации:") + "\n\n")
+
+	if len(m.conflicts) == 0 && m.conflictErr == nil {
+		b.WriteString(hintStyle.Render("Конфликтов нет") + "\n")
+	}
+
+	for i, c := range m.conflicts {
+		cursor := "  "
+		if i == m.conflictCursor {
+			cursor = "> "
+		}
+		b.WriteString(fmt.Sprintf("%s%s  %s  %s\n",
+			cursor, conflictTitle(c), hintStyle.Render(itemTypeLabel(c.Type)),
+			hintStyle.Render(c.DetectedAt.Format("02.01.2006 15:04"))))
+	}
+
+	if m.conflictErr != nil {
+		b.WriteString("\n" + errorStyle.Render("Ошибка: "+m.conflictErr.Error()))
+	}
+
+	b.WriteString("\n" + hintStyle.Render("↑/↓: навигация • Enter: сравнить версии • Esc: назад"))
+	return b.String()
+}
+
+// initConflictForm открывает экран сравнения версий записи.
+// По умолчанию для объединения выбраны локальные значения полей.
+func initConflictForm(m Model, c cache.Conflict) Model {
+	m.currentState = "conflict"
+	m.conflict = c
+	m.conflictErr = nil
+	m.conflictRowCursor = 0
+	m.conflictReveal = false
+	m.conflictRows = nil
+
+	if c.Local.Deleted || c.Server.Deleted {
+		// Объединять нечего: одна из версий удалена
+		return m
+	}
+
+	localFields, err := changeFields(c.Local)
+	if err != nil {
+		m.conflictErr = err
+		return m
+	}
+	serverFields, err := changeFields(c.Server)
+	if err != nil {
+		m.conflictErr = err
+		return m
+	}
+	for i, lf := range localFields {
+		if i >= len(serverFields) {
+			break
+		}
+		m.conflictRows = append(m.conflictRows, conflictRow{
+			label:     lf.Label,
+			local:     lf.Value,
+			server:    serverFields[i].Value,
+			sensitive: strings.EqualFold(lf.InputType, "password"),
+			useLocal:  true,
+		})
+	}
+	return m
+}
+
+// updateConflict обрабатывает сообщения на экране сравнения версий.
+func updateConflict(m Model, msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case conflictResolvedMsg:
+		if msg.err != nil {
+			m.conflictErr = msg.err
+			if errors.Is(msg.err, model.ErrVersionConflict) {
+				// Серверная версия снова изменилась: показываем обновлённый конфликт
+				next := initConflictsForm(m)
+				next.conflictErr = msg.err
+				return next, next.loadConflicts()
+			}
+			return m, nil
+		}
+		next := initConflictsForm(m)
+		return next, next.loadConflicts()
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "shift+tab":
+			if m.conflictRowCursor > 0 {
+				m.conflictRowCursor--
+			}
+		case "down", "tab":
+			if m.conflictRowCursor < len(m.conflictRows)-1 {
+				m.conflictRowCursor++
+			}
+		case "left":
+			if len(m.conflictRows) > 0 {
+				m.conflictRows[m.conflictRowCursor].useLocal = true
+			}
+		case "right":
+			if len(m.conflictRows) > 0 {
+				m.conflictRows[m.conflictRowCursor].useLocal = false
+			}
+		case "ctrl+b":
+			m.conflictReveal = !m.conflictReveal
+		case "ctrl+l":
+			return m, m.resolveConflict(m.conflict.Local)
+		case "ctrl+r":
+			return m, m.resolveConflict(m.conflict.Server)
+		case "ctrl+s":
+			if len(m.conflictRows) == 0 {
+				m.conflictErr = errors.New("merge is not available: one of the versions is deleted")
+				return m, nil
+			}
+			merged, err := mergeConflict(m.conflict, m.conflictRows)
+			if err != nil {
+				m.conflictErr = err
+				return m, nil
+			}
+			return m, m.resolveConflict(merged)
+		case "esc":
+			m.currentState = "conflicts"
+			m.conflictErr = nil
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// resolveConflict возвращает команду записи выбранной версии записи.
+func (m Model) resolveConflict(result model.Change) tea.Cmd {
+	svc, ctx, c := m.conflictService, m.ctx, m.conflict
+	return func() tea.Msg {
+		if svc == nil {
+			return conflictResolvedMsg{errors.New("conflict service is not configured")}
+		}
+		return conflictResolvedMsg{svc.ResolveConflict(ctx, c, result)}
+	}
+}
+
+// renderConflict отображает локальную и серверную версии записи поле за полем.
+func renderConflict(m Model) string {
+	var b strings.Builder
+	c := m.conflict
+	b.WriteString(lipgloss.NewStyle().Bold(true).Render(
+		fmt.Sprintf("Конфликт: %s %s", conflictTitle(c), itemTypeLabel(c.Type))) + "\n\n")
+
+	switch {
+	case c.Local.Deleted:
+		b.WriteString("Локально запись удалена, а на сервере изменена.\n")
+	case c.Server.Deleted:
+		b.WriteString("Локально запись изменена, а на сервере удалена.\n")
+	default:
+		header := fmt.Sprintf("  %-16s %-*s   %-*s", "Поле",
+			conflictValueWidth, "Локальная версия", conflictValueWidth, "Версия на сервере")
+		b.WriteString(hintStyle.Render(header) + "\n")
+		for i, r := range m.conflictRows {
+			cursor := "  "
+			if i == m.conflictRowCursor {
+				cursor = "> "
+			}
+			local := conflictCell(r.local, r.sensitive && !m.conflictReveal)
+			server := conflictCell(r.server, r.sensitive && !m.conflictReveal)
+			if r.differs() {
+				// Выбранное для объединения значение выделяется
+				if r.useLocal {
+					local = selectedStyle.Render("● " + local)
+					server = normalStyle.Render("  " + server)
+				} else {
+					local = normalStyle.Render("  " + local)
+					server = selectedStyle.Render("● " + server)
+				}
+			} else {
+				local, server = "  "+local, "  "+server
+			}
+			line := fmt.Sprintf("%s%-16s %s %s", cursor, r.label,
+				lipgloss.NewStyle().Width(conflictValueWidth+2).Render(local), server)
+			b.WriteString(line + "\n")
+		}
+	}
+
+	if m.conflictErr != nil {
+		b.WriteString("\n" + errorStyle.Render("Ошибка: "+m.conflictErr.Error()) + "\n")
+	}
+
+	hint := "Ctrl+L: оставить локальную • Ctrl+R: оставить серверную • Esc: назад"
+	if len(m.conflictRows) > 0 {
+		hint = "↑/↓: поле • ←/→: выбрать значение • Ctrl+S: сохранить объединение • " + hint +
+			" • Ctrl+B: показать скрытые поля"
+	}
+	b.WriteString("\n" + hintStyle.Render(hint))
+	return b.String()
+}
+
+// conflictCell подготавливает значение поля для таблицы сравнения:
+// скрывает конфиденциальные значения и укорачивает длинные.
+func conflictCell(v string, masked bool) string {
+	if v == "" {
+		return "—"
+	}
+	if masked {
+		return "••••••"
+	}
+	v = strings.ReplaceAll(v, "\n", " ⏎ ")
+	if r := []rune(v); len(r) > conflictValueWidth {
+		v = string(r[:conflictValueWidth-1]) + "…"
+	}
+	return v
+}
+
+// conflictTitle возвращает заголовок записи конфликта (локальный, если он есть).
+func conflictTitle(c cache.Conflict) string {
+	for _, ch := range []model.Change{c.Local, c.Server} {
+		if e := changeEntity(ch); e != nil {
+			if fe, err := forms.Adapt(e); err == nil {
+				if fields := fe.FormFields(); len(fields) > 0 && fields[0].Value != "" {
+					return fields[0].Value
+				}
+			}
+		}
+	}
+	return c.ItemID
+}
+
+// mergeConflict собирает запись из серверной версии, заменяя значения полей,
+// для которых пользователь выбрал локальную версию.
+func mergeConflict(c cache.Conflict, rows []conflictRow) (model.Change, error) {
+	entity := changeEntity(c.Server)
+	fe, err := forms.Adapt(entity)
+	if err != nil {
+		return model.Change{}, err
+	}
+	fields := fe.FormFields()
+	for i, r := range rows {
+		if r.useLocal && i < len(fields) {
+			fields[i].Value = r.local
+		}
+	}
+	if err := fe.UpdateFromFields(fields); err != nil {
+		return model.Change{}, err
+	}
+	return entityChange(c.Server, entity), nil
+}
+
+// changeFields возвращает поля формы записи, содержащейся в изменении.
+func changeFields(ch model.Change) ([]forms.FormField, error) {
+	fe, err := forms.Adapt(changeEntity(ch))
+	if err != nil {
+		return nil, err
+	}
+	return fe.FormFields(), nil
+}
+
+// changeEntity возвращает копию записи из изменения (nil для надгробия).
+func changeEntity(ch model.Change) interface{} {
+	switch {
+	case ch.Credential != nil:
+		c := *ch.Credential
+		return &c
+	case ch.BankCard != nil:
+		c := *ch.BankCard
+		return &c
+	case ch.TextData != nil:
+		d := *ch.TextData
+		return &d
+	case ch.BinaryData != nil:
+		d := *ch.BinaryData
+		return &d
+	}
+	return nil
+}
+
+// entityChange помещает запись в изменение того же типа и идентификатора, что base.
+func entityChange(base model.Change, entity interface{}) model.Change {
+	ch := model.Change{Type: base.Type, ItemID: base.ItemID, ChangedAt: base.ChangedAt}
+	switch e := entity.(type) {
+	case *model.Credential:
+		ch.Credential = e
+	case *model.BankCard:
+		ch.BankCard = e
+	case *model.TextData:
+		ch.TextData = e
+	case *model.BinaryData:
+		ch.BinaryData = e
+	}
+	return ch
+}
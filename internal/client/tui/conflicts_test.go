@@ -0,0 +1,171 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Фейковый ConflictService ---
+type fakeConflictService struct {
+	items      []cache.Conflict
+	listErr    error
+	resolveErr error
+
+	resolved model.Change
+	calls    int
+}
+
+func (f *fakeConflictService) Conflicts() ([]cache.Conflict, error) {
+	return f.items, f.listErr
+}
+
+func (f *fakeConflictService) ResolveConflict(ctx context.Context, c cache.Conflict, result model.Change) error {
+	f.calls++
+	f.resolved = result
+	return f.resolveErr
+}
+
+func credConflict() cache.Conflict {
+	return cache.Conflict{
+		Type:   model.ItemTypeCredential,
+		ItemID: "c1",
+		Local: model.Change{Type: model.ItemTypeCredential, ItemID: "c1", Credential: &model.Credential{
+			ID: "c1", Title: "Gmail", Login: "me", Password: "local-secret", Metadata: "mine", Version: 2,
+		}},
+		Server: model.Change{Type: model.ItemTypeCredential, ItemID: "c1", Credential: &model.Credential{
+			ID: "c1", Title: "Gmail", Login: "me@work", Password: "server-secret", Metadata: "theirs", Version: 3,
+		}},
+	}
+}
+
+func rowIndex(t *testing.T, m Model, label string) int {
+	t.Helper()
+	for i, r := range m.conflictRows {
+		if r.label == label {
+			return i
+		}
+	}
+	t.Fatalf("row %q not found", label)
+	return -1
+}
+
+func TestUpdateMenu_Conflicts(t *testing.T) {
+	svc := &fakeConflictService{items: []cache.Conflict{credConflict()}}
+	m := NewModel(context.Background(), ModelServices{Conflicts: svc})
+	for i, item := range m.menuItems {
+		if item.title == "Conflicts" {
+			m.menuCursor = i
+		}
+	}
+
+	m2, cmd := updateMenu(*m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "conflicts", m2.currentState)
+	require.NotNil(t, cmd)
+
+	m3, _ := updateConflicts(m2, cmd())
+	require.Len(t, m3.conflicts, 1)
+	assert.Contains(t, renderConflicts(m3), "Gmail")
+
+	m4, _ := updateConflicts(m3, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "conflict", m4.currentState)
+	assert.NotEmpty(t, m4.conflictRows)
+}
+
+func TestLoadConflicts_Error(t *testing.T) {
+	m := Model{ctx: context.Background(), conflictService: &fakeConflictService{listErr: errors.New("boom")}}
+	m2, _ := updateConflicts(m, m.loadConflicts()())
+	assert.Contains(t, renderConflicts(m2), "boom")
+}
+
+func TestRenderConflict_MasksSensitiveFields(t *testing.T) {
+	m := initConflictForm(Model{}, credConflict())
+
+	out := renderConflict(m)
+	assert.Contains(t, out, "me@work")
+	assert.NotContains(t, out, "local-secret")
+	assert.NotContains(t, out, "server-secret")
+
+	m, _ = updateConflict(m, tea.KeyMsg{Type: tea.KeyCtrlB})
+	out = renderConflict(m)
+	assert.Contains(t, out, "local-secret")
+	assert.Contains(t, out, "server-secret")
+}
+
+func TestUpdateConflict_KeepEitherVersion(t *testing.T) {
+	c := credConflict()
+	svc := &fakeConflictService{}
+	m := initConflictForm(Model{ctx: context.Background(), conflictService: svc}, c)
+
+	_, cmd := updateConflict(m, tea.KeyMsg{Type: tea.KeyCtrlL})
+	require.NotNil(t, cmd)
+	cmd()
+	assert.Equal(t, "local-secret", svc.resolved.Credential.Password)
+
+	_, cmd = updateConflict(m, tea.KeyMsg{Type: tea.KeyCtrlR})
+	cmd()
+	assert.Equal(t, "server-secret", svc.resolved.Credential.Password)
+}
+
+func TestUpdateConflict_MergeFields(t *testing.T) {
+	svc := &fakeConflictService{}
+	m := initConflictForm(Model{ctx: context.Background(), conflictService: svc}, credConflict())
+
+	// Логин берём с сервера, остальное — локальное
+	m.conflictRowCursor = rowIndex(t, m, "Login")
+	m, _ = updateConflict(m, tea.KeyMsg{Type: tea.KeyRight})
+
+	_, cmd := updateConflict(m, tea.KeyMsg{Type: tea.KeyCtrlS})
+	require.NotNil(t, cmd)
+	msg := cmd()
+
+	got := svc.resolved.Credential
+	require.NotNil(t, got)
+	assert.Equal(t, "c1", got.ID)
+	assert.Equal(t, "me@work", got.Login)
+	assert.Equal(t, "local-secret", got.Password)
+	assert.Equal(t, "mine", got.Metadata)
+
+	// После успешной записи возвращаемся к списку конфликтов
+	m2, cmd := updateConflict(m, msg)
+	assert.Equal(t, "conflicts", m2.currentState)
+	assert.NotNil(t, cmd)
+}
+
+func TestUpdateConflict_DeletedSide(t *testing.T) {
+	c := credConflict()
+	c.Local = model.Change{Type: model.ItemTypeCredential, ItemID: "c1", Deleted: true}
+	svc := &fakeConflictService{}
+	m := initConflictForm(Model{ctx: context.Background(), conflictService: svc}, c)
+
+	assert.Empty(t, m.conflictRows)
+	assert.Contains(t, renderConflict(m), "Локально запись удалена")
+
+	m2, cmd := updateConflict(m, tea.KeyMsg{Type: tea.KeyCtrlS})
+	assert.Nil(t, cmd)
+	assert.Error(t, m2.conflictErr)
+
+	_, cmd = updateConflict(m, tea.KeyMsg{Type: tea.KeyCtrlL})
+	cmd()
+	assert.True(t, svc.resolved.Deleted)
+}
+
+func TestUpdateConflict_ResolveError(t *testing.T) {
+	svc := &fakeConflictService{resolveErr: errors.New("offline")}
+	m := initConflictForm(Model{ctx: context.Background(), conflictService: svc}, credConflict())
+
+	m2, _ := updateConflict(m, conflictResolvedMsg{err: svc.resolveErr})
+	assert.Equal(t, "conflict", m2.currentState)
+	assert.Contains(t, renderConflict(m2), "offline")
+
+	m3, cmd := updateConflict(m, conflictResolvedMsg{err: model.ErrVersionConflict})
+	assert.Equal(t, "conflicts", m3.currentState)
+	assert.ErrorIs(t, m3.conflictErr, model.ErrVersionConflict)
+	assert.NotNil(t, cmd)
+}
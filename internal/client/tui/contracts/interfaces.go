@@ -3,6 +3,7 @@ package contracts
 import (
 	"context"
 
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 )
 
@@ -22,7 +23,7 @@ type AuthService interface {
 type CredentialService interface {
 	CreateCredential(ctx context.Context, cred *model.Credential) error
 	GetCredentialByID(ctx context.Context, id string) (*model.Credential, error)
-	GetCredentials(ctx context.Context) ([]model.Credential, error)
+	GetCredentials(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error)
 	UpdateCredential(ctx context.Context, cred *model.Credential) error
 	DeleteCredential(ctx context.Context, id string) error
 }
@@ -37,9 +38,9 @@ type BankCardService interface {
 	// При необходимости выполняет дешифровку данных карты.
 	GetBankCardByID(ctx context.Context, id string) (*model.BankCard, error)
 
-	// GetBankCards возвращает все банковские карты пользователя.
-	// При необходимости выполняет дешифровку данных карт.
-	GetBankCards(ctx context.Context) ([]model.BankCard, error)
+	// GetBankCards возвращает страницу банковских карт пользователя, подходящих под фильтр,
+	// и токен следующей страницы. При необходимости выполняет дешифровку данных карт.
+	GetBankCards(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error)
 
 	// UpdateBankCard обновляет существующую запись банковской карты.
 	// Перед сохранением все чувствительные данные должны быть зашифрованы.
@@ -57,8 +58,9 @@ type TextDataService interface {
 	// GetTextDataByID получает текстовые данные по ID с расшифровкой содержимого
 	GetTextDataByID(ctx context.Context, id string) (*model.TextData, error)
 
-	// GetTextDataTitles получает только заголовки текстовых данных (без расшифровки контента)
-	GetTextDataTitles(ctx context.Context) ([]*model.TextData, error)
+	// GetTextDataTitles получает страницу заголовков текстовых данных (без расшифровки контента), подходящих под фильтр,
+	// и токен следующей страницы
+	GetTextDataTitles(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error)
 
 	// UpdateTextData обновляет текстовые данные с шифрованием содержимого
 	UpdateTextData(ctx context.Context, text *model.TextData) error
@@ -78,8 +80,9 @@ type BinaryDataService interface {
 	// GetBinaryDataInfo получает метаданные бинарного объекта по ID (без содержимого)
 	GetBinaryDataInfo(ctx context.Context, id string) (*model.BinaryData, error)
 
-	// ListBinaryData возвращает список всех бинарных объектов пользователя (только метаданные)
-	ListBinaryData(ctx context.Context) ([]model.BinaryData, error)
+	// ListBinaryData возвращает страницу бинарных объектов пользователя, подходящих под фильтр (только метаданные),
+	// и токен следующей страницы
+	ListBinaryData(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]model.BinaryData, string, error)
 
 	// DeleteBinaryData удаляет бинарный объект по ID
 	DeleteBinaryData(ctx context.Context, id string) error
@@ -89,4 +92,51 @@ type BinaryDataService interface {
 
 	// CreateBinaryDataInfo обновляет метаданные бинарного объекта
 	UpdateBinaryDataInfo(ctx context.Context, data *model.BinaryData) error
+
+	// ListBinaryVersions возвращает прежние версии содержимого бинарного объекта, начиная с последней
+	ListBinaryVersions(ctx context.Context, id string) ([]model.BinaryVersion, error)
+
+	// DownloadBinaryVersion скачивает прежнюю версию содержимого бинарного объекта с прогрессом
+	DownloadBinaryVersion(ctx context.Context, dataID, versionID, destPath string, progressCh chan<- int64) error
+
+	// RestoreBinaryVersion делает прежнюю версию текущим содержимым и возвращает обновлённые метаданные
+	RestoreBinaryVersion(ctx context.Context, id, versionID string, version int64) (*model.BinaryData, error)
+
+	// ReadBinaryData расшифровывает содержимое бинарного объекта в память (не больше limit байт)
+	ReadBinaryData(ctx context.Context, id string, limit int64) ([]byte, error)
+}
+
+// ItemService описывает операции, общие для записей всех типов:
+// избранное, история открытия и занятое на сервере место.
+type ItemService interface {
+	// SetFavorite устанавливает или снимает отметку «избранное» у записи.
+	SetFavorite(ctx context.Context, itemType model.ItemType, id string, favorite bool) error
+
+	// MarkAccessed фиксирует время последнего открытия записи.
+	MarkAccessed(ctx context.Context, itemType model.ItemType, id string) error
+
+	// ListFavoritesRecent возвращает объединённый список избранных и недавно открытых записей всех типов.
+	ListFavoritesRecent(ctx context.Context, limit int) ([]model.ItemSummary, error)
+
+	// GetUsage возвращает занятое пользователем место и ограничения сервера.
+	GetUsage(ctx context.Context) (*model.Usage, error)
+}
+
+// ChangeWatcher описывает подписку на уведомления об изменениях записей,
+// сделанных в том числе на других устройствах пользователя.
+type ChangeWatcher interface {
+	// WatchChanges возвращает канал уведомлений; канал закрывается после
+	// отмены ctx или обрыва соединения.
+	WatchChanges(ctx context.Context) (<-chan model.ChangeEvent, error)
+}
+
+// ConflictService описывает разрешение конфликтов версий, обнаруженных при
+// отправке на сервер изменений, сделанных без связи.
+type ConflictService interface {
+	// Conflicts возвращает неразрешённые конфликты.
+	Conflicts() ([]cache.Conflict, error)
+
+	// ResolveConflict записывает выбранный пользователем результат
+	// (надгробие — удалить запись) и удаляет конфликт.
+	ResolveConflict(ctx context.Context, conflict cache.Conflict, result model.Change) error
 }
@@ -11,3 +11,17 @@ type BinaryTransferCapable interface {
 	UploadBinaryData(ctx context.Context, data *model.BinaryData, filePath string, progress chan<- int64) error
 	DownloadBinaryData(ctx context.Context, dataID, destPath string, progress chan<- int64) error
 }
+
+// BinaryVersionCapable описывает работу с прежними версиями содержимого
+// файла: просмотр, скачивание и восстановление.
+type BinaryVersionCapable interface {
+	ListBinaryVersions(ctx context.Context, dataID string) ([]model.BinaryVersion, error)
+	DownloadBinaryVersion(ctx context.Context, dataID, versionID, destPath string, progress chan<- int64) error
+	RestoreBinaryVersion(ctx context.Context, dataID, versionID string, version int64) (*model.BinaryData, error)
+}
+
+// BinaryPreviewCapable описывает чтение содержимого файла в память для
+// просмотра без записи на диск.
+type BinaryPreviewCapable interface {
+	ReadBinaryData(ctx context.Context, dataID string, limit int64) ([]byte, error)
+}
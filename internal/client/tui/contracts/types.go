@@ -1,6 +1,10 @@
 package contracts
 
-import "context"
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
 
 // DataType — перечисление типов объектов
 type DataType int
@@ -40,17 +44,55 @@ func (dt DataType) String() string {
 	}
 }
 
+// ItemType возвращает тип записи домена, соответствующий типу данных DataType.
+// Для неизвестного значения возвращается пустая строка.
+func (dt DataType) ItemType() model.ItemType {
+	switch dt {
+	case TypeCredentials:
+		return model.ItemTypeCredential
+	case TypeNotes:
+		return model.ItemTypeTextData
+	case TypeFiles:
+		return model.ItemTypeBinaryData
+	case TypeCards:
+		return model.ItemTypeBankCard
+	default:
+		return ""
+	}
+}
+
+// DataTypeFromItemType возвращает тип данных DataType для типа записи домена.
+// Второе значение равно false, если тип записи неизвестен.
+func DataTypeFromItemType(t model.ItemType) (DataType, bool) {
+	switch t {
+	case model.ItemTypeCredential:
+		return TypeCredentials, true
+	case model.ItemTypeTextData:
+		return TypeNotes, true
+	case model.ItemTypeBinaryData:
+		return TypeFiles, true
+	case model.ItemTypeBankCard:
+		return TypeCards, true
+	default:
+		return 0, false
+	}
+}
+
 // ListItem — единица для универсального списка
 type ListItem struct {
 	ID       string
 	Title    string
 	Subtitle string // короткая подсказка (например, login или дата)
 	Type     DataType
+	Folder   string   // папка элемента
+	Tags     []string // теги элемента (в открытом виде)
+	Favorite bool     // элемент отмечен как избранный
 }
 
 // DataService — общий интерфейс для CRUD-операций для любого типа
 type DataService interface {
-	List(ctx context.Context) ([]ListItem, error)            // вернуть элементы списка
+	// List возвращает страницу элементов списка, подходящих под фильтр, и токен следующей страницы
+	List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]ListItem, string, error)
 	Get(ctx context.Context, id string) (interface{}, error) // вернуть полную сущность (тип-specific)
 	Create(ctx context.Context, v interface{}) error
 	Update(ctx context.Context, id string, v interface{}) error
@@ -2,6 +2,8 @@ package contracts
 
 import (
 	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 )
 
 func TestDataTypeString(t *testing.T) {
@@ -21,3 +23,23 @@ func TestDataTypeString(t *testing.T) {
 		}
 	}
 }
+
+func TestDataTypeItemTypeRoundTrip(t *testing.T) {
+	for _, dt := range []DataType{TypeCredentials, TypeNotes, TypeFiles, TypeCards} {
+		itemType := dt.ItemType()
+		if err := itemType.Validate(); err != nil {
+			t.Errorf("%v: unexpected item type %q", dt, itemType)
+		}
+		got, ok := DataTypeFromItemType(itemType)
+		if !ok || got != dt {
+			t.Errorf("%v: round trip failed, got %v (ok=%v)", dt, got, ok)
+		}
+	}
+
+	if got := DataType(999).ItemType(); got != "" {
+		t.Errorf("unknown DataType: want empty item type, got %q", got)
+	}
+	if _, ok := DataTypeFromItemType(model.ItemType("unknown")); ok {
+		t.Errorf("unknown item type: want ok=false")
+	}
+}
@@ -27,11 +27,36 @@
 //
 //   - "login" / "register" — аутентификация пользователя.
 //   - "menu"                 — главное меню приложения.
-//   - "list"                 — список записей выбранного типа (TypeLogins, …, TypeFiles).
+//   - "list"                 — список записей выбранного типа (TypeLogins, …, TypeFiles)
+//     с панелью навигации по папкам и тегам (←/→ — переключение фокуса).
+//     Ctrl+F — поставить/снять отметку «избранное», Ctrl+O — сменить сортировку.
+//     Список загружается постранично: следующая страница подгружается при прокрутке
+//     к концу уже загруженных записей. После первой загрузки списка открывается
+//     подписка на уведомления сервера об изменениях (changes.go): если запись
+//     отображаемого типа изменилась на другом устройстве, список перечитывается.
+//   - "favorites"            — объединённый список избранных и недавно открытых записей
+//     всех типов; Enter открывает запись в форме редактирования.
+//   - "conflicts" / "conflict" — конфликты версий, обнаруженные при отправке
+//     офлайн-изменений (conflicts.go). Экран сравнения показывает локальную и
+//     серверную версии записи поле за полем, скрывая пароли и CVV (Ctrl+B —
+//     показать). Можно оставить любую версию целиком (Ctrl+L / Ctrl+R) или
+//     выбрать значение каждого поля (←/→) и сохранить объединение (Ctrl+S);
+//     результат записывается обычным путём обновления (ConflictService).
 //   - "edit"                 — универсальная форма создания/редактирования записи.
 //   - "fullscreen_editor"    — полноэкранный редактор больших текстов/заметок.
-//   - "file_transfer"        — форма передачи файлов (upload/download) с прогресс-баром
-//     и отменой.
+//   - "file_transfer"        — форма передачи файлов (upload/download): ставит передачу
+//     в очередь менеджера передач и показывает её прогресс. Путь можно выбрать
+//     в обзоре файлов (filepicker.go) с размерами и glob-фильтром; существующий
+//     файл назначения перезаписывается только после подтверждения.
+//   - "file_view"            — просмотр содержимого файла только для чтения (viewer.go):
+//     содержимое до previewLimit расшифровывается в память, текст показывается
+//     постранично, остальное — шестнадцатеричным дампом.
+//   - "transfers"            — менеджер передач (transfers.go): очередь загрузок и
+//     скачиваний, из которой одновременно выполняется не больше
+//     ModelServices.TransferLimit передач. Для каждой показываются прогресс и
+//     скорость; x — отменить, r — повторить, c — убрать завершённые. Сообщения
+//     передач обрабатываются на любом экране (updateTransfers), поэтому передачи
+//     продолжаются, пока пользователь работает с другими списками.
 //
 // # Сервисы и контракты
 //
@@ -39,6 +64,9 @@
 //
 //   - DataService — общий CRUD-интерфейс для любого типа записи.
 //     Model держит services: map[contracts.DataType]contracts.DataService.
+//   - ItemService — операции, общие для всех типов: избранное и время последнего
+//     открытия. loadAndShowItem после загрузки записи асинхронно вызывает
+//     MarkAccessed, поэтому экран "favorites" показывает и недавно открытые записи.
 //   - Для файлов может быть расширение адаптера:
 //     BinaryTransferCapable { UploadBinaryData(ctx, *model.BinaryData, path string, progress chan<- int64) error
 //     DownloadBinaryData(ctx, id, dest string, progress chan<- int64) error }
@@ -51,7 +79,7 @@
 //
 //   - Фоновая горутина делает работу и пишет кумулятивный прогресс в chan int64.
 //   - В UI подписка оформлена как tea.Cmd:
-//     tea.Batch(listenProgressThrottle(ch, 200*time.Millisecond), waitDone(doneCh))
+//     tea.Batch(listenTransferProgressThrottle(id, ch, progressUIRate), waitTransferDone(id, doneCh))
 //     где listenProgressThrottle коалесцирует значения за окно (снижает «шум»),
 //     а скорость сглаживается EWMA: v = α*inst + (1-α)*v.
 //   - Отмена осуществляется через context.CancelFunc; сервис обязан корректно
@@ -64,7 +92,14 @@
 //   - Tab — переключение режима/фокуса, если применимо.
 //   - Для файлов (если экран "file_transfer" подключён):
 //     Ctrl+U — открыть загрузку на сервер (upload),
-//     Ctrl+D — открыть скачивание с сервера (download; доступно, если есть ClientPath).
+//     Ctrl+D — открыть скачивание с сервера (download; доступно, если есть ClientPath),
+//     Ctrl+P — просмотреть содержимое файла без сохранения на диск,
+//     Ctrl+T — включить/выключить сжатие перед шифрованием при загрузке,
+//     Ctrl+V — показать прежние версии файла при скачивании (↑/↓ — выбор,
+//     Enter — скачать выбранную, Ctrl+R — восстановить её),
+//     Ctrl+O — обзор файлов (Enter — открыть каталог/выбрать файл, / — фильтр,
+//     Ctrl+S — выбрать текущий каталог),
+//     Ctrl+X — отменить передачу; Esc оставляет её выполняться в фоне.
 //
 // # Стили
 //
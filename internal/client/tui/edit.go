@@ -134,6 +134,18 @@ func updateEdit(m Model, msg tea.Msg) (Model, tea.Cmd) {
 				m = initTransferForm(m, modeDownload, bd, id)
 			}
 			return m, nil
+
+		case "ctrl+p":
+			if m.currentType == contracts.TypeFiles {
+				bd, ok := m.editEntity.(*model.BinaryData)
+				// просматривать можно только уже загруженное содержимое
+				if !ok || strings.TrimSpace(bd.ID) == "" || !canSwitchToDownload(bd) {
+					m.editErr = fmt.Errorf("просмотр недоступен: файл ещё не загружался")
+					return m, nil
+				}
+				return initViewer(m, bd)
+			}
+			return m, nil
 		}
 
 		if len(m.widgets) == 0 {
@@ -230,7 +242,7 @@ func renderEditForm(m Model) string {
 	hint := "Esc: Назад • Ctrl+S: Сохранить • Tab: Следующее поле "
 	switch m.currentType {
 	case contracts.TypeFiles:
-		hint += "• Ctrl+U: загрузить файл • Ctrl+D: скачать файл\n"
+		hint += "• Ctrl+U: загрузить файл • Ctrl+D: скачать файл • Ctrl+P: просмотр\n"
 	default:
 		hint += "• Ctrl+B: переключить видимость пароля\n"
 	}
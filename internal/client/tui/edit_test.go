@@ -47,8 +47,8 @@ type fakeEditDataService struct {
 	updated bool
 }
 
-func (f *fakeEditDataService) List(ctx context.Context) ([]contracts.ListItem, error) {
-	return nil, nil
+func (f *fakeEditDataService) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
+	return nil, "", nil
 }
 func (f *fakeEditDataService) Get(ctx context.Context, id string) (interface{}, error) {
 	return nil, nil
@@ -0,0 +1,163 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// favoritesLimit — максимальное количество записей в списке «Избранное и недавние».
+const favoritesLimit = 50
+
+// initFavoritesForm инициализирует экран избранных и недавно открытых записей.
+func initFavoritesForm(m Model) Model {
+	m.currentState = "favorites"
+	m.favItems = nil
+	m.favCursor = 0
+	m.favErr = nil
+	m.editEntity = nil
+	m.widgets = nil
+
+	return m
+}
+
+// loadFavorites возвращает команду для загрузки объединённого списка
+// избранных и недавно открытых записей всех типов.
+func (m *Model) loadFavorites() tea.Cmd {
+	return func() tea.Msg {
+		if m.itemService == nil {
+			return errMsg{errors.New("favorites service is not configured")}
+		}
+		items, err := m.itemService.ListFavoritesRecent(m.ctx, favoritesLimit)
+		if err != nil {
+			return errMsg{err}
+		}
+		return favoritesLoadedMsg{items: items}
+	}
+}
+
+// markAccessed возвращает команду, фиксирующую время открытия записи на сервере.
+// Ошибка не показывается пользователю: история открытия носит вспомогательный характер.
+func (m Model) markAccessed(dataType contracts.DataType, id string) tea.Cmd {
+	if m.itemService == nil {
+		return nil
+	}
+	svc, ctx := m.itemService, m.ctx
+	return func() tea.Msg {
+		_ = svc.MarkAccessed(ctx, dataType.ItemType(), id)
+		return nil
+	}
+}
+
+// updateFavorites обрабатывает сообщения на экране избранных и недавно открытых записей.
+func updateFavorites(m Model, msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case favoritesLoadedMsg:
+		m.favItems = msg.items
+		m.favCursor = 0
+		return m, nil
+
+	case errMsg:
+		m.favErr = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "shift+tab":
+			if m.favCursor > 0 {
+				m.favCursor--
+			}
+		case "down", "tab":
+			if m.favCursor < len(m.favItems)-1 {
+				m.favCursor++
+			}
+		case "enter":
+			if len(m.favItems) == 0 {
+				return m, nil
+			}
+			selected := m.favItems[m.favCursor]
+			dataType, ok := contracts.DataTypeFromItemType(selected.Type)
+			if !ok {
+				m.favErr = fmt.Errorf("unknown item type %q", selected.Type)
+				return m, nil
+			}
+			m.currentType = dataType
+			m.listErr = nil
+			next, cmd := loadAndShowItem(m, selected.ID)
+			if next.listErr != nil {
+				// Остаёмся на экране избранного и показываем ошибку загрузки
+				m.favErr = next.listErr
+				return m, nil
+			}
+			return next, cmd
+		case "ctrl+f":
+			// Снимаем/ставим отметку «избранное» и перезагружаем список
+			if len(m.favItems) == 0 || m.itemService == nil {
+				return m, nil
+			}
+			selected := m.favItems[m.favCursor]
+			if err := m.itemService.SetFavorite(m.ctx, selected.Type, selected.ID, !selected.Favorite); err != nil {
+				m.favErr = fmt.Errorf("failed to update favorite: %w", err)
+				return m, nil
+			}
+			m.favErr = nil
+			return m, m.loadFavorites()
+		case "esc":
+			m.currentState = "menu"
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// renderFavorites отображает объединённый список избранных и недавно открытых записей.
+func renderFavorites(m Model) string {
+	var b strings.Builder
+	b.WriteString(lipgloss.NewStyle().Bold(true).Render("Избранное и недавние:") + "\n\n")
+
+	if len(m.favItems) == 0 && m.favErr == nil {
+		b.WriteString(hintStyle.Render("Нет избранных или недавно открытых записей") + "\n")
+	}
+
+	for i, item := range m.favItems {
+		cursor := "  "
+		if i == m.favCursor {
+			cursor = "> "
+		}
+		b.WriteString(fmt.Sprintf("%s%s%s  %s\n",
+			cursor, favoriteMark(item.Favorite), item.Title, hintStyle.Render(itemTypeLabel(item.Type))))
+	}
+
+	if m.favErr != nil {
+		b.WriteString("\n" + errorStyle.Render("Ошибка: "+m.favErr.Error()))
+	}
+
+	b.WriteString("\n" + hintStyle.Render(
+		"↑/↓: навигация • Enter: просмотр • Ctrl+F: избранное • Esc: назад",
+	))
+
+	return b.String()
+}
+
+// favoriteMark возвращает отметку избранного для отображения перед заголовком.
+func favoriteMark(favorite bool) string {
+	if favorite {
+		return "★ "
+	}
+	return "  "
+}
+
+// itemTypeLabel возвращает подпись типа записи для списка избранного.
+func itemTypeLabel(t model.ItemType) string {
+	dataType, ok := contracts.DataTypeFromItemType(t)
+	if !ok {
+		return string(t)
+	}
+	return "[" + dataType.String() + "]"
+}
@@ -0,0 +1,159 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Фейковый ItemService ---
+type fakeItemService struct {
+	items       []model.ItemSummary
+	listErr     error
+	favoriteErr error
+
+	accessedType model.ItemType
+	accessedID   string
+	favoriteType model.ItemType
+	favoriteID   string
+	favoriteVal  bool
+
+	usage    *model.Usage
+	usageErr error
+}
+
+func (f *fakeItemService) SetFavorite(ctx context.Context, itemType model.ItemType, id string, favorite bool) error {
+	f.favoriteType, f.favoriteID, f.favoriteVal = itemType, id, favorite
+	return f.favoriteErr
+}
+
+func (f *fakeItemService) MarkAccessed(ctx context.Context, itemType model.ItemType, id string) error {
+	f.accessedType, f.accessedID = itemType, id
+	return nil
+}
+
+func (f *fakeItemService) ListFavoritesRecent(ctx context.Context, limit int) ([]model.ItemSummary, error) {
+	return f.items, f.listErr
+}
+
+func (f *fakeItemService) GetUsage(ctx context.Context) (*model.Usage, error) {
+	return f.usage, f.usageErr
+}
+
+func TestUpdateMenu_Favorites(t *testing.T) {
+	itemSvc := &fakeItemService{
+		items: []model.ItemSummary{{Type: model.ItemTypeCredential, ID: "1", Title: "GitHub", Favorite: true}},
+	}
+	m := NewModel(context.Background(), ModelServices{Item: itemSvc})
+	for i, item := range m.menuItems {
+		if item.title == "Favorites" {
+			m.menuCursor = i
+		}
+	}
+
+	m2, cmd := updateMenu(*m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "favorites", m2.currentState)
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	loaded, ok := msg.(favoritesLoadedMsg)
+	require.True(t, ok, "expected favoritesLoadedMsg, got %T", msg)
+	assert.Equal(t, itemSvc.items, loaded.items)
+
+	m3, _ := updateFavorites(m2, loaded)
+	assert.Len(t, m3.favItems, 1)
+	assert.Contains(t, renderFavorites(m3), "★ GitHub")
+}
+
+func TestLoadFavorites_Error(t *testing.T) {
+	m := Model{ctx: context.Background(), itemService: &fakeItemService{listErr: errors.New("boom")}}
+
+	msg := m.loadFavorites()()
+	m2, _ := updateFavorites(m, msg)
+	require.Error(t, m2.favErr)
+	assert.Contains(t, renderFavorites(m2), "boom")
+}
+
+func TestUpdateFavorites_EnterOpensItemAndMarksAccessed(t *testing.T) {
+	itemSvc := &fakeItemService{}
+	notes := &fakeDataService{data: map[string]interface{}{"n1": &model.TextData{ID: "n1", Title: "Note"}}}
+	m := Model{
+		currentState: "favorites",
+		ctx:          context.Background(),
+		itemService:  itemSvc,
+		services: map[contracts.DataType]contracts.DataService{
+			contracts.TypeNotes: notes,
+		},
+		favItems: []model.ItemSummary{{Type: model.ItemTypeTextData, ID: "n1", Title: "Note"}},
+	}
+
+	m2, cmd := updateFavorites(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "edit", m2.currentState)
+	assert.Equal(t, contracts.TypeNotes, m2.currentType)
+	require.NotNil(t, cmd)
+
+	cmd()
+	assert.Equal(t, model.ItemTypeTextData, itemSvc.accessedType)
+	assert.Equal(t, "n1", itemSvc.accessedID)
+}
+
+func TestUpdateFavorites_EnterLoadError(t *testing.T) {
+	m := Model{
+		currentState: "favorites",
+		ctx:          context.Background(),
+		services: map[contracts.DataType]contracts.DataService{
+			contracts.TypeCards: &fakeDataService{data: map[string]interface{}{}},
+		},
+		favItems: []model.ItemSummary{{Type: model.ItemTypeBankCard, ID: "missing"}},
+	}
+
+	m2, _ := updateFavorites(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "favorites", m2.currentState)
+	assert.Error(t, m2.favErr)
+}
+
+func TestUpdateFavorites_ToggleFavorite(t *testing.T) {
+	itemSvc := &fakeItemService{}
+	m := Model{
+		currentState: "favorites",
+		ctx:          context.Background(),
+		itemService:  itemSvc,
+		favItems:     []model.ItemSummary{{Type: model.ItemTypeBinaryData, ID: "f1", Favorite: true}},
+	}
+
+	_, cmd := updateFavorites(m, tea.KeyMsg{Type: tea.KeyCtrlF})
+	require.NotNil(t, cmd, "list should be reloaded after toggle")
+	assert.Equal(t, model.ItemTypeBinaryData, itemSvc.favoriteType)
+	assert.Equal(t, "f1", itemSvc.favoriteID)
+	assert.False(t, itemSvc.favoriteVal)
+}
+
+func TestUpdateViewData_ToggleFavorite(t *testing.T) {
+	itemSvc := &fakeItemService{}
+	m := Model{
+		currentState: "list",
+		currentType:  contracts.TypeCards,
+		ctx:          context.Background(),
+		itemService:  itemSvc,
+		listItems:    []contracts.ListItem{{ID: "c1", Title: "Visa"}},
+	}
+
+	m2, _ := updateViewData(m, tea.KeyMsg{Type: tea.KeyCtrlF})
+	assert.True(t, m2.listItems[0].Favorite)
+	assert.Equal(t, model.ItemTypeBankCard, itemSvc.favoriteType)
+	assert.True(t, itemSvc.favoriteVal)
+	assert.True(t, strings.Contains(renderList(m2), "★ Visa"))
+
+	// Ошибка сервиса не меняет отметку
+	itemSvc.favoriteErr = errors.New("fail")
+	m3, _ := updateViewData(m2, tea.KeyMsg{Type: tea.KeyCtrlF})
+	assert.True(t, m3.listItems[0].Favorite)
+	assert.Error(t, m3.listErr)
+}
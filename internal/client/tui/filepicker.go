@@ -0,0 +1,197 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// pickerRows — сколько элементов каталога показывает обзор файлов.
+const pickerRows = 15
+
+// fileEntry — элемент каталога в обзоре файлов.
+type fileEntry struct {
+	name string
+	dir  bool
+	size int64
+}
+
+// filePicker — обзор локальной файловой системы для выбора файла или
+// каталога на экране передачи. Каталоги показываются всегда, файлы —
+// только подходящие под фильтр (glob по имени, например «*.pdf»).
+type filePicker struct {
+	dir       string          // текущий каталог
+	entries   []fileEntry     // содержимое каталога после фильтра
+	cursor    int             // выбранный элемент
+	filter    textinput.Model // glob-фильтр имён файлов
+	filtering bool            // фокус на поле фильтра
+	err       error           // ошибка чтения каталога или фильтра
+}
+
+// newFilePicker открывает обзор в каталоге пути start (или в самом start,
+// если это каталог). Если путь не задан или не существует — в домашнем
+// каталоге пользователя.
+func newFilePicker(start string) filePicker {
+	dir := strings.TrimSpace(start)
+	if fi, err := os.Stat(dir); dir != "" && (err != nil || !fi.IsDir()) {
+		dir = filepath.Dir(dir)
+	}
+	if fi, err := os.Stat(dir); dir == "" || err != nil || !fi.IsDir() {
+		dir, _ = os.UserHomeDir()
+	}
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+
+	filter := newInputField("*")
+	filter.Prompt = " Фильтр: "
+
+	p := filePicker{dir: dir, filter: filter}
+	p.load()
+	return p
+}
+
+// load перечитывает текущий каталог с учётом фильтра.
+func (p *filePicker) load() {
+	p.entries, p.err = nil, nil
+	items, err := os.ReadDir(p.dir)
+	if err != nil {
+		p.err = err
+		return
+	}
+	glob := strings.TrimSpace(p.filter.Value())
+	if _, err := filepath.Match(glob, ""); err != nil {
+		p.err = fmt.Errorf("неверный фильтр: %w", err)
+		glob = ""
+	}
+	for _, it := range items {
+		info, err := it.Info()
+		if err != nil {
+			continue
+		}
+		e := fileEntry{name: it.Name(), dir: info.IsDir(), size: info.Size()}
+		if !e.dir && glob != "" {
+			if ok, _ := filepath.Match(glob, e.name); !ok {
+				continue
+			}
+		}
+		p.entries = append(p.entries, e)
+	}
+	// каталоги сверху, внутри групп — по имени
+	sort.Slice(p.entries, func(i, j int) bool {
+		if p.entries[i].dir != p.entries[j].dir {
+			return p.entries[i].dir
+		}
+		return strings.ToLower(p.entries[i].name) < strings.ToLower(p.entries[j].name)
+	})
+	p.cursor = min(p.cursor, max(len(p.entries)-1, 0))
+}
+
+// open переходит в каталог dir.
+func (p *filePicker) open(dir string) {
+	prev := p.dir
+	p.dir = dir
+	p.cursor = 0
+	p.load()
+	if p.err != nil {
+		// остаёмся в прежнем каталоге, сохранив ошибку
+		err := p.err
+		p.dir = prev
+		p.load()
+		p.err = err
+	}
+}
+
+// update обрабатывает клавиши обзора. Возвращает выбранный путь и true,
+// когда выбор сделан; пустой путь с true означает отказ от выбора.
+func (p filePicker) update(msg tea.KeyMsg) (filePicker, string, bool) {
+	if p.filtering {
+		switch msg.String() {
+		case "enter", "esc", "up", "down":
+			p.filtering = false
+			p.filter.Blur()
+		default:
+			p.filter, _ = p.filter.Update(msg)
+			p.cursor = 0
+			p.load()
+		}
+		return p, "", false
+	}
+
+	switch msg.String() {
+	case "up":
+		if p.cursor > 0 {
+			p.cursor--
+		}
+	case "down":
+		if p.cursor < len(p.entries)-1 {
+			p.cursor++
+		}
+	case "left", "backspace":
+		p.open(filepath.Dir(p.dir))
+	case "right", "enter":
+		if p.cursor >= len(p.entries) {
+			return p, "", false
+		}
+		e := p.entries[p.cursor]
+		path := filepath.Join(p.dir, e.name)
+		if e.dir {
+			p.open(path)
+			return p, "", false
+		}
+		if msg.String() == "enter" {
+			return p, path, true
+		}
+	case "/":
+		p.filtering = true
+		p.filter.Focus()
+	case "ctrl+s":
+		// выбрать текущий каталог (папка для загрузки или место сохранения)
+		return p, p.dir, true
+	case "esc":
+		return p, "", true
+	}
+	return p, "", false
+}
+
+// view отображает каталог, фильтр и окно элементов вокруг курсора.
+func (p filePicker) view() string {
+	var b strings.Builder
+	b.WriteString(" Каталог: " + p.dir + "\n")
+	b.WriteString(p.filter.View() + "\n\n")
+
+	start := max(0, min(p.cursor-pickerRows/2, len(p.entries)-pickerRows))
+	end := min(len(p.entries), start+pickerRows)
+	if len(p.entries) == 0 {
+		b.WriteString(hintStyle.Render("  Каталог пуст") + "\n")
+	}
+	for i := start; i < end; i++ {
+		e := p.entries[i]
+		name, size := e.name, humanBytes(e.size)
+		if e.dir {
+			name, size = name+string(filepath.Separator), ""
+		}
+		line := fmt.Sprintf("%-48s %10s", name, size)
+		if i == p.cursor {
+			b.WriteString(selectedStyle.Render("> "+line) + "\n")
+		} else {
+			b.WriteString("  " + line + "\n")
+		}
+	}
+	if end < len(p.entries) {
+		b.WriteString(hintStyle.Render(fmt.Sprintf("  … ещё %d", len(p.entries)-end)) + "\n")
+	}
+	if p.err != nil {
+		b.WriteString(errorStyle.Render("Ошибка: "+p.err.Error()) + "\n")
+	}
+
+	b.WriteString("\n" + hintStyle.Render(
+		"↑/↓: выбор • Enter: открыть/выбрать • ←/Backspace: вверх • /: фильтр • Ctrl+S: выбрать этот каталог • Esc: закрыть",
+	))
+	return b.String()
+}
@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pickerDir создаёт каталог с файлами a.txt, b.pdf и подкаталогом sub.
+func pickerDir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "b.pdf"), []byte("pdf"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "c.txt"), nil, 0o600))
+	return dir
+}
+
+func entryNames(p filePicker) []string {
+	var names []string
+	for _, e := range p.entries {
+		names = append(names, e.name)
+	}
+	return names
+}
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}
+	case "backspace":
+		return tea.KeyMsg{Type: tea.KeyBackspace}
+	case "ctrl+s":
+		return tea.KeyMsg{Type: tea.KeyCtrlS}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func TestNewFilePicker_StartDir(t *testing.T) {
+	dir := pickerDir(t)
+
+	p := newFilePicker(filepath.Join(dir, "a.txt"))
+	assert.Equal(t, dir, p.dir)
+	// каталоги сверху, затем файлы по имени
+	assert.Equal(t, []string{"sub", "a.txt", "b.pdf"}, entryNames(p))
+	assert.Equal(t, int64(5), p.entries[1].size)
+
+	p = newFilePicker(filepath.Join(dir, "missing", "x"))
+	home, _ := os.UserHomeDir()
+	assert.Equal(t, home, p.dir)
+}
+
+func TestFilePicker_Navigate(t *testing.T) {
+	dir := pickerDir(t)
+	p := newFilePicker(dir)
+
+	// Enter на каталоге открывает его
+	p, path, done := p.update(key("enter"))
+	assert.False(t, done)
+	assert.Empty(t, path)
+	assert.Equal(t, filepath.Join(dir, "sub"), p.dir)
+	assert.Equal(t, []string{"c.txt"}, entryNames(p))
+
+	// Backspace — на уровень вверх
+	p, _, _ = p.update(key("backspace"))
+	assert.Equal(t, dir, p.dir)
+
+	// Enter на файле выбирает его
+	p, _, _ = p.update(key("down"))
+	_, path, done = p.update(key("enter"))
+	assert.True(t, done)
+	assert.Equal(t, filepath.Join(dir, "a.txt"), path)
+
+	// Ctrl+S выбирает текущий каталог, Esc закрывает без выбора
+	_, path, done = p.update(key("ctrl+s"))
+	assert.True(t, done)
+	assert.Equal(t, dir, path)
+	_, path, done = p.update(key("esc"))
+	assert.True(t, done)
+	assert.Empty(t, path)
+}
+
+func TestFilePicker_Filter(t *testing.T) {
+	dir := pickerDir(t)
+	p := newFilePicker(dir)
+
+	p, _, _ = p.update(key("/"))
+	assert.True(t, p.filtering)
+	for _, r := range "*.pdf" {
+		p, _, _ = p.update(key(string(r)))
+	}
+	// каталоги остаются, файлы фильтруются по glob
+	assert.Equal(t, []string{"sub", "b.pdf"}, entryNames(p))
+
+	p, _, _ = p.update(key("enter"))
+	assert.False(t, p.filtering)
+	out := p.view()
+	assert.Contains(t, out, "b.pdf")
+	assert.NotContains(t, out, "a.txt")
+	assert.Contains(t, out, "3 B")
+
+	// неверный шаблон показывает ошибку, но не скрывает файлы
+	p, _, _ = p.update(key("/"))
+	p, _, _ = p.update(key("["))
+	assert.Error(t, p.err)
+	assert.Len(t, p.entries, 3)
+}
@@ -2,6 +2,7 @@ package tui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -10,6 +11,24 @@ import (
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 )
 
+// loadMoreThreshold — за сколько элементов до конца загруженного списка
+// начинается подгрузка следующей страницы.
+const loadMoreThreshold = 5
+
+// listSortMode — режим сортировки списка данных.
+type listSortMode struct {
+	label string            // отображаемое название режима
+	page  model.PageRequest // поле и направление сортировки
+}
+
+// listSortModes — режимы сортировки, переключаемые по Ctrl+O.
+// Первый режим совпадает с сортировкой сервера по умолчанию.
+var listSortModes = []listSortMode{
+	{label: "сначала новые", page: model.PageRequest{SortBy: model.SortByCreated, Desc: true}},
+	{label: "недавно изменённые", page: model.PageRequest{SortBy: model.SortByUpdated, Desc: true}},
+	{label: "по названию", page: model.PageRequest{SortBy: model.SortByTitle}},
+}
+
 // initListForm инициализирует просмотр данных
 func initListForm(m Model, dataType contracts.DataType) Model {
 	m.currentState = "list"
@@ -21,15 +40,113 @@ func initListForm(m Model, dataType contracts.DataType) Model {
 	m.focusedInput = 0
 	m.widgets = nil
 	m.listErr = nil
+	m.listFilter = model.ListFilter{}
+	m.navEntries = nil
+	m.navCursor = 0
+	m.navFocused = false
+	m.navPartial = false
+	m.listNextToken = ""
+	m.listLoading = false
 
 	return m
 }
 
+// loadMoreIfNeeded запускает загрузку следующей страницы, если курсор
+// приблизился к концу загруженного списка и у сервера есть ещё записи.
+func loadMoreIfNeeded(m Model) (Model, tea.Cmd) {
+	if m.listNextToken == "" || m.listLoading || m.listCursor < len(m.listItems)-loadMoreThreshold {
+		return m, nil
+	}
+	m.listLoading = true
+	return m, m.loadNextPage()
+}
+
+// navEntry — элемент панели навигации по папкам и тегам.
+type navEntry struct {
+	label  string           // отображаемое название
+	filter model.ListFilter // фильтр, применяемый при выборе элемента
+}
+
+// buildNavEntries строит элементы панели навигации по списку элементов:
+// пункт «Все», затем папки и теги в алфавитном порядке.
+func buildNavEntries(items []contracts.ListItem) []navEntry {
+	folders := make(map[string]struct{})
+	tags := make(map[string]struct{})
+	for _, item := range items {
+		if item.Folder != "" {
+			folders[item.Folder] = struct{}{}
+		}
+		for _, tag := range item.Tags {
+			tags[tag] = struct{}{}
+		}
+	}
+
+	entries := []navEntry{{label: "Все"}}
+	for _, folder := range sortedKeys(folders) {
+		entries = append(entries, navEntry{
+			label:  "📁 " + folder,
+			filter: model.ListFilter{Folder: folder},
+		})
+	}
+	for _, tag := range sortedKeys(tags) {
+		entries = append(entries, navEntry{
+			label:  "#" + tag,
+			filter: model.ListFilter{Tags: []string{tag}},
+		})
+	}
+	return entries
+}
+
+// sortedKeys возвращает отсортированные ключи множества строк.
+func sortedKeys(set map[string]struct{}) []string {
+	keys := make([]string, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// updateNavPane обрабатывает нажатия клавиш, когда фокус находится на панели навигации.
+func updateNavPane(m Model, msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "shift+tab":
+		if m.navCursor > 0 {
+			m.navCursor--
+		}
+	case "down", "tab":
+		if m.navCursor < len(m.navEntries)-1 {
+			m.navCursor++
+		}
+	case "enter":
+		// Применяем выбранный фильтр и перезагружаем список
+		m.listFilter = m.navEntries[m.navCursor].filter
+		m.navFocused = false
+		m.listCursor = 0
+		m.listErr = nil
+		return m, m.loadList()
+	case "right":
+		m.navFocused = false
+	case "esc":
+		// Возврат в главное меню
+		m.currentState = "menu"
+	}
+	return m, nil
+}
+
 // updateViewData обрабатывает сообщения при просмотре данных
 func updateViewData(m Model, msg tea.Msg) (Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.navFocused {
+			return updateNavPane(m, msg)
+		}
 		switch msg.String() {
+		case "left":
+			// Переключаем фокус на панель навигации по папкам и тегам
+			if len(m.navEntries) > 0 {
+				m.navFocused = true
+			}
 		case "up", "shift+tab":
 			if m.listCursor > 0 {
 				m.listCursor--
@@ -38,6 +155,15 @@ func updateViewData(m Model, msg tea.Msg) (Model, tea.Cmd) {
 			if m.listCursor < len(m.listItems)-1 {
 				m.listCursor++
 			}
+			return loadMoreIfNeeded(m)
+		case "ctrl+o":
+			// Переключаем режим сортировки и загружаем список заново с первой страницы
+			m.listSortIdx = (m.listSortIdx + 1) % len(listSortModes)
+			m.listCursor = 0
+			m.listNextToken = ""
+			m.listLoading = false
+			m.listErr = nil
+			return m, m.loadList()
 		case "enter":
 			if len(m.listItems) > 0 {
 				selected := m.listItems[m.listCursor]
@@ -49,6 +175,17 @@ func updateViewData(m Model, msg tea.Msg) (Model, tea.Cmd) {
 			m.currentState = "edit_new"
 			m.editEntity = newEmptyEntity(m.currentType) // функция создаёт пустую структуру соответствующего типа
 			m = initEditForm(m)
+		case "ctrl+f":
+			// Ставим/снимаем отметку «избранное» у выбранной записи
+			if len(m.listItems) > 0 && m.itemService != nil {
+				selected := m.listItems[m.listCursor]
+				err := m.itemService.SetFavorite(m.ctx, m.currentType.ItemType(), selected.ID, !selected.Favorite)
+				if err != nil {
+					m.listErr = fmt.Errorf("failed to update favorite: %w", err)
+				} else {
+					m.listItems[m.listCursor].Favorite = !selected.Favorite
+				}
+			}
 		case "ctrl+d":
 			// Удаляем выбранную сущность
 			if len(m.listItems) > 0 {
@@ -57,8 +194,10 @@ func updateViewData(m Model, msg tea.Msg) (Model, tea.Cmd) {
 				if err != nil {
 					m.listErr = fmt.Errorf("failed to delete item: %w", err)
 				} else {
-					// Обновляем список после удаления
+					// Обновляем список после удаления, сохраняя текущий фильтр
+					filter := m.listFilter
 					m = initListForm(m, m.currentType)
+					m.listFilter = filter
 					return m, m.loadList()
 				}
 			}
@@ -75,14 +214,26 @@ func renderList(m Model) string {
 	var b strings.Builder
 	title := lipgloss.NewStyle().Bold(true).Render("Список данных " + m.currentType.String() + ":")
 
-	b.WriteString(title + "\n\n")
+	b.WriteString(title + "  " + hintStyle.Render("сортировка: "+listSortModes[m.listSortIdx].label) + "\n\n")
 
+	var list strings.Builder
 	for i, item := range m.listItems {
 		cursor := "  "
-		if i == m.listCursor {
+		if i == m.listCursor && !m.navFocused {
 			cursor = "> "
 		}
-		b.WriteString(fmt.Sprintf("%s%s\n", cursor, item.Title))
+		list.WriteString(fmt.Sprintf("%s%s%s%s\n", cursor, favoriteMark(item.Favorite), item.Title, renderItemLabels(item)))
+	}
+	if m.listLoading {
+		list.WriteString(hintStyle.Render("  загрузка…") + "\n")
+	} else if m.listNextToken != "" {
+		list.WriteString(hintStyle.Render("  ↓ ещё записи") + "\n")
+	}
+
+	if len(m.navEntries) > 0 {
+		b.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, renderNavPane(m), list.String()))
+	} else {
+		b.WriteString(list.String())
 	}
 
 	if m.listErr != nil {
@@ -90,12 +241,58 @@ func renderList(m Model) string {
 	}
 
 	b.WriteString("\n" + hintStyle.Render(
-		"↑/↓: навигация • Enter: просмотр • Ctrl+N: добавить новую запись • Ctrl+D: удалить выбранную запись • Esc: назад",
+		"↑/↓: навигация • ←/→: папки и теги • Enter: просмотр • Ctrl+F: избранное • Ctrl+O: сортировка • Ctrl+N: добавить новую запись • Ctrl+D: удалить выбранную запись • Esc: назад",
 	))
 
 	return b.String()
 }
 
+// renderNavPane отображает панель навигации по папкам и тегам. Если панель
+// построена не по всем записям, это отмечается подсказкой: папки и теги
+// незагруженных страниц в ней не показаны.
+func renderNavPane(m Model) string {
+	var b strings.Builder
+	for i, entry := range m.navEntries {
+		cursor := "  "
+		if i == m.navCursor && m.navFocused {
+			cursor = "> "
+		}
+		label := entry.label
+		if navFilterEqual(entry.filter, m.listFilter) {
+			label = lipgloss.NewStyle().Bold(true).Render(label)
+		}
+		b.WriteString(cursor + label + "\n")
+	}
+	if m.navPartial {
+		b.WriteString(hintStyle.Render("  …по загруженным") + "\n")
+	}
+	return lipgloss.NewStyle().
+		Border(lipgloss.NormalBorder(), false, true, false, false).
+		PaddingRight(1).
+		MarginRight(1).
+		Render(strings.TrimSuffix(b.String(), "\n"))
+}
+
+// renderItemLabels возвращает подпись с папкой и тегами элемента списка.
+func renderItemLabels(item contracts.ListItem) string {
+	var parts []string
+	if item.Folder != "" {
+		parts = append(parts, "["+item.Folder+"]")
+	}
+	for _, tag := range item.Tags {
+		parts = append(parts, "#"+tag)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "  " + hintStyle.Render(strings.Join(parts, " "))
+}
+
+// navFilterEqual сообщает, совпадают ли два фильтра панели навигации.
+func navFilterEqual(a, b model.ListFilter) bool {
+	return a.Folder == b.Folder && strings.Join(a.Tags, ",") == strings.Join(b.Tags, ",")
+}
+
 func newEmptyEntity(dataType contracts.DataType) interface{} {
 	switch dataType {
 	case contracts.TypeCredentials:
@@ -111,6 +308,8 @@ func newEmptyEntity(dataType contracts.DataType) interface{} {
 	}
 }
 
+// loadAndShowItem загружает полную сущность по ID, открывает форму просмотра/редактирования
+// и возвращает команду, фиксирующую время открытия записи.
 func loadAndShowItem(m Model, id string) (Model, tea.Cmd) {
 	entity, err := m.services[m.currentType].Get(m.ctx, id)
 	if err != nil {
@@ -122,5 +321,5 @@ func loadAndShowItem(m Model, id string) (Model, tea.Cmd) {
 	m.currentState = "edit"
 	m = initEditForm(m)
 
-	return m, nil
+	return m, m.markAccessed(m.currentType, id)
 }
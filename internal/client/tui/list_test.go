@@ -15,15 +15,23 @@ import (
 
 // --- Фейковый DataService ---
 type fakeDataService struct {
-	data map[string]interface{}
+	data       map[string]interface{}
+	lastFilter model.ListFilter
+	lastPage   model.PageRequest
+	next       string // токен, возвращаемый вместе с первой страницей
 }
 
-func (f *fakeDataService) List(ctx context.Context) ([]contracts.ListItem, error) {
+func (f *fakeDataService) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
+	f.lastFilter = filter
+	f.lastPage = page
 	items := []contracts.ListItem{}
 	for id, _ := range f.data {
 		items = append(items, contracts.ListItem{ID: id, Title: id})
 	}
-	return items, nil
+	if page.Token == "" {
+		return items, f.next, nil
+	}
+	return items, "", nil
 }
 
 func (f *fakeDataService) Get(ctx context.Context, id string) (interface{}, error) {
@@ -188,3 +196,181 @@ func TestUpdateViewData_DeleteItem(t *testing.T) {
 		t.Errorf("listCursor out of bounds: %d", m.listCursor)
 	}
 }
+
+func TestBuildNavEntries(t *testing.T) {
+	items := []contracts.ListItem{
+		{ID: "1", Folder: "Work", Tags: []string{"prod", "db"}},
+		{ID: "2", Folder: "Home", Tags: []string{"db"}},
+		{ID: "3"},
+	}
+
+	entries := buildNavEntries(items)
+
+	labels := make([]string, 0, len(entries))
+	for _, e := range entries {
+		labels = append(labels, e.label)
+	}
+	want := []string{"Все", "📁 Home", "📁 Work", "#db", "#prod"}
+	if strings.Join(labels, "|") != strings.Join(want, "|") {
+		t.Fatalf("unexpected nav entries: %v", labels)
+	}
+	if !entries[0].filter.IsEmpty() {
+		t.Errorf("expected first entry to reset filter")
+	}
+	if entries[2].filter.Folder != "Work" {
+		t.Errorf("expected folder filter Work, got %+v", entries[2].filter)
+	}
+	if len(entries[3].filter.Tags) != 1 || entries[3].filter.Tags[0] != "db" {
+		t.Errorf("expected tag filter db, got %+v", entries[3].filter)
+	}
+}
+
+func TestUpdateViewData_NavPaneAppliesFilter(t *testing.T) {
+	svc := &fakeDataService{data: map[string]interface{}{"1": &model.Credential{ID: "1"}}}
+	m := initListForm(Model{
+		ctx:      context.Background(),
+		services: map[contracts.DataType]contracts.DataService{contracts.TypeCredentials: svc},
+	}, contracts.TypeCredentials)
+	m.navEntries = buildNavEntries([]contracts.ListItem{{ID: "1", Folder: "Work"}})
+
+	// Переходим на панель навигации и выбираем папку
+	m, _ = updateViewData(m, tea.KeyMsg{Type: tea.KeyLeft})
+	if !m.navFocused {
+		t.Fatal("expected nav pane to be focused")
+	}
+	m, _ = updateViewData(m, tea.KeyMsg{Type: tea.KeyDown})
+	m, cmd := updateViewData(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected loadList command")
+	}
+	if m.navFocused {
+		t.Errorf("expected focus to return to list")
+	}
+
+	msg := cmd()
+	if svc.lastFilter.Folder != "Work" {
+		t.Errorf("expected list to be loaded with folder filter, got %+v", svc.lastFilter)
+	}
+
+	// Список, загруженный с фильтром, не перестраивает панель навигации
+	updated, _ := m.Update(msg)
+	m = updated.(Model)
+	if len(m.navEntries) != 2 {
+		t.Errorf("expected nav entries to be preserved, got %d", len(m.navEntries))
+	}
+	if !strings.Contains(renderList(m), "📁 Work") {
+		t.Errorf("expected nav pane in rendered list")
+	}
+}
+
+func TestUpdateViewData_LoadsNextPageOnScroll(t *testing.T) {
+	svc := &fakeDataService{data: map[string]interface{}{"a": nil}, next: "tok"}
+	m := initListForm(Model{
+		currentState: "list",
+		ctx:          context.Background(),
+		services:     map[contracts.DataType]contracts.DataService{contracts.TypeNotes: svc},
+	}, contracts.TypeNotes)
+
+	updated, _ := m.Update(m.loadList()())
+	m = updated.(Model)
+	if m.listNextToken != "tok" {
+		t.Fatalf("expected next page token, got %q", m.listNextToken)
+	}
+	if !strings.Contains(renderList(m), "ещё записи") {
+		t.Errorf("expected more-items hint in rendered list")
+	}
+	if !strings.Contains(renderList(m), "по загруженным") {
+		t.Errorf("expected nav pane to be marked as partial")
+	}
+
+	// Курсор у конца списка — запрашивается следующая страница
+	m, cmd := updateViewData(m, tea.KeyMsg{Type: tea.KeyDown})
+	if cmd == nil || !m.listLoading {
+		t.Fatal("expected next page to be requested")
+	}
+	// Повторное нажатие не запускает параллельную загрузку
+	if _, again := updateViewData(m, tea.KeyMsg{Type: tea.KeyDown}); again != nil {
+		t.Errorf("expected no duplicate page request while loading")
+	}
+
+	msg := cmd()
+	if svc.lastPage.Token != "tok" {
+		t.Errorf("expected page token to be passed, got %q", svc.lastPage.Token)
+	}
+	updated, _ = m.Update(msg)
+	m = updated.(Model)
+	if len(m.listItems) != 2 {
+		t.Errorf("expected page to be appended, got %d items", len(m.listItems))
+	}
+	if m.listNextToken != "" || m.listLoading {
+		t.Errorf("expected last page to reset paging state")
+	}
+	if strings.Contains(renderList(m), "по загруженным") {
+		t.Errorf("expected nav pane built from all pages to be complete")
+	}
+}
+
+func TestUpdate_StalePageDropped(t *testing.T) {
+	svc := &fakeDataService{data: map[string]interface{}{"a": nil}, next: "tok"}
+	m := initListForm(Model{
+		currentState: "list",
+		ctx:          context.Background(),
+		services: map[contracts.DataType]contracts.DataService{
+			contracts.TypeNotes: svc,
+			contracts.TypeCards: svc,
+		},
+	}, contracts.TypeNotes)
+
+	updated, _ := m.Update(m.loadList()())
+	m = updated.(Model)
+	page := m.loadNextPage()()
+
+	// Сортировка сменилась, пока загружалась следующая страница
+	sorted := m
+	sorted.listSortIdx = 1
+	updated, _ = sorted.Update(page)
+	if got := len(updated.(Model).listItems); got != 1 {
+		t.Errorf("expected page loaded with other sort to be dropped, got %d items", got)
+	}
+
+	// Пользователь перешёл к другому типу данных
+	other := m
+	other.currentType = contracts.TypeCards
+	updated, _ = other.Update(page)
+	if got := len(updated.(Model).listItems); got != 1 {
+		t.Errorf("expected page of other data type to be dropped, got %d items", got)
+	}
+
+	updated, _ = m.Update(page)
+	if got := len(updated.(Model).listItems); got != 2 {
+		t.Errorf("expected current page to be appended, got %d items", got)
+	}
+}
+
+func TestUpdateViewData_CycleSort(t *testing.T) {
+	svc := &fakeDataService{data: map[string]interface{}{"a": nil}}
+	m := initListForm(Model{
+		currentState: "list",
+		ctx:          context.Background(),
+		services:     map[contracts.DataType]contracts.DataService{contracts.TypeNotes: svc},
+	}, contracts.TypeNotes)
+
+	m, cmd := updateViewData(m, tea.KeyMsg{Type: tea.KeyCtrlO})
+	if cmd == nil {
+		t.Fatal("expected list reload after sort change")
+	}
+	cmd()
+	if svc.lastPage.SortBy != model.SortByUpdated || !svc.lastPage.Desc || svc.lastPage.Token != "" {
+		t.Errorf("unexpected page request %+v", svc.lastPage)
+	}
+	if !strings.Contains(renderList(m), "недавно изменённые") {
+		t.Errorf("expected sort mode in rendered list")
+	}
+
+	m, _ = updateViewData(m, tea.KeyMsg{Type: tea.KeyCtrlO})
+	m, cmd = updateViewData(m, tea.KeyMsg{Type: tea.KeyCtrlO})
+	cmd()
+	if svc.lastPage.SortBy != model.SortByCreated || m.listSortIdx != 0 {
+		t.Errorf("expected sort modes to wrap around, got %+v", svc.lastPage)
+	}
+}
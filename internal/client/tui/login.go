@@ -78,6 +78,8 @@ func updateLogin(m Model, msg tea.Msg) (Model, tea.Cmd) {
 		// Вместо перехода сразу в меню — переключаем состояние на loginSuccess
 		m.currentState = "loginSuccess"
 		m.loginErr = nil
+		// Подписка прежнего пользователя больше не актуальна; новая откроется при загрузке списка
+		m.stopWatching()
 		return m, nil
 
 	case LoginFailedMsg:
@@ -36,6 +36,17 @@ func updateMenu(m Model, msg tea.Msg) (Model, tea.Cmd) {
 				return handleListSelection(m, contracts.TypeNotes)
 			case "Files":
 				return handleListSelection(m, contracts.TypeFiles)
+			case "Favorites":
+				newModel := initFavoritesForm(m)
+				return newModel, newModel.loadFavorites()
+			case "Conflicts":
+				newModel := initConflictsForm(m)
+				return newModel, newModel.loadConflicts()
+			case "Usage":
+				newModel := initUsageForm(m)
+				return newModel, newModel.loadUsage()
+			case "Transfers":
+				return initTransfersForm(m), nil
 			case "About":
 				m.currentState = "about"
 				return m, nil
@@ -5,9 +5,11 @@ import (
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/client/cache"
 	"github.com/ryabkov82/gophkeeper/internal/client/forms"
 	"github.com/ryabkov82/gophkeeper/internal/client/tui/adapters"
 	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 )
 
 // Определяем структуру, которая содержит все нужные сервисы для модели
@@ -17,13 +19,18 @@ type ModelServices struct {
 	Bankcard   contracts.BankCardService   // Сервис управления банковскими картами
 	TextData   contracts.TextDataService   // Сервис управления текстовыми данными
 	BinaryData contracts.BinaryDataService // Сервис управления бинарными данными
+	Item       contracts.ItemService       // Сервис избранного и истории открытия записей
+	Changes    contracts.ChangeWatcher     // Подписка на уведомления об изменениях записей
+	Conflicts  contracts.ConflictService   // Разрешение конфликтов офлайн-изменений
+
+	TransferLimit int // Число одновременных передач файлов (0 — по умолчанию)
 	// Добавляй сюда другие интерфейсы по необходимости
 }
 
 // Model - основная модель приложения, реализующая tea.Model
 type Model struct {
 	// Состояния интерфейса
-	currentState string // "menu", "login", "register", "list", "view", "edit"
+	currentState string // "menu", "login", "register", "list", "favorites", "usage", "conflicts", "conflict", "view", "edit", "transfers"
 
 	// Главное меню
 	menuItems  []menuItem // элементы главного меню
@@ -43,6 +50,37 @@ type Model struct {
 	listItems   []contracts.ListItem // универсальный список элементов
 	listCursor  int                  // индекс выбранного элемента списка
 	listErr     error                // ошибка загрузки списка
+	listFilter  model.ListFilter     // текущий фильтр списка по папке/тегу
+
+	listSortIdx   int    // индекс текущего режима сортировки в listSortModes
+	listNextToken string // токен следующей страницы списка ("" — страниц больше нет)
+	listLoading   bool   // идёт загрузка следующей страницы списка
+
+	navEntries []navEntry // элементы панели навигации по папкам и тегам
+	navCursor  int        // индекс выбранного элемента панели навигации
+	navFocused bool       // true — фокус на панели навигации, а не на списке
+	navPartial bool       // true — панель построена не по всем записям: загружены не все страницы
+
+	itemService contracts.ItemService // сервис избранного и истории открытия записей
+	favItems    []model.ItemSummary   // избранные и недавно открытые записи всех типов
+	favCursor   int                   // индекс выбранной записи в списке избранного
+	favErr      error                 // ошибка загрузки списка избранного
+	usage       *model.Usage          // занятое на сервере место (nil — не загружено)
+	usageErr    error                 // ошибка загрузки занятого места
+
+	changeWatcher contracts.ChangeWatcher  // подписка на уведомления об изменениях записей
+	watching      bool                     // подписка открыта или открывается
+	watchEvents   <-chan model.ChangeEvent // канал уведомлений текущей подписки
+	watchCancel   context.CancelFunc       // закрывает текущую подписку
+
+	conflictService   contracts.ConflictService // сервис разрешения конфликтов синхронизации
+	conflicts         []cache.Conflict          // неразрешённые конфликты
+	conflictCursor    int                       // индекс выбранного конфликта
+	conflictErr       error                     // ошибка загрузки или разрешения конфликта
+	conflict          cache.Conflict            // конфликт на экране сравнения версий
+	conflictRows      []conflictRow             // поля записи в локальной и серверной версиях
+	conflictRowCursor int                       // индекс выбранного поля
+	conflictReveal    bool                      // показывать значения конфиденциальных полей
 
 	// map: DataType -> DataService
 	services map[contracts.DataType]contracts.DataService // карта сервисов для каждого типа данных
@@ -59,13 +97,24 @@ type Model struct {
 	termWidth  int // ширина терминала
 	termHeight int // высота терминала
 
-	transfer transferVM //структура для реализации передачи файлов
+	transfer  transferVM    //структура для реализации передачи файлов
+	transfers transferQueue // очередь фоновых передач файлов
+	viewer    viewerVM      // просмотр содержимого файла
 }
 
 // Добавляем сообщения для системы
 type RegisterSuccessMsg struct{}
 type RegisterFailedMsg struct{ Err error }
-type listLoadedMsg struct{ items []contracts.ListItem }
+type listLoadedMsg struct {
+	items      []contracts.ListItem
+	filter     model.ListFilter   // фильтр, с которым был загружен список
+	next       string             // токен следующей страницы
+	appendPage bool               // true — страница дописывается к уже загруженным элементам
+	refresh    bool               // true — список перечитан по уведомлению об изменении
+	dataType   contracts.DataType // тип данных, для которого загружен список
+	sortIdx    int                // режим сортировки, с которым загружен список
+}
+type favoritesLoadedMsg struct{ items []model.ItemSummary }
 type errMsg struct{ err error }
 
 // menuItem описывает элемент главного меню.
@@ -86,13 +135,21 @@ func NewModel(ctx context.Context, svcs ModelServices) *Model {
 			{"Notes", "Текстовые заметки"},
 			{"Files", "Бинарные файлы"},
 			{"Cards", "Банковские карты"},
+			{"Favorites", "Избранное и недавние"},
+			{"Conflicts", "Конфликты синхронизации"},
+			{"Usage", "Занятое место"},
+			{"Transfers", "Передачи файлов"},
 			{"About", "О программе"},
 			{"Exit", "Выйти из приложения"},
 		},
-		inputs:       make([]textinput.Model, 0),
-		focusedInput: 0,
-		ctx:          ctx,
-		authService:  svcs.Auth,
+		inputs:          make([]textinput.Model, 0),
+		focusedInput:    0,
+		ctx:             ctx,
+		authService:     svcs.Auth,
+		itemService:     svcs.Item,
+		changeWatcher:   svcs.Changes,
+		conflictService: svcs.Conflicts,
+		transfers:       transferQueue{limit: svcs.TransferLimit},
 		services: map[contracts.DataType]contracts.DataService{
 			contracts.TypeCredentials: adapters.NewCredentialAdapter(svcs.Credential),
 			contracts.TypeCards:       adapters.NewBankCardAdapter(svcs.Bankcard),
@@ -130,6 +187,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 
+	// --- Уведомления об изменениях обрабатываются на любом экране ---
+	if updated, cmd, ok := updateChanges(m, msg); ok {
+		return updated, cmd
+	}
+
+	// --- Прогресс и завершение фоновых передач файлов ---
+	if updated, cmd, ok := updateTransfers(m, msg); ok {
+		return updated, cmd
+	}
+
 	switch m.currentState {
 	case "menu":
 		return updateMenu(m, msg)
@@ -147,24 +214,61 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Обрабатываем сообщения listLoadedMsg и errMsg
 		switch msg := msg.(type) {
 		case listLoadedMsg:
-			m.listItems = msg.items
-			m.listCursor = 0
-			return m, nil
+			if msg.refresh {
+				if m.staleList(msg) {
+					// Экран сменился, пока список перечитывался
+					return m, nil
+				}
+				m.listItems = msg.items
+				m.listCursor = min(m.listCursor, max(len(m.listItems)-1, 0))
+			} else if msg.appendPage {
+				if m.staleList(msg) {
+					// Страница устарела: тип данных, фильтр или сортировка
+					// сменились, пока она загружалась
+					return m, nil
+				}
+				m.listItems = append(m.listItems, msg.items...)
+			} else {
+				m.listItems = msg.items
+				m.listCursor = 0
+			}
+			m.listNextToken = msg.next
+			m.listLoading = false
+			if msg.filter.IsEmpty() {
+				// Панель навигации строится по нефильтрованному списку (по загруженным страницам)
+				m.navEntries = buildNavEntries(m.listItems)
+				m.navPartial = msg.next != ""
+			}
+			// Первая успешная загрузка списка открывает подписку на изменения
+			return m, m.startWatching()
 
 		case errMsg:
 			// Сохраняем ошибку в модель, чтобы показать пользователю
 			m.listErr = msg.err
+			m.listLoading = false
 			return m, nil
 
 		default:
 			return updateViewData(m, msg)
 		}
+	case "favorites":
+		return updateFavorites(m, msg)
+	case "usage":
+		return updateUsage(m, msg)
+	case "conflicts":
+		return updateConflicts(m, msg)
+	case "conflict":
+		return updateConflict(m, msg)
 	case "edit", "edit_new":
 		return updateEdit(m, msg)
 	case "fullscreen_edit":
 		return updateFullscreenForm(m, msg)
 	case "file_transfer":
 		return updateTransfer(m, msg)
+	case "transfers":
+		return updateTransfersScreen(m, msg)
+	case "file_view":
+		return updateViewer(m, msg)
 	default:
 		return m, nil
 	}
@@ -187,28 +291,67 @@ func (m Model) View() string {
 		return renderAbout(m)
 	case "list":
 		return renderList(m)
+	case "favorites":
+		return renderFavorites(m)
+	case "usage":
+		return renderUsage(m)
+	case "conflicts":
+		return renderConflicts(m)
+	case "conflict":
+		return renderConflict(m)
 	case "edit", "edit_new":
 		return renderEditForm(m)
 	case "fullscreen_edit":
 		return renderFullscreenForm(m)
 	case "file_transfer":
 		return renderTransfer(m)
+	case "transfers":
+		return renderTransfers(m)
+	case "file_view":
+		return renderViewer(m)
 	default:
 		return ""
 	}
 }
 
-// loadList возвращает команду для загрузки списка элементов текущего типа.
+// loadList возвращает команду для загрузки первой страницы списка элементов
+// текущего типа с учётом текущего фильтра по папке/тегу и режима сортировки.
 func (m *Model) loadList() tea.Cmd {
+	return m.loadPage("")
+}
+
+// loadNextPage возвращает команду для загрузки следующей страницы списка,
+// которая будет дописана к уже загруженным элементам.
+func (m *Model) loadNextPage() tea.Cmd {
+	return m.loadPage(m.listNextToken)
+}
+
+// loadPage возвращает команду загрузки страницы списка по токену
+// (пустой токен — первая страница).
+func (m *Model) loadPage(token string) tea.Cmd {
+	filter := m.listFilter
+	dataType, sortIdx := m.currentType, m.listSortIdx
+	page := listSortModes[sortIdx].page
+	page.Token = token
 	return func() tea.Msg {
-		items, err := m.services[m.currentType].List(m.ctx)
+		items, next, err := m.services[dataType].List(m.ctx, filter, page)
 		if err != nil {
 			return errMsg{err}
 		}
-		return listLoadedMsg{items}
+		return listLoadedMsg{
+			items: items, filter: filter, next: next, appendPage: token != "",
+			dataType: dataType, sortIdx: sortIdx,
+		}
 	}
 }
 
+// staleList сообщает, что список загружен для другого типа данных, фильтра
+// или режима сортировки, чем отображаемый сейчас.
+func (m Model) staleList(msg listLoadedMsg) bool {
+	return msg.dataType != m.currentType || msg.sortIdx != m.listSortIdx ||
+		!navFilterEqual(msg.filter, m.listFilter)
+}
+
 // ExtractFields извлекает значения из всех виджетов формы (inputs и textarea) в срез FormField.
 func (m Model) ExtractFields() []forms.FormField {
 	result := make([]forms.FormField, 0, len(m.widgets))
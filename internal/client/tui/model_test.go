@@ -9,16 +9,17 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/ryabkov82/gophkeeper/internal/client/forms"
 	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 )
 
 // --- Фейковые сервисы ---
 type fakeCredentialService struct{}
 
-func (f *fakeCredentialService) List(ctx context.Context) ([]contracts.ListItem, error) {
+func (f *fakeCredentialService) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
 	return []contracts.ListItem{
 		{ID: "1", Title: "Cred1"},
 		{ID: "2", Title: "Cred2"},
-	}, nil
+	}, "", nil
 }
 func (f *fakeCredentialService) Get(ctx context.Context, id string) (interface{}, error) {
 	return &contracts.ListItem{ID: id, Title: "title"}, nil
@@ -58,6 +58,11 @@ func Run(
 		Bankcard:   services,
 		TextData:   services,
 		BinaryData: services,
+		Item:       services,
+		Changes:    services,
+		Conflicts:  services,
+
+		TransferLimit: services.TransferConcurrency,
 	})
 	p := newProgram(model)
 
@@ -6,11 +6,10 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
-	"time"
 
-	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/client/archive"
 	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 )
@@ -23,15 +22,22 @@ const (
 	modeDownload
 )
 
-// частота обновления прогресса
-const progressUIRate = 250 * time.Millisecond
-
 // ----- сообщения -----
-type transferProgressMsg struct{ sent int64 }
-type transferDoneMsg struct{}
-type transferErrorMsg struct{ err error }
+type transferVersionsMsg struct {
+	versions []model.BinaryVersion
+	err      error
+}
+type transferRestoredMsg struct {
+	data *model.BinaryData
+	err  error
+}
 
 // ----- внутренний VM экрана передачи -----
+//
+// Экран только формирует передачу: нажатие Enter ставит её в очередь
+// менеджера передач (transferQueue), который выполняет её в фоне. Пока
+// передача не завершена, экран показывает её прогресс; уход с экрана её
+// не прерывает.
 type transferVM struct {
 	// deps
 	svc    contracts.BinaryTransferCapable
@@ -39,28 +45,22 @@ type transferVM struct {
 	dataID string            // ID для download (если data == nil)
 
 	// ui
-	mode  transferMode
-	input textinput.Model
-
-	// прогресс
-	bar      progress.Model
-	inFlight bool
+	mode     transferMode
+	input    textinput.Model
+	compress bool // сжимать содержимое перед шифрованием (upload)
 	err      error
-	total    int64
-	sent     int64
-
-	// скорость/ETA
-	startedAt    time.Time
-	lastTickAt   time.Time
-	lastTickSent int64
-	speedBps     float64
-	alpha        float64 // 0.25..0.35 — нормально, для сглаживания расчета скорости (EWMA)
-
-	// async
-	ctx    context.Context
-	cancel context.CancelFunc
-	progCh chan int64
-	doneCh chan error
+	jobID    int // передача, поставленная в очередь с этого экрана (0 — нет)
+
+	// выбор пути
+	picker      *filePicker // открытый обзор файлов (nil — закрыт)
+	overwrite   string      // существующий путь назначения, ждущий подтверждения
+	overwriteOK string      // путь назначения, перезапись которого подтверждена
+
+	// прежние версии содержимого (download)
+	showVersions bool
+	versions     []model.BinaryVersion
+	versionIdx   int    // выбранная версия; -1 — текущее содержимое
+	notice       string // результат последнего восстановления версии
 }
 
 // ===== init/update/render в стиле проекта =====
@@ -79,7 +79,7 @@ func initTransferForm(m Model, mode transferMode, data *model.BinaryData, dataID
 	ti := newInputField("")
 	ti.Prompt = " Путь: "
 	if mode == modeUpload {
-		ti.Placeholder = "Источник (локальный файл)"
+		ti.Placeholder = "Источник (локальный файл или папка)"
 	} else {
 		ti.Placeholder = "Назначение (куда сохранить)"
 	}
@@ -92,49 +92,76 @@ func initTransferForm(m Model, mode transferMode, data *model.BinaryData, dataID
 		m.transfer.err = fmt.Errorf("скачивание недоступно: файл ещё не загружался")
 	}
 
-	bar := progress.New(
-		progress.WithWidth(max(30, m.termWidth-20)),
-		progress.WithScaledGradient("#22c55e", "#60a5fa"), // зелёный → голубой
-		// если не хочешь проценты внутри бара:
-		// progress.WithoutPercentage(),
-	)
-
-	// Настраиваем символы «заполнено/пусто»
-	bar.Full = '█'  // чем «жирнее» символ — тем красивее градиент
-	bar.Empty = '░' // или пробел ' ' / '·' — на вкус
-
 	m.transfer = transferVM{
 		svc:    svc,
 		data:   data,
 		dataID: dataID,
 
-		mode:  mode,
-		input: ti,
-
-		bar:      bar,
-		inFlight: false,
-		err:      nil,
-		total:    0,
-		sent:     0,
+		mode:       mode,
+		input:      ti,
+		versionIdx: -1,
 	}
 
 	return m
 }
 
+// transferInFlight сообщает, что передача, поставленная с экрана, ещё
+// не завершена.
+func (m Model) transferInFlight() bool {
+	job := m.transfers.find(m.transfer.jobID)
+	return job != nil && job.active()
+}
+
 // updateTransfer — обработчик событий формы передачи
 func updateTransfer(m Model, msg tea.Msg) (Model, tea.Cmd) {
 	t := m.transfer
+	inFlight := m.transferInFlight()
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// открыт обзор файлов: клавиши принадлежат ему
+		if t.picker != nil {
+			p, path, done := t.picker.update(msg)
+			t.picker = &p
+			if done {
+				t.picker = nil
+				if path != "" {
+					t.input.SetValue(path)
+					t.input.CursorEnd()
+				}
+			}
+			m.transfer = t
+			return m, nil
+		}
+		// ждём подтверждения перезаписи существующего файла
+		if t.overwrite != "" {
+			switch msg.String() {
+			case "y", "Y", "enter":
+				t.overwriteOK, t.overwrite = t.overwrite, ""
+				m.transfer = t
+				return startTransfer(m)
+			case "n", "N", "esc":
+				t.overwrite = ""
+			}
+			m.transfer = t
+			return m, nil
+		}
+
 		switch msg.String() {
+		case "ctrl+o":
+			if !inFlight {
+				p := newFilePicker(t.input.Value())
+				t.picker = &p
+				m.transfer = t
+				return m, nil
+			}
 		case "ctrl+u":
-			if !t.inFlight {
+			if !inFlight {
 				t.mode = modeUpload
-				t.input.Placeholder = "Источник (локальный файл)"
+				t.input.Placeholder = "Источник (локальный файл или папка)"
 			}
 		case "ctrl+d":
-			if !t.inFlight {
+			if !inFlight {
 				if canSwitchToDownload(t.data) {
 					t.mode = modeDownload
 					t.input.Placeholder = "Назначение (куда сохранить)"
@@ -142,15 +169,51 @@ func updateTransfer(m Model, msg tea.Msg) (Model, tea.Cmd) {
 					t.err = fmt.Errorf("скачивание недоступно: файл ещё не загружался")
 				}
 			}
+		case "ctrl+t":
+			if !inFlight && t.mode == modeUpload {
+				t.compress = !t.compress
+			}
+		case "ctrl+v":
+			if !inFlight && t.mode == modeDownload && t.versionSvc() != nil {
+				t.showVersions = !t.showVersions
+				t.versionIdx = -1
+				t.notice = ""
+				m.transfer = t
+				if t.showVersions {
+					return m, loadTransferVersions(t.versionSvc(), t.data.ID)
+				}
+				return m, nil
+			}
+		case "up", "down":
+			if !inFlight && t.showVersions {
+				if msg.String() == "up" && t.versionIdx > -1 {
+					t.versionIdx--
+				}
+				if msg.String() == "down" && t.versionIdx < len(t.versions)-1 {
+					t.versionIdx++
+				}
+				m.transfer = t
+				return m, nil
+			}
+		case "ctrl+r":
+			if v := t.selectedVersion(); !inFlight && v != nil {
+				t.err = nil
+				t.notice = ""
+				m.transfer = t
+				return m, restoreTransferVersion(t.versionSvc(), t.data, v.ID)
+			}
+		case "ctrl+x":
+			// отмена передачи, поставленной с этого экрана
+			if inFlight {
+				m.transfers.cancel(t.jobID)
+				return m, nil
+			}
 		case "enter":
-			if !t.inFlight {
+			if !inFlight {
 				return startTransfer(m)
 			}
 		case "esc", "ctrl+c":
-			// отмена/назад
-			if t.inFlight && t.cancel != nil {
-				t.cancel()
-			}
+			// назад; передача продолжается в фоне (см. экран «Передачи»)
 			m.currentState = m.prevState
 			if t.data.ID != "" {
 				return loadAndShowItem(m, t.data.ID)
@@ -158,44 +221,33 @@ func updateTransfer(m Model, msg tea.Msg) (Model, tea.Cmd) {
 			return m, nil
 		}
 
-	case transferProgressMsg:
-		// апдейтим количество и скорость
-		now := time.Now()
-		dt := now.Sub(t.lastTickAt).Seconds()
-		if dt > 0 {
-			inst := float64(msg.sent-t.lastTickSent) / dt // bytes/sec за окно троттлинга
-			if t.speedBps == 0 {
-				t.speedBps = inst
-			} else {
-				t.speedBps = t.alpha*inst + (1.0-t.alpha)*t.speedBps // EWMA
-			}
-		}
-		t.lastTickAt = now
-		t.lastTickSent = msg.sent
-
-		t.sent = msg.sent
-		if t.total > 0 && t.sent > t.total {
-			t.sent = t.total
+	case transferVersionsMsg:
+		if msg.err != nil {
+			t.err = msg.err
+			m.transfer = t
+			return m, nil
 		}
-
-		m.transfer = t
-		return m, listenTransferProgressThrottle(t.progCh, progressUIRate)
-
-	case transferDoneMsg:
-		t.inFlight = false
-		t.err = nil
+		t.versions = msg.versions
+		t.versionIdx = min(t.versionIdx, len(t.versions)-1)
 		m.transfer = t
 		return m, nil
 
-	case transferErrorMsg:
-		t.inFlight = false
-		t.err = msg.err
+	case transferRestoredMsg:
+		if msg.err != nil {
+			t.err = msg.err
+			m.transfer = t
+			return m, nil
+		}
+		// обновляем запись на месте: её же показывает форма редактирования
+		*t.data = *msg.data
+		t.versionIdx = -1
+		t.notice = "Версия восстановлена"
 		m.transfer = t
-		return m, nil
+		return m, loadTransferVersions(t.versionSvc(), t.data.ID)
 	}
 
 	// делегируем ввод инпуту, если не идёт передача
-	if !t.inFlight {
+	if !inFlight {
 		var cmd tea.Cmd
 		t.input, cmd = t.input.Update(msg)
 		m.transfer = t
@@ -214,55 +266,69 @@ func renderTransfer(m Model) string {
 	title := "Загрузка файла на сервер"
 	if t.mode == modeDownload {
 		title = "Скачивание файла с сервера"
+		if t.selectedVersion() != nil {
+			title = "Скачивание прежней версии файла"
+		}
 	}
 	b.WriteString(titleStyle.Render(title) + "\n\n")
+	if t.picker != nil {
+		b.WriteString(t.picker.view())
+		return b.String()
+	}
 	b.WriteString(t.input.View() + "\n\n")
-
-	if t.inFlight {
-		// проценты/бар
-
-		if t.total > 0 {
-			r := float64(t.sent) / float64(t.total)
-			if r < 0 {
-				r = 0
-			}
-			if r > 1 {
-				r = 1
-			}
-			//b.WriteString(renderAsciiBar(r, max(20, m.termWidth-20)) + "\n")
-			barView := t.bar.ViewAs(r)
-			b.WriteString(barView + "\n")
-
-			b.WriteString(fmt.Sprintf("  %s / %s (%.1f%%)",
-				humanBytes(t.sent), humanBytes(t.total), r*100))
-		} else {
-			//b.WriteString(renderAsciiBar(0, max(20, m.termWidth-20)) + "\n")
-			barView := t.bar.ViewAs(0)
-			b.WriteString(barView + "\n")
-			b.WriteString(fmt.Sprintf("  Передано: %s (размер неизвестен)", humanBytes(t.sent)))
+	if t.showVersions {
+		b.WriteString(renderTransferVersions(t) + "\n")
+	}
+	if t.mode == modeUpload {
+		state := "выкл"
+		if t.compress {
+			state = "вкл"
 		}
+		b.WriteString(fmt.Sprintf(" Сжатие: %s\n\n", state))
+	}
 
-		// скорость/ETA
-		if t.speedBps > 0 && t.total > 0 && t.sent <= t.total {
-			remain := float64(t.total - t.sent)
-			eta := time.Duration(remain/t.speedBps) * time.Second
-			b.WriteString(fmt.Sprintf(" • ~%s/s • ETA %s", humanBytes(int64(t.speedBps)), eta.Truncate(time.Second)))
-		} else if t.speedBps > 0 {
-			b.WriteString(fmt.Sprintf(" • ~%s/s", humanBytes(int64(t.speedBps))))
-		}
+	job := m.transfers.find(t.jobID)
+	if job != nil && job.active() {
+		b.WriteString(renderJobProgress(job, m.transfers.bar(m.termWidth)))
+		b.WriteString("\n\n" + hintStyle.Render("Esc: назад (передача продолжится в фоне) • Ctrl+X: отменить"))
+		return b.String()
+	}
 
-		b.WriteString("\n\n" + hintStyle.Render("Esc: отменить/назад • Ctrl+U/Ctrl+D: режим Upload/Download"))
-	} else {
-		b.WriteString(hintStyle.Render("Enter: старт • Esc: назад • Ctrl+U/Ctrl+D: режим Upload/Download"))
-		if t.err != nil {
-			b.WriteString("\n\n" + errorStyle.Render("Ошибка: "+t.err.Error()))
-		}
+	if t.overwrite != "" {
+		b.WriteString(fmt.Sprintf("Файл %s уже существует. Перезаписать? (y/n)", t.overwrite))
+		return b.String()
+	}
+
+	hint := "Enter: старт • Esc: назад • Ctrl+O: обзор • Ctrl+U/Ctrl+D: режим Upload/Download"
+	if t.mode == modeUpload {
+		hint += " • Ctrl+T: сжатие"
+	}
+	if t.mode == modeDownload && t.versionSvc() != nil {
+		hint += " • Ctrl+V: версии"
+	}
+	if t.showVersions {
+		hint += " • ↑/↓: выбор версии • Ctrl+R: восстановить"
+	}
+	b.WriteString(hintStyle.Render(hint))
+	if job != nil && job.state == jobDone {
+		b.WriteString("\n\n" + transferDoneText(job))
+	}
+	if t.notice != "" {
+		b.WriteString("\n\n" + t.notice)
+	}
+	err := t.err
+	if err == nil && job != nil && job.state == jobFailed {
+		err = job.err
+	}
+	if err != nil {
+		b.WriteString("\n\n" + errorStyle.Render("Ошибка: "+err.Error()))
 	}
 	return b.String()
 }
 
 // ===== команды/утилиты =====
 
+// startTransfer проверяет форму и ставит передачу в очередь менеджера передач.
 func startTransfer(m Model) (Model, tea.Cmd) {
 
 	t := m.transfer
@@ -286,6 +352,11 @@ func startTransfer(m Model) (Model, tea.Cmd) {
 		return m, nil
 	}
 
+	job := &transferJob{svc: t.svc, mode: t.mode, data: t.data, dataID: t.dataID}
+	if job.dataID == "" && t.data != nil {
+		job.dataID = t.data.ID
+	}
+
 	// total и нормализация пути
 	if t.mode == modeUpload {
 		st, err := os.Stat(path)
@@ -294,92 +365,111 @@ func startTransfer(m Model) (Model, tea.Cmd) {
 			m.transfer = t
 			return m, nil
 		}
+		job.total = st.Size()
 		if st.IsDir() {
-			t.err = fmt.Errorf("ожидается файл, а не каталог")
-			m.transfer = t
-			return m, nil
+			// Папка отправляется архивом; прогресс — по суммарному размеру файлов
+			entries, err := archive.List(path)
+			if err != nil {
+				t.err = err
+				m.transfer = t
+				return m, nil
+			}
+			job.total = archive.Size(entries)
+		}
+		if t.data != nil {
+			t.data.Compress = t.compress
 		}
-		t.total = st.Size()
 	} else {
-		// каталог -> доклеим имя
+		archived, clientPath, size := false, "", int64(0)
+		if v := t.selectedVersion(); v != nil {
+			job.versions, job.versionID = t.versionSvc(), v.ID
+			archived, clientPath, size = v.Archive != "", v.ClientPath, v.Size
+		} else if t.data != nil {
+			archived, clientPath, size = t.data.Archive != "", t.data.ClientPath, t.data.Size
+		}
+		// каталог -> доклеим имя; архив папки распаковывается во вложенную папку
 		if fi, err := os.Stat(path); err == nil && fi.IsDir() {
 			name := "download.bin"
+			if archived {
+				name = filepath.Base(clientPath)
+			}
 			path = filepath.Join(path, name)
 		}
-		if t.data != nil && t.data.Size > 0 {
-			t.total = t.data.Size
-		} else {
-			t.total = 0
+		job.total = max(size, 0)
+
+		// существующий файл (или папку) назначения перезаписываем только с согласия
+		if _, err := os.Stat(path); err == nil && path != t.overwriteOK {
+			t.overwrite = path
+			m.transfer = t
+			return m, nil
 		}
 	}
+	job.path = path
 
-	// запуск фоновой операции
-	t.ctx, t.cancel = context.WithCancel(context.Background())
-	t.progCh = make(chan int64, 32)
-	t.doneCh = make(chan error, 1)
-	t.inFlight = true
 	t.err = nil
-	t.sent = 0
-	t.startedAt = time.Now()
-	t.lastTickAt = t.startedAt
-	t.lastTickSent = 0
-	t.speedBps = 0
-	t.alpha = 0.3
-
-	go func(tt *transferVM, p string) {
-		var err error
-		if tt.mode == modeUpload {
-			err = tt.svc.UploadBinaryData(tt.ctx, tt.data, p, tt.progCh)
-		} else {
-			id := tt.dataID
-			if id == "" && tt.data != nil {
-				id = tt.data.ID
-			}
-			err = tt.svc.DownloadBinaryData(tt.ctx, id, p, tt.progCh)
-		}
-		close(tt.progCh)
-		tt.doneCh <- err
-	}(&t, path)
-
+	t.notice = ""
+	t.overwriteOK = ""
+	cmd := m.transfers.add(job)
+	t.jobID = job.id
 	m.transfer = t
-	return m, tea.Batch(listenTransferProgressThrottle(t.progCh, progressUIRate), waitTransferDone(t.doneCh))
+	return m, cmd
 }
 
-func waitTransferDone(done <-chan error) tea.Cmd {
-	return func() tea.Msg {
-		if err := <-done; err != nil {
-			return transferErrorMsg{err: err}
-		}
-		return transferDoneMsg{}
+// versionSvc возвращает сервис прежних версий, если он доступен для записи.
+func (t *transferVM) versionSvc() contracts.BinaryVersionCapable {
+	if t.data == nil || t.data.ID == "" {
+		return nil
 	}
+	svc, _ := t.svc.(contracts.BinaryVersionCapable)
+	return svc
 }
 
-func listenTransferProgressThrottle(ch <-chan int64, maxRate time.Duration) tea.Cmd {
-	return func() tea.Msg {
-		// первое значение (или сразу выходим, если канал закрыт)
-		v, ok := <-ch
-		if !ok {
-			return nil
+// selectedVersion возвращает выбранную прежнюю версию или nil, если
+// выбрано текущее содержимое.
+func (t *transferVM) selectedVersion() *model.BinaryVersion {
+	if !t.showVersions || t.versionIdx < 0 || t.versionIdx >= len(t.versions) {
+		return nil
+	}
+	return &t.versions[t.versionIdx]
+}
+
+// renderTransferVersions — список версий: текущее содержимое и прежние версии.
+func renderTransferVersions(t transferVM) string {
+	var b strings.Builder
+	b.WriteString(" Версии:\n")
+	row := func(selected bool, text string) {
+		if selected {
+			b.WriteString(selectedStyle.Render("> "+text) + "\n")
+		} else {
+			b.WriteString("  " + text + "\n")
 		}
-		last := v
+	}
+	row(t.versionIdx < 0, "текущая")
+	for i, v := range t.versions {
+		row(i == t.versionIdx, fmt.Sprintf("%s  %s  %s",
+			v.CreatedAt.Local().Format("02.01.2006 15:04"), humanBytes(v.Size), v.ClientPath))
+	}
+	if len(t.versions) == 0 {
+		b.WriteString(hintStyle.Render("  Прежних версий нет") + "\n")
+	}
+	return b.String()
+}
 
-		timer := time.NewTimer(maxRate)
-		defer timer.Stop()
+// loadTransferVersions возвращает команду загрузки прежних версий файла.
+func loadTransferVersions(svc contracts.BinaryVersionCapable, id string) tea.Cmd {
+	return func() tea.Msg {
+		versions, err := svc.ListBinaryVersions(context.Background(), id)
+		return transferVersionsMsg{versions: versions, err: err}
+	}
+}
 
-		for {
-			select {
-			case x, ok := <-ch:
-				if !ok {
-					// канал закрыт: отдадим последнее накопленное значение
-					return transferProgressMsg{sent: last}
-				}
-				last = x // коалесцируем
-				// остаёмся в этом select до таймаута
-			case <-timer.C:
-				// окно прошло — отдадим одно сообщение в Update
-				return transferProgressMsg{sent: last}
-			}
-		}
+// restoreTransferVersion возвращает команду восстановления прежней версии
+// versionID файла data.
+func restoreTransferVersion(svc contracts.BinaryVersionCapable, data *model.BinaryData, versionID string) tea.Cmd {
+	id, version := data.ID, data.Version
+	return func() tea.Msg {
+		restored, err := svc.RestoreBinaryVersion(context.Background(), id, versionID, version)
+		return transferRestoredMsg{data: restored, err: err}
 	}
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -23,8 +24,8 @@ type mockBinaryTransferService struct {
 	downloadErr    error
 }
 
-func (f *mockBinaryTransferService) List(ctx context.Context) ([]contracts.ListItem, error) {
-	return nil, nil
+func (f *mockBinaryTransferService) List(ctx context.Context, filter model.ListFilter, page model.PageRequest) ([]contracts.ListItem, string, error) {
+	return nil, "", nil
 }
 func (f *mockBinaryTransferService) Get(ctx context.Context, id string) (interface{}, error) {
 	return nil, nil
@@ -77,32 +78,31 @@ func TestHumanBytes(t *testing.T) {
 func TestListenTransferProgress(t *testing.T) {
 	ch := make(chan int64, 1)
 	ch <- 42
-	cmd := listenTransferProgressThrottle(ch, 1)
+	cmd := listenTransferProgressThrottle(7, ch, 1)
 	msg := cmd()
 	progress, ok := msg.(transferProgressMsg)
 	require.True(t, ok)
+	assert.Equal(t, 7, progress.id)
 	assert.Equal(t, int64(42), progress.sent)
 
 	emptyCh := make(chan int64)
 	close(emptyCh)
-	cmd = listenTransferProgressThrottle(emptyCh, 1)
+	cmd = listenTransferProgressThrottle(7, emptyCh, 1)
 	assert.Nil(t, cmd())
 }
 
 func TestWaitTransferDone(t *testing.T) {
 	done := make(chan error, 1)
 	done <- nil
-	cmd := waitTransferDone(done)
-	msg := cmd()
-	assert.IsType(t, transferDoneMsg{}, msg)
+	cmd := waitTransferDone(3, done)
+	assert.Equal(t, transferDoneMsg{id: 3}, cmd())
 
 	errCh := make(chan error, 1)
 	errCh <- errors.New("boom")
-	cmd = waitTransferDone(errCh)
-	msg = cmd()
-	errMsg, ok := msg.(transferErrorMsg)
+	cmd = waitTransferDone(3, errCh)
+	msg, ok := cmd().(transferDoneMsg)
 	require.True(t, ok)
-	assert.EqualError(t, errMsg.err, "boom")
+	assert.EqualError(t, msg.err, "boom")
 }
 
 func TestStartTransfer_EmptyPath(t *testing.T) {
@@ -140,8 +140,8 @@ func TestStartTransfer_UploadFile(t *testing.T) {
 
 	m, cmd := startTransfer(m)
 	require.NotNil(t, cmd)
-	assert.True(t, m.transfer.inFlight)
-	assert.Equal(t, int64(5), m.transfer.total)
+	assert.True(t, m.transferInFlight())
+	assert.Equal(t, int64(5), m.transfers.find(m.transfer.jobID).total)
 
 	// allow goroutine to run
 	time.Sleep(10 * time.Millisecond)
@@ -162,14 +162,44 @@ func TestStartTransfer_DownloadNoClientPath(t *testing.T) {
 
 func TestStartTransfer_UploadDir(t *testing.T) {
 	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "a.txt"), []byte("hello"), 0o600))
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "sub"), 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "sub", "b.txt"), []byte("world!"), 0o600))
+
 	ti := newInputField("")
 	ti.SetValue(dir)
 	svc := &mockBinaryTransferService{}
-	m := Model{transfer: transferVM{mode: modeUpload, input: ti, svc: svc}}
+	m := Model{transfer: transferVM{mode: modeUpload, input: ti, svc: svc, data: &model.BinaryData{}}}
 	m, cmd := startTransfer(m)
-	assert.Nil(t, cmd)
-	require.Error(t, m.transfer.err)
-	assert.Equal(t, "ожидается файл, а не каталог", m.transfer.err.Error())
+	require.NotNil(t, cmd)
+	assert.NoError(t, m.transfer.err)
+	assert.True(t, m.transferInFlight())
+	// Папка отправляется архивом, прогресс считается по размеру файлов
+	assert.Equal(t, int64(11), m.transfers.find(m.transfer.jobID).total)
+}
+
+func TestStartTransfer_DownloadArchiveIntoDir(t *testing.T) {
+	dir := t.TempDir()
+	ti := newInputField("")
+	ti.SetValue(dir)
+	svc := &recordingTransferService{dest: make(chan string, 1)}
+	data := &model.BinaryData{ID: "id1", ClientPath: "/home/user/photos", Archive: "[]"}
+	m := Model{transfer: transferVM{mode: modeDownload, input: ti, svc: svc, data: data}}
+
+	_, cmd := startTransfer(m)
+	require.NotNil(t, cmd)
+	assert.Equal(t, filepath.Join(dir, "photos"), <-svc.dest)
+}
+
+// recordingTransferService запоминает путь назначения скачивания.
+type recordingTransferService struct {
+	mockBinaryTransferService
+	dest chan string
+}
+
+func (r *recordingTransferService) DownloadBinaryData(ctx context.Context, dataID, destPath string, progress chan<- int64) error {
+	r.dest <- destPath
+	return nil
 }
 
 func TestStartTransfer_CommandMessages(t *testing.T) {
@@ -213,7 +243,7 @@ func TestInitTransferForm_Upload(t *testing.T) {
 	assert.Equal(t, svc, m2.transfer.svc)
 	assert.Equal(t, data, m2.transfer.data)
 	assert.Equal(t, "id1", m2.transfer.dataID)
-	assert.Equal(t, "Источник (локальный файл)", m2.transfer.input.Placeholder)
+	assert.Equal(t, "Источник (локальный файл или папка)", m2.transfer.input.Placeholder)
 	assert.Nil(t, m2.transfer.err)
 }
 
@@ -251,7 +281,7 @@ func TestUpdateTransfer_CtrlU(t *testing.T) {
 	m := Model{transfer: transferVM{mode: modeDownload, input: newInputField("")}}
 	m2, _ := updateTransfer(m, tea.KeyMsg{Type: tea.KeyCtrlU})
 	assert.Equal(t, modeUpload, m2.transfer.mode)
-	assert.Equal(t, "Источник (локальный файл)", m2.transfer.input.Placeholder)
+	assert.Equal(t, "Источник (локальный файл или папка)", m2.transfer.input.Placeholder)
 }
 
 func TestUpdateTransfer_CtrlD(t *testing.T) {
@@ -282,38 +312,12 @@ func TestUpdateTransfer_Enter(t *testing.T) {
 }
 
 func TestUpdateTransfer_Esc(t *testing.T) {
-	m := Model{currentState: "file_transfer", prevState: "menu", transfer: transferVM{input: newInputField(""), inFlight: false, data: &model.BinaryData{}}}
+	m := Model{currentState: "file_transfer", prevState: "menu", transfer: transferVM{input: newInputField(""), data: &model.BinaryData{}}}
 	m2, cmd := updateTransfer(m, tea.KeyMsg{Type: tea.KeyEsc})
 	assert.Nil(t, cmd)
 	assert.Equal(t, "menu", m2.currentState)
 }
 
-func TestUpdateTransfer_ProgressMsg(t *testing.T) {
-	ch := make(chan int64)
-	tv := transferVM{progCh: ch, total: 100, lastTickAt: time.Now().Add(-time.Second), inFlight: true}
-	m := Model{transfer: tv}
-	m2, cmd := updateTransfer(m, transferProgressMsg{sent: 150})
-	require.NotNil(t, cmd)
-	assert.Equal(t, int64(100), m2.transfer.sent)
-}
-
-func TestUpdateTransfer_DoneMsg(t *testing.T) {
-	m := Model{transfer: transferVM{inFlight: true, err: errors.New("fail")}}
-	m2, cmd := updateTransfer(m, transferDoneMsg{})
-	assert.Nil(t, cmd)
-	assert.False(t, m2.transfer.inFlight)
-	assert.Nil(t, m2.transfer.err)
-}
-
-func TestUpdateTransfer_ErrorMsg(t *testing.T) {
-	err := errors.New("boom")
-	m := Model{transfer: transferVM{inFlight: true}}
-	m2, cmd := updateTransfer(m, transferErrorMsg{err: err})
-	assert.Nil(t, cmd)
-	assert.False(t, m2.transfer.inFlight)
-	assert.Equal(t, err, m2.transfer.err)
-}
-
 func TestUpdateTransfer_InputUpdate(t *testing.T) {
 	ti := newInputField("")
 	ti.Focus()
@@ -332,17 +336,198 @@ func TestRenderTransfer_NotInFlight(t *testing.T) {
 }
 
 func TestRenderTransfer_InFlightKnownTotal(t *testing.T) {
-	tv := transferVM{mode: modeDownload, input: newInputField(""), inFlight: true, total: 100, sent: 50}
-	m := Model{transfer: tv, termWidth: 80}
+	job := &transferJob{id: 1, state: jobRunning, total: 100, sent: 50}
+	tv := transferVM{mode: modeDownload, input: newInputField(""), jobID: 1}
+	m := Model{transfer: tv, transfers: transferQueue{jobs: []*transferJob{job}}, termWidth: 80}
 	out := renderTransfer(m)
 	assert.Contains(t, out, "Скачивание файла с сервера")
 	assert.Contains(t, out, "50 B / 100 B (50.0%)")
-	assert.Contains(t, out, "Esc: отменить/назад")
+	assert.Contains(t, out, "Ctrl+X: отменить")
 }
 
 func TestRenderTransfer_InFlightUnknownTotal(t *testing.T) {
-	tv := transferVM{mode: modeUpload, input: newInputField(""), inFlight: true, total: 0, sent: 10}
-	m := Model{transfer: tv, termWidth: 80}
+	job := &transferJob{id: 1, state: jobRunning, sent: 10}
+	tv := transferVM{mode: modeUpload, input: newInputField(""), jobID: 1}
+	m := Model{transfer: tv, transfers: transferQueue{jobs: []*transferJob{job}}, termWidth: 80}
 	out := renderTransfer(m)
 	assert.Contains(t, out, "Передано: 10 B (размер неизвестен)")
 }
+
+func TestRenderTransfer_Finished(t *testing.T) {
+	done := &transferJob{id: 1, mode: modeDownload, state: jobDone, sent: 5, path: "/tmp/out"}
+	failed := &transferJob{id: 2, mode: modeUpload, state: jobFailed, err: errors.New("boom")}
+	q := transferQueue{jobs: []*transferJob{done, failed}}
+
+	m := Model{transfer: transferVM{mode: modeDownload, input: newInputField(""), jobID: 1}, transfers: q}
+	out := renderTransfer(m)
+	assert.Contains(t, out, "Скачано: 5 B → /tmp/out")
+	assert.Contains(t, out, "Enter: старт")
+
+	m.transfer = transferVM{mode: modeUpload, input: newInputField(""), jobID: 2}
+	assert.Contains(t, renderTransfer(m), "Ошибка: boom")
+}
+
+func TestUpdateTransfer_CtrlX(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	job := &transferJob{id: 1, state: jobRunning, cancel: cancel}
+	m := Model{transfer: transferVM{input: newInputField(""), jobID: 1}, transfers: transferQueue{jobs: []*transferJob{job}}}
+
+	m, _ = updateTransfer(m, tea.KeyMsg{Type: tea.KeyCtrlX})
+	assert.Error(t, ctx.Err())
+	assert.True(t, job.canceled)
+
+	// Esc уводит с экрана, не прерывая передачу
+	job2 := &transferJob{id: 2, state: jobRunning, cancel: func() { t.Fatal("unexpected cancel") }}
+	m.transfers.jobs = append(m.transfers.jobs, job2)
+	m.transfer = transferVM{input: newInputField(""), jobID: 2, data: &model.BinaryData{}}
+	m.currentState, m.prevState = "file_transfer", "list"
+	m, _ = updateTransfer(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, "list", m.currentState)
+	assert.Equal(t, jobRunning, job2.state)
+}
+
+func TestUpdateTransfer_CtrlT_Compress(t *testing.T) {
+	f, err := os.CreateTemp("", "upload")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+	require.NoError(t, f.Close())
+
+	svc := &mockBinaryTransferService{}
+	ti := newInputField("")
+	ti.SetValue(f.Name())
+	data := &model.BinaryData{}
+	m := Model{transfer: transferVM{mode: modeUpload, input: ti, svc: svc, data: data}}
+	assert.Contains(t, renderTransfer(m), "Сжатие: выкл")
+
+	m, _ = updateTransfer(m, tea.KeyMsg{Type: tea.KeyCtrlT})
+	assert.True(t, m.transfer.compress)
+	assert.Contains(t, renderTransfer(m), "Сжатие: вкл")
+
+	m, _ = startTransfer(m)
+	assert.True(t, data.Compress)
+
+	// В режиме скачивания переключатель не показывается
+	m.transfer.mode = modeDownload
+	m.transfer.jobID = 0
+	assert.NotContains(t, renderTransfer(m), "Сжатие")
+}
+
+// versionTransferService — мок сервиса передачи с прежними версиями файла.
+type versionTransferService struct {
+	mockBinaryTransferService
+	versions  []model.BinaryVersion
+	versionID chan string
+	restored  string
+}
+
+func (v *versionTransferService) ListBinaryVersions(ctx context.Context, dataID string) ([]model.BinaryVersion, error) {
+	return v.versions, nil
+}
+
+func (v *versionTransferService) DownloadBinaryVersion(ctx context.Context, dataID, versionID, destPath string, progress chan<- int64) error {
+	v.versionID <- versionID
+	return nil
+}
+
+func (v *versionTransferService) RestoreBinaryVersion(ctx context.Context, dataID, versionID string, version int64) (*model.BinaryData, error) {
+	v.restored = versionID
+	return &model.BinaryData{ID: dataID, ClientPath: "/tmp/old", Version: version + 1}, nil
+}
+
+func TestUpdateTransfer_Versions(t *testing.T) {
+	svc := &versionTransferService{
+		versions:  []model.BinaryVersion{{ID: "v1", Size: 10, ClientPath: "/tmp/old"}},
+		versionID: make(chan string, 1),
+	}
+	data := &model.BinaryData{ID: "id1", ClientPath: "/tmp/file", Version: 2}
+	ti := newInputField("")
+	ti.SetValue(filepath.Join(t.TempDir(), "out"))
+	m := Model{transfer: transferVM{mode: modeDownload, input: ti, svc: svc, data: data, versionIdx: -1}}
+	assert.Contains(t, renderTransfer(m), "Ctrl+V: версии")
+
+	m, cmd := updateTransfer(m, tea.KeyMsg{Type: tea.KeyCtrlV})
+	require.NotNil(t, cmd)
+	m, _ = updateTransfer(m, cmd())
+	assert.Contains(t, renderTransfer(m), "/tmp/old")
+
+	m, _ = updateTransfer(m, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 0, m.transfer.versionIdx)
+	assert.Contains(t, renderTransfer(m), "Скачивание прежней версии файла")
+
+	// Enter скачивает выбранную версию
+	m, cmd = startTransfer(m)
+	require.NotNil(t, cmd)
+	assert.Equal(t, "v1", <-svc.versionID)
+	assert.Equal(t, int64(10), m.transfers.find(m.transfer.jobID).total)
+	m, _, _ = updateTransfers(m, transferDoneMsg{id: m.transfer.jobID})
+
+	// Ctrl+R восстанавливает её и обновляет запись
+	m, cmd = updateTransfer(m, tea.KeyMsg{Type: tea.KeyCtrlR})
+	require.NotNil(t, cmd)
+	m, cmd = updateTransfer(m, cmd())
+	require.NotNil(t, cmd)
+	assert.Equal(t, "v1", svc.restored)
+	assert.EqualValues(t, 3, data.Version)
+	assert.Equal(t, -1, m.transfer.versionIdx)
+	assert.Contains(t, renderTransfer(m), "Версия восстановлена")
+}
+
+func TestUpdateTransfer_VersionsUnavailable(t *testing.T) {
+	// Сервис без поддержки версий и запись без ID — список не открывается
+	data := &model.BinaryData{ClientPath: "/tmp/file"}
+	m := Model{transfer: transferVM{mode: modeDownload, input: newInputField(""), svc: &mockBinaryTransferService{}, data: data, versionIdx: -1}}
+	m, cmd := updateTransfer(m, tea.KeyMsg{Type: tea.KeyCtrlV})
+	assert.Nil(t, cmd)
+	assert.False(t, m.transfer.showVersions)
+	assert.NotContains(t, renderTransfer(m), "Ctrl+V")
+}
+
+func TestUpdateTransfer_Picker(t *testing.T) {
+	dir := pickerDir(t)
+	ti := newInputField("")
+	ti.SetValue(dir)
+	m := Model{transfer: transferVM{mode: modeUpload, input: ti, svc: &mockBinaryTransferService{}, data: &model.BinaryData{}}}
+
+	m, _ = updateTransfer(m, tea.KeyMsg{Type: tea.KeyCtrlO})
+	require.NotNil(t, m.transfer.picker)
+	assert.Contains(t, renderTransfer(m), "a.txt")
+
+	// выбор файла закрывает обзор и подставляет путь
+	m, _ = updateTransfer(m, key("down"))
+	m, cmd := updateTransfer(m, key("enter"))
+	assert.Nil(t, cmd)
+	assert.Nil(t, m.transfer.picker)
+	assert.Equal(t, filepath.Join(dir, "a.txt"), m.transfer.input.Value())
+}
+
+func TestStartTransfer_ConfirmOverwrite(t *testing.T) {
+	dir := t.TempDir()
+	dest := filepath.Join(dir, "out.bin")
+	require.NoError(t, os.WriteFile(dest, []byte("old"), 0o600))
+
+	ti := newInputField("")
+	ti.SetValue(dest)
+	svc := &recordingTransferService{dest: make(chan string, 1)}
+	data := &model.BinaryData{ID: "id1", ClientPath: "/tmp/file"}
+	m := Model{transfer: transferVM{mode: modeDownload, input: ti, svc: svc, data: data, versionIdx: -1}}
+
+	// существующий файл — спрашиваем
+	m, cmd := updateTransfer(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+	assert.Equal(t, dest, m.transfer.overwrite)
+	assert.Contains(t, renderTransfer(m), "уже существует. Перезаписать? (y/n)")
+
+	// отказ — передача не начинается
+	m, cmd = updateTransfer(m, key("n"))
+	assert.Nil(t, cmd)
+	assert.Empty(t, m.transfer.overwrite)
+	assert.Zero(t, m.transfer.jobID)
+
+	// согласие — передача ставится в очередь
+	m, _ = updateTransfer(m, tea.KeyMsg{Type: tea.KeyEnter})
+	m, cmd = updateTransfer(m, key("y"))
+	require.NotNil(t, cmd)
+	assert.NotZero(t, m.transfer.jobID)
+	assert.Equal(t, dest, <-svc.dest)
+	assert.Empty(t, m.transfer.overwriteOK)
+}
@@ -0,0 +1,450 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// defaultTransferLimit — число одновременных передач, если ограничение не задано.
+const defaultTransferLimit = 3
+
+// частота обновления прогресса
+const progressUIRate = 250 * time.Millisecond
+
+// transferSpeedAlpha — вес последнего замера в сглаженной скорости (EWMA).
+const transferSpeedAlpha = 0.3
+
+// ----- сообщения -----
+type transferProgressMsg struct {
+	id   int
+	ch   <-chan int64 // канал прогресса запуска, к которому относится значение
+	sent int64
+}
+type transferDoneMsg struct {
+	id  int
+	err error
+}
+
+// transferState — состояние передачи в очереди.
+type transferState int
+
+const (
+	jobQueued transferState = iota
+	jobRunning
+	jobDone
+	jobFailed
+	jobCanceled
+)
+
+// transferJob — одна передача файла в очереди менеджера передач.
+type transferJob struct {
+	id        int
+	svc       contracts.BinaryTransferCapable
+	versions  contracts.BinaryVersionCapable // для скачивания прежней версии
+	mode      transferMode
+	data      *model.BinaryData
+	dataID    string
+	versionID string // прежняя версия содержимого (download; "" — текущее)
+	path      string
+
+	state    transferState
+	err      error
+	canceled bool // пользователь отменил выполняемую передачу
+	total    int64
+	sent     int64
+
+	// скорость
+	lastTickAt   time.Time
+	lastTickSent int64
+	speedBps     float64
+
+	cancel context.CancelFunc
+	progCh chan int64
+}
+
+// active сообщает, что передача ждёт своей очереди или выполняется.
+func (j *transferJob) active() bool {
+	return j.state == jobQueued || j.state == jobRunning
+}
+
+// title возвращает название передачи для списка.
+func (j *transferJob) title() string {
+	name := j.path
+	if j.data != nil && j.data.Title != "" {
+		name = j.data.Title
+	}
+	if j.mode == modeUpload {
+		return "↑ " + name
+	}
+	if j.versionID != "" {
+		name += " (прежняя версия)"
+	}
+	return "↓ " + name
+}
+
+// progress учитывает очередное значение счётчика переданных байт и
+// пересчитывает сглаженную скорость.
+func (j *transferJob) progress(sent int64, now time.Time) {
+	if dt := now.Sub(j.lastTickAt).Seconds(); dt > 0 {
+		inst := float64(sent-j.lastTickSent) / dt // bytes/sec за окно троттлинга
+		if j.speedBps == 0 {
+			j.speedBps = inst
+		} else {
+			j.speedBps = transferSpeedAlpha*inst + (1.0-transferSpeedAlpha)*j.speedBps
+		}
+	}
+	j.lastTickAt = now
+	j.lastTickSent = sent
+
+	j.sent = sent
+	if j.total > 0 && j.sent > j.total {
+		j.sent = j.total
+	}
+}
+
+// transferQueue — менеджер передач: очередь загрузок и скачиваний, из
+// которой одновременно выполняется не больше limit передач. Прогресс и
+// завершение передач обрабатываются на любом экране (см. updateTransfers),
+// поэтому передачи продолжаются, пока пользователь работает с другими
+// списками.
+type transferQueue struct {
+	limit    int // ограничение одновременных передач (0 — defaultTransferLimit)
+	jobs     []*transferJob
+	nextID   int
+	cursor   int // выбранная передача на экране «Передачи»
+	progress *progress.Model
+}
+
+// capacity возвращает число одновременно выполняемых передач.
+func (q *transferQueue) capacity() int {
+	if q.limit > 0 {
+		return q.limit
+	}
+	return defaultTransferLimit
+}
+
+// find возвращает передачу по идентификатору или nil.
+func (q *transferQueue) find(id int) *transferJob {
+	for _, j := range q.jobs {
+		if j.id == id {
+			return j
+		}
+	}
+	return nil
+}
+
+// add ставит передачу в очередь, присваивая ей идентификатор, и запускает
+// её, если есть свободное место.
+func (q *transferQueue) add(job *transferJob) tea.Cmd {
+	q.nextID++
+	job.id = q.nextID
+	job.state = jobQueued
+	q.jobs = append(q.jobs, job)
+	return q.schedule()
+}
+
+// schedule запускает передачи из очереди, пока их не станет limit.
+func (q *transferQueue) schedule() tea.Cmd {
+	running := 0
+	for _, j := range q.jobs {
+		if j.state == jobRunning {
+			running++
+		}
+	}
+	var cmds []tea.Cmd
+	for _, j := range q.jobs {
+		if running >= q.capacity() {
+			break
+		}
+		if j.state == jobQueued {
+			cmds = append(cmds, startJob(j))
+			running++
+		}
+	}
+	return tea.Batch(cmds...)
+}
+
+// cancel отменяет передачу: выполняемая прерывается, ожидающая снимается
+// с очереди.
+func (q *transferQueue) cancel(id int) {
+	job := q.find(id)
+	if job == nil {
+		return
+	}
+	switch job.state {
+	case jobRunning:
+		// состояние сменит transferDoneMsg после остановки передачи
+		job.canceled = true
+		job.cancel()
+	case jobQueued:
+		job.state = jobCanceled
+	}
+}
+
+// retry ставит завершившуюся ошибкой или отменённую передачу в очередь заново.
+func (q *transferQueue) retry(id int) tea.Cmd {
+	job := q.find(id)
+	if job == nil || (job.state != jobFailed && job.state != jobCanceled) {
+		return nil
+	}
+	job.state = jobQueued
+	job.err = nil
+	job.canceled = false
+	job.sent, job.lastTickSent, job.speedBps = 0, 0, 0
+	return q.schedule()
+}
+
+// clearFinished убирает из списка завершённые передачи.
+func (q *transferQueue) clearFinished() {
+	jobs := q.jobs[:0]
+	for _, j := range q.jobs {
+		if j.active() || j.state == jobFailed {
+			jobs = append(jobs, j)
+		}
+	}
+	q.jobs = jobs
+	q.cursor = min(q.cursor, max(len(q.jobs)-1, 0))
+}
+
+// bar возвращает прогресс-бар под ширину терминала.
+func (q *transferQueue) bar(termWidth int) progress.Model {
+	if q.progress == nil {
+		bar := progress.New(
+			progress.WithScaledGradient("#22c55e", "#60a5fa"), // зелёный → голубой
+		)
+		// Настраиваем символы «заполнено/пусто»
+		bar.Full = '█'
+		bar.Empty = '░'
+		q.progress = &bar
+	}
+	q.progress.Width = max(30, termWidth-20)
+	return *q.progress
+}
+
+// startJob запускает передачу в фоне и возвращает команды ожидания её
+// прогресса и завершения.
+func startJob(job *transferJob) tea.Cmd {
+	var ctx context.Context
+	ctx, job.cancel = context.WithCancel(context.Background())
+	job.progCh = make(chan int64, 32)
+	job.state = jobRunning
+	job.lastTickAt = time.Now()
+	done := make(chan error, 1)
+
+	go func(j *transferJob, progCh chan int64) {
+		var err error
+		switch {
+		case j.mode == modeUpload:
+			err = j.svc.UploadBinaryData(ctx, j.data, j.path, progCh)
+		case j.versionID != "":
+			err = j.versions.DownloadBinaryVersion(ctx, j.dataID, j.versionID, j.path, progCh)
+		default:
+			err = j.svc.DownloadBinaryData(ctx, j.dataID, j.path, progCh)
+		}
+		close(progCh)
+		done <- err
+	}(job, job.progCh)
+
+	return tea.Batch(listenTransferProgressThrottle(job.id, job.progCh, progressUIRate), waitTransferDone(job.id, done))
+}
+
+// updateTransfers обрабатывает сообщения передач на любом экране.
+// Возвращает false, если сообщение к передачам не относится.
+func updateTransfers(m Model, msg tea.Msg) (Model, tea.Cmd, bool) {
+	switch msg := msg.(type) {
+	case transferProgressMsg:
+		job := m.transfers.find(msg.id)
+		// значения прежнего запуска (до повтора) отбрасываем
+		if job == nil || job.state != jobRunning || job.progCh != msg.ch {
+			return m, nil, true
+		}
+		job.progress(msg.sent, time.Now())
+		return m, listenTransferProgressThrottle(job.id, job.progCh, progressUIRate), true
+
+	case transferDoneMsg:
+		job := m.transfers.find(msg.id)
+		if job == nil {
+			return m, nil, true
+		}
+		switch {
+		case msg.err == nil:
+			job.state = jobDone
+			job.sent = max(job.sent, job.total)
+		case job.canceled:
+			job.state = jobCanceled
+		default:
+			job.state = jobFailed
+			job.err = msg.err
+		}
+		job.cancel()
+		return m, m.transfers.schedule(), true
+	}
+	return m, nil, false
+}
+
+// initTransfersForm открывает экран «Передачи».
+func initTransfersForm(m Model) Model {
+	m.currentState = "transfers"
+	m.transfers.cursor = min(m.transfers.cursor, max(len(m.transfers.jobs)-1, 0))
+	return m
+}
+
+// updateTransfersScreen обрабатывает клавиши на экране «Передачи».
+func updateTransfersScreen(m Model, msg tea.Msg) (Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	q := &m.transfers
+	var selected int
+	if q.cursor < len(q.jobs) {
+		selected = q.jobs[q.cursor].id
+	}
+	switch key.String() {
+	case "up":
+		if q.cursor > 0 {
+			q.cursor--
+		}
+	case "down":
+		if q.cursor < len(q.jobs)-1 {
+			q.cursor++
+		}
+	case "x":
+		q.cancel(selected)
+	case "r":
+		return m, q.retry(selected)
+	case "c":
+		q.clearFinished()
+	case "esc":
+		m.currentState = "menu"
+	case "ctrl+c":
+		return m, tea.Quit
+	}
+	return m, nil
+}
+
+// renderTransfers отображает очередь передач с прогрессом и скоростью каждой.
+func renderTransfers(m Model) string {
+	q := m.transfers
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Передачи файлов") + "\n\n")
+
+	running, queued := 0, 0
+	for _, j := range q.jobs {
+		switch j.state {
+		case jobRunning:
+			running++
+		case jobQueued:
+			queued++
+		}
+	}
+	b.WriteString(fmt.Sprintf(" Выполняется: %d из %d • В очереди: %d\n\n", running, q.capacity(), queued))
+
+	if len(q.jobs) == 0 {
+		b.WriteString(hintStyle.Render("Передач нет") + "\n")
+	}
+	bar := q.bar(m.termWidth)
+	for i, j := range q.jobs {
+		cursor := "  "
+		title := j.title()
+		if i == q.cursor {
+			cursor = "> "
+			title = selectedStyle.Render(title)
+		}
+		b.WriteString(cursor + title + "\n")
+		switch j.state {
+		case jobQueued:
+			b.WriteString("    " + hintStyle.Render("в очереди") + "\n")
+		case jobRunning:
+			b.WriteString(indentLines(renderJobProgress(j, bar), "    ") + "\n")
+		case jobDone:
+			b.WriteString("    " + transferDoneText(j) + "\n")
+		case jobCanceled:
+			b.WriteString("    " + hintStyle.Render("отменено") + "\n")
+		case jobFailed:
+			b.WriteString("    " + errorStyle.Render("Ошибка: "+j.err.Error()) + "\n")
+		}
+	}
+
+	b.WriteString("\n" + hintStyle.Render("↑/↓: выбор • x: отменить • r: повторить • c: убрать завершённые • Esc: назад"))
+	return b.String()
+}
+
+// renderJobProgress — прогресс-бар, объём, скорость и ETA передачи.
+func renderJobProgress(j *transferJob, bar progress.Model) string {
+	var b strings.Builder
+	if j.total > 0 {
+		r := min(max(float64(j.sent)/float64(j.total), 0), 1)
+		b.WriteString(bar.ViewAs(r) + "\n")
+		b.WriteString(fmt.Sprintf("  %s / %s (%.1f%%)",
+			humanBytes(j.sent), humanBytes(j.total), r*100))
+	} else {
+		b.WriteString(bar.ViewAs(0) + "\n")
+		b.WriteString(fmt.Sprintf("  Передано: %s (размер неизвестен)", humanBytes(j.sent)))
+	}
+
+	// скорость/ETA
+	if j.speedBps > 0 && j.total > 0 && j.sent <= j.total {
+		remain := float64(j.total - j.sent)
+		eta := time.Duration(remain/j.speedBps) * time.Second
+		b.WriteString(fmt.Sprintf(" • ~%s/s • ETA %s", humanBytes(int64(j.speedBps)), eta.Truncate(time.Second)))
+	} else if j.speedBps > 0 {
+		b.WriteString(fmt.Sprintf(" • ~%s/s", humanBytes(int64(j.speedBps))))
+	}
+	return b.String()
+}
+
+// transferDoneText — итог завершённой передачи.
+func transferDoneText(j *transferJob) string {
+	if j.mode == modeUpload {
+		return fmt.Sprintf("Загружено: %s", humanBytes(j.sent))
+	}
+	return fmt.Sprintf("Скачано: %s → %s", humanBytes(j.sent), j.path)
+}
+
+// indentLines добавляет отступ prefix к каждой строке s.
+func indentLines(s, prefix string) string {
+	return prefix + strings.ReplaceAll(s, "\n", "\n"+prefix)
+}
+
+func waitTransferDone(id int, done <-chan error) tea.Cmd {
+	return func() tea.Msg {
+		return transferDoneMsg{id: id, err: <-done}
+	}
+}
+
+func listenTransferProgressThrottle(id int, ch <-chan int64, maxRate time.Duration) tea.Cmd {
+	return func() tea.Msg {
+		// первое значение (или сразу выходим, если канал закрыт)
+		v, ok := <-ch
+		if !ok {
+			return nil
+		}
+		last := v
+
+		timer := time.NewTimer(maxRate)
+		defer timer.Stop()
+
+		for {
+			select {
+			case x, ok := <-ch:
+				if !ok {
+					// канал закрыт: отдадим последнее накопленное значение
+					return transferProgressMsg{id: id, ch: ch, sent: last}
+				}
+				last = x // коалесцируем
+				// остаёмся в этом select до таймаута
+			case <-timer.C:
+				// окно прошло — отдадим одно сообщение в Update
+				return transferProgressMsg{id: id, ch: ch, sent: last}
+			}
+		}
+	}
+}
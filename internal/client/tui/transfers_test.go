@@ -0,0 +1,251 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingTransferService — мок передачи, которая выполняется, пока её не
+// отпустят через release или не отменят.
+type blockingTransferService struct {
+	mockBinaryTransferService
+	started chan string
+
+	mu       sync.Mutex
+	releases map[string]chan error
+}
+
+func newBlockingTransferService() *blockingTransferService {
+	return &blockingTransferService{started: make(chan string, 8), releases: map[string]chan error{}}
+}
+
+// release возвращает канал завершения передачи path.
+func (b *blockingTransferService) release(path string) chan error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.releases[path] == nil {
+		b.releases[path] = make(chan error, 1)
+	}
+	return b.releases[path]
+}
+
+func (b *blockingTransferService) UploadBinaryData(ctx context.Context, data *model.BinaryData, filePath string, progress chan<- int64) error {
+	return b.run(ctx, filePath, progress)
+}
+
+func (b *blockingTransferService) DownloadBinaryData(ctx context.Context, dataID, destPath string, progress chan<- int64) error {
+	return b.run(ctx, destPath, progress)
+}
+
+func (b *blockingTransferService) run(ctx context.Context, path string, progress chan<- int64) error {
+	b.started <- path
+	progress <- 5
+	select {
+	case err := <-b.release(path):
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// collectMsgs выполняет команду, раскрывая пакеты, и возвращает сообщения.
+func collectMsgs(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	batch, ok := msg.(tea.BatchMsg)
+	if !ok {
+		return []tea.Msg{msg}
+	}
+	var msgs []tea.Msg
+	for _, c := range batch {
+		msgs = append(msgs, collectMsgs(c)...)
+	}
+	return msgs
+}
+
+// applyMsgs передаёт сообщения в Update модели.
+func applyMsgs(t *testing.T, m Model, msgs []tea.Msg) Model {
+	t.Helper()
+	for _, msg := range msgs {
+		if msg == nil {
+			continue
+		}
+		updated, _ := m.Update(msg)
+		m = updated.(Model)
+	}
+	return m
+}
+
+func newUploadJob(svc *blockingTransferService, path string) *transferJob {
+	return &transferJob{svc: svc, mode: modeUpload, data: &model.BinaryData{Title: path}, path: path, total: 10}
+}
+
+func TestTransferQueue_Limit(t *testing.T) {
+	svc := newBlockingTransferService()
+	q := transferQueue{limit: 2}
+
+	var cmds []tea.Cmd
+	for _, p := range []string{"a", "b", "c"} {
+		cmds = append(cmds, q.add(newUploadJob(svc, p)))
+	}
+	assert.Equal(t, jobRunning, q.jobs[0].state)
+	assert.Equal(t, jobRunning, q.jobs[1].state)
+	assert.Equal(t, jobQueued, q.jobs[2].state)
+	assert.Nil(t, cmds[2])
+
+	assert.ElementsMatch(t, []string{"a", "b"}, []string{<-svc.started, <-svc.started})
+
+	// Завершение первой передачи запускает ожидающую
+	m := Model{currentState: "list", transfers: q}
+	svc.release("a") <- nil
+	var done tea.Msg
+	for _, msg := range collectMsgs(cmds[0]) {
+		if _, ok := msg.(transferDoneMsg); ok {
+			done = msg
+		}
+	}
+	require.NotNil(t, done)
+	m, next, ok := updateTransfers(m, done)
+	require.True(t, ok)
+	require.NotNil(t, next)
+	assert.Equal(t, jobDone, m.transfers.jobs[0].state)
+	assert.Equal(t, int64(10), m.transfers.jobs[0].sent)
+	assert.Equal(t, jobRunning, m.transfers.jobs[2].state)
+	assert.Equal(t, "c", <-svc.started)
+	assert.Equal(t, "list", m.currentState)
+
+	m.transfers.cancel(2)
+	m.transfers.cancel(3)
+}
+
+func TestTransferQueue_DefaultLimit(t *testing.T) {
+	q := transferQueue{}
+	assert.Equal(t, defaultTransferLimit, q.capacity())
+	q.limit = 5
+	assert.Equal(t, 5, q.capacity())
+}
+
+func TestTransferQueue_CancelAndRetry(t *testing.T) {
+	svc := newBlockingTransferService()
+	m := Model{currentState: "menu", transfers: transferQueue{limit: 1}}
+	cmd := m.transfers.add(newUploadJob(svc, "a"))
+	m.transfers.add(newUploadJob(svc, "b"))
+	<-svc.started
+
+	// Ожидающая передача снимается с очереди сразу
+	m.transfers.cancel(2)
+	assert.Equal(t, jobCanceled, m.transfers.jobs[1].state)
+
+	// Выполняемая прерывается и завершается отменой, а не ошибкой
+	m.transfers.cancel(1)
+	m = applyMsgs(t, m, collectMsgs(cmd))
+	job := m.transfers.find(1)
+	assert.Equal(t, jobCanceled, job.state)
+	assert.NoError(t, job.err)
+
+	// Повтор запускает передачу заново
+	cmd = m.transfers.retry(1)
+	require.NotNil(t, cmd)
+	assert.Equal(t, jobRunning, job.state)
+	assert.Equal(t, int64(0), job.sent)
+	<-svc.started
+
+	svc.release("a") <- errors.New("boom")
+	m = applyMsgs(t, m, collectMsgs(cmd))
+	assert.Equal(t, jobFailed, job.state)
+	assert.EqualError(t, job.err, "boom")
+
+	// Повтор доступен только для неудачных и отменённых передач
+	job.state = jobDone
+	assert.Nil(t, m.transfers.retry(1))
+}
+
+func TestUpdateTransfers_ProgressOnAnyScreen(t *testing.T) {
+	ch := make(chan int64)
+	job := &transferJob{id: 1, state: jobRunning, total: 100, progCh: ch, lastTickAt: time.Now().Add(-time.Second)}
+	m := Model{currentState: "favorites", transfers: transferQueue{jobs: []*transferJob{job}}}
+
+	updated, cmd := m.Update(transferProgressMsg{id: 1, ch: ch, sent: 150})
+	require.NotNil(t, cmd)
+	assert.Equal(t, "favorites", updated.(Model).currentState)
+	assert.Equal(t, int64(100), job.sent)
+	assert.Greater(t, job.speedBps, 0.0)
+
+	// Значения прежнего запуска и неизвестных передач игнорируются
+	_, cmd, ok := updateTransfers(m, transferProgressMsg{id: 1, ch: make(chan int64), sent: 1})
+	assert.True(t, ok)
+	assert.Nil(t, cmd)
+	_, cmd, ok = updateTransfers(m, transferDoneMsg{id: 42})
+	assert.True(t, ok)
+	assert.Nil(t, cmd)
+
+	_, _, ok = updateTransfers(m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.False(t, ok)
+}
+
+func TestTransferJob_Progress(t *testing.T) {
+	start := time.Now()
+	job := &transferJob{total: 1000, lastTickAt: start}
+	job.progress(100, start.Add(time.Second))
+	assert.InDelta(t, 100.0, job.speedBps, 0.001)
+
+	// Скорость сглаживается с весом последнего замера
+	job.progress(400, start.Add(2*time.Second))
+	assert.InDelta(t, 0.3*300+0.7*100, job.speedBps, 0.001)
+	assert.Equal(t, int64(400), job.sent)
+}
+
+func TestUpdateTransfersScreen(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	running := &transferJob{id: 1, mode: modeUpload, path: "/tmp/a", state: jobRunning, total: 10, sent: 5, cancel: cancel}
+	done := &transferJob{id: 2, mode: modeDownload, path: "/tmp/b", state: jobDone, sent: 3}
+	failed := &transferJob{id: 3, mode: modeUpload, path: "/tmp/c", state: jobFailed, err: errors.New("boom")}
+	m := initTransfersForm(Model{currentState: "menu", transfers: transferQueue{jobs: []*transferJob{running, done, failed}}})
+	assert.Equal(t, "transfers", m.currentState)
+
+	out := m.View()
+	assert.Contains(t, out, "Выполняется: 1 из 3")
+	assert.Contains(t, out, "↑ /tmp/a")
+	assert.Contains(t, out, "5 B / 10 B (50.0%)")
+	assert.Contains(t, out, "Скачано: 3 B → /tmp/b")
+	assert.Contains(t, out, "Ошибка: boom")
+
+	// x отменяет выбранную передачу
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	m = updated.(Model)
+	assert.Error(t, ctx.Err())
+
+	// c убирает завершённые, но оставляет неудачные и активные
+	m, _ = updateTransfersScreen(m, tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = updateTransfersScreen(m, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 2, m.transfers.cursor)
+	m, _ = updateTransfersScreen(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'c'}})
+	assert.Equal(t, []*transferJob{running, failed}, m.transfers.jobs)
+	assert.Equal(t, 1, m.transfers.cursor)
+
+	m, _ = updateTransfersScreen(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, "menu", m.currentState)
+}
+
+func TestMenu_Transfers(t *testing.T) {
+	m := NewModel(context.Background(), ModelServices{TransferLimit: 2})
+	assert.Equal(t, 2, m.transfers.capacity())
+	for i, item := range m.menuItems {
+		if item.title == "Transfers" {
+			m.menuCursor = i
+		}
+	}
+	updated, _ := updateMenu(*m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "transfers", updated.currentState)
+	assert.Contains(t, renderTransfers(updated), "Передач нет")
+}
@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/progress"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// usageLoadedMsg — сведения о занятом месте получены с сервера.
+type usageLoadedMsg struct{ usage *model.Usage }
+
+// initUsageForm инициализирует экран занятого на сервере места.
+func initUsageForm(m Model) Model {
+	m.currentState = "usage"
+	m.usage = nil
+	m.usageErr = nil
+	return m
+}
+
+// loadUsage возвращает команду для загрузки занятого пользователем места.
+func (m *Model) loadUsage() tea.Cmd {
+	return func() tea.Msg {
+		if m.itemService == nil {
+			return errMsg{errors.New("usage service is not configured")}
+		}
+		usage, err := m.itemService.GetUsage(m.ctx)
+		if err != nil {
+			return errMsg{err}
+		}
+		return usageLoadedMsg{usage: usage}
+	}
+}
+
+// updateUsage обрабатывает сообщения на экране занятого места.
+func updateUsage(m Model, msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case usageLoadedMsg:
+		m.usage = msg.usage
+		m.usageErr = nil
+		return m, nil
+
+	case errMsg:
+		m.usageErr = msg.err
+		return m, nil
+
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "r":
+			m.usageErr = nil
+			return m, m.loadUsage()
+		case "enter", "esc":
+			m.currentState = "menu"
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+// renderUsage отображает занятое место и ограничения сервера.
+func renderUsage(m Model) string {
+	var b strings.Builder
+
+	title := titleStyle.
+		Width(50).
+		Align(lipgloss.Center).
+		Render("Занятое место")
+	b.WriteString(title + "\n\n")
+
+	switch {
+	case m.usageErr != nil:
+		b.WriteString(errorStyle.Render("Ошибка: "+m.usageErr.Error()) + "\n")
+	case m.usage == nil:
+		b.WriteString(hintStyle.Render("Загрузка...") + "\n")
+	default:
+		u := m.usage
+		b.WriteString(usageLine("Файлы", humanBytes(u.Bytes), humanBytes(u.Limits.MaxBytes), u.Bytes, u.Limits.MaxBytes))
+		b.WriteString(usageLine("Записи", fmt.Sprint(u.Items), fmt.Sprint(u.Limits.MaxItems), u.Items, u.Limits.MaxItems))
+	}
+
+	b.WriteString("\n" + hintStyle.Render("R: обновить • Enter/Esc: назад"))
+	return b.String()
+}
+
+// usageLine описывает использование одного ресурса; при заданном
+// ограничении limit добавляет шкалу заполнения.
+func usageLine(label, used, total string, n, limit int64) string {
+	if limit <= 0 {
+		return fmt.Sprintf("%s: %s (без ограничения)\n\n", label, used)
+	}
+	bar := progress.New(progress.WithWidth(40), progress.WithSolidFill("#60a5fa"))
+	ratio := min(float64(n)/float64(limit), 1)
+	return fmt.Sprintf("%s: %s из %s\n%s\n\n", label, used, total, bar.ViewAs(ratio))
+}
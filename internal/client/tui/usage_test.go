@@ -0,0 +1,52 @@
+package tui
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateMenu_Usage(t *testing.T) {
+	itemSvc := &fakeItemService{
+		usage: &model.Usage{Bytes: 5 * 1024 * 1024, Items: 12, Limits: model.Quota{MaxBytes: 10 * 1024 * 1024}},
+	}
+	m := NewModel(context.Background(), ModelServices{Item: itemSvc})
+	for i, item := range m.menuItems {
+		if item.title == "Usage" {
+			m.menuCursor = i
+		}
+	}
+
+	m2, cmd := updateMenu(*m, tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Equal(t, "usage", m2.currentState)
+	require.NotNil(t, cmd)
+
+	msg := cmd()
+	loaded, ok := msg.(usageLoadedMsg)
+	require.True(t, ok, "expected usageLoadedMsg, got %T", msg)
+
+	m3, _ := updateUsage(m2, loaded)
+	view := renderUsage(m3)
+	assert.Contains(t, view, "Файлы: 5.0 MiB из 10.0 MiB")
+	assert.Contains(t, view, "Записи: 12 (без ограничения)")
+
+	m4, _ := updateUsage(m3, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, "menu", m4.currentState)
+}
+
+func TestUpdateUsage_Error(t *testing.T) {
+	m := initUsageForm(Model{ctx: context.Background(), itemService: &fakeItemService{usageErr: errors.New("boom")}})
+
+	msg := m.loadUsage()()
+	m, _ = updateUsage(m, msg)
+	assert.Contains(t, renderUsage(m), "Ошибка: boom")
+
+	// Повторная загрузка по R
+	_, cmd := updateUsage(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}})
+	assert.NotNil(t, cmd)
+}
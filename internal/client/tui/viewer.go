@@ -0,0 +1,168 @@
+package tui
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// previewLimit — наибольший размер содержимого, которое читается в память
+// для просмотра.
+const previewLimit = 1 << 20
+
+// viewerLoadedMsg — содержимое файла для просмотра прочитано.
+type viewerLoadedMsg struct {
+	content []byte
+	err     error
+}
+
+// viewerVM — просмотр содержимого файла только для чтения. Содержимое
+// расшифровывается в память и на диск не попадает: текст показывается
+// постранично, остальное — шестнадцатеричным дампом.
+type viewerVM struct {
+	title   string
+	loading bool
+	binary  bool     // содержимое не текст — показывается hex-дамп
+	size    int      // размер содержимого
+	lines   []string // строки текста или дампа
+	offset  int      // первая видимая строка
+	err     error
+}
+
+// initViewer открывает просмотр файла data и возвращает команду чтения
+// его содержимого.
+func initViewer(m Model, data *model.BinaryData) (Model, tea.Cmd) {
+	svc, ok := m.services[contracts.TypeFiles].(contracts.BinaryPreviewCapable)
+	if !ok {
+		m.editErr = fmt.Errorf("просмотр недоступен")
+		return m, nil
+	}
+	m.prevState = m.currentState
+	m.currentState = "file_view"
+	m.viewer = viewerVM{title: data.Title, loading: true}
+	return m, readViewerContent(svc, data.ID)
+}
+
+// readViewerContent возвращает команду чтения содержимого файла id в память.
+func readViewerContent(svc contracts.BinaryPreviewCapable, id string) tea.Cmd {
+	return func() tea.Msg {
+		content, err := svc.ReadBinaryData(context.Background(), id, previewLimit)
+		return viewerLoadedMsg{content: content, err: err}
+	}
+}
+
+// updateViewer обрабатывает сообщения экрана просмотра.
+func updateViewer(m Model, msg tea.Msg) (Model, tea.Cmd) {
+	v := &m.viewer
+	switch msg := msg.(type) {
+	case viewerLoadedMsg:
+		v.loading = false
+		if msg.err != nil {
+			v.err = msg.err
+			if errors.Is(msg.err, model.ErrPreviewTooLarge) {
+				v.err = fmt.Errorf("файл больше %s — скачайте его", humanBytes(previewLimit))
+			}
+			return m, nil
+		}
+		v.size = len(msg.content)
+		v.binary = !isText(msg.content)
+		v.lines = viewerLines(msg.content, v.binary)
+		v.offset = 0
+
+	case tea.KeyMsg:
+		page := m.viewerPage()
+		last := max(len(v.lines)-page, 0)
+		switch msg.String() {
+		case "up", "k":
+			v.offset--
+		case "down", "j":
+			v.offset++
+		case "pgup", "b":
+			v.offset -= page
+		case "pgdown", " ", "f":
+			v.offset += page
+		case "home", "g":
+			v.offset = 0
+		case "end", "G":
+			v.offset = last
+		case "esc":
+			m.currentState = m.prevState
+			m.viewer = viewerVM{}
+			return m, nil
+		case "ctrl+c":
+			return m, tea.Quit
+		}
+		v.offset = min(max(v.offset, 0), last)
+	}
+	return m, nil
+}
+
+// viewerPage возвращает число строк содержимого на экране.
+func (m Model) viewerPage() int {
+	if m.termHeight == 0 {
+		return 20
+	}
+	return max(m.termHeight-6, 5)
+}
+
+// renderViewer отображает видимую страницу содержимого.
+func renderViewer(m Model) string {
+	v := m.viewer
+	var b strings.Builder
+	b.WriteString(titleStyle.Render("Просмотр: "+v.title) + "\n")
+
+	switch {
+	case v.loading:
+		b.WriteString("\nЗагрузка...\n")
+	case v.err != nil:
+		b.WriteString("\n" + errorStyle.Render("Ошибка: "+v.err.Error()) + "\n")
+	default:
+		kind := "текст"
+		if v.binary {
+			kind = "hex"
+		}
+		end := min(v.offset+m.viewerPage(), len(v.lines))
+		b.WriteString(hintStyle.Render(fmt.Sprintf("%s • %s • строки %d–%d из %d",
+			kind, humanBytes(int64(v.size)), min(v.offset+1, end), end, len(v.lines))) + "\n\n")
+		for _, line := range v.lines[v.offset:end] {
+			b.WriteString(line + "\n")
+		}
+	}
+
+	b.WriteString("\n" + hintStyle.Render("↑/↓: строка • PgUp/PgDn: страница • Home/End: начало/конец • Esc: назад"))
+	return b.String()
+}
+
+// isText сообщает, что содержимое похоже на текст: корректный UTF-8 без
+// нулевых байтов и с небольшой долей управляющих символов.
+func isText(content []byte) bool {
+	if bytes.IndexByte(content, 0) >= 0 || !utf8.Valid(content) {
+		return false
+	}
+	control := 0
+	for _, c := range content {
+		if c < 0x20 && c != '\n' && c != '\r' && c != '\t' && c != '\f' {
+			control++
+		}
+	}
+	return control*100 <= len(content)
+}
+
+// viewerLines разбивает содержимое на строки для показа: текст — по
+// переводам строк, остальное — строками шестнадцатеричного дампа.
+func viewerLines(content []byte, binary bool) []string {
+	if binary {
+		return strings.Split(strings.TrimSuffix(hex.Dump(content), "\n"), "\n")
+	}
+	text := strings.ReplaceAll(string(content), "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\t", "    ")
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
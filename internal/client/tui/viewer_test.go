@@ -0,0 +1,118 @@
+package tui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/ryabkov82/gophkeeper/internal/client/tui/contracts"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// previewService — мок сервиса файлов с чтением содержимого в память.
+type previewService struct {
+	mockBinaryTransferService
+	content []byte
+	err     error
+	limit   int64
+}
+
+func (p *previewService) ReadBinaryData(ctx context.Context, dataID string, limit int64) ([]byte, error) {
+	p.limit = limit
+	return p.content, p.err
+}
+
+func viewerModel(svc contracts.DataService) Model {
+	return Model{
+		currentState: "edit",
+		currentType:  contracts.TypeFiles,
+		services:     map[contracts.DataType]contracts.DataService{contracts.TypeFiles: svc},
+		editEntity:   &model.BinaryData{ID: "id1", Title: "codes.txt", ClientPath: "/tmp/codes.txt"},
+	}
+}
+
+func TestViewer_Text(t *testing.T) {
+	var text strings.Builder
+	for i := 1; i <= 30; i++ {
+		fmt.Fprintf(&text, "code-%02d\r\n", i)
+	}
+	svc := &previewService{content: []byte(text.String())}
+	m := viewerModel(svc)
+
+	m, cmd := updateEdit(m, tea.KeyMsg{Type: tea.KeyCtrlP})
+	require.NotNil(t, cmd)
+	assert.Equal(t, "file_view", m.currentState)
+	assert.Contains(t, renderViewer(m), "Загрузка")
+
+	updated, _ := m.Update(cmd())
+	m = updated.(Model)
+	assert.EqualValues(t, previewLimit, svc.limit)
+	assert.False(t, m.viewer.binary)
+	require.Len(t, m.viewer.lines, 30)
+
+	out := renderViewer(m)
+	assert.Contains(t, out, "текст")
+	assert.Contains(t, out, "строки 1–20 из 30")
+	assert.Contains(t, out, "code-20")
+	assert.NotContains(t, out, "code-21")
+
+	// PgDn листает до конца, но не дальше
+	m, _ = updateViewer(m, tea.KeyMsg{Type: tea.KeyPgDown})
+	assert.Equal(t, 10, m.viewer.offset)
+	m, _ = updateViewer(m, tea.KeyMsg{Type: tea.KeyDown})
+	assert.Equal(t, 10, m.viewer.offset)
+	assert.Contains(t, renderViewer(m), "code-30")
+	m, _ = updateViewer(m, tea.KeyMsg{Type: tea.KeyHome})
+	assert.Equal(t, 0, m.viewer.offset)
+
+	m, _ = updateViewer(m, tea.KeyMsg{Type: tea.KeyEsc})
+	assert.Equal(t, "edit", m.currentState)
+	assert.Nil(t, m.viewer.lines)
+}
+
+func TestViewer_Binary(t *testing.T) {
+	svc := &previewService{content: []byte{0x00, 0x01, 'G', 'K', 0xff}}
+	m := viewerModel(svc)
+	m, cmd := initViewer(m, m.editEntity.(*model.BinaryData))
+	m, _ = updateViewer(m, cmd())
+
+	assert.True(t, m.viewer.binary)
+	out := renderViewer(m)
+	assert.Contains(t, out, "hex")
+	assert.Contains(t, out, "00000000  00 01 47 4b ff")
+	assert.Contains(t, out, "|..GK.|")
+}
+
+func TestViewer_Errors(t *testing.T) {
+	svc := &previewService{err: model.ErrPreviewTooLarge}
+	m := viewerModel(svc)
+	m, cmd := initViewer(m, m.editEntity.(*model.BinaryData))
+	m, _ = updateViewer(m, cmd())
+	assert.Contains(t, renderViewer(m), "файл больше 1.0 MiB — скачайте его")
+
+	// Запись без загруженного содержимого не просматривается
+	m = viewerModel(svc)
+	m.editEntity = &model.BinaryData{ID: "id1"}
+	m, cmd = updateEdit(m, tea.KeyMsg{Type: tea.KeyCtrlP})
+	assert.Nil(t, cmd)
+	assert.Equal(t, "edit", m.currentState)
+	assert.Error(t, m.editErr)
+
+	// Сервис без чтения в память
+	m = viewerModel(&mockBinaryTransferService{})
+	m, cmd = updateEdit(m, tea.KeyMsg{Type: tea.KeyCtrlP})
+	assert.Nil(t, cmd)
+	assert.EqualError(t, m.editErr, "просмотр недоступен")
+}
+
+func TestIsText(t *testing.T) {
+	assert.True(t, isText([]byte("привет\n\tмир\r\n")))
+	assert.True(t, isText(nil))
+	assert.False(t, isText([]byte("a\x00b")))
+	assert.False(t, isText([]byte{0xff, 0xfe}))
+	assert.False(t, isText([]byte("\x01\x02\x03abc")))
+}
@@ -0,0 +1,341 @@
+// Package vaultfile читает и пишет файл экспорта записей пользователя.
+//
+// Файл экспорта нужен для переноса записей между серверами (например, из
+// персонального режима на отдельный сервер). Ключ шифрования данных на
+// разных серверах разный — он выводится из пароля и соли, выданной сервером
+// при регистрации, — поэтому экспорт шифруется собственным ключом, который
+// выводится из парольной фразы экспорта тем же Argon2id, что и ключ данных.
+//
+// Формат файла:
+//
+//	[magic(8)][version(1)][time:u32][memory:u32][threads(1)][salt(16)]
+//	[поток crypto.EncryptStreamCompressed с архивом tar]
+//
+// Первая запись архива — manifest.json (Manifest), за ней идёт содержимое
+// файлов в записях files/<id> в порядке Manifest.Files. Идентификатор файла —
+// UUID в каноническом виде: при импорте он становится именем временного
+// файла, поэтому другие значения отвергаются.
+package vaultfile
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
+	"golang.org/x/crypto/argon2"
+)
+
+// FormatVersion — версия формата файла экспорта.
+const FormatVersion = 1
+
+const (
+	magic        = "GKEXPORT"
+	saltSize     = 16
+	manifestName = "manifest.json"
+	filesDir     = "files"
+
+	// bufferSize — размер буфера между tar и шифрованием: определяет размер
+	// кадров зашифрованного потока.
+	bufferSize = 32 * 1024
+)
+
+// ErrFormat возвращается, если данные не являются файлом экспорта
+// поддерживаемой версии.
+var ErrFormat = errors.New("not a gophkeeper export file")
+
+// Manifest — записи пользователя в файле экспорта. Значения хранятся
+// в открытом виде: файл шифруется целиком.
+type Manifest struct {
+	Version     int          `json:"version"`
+	CreatedAt   time.Time    `json:"created_at"`
+	Credentials []Credential `json:"credentials,omitempty"`
+	BankCards   []BankCard   `json:"bank_cards,omitempty"`
+	TextData    []TextData   `json:"text_data,omitempty"`
+	Files       []File       `json:"files,omitempty"`
+}
+
+// Credential — учётные данные.
+type Credential struct {
+	Title    string   `json:"title"`
+	Login    string   `json:"login"`
+	Password string   `json:"password"`
+	Metadata string   `json:"metadata,omitempty"`
+	Folder   string   `json:"folder,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Favorite bool     `json:"favorite,omitempty"`
+}
+
+// BankCard — банковская карта.
+type BankCard struct {
+	Title          string   `json:"title"`
+	CardholderName string   `json:"cardholder_name"`
+	CardNumber     string   `json:"card_number"`
+	ExpiryDate     string   `json:"expiry_date"`
+	CVV            string   `json:"cvv"`
+	Metadata       string   `json:"metadata,omitempty"`
+	Folder         string   `json:"folder,omitempty"`
+	Tags           []string `json:"tags,omitempty"`
+	Favorite       bool     `json:"favorite,omitempty"`
+}
+
+// TextData — текстовая запись.
+type TextData struct {
+	Title    string   `json:"title"`
+	Content  []byte   `json:"content"`
+	Metadata string   `json:"metadata,omitempty"`
+	Folder   string   `json:"folder,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Favorite bool     `json:"favorite,omitempty"`
+}
+
+// File — файл или папка. Содержимое хранится в архиве под ID; папка
+// хранится архивом tar, как при загрузке на сервер.
+type File struct {
+	ID         string   `json:"id"`
+	Title      string   `json:"title"`
+	ClientPath string   `json:"client_path,omitempty"`
+	Directory  bool     `json:"directory,omitempty"`
+	Metadata   string   `json:"metadata,omitempty"`
+	Folder     string   `json:"folder,omitempty"`
+	Tags       []string `json:"tags,omitempty"`
+	Favorite   bool     `json:"favorite,omitempty"`
+}
+
+// header — заголовок файла после magic: версия формата и параметры Argon2id
+// ключа экспорта. Параметры записываются в файл, чтобы он читался и после
+// смены параметров по умолчанию.
+type header struct {
+	Version uint8
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+	Salt    [saltSize]byte
+}
+
+// deriveKey выводит ключ экспорта из парольной фразы.
+func (p *header) deriveKey(passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("export passphrase cannot be empty")
+	}
+	return argon2.IDKey([]byte(passphrase), p.Salt[:], p.Time, p.Memory, p.Threads, crypto.DefaultParams.KeyLen), nil
+}
+
+// Writer пишет файл экспорта. Сначала вызывается WriteManifest, затем
+// WriteFile для каждого файла из манифеста, в конце — обязательно Close,
+// даже после ошибки: он останавливает фоновое шифрование.
+type Writer struct {
+	pw   *io.PipeWriter
+	buf  *bufio.Writer
+	tw   *tar.Writer
+	done chan error
+
+	manifest bool
+}
+
+// NewWriter пишет в w заголовок файла экспорта и возвращает Writer,
+// шифрующий содержимое ключом из парольной фразы passphrase.
+func NewWriter(w io.Writer, passphrase string) (*Writer, error) {
+	params := header{
+		Version: FormatVersion,
+		Time:    crypto.DefaultParams.Time,
+		Memory:  crypto.DefaultParams.Memory,
+		Threads: crypto.DefaultParams.Threads,
+	}
+	if _, err := rand.Read(params.Salt[:]); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	key, err := params.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := io.WriteString(w, magic); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+	if err := binary.Write(w, binary.BigEndian, &params); err != nil {
+		return nil, fmt.Errorf("failed to write header: %w", err)
+	}
+
+	pr, pw := io.Pipe()
+	vw := &Writer{pw: pw, done: make(chan error, 1)}
+	vw.buf = bufio.NewWriterSize(pw, bufferSize)
+	vw.tw = tar.NewWriter(vw.buf)
+	go func() {
+		err := crypto.EncryptStreamCompressed(pr, w, key)
+		// Ошибка записи останавливает и сторону tar
+		pr.CloseWithError(err)
+		vw.done <- err
+	}()
+	return vw, nil
+}
+
+// WriteManifest записывает манифест. Вызывается один раз, до файлов.
+func (w *Writer) WriteManifest(m *Manifest) error {
+	if w.manifest {
+		return errors.New("manifest already written")
+	}
+	m.Version = FormatVersion
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	if err := w.writeEntry(manifestName, int64(len(data)), bytes.NewReader(data)); err != nil {
+		return err
+	}
+	w.manifest = true
+	return nil
+}
+
+// WriteFile записывает содержимое файла id размером size из r.
+func (w *Writer) WriteFile(id string, size int64, r io.Reader) error {
+	if !w.manifest {
+		return errors.New("manifest must be written before files")
+	}
+	if !validID(id) {
+		return fmt.Errorf("invalid file id %q", id)
+	}
+	return w.writeEntry(path.Join(filesDir, id), size, r)
+}
+
+// writeEntry добавляет в архив запись name с содержимым r размером size.
+func (w *Writer) writeEntry(name string, size int64, r io.Reader) error {
+	hdr := &tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0o600,
+		Format:   tar.FormatPAX,
+	}
+	if err := w.tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	if _, err := io.CopyN(w.tw, r, size); err != nil {
+		return fmt.Errorf("failed to write %s: %w", name, err)
+	}
+	return nil
+}
+
+// Close завершает архив и дожидается записи зашифрованного потока.
+// Файл экспорта полон, только если Close не вернул ошибку.
+func (w *Writer) Close() error {
+	err := w.tw.Close()
+	if err == nil {
+		err = w.buf.Flush()
+	}
+	w.pw.CloseWithError(err)
+	if werr := <-w.done; werr != nil {
+		return fmt.Errorf("failed to write export: %w", werr)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+	return nil
+}
+
+// Reader читает файл экспорта: манифест доступен сразу после NewReader,
+// содержимое файлов читается по порядку через Next.
+type Reader struct {
+	pr       *io.PipeReader
+	tr       *tar.Reader
+	done     chan struct{}
+	manifest *Manifest
+}
+
+// NewReader проверяет заголовок файла экспорта из r, расшифровывает его
+// ключом из парольной фразы passphrase и читает манифест. Неверная
+// парольная фраза обнаруживается здесь же.
+func NewReader(r io.Reader, passphrase string) (*Reader, error) {
+	head := make([]byte, len(magic))
+	if _, err := io.ReadFull(r, head); err != nil || string(head) != magic {
+		return nil, ErrFormat
+	}
+	var params header
+	if err := binary.Read(r, binary.BigEndian, &params); err != nil {
+		return nil, ErrFormat
+	}
+	if params.Version != FormatVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", ErrFormat, params.Version)
+	}
+	key, err := params.deriveKey(passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	vr := &Reader{pr: pr, tr: tar.NewReader(pr), done: make(chan struct{})}
+	go func() {
+		defer close(vr.done)
+		err := crypto.DecryptStream(r, pw, key)
+		pw.CloseWithError(err)
+	}()
+
+	hdr, err := vr.tr.Next()
+	if err != nil {
+		vr.Close()
+		return nil, fmt.Errorf("failed to read export (wrong passphrase?): %w", err)
+	}
+	if hdr.Name != manifestName {
+		vr.Close()
+		return nil, fmt.Errorf("%w: manifest not found", ErrFormat)
+	}
+	var m Manifest
+	if err := json.NewDecoder(vr.tr).Decode(&m); err != nil {
+		vr.Close()
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	for _, f := range m.Files {
+		if !validID(f.ID) {
+			vr.Close()
+			return nil, fmt.Errorf("%w: invalid file id %q", ErrFormat, f.ID)
+		}
+	}
+	vr.manifest = &m
+	return vr, nil
+}
+
+// Manifest возвращает манифест файла экспорта.
+func (r *Reader) Manifest() *Manifest {
+	return r.manifest
+}
+
+// Next переходит к содержимому следующего файла и возвращает его
+// идентификатор и поток чтения, действительный до следующего вызова Next.
+// После последнего файла возвращает io.EOF.
+func (r *Reader) Next() (string, io.Reader, error) {
+	hdr, err := r.tr.Next()
+	if err != nil {
+		if err == io.EOF {
+			return "", nil, io.EOF
+		}
+		return "", nil, fmt.Errorf("failed to read export: %w", err)
+	}
+	dir, id := path.Split(hdr.Name)
+	if dir != filesDir+"/" || !validID(id) {
+		return "", nil, fmt.Errorf("%w: unexpected entry %q", ErrFormat, hdr.Name)
+	}
+	return id, r.tr, nil
+}
+
+// Close прекращает чтение и освобождает ресурсы. Источник r, переданный
+// в NewReader, не закрывается.
+func (r *Reader) Close() error {
+	r.pr.Close()
+	<-r.done
+	return nil
+}
+
+// validID сообщает, что id — UUID в каноническом виде.
+func validID(id string) bool {
+	u, err := uuid.Parse(id)
+	return err == nil && u.String() == id
+}
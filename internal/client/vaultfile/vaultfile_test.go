@@ -0,0 +1,191 @@
+package vaultfile_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/client/crypto"
+	"github.com/ryabkov82/gophkeeper/internal/client/vaultfile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	fileID1 = "3f2b8c1e-4d5a-4e6f-8a9b-0c1d2e3f4a5b"
+	fileID2 = "9a8b7c6d-5e4f-4a3b-9c2d-1e0f9a8b7c6d"
+)
+
+func writeExport(t *testing.T, passphrase string, m *vaultfile.Manifest, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w, err := vaultfile.NewWriter(&buf, passphrase)
+	require.NoError(t, err)
+	require.NoError(t, w.WriteManifest(m))
+	for _, f := range m.Files {
+		content := files[f.ID]
+		require.NoError(t, w.WriteFile(f.ID, int64(len(content)), strings.NewReader(content)))
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func TestRoundTrip(t *testing.T) {
+	manifest := &vaultfile.Manifest{
+		CreatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Credentials: []vaultfile.Credential{
+			{Title: "GitHub", Login: "octo", Password: "p@ss", Tags: []string{"work"}, Favorite: true},
+		},
+		BankCards: []vaultfile.BankCard{{Title: "Visa", CardNumber: "4111111111111111", CVV: "123"}},
+		TextData:  []vaultfile.TextData{{Title: "note", Content: []byte("secret note")}},
+		Files: []vaultfile.File{
+			{ID: fileID1, Title: "photo.jpg"},
+			{ID: fileID2, Title: "docs", Directory: true},
+		},
+	}
+	files := map[string]string{fileID1: "hello", fileID2: strings.Repeat("x", 70000)}
+
+	data := writeExport(t, "correct horse", manifest, files)
+	assert.NotContains(t, string(data), "p@ss", "содержимое экспорта зашифровано")
+	assert.NotContains(t, string(data), "GitHub")
+
+	r, err := vaultfile.NewReader(bytes.NewReader(data), "correct horse")
+	require.NoError(t, err)
+	defer r.Close()
+
+	got := r.Manifest()
+	assert.Equal(t, vaultfile.FormatVersion, got.Version)
+	assert.Equal(t, manifest.CreatedAt, got.CreatedAt)
+	assert.Equal(t, manifest.Credentials, got.Credentials)
+	assert.Equal(t, manifest.BankCards, got.BankCards)
+	assert.Equal(t, manifest.TextData, got.TextData)
+	assert.Equal(t, manifest.Files, got.Files)
+
+	read := map[string]string{}
+	for {
+		id, content, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		b, err := io.ReadAll(content)
+		require.NoError(t, err)
+		read[id] = string(b)
+	}
+	assert.Equal(t, files, read)
+}
+
+func TestNewReader_WrongPassphrase(t *testing.T) {
+	data := writeExport(t, "right", &vaultfile.Manifest{}, nil)
+
+	_, err := vaultfile.NewReader(bytes.NewReader(data), "wrong")
+	assert.Error(t, err)
+
+	_, err = vaultfile.NewReader(bytes.NewReader(data), "")
+	assert.Error(t, err)
+}
+
+func TestNewReader_NotExport(t *testing.T) {
+	_, err := vaultfile.NewReader(strings.NewReader("definitely not an export file"), "x")
+	assert.ErrorIs(t, err, vaultfile.ErrFormat)
+
+	_, err = vaultfile.NewReader(strings.NewReader(""), "x")
+	assert.ErrorIs(t, err, vaultfile.ErrFormat)
+}
+
+func TestNewReader_Truncated(t *testing.T) {
+	// Несжимаемое содержимое, чтобы файл занимал большую часть экспорта
+	content := make([]byte, 100000)
+	_, _ = rand.Read(content)
+	manifest := &vaultfile.Manifest{Files: []vaultfile.File{{ID: fileID1}}}
+	data := writeExport(t, "pass", manifest, map[string]string{fileID1: string(content)})
+
+	r, err := vaultfile.NewReader(bytes.NewReader(data[:len(data)/2]), "pass")
+	require.NoError(t, err)
+	defer r.Close()
+
+	_, rc, err := r.Next()
+	require.NoError(t, err)
+	_, err = io.ReadAll(rc)
+	assert.Error(t, err, "обрезанный файл не читается целиком")
+}
+
+func TestWriter_Order(t *testing.T) {
+	w, err := vaultfile.NewWriter(io.Discard, "pass")
+	require.NoError(t, err)
+	assert.Error(t, w.WriteFile(fileID1, 0, strings.NewReader("")), "файлы пишутся после манифеста")
+	require.NoError(t, w.WriteManifest(&vaultfile.Manifest{}))
+	assert.Error(t, w.WriteManifest(&vaultfile.Manifest{}))
+	assert.Error(t, w.WriteFile("../"+fileID1, 0, strings.NewReader("")))
+	assert.Error(t, w.WriteFile("..", 0, strings.NewReader("")))
+	assert.Error(t, w.WriteFile("f1", 0, strings.NewReader("")))
+	require.NoError(t, w.Close())
+
+	_, err = vaultfile.NewWriter(io.Discard, "")
+	assert.Error(t, err)
+}
+
+// craftExport собирает файл экспорта в обход Writer: с произвольным
+// манифестом и именами записей архива.
+func craftExport(t *testing.T, passphrase, manifest string, entries map[string]string) []byte {
+	t.Helper()
+	var plain bytes.Buffer
+	tw := tar.NewWriter(&plain)
+	add := func(name, content string) {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Typeflag: tar.TypeReg, Name: name, Size: int64(len(content)), Mode: 0o600}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	add("manifest.json", manifest)
+	for name, content := range entries {
+		add(name, content)
+	}
+	require.NoError(t, tw.Close())
+
+	// Заголовок: версия и минимальные параметры Argon2id, чтобы тест был быстрым
+	header := struct {
+		Version uint8
+		Time    uint32
+		Memory  uint32
+		Threads uint8
+		Salt    [16]byte
+	}{Version: vaultfile.FormatVersion, Time: 1, Memory: 8, Threads: 1}
+	key := argon2.IDKey([]byte(passphrase), header.Salt[:], header.Time, header.Memory, header.Threads, crypto.DefaultParams.KeyLen)
+
+	var out bytes.Buffer
+	out.WriteString("GKEXPORT")
+	require.NoError(t, binary.Write(&out, binary.BigEndian, &header))
+	require.NoError(t, crypto.EncryptStreamCompressed(&plain, &out, key))
+	return out.Bytes()
+}
+
+func TestNewReader_InvalidFileID(t *testing.T) {
+	for _, id := range []string{"..", ".", "", "../../etc", "f1", "{" + fileID1 + "}", strings.ToUpper(fileID1)} {
+		manifest := fmt.Sprintf(`{"version":1,"files":[{"id":%q,"title":"x","directory":true}]}`, id)
+		data := craftExport(t, "pass", manifest, nil)
+
+		_, err := vaultfile.NewReader(bytes.NewReader(data), "pass")
+		assert.ErrorIs(t, err, vaultfile.ErrFormat, "id %q", id)
+	}
+}
+
+func TestReader_Next_InvalidEntry(t *testing.T) {
+	manifest := fmt.Sprintf(`{"version":1,"files":[{"id":%q,"title":"x"}]}`, fileID1)
+	for _, name := range []string{"files/..", "files/.", "files/f1", "files/../" + fileID1, fileID1} {
+		data := craftExport(t, "pass", manifest, map[string]string{name: "content"})
+
+		r, err := vaultfile.NewReader(bytes.NewReader(data), "pass")
+		require.NoError(t, err)
+		_, _, err = r.Next()
+		assert.ErrorIs(t, err, vaultfile.ErrFormat, "entry %q", name)
+		r.Close()
+	}
+}
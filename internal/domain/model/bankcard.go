@@ -15,16 +15,21 @@ var timeNow = time.Now
 // Все чувствительные поля (номер карты, срок действия, CVV, имя владельца)
 // должны храниться в зашифрованном виде (например, base64).
 type BankCard struct {
-	ID             string    `db:"id"`              // Уникальный идентификатор карты (UUID)
-	UserID         string    `db:"user_id"`         // Идентификатор пользователя-владельца карты
-	Title          string    `db:"title"`           // Название или ярлык карты (например, "Рабочая карта")
-	CardholderName string    `db:"cardholder_name"` // Имя держателя карты, как указано на карте
-	CardNumber     string    `db:"card_number"`     // Номер карты (обычно 16 цифр)
-	ExpiryDate     string    `db:"expiry_date"`     // Срок действия карты в формате MM/YY
-	CVV            string    `db:"cvv"`             // Код безопасности карты (3 или 4 цифры)
-	Metadata       string    `db:"metadata"`        // Дополнительные данные в формате JSON или свободный текст
-	CreatedAt      time.Time `db:"created_at"`      // Время создания записи
-	UpdatedAt      time.Time `db:"updated_at"`      // Время последнего обновления записи
+	ID             string     `db:"id"`               // Уникальный идентификатор карты (UUID)
+	UserID         string     `db:"user_id"`          // Идентификатор пользователя-владельца карты
+	Title          string     `db:"title"`            // Название или ярлык карты (например, "Рабочая карта")
+	CardholderName string     `db:"cardholder_name"`  // Имя держателя карты, как указано на карте
+	CardNumber     string     `db:"card_number"`      // Номер карты (обычно 16 цифр)
+	ExpiryDate     string     `db:"expiry_date"`      // Срок действия карты в формате MM/YY
+	CVV            string     `db:"cvv"`              // Код безопасности карты (3 или 4 цифры)
+	Metadata       string     `db:"metadata"`         // Дополнительные данные в формате JSON или свободный текст
+	Folder         string     `db:"folder"`           // Папка, в которой находится карта
+	Tags           Tags       `db:"tags"`             // Теги карты (в зашифрованном виде)
+	Favorite       bool       `db:"favorite"`         // Карта отмечена как избранная
+	LastAccessedAt *time.Time `db:"last_accessed_at"` // Время последнего открытия карты (nil — не открывалась)
+	CreatedAt      time.Time  `db:"created_at"`       // Время создания записи
+	UpdatedAt      time.Time  `db:"updated_at"`       // Время последнего обновления записи
+	Version        int64      `db:"version"`          // Версия записи (см. ErrVersionConflict)
 }
 
 // ValidateCardNumber проверяет номер карты
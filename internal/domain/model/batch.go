@@ -0,0 +1,52 @@
+package model
+
+import "errors"
+
+// MaxBatchOps — максимальное количество операций в одном пакетном изменении.
+const MaxBatchOps = 1000
+
+// BatchOpKind — вид операции пакетного изменения записей.
+type BatchOpKind string
+
+const (
+	// BatchCreate — создание записи.
+	BatchCreate BatchOpKind = "create"
+	// BatchUpdate — обновление записи (с проверкой версии, если она указана).
+	BatchUpdate BatchOpKind = "update"
+	// BatchDelete — удаление записи.
+	BatchDelete BatchOpKind = "delete"
+)
+
+var (
+	// ErrItemNotFound возвращается операцией пакета, если запись не найдена
+	// или принадлежит другому пользователю.
+	ErrItemNotFound = errors.New("item not found")
+
+	// ErrBatchAborted — результат операций атомарного пакета, не применённых
+	// из-за ошибки другой операции.
+	ErrBatchAborted = errors.New("batch aborted: another operation failed")
+)
+
+// BatchOp — одна операция пакетного изменения записей.
+//
+// Для создания и обновления заполняется запись, соответствующая Type
+// (идентификатор обновляемой записи берётся из неё); для удаления — ItemID.
+// Пакет поддерживает учётные данные, банковские карты и текстовые заметки:
+// бинарные данные создаются только вместе с содержимым (потоковой загрузкой).
+type BatchOp struct {
+	Kind       BatchOpKind
+	Type       ItemType
+	ItemID     string
+	Credential *Credential
+	BankCard   *BankCard
+	TextData   *TextData
+}
+
+// BatchResult — результат одной операции пакета.
+// Err равна nil, если операция применена; ItemID и Version — идентификатор
+// и новая версия созданной или обновлённой записи.
+type BatchResult struct {
+	ItemID  string
+	Version int64
+	Err     error
+}
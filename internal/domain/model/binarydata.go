@@ -1,22 +1,63 @@
 package model
 
 import (
+	"errors"
 	"time"
 )
 
+// ErrChecksumMismatch возвращается, если контрольная сумма содержимого не
+// совпадает с записанной при загрузке: содержимое повреждено.
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// ErrNoChecksum возвращается при проверке файла, для которого контрольная
+// сумма не записана (загружен до её появления или не имеет содержимого).
+var ErrNoChecksum = errors.New("checksum not recorded")
+
+// ErrPreviewTooLarge возвращается при чтении содержимого в память, если оно
+// больше допустимого для просмотра размера.
+var ErrPreviewTooLarge = errors.New("content too large to preview")
+
+// ErrPreviewArchive возвращается при попытке просмотреть папку, загруженную
+// архивом: просматривать можно только отдельные файлы.
+var ErrPreviewArchive = errors.New("folder archive cannot be previewed")
+
 // BinaryData представляет произвольные бинарные данные пользователя.
 // Содержит путь к зашифрованному файлу в хранилище и дополнительную
 // текстовую метаинформацию (также зашифрованную на клиенте).
+// Если Chunked, содержимое хранится не одним файлом StoragePath, а
+// зашифрованными фрагментами по манифесту (см. Chunk).
+//
+// Checksum — SHA-256 содержимого в том виде, в каком его хранит сервер
+// (зашифрованного); её вычисляет и проверяет сервер. PlainChecksum — SHA-256
+// исходного файла, зашифрованная на клиенте; по ней клиент проверяет файл
+// после скачивания и расшифровки.
+//
+// Archive непуст, если запись содержит папку, загруженную одним архивом tar:
+// это зашифрованный на клиенте список файлов папки.
+//
+// Compress — параметр загрузки на клиенте: сжимать содержимое перед
+// шифрованием. Не хранится: сжатие отмечается в самом зашифрованном
+// содержимом.
 type BinaryData struct {
-	ID          string    `db:"id"`
-	UserID      string    `db:"user_id"`
-	Title       string    `db:"title"`
-	StoragePath string    `db:"storage_path"`
-	ClientPath  string    `db:"client_path"`
-	Size        int64     `db:"size"`
-	Metadata    string    `db:"metadata"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	ID             string     `db:"id"`
+	UserID         string     `db:"user_id"`
+	Title          string     `db:"title"`
+	StoragePath    string     `db:"storage_path"`
+	ClientPath     string     `db:"client_path"`
+	Size           int64      `db:"size"`
+	Chunked        bool       `db:"chunked"`
+	Checksum       string     `db:"checksum"`
+	PlainChecksum  string     `db:"plain_checksum"`
+	Archive        string     `db:"archive"`
+	Metadata       string     `db:"metadata"`
+	Folder         string     `db:"folder"`
+	Tags           Tags       `db:"tags"`
+	Favorite       bool       `db:"favorite"`
+	LastAccessedAt *time.Time `db:"last_accessed_at"`
+	CreatedAt      time.Time  `db:"created_at"`
+	UpdatedAt      time.Time  `db:"updated_at"`
+	Version        int64      `db:"version"`
+	Compress       bool       `db:"-"`
 }
 
 // Реализация интерфейса forms.Identifiable
@@ -13,3 +13,15 @@ func TestBinaryData_GetSetID(t *testing.T) {
 		t.Errorf("GetID returned %s, want 123", data.GetID())
 	}
 }
+
+func TestValidChunkID(t *testing.T) {
+	valid := "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	if !model.ValidChunkID(valid) {
+		t.Errorf("ValidChunkID(%q) = false, want true", valid)
+	}
+	for _, id := range []string{"", "abc", valid[:63] + "G", valid[:63] + "A", valid + "0"} {
+		if model.ValidChunkID(id) {
+			t.Errorf("ValidChunkID(%q) = true, want false", id)
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrBinaryVersionNotFound возвращается при обращении к прежней версии
+// содержимого, которой у записи нет (или она уже удалена).
+var ErrBinaryVersionNotFound = errors.New("binary version not found")
+
+// BinaryVersion — прежняя версия содержимого бинарных данных, сохранённая
+// сервером при замене содержимого записи BinaryID.
+//
+// Версия хранит только содержимое и его атрибуты (поля BinaryData с теми же
+// именами); заголовок, описание, папка и теги у всех версий общие — это
+// поля самой записи. UpdatedAt — время последнего изменения записи до
+// замены, CreatedAt — время замены.
+type BinaryVersion struct {
+	ID            string    `db:"id"`
+	BinaryID      string    `db:"binary_data_id"`
+	UserID        string    `db:"user_id"`
+	StoragePath   string    `db:"storage_path"`
+	Size          int64     `db:"size"`
+	Chunked       bool      `db:"chunked"`
+	Checksum      string    `db:"checksum"`
+	PlainChecksum string    `db:"plain_checksum"`
+	Archive       string    `db:"archive"`
+	ClientPath    string    `db:"client_path"`
+	UpdatedAt     time.Time `db:"updated_at"`
+	CreatedAt     time.Time `db:"created_at"`
+}
+
+// NewBinaryVersion возвращает версию с текущим содержимым записи data.
+func NewBinaryVersion(data *BinaryData) *BinaryVersion {
+	return &BinaryVersion{
+		BinaryID:      data.ID,
+		UserID:        data.UserID,
+		StoragePath:   data.StoragePath,
+		Size:          data.Size,
+		Chunked:       data.Chunked,
+		Checksum:      data.Checksum,
+		PlainChecksum: data.PlainChecksum,
+		Archive:       data.Archive,
+		ClientPath:    data.ClientPath,
+		UpdatedAt:     data.UpdatedAt,
+	}
+}
+
+// ApplyTo заменяет содержимое записи data содержимым версии.
+func (v *BinaryVersion) ApplyTo(data *BinaryData) {
+	data.StoragePath = v.StoragePath
+	data.Size = v.Size
+	data.Chunked = v.Chunked
+	data.Checksum = v.Checksum
+	data.PlainChecksum = v.PlainChecksum
+	data.Archive = v.Archive
+	data.ClientPath = v.ClientPath
+}
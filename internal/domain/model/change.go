@@ -0,0 +1,56 @@
+package model
+
+import "time"
+
+const (
+	// DefaultChangesLimit — количество изменений в одном ответе, если клиент его не указал.
+	DefaultChangesLimit = 200
+	// MaxChangesLimit — максимальное количество изменений в одном ответе.
+	MaxChangesLimit = 1000
+)
+
+// Change — элемент журнала изменений пользователя.
+//
+// Журнал хранит только последнее изменение каждой записи: при каждом создании,
+// обновлении или удалении запись получает следующий номер Seq из монотонно
+// возрастающей последовательности пользователя. Удаление оставляет «надгробие»
+// (Deleted = true), чтобы клиенты, синхронизировавшиеся ранее, узнали о нём.
+//
+// Для созданных и обновлённых записей заполнено ровно одно из полей
+// Credential, BankCard, TextData или BinaryData — в соответствии с Type.
+type Change struct {
+	Seq       int64     `db:"seq"`        // Номер изменения в последовательности пользователя
+	Type      ItemType  `db:"item_type"`  // Тип записи
+	ItemID    string    `db:"item_id"`    // Идентификатор записи (UUID)
+	Deleted   bool      `db:"deleted"`    // Запись удалена (надгробие)
+	ChangedAt time.Time `db:"changed_at"` // Время изменения
+
+	Credential *Credential `db:"-"`
+	BankCard   *BankCard   `db:"-"`
+	TextData   *TextData   `db:"-"`
+	BinaryData *BinaryData `db:"-"`
+}
+
+// ChangeBatch — порция изменений, полученная по курсору.
+//
+// Cursor — номер последнего изменения в порции (или исходный курсор, если
+// изменений нет); его следует передать в следующий запрос. HasMore сообщает,
+// что на сервере остались изменения после Cursor.
+type ChangeBatch struct {
+	Changes []Change
+	Cursor  int64
+	HasMore bool
+}
+
+// ChangeEvent — уведомление об изменении записи пользователя.
+//
+// Уведомление не содержит самой записи и служит лишь сигналом: получив его,
+// клиент запрашивает изменения через ListChanges или перечитывает список.
+// Уведомления не гарантируют доставку, поэтому журнал остаётся источником истины.
+type ChangeEvent struct {
+	UserID  string   `json:"user_id"` // Владелец записи
+	Seq     int64    `json:"seq"`     // Номер изменения в последовательности пользователя
+	Type    ItemType `json:"type"`    // Тип записи
+	ItemID  string   `json:"id"`      // Идентификатор записи (UUID)
+	Deleted bool     `json:"deleted"` // Запись удалена
+}
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ChunkIDLen — длина идентификатора фрагмента: HMAC-SHA256 открытого текста
+// фрагмента в шестнадцатеричной записи.
+const ChunkIDLen = 64
+
+// MaxChunkSize — предельный размер зашифрованного фрагмента, принимаемого сервером.
+const MaxChunkSize = 3 << 20
+
+// ErrMissingChunks возвращается при сохранении манифеста, ссылающегося на
+// фрагменты, которых нет в хранилище: клиент должен загрузить их и повторить.
+var ErrMissingChunks = errors.New("missing chunks")
+
+// ErrChunkedFile возвращается при попытке прочитать одним потоком файл,
+// содержимое которого хранится фрагментами.
+var ErrChunkedFile = errors.New("file is stored in chunks")
+
+// Chunk — зашифрованный фрагмент файла в хранилище.
+//
+// Файлы делятся на фрагменты на клиенте по содержимому, поэтому при изменении
+// части большого файла большинство фрагментов совпадает с уже загруженными.
+// Фрагмент хранится один раз для пользователя и удаляется, когда на него не
+// ссылается ни один манифест (RefCount).
+type Chunk struct {
+	UserID      string    `db:"user_id"`
+	ID          string    `db:"id"`
+	StoragePath string    `db:"storage_path"`
+	Size        int64     `db:"size"`
+	Checksum    string    `db:"checksum"` // SHA-256 зашифрованного фрагмента (hex)
+	RefCount    int64     `db:"ref_count"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// ChunkRef — элемент манифеста файла: идентификатор и размер зашифрованного фрагмента.
+type ChunkRef struct {
+	ID   string `db:"chunk_id"`
+	Size int64  `db:"size"`
+}
+
+// ValidChunkID сообщает, является ли id идентификатором фрагмента
+// (ChunkIDLen шестнадцатеричных символов в нижнем регистре).
+func ValidChunkID(id string) bool {
+	if len(id) != ChunkIDLen {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		c := id[i]
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return false
+		}
+	}
+	return true
+}
@@ -12,18 +12,25 @@ import (
 //   - логин (Login);
 //   - пароль (Password) в зашифрованном виде;
 //   - произвольную текстовую метаинформацию (Metadata), например ссылки, заметки,
-//     одноразовые коды и т. п.
+//     одноразовые коды и т. п.;
+//   - папку (Folder) и набор тегов (Tags) для группировки записей;
+//   - флаг избранного (Favorite) и время последнего открытия (LastAccessedAt).
 //
 // Поля CreatedAt и UpdatedAt фиксируют время создания и последнего обновления записи.
 type Credential struct {
-	ID        string // UUID
-	UserID    string // Владелец
-	Title     string // Метаинформация (например, "Gmail", "GitHub")
-	Login     string
-	Password  string // Храним в зашифрованном виде
-	Metadata  string // Произвольный текст
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID             string // UUID
+	UserID         string // Владелец
+	Title          string // Метаинформация (например, "Gmail", "GitHub")
+	Login          string
+	Password       string     // Храним в зашифрованном виде
+	Metadata       string     // Произвольный текст
+	Folder         string     // Папка, в которой находится запись
+	Tags           Tags       // Теги (в зашифрованном виде)
+	Favorite       bool       // Запись отмечена как избранная
+	LastAccessedAt *time.Time // Время последнего открытия записи (nil — не открывалась)
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	Version        int64 // Версия записи (см. ErrVersionConflict)
 }
 
 // GetID возвращает идентификатор учётных данных.
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ItemType — тип записи хранилища (учётные данные, карта, заметка, файл).
+// Используется операциями, общими для всех типов записей.
+type ItemType string
+
+const (
+	// ItemTypeCredential — учётные данные.
+	ItemTypeCredential ItemType = "credential"
+	// ItemTypeBankCard — банковская карта.
+	ItemTypeBankCard ItemType = "bank_card"
+	// ItemTypeTextData — текстовая заметка.
+	ItemTypeTextData ItemType = "text_data"
+	// ItemTypeBinaryData — бинарный файл.
+	ItemTypeBinaryData ItemType = "binary_data"
+)
+
+// Validate проверяет, что тип записи известен.
+func (t ItemType) Validate() error {
+	switch t {
+	case ItemTypeCredential, ItemTypeBankCard, ItemTypeTextData, ItemTypeBinaryData:
+		return nil
+	default:
+		return fmt.Errorf("unknown item type %q", string(t))
+	}
+}
+
+// ItemSummary — краткое описание записи любого типа.
+// Используется для объединённого списка избранных и недавно открытых записей.
+type ItemSummary struct {
+	Type           ItemType   `db:"item_type"`        // Тип записи
+	ID             string     `db:"id"`               // Идентификатор записи (UUID)
+	Title          string     `db:"title"`            // Заголовок записи
+	Favorite       bool       `db:"favorite"`         // Запись отмечена как избранная
+	LastAccessedAt *time.Time `db:"last_accessed_at"` // Время последнего открытия (nil — не открывалась)
+}
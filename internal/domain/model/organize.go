@@ -0,0 +1,84 @@
+package model
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"strings"
+)
+
+// tagSeparator — разделитель тегов при хранении в одной текстовой колонке.
+// Теги на клиенте шифруются и кодируются в base64, поэтому запятая в них не встречается.
+const tagSeparator = ","
+
+// Tags — набор пользовательских тегов записи.
+//
+// На стороне сервера теги хранятся как непрозрачные строки (клиент шифрует их
+// детерминированно, чтобы одинаковые теги давали одинаковый шифртекст и по ним
+// можно было фильтровать). В базе данных набор сохраняется в одну TEXT-колонку
+// через запятую.
+type Tags []string
+
+// Value реализует driver.Valuer для сохранения тегов в базе данных.
+func (t Tags) Value() (driver.Value, error) {
+	return strings.Join(t, tagSeparator), nil
+}
+
+// Scan реализует sql.Scanner для чтения тегов из базы данных.
+func (t *Tags) Scan(src interface{}) error {
+	var s string
+	switch v := src.(type) {
+	case nil:
+		*t = nil
+		return nil
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	default:
+		return fmt.Errorf("unsupported tags type %T", src)
+	}
+	if s == "" {
+		*t = nil
+		return nil
+	}
+	*t = strings.Split(s, tagSeparator)
+	return nil
+}
+
+// Has сообщает, содержит ли набор указанный тег.
+func (t Tags) Has(tag string) bool {
+	for _, v := range t {
+		if v == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// ListFilter задаёт условия отбора записей при получении списков.
+//
+// Пустое значение поля означает отсутствие ограничения:
+//   - Folder — имя папки, в которой должна находиться запись;
+//   - Tags — теги, каждый из которых должен присутствовать у записи.
+type ListFilter struct {
+	Folder string
+	Tags   []string
+}
+
+// IsEmpty сообщает, что фильтр не накладывает ограничений.
+func (f ListFilter) IsEmpty() bool {
+	return f.Folder == "" && len(f.Tags) == 0
+}
+
+// Match проверяет, удовлетворяет ли запись с папкой folder и тегами tags фильтру.
+func (f ListFilter) Match(folder string, tags Tags) bool {
+	if f.Folder != "" && f.Folder != folder {
+		return false
+	}
+	for _, tag := range f.Tags {
+		if !tags.Has(tag) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,42 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTags_ValueScan(t *testing.T) {
+	tags := model.Tags{"a", "b"}
+	v, err := tags.Value()
+	require.NoError(t, err)
+	assert.Equal(t, "a,b", v)
+
+	var scanned model.Tags
+	require.NoError(t, scanned.Scan("a,b"))
+	assert.Equal(t, tags, scanned)
+
+	require.NoError(t, scanned.Scan([]byte("c")))
+	assert.Equal(t, model.Tags{"c"}, scanned)
+
+	require.NoError(t, scanned.Scan(""))
+	assert.Nil(t, scanned)
+
+	require.NoError(t, scanned.Scan(nil))
+	assert.Nil(t, scanned)
+
+	assert.Error(t, scanned.Scan(42))
+}
+
+func TestListFilter_Match(t *testing.T) {
+	tags := model.Tags{"work", "mail"}
+
+	assert.True(t, model.ListFilter{}.IsEmpty())
+	assert.True(t, model.ListFilter{}.Match("any", nil))
+	assert.True(t, model.ListFilter{Folder: "Work"}.Match("Work", nil))
+	assert.False(t, model.ListFilter{Folder: "Work"}.Match("Home", tags))
+	assert.True(t, model.ListFilter{Tags: []string{"work", "mail"}}.Match("", tags))
+	assert.False(t, model.ListFilter{Tags: []string{"work", "bank"}}.Match("", tags))
+}
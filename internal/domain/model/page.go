@@ -0,0 +1,144 @@
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// DefaultPageSize — размер страницы списка, если клиент его не указал.
+	DefaultPageSize = 50
+	// MaxPageSize — максимальный размер страницы списка.
+	MaxPageSize = 500
+)
+
+// ErrInvalidPageToken возвращается, если токен страницы повреждён
+// или был выдан для другого поля или направления сортировки.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// SortField — поле, по которому сортируются списки записей.
+type SortField string
+
+const (
+	// SortByCreated — сортировка по времени создания записи.
+	SortByCreated SortField = "created"
+	// SortByUpdated — сортировка по времени последнего обновления записи.
+	SortByUpdated SortField = "updated"
+	// SortByTitle — сортировка по заголовку записи.
+	SortByTitle SortField = "title"
+)
+
+// PageRequest задаёт параметры постраничного получения списка записей.
+//
+// Пагинация курсорная: Token — непрозрачный токен, полученный вместе с
+// предыдущей страницей (пустой — первая страница). Курсор содержит значение
+// поля сортировки и ID последней записи страницы, поэтому вставки и удаления
+// между запросами не приводят к пропускам и повторам.
+//
+// Нулевое значение соответствует первой странице размера DefaultPageSize,
+// отсортированной по времени создания от новых к старым.
+type PageRequest struct {
+	Size   int       // размер страницы (0 — DefaultPageSize)
+	Token  string    // токен следующей страницы
+	SortBy SortField // поле сортировки (пустое — SortByCreated по убыванию)
+	Desc   bool      // сортировка по убыванию
+}
+
+// Normalize возвращает копию запроса с подставленными значениями по умолчанию
+// и размером страницы, ограниченным MaxPageSize.
+func (p PageRequest) Normalize() PageRequest {
+	if p.SortBy == "" {
+		p.SortBy = SortByCreated
+		p.Desc = true
+	}
+	if p.Size <= 0 {
+		p.Size = DefaultPageSize
+	}
+	if p.Size > MaxPageSize {
+		p.Size = MaxPageSize
+	}
+	return p
+}
+
+// Validate проверяет, что поле сортировки известно.
+func (p PageRequest) Validate() error {
+	switch p.SortBy {
+	case "", SortByCreated, SortByUpdated, SortByTitle:
+		return nil
+	default:
+		return fmt.Errorf("unknown sort field %q", string(p.SortBy))
+	}
+}
+
+// PageCursor — содержимое токена страницы: поле и направление сортировки,
+// значение этого поля и ID последней записи предыдущей страницы.
+type PageCursor struct {
+	SortBy SortField `json:"s"`
+	Desc   bool      `json:"d,omitempty"`
+	Value  string    `json:"v"`
+	ID     string    `json:"id"`
+}
+
+// NextToken формирует токен следующей страницы по последней записи текущей.
+func (p PageRequest) NextToken(id, title string, createdAt, updatedAt time.Time) string {
+	p = p.Normalize()
+	cursor := PageCursor{SortBy: p.SortBy, Desc: p.Desc, ID: id}
+	switch p.SortBy {
+	case SortByTitle:
+		cursor.Value = title
+	case SortByUpdated:
+		cursor.Value = updatedAt.UTC().Format(time.RFC3339Nano)
+	default:
+		cursor.Value = createdAt.UTC().Format(time.RFC3339Nano)
+	}
+	return cursor.Encode()
+}
+
+// Cursor декодирует токен запроса. Для первой страницы возвращает nil.
+// Если токен повреждён или выдан для другого поля или направления сортировки,
+// возвращает ErrInvalidPageToken: продолжение чужого порядка вернуло бы не ту страницу.
+func (p PageRequest) Cursor() (*PageCursor, error) {
+	if p.Token == "" {
+		return nil, nil
+	}
+	cursor, err := DecodePageCursor(p.Token)
+	if err != nil {
+		return nil, err
+	}
+	p = p.Normalize()
+	if cursor.SortBy != p.SortBy || cursor.Desc != p.Desc {
+		return nil, ErrInvalidPageToken
+	}
+	return cursor, nil
+}
+
+// Time возвращает значение курсора как время (для сортировки по датам).
+func (c PageCursor) Time() (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, c.Value)
+	if err != nil {
+		return time.Time{}, ErrInvalidPageToken
+	}
+	return t, nil
+}
+
+// Encode кодирует курсор в непрозрачный токен.
+func (c PageCursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// DecodePageCursor разбирает токен, сформированный PageCursor.Encode.
+func DecodePageCursor(token string) (*PageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, ErrInvalidPageToken
+	}
+	var cursor PageCursor
+	if err := json.Unmarshal(raw, &cursor); err != nil || cursor.ID == "" {
+		return nil, ErrInvalidPageToken
+	}
+	return &cursor, nil
+}
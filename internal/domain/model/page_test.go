@@ -0,0 +1,88 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageRequest_Normalize(t *testing.T) {
+	p := model.PageRequest{}.Normalize()
+	assert.Equal(t, model.DefaultPageSize, p.Size)
+	assert.Equal(t, model.SortByCreated, p.SortBy)
+	assert.True(t, p.Desc)
+
+	p = model.PageRequest{Size: 10_000, SortBy: model.SortByTitle}.Normalize()
+	assert.Equal(t, model.MaxPageSize, p.Size)
+	assert.Equal(t, model.SortByTitle, p.SortBy)
+	assert.False(t, p.Desc)
+}
+
+func TestPageRequest_Validate(t *testing.T) {
+	assert.NoError(t, model.PageRequest{}.Validate())
+	assert.NoError(t, model.PageRequest{SortBy: model.SortByUpdated}.Validate())
+	assert.Error(t, model.PageRequest{SortBy: "size"}.Validate())
+}
+
+func TestPageRequest_NextTokenRoundTrip(t *testing.T) {
+	created := time.Date(2025, 1, 2, 3, 4, 5, 6, time.UTC)
+	updated := created.Add(time.Hour)
+
+	page := model.PageRequest{SortBy: model.SortByUpdated}
+	page.Token = page.NextToken("id1", "GitHub", created, updated)
+
+	cursor, err := page.Cursor()
+	require.NoError(t, err)
+	require.NotNil(t, cursor)
+	assert.Equal(t, "id1", cursor.ID)
+	ts, err := cursor.Time()
+	require.NoError(t, err)
+	assert.True(t, ts.Equal(updated))
+
+	byTitle := model.PageRequest{SortBy: model.SortByTitle}
+	byTitle.Token = byTitle.NextToken("id2", "GitHub", created, updated)
+	cursor, err = byTitle.Cursor()
+	require.NoError(t, err)
+	assert.Equal(t, "GitHub", cursor.Value)
+}
+
+func TestPageRequest_CursorErrors(t *testing.T) {
+	cursor, err := model.PageRequest{}.Cursor()
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	_, err = model.PageRequest{Token: "%%%"}.Cursor()
+	assert.ErrorIs(t, err, model.ErrInvalidPageToken)
+
+	// Токен, выданный для сортировки по заголовку, не подходит для сортировки по дате
+	byTitle := model.PageRequest{SortBy: model.SortByTitle}
+	token := byTitle.NextToken("id1", "a", time.Now(), time.Now())
+	_, err = model.PageRequest{Token: token, SortBy: model.SortByCreated}.Cursor()
+	assert.ErrorIs(t, err, model.ErrInvalidPageToken)
+
+	// Токен, выданный для сортировки по возрастанию, не подходит для сортировки по убыванию
+	asc := model.PageRequest{SortBy: model.SortByTitle}
+	token = asc.NextToken("id1", "a", time.Now(), time.Now())
+	_, err = model.PageRequest{Token: token, SortBy: model.SortByTitle, Desc: true}.Cursor()
+	assert.ErrorIs(t, err, model.ErrInvalidPageToken)
+	desc := model.PageRequest{SortBy: model.SortByTitle, Desc: true}
+	desc.Token = desc.NextToken("id1", "a", time.Now(), time.Now())
+	_, err = model.PageRequest{Token: desc.Token, SortBy: model.SortByTitle}.Cursor()
+	assert.ErrorIs(t, err, model.ErrInvalidPageToken)
+	_, err = desc.Cursor()
+	assert.NoError(t, err)
+
+	// Порядок по умолчанию — по дате создания от новых к старым
+	defaults := model.PageRequest{}
+	defaults.Token = defaults.NextToken("id1", "a", time.Now(), time.Now())
+	_, err = defaults.Cursor()
+	assert.NoError(t, err)
+	_, err = model.PageRequest{Token: defaults.Token, SortBy: model.SortByCreated}.Cursor()
+	assert.ErrorIs(t, err, model.ErrInvalidPageToken)
+
+	_, err = model.PageCursor{SortBy: model.SortByCreated, Value: "yesterday", ID: "x"}.Time()
+	assert.ErrorIs(t, err, model.ErrInvalidPageToken)
+}
@@ -0,0 +1,21 @@
+package model
+
+import "errors"
+
+// ErrQuotaExceeded возвращается, когда операция превысила бы ограничение
+// пользователя на объём хранимых файлов или число записей.
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// Quota — ограничения, действующие для каждого пользователя сервера.
+// Нулевое значение поля означает отсутствие ограничения.
+type Quota struct {
+	MaxBytes int64 // объём хранимых файлов, байт
+	MaxItems int64 // число записей всех типов
+}
+
+// Usage — место, занятое пользователем, и действующие для него ограничения.
+type Usage struct {
+	Bytes  int64 `db:"bytes"` // объём хранимых файлов (общие фрагменты учитываются один раз)
+	Items  int64 `db:"items"` // число записей всех типов
+	Limits Quota `db:"-"`
+}
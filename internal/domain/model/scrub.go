@@ -0,0 +1,63 @@
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrScrubInProgress возвращается при запуске сверки хранилища, пока
+// выполняется предыдущая.
+var ErrScrubInProgress = errors.New("scrub already in progress")
+
+// StoredRef — ссылка записи метаданных на файл в хранилище бинарных данных:
+// файл записи binary_data, файл прежней версии записи (Version) или
+// зашифрованный фрагмент (Chunk).
+type StoredRef struct {
+	UserID      string `db:"user_id"`
+	ID          string `db:"id"` // идентификатор записи, версии или фрагмента
+	StoragePath string `db:"storage_path"`
+	Checksum    string `db:"checksum"` // SHA-256 содержимого (hex); пусто — не записана
+	Version     bool   `db:"version"`  // ссылка прежней версии записи
+	Chunk       bool   `db:"-"`        // ссылка фрагмента, а не записи
+}
+
+// ScrubOptions задаёт режим сверки хранилища бинарных данных.
+type ScrubOptions struct {
+	// Fix разрешает исправлять найденное: удалять файлы без записей и
+	// записи, файл которых утрачен. Без него сверка только сообщает.
+	Fix bool
+
+	// Rehash включает пересчёт SHA-256 хранимых файлов и сверку с
+	// записанными контрольными суммами.
+	Rehash bool
+
+	// OrphanGrace — файлы моложе этого срока не считаются лишними: запись
+	// о только что сохранённом файле может ещё не появиться в базе.
+	OrphanGrace time.Duration
+}
+
+// ScrubReport — результат сверки хранилища бинарных данных с записями.
+type ScrubReport struct {
+	StartedAt  time.Time
+	FinishedAt time.Time
+	Options    ScrubOptions
+
+	FilesScanned   int // файлов в хранилище
+	RecordsScanned int // ссылок записей и фрагментов на файлы
+	FilesRehashed  int // файлов, сверенных с контрольной суммой
+
+	OrphanFiles        []string    // файлы, на которые не ссылается ни одна запись
+	MissingFiles       []StoredRef // записи, файлов которых нет в хранилище
+	ChecksumMismatches []StoredRef // файлы, не совпадающие с контрольной суммой
+
+	RemovedFiles   int // удалённых лишних файлов (Fix)
+	RemovedRecords int // удалённых записей без файлов (Fix)
+
+	Errors []string // ошибки, не прервавшие сверку
+}
+
+// Clean сообщает, что сверка не нашла расхождений и завершилась без ошибок.
+func (r *ScrubReport) Clean() bool {
+	return len(r.OrphanFiles) == 0 && len(r.MissingFiles) == 0 &&
+		len(r.ChecksumMismatches) == 0 && len(r.Errors) == 0
+}
@@ -7,13 +7,18 @@ import (
 // TextData — модель для хранения произвольных текстовых данных.
 // Все чувствительные поля (Content и Metadata) должны храниться в зашифрованном виде (например, base64 или raw bytes).
 type TextData struct {
-	ID        string    `db:"id"`         // Уникальный идентификатор записи (UUID)
-	UserID    string    `db:"user_id"`    // Идентификатор пользователя-владельца записи
-	Title     string    `db:"title"`      // Краткое название записи (например, "Рабочие заметки")
-	Content   []byte    `db:"content"`    // Основной зашифрованный контент
-	Metadata  string    `db:"metadata"`   // Дополнительные данные в формате JSON или свободный текст, зашифрованные
-	CreatedAt time.Time `db:"created_at"` // Время создания записи
-	UpdatedAt time.Time `db:"updated_at"` // Время последнего обновления записи
+	ID             string     `db:"id"`               // Уникальный идентификатор записи (UUID)
+	UserID         string     `db:"user_id"`          // Идентификатор пользователя-владельца записи
+	Title          string     `db:"title"`            // Краткое название записи (например, "Рабочие заметки")
+	Content        []byte     `db:"content"`          // Основной зашифрованный контент
+	Metadata       string     `db:"metadata"`         // Дополнительные данные в формате JSON или свободный текст, зашифрованные
+	Folder         string     `db:"folder"`           // Папка, в которой находится запись
+	Tags           Tags       `db:"tags"`             // Теги записи (в зашифрованном виде)
+	Favorite       bool       `db:"favorite"`         // Запись отмечена как избранная
+	LastAccessedAt *time.Time `db:"last_accessed_at"` // Время последнего открытия записи (nil — не открывалась)
+	CreatedAt      time.Time  `db:"created_at"`       // Время создания записи
+	UpdatedAt      time.Time  `db:"updated_at"`       // Время последнего обновления записи
+	Version        int64      `db:"version"`          // Версия записи (см. ErrVersionConflict)
 }
 
 // GetID возвращает идентификатор текстовых данных.
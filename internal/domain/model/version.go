@@ -0,0 +1,11 @@
+package model
+
+import "errors"
+
+// ErrVersionConflict возвращается при обновлении записи, если её версия
+// на сервере отличается от версии, на основе которой сделано изменение.
+//
+// Каждая запись имеет версию (поле Version): новая запись получает версию 1,
+// каждое обновление содержимого увеличивает её на единицу. Клиент передаёт
+// в обновлении версию, которую он видел; нулевая версия отключает проверку.
+var ErrVersionConflict = errors.New("version conflict")
@@ -10,7 +10,7 @@ import (
 type BankCardRepository interface {
 	Create(ctx context.Context, card *model.BankCard) error
 	GetByID(ctx context.Context, id string) (*model.BankCard, error)
-	GetByUser(ctx context.Context, userID string) ([]model.BankCard, error)
+	GetByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) // страница карт и токен следующей страницы
 	Update(ctx context.Context, card *model.BankCard) error
 	Delete(ctx context.Context, id string) error
 }
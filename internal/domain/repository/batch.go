@@ -0,0 +1,21 @@
+package repository
+
+import "context"
+
+// BatchRepository выполняет изменения записей разных типов в одной транзакции.
+type BatchRepository interface {
+	// WithinTx выполняет fn в транзакции: если fn вернула ошибку, все изменения
+	// откатываются, иначе транзакция фиксируется.
+	WithinTx(ctx context.Context, fn func(tx BatchTx) error) error
+}
+
+// BatchTx — репозитории записей, работающие в рамках одной транзакции.
+type BatchTx interface {
+	Credential() CredentialRepository
+	BankCard() BankCardRepository
+	TextData() TextDataRepository
+
+	// Savepoint выполняет fn так, что при её ошибке откатываются только
+	// сделанные ею изменения, а транзакция остаётся пригодной для продолжения.
+	Savepoint(ctx context.Context, fn func() error) error
+}
@@ -15,11 +15,17 @@ type BinaryDataRepository interface {
 	// GetByID возвращает бинарные данные по их идентификатору и владельцу.
 	GetByID(ctx context.Context, userID, id string) (*model.BinaryData, error)
 
-	// ListByUser возвращает все бинарные данные конкретного пользователя.
-	ListByUser(ctx context.Context, userID string) ([]*model.BinaryData, error)
+	// ListByUser возвращает страницу бинарных данных конкретного пользователя,
+	// удовлетворяющих фильтру по папке и тегам, и токен следующей страницы.
+	ListByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.BinaryData, string, error)
 
 	// Delete удаляет запись по идентификатору и владельцу.
 	Delete(ctx context.Context, userID, id string) error
 
 	Update(ctx context.Context, data *model.BinaryData) error
+
+	// StoredRefs возвращает ссылки всех записей и их прежних версий (всех
+	// пользователей) на файлы хранилища; записи без файла и хранящиеся
+	// фрагментами не входят.
+	StoredRefs(ctx context.Context) ([]model.StoredRef, error)
 }
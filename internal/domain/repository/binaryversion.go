@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// BinaryVersionRepository хранит прежние версии содержимого бинарных данных,
+// сохраняемые при замене содержимого записи.
+//
+// Версия, хранящаяся фрагментами, имеет свой манифест, вхождения которого
+// учитываются в счётчиках ссылок фрагментов наравне с манифестами файлов
+// (см. ChunkRepository). Методы, удаляющие версии, возвращают фрагменты,
+// на которые больше никто не ссылается: вызывающий код должен удалить их
+// содержимое из хранилища, как и файлы удалённых версий.
+type BinaryVersionRepository interface {
+	// Save сохраняет версию v (присваивая ей идентификатор) и, если она
+	// хранится фрагментами, её манифест ids.
+	Save(ctx context.Context, v *model.BinaryVersion, ids []string) error
+
+	// List возвращает версии записи binaryID, начиная с последней.
+	List(ctx context.Context, userID, binaryID string) ([]*model.BinaryVersion, error)
+
+	// Get возвращает версию id записи binaryID или
+	// model.ErrBinaryVersionNotFound.
+	Get(ctx context.Context, userID, binaryID, id string) (*model.BinaryVersion, error)
+
+	// Manifest возвращает фрагменты версии по порядку.
+	Manifest(ctx context.Context, userID, id string) ([]model.ChunkRef, error)
+
+	// Delete удаляет версию вместе с манифестом.
+	Delete(ctx context.Context, userID, id string) ([]model.Chunk, error)
+
+	// Prune удаляет версии записи binaryID, кроме keep последних, и
+	// возвращает удалённые версии и освобождённые фрагменты.
+	Prune(ctx context.Context, userID, binaryID string, keep int) ([]*model.BinaryVersion, []model.Chunk, error)
+}
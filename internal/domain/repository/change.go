@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// ChangeRepository предоставляет доступ к журналу изменений записей пользователя.
+//
+// Журнал ведётся хранилищем при каждом создании, обновлении и удалении записи
+// любого типа; репозиторий только читает его.
+type ChangeRepository interface {
+	// ListChanges возвращает не более limit изменений пользователя с номером больше since
+	// в порядке возрастания номера. Для созданных и обновлённых записей заполняется
+	// актуальное содержимое записи, для удалённых — только признак Deleted.
+	ListChanges(ctx context.Context, userID string, since int64, limit int) ([]model.Change, error)
+}
+
+// ChangeFeed доставляет уведомления об изменениях записей пользователей.
+//
+// Реализация должна получать уведомления обо всех изменениях в хранилище,
+// в том числе сделанных другими экземплярами сервера.
+type ChangeFeed interface {
+	// Subscribe подписывается на уведомления об изменениях записей пользователя.
+	// Канал закрывается после отмены ctx или остановки источника уведомлений.
+	Subscribe(ctx context.Context, userID string) (<-chan model.ChangeEvent, error)
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// ChunkRepository хранит сведения о зашифрованных фрагментах файлов и
+// манифесты файлов, содержимое которых хранится фрагментами.
+//
+// Сами фрагменты лежат в хранилище бинарных данных (storage.BinaryDataStorage),
+// репозиторий ведёт их пути и счётчики ссылок. Методы *File изменяют запись
+// binary_data и её манифест в одной транзакции и возвращают фрагменты, на
+// которые больше не ссылается ни один файл: они удалены из репозитория, и
+// вызывающий код должен удалить их содержимое из хранилища.
+type ChunkRepository interface {
+	// Sizes возвращает размеры фрагментов пользователя из ids, которые есть
+	// в репозитории; отсутствующих фрагментов в результате нет.
+	Sizes(ctx context.Context, userID string, ids []string) (map[string]int64, error)
+
+	// Add регистрирует загруженный фрагмент с нулевым счётчиком ссылок.
+	// Возвращает false, если фрагмент уже зарегистрирован (например,
+	// параллельной загрузкой) — тогда новое содержимое не нужно.
+	Add(ctx context.Context, chunk *model.Chunk) (bool, error)
+
+	// Get возвращает фрагмент пользователя по идентификатору.
+	Get(ctx context.Context, userID, id string) (*model.Chunk, error)
+
+	// Manifest возвращает фрагменты файла по порядку.
+	Manifest(ctx context.Context, userID, binaryID string) ([]model.ChunkRef, error)
+
+	// CreateFile сохраняет новую запись data с манифестом ids.
+	CreateFile(ctx context.Context, data *model.BinaryData, ids []string) error
+
+	// UpdateFile обновляет запись data (с проверкой версии, как
+	// BinaryDataRepository.Update) и заменяет её манифест на ids.
+	UpdateFile(ctx context.Context, data *model.BinaryData, ids []string) ([]model.Chunk, error)
+
+	// DeleteFile удаляет запись вместе с манифестом.
+	DeleteFile(ctx context.Context, userID, binaryID string) ([]model.Chunk, error)
+
+	// StoredRefs возвращает ссылки всех фрагментов (всех пользователей) на
+	// файлы хранилища.
+	StoredRefs(ctx context.Context) ([]model.StoredRef, error)
+}
@@ -12,7 +12,7 @@ import (
 // Интерфейс инкапсулирует CRUD-операции для учётных данных пользователя:
 //   - Create — создание новой пары логин/пароль;
 //   - GetByID — получение конкретной записи по её идентификатору;
-//   - GetByUserID — получение всех записей, принадлежащих определённому пользователю;
+//   - GetByUserID — постраничное получение записей пользователя с фильтрацией по папке и тегам;
 //   - Update — обновление существующей записи;
 //   - Delete — удаление записи по идентификатору.
 //
@@ -25,9 +25,10 @@ type CredentialRepository interface {
 	// GetByID возвращает запись учётных данных по её уникальному идентификатору.
 	GetByID(ctx context.Context, id string) (*model.Credential, error)
 
-	// GetByUserID возвращает все записи учётных данных,
-	// принадлежащие указанному пользователю.
-	GetByUserID(ctx context.Context, userID string) ([]model.Credential, error)
+	// GetByUserID возвращает страницу записей учётных данных, принадлежащих указанному
+	// пользователю и удовлетворяющих фильтру по папке и тегам, а также токен
+	// следующей страницы (пустой, если страница последняя).
+	GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error)
 
 	// Update изменяет существующую запись учётных данных.
 	Update(ctx context.Context, cred *model.Credential) error
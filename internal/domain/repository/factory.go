@@ -10,6 +10,12 @@ type StorageFactory interface {
 	BankCard() BankCardRepository
 	TextData() TextDataRepository
 	BinaryData() BinaryDataRepository
+	Chunk() ChunkRepository
+	BinaryVersion() BinaryVersionRepository
+	Item() ItemRepository
+	Change() ChangeRepository
+	ChangeFeed() ChangeFeed
+	Batch() BatchRepository
 	// Если будут новые сущности — добавляем сюда
 	// Close освобождает ресурсы, связанные с фабрикой (например, соединение с БД).
 	Close() error
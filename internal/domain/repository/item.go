@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// ItemRepository определяет операции, общие для записей всех типов
+// (учётные данные, банковские карты, текстовые и бинарные данные):
+//   - SetFavorite — установка или снятие отметки «избранное»;
+//   - MarkAccessed — фиксация времени последнего открытия записи;
+//   - ListFavoritesRecent — объединённый список избранных и недавно открытых записей;
+//   - Usage — занятое пользователем место.
+type ItemRepository interface {
+	// SetFavorite устанавливает флаг избранного для записи указанного типа.
+	// Возвращает ошибку, если запись не найдена или принадлежит другому пользователю.
+	SetFavorite(ctx context.Context, userID string, itemType model.ItemType, id string, favorite bool) error
+
+	// MarkAccessed сохраняет время последнего открытия записи указанного типа.
+	// Возвращает ошибку, если запись не найдена или принадлежит другому пользователю.
+	MarkAccessed(ctx context.Context, userID string, itemType model.ItemType, id string, at time.Time) error
+
+	// ListFavoritesRecent возвращает избранные и открывавшиеся записи пользователя всех типов:
+	// сначала избранные, затем остальные по убыванию времени последнего открытия.
+	// limit ограничивает размер результата.
+	ListFavoritesRecent(ctx context.Context, userID string, limit int) ([]model.ItemSummary, error)
+
+	// Usage возвращает число записей пользователя всех типов и объём его
+	// хранимых файлов (поля Bytes и Items; Limits не заполняется).
+	Usage(ctx context.Context, userID string) (*model.Usage, error)
+}
@@ -12,5 +12,5 @@ type TextDataRepository interface {
 	GetByID(ctx context.Context, userID, id string) (*model.TextData, error)
 	Update(ctx context.Context, data *model.TextData) error
 	Delete(ctx context.Context, userID, id string) error
-	ListTitles(ctx context.Context, userID string) ([]*model.TextData, error) // возвращает только ID и Title
+	ListTitles(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) // страница записей без содержимого и токен следующей страницы
 }
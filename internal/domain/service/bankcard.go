@@ -16,8 +16,9 @@ type BankCardService interface {
 	// GetByID возвращает банковскую карту по её уникальному идентификатору.
 	GetByID(ctx context.Context, id string) (*model.BankCard, error)
 
-	// GetByUserID возвращает все банковские карты, принадлежащие указанному пользователю.
-	GetByUserID(ctx context.Context, userID string) ([]model.BankCard, error)
+	// GetByUserID возвращает страницу банковских карт указанного пользователя, удовлетворяющих фильтру,
+	// и токен следующей страницы.
+	GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error)
 
 	// Update обновляет существующую запись банковской карты.
 	Update(ctx context.Context, card *model.BankCard) error
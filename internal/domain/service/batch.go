@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// BatchService описывает контракт сервиса пакетного изменения записей.
+type BatchService interface {
+	// Mutate применяет операции пакета в одной транзакции и возвращает результат
+	// каждой операции в том же порядке. Если atomic равен true, ошибка любой
+	// операции отменяет весь пакет (остальные операции получают ErrBatchAborted),
+	// иначе применяются все успешные операции.
+	// Ошибка возвращается, если пакет некорректен или не удалось выполнить транзакцию.
+	Mutate(ctx context.Context, userID string, ops []model.BatchOp, atomic bool) ([]model.BatchResult, error)
+}
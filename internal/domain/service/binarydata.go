@@ -50,6 +50,24 @@ type BinaryDataService interface {
 	//   - ошибку, если операция не удалась
 	Update(ctx context.Context, data *model.BinaryData, r io.Reader) (*model.BinaryData, error)
 
+	// ResumeUpload принимает содержимое файла в сессии загрузки с докачкой.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения
+	//   - data: метаданные записи; пустой ID — создание, иначе обновление
+	//   - uploadID: идентификатор сессии загрузки (UUID, задаётся клиентом)
+	//   - offset: смещение, с которого передаётся содержимое (см. UploadStatus)
+	//   - r: поток содержимого начиная с offset
+	//
+	// Возвращает:
+	//   - созданную или обновлённую модель BinaryData, если поток дочитан до конца
+	//   - ошибку; при обрыве потока принятые данные остаются в сессии
+	ResumeUpload(ctx context.Context, data *model.BinaryData, uploadID string, offset int64, r io.Reader) (*model.BinaryData, error)
+
+	// UploadStatus возвращает число байт, принятых в сессии загрузки
+	// (0 — сессии нет или она истекла).
+	UploadStatus(ctx context.Context, userID, uploadID string) (int64, error)
+
 	// UpdateInfo обновляет только метаданные (заголовок и описание) без изменения бинарного содержимого.
 	//
 	// Параметры:
@@ -70,9 +88,9 @@ type BinaryDataService interface {
 	//
 	// Возвращает:
 	//   - модель BinaryData с метаданными
-	//   - поток для чтения содержимого (io.ReadCloser)
+	//   - поток для чтения содержимого с поддержкой перемотки (для выдачи диапазонов)
 	//   - ошибку, если запись не найдена или произошла ошибка чтения
-	Get(ctx context.Context, userID, id string) (*model.BinaryData, io.ReadCloser, error)
+	Get(ctx context.Context, userID, id string) (*model.BinaryData, io.ReadSeekCloser, error)
 
 	// GetInfo возвращает только метаданные бинарных данных без чтения содержимого.
 	//
@@ -86,16 +104,19 @@ type BinaryDataService interface {
 	//   - ошибку, если запись не найдена
 	GetInfo(ctx context.Context, userID, id string) (*model.BinaryData, error)
 
-	// List возвращает список всех бинарных данных конкретного пользователя.
+	// List возвращает список бинарных данных конкретного пользователя.
 	//
 	// Параметры:
 	//   - ctx: контекст выполнения операции
 	//   - userID: идентификатор пользователя
+	//   - filter: фильтр по папке и тегам (пустой фильтр — все записи)
+	//   - page: размер страницы, токен и поле сортировки
 	//
 	// Возвращает:
 	//   - срез моделей BinaryData с метаданными (без самого контента)
+	//   - токен следующей страницы (пустой, если страница последняя)
 	//   - ошибку, если операция не удалась
-	List(ctx context.Context, userID string) ([]*model.BinaryData, error)
+	List(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.BinaryData, string, error)
 
 	// Delete удаляет бинарные данные по идентификатору пользователя и записи.
 	//
@@ -108,6 +129,67 @@ type BinaryDataService interface {
 	//   - ошибку, если удаление не удалось или запись не найдена
 	Delete(ctx context.Context, userID, id string) error
 
+	// MissingChunks возвращает идентификаторы из ids, зашифрованных фрагментов
+	// с которыми ещё нет у пользователя userID.
+	MissingChunks(ctx context.Context, userID string, ids []string) ([]string, error)
+
+	// PutChunk сохраняет зашифрованный фрагмент файла с идентификатором id.
+	PutChunk(ctx context.Context, userID, id string, data []byte) error
+
+	// CommitChunked создаёт или обновляет запись, содержимое которой состоит
+	// из загруженных фрагментов.
+	//
+	// Параметры:
+	//   - ctx: контекст выполнения
+	//   - data: метаданные записи; пустой ID — создание, иначе обновление
+	//   - ids: идентификаторы фрагментов файла по порядку
+	//
+	// Возвращает:
+	//   - созданную или обновлённую модель BinaryData
+	//   - model.ErrMissingChunks, если каких-то фрагментов нет на сервере
+	CommitChunked(ctx context.Context, data *model.BinaryData, ids []string) (*model.BinaryData, error)
+
+	// Manifest возвращает фрагменты файла по порядку и true, если содержимое
+	// хранится фрагментами (false — одним потоком, см. Get).
+	Manifest(ctx context.Context, userID, id string) ([]model.ChunkRef, bool, error)
+
+	// GetChunk возвращает содержимое зашифрованного фрагмента; повреждённый
+	// фрагмент — model.ErrChecksumMismatch.
+	GetChunk(ctx context.Context, userID, id string) ([]byte, error)
+
+	// Verify проверяет хранимое содержимое файла по контрольным суммам,
+	// записанным при загрузке.
+	//
+	// Возвращает:
+	//   - nil, если содержимое не повреждено
+	//   - model.ErrChecksumMismatch, если содержимое не совпадает с суммой
+	//   - model.ErrNoChecksum, если сумма для файла не записана
+	Verify(ctx context.Context, userID, id string) error
+
+	// ListVersions возвращает прежние версии содержимого записи, сохранённые
+	// при его замене, начиная с последней.
+	ListVersions(ctx context.Context, userID, id string) ([]*model.BinaryVersion, error)
+
+	// GetVersion возвращает запись с содержимым прежней версии versionID и
+	// поток для его чтения (как Get). Для версии, хранящейся фрагментами, —
+	// model.ErrChunkedFile; для неизвестной версии —
+	// model.ErrBinaryVersionNotFound.
+	GetVersion(ctx context.Context, userID, id, versionID string) (*model.BinaryData, io.ReadSeekCloser, error)
+
+	// VersionManifest возвращает фрагменты прежней версии по порядку и true,
+	// если она хранится фрагментами (как Manifest).
+	VersionManifest(ctx context.Context, userID, id, versionID string) ([]model.ChunkRef, bool, error)
+
+	// RestoreVersion делает содержимое прежней версии versionID текущим.
+	//
+	// Параметры:
+	//   - version: ожидаемая версия записи (0 — без проверки)
+	//
+	// Возвращает:
+	//   - обновлённую модель BinaryData
+	//   - model.ErrVersionConflict или model.ErrBinaryVersionNotFound
+	RestoreVersion(ctx context.Context, userID, id, versionID string, version int64) (*model.BinaryData, error)
+
 	// Close освобождает ресурсы
 	Close()
 }
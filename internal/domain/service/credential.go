@@ -14,8 +14,9 @@ type CredentialService interface {
 	// GetByID возвращает учётные данные по их уникальному идентификатору.
 	GetByID(ctx context.Context, id string) (*model.Credential, error)
 
-	// GetByUserID возвращает все учётные данные пользователя.
-	GetByUserID(ctx context.Context, userID string) ([]model.Credential, error)
+	// GetByUserID возвращает страницу учётных данных пользователя, удовлетворяющих фильтру,
+	// и токен следующей страницы (пустой, если страница последняя).
+	GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error)
 
 	// Update обновляет существующую запись учётных данных.
 	Update(ctx context.Context, cred *model.Credential) error
@@ -8,6 +8,10 @@ type ServiceFactory interface {
 	BankCard() BankCardService
 	TextData() TextDataService
 	BinaryData() BinaryDataService
+	Item() ItemService
+	Sync() SyncService
+	Batch() BatchService
+	Scrub() ScrubService
 	// Close освобождает ресурсы сервисов и нижележащих слоёв.
 	Close()
 }
@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// ItemService описывает контракт сервиса для операций, общих для записей всех типов:
+// избранное, история открытия записей и занятое пользователем место.
+type ItemService interface {
+	// SetFavorite устанавливает или снимает отметку «избранное» у записи пользователя.
+	SetFavorite(ctx context.Context, userID string, itemType model.ItemType, id string, favorite bool) error
+
+	// MarkAccessed фиксирует текущее время как время последнего открытия записи.
+	MarkAccessed(ctx context.Context, userID string, itemType model.ItemType, id string) error
+
+	// ListFavoritesRecent возвращает объединённый список избранных и недавно открытых записей
+	// пользователя всех типов. Неположительный limit заменяется значением по умолчанию.
+	ListFavoritesRecent(ctx context.Context, userID string, limit int) ([]model.ItemSummary, error)
+
+	// Usage возвращает число записей пользователя, объём его файлов (вместе
+	// с незавершёнными загрузками) и действующие ограничения (нулевое
+	// ограничение — его нет).
+	Usage(ctx context.Context, userID string) (*model.Usage, error)
+}
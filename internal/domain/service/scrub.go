@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// ScrubService описывает контракт сверки хранилища бинарных данных с
+// записями в базе: поиск файлов без записей, записей без файлов и файлов,
+// не совпадающих с записанными контрольными суммами.
+type ScrubService interface {
+	// Run выполняет сверку и возвращает отчёт. Если сверка уже идёт,
+	// возвращается model.ErrScrubInProgress.
+	Run(ctx context.Context, opts model.ScrubOptions) (*model.ScrubReport, error)
+
+	// LastReport возвращает отчёт последней завершённой сверки или nil.
+	LastReport() *model.ScrubReport
+
+	// Start запускает периодическую сверку с интервалом interval.
+	Start(interval time.Duration, opts model.ScrubOptions)
+
+	// Close останавливает периодическую сверку.
+	Close()
+}
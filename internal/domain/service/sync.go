@@ -0,0 +1,18 @@
+package service
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// SyncService описывает контракт сервиса разностной синхронизации.
+type SyncService interface {
+	// ListChanges возвращает изменения записей пользователя после курсора cursor
+	// (0 — с самого начала). Неположительный limit заменяется значением по умолчанию.
+	ListChanges(ctx context.Context, userID string, cursor int64, limit int) (model.ChangeBatch, error)
+
+	// WatchChanges подписывается на уведомления об изменениях записей пользователя.
+	// Канал закрывается после отмены ctx или остановки источника уведомлений.
+	WatchChanges(ctx context.Context, userID string) (<-chan model.ChangeEvent, error)
+}
@@ -16,8 +16,9 @@ type TextDataService interface {
 	// GetByID возвращает полную запись TextData по её уникальному идентификатору.
 	GetByID(ctx context.Context, userID, id string) (*model.TextData, error)
 
-	// ListTitles возвращает список заголовков всех записей пользователя (ID + Title).
-	ListTitles(ctx context.Context, userID string) ([]*model.TextData, error)
+	// ListTitles возвращает страницу заголовков записей пользователя (ID, Title, Folder, Tags),
+	// удовлетворяющих фильтру, и токен следующей страницы.
+	ListTitles(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error)
 
 	// Update обновляет существующую запись TextData.
 	Update(ctx context.Context, data *model.TextData) error
@@ -10,13 +10,36 @@
 //  2. Этот путь сохраняется в таблице binary_data (через BinaryDataRepository).
 //  3. Для загрузки данных используется BinaryDataStorage.Load() по сохранённому пути.
 //  4. Для удаления записи из хранилища вызывается BinaryDataStorage.Delete().
+//
+// Загрузка с докачкой вместо Save использует сессию: AppendUpload вызывается
+// для каждой попытки передачи (UploadOffset сообщает, с какого места
+// продолжать), а CommitUpload возвращает путь готового файла.
+//
+// List перечисляет сохранённые файлы; по нему фоновая сверка находит файлы,
+// на которые не ссылается ни одна запись.
+//
+// Хранилище, шифрующее файлы ключом сервера, реализует Reencryptor:
+// после смены ключа файлы перешифровываются новым активным ключом.
 package storage
 
 import (
 	"context"
+	"errors"
 	"io"
+	"time"
 )
 
+// ErrUploadOffset возвращается при продолжении загрузки со смещения,
+// превышающего объём уже принятых в сессии данных.
+var ErrUploadOffset = errors.New("upload offset exceeds uploaded data")
+
+// StoredObject описывает файл в хранилище бинарных данных.
+type StoredObject struct {
+	Path    string    // путь или ключ файла, как его возвращает Save
+	Size    int64     // размер в байтах
+	ModTime time.Time // время последнего изменения
+}
+
 // BinaryDataStorage абстрагирует доступ к бинарным данным (файлам).
 // Реализации могут сохранять данные в локальной файловой системе,
 // удалённом объектном хранилище (например, S3/MinIO) или в другом бэкенде.
@@ -33,12 +56,58 @@ type BinaryDataStorage interface {
 	Save(ctx context.Context, userID string, r io.Reader) (storagePath string, size int64, err error)
 
 	// Load возвращает поток для чтения бинарных данных по указанному storagePath.
-	// Вызвавший код обязан закрыть возвращённый io.ReadCloser.
-	Load(ctx context.Context, storagePath string) (io.ReadCloser, error)
+	// Поток поддерживает перемотку, что позволяет отдавать диапазоны файла
+	// (докачка при скачивании). Вызвавший код обязан закрыть возвращённый поток.
+	Load(ctx context.Context, storagePath string) (io.ReadSeekCloser, error)
 
 	// Delete удаляет бинарные данные из хранилища по указанному storagePath.
 	Delete(ctx context.Context, storagePath string) error
 
+	// UploadOffset возвращает число байт, принятых в сессии загрузки uploadID
+	// пользователя userID. Для неизвестной (или истёкшей) сессии возвращает 0.
+	UploadOffset(ctx context.Context, userID, uploadID string) (int64, error)
+
+	// UploadUsage возвращает суммарный объём данных в незавершённых сессиях
+	// загрузки пользователя userID: до фиксации они не учтены в записях, но
+	// уже занимают место.
+	UploadUsage(ctx context.Context, userID string) (int64, error)
+
+	// AppendUpload дописывает данные из r в сессию загрузки, начиная с offset
+	// (данные после offset, принятые ранее, отбрасываются). Принятые байты
+	// сохраняются и при ошибке чтения r, чтобы загрузку можно было продолжить.
+	// Если offset больше объёма сессии, возвращается ErrUploadOffset.
+	//
+	// Возвращает объём данных в сессии после записи.
+	AppendUpload(ctx context.Context, userID, uploadID string, offset int64, r io.Reader) (int64, error)
+
+	// CommitUpload завершает сессию загрузки: данные переносятся в хранилище
+	// так же, как при Save, а сессия удаляется.
+	CommitUpload(ctx context.Context, userID, uploadID string) (storagePath string, size int64, err error)
+
+	// List вызывает fn для каждого сохранённого файла (без незавершённых
+	// сессий загрузки и временных файлов). Ошибка fn прерывает обход и
+	// возвращается из List.
+	List(ctx context.Context, fn func(obj StoredObject) error) error
+
 	// Close освобождает ресурсы
 	Close()
 }
+
+// ReencryptResult — итог перешифрования хранилища.
+type ReencryptResult struct {
+	Scanned     int64 // просмотрено файлов
+	Reencrypted int64 // перешифровано активным ключом
+	Failed      int64 // файлов, которые не удалось перешифровать
+
+	Errors []string // ошибки отдельных файлов, не прервавшие перешифрование
+}
+
+// Reencryptor реализуется хранилищами, которые шифруют файлы ключом сервера.
+type Reencryptor interface {
+	// Reencrypt перешифровывает активным ключом все файлы, зашифрованные
+	// прежними ключами или сохранённые без шифрования. Файлы, уже
+	// зашифрованные активным ключом, не переписываются. Ошибка отдельного
+	// файла не прерывает обход, а учитывается в ReencryptResult; ошибка
+	// возвращается, только если обход не завершён (в том числе при отмене ctx).
+	Reencrypt(ctx context.Context) (ReencryptResult, error)
+}
@@ -13,6 +13,9 @@ import (
 //go:embed sql/*.sql
 var embedMigrations embed.FS
 
+//go:embed sqlite/*.sql
+var embedSQLiteMigrations embed.FS
+
 // RunMigrations применяет миграции к базе данных с использованием библиотеки Goose.
 //
 // Функция выполняет следующие действия:
@@ -42,3 +45,29 @@ func RunMigrations(db *sql.DB) error {
 	log.Println("migrations applied successfully")
 	return nil
 }
+
+// RunSQLiteMigrations применяет к базе данных SQLite миграции из каталога sqlite.
+//
+// Схема SQLite повторяет схему PostgreSQL, но ведётся отдельным набором
+// миграций: типы столбцов, триггеры журнала изменений и способ уведомления
+// об изменениях в этих СУБД различаются.
+//
+// Параметры:
+//   - db: открытое соединение к SQLite (*sql.DB);
+//
+// Возвращает:
+//   - error: если установка диалекта или выполнение миграций завершились с ошибкой.
+func RunSQLiteMigrations(db *sql.DB) error {
+	goose.SetBaseFS(embedSQLiteMigrations)
+
+	if err := goose.SetDialect("sqlite3"); err != nil {
+		return fmt.Errorf("set dialect: %w", err)
+	}
+
+	if err := goose.Up(db, "sqlite"); err != nil {
+		return fmt.Errorf("goose up: %w", err)
+	}
+
+	log.Println("sqlite migrations applied successfully")
+	return nil
+}
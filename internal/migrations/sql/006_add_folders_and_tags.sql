@@ -0,0 +1,35 @@
+-- +goose Up
+
+-- Папка (в открытом виде, как и заголовок) и теги (в зашифрованном виде, через запятую)
+ALTER TABLE credentials
+    ADD COLUMN IF NOT EXISTS folder TEXT NOT NULL DEFAULT '' CHECK (char_length(folder) <= 255),
+    ADD COLUMN IF NOT EXISTS tags TEXT NOT NULL DEFAULT '' CHECK (char_length(tags) <= 4096);
+
+ALTER TABLE bank_cards
+    ADD COLUMN IF NOT EXISTS folder TEXT NOT NULL DEFAULT '' CHECK (char_length(folder) <= 255),
+    ADD COLUMN IF NOT EXISTS tags TEXT NOT NULL DEFAULT '' CHECK (char_length(tags) <= 4096);
+
+ALTER TABLE text_data
+    ADD COLUMN IF NOT EXISTS folder TEXT NOT NULL DEFAULT '' CHECK (char_length(folder) <= 255),
+    ADD COLUMN IF NOT EXISTS tags TEXT NOT NULL DEFAULT '' CHECK (char_length(tags) <= 4096);
+
+ALTER TABLE binary_data
+    ADD COLUMN IF NOT EXISTS folder TEXT NOT NULL DEFAULT '' CHECK (char_length(folder) <= 255),
+    ADD COLUMN IF NOT EXISTS tags TEXT NOT NULL DEFAULT '' CHECK (char_length(tags) <= 4096);
+
+-- Индексы для быстрого отбора по папке внутри пользователя
+CREATE INDEX IF NOT EXISTS idx_credentials_user_id_folder ON credentials(user_id, folder);
+CREATE INDEX IF NOT EXISTS idx_bank_cards_user_id_folder ON bank_cards(user_id, folder);
+CREATE INDEX IF NOT EXISTS idx_text_data_user_id_folder ON text_data(user_id, folder);
+CREATE INDEX IF NOT EXISTS idx_binary_data_user_id_folder ON binary_data(user_id, folder);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_binary_data_user_id_folder;
+DROP INDEX IF EXISTS idx_text_data_user_id_folder;
+DROP INDEX IF EXISTS idx_bank_cards_user_id_folder;
+DROP INDEX IF EXISTS idx_credentials_user_id_folder;
+
+ALTER TABLE binary_data DROP COLUMN IF EXISTS tags, DROP COLUMN IF EXISTS folder;
+ALTER TABLE text_data DROP COLUMN IF EXISTS tags, DROP COLUMN IF EXISTS folder;
+ALTER TABLE bank_cards DROP COLUMN IF EXISTS tags, DROP COLUMN IF EXISTS folder;
+ALTER TABLE credentials DROP COLUMN IF EXISTS tags, DROP COLUMN IF EXISTS folder;
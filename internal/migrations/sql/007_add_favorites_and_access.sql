@@ -0,0 +1,35 @@
+-- +goose Up
+
+-- Флаг «избранное» и время последнего открытия записи
+ALTER TABLE credentials
+    ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ;
+
+ALTER TABLE bank_cards
+    ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ;
+
+ALTER TABLE text_data
+    ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ;
+
+ALTER TABLE binary_data
+    ADD COLUMN IF NOT EXISTS favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS last_accessed_at TIMESTAMPTZ;
+
+-- Индексы для выборки избранных и недавно открытых записей пользователя
+CREATE INDEX IF NOT EXISTS idx_credentials_user_id_accessed ON credentials(user_id, last_accessed_at DESC);
+CREATE INDEX IF NOT EXISTS idx_bank_cards_user_id_accessed ON bank_cards(user_id, last_accessed_at DESC);
+CREATE INDEX IF NOT EXISTS idx_text_data_user_id_accessed ON text_data(user_id, last_accessed_at DESC);
+CREATE INDEX IF NOT EXISTS idx_binary_data_user_id_accessed ON binary_data(user_id, last_accessed_at DESC);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_binary_data_user_id_accessed;
+DROP INDEX IF EXISTS idx_text_data_user_id_accessed;
+DROP INDEX IF EXISTS idx_bank_cards_user_id_accessed;
+DROP INDEX IF EXISTS idx_credentials_user_id_accessed;
+
+ALTER TABLE binary_data DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS favorite;
+ALTER TABLE text_data DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS favorite;
+ALTER TABLE bank_cards DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS favorite;
+ALTER TABLE credentials DROP COLUMN IF EXISTS last_accessed_at, DROP COLUMN IF EXISTS favorite;
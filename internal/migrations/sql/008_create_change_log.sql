@@ -0,0 +1,92 @@
+-- +goose Up
+
+-- Счётчик изменений пользователя: источник монотонно возрастающих номеров журнала.
+-- Обновление строки пользователя блокирует её до конца транзакции, поэтому
+-- изменения одного пользователя получают номера в порядке фиксации.
+ALTER TABLE users ADD COLUMN IF NOT EXISTS change_seq BIGINT NOT NULL DEFAULT 0;
+
+-- Журнал изменений: по одной строке на запись с номером её последнего изменения.
+-- Удалённые записи остаются в журнале как «надгробия» (deleted = TRUE).
+CREATE TABLE IF NOT EXISTS change_log (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    item_type TEXT NOT NULL,
+    item_id UUID NOT NULL,
+    seq BIGINT NOT NULL,
+    deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, item_type, item_id)
+);
+
+-- Индекс для выборки изменений пользователя после курсора
+CREATE UNIQUE INDEX IF NOT EXISTS idx_change_log_user_id_seq ON change_log(user_id, seq);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_item_change() RETURNS TRIGGER AS $$
+DECLARE
+    rec RECORD;
+    next_seq BIGINT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        rec := OLD;
+    ELSE
+        rec := NEW;
+    END IF;
+
+    -- Фиксация времени открытия записи не является её изменением
+    IF TG_OP = 'UPDATE' AND (to_jsonb(NEW) - 'last_accessed_at') = (to_jsonb(OLD) - 'last_accessed_at') THEN
+        RETURN NULL;
+    END IF;
+
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = rec.user_id RETURNING change_seq INTO next_seq;
+    IF next_seq IS NULL THEN
+        -- Пользователь удаляется вместе с записями — журнал не нужен
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at)
+    VALUES (rec.user_id, TG_ARGV[0], rec.id, next_seq, TG_OP = 'DELETE', NOW())
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted, changed_at = EXCLUDED.changed_at;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER credentials_change_log AFTER INSERT OR UPDATE OR DELETE ON credentials
+    FOR EACH ROW EXECUTE FUNCTION log_item_change('credential');
+CREATE TRIGGER bank_cards_change_log AFTER INSERT OR UPDATE OR DELETE ON bank_cards
+    FOR EACH ROW EXECUTE FUNCTION log_item_change('bank_card');
+CREATE TRIGGER text_data_change_log AFTER INSERT OR UPDATE OR DELETE ON text_data
+    FOR EACH ROW EXECUTE FUNCTION log_item_change('text_data');
+CREATE TRIGGER binary_data_change_log AFTER INSERT OR UPDATE OR DELETE ON binary_data
+    FOR EACH ROW EXECUTE FUNCTION log_item_change('binary_data');
+
+-- Существующие записи попадают в журнал, чтобы первая синхронизация их получила
+INSERT INTO change_log (user_id, item_type, item_id, seq, changed_at)
+SELECT user_id, item_type, id,
+       ROW_NUMBER() OVER (PARTITION BY user_id ORDER BY updated_at, id),
+       updated_at
+FROM (
+    SELECT user_id, 'credential' AS item_type, id, updated_at FROM credentials
+    UNION ALL
+    SELECT user_id, 'bank_card', id, updated_at FROM bank_cards
+    UNION ALL
+    SELECT user_id, 'text_data', id, updated_at FROM text_data
+    UNION ALL
+    SELECT user_id, 'binary_data', id, updated_at FROM binary_data
+) AS items
+ON CONFLICT DO NOTHING;
+
+UPDATE users u
+SET change_seq = COALESCE((SELECT MAX(seq) FROM change_log l WHERE l.user_id = u.id), 0);
+
+-- +goose Down
+DROP TRIGGER IF EXISTS binary_data_change_log ON binary_data;
+DROP TRIGGER IF EXISTS text_data_change_log ON text_data;
+DROP TRIGGER IF EXISTS bank_cards_change_log ON bank_cards;
+DROP TRIGGER IF EXISTS credentials_change_log ON credentials;
+DROP FUNCTION IF EXISTS log_item_change();
+DROP INDEX IF EXISTS idx_change_log_user_id_seq;
+DROP TABLE IF EXISTS change_log;
+ALTER TABLE users DROP COLUMN IF EXISTS change_seq;
@@ -0,0 +1,77 @@
+-- +goose Up
+
+-- Триггер журнала изменений дополнительно отправляет уведомление в канал item_changes.
+-- NOTIFY доставляется слушателям только после фиксации транзакции, поэтому
+-- к моменту получения уведомления изменение уже видно в журнале.
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_item_change() RETURNS TRIGGER AS $$
+DECLARE
+    rec RECORD;
+    next_seq BIGINT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        rec := OLD;
+    ELSE
+        rec := NEW;
+    END IF;
+
+    -- Фиксация времени открытия записи не является её изменением
+    IF TG_OP = 'UPDATE' AND (to_jsonb(NEW) - 'last_accessed_at') = (to_jsonb(OLD) - 'last_accessed_at') THEN
+        RETURN NULL;
+    END IF;
+
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = rec.user_id RETURNING change_seq INTO next_seq;
+    IF next_seq IS NULL THEN
+        -- Пользователь удаляется вместе с записями — журнал не нужен
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at)
+    VALUES (rec.user_id, TG_ARGV[0], rec.id, next_seq, TG_OP = 'DELETE', NOW())
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted, changed_at = EXCLUDED.changed_at;
+
+    PERFORM pg_notify('item_changes', json_build_object(
+        'user_id', rec.user_id,
+        'seq', next_seq,
+        'type', TG_ARGV[0],
+        'id', rec.id,
+        'deleted', TG_OP = 'DELETE'
+    )::text);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION log_item_change() RETURNS TRIGGER AS $$
+DECLARE
+    rec RECORD;
+    next_seq BIGINT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        rec := OLD;
+    ELSE
+        rec := NEW;
+    END IF;
+
+    IF TG_OP = 'UPDATE' AND (to_jsonb(NEW) - 'last_accessed_at') = (to_jsonb(OLD) - 'last_accessed_at') THEN
+        RETURN NULL;
+    END IF;
+
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = rec.user_id RETURNING change_seq INTO next_seq;
+    IF next_seq IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at)
+    VALUES (rec.user_id, TG_ARGV[0], rec.id, next_seq, TG_OP = 'DELETE', NOW())
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = EXCLUDED.seq, deleted = EXCLUDED.deleted, changed_at = EXCLUDED.changed_at;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
@@ -0,0 +1,14 @@
+-- +goose Up
+
+-- Версия записи для оптимистической блокировки: каждое обновление содержимого
+-- увеличивает её на единицу, а клиент передаёт в обновлении ожидаемую версию.
+ALTER TABLE credentials ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE bank_cards ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE text_data ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE binary_data DROP COLUMN IF EXISTS version;
+ALTER TABLE text_data DROP COLUMN IF EXISTS version;
+ALTER TABLE bank_cards DROP COLUMN IF EXISTS version;
+ALTER TABLE credentials DROP COLUMN IF EXISTS version;
@@ -0,0 +1,44 @@
+-- +goose Up
+
+-- Хранилище зашифрованных фрагментов файлов с подсчётом ссылок.
+-- Идентификатор фрагмента вычисляет клиент (HMAC-SHA256 открытого текста
+-- на ключе пользователя), поэтому одинаковые фрагменты одного пользователя
+-- хранятся один раз. Фрагмент с нулевым счётчиком ещё не вошёл ни в один файл.
+CREATE TABLE IF NOT EXISTS binary_chunks (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    id TEXT NOT NULL CHECK (char_length(id) = 64),
+
+    -- Путь к фрагменту в хранилище бинарных данных (локальная ФС, S3 и т.п.)
+    storage_path TEXT NOT NULL CHECK (char_length(storage_path) <= 1024),
+
+    -- Размер зашифрованного фрагмента в байтах
+    size BIGINT NOT NULL,
+
+    -- Число вхождений фрагмента в манифесты файлов
+    ref_count BIGINT NOT NULL DEFAULT 0,
+
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    PRIMARY KEY (user_id, id)
+);
+
+-- Манифест файла: упорядоченный список фрагментов
+CREATE TABLE IF NOT EXISTS binary_data_chunks (
+    binary_data_id UUID NOT NULL REFERENCES binary_data(id) ON DELETE CASCADE,
+    seq INT NOT NULL,
+    user_id UUID NOT NULL,
+    chunk_id TEXT NOT NULL,
+    PRIMARY KEY (binary_data_id, seq),
+    FOREIGN KEY (user_id, chunk_id) REFERENCES binary_chunks(user_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_binary_data_chunks_chunk ON binary_data_chunks(user_id, chunk_id);
+
+-- Файлы, содержимое которых хранится фрагментами, а не одним файлом storage_path
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS chunked BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE binary_data DROP COLUMN IF EXISTS chunked;
+DROP INDEX IF EXISTS idx_binary_data_chunks_chunk;
+DROP TABLE IF EXISTS binary_data_chunks;
+DROP TABLE IF EXISTS binary_chunks;
@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- SHA-256 содержимого в том виде, в каком оно хранится на сервере
+-- (зашифрованного), в шестнадцатеричном виде. Пустая строка — контрольная
+-- сумма не записана (файлы, загруженные до появления столбца, и файлы из
+-- фрагментов, у которых сумма хранится для каждого фрагмента).
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS checksum TEXT NOT NULL DEFAULT '';
+
+-- SHA-256 исходного содержимого файла, зашифрованная на клиенте.
+-- Сервер её не проверяет: по ней клиент проверяет расшифрованный файл.
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS plain_checksum TEXT NOT NULL DEFAULT '';
+
+-- SHA-256 зашифрованного фрагмента
+ALTER TABLE binary_chunks ADD COLUMN IF NOT EXISTS checksum TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE binary_chunks DROP COLUMN IF EXISTS checksum;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS plain_checksum;
+ALTER TABLE binary_data DROP COLUMN IF EXISTS checksum;
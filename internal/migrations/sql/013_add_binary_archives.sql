@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- Для папки, загруженной одним архивом tar, — список её файлов,
+-- зашифрованный на клиенте. Пустая строка — запись содержит обычный файл.
+ALTER TABLE binary_data ADD COLUMN IF NOT EXISTS archive TEXT NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE binary_data DROP COLUMN IF EXISTS archive;
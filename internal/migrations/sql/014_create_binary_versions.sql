@@ -0,0 +1,45 @@
+-- +goose Up
+
+-- Прежние версии содержимого бинарных данных, сохранённые при его замене.
+-- Версия хранит только содержимое и его атрибуты; метаданные записи
+-- (заголовок, папка, теги) общие для всех версий.
+CREATE TABLE IF NOT EXISTS binary_versions (
+    id UUID PRIMARY KEY,
+    binary_data_id UUID NOT NULL REFERENCES binary_data(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+
+    -- Содержимое: файл хранилища или, если chunked, манифест binary_version_chunks
+    storage_path TEXT NOT NULL DEFAULT '' CHECK (char_length(storage_path) <= 1024),
+    size BIGINT NOT NULL DEFAULT 0,
+    chunked BOOLEAN NOT NULL DEFAULT FALSE,
+    checksum TEXT NOT NULL DEFAULT '',
+    plain_checksum TEXT NOT NULL DEFAULT '',
+    archive TEXT NOT NULL DEFAULT '',
+    client_path TEXT NOT NULL DEFAULT '' CHECK (char_length(client_path) <= 1024),
+
+    -- Время последнего изменения записи до замены содержимого
+    updated_at TIMESTAMP NOT NULL,
+    -- Время замены содержимого (создания версии)
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_binary_versions_binary ON binary_versions(binary_data_id, created_at);
+
+-- Манифест версии, хранящейся фрагментами. Вхождения учитываются в
+-- binary_chunks.ref_count наравне с манифестами файлов.
+CREATE TABLE IF NOT EXISTS binary_version_chunks (
+    version_id UUID NOT NULL REFERENCES binary_versions(id) ON DELETE CASCADE,
+    seq INT NOT NULL,
+    user_id UUID NOT NULL,
+    chunk_id TEXT NOT NULL,
+    PRIMARY KEY (version_id, seq),
+    FOREIGN KEY (user_id, chunk_id) REFERENCES binary_chunks(user_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_binary_version_chunks_chunk ON binary_version_chunks(user_id, chunk_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_binary_version_chunks_chunk;
+DROP TABLE IF EXISTS binary_version_chunks;
+DROP INDEX IF EXISTS idx_binary_versions_binary;
+DROP TABLE IF EXISTS binary_versions;
@@ -0,0 +1,163 @@
+-- +goose Up
+-- Схема SQLite повторяет схему PostgreSQL (см. ../sql) на момент миграции 014.
+-- Идентификаторы UUID хранятся текстом и формируются приложением. Время
+-- хранится текстом UTC фиксированной ширины «YYYY-MM-DD HH:MM:SS.nnnnnnnnn»,
+-- поэтому сравнение строк совпадает со сравнением моментов времени.
+
+CREATE TABLE IF NOT EXISTS users (
+    id TEXT PRIMARY KEY,
+    login TEXT NOT NULL UNIQUE CHECK (length(login) <= 255),
+    password_hash TEXT NOT NULL CHECK (length(password_hash) <= 1024),
+    salt TEXT NOT NULL CHECK (length(salt) <= 255),
+    -- Счётчик изменений пользователя (см. 002_create_change_log.sql)
+    change_seq INTEGER NOT NULL DEFAULT 0
+);
+
+CREATE TABLE IF NOT EXISTS credentials (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT CHECK (length(title) <= 255),
+    login TEXT NOT NULL CHECK (length(login) <= 512),
+    password TEXT NOT NULL CHECK (length(password) <= 1024),
+    metadata TEXT CHECK (length(metadata) <= 4096),
+    folder TEXT NOT NULL DEFAULT '' CHECK (length(folder) <= 255),
+    tags TEXT NOT NULL DEFAULT '' CHECK (length(tags) <= 4096),
+    favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    last_accessed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_credentials_user_id_title ON credentials(user_id, title);
+CREATE INDEX IF NOT EXISTS idx_credentials_user_id_folder ON credentials(user_id, folder);
+CREATE INDEX IF NOT EXISTS idx_credentials_user_id_accessed ON credentials(user_id, last_accessed_at DESC);
+
+CREATE TABLE IF NOT EXISTS bank_cards (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT CHECK (length(title) <= 255),
+    cardholder_name TEXT NOT NULL CHECK (length(cardholder_name) <= 1024),
+    card_number TEXT NOT NULL CHECK (length(card_number) <= 1024),
+    expiry_date TEXT NOT NULL CHECK (length(expiry_date) <= 1024),
+    cvv TEXT NOT NULL CHECK (length(cvv) <= 1024),
+    metadata TEXT CHECK (length(metadata) <= 4096),
+    folder TEXT NOT NULL DEFAULT '' CHECK (length(folder) <= 255),
+    tags TEXT NOT NULL DEFAULT '' CHECK (length(tags) <= 4096),
+    favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    last_accessed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_bank_cards_user_id_title ON bank_cards(user_id, title);
+CREATE INDEX IF NOT EXISTS idx_bank_cards_user_id_folder ON bank_cards(user_id, folder);
+CREATE INDEX IF NOT EXISTS idx_bank_cards_user_id_accessed ON bank_cards(user_id, last_accessed_at DESC);
+
+CREATE TABLE IF NOT EXISTS text_data (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT CHECK (length(title) <= 255),
+    content BLOB NOT NULL,
+    metadata TEXT CHECK (length(metadata) <= 4096),
+    folder TEXT NOT NULL DEFAULT '' CHECK (length(folder) <= 255),
+    tags TEXT NOT NULL DEFAULT '' CHECK (length(tags) <= 4096),
+    favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    last_accessed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_text_data_user_id_title ON text_data(user_id, title);
+CREATE INDEX IF NOT EXISTS idx_text_data_user_id_folder ON text_data(user_id, folder);
+CREATE INDEX IF NOT EXISTS idx_text_data_user_id_accessed ON text_data(user_id, last_accessed_at DESC);
+
+CREATE TABLE IF NOT EXISTS binary_data (
+    id TEXT PRIMARY KEY,
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    title TEXT CHECK (length(title) <= 255),
+    client_path TEXT NOT NULL CHECK (length(client_path) <= 1024),
+    storage_path TEXT NOT NULL CHECK (length(storage_path) <= 1024),
+    size INTEGER NOT NULL DEFAULT 0,
+    chunked BOOLEAN NOT NULL DEFAULT FALSE,
+    checksum TEXT NOT NULL DEFAULT '',
+    plain_checksum TEXT NOT NULL DEFAULT '',
+    archive TEXT NOT NULL DEFAULT '',
+    metadata TEXT CHECK (length(metadata) <= 4096),
+    folder TEXT NOT NULL DEFAULT '' CHECK (length(folder) <= 255),
+    tags TEXT NOT NULL DEFAULT '' CHECK (length(tags) <= 4096),
+    favorite BOOLEAN NOT NULL DEFAULT FALSE,
+    last_accessed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1
+);
+
+CREATE INDEX IF NOT EXISTS idx_binary_data_user_id_title ON binary_data(user_id, title);
+CREATE INDEX IF NOT EXISTS idx_binary_data_user_id_folder ON binary_data(user_id, folder);
+CREATE INDEX IF NOT EXISTS idx_binary_data_user_id_accessed ON binary_data(user_id, last_accessed_at DESC);
+
+-- Фрагменты файлов со счётчиками ссылок и манифесты файлов
+CREATE TABLE IF NOT EXISTS binary_chunks (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    id TEXT NOT NULL CHECK (length(id) = 64),
+    storage_path TEXT NOT NULL CHECK (length(storage_path) <= 1024),
+    size INTEGER NOT NULL,
+    checksum TEXT NOT NULL DEFAULT '',
+    ref_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (user_id, id)
+);
+
+CREATE TABLE IF NOT EXISTS binary_data_chunks (
+    binary_data_id TEXT NOT NULL REFERENCES binary_data(id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    user_id TEXT NOT NULL,
+    chunk_id TEXT NOT NULL,
+    PRIMARY KEY (binary_data_id, seq),
+    FOREIGN KEY (user_id, chunk_id) REFERENCES binary_chunks(user_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_binary_data_chunks_chunk ON binary_data_chunks(user_id, chunk_id);
+
+-- Прежние версии содержимого бинарных данных
+CREATE TABLE IF NOT EXISTS binary_versions (
+    id TEXT PRIMARY KEY,
+    binary_data_id TEXT NOT NULL REFERENCES binary_data(id) ON DELETE CASCADE,
+    user_id TEXT NOT NULL,
+    storage_path TEXT NOT NULL DEFAULT '' CHECK (length(storage_path) <= 1024),
+    size INTEGER NOT NULL DEFAULT 0,
+    chunked BOOLEAN NOT NULL DEFAULT FALSE,
+    checksum TEXT NOT NULL DEFAULT '',
+    plain_checksum TEXT NOT NULL DEFAULT '',
+    archive TEXT NOT NULL DEFAULT '',
+    client_path TEXT NOT NULL DEFAULT '' CHECK (length(client_path) <= 1024),
+    updated_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_binary_versions_binary ON binary_versions(binary_data_id, created_at);
+
+CREATE TABLE IF NOT EXISTS binary_version_chunks (
+    version_id TEXT NOT NULL REFERENCES binary_versions(id) ON DELETE CASCADE,
+    seq INTEGER NOT NULL,
+    user_id TEXT NOT NULL,
+    chunk_id TEXT NOT NULL,
+    PRIMARY KEY (version_id, seq),
+    FOREIGN KEY (user_id, chunk_id) REFERENCES binary_chunks(user_id, id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_binary_version_chunks_chunk ON binary_version_chunks(user_id, chunk_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS binary_version_chunks;
+DROP TABLE IF EXISTS binary_versions;
+DROP TABLE IF EXISTS binary_data_chunks;
+DROP TABLE IF EXISTS binary_chunks;
+DROP TABLE IF EXISTS binary_data;
+DROP TABLE IF EXISTS text_data;
+DROP TABLE IF EXISTS bank_cards;
+DROP TABLE IF EXISTS credentials;
+DROP TABLE IF EXISTS users;
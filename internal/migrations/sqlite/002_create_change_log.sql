@@ -0,0 +1,219 @@
+-- +goose Up
+
+-- Журнал изменений: по одной строке на запись с номером её последнего изменения
+-- в последовательности пользователя (seq, см. users.change_seq). Удалённые
+-- записи остаются в журнале как «надгробия» (deleted = TRUE).
+--
+-- В SQLite нет LISTEN/NOTIFY, поэтому каждое изменение дополнительно получает
+-- номер feed_id в общей для всех пользователей последовательности: по нему
+-- сервер опрашивает журнал и рассылает уведомления подписчикам.
+CREATE TABLE IF NOT EXISTS change_log (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    item_type TEXT NOT NULL,
+    item_id TEXT NOT NULL,
+    seq INTEGER NOT NULL,
+    deleted BOOLEAN NOT NULL DEFAULT FALSE,
+    changed_at TIMESTAMP NOT NULL,
+    feed_id INTEGER NOT NULL,
+    PRIMARY KEY (user_id, item_type, item_id)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_change_log_user_id_seq ON change_log(user_id, seq);
+CREATE UNIQUE INDEX IF NOT EXISTS idx_change_log_feed_id ON change_log(feed_id);
+
+-- Триггеры журнала. Запись в SQLite сериализуется блокировкой всей базы,
+-- поэтому номера изменений выдаются в порядке фиксации. Изменение только
+-- last_accessed_at (фиксация открытия записи) в журнал не попадает.
+
+-- +goose StatementBegin
+CREATE TRIGGER credentials_change_log_insert AFTER INSERT ON credentials
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = NEW.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT NEW.user_id, 'credential', NEW.id, change_seq, FALSE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = NEW.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER credentials_change_log_update AFTER UPDATE ON credentials
+    WHEN NEW.updated_at IS NOT OLD.updated_at OR NEW.version IS NOT OLD.version
+      OR NEW.favorite IS NOT OLD.favorite
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = NEW.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT NEW.user_id, 'credential', NEW.id, change_seq, FALSE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = NEW.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER credentials_change_log_delete AFTER DELETE ON credentials
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = OLD.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT OLD.user_id, 'credential', OLD.id, change_seq, TRUE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = OLD.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER bank_cards_change_log_insert AFTER INSERT ON bank_cards
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = NEW.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT NEW.user_id, 'bank_card', NEW.id, change_seq, FALSE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = NEW.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER bank_cards_change_log_update AFTER UPDATE ON bank_cards
+    WHEN NEW.updated_at IS NOT OLD.updated_at OR NEW.version IS NOT OLD.version
+      OR NEW.favorite IS NOT OLD.favorite
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = NEW.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT NEW.user_id, 'bank_card', NEW.id, change_seq, FALSE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = NEW.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER bank_cards_change_log_delete AFTER DELETE ON bank_cards
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = OLD.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT OLD.user_id, 'bank_card', OLD.id, change_seq, TRUE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = OLD.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER text_data_change_log_insert AFTER INSERT ON text_data
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = NEW.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT NEW.user_id, 'text_data', NEW.id, change_seq, FALSE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = NEW.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER text_data_change_log_update AFTER UPDATE ON text_data
+    WHEN NEW.updated_at IS NOT OLD.updated_at OR NEW.version IS NOT OLD.version
+      OR NEW.favorite IS NOT OLD.favorite
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = NEW.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT NEW.user_id, 'text_data', NEW.id, change_seq, FALSE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = NEW.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER text_data_change_log_delete AFTER DELETE ON text_data
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = OLD.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT OLD.user_id, 'text_data', OLD.id, change_seq, TRUE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = OLD.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER binary_data_change_log_insert AFTER INSERT ON binary_data
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = NEW.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT NEW.user_id, 'binary_data', NEW.id, change_seq, FALSE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = NEW.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER binary_data_change_log_update AFTER UPDATE ON binary_data
+    WHEN NEW.updated_at IS NOT OLD.updated_at OR NEW.version IS NOT OLD.version
+      OR NEW.favorite IS NOT OLD.favorite
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = NEW.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT NEW.user_id, 'binary_data', NEW.id, change_seq, FALSE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = NEW.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER binary_data_change_log_delete AFTER DELETE ON binary_data
+BEGIN
+    UPDATE users SET change_seq = change_seq + 1 WHERE id = OLD.user_id;
+    INSERT INTO change_log (user_id, item_type, item_id, seq, deleted, changed_at, feed_id)
+    SELECT OLD.user_id, 'binary_data', OLD.id, change_seq, TRUE, strftime('%Y-%m-%d %H:%M:%f', 'now'),
+           (SELECT COALESCE(MAX(feed_id), 0) + 1 FROM change_log)
+    FROM users WHERE id = OLD.user_id
+    ON CONFLICT (user_id, item_type, item_id) DO UPDATE
+        SET seq = excluded.seq, deleted = excluded.deleted,
+            changed_at = excluded.changed_at, feed_id = excluded.feed_id;
+END;
+-- +goose StatementEnd
+
+-- +goose Down
+DROP TRIGGER IF EXISTS binary_data_change_log_delete;
+DROP TRIGGER IF EXISTS binary_data_change_log_update;
+DROP TRIGGER IF EXISTS binary_data_change_log_insert;
+DROP TRIGGER IF EXISTS text_data_change_log_delete;
+DROP TRIGGER IF EXISTS text_data_change_log_update;
+DROP TRIGGER IF EXISTS text_data_change_log_insert;
+DROP TRIGGER IF EXISTS bank_cards_change_log_delete;
+DROP TRIGGER IF EXISTS bank_cards_change_log_update;
+DROP TRIGGER IF EXISTS bank_cards_change_log_insert;
+DROP TRIGGER IF EXISTS credentials_change_log_delete;
+DROP TRIGGER IF EXISTS credentials_change_log_update;
+DROP TRIGGER IF EXISTS credentials_change_log_insert;
+DROP INDEX IF EXISTS idx_change_log_feed_id;
+DROP INDEX IF EXISTS idx_change_log_user_id_seq;
+DROP TABLE IF EXISTS change_log;
@@ -50,11 +50,18 @@ func HashPassword(password string) (hash, salt string, err error) {
 	}
 	salt = base64.StdEncoding.EncodeToString(saltBytes)
 
-	// Хешируем пароль + соль
-	hashBytes := sha256.Sum256([]byte(password + salt))
-	hash = base64.StdEncoding.EncodeToString(hashBytes[:])
+	return HashPasswordWithSalt(password, salt), salt, nil
+}
 
-	return hash, salt, nil
+// HashPasswordWithSalt вычисляет хеш пароля с заданной солью так же, как
+// HashPassword: base64 от SHA-256 строки password+salt.
+//
+// Нужна, когда соль известна заранее — например, для учётных записей из
+// файла начальных данных, записи которых зашифрованы ключом, выведенным
+// из этой соли.
+func HashPasswordWithSalt(password, salt string) string {
+	hashBytes := sha256.Sum256([]byte(password + salt))
+	return base64.StdEncoding.EncodeToString(hashBytes[:])
 }
 
 // VerifyPassword проверяет соответствие пароля переданным хешу и соли.
@@ -82,7 +89,5 @@ func HashPassword(password string) (hash, salt string, err error) {
 //	    fmt.Println("Пароль неверный")
 //	}
 func VerifyPassword(password, hash, salt string) bool {
-	hashBytes := sha256.Sum256([]byte(password + salt))
-	computedHash := base64.StdEncoding.EncodeToString(hashBytes[:])
-	return computedHash == hash
+	return HashPasswordWithSalt(password, salt) == hash
 }
@@ -34,3 +34,16 @@ func TestHashPassword(t *testing.T) {
 		t.Error("VerifyPassword returned true for incorrect password")
 	}
 }
+
+func TestHashPasswordWithSalt(t *testing.T) {
+	hash := crypto.HashPasswordWithSalt("demo", "c2FsdA==")
+	if hash != crypto.HashPasswordWithSalt("demo", "c2FsdA==") {
+		t.Error("hash is not deterministic")
+	}
+	if !crypto.VerifyPassword("demo", hash, "c2FsdA==") {
+		t.Error("VerifyPassword returned false for hash with known salt")
+	}
+	if crypto.VerifyPassword("demo", hash, "b3RoZXI=") {
+		t.Error("VerifyPassword returned true for another salt")
+	}
+}
@@ -4,6 +4,8 @@
 package mapper
 
 import (
+	"time"
+
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -20,6 +22,11 @@ func BankCardToPB(c *model.BankCard) *pb.BankCard {
 	card.SetExpiryDate(c.ExpiryDate)
 	card.SetCvv(c.CVV)
 	card.SetMetadata(c.Metadata)
+	card.SetFolder(c.Folder)
+	card.SetTags(c.Tags)
+	card.SetFavorite(c.Favorite)
+	card.SetVersion(c.Version)
+	card.SetLastAccessedAt(timeToPB(c.LastAccessedAt))
 	card.SetCreatedAt(timestamppb.New(c.CreatedAt))
 	card.SetUpdatedAt(timestamppb.New(c.UpdatedAt))
 	return card
@@ -39,6 +46,11 @@ func BankCardFromPB(pbCard *pb.BankCard) *model.BankCard {
 		ExpiryDate:     pbCard.GetExpiryDate(),
 		CVV:            pbCard.GetCvv(),
 		Metadata:       pbCard.GetMetadata(),
+		Folder:         pbCard.GetFolder(),
+		Tags:           pbCard.GetTags(),
+		Favorite:       pbCard.GetFavorite(),
+		Version:        pbCard.GetVersion(),
+		LastAccessedAt: timeFromPB(pbCard.GetLastAccessedAt()),
 		CreatedAt:      pbCard.GetCreatedAt().AsTime(),
 		UpdatedAt:      pbCard.GetUpdatedAt().AsTime(),
 	}
@@ -53,6 +65,11 @@ func CredentialToPB(c *model.Credential) *pb.Credential {
 	cred.SetLogin(c.Login)
 	cred.SetPassword(c.Password)
 	cred.SetMetadata(c.Metadata)
+	cred.SetFolder(c.Folder)
+	cred.SetTags(c.Tags)
+	cred.SetFavorite(c.Favorite)
+	cred.SetVersion(c.Version)
+	cred.SetLastAccessedAt(timeToPB(c.LastAccessedAt))
 	cred.SetCreatedAt(timestamppb.New(c.CreatedAt))
 	cred.SetUpdatedAt(timestamppb.New(c.UpdatedAt))
 	return cred
@@ -64,14 +81,19 @@ func CredentialFromPB(pbCred *pb.Credential) *model.Credential {
 		return nil
 	}
 	return &model.Credential{
-		ID:        pbCred.GetId(),
-		UserID:    pbCred.GetUserId(),
-		Title:     pbCred.GetTitle(),
-		Login:     pbCred.GetLogin(),
-		Password:  pbCred.GetPassword(),
-		Metadata:  pbCred.GetMetadata(),
-		CreatedAt: pbCred.GetCreatedAt().AsTime(),
-		UpdatedAt: pbCred.GetUpdatedAt().AsTime(),
+		ID:             pbCred.GetId(),
+		UserID:         pbCred.GetUserId(),
+		Title:          pbCred.GetTitle(),
+		Login:          pbCred.GetLogin(),
+		Password:       pbCred.GetPassword(),
+		Metadata:       pbCred.GetMetadata(),
+		Folder:         pbCred.GetFolder(),
+		Tags:           pbCred.GetTags(),
+		Favorite:       pbCred.GetFavorite(),
+		Version:        pbCred.GetVersion(),
+		LastAccessedAt: timeFromPB(pbCred.GetLastAccessedAt()),
+		CreatedAt:      pbCred.GetCreatedAt().AsTime(),
+		UpdatedAt:      pbCred.GetUpdatedAt().AsTime(),
 	}
 }
 
@@ -83,6 +105,11 @@ func TextDataToPB(td *model.TextData) *pb.TextData {
 	pbtd.SetTitle(td.Title)
 	pbtd.SetContent(td.Content)
 	pbtd.SetMetadata(td.Metadata)
+	pbtd.SetFolder(td.Folder)
+	pbtd.SetTags(td.Tags)
+	pbtd.SetFavorite(td.Favorite)
+	pbtd.SetVersion(td.Version)
+	pbtd.SetLastAccessedAt(timeToPB(td.LastAccessedAt))
 	pbtd.SetCreatedAt(timestamppb.New(td.CreatedAt))
 	pbtd.SetUpdatedAt(timestamppb.New(td.UpdatedAt))
 	return pbtd
@@ -94,13 +121,18 @@ func TextDataFromPB(pbtd *pb.TextData) *model.TextData {
 		return nil
 	}
 	return &model.TextData{
-		ID:        pbtd.GetId(),
-		UserID:    pbtd.GetUserId(),
-		Title:     pbtd.GetTitle(),
-		Content:   pbtd.GetContent(),
-		Metadata:  pbtd.GetMetadata(),
-		CreatedAt: pbtd.GetCreatedAt().AsTime(),
-		UpdatedAt: pbtd.GetUpdatedAt().AsTime(),
+		ID:             pbtd.GetId(),
+		UserID:         pbtd.GetUserId(),
+		Title:          pbtd.GetTitle(),
+		Content:        pbtd.GetContent(),
+		Metadata:       pbtd.GetMetadata(),
+		Folder:         pbtd.GetFolder(),
+		Tags:           pbtd.GetTags(),
+		Favorite:       pbtd.GetFavorite(),
+		Version:        pbtd.GetVersion(),
+		LastAccessedAt: timeFromPB(pbtd.GetLastAccessedAt()),
+		CreatedAt:      pbtd.GetCreatedAt().AsTime(),
+		UpdatedAt:      pbtd.GetUpdatedAt().AsTime(),
 	}
 }
 
@@ -114,7 +146,16 @@ func BinaryDataToPB(bd *model.BinaryData) *pb.BinaryDataInfo {
 	info.SetTitle(bd.Title)
 	info.SetMetadata(bd.Metadata)
 	info.SetSize(bd.Size)
+	info.SetChunked(bd.Chunked)
+	info.SetChecksum(bd.Checksum)
+	info.SetPlainChecksum(bd.PlainChecksum)
+	info.SetArchive(bd.Archive)
 	info.SetClientPath(bd.ClientPath)
+	info.SetFolder(bd.Folder)
+	info.SetTags(bd.Tags)
+	info.SetFavorite(bd.Favorite)
+	info.SetVersion(bd.Version)
+	info.SetLastAccessedAt(timeToPB(bd.LastAccessedAt))
 	info.SetCreatedAt(timestamppb.New(bd.CreatedAt))
 	info.SetUpdatedAt(timestamppb.New(bd.UpdatedAt))
 	return info
@@ -126,12 +167,365 @@ func BinaryDataFromPB(info *pb.BinaryDataInfo) *model.BinaryData {
 		return nil
 	}
 	return &model.BinaryData{
-		ID:         info.GetId(),
-		Title:      info.GetTitle(),
-		Metadata:   info.GetMetadata(),
-		Size:       info.GetSize(),
-		ClientPath: info.GetClientPath(),
-		CreatedAt:  info.GetCreatedAt().AsTime(),
-		UpdatedAt:  info.GetUpdatedAt().AsTime(),
+		ID:             info.GetId(),
+		Title:          info.GetTitle(),
+		Metadata:       info.GetMetadata(),
+		Size:           info.GetSize(),
+		Chunked:        info.GetChunked(),
+		Checksum:       info.GetChecksum(),
+		PlainChecksum:  info.GetPlainChecksum(),
+		Archive:        info.GetArchive(),
+		ClientPath:     info.GetClientPath(),
+		Folder:         info.GetFolder(),
+		Tags:           info.GetTags(),
+		Favorite:       info.GetFavorite(),
+		Version:        info.GetVersion(),
+		LastAccessedAt: timeFromPB(info.GetLastAccessedAt()),
+		CreatedAt:      info.GetCreatedAt().AsTime(),
+		UpdatedAt:      info.GetUpdatedAt().AsTime(),
+	}
+}
+
+// BinaryVersionToPB converts model.BinaryVersion to pb.BinaryVersionInfo.
+func BinaryVersionToPB(v *model.BinaryVersion) *pb.BinaryVersionInfo {
+	if v == nil {
+		return nil
+	}
+	info := &pb.BinaryVersionInfo{}
+	info.SetId(v.ID)
+	info.SetSize(v.Size)
+	info.SetChunked(v.Chunked)
+	info.SetChecksum(v.Checksum)
+	info.SetPlainChecksum(v.PlainChecksum)
+	info.SetArchive(v.Archive)
+	info.SetClientPath(v.ClientPath)
+	info.SetUpdatedAt(timestamppb.New(v.UpdatedAt))
+	info.SetCreatedAt(timestamppb.New(v.CreatedAt))
+	return info
+}
+
+// BinaryVersionFromPB converts pb.BinaryVersionInfo to model.BinaryVersion.
+func BinaryVersionFromPB(info *pb.BinaryVersionInfo) *model.BinaryVersion {
+	if info == nil {
+		return nil
+	}
+	return &model.BinaryVersion{
+		ID:            info.GetId(),
+		Size:          info.GetSize(),
+		Chunked:       info.GetChunked(),
+		Checksum:      info.GetChecksum(),
+		PlainChecksum: info.GetPlainChecksum(),
+		Archive:       info.GetArchive(),
+		ClientPath:    info.GetClientPath(),
+		UpdatedAt:     info.GetUpdatedAt().AsTime(),
+		CreatedAt:     info.GetCreatedAt().AsTime(),
+	}
+}
+
+// ChunkRefsToPB converts model.ChunkRef slice to pb.ChunkRef slice.
+func ChunkRefsToPB(refs []model.ChunkRef) []*pb.ChunkRef {
+	out := make([]*pb.ChunkRef, 0, len(refs))
+	for _, ref := range refs {
+		r := &pb.ChunkRef{}
+		r.SetId(ref.ID)
+		r.SetSize(ref.Size)
+		out = append(out, r)
+	}
+	return out
+}
+
+// ChunkRefsFromPB converts pb.ChunkRef slice to model.ChunkRef slice.
+func ChunkRefsFromPB(refs []*pb.ChunkRef) []model.ChunkRef {
+	out := make([]model.ChunkRef, 0, len(refs))
+	for _, ref := range refs {
+		out = append(out, model.ChunkRef{ID: ref.GetId(), Size: ref.GetSize()})
+	}
+	return out
+}
+
+// ListFilterToPB converts model.ListFilter to pb.ListFilter.
+func ListFilterToPB(f model.ListFilter) *pb.ListFilter {
+	filter := &pb.ListFilter{}
+	filter.SetFolder(f.Folder)
+	filter.SetTags(f.Tags)
+	return filter
+}
+
+// ListFilterFromPB converts pb.ListFilter to model.ListFilter.
+// A nil filter yields an empty model.ListFilter.
+func ListFilterFromPB(f *pb.ListFilter) model.ListFilter {
+	if f == nil {
+		return model.ListFilter{}
+	}
+	return model.ListFilter{
+		Folder: f.GetFolder(),
+		Tags:   f.GetTags(),
+	}
+}
+
+// sortFieldsToPB сопоставляет доменные поля сортировки с их protobuf-представлением.
+var sortFieldsToPB = map[model.SortField]pb.SortField{
+	model.SortByCreated: pb.SortField_SORT_FIELD_CREATED,
+	model.SortByUpdated: pb.SortField_SORT_FIELD_UPDATED,
+	model.SortByTitle:   pb.SortField_SORT_FIELD_TITLE,
+}
+
+// PageRequestToPB converts model.PageRequest to pb.PageRequest.
+func PageRequestToPB(p model.PageRequest) *pb.PageRequest {
+	page := &pb.PageRequest{}
+	page.SetPageSize(int32(p.Size))
+	page.SetPageToken(p.Token)
+	page.SetSortBy(sortFieldsToPB[p.SortBy])
+	page.SetDescending(p.Desc)
+	return page
+}
+
+// PageRequestFromPB converts pb.PageRequest to model.PageRequest.
+// A nil request and SORT_FIELD_UNSPECIFIED yield the server defaults.
+func PageRequestFromPB(p *pb.PageRequest) model.PageRequest {
+	if p == nil {
+		return model.PageRequest{}
+	}
+	page := model.PageRequest{
+		Size:  int(p.GetPageSize()),
+		Token: p.GetPageToken(),
+		Desc:  p.GetDescending(),
+	}
+	for k, v := range sortFieldsToPB {
+		if v == p.GetSortBy() {
+			page.SortBy = k
+		}
+	}
+	return page
+}
+
+// itemTypesToPB сопоставляет доменные типы записей с их protobuf-представлением.
+var itemTypesToPB = map[model.ItemType]pb.ItemType{
+	model.ItemTypeCredential: pb.ItemType_ITEM_TYPE_CREDENTIAL,
+	model.ItemTypeBankCard:   pb.ItemType_ITEM_TYPE_BANK_CARD,
+	model.ItemTypeTextData:   pb.ItemType_ITEM_TYPE_TEXT_DATA,
+	model.ItemTypeBinaryData: pb.ItemType_ITEM_TYPE_BINARY_DATA,
+}
+
+// ItemTypeToPB converts model.ItemType to pb.ItemType.
+// An unknown type yields ITEM_TYPE_UNSPECIFIED.
+func ItemTypeToPB(t model.ItemType) pb.ItemType {
+	return itemTypesToPB[t]
+}
+
+// ItemTypeFromPB converts pb.ItemType to model.ItemType.
+// ITEM_TYPE_UNSPECIFIED and unknown values yield an empty model.ItemType.
+func ItemTypeFromPB(t pb.ItemType) model.ItemType {
+	for k, v := range itemTypesToPB {
+		if v == t {
+			return k
+		}
+	}
+	return ""
+}
+
+// ItemSummaryToPB converts model.ItemSummary to pb.ItemSummary.
+func ItemSummaryToPB(s model.ItemSummary) *pb.ItemSummary {
+	item := &pb.ItemSummary{}
+	item.SetType(ItemTypeToPB(s.Type))
+	item.SetId(s.ID)
+	item.SetTitle(s.Title)
+	item.SetFavorite(s.Favorite)
+	item.SetLastAccessedAt(timeToPB(s.LastAccessedAt))
+	return item
+}
+
+// ItemSummaryFromPB converts pb.ItemSummary to model.ItemSummary.
+func ItemSummaryFromPB(s *pb.ItemSummary) model.ItemSummary {
+	return model.ItemSummary{
+		Type:           ItemTypeFromPB(s.GetType()),
+		ID:             s.GetId(),
+		Title:          s.GetTitle(),
+		Favorite:       s.GetFavorite(),
+		LastAccessedAt: timeFromPB(s.GetLastAccessedAt()),
+	}
+}
+
+// UsageToPB converts model.Usage to pb.GetUsageResponse.
+func UsageToPB(u *model.Usage) *pb.GetUsageResponse {
+	resp := &pb.GetUsageResponse{}
+	resp.SetBytes(u.Bytes)
+	resp.SetItems(u.Items)
+	resp.SetMaxBytes(u.Limits.MaxBytes)
+	resp.SetMaxItems(u.Limits.MaxItems)
+	return resp
+}
+
+// UsageFromPB converts pb.GetUsageResponse to model.Usage.
+func UsageFromPB(resp *pb.GetUsageResponse) *model.Usage {
+	return &model.Usage{
+		Bytes:  resp.GetBytes(),
+		Items:  resp.GetItems(),
+		Limits: model.Quota{MaxBytes: resp.GetMaxBytes(), MaxItems: resp.GetMaxItems()},
+	}
+}
+
+// ChangeToPB converts model.Change to pb.Change.
+// Item content is set only for changes that are not deletions.
+func ChangeToPB(c model.Change) *pb.Change {
+	ch := &pb.Change{}
+	ch.SetSeq(c.Seq)
+	ch.SetType(ItemTypeToPB(c.Type))
+	ch.SetId(c.ItemID)
+	ch.SetDeleted(c.Deleted)
+	ch.SetChangedAt(timestamppb.New(c.ChangedAt))
+	if c.Deleted {
+		return ch
+	}
+	switch {
+	case c.Credential != nil:
+		ch.SetCredential(CredentialToPB(c.Credential))
+	case c.BankCard != nil:
+		ch.SetBankCard(BankCardToPB(c.BankCard))
+	case c.TextData != nil:
+		ch.SetTextData(TextDataToPB(c.TextData))
+	case c.BinaryData != nil:
+		ch.SetBinaryData(BinaryDataToPB(c.BinaryData))
+	}
+	return ch
+}
+
+// ChangeFromPB converts pb.Change to model.Change.
+func ChangeFromPB(ch *pb.Change) model.Change {
+	c := model.Change{
+		Seq:       ch.GetSeq(),
+		Type:      ItemTypeFromPB(ch.GetType()),
+		ItemID:    ch.GetId(),
+		Deleted:   ch.GetDeleted(),
+		ChangedAt: ch.GetChangedAt().AsTime(),
+	}
+	switch ch.WhichItem() {
+	case pb.Change_Credential_case:
+		c.Credential = CredentialFromPB(ch.GetCredential())
+	case pb.Change_BankCard_case:
+		c.BankCard = BankCardFromPB(ch.GetBankCard())
+	case pb.Change_TextData_case:
+		c.TextData = TextDataFromPB(ch.GetTextData())
+	case pb.Change_BinaryData_case:
+		c.BinaryData = BinaryDataFromPB(ch.GetBinaryData())
+	}
+	return c
+}
+
+// ChangeEventToPB converts model.ChangeEvent to pb.ChangeEvent.
+// The owner is implied by the stream and is not transferred.
+func ChangeEventToPB(ev model.ChangeEvent) *pb.ChangeEvent {
+	out := &pb.ChangeEvent{}
+	out.SetSeq(ev.Seq)
+	out.SetType(ItemTypeToPB(ev.Type))
+	out.SetId(ev.ItemID)
+	out.SetDeleted(ev.Deleted)
+	return out
+}
+
+// ChangeEventFromPB converts pb.ChangeEvent to model.ChangeEvent.
+func ChangeEventFromPB(ev *pb.ChangeEvent) model.ChangeEvent {
+	return model.ChangeEvent{
+		Seq:     ev.GetSeq(),
+		Type:    ItemTypeFromPB(ev.GetType()),
+		ItemID:  ev.GetId(),
+		Deleted: ev.GetDeleted(),
+	}
+}
+
+// batchOpKindsToPB сопоставляет виды операций пакета с их protobuf-представлением.
+var batchOpKindsToPB = map[model.BatchOpKind]pb.BatchOperationKind{
+	model.BatchCreate: pb.BatchOperationKind_BATCH_OPERATION_KIND_CREATE,
+	model.BatchUpdate: pb.BatchOperationKind_BATCH_OPERATION_KIND_UPDATE,
+	model.BatchDelete: pb.BatchOperationKind_BATCH_OPERATION_KIND_DELETE,
+}
+
+// BatchOpToPB converts model.BatchOp to pb.BatchOperation.
+func BatchOpToPB(op model.BatchOp) *pb.BatchOperation {
+	out := &pb.BatchOperation{}
+	out.SetKind(batchOpKindsToPB[op.Kind])
+	out.SetType(ItemTypeToPB(op.Type))
+	out.SetId(op.ItemID)
+	switch {
+	case op.Credential != nil:
+		out.SetCredential(CredentialToPB(op.Credential))
+	case op.BankCard != nil:
+		out.SetBankCard(BankCardToPB(op.BankCard))
+	case op.TextData != nil:
+		out.SetTextData(TextDataToPB(op.TextData))
+	}
+	return out
+}
+
+// BatchOpFromPB converts pb.BatchOperation to model.BatchOp.
+// BATCH_OPERATION_KIND_UNSPECIFIED and unknown values yield an empty kind.
+func BatchOpFromPB(op *pb.BatchOperation) model.BatchOp {
+	out := model.BatchOp{
+		Type:   ItemTypeFromPB(op.GetType()),
+		ItemID: op.GetId(),
+	}
+	for k, v := range batchOpKindsToPB {
+		if v == op.GetKind() {
+			out.Kind = k
+		}
+	}
+	switch op.WhichItem() {
+	case pb.BatchOperation_Credential_case:
+		out.Credential = CredentialFromPB(op.GetCredential())
+	case pb.BatchOperation_BankCard_case:
+		out.BankCard = BankCardFromPB(op.GetBankCard())
+	case pb.BatchOperation_TextData_case:
+		out.TextData = TextDataFromPB(op.GetTextData())
+	}
+	return out
+}
+
+// ScrubReportToPB converts model.ScrubReport to pb.ScrubReport.
+func ScrubReportToPB(r *model.ScrubReport) *pb.ScrubReport {
+	out := &pb.ScrubReport{}
+	out.SetStartedAt(timestamppb.New(r.StartedAt))
+	out.SetFinishedAt(timestamppb.New(r.FinishedAt))
+	out.SetFix(r.Options.Fix)
+	out.SetRehash(r.Options.Rehash)
+	out.SetFilesScanned(int64(r.FilesScanned))
+	out.SetRecordsScanned(int64(r.RecordsScanned))
+	out.SetFilesRehashed(int64(r.FilesRehashed))
+	out.SetOrphanFiles(r.OrphanFiles)
+	out.SetMissingFiles(storedRefsToPB(r.MissingFiles))
+	out.SetChecksumMismatches(storedRefsToPB(r.ChecksumMismatches))
+	out.SetRemovedFiles(int64(r.RemovedFiles))
+	out.SetRemovedRecords(int64(r.RemovedRecords))
+	out.SetErrors(r.Errors)
+	return out
+}
+
+// storedRefsToPB converts model.StoredRef slice to pb.StoredRef slice.
+func storedRefsToPB(refs []model.StoredRef) []*pb.StoredRef {
+	out := make([]*pb.StoredRef, 0, len(refs))
+	for _, ref := range refs {
+		r := &pb.StoredRef{}
+		r.SetUserId(ref.UserID)
+		r.SetId(ref.ID)
+		r.SetStoragePath(ref.StoragePath)
+		r.SetChunk(ref.Chunk)
+		out = append(out, r)
+	}
+	return out
+}
+
+// timeToPB converts an optional time to a timestamp; nil stays nil.
+func timeToPB(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// timeFromPB converts an optional timestamp to time; nil stays nil.
+func timeFromPB(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
 	}
+	t := ts.AsTime()
+	return &t
 }
@@ -10,7 +10,6 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	_ "google.golang.org/protobuf/types/gofeaturespb"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	unsafe "unsafe"
@@ -23,6 +22,153 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Поле сортировки списков
+type SortField int32
+
+const (
+	SortField_SORT_FIELD_UNSPECIFIED SortField = 0 // по умолчанию: по времени создания, от новых к старым
+	SortField_SORT_FIELD_CREATED     SortField = 1
+	SortField_SORT_FIELD_UPDATED     SortField = 2
+	SortField_SORT_FIELD_TITLE       SortField = 3
+)
+
+// Enum value maps for SortField.
+var (
+	SortField_name = map[int32]string{
+		0: "SORT_FIELD_UNSPECIFIED",
+		1: "SORT_FIELD_CREATED",
+		2: "SORT_FIELD_UPDATED",
+		3: "SORT_FIELD_TITLE",
+	}
+	SortField_value = map[string]int32{
+		"SORT_FIELD_UNSPECIFIED": 0,
+		"SORT_FIELD_CREATED":     1,
+		"SORT_FIELD_UPDATED":     2,
+		"SORT_FIELD_TITLE":       3,
+	}
+)
+
+func (x SortField) Enum() *SortField {
+	p := new(SortField)
+	*p = x
+	return p
+}
+
+func (x SortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[0].Descriptor()
+}
+
+func (SortField) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[0]
+}
+
+func (x SortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Тип записи для операций, общих для всех типов
+type ItemType int32
+
+const (
+	ItemType_ITEM_TYPE_UNSPECIFIED ItemType = 0
+	ItemType_ITEM_TYPE_CREDENTIAL  ItemType = 1
+	ItemType_ITEM_TYPE_BANK_CARD   ItemType = 2
+	ItemType_ITEM_TYPE_TEXT_DATA   ItemType = 3
+	ItemType_ITEM_TYPE_BINARY_DATA ItemType = 4
+)
+
+// Enum value maps for ItemType.
+var (
+	ItemType_name = map[int32]string{
+		0: "ITEM_TYPE_UNSPECIFIED",
+		1: "ITEM_TYPE_CREDENTIAL",
+		2: "ITEM_TYPE_BANK_CARD",
+		3: "ITEM_TYPE_TEXT_DATA",
+		4: "ITEM_TYPE_BINARY_DATA",
+	}
+	ItemType_value = map[string]int32{
+		"ITEM_TYPE_UNSPECIFIED": 0,
+		"ITEM_TYPE_CREDENTIAL":  1,
+		"ITEM_TYPE_BANK_CARD":   2,
+		"ITEM_TYPE_TEXT_DATA":   3,
+		"ITEM_TYPE_BINARY_DATA": 4,
+	}
+)
+
+func (x ItemType) Enum() *ItemType {
+	p := new(ItemType)
+	*p = x
+	return p
+}
+
+func (x ItemType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ItemType) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[1].Descriptor()
+}
+
+func (ItemType) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[1]
+}
+
+func (x ItemType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Вид операции пакетного изменения записей
+type BatchOperationKind int32
+
+const (
+	BatchOperationKind_BATCH_OPERATION_KIND_UNSPECIFIED BatchOperationKind = 0
+	BatchOperationKind_BATCH_OPERATION_KIND_CREATE      BatchOperationKind = 1
+	BatchOperationKind_BATCH_OPERATION_KIND_UPDATE      BatchOperationKind = 2
+	BatchOperationKind_BATCH_OPERATION_KIND_DELETE      BatchOperationKind = 3
+)
+
+// Enum value maps for BatchOperationKind.
+var (
+	BatchOperationKind_name = map[int32]string{
+		0: "BATCH_OPERATION_KIND_UNSPECIFIED",
+		1: "BATCH_OPERATION_KIND_CREATE",
+		2: "BATCH_OPERATION_KIND_UPDATE",
+		3: "BATCH_OPERATION_KIND_DELETE",
+	}
+	BatchOperationKind_value = map[string]int32{
+		"BATCH_OPERATION_KIND_UNSPECIFIED": 0,
+		"BATCH_OPERATION_KIND_CREATE":      1,
+		"BATCH_OPERATION_KIND_UPDATE":      2,
+		"BATCH_OPERATION_KIND_DELETE":      3,
+	}
+)
+
+func (x BatchOperationKind) Enum() *BatchOperationKind {
+	p := new(BatchOperationKind)
+	*p = x
+	return p
+}
+
+func (x BatchOperationKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BatchOperationKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_proto_enumTypes[2].Descriptor()
+}
+
+func (BatchOperationKind) Type() protoreflect.EnumType {
+	return &file_api_proto_enumTypes[2]
+}
+
+func (x BatchOperationKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
 // Запрос на регистрацию
 type RegisterRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
@@ -438,37 +584,31 @@ func (b0 LoginResponse_builder) Build() *LoginResponse {
 	return m0
 }
 
-// Сообщения для Credential
-type Credential struct {
+// Фильтр списков по папке и тегам (пустые значения — без ограничений)
+type ListFilter struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_UserId      *string                `protobuf:"bytes,2,opt,name=user_id,json=userId"`
-	xxx_hidden_Title       *string                `protobuf:"bytes,3,opt,name=title"`
-	xxx_hidden_Login       *string                `protobuf:"bytes,4,opt,name=login"`
-	xxx_hidden_Password    *string                `protobuf:"bytes,5,opt,name=password"`
-	xxx_hidden_Metadata    *string                `protobuf:"bytes,6,opt,name=metadata"`
-	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt"`
-	xxx_hidden_UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_Folder      *string                `protobuf:"bytes,1,opt,name=folder"`
+	xxx_hidden_Tags        []string               `protobuf:"bytes,2,rep,name=tags"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *Credential) Reset() {
-	*x = Credential{}
+func (x *ListFilter) Reset() {
+	*x = ListFilter{}
 	mi := &file_api_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Credential) String() string {
+func (x *ListFilter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Credential) ProtoMessage() {}
+func (*ListFilter) ProtoMessage() {}
 
-func (x *Credential) ProtoReflect() protoreflect.Message {
+func (x *ListFilter) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -480,280 +620,6172 @@ func (x *Credential) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *Credential) GetId() string {
+func (x *ListFilter) GetFolder() string {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
+		if x.xxx_hidden_Folder != nil {
+			return *x.xxx_hidden_Folder
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *Credential) GetUserId() string {
+func (x *ListFilter) GetTags() []string {
 	if x != nil {
-		if x.xxx_hidden_UserId != nil {
-			return *x.xxx_hidden_UserId
-		}
-		return ""
+		return x.xxx_hidden_Tags
 	}
-	return ""
+	return nil
 }
 
-func (x *Credential) GetTitle() string {
-	if x != nil {
-		if x.xxx_hidden_Title != nil {
-			return *x.xxx_hidden_Title
-		}
-		return ""
+func (x *ListFilter) SetFolder(v string) {
+	x.xxx_hidden_Folder = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListFilter) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *ListFilter) HasFolder() bool {
+	if x == nil {
+		return false
 	}
-	return ""
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *Credential) GetLogin() string {
+func (x *ListFilter) ClearFolder() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Folder = nil
+}
+
+type ListFilter_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Folder *string
+	Tags   []string
+}
+
+func (b0 ListFilter_builder) Build() *ListFilter {
+	m0 := &ListFilter{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Folder != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Folder = b.Folder
+	}
+	x.xxx_hidden_Tags = b.Tags
+	return m0
+}
+
+// Параметры курсорной пагинации списков
+type PageRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_PageSize    int32                  `protobuf:"varint,1,opt,name=page_size,json=pageSize"`
+	xxx_hidden_PageToken   *string                `protobuf:"bytes,2,opt,name=page_token,json=pageToken"`
+	xxx_hidden_SortBy      SortField              `protobuf:"varint,3,opt,name=sort_by,json=sortBy,enum=gophkeeper.proto.SortField"`
+	xxx_hidden_Descending  bool                   `protobuf:"varint,4,opt,name=descending"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *PageRequest) Reset() {
+	*x = PageRequest{}
+	mi := &file_api_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PageRequest) ProtoMessage() {}
+
+func (x *PageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[5]
 	if x != nil {
-		if x.xxx_hidden_Login != nil {
-			return *x.xxx_hidden_Login
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return ""
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Credential) GetPassword() string {
+func (x *PageRequest) GetPageSize() int32 {
 	if x != nil {
-		if x.xxx_hidden_Password != nil {
-			return *x.xxx_hidden_Password
-		}
-		return ""
+		return x.xxx_hidden_PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *Credential) GetMetadata() string {
+func (x *PageRequest) GetPageToken() string {
 	if x != nil {
-		if x.xxx_hidden_Metadata != nil {
-			return *x.xxx_hidden_Metadata
+		if x.xxx_hidden_PageToken != nil {
+			return *x.xxx_hidden_PageToken
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *Credential) GetCreatedAt() *timestamppb.Timestamp {
+func (x *PageRequest) GetSortBy() SortField {
 	if x != nil {
-		return x.xxx_hidden_CreatedAt
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 2) {
+			return x.xxx_hidden_SortBy
+		}
 	}
-	return nil
+	return SortField_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *Credential) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *PageRequest) GetDescending() bool {
 	if x != nil {
-		return x.xxx_hidden_UpdatedAt
+		return x.xxx_hidden_Descending
 	}
-	return nil
-}
-
-func (x *Credential) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 8)
-}
-
-func (x *Credential) SetUserId(v string) {
-	x.xxx_hidden_UserId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 8)
-}
-
-func (x *Credential) SetTitle(v string) {
-	x.xxx_hidden_Title = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 8)
-}
-
-func (x *Credential) SetLogin(v string) {
-	x.xxx_hidden_Login = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 8)
+	return false
 }
 
-func (x *Credential) SetPassword(v string) {
-	x.xxx_hidden_Password = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 8)
+func (x *PageRequest) SetPageSize(v int32) {
+	x.xxx_hidden_PageSize = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
-func (x *Credential) SetMetadata(v string) {
-	x.xxx_hidden_Metadata = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 8)
+func (x *PageRequest) SetPageToken(v string) {
+	x.xxx_hidden_PageToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
-func (x *Credential) SetCreatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_CreatedAt = v
+func (x *PageRequest) SetSortBy(v SortField) {
+	x.xxx_hidden_SortBy = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
 }
 
-func (x *Credential) SetUpdatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_UpdatedAt = v
+func (x *PageRequest) SetDescending(v bool) {
+	x.xxx_hidden_Descending = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
 }
 
-func (x *Credential) HasId() bool {
+func (x *PageRequest) HasPageSize() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *Credential) HasUserId() bool {
+func (x *PageRequest) HasPageToken() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *Credential) HasTitle() bool {
+func (x *PageRequest) HasSortBy() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *Credential) HasLogin() bool {
+func (x *PageRequest) HasDescending() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *Credential) HasPassword() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
-}
-
-func (x *Credential) HasMetadata() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
-}
-
-func (x *Credential) HasCreatedAt() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_CreatedAt != nil
-}
-
-func (x *Credential) HasUpdatedAt() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_UpdatedAt != nil
-}
-
-func (x *Credential) ClearId() {
+func (x *PageRequest) ClearPageSize() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_PageSize = 0
 }
 
-func (x *Credential) ClearUserId() {
+func (x *PageRequest) ClearPageToken() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_UserId = nil
+	x.xxx_hidden_PageToken = nil
 }
 
-func (x *Credential) ClearTitle() {
+func (x *PageRequest) ClearSortBy() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Title = nil
+	x.xxx_hidden_SortBy = SortField_SORT_FIELD_UNSPECIFIED
 }
 
-func (x *Credential) ClearLogin() {
+func (x *PageRequest) ClearDescending() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_Login = nil
-}
-
-func (x *Credential) ClearPassword() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_Password = nil
-}
-
-func (x *Credential) ClearMetadata() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_Metadata = nil
-}
-
-func (x *Credential) ClearCreatedAt() {
-	x.xxx_hidden_CreatedAt = nil
-}
-
-func (x *Credential) ClearUpdatedAt() {
-	x.xxx_hidden_UpdatedAt = nil
+	x.xxx_hidden_Descending = false
 }
 
-type Credential_builder struct {
+type PageRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id        *string
-	UserId    *string
-	Title     *string
-	Login     *string
-	Password  *string
-	Metadata  *string
-	CreatedAt *timestamppb.Timestamp
-	UpdatedAt *timestamppb.Timestamp
+	PageSize   *int32
+	PageToken  *string
+	SortBy     *SortField
+	Descending *bool
 }
 
-func (b0 Credential_builder) Build() *Credential {
-	m0 := &Credential{}
+func (b0 PageRequest_builder) Build() *PageRequest {
+	m0 := &PageRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 8)
-		x.xxx_hidden_Id = b.Id
-	}
-	if b.UserId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 8)
-		x.xxx_hidden_UserId = b.UserId
+	if b.PageSize != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_PageSize = *b.PageSize
 	}
-	if b.Title != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 8)
-		x.xxx_hidden_Title = b.Title
-	}
-	if b.Login != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 8)
-		x.xxx_hidden_Login = b.Login
+	if b.PageToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_PageToken = b.PageToken
 	}
-	if b.Password != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 8)
-		x.xxx_hidden_Password = b.Password
+	if b.SortBy != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_SortBy = *b.SortBy
 	}
-	if b.Metadata != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 8)
-		x.xxx_hidden_Metadata = b.Metadata
+	if b.Descending != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Descending = *b.Descending
 	}
-	x.xxx_hidden_CreatedAt = b.CreatedAt
-	x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	return m0
 }
 
-type CreateCredentialRequest struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
-}
-
-func (x *CreateCredentialRequest) Reset() {
-	*x = CreateCredentialRequest{}
-	mi := &file_api_proto_msgTypes[5]
+// Сообщения для Credential
+type Credential struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id             *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_UserId         *string                `protobuf:"bytes,2,opt,name=user_id,json=userId"`
+	xxx_hidden_Title          *string                `protobuf:"bytes,3,opt,name=title"`
+	xxx_hidden_Login          *string                `protobuf:"bytes,4,opt,name=login"`
+	xxx_hidden_Password       *string                `protobuf:"bytes,5,opt,name=password"`
+	xxx_hidden_Metadata       *string                `protobuf:"bytes,6,opt,name=metadata"`
+	xxx_hidden_CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_Folder         *string                `protobuf:"bytes,9,opt,name=folder"`
+	xxx_hidden_Tags           []string               `protobuf:"bytes,10,rep,name=tags"`
+	xxx_hidden_Favorite       bool                   `protobuf:"varint,11,opt,name=favorite"`
+	xxx_hidden_LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_accessed_at,json=lastAccessedAt"`
+	xxx_hidden_Version        int64                  `protobuf:"varint,13,opt,name=version"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *Credential) Reset() {
+	*x = Credential{}
+	mi := &file_api_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Credential) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Credential) ProtoMessage() {}
+
+func (x *Credential) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *Credential) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Credential) GetUserId() string {
+	if x != nil {
+		if x.xxx_hidden_UserId != nil {
+			return *x.xxx_hidden_UserId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Credential) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Credential) GetLogin() string {
+	if x != nil {
+		if x.xxx_hidden_Login != nil {
+			return *x.xxx_hidden_Login
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Credential) GetPassword() string {
+	if x != nil {
+		if x.xxx_hidden_Password != nil {
+			return *x.xxx_hidden_Password
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Credential) GetMetadata() string {
+	if x != nil {
+		if x.xxx_hidden_Metadata != nil {
+			return *x.xxx_hidden_Metadata
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Credential) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *Credential) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *Credential) GetFolder() string {
+	if x != nil {
+		if x.xxx_hidden_Folder != nil {
+			return *x.xxx_hidden_Folder
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *Credential) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *Credential) GetFavorite() bool {
+	if x != nil {
+		return x.xxx_hidden_Favorite
+	}
+	return false
+}
+
+func (x *Credential) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastAccessedAt
+	}
+	return nil
+}
+
+func (x *Credential) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *Credential) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 13)
+}
+
+func (x *Credential) SetUserId(v string) {
+	x.xxx_hidden_UserId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 13)
+}
+
+func (x *Credential) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 13)
+}
+
+func (x *Credential) SetLogin(v string) {
+	x.xxx_hidden_Login = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 13)
+}
+
+func (x *Credential) SetPassword(v string) {
+	x.xxx_hidden_Password = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 13)
+}
+
+func (x *Credential) SetMetadata(v string) {
+	x.xxx_hidden_Metadata = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 13)
+}
+
+func (x *Credential) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *Credential) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *Credential) SetFolder(v string) {
+	x.xxx_hidden_Folder = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 8, 13)
+}
+
+func (x *Credential) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *Credential) SetFavorite(v bool) {
+	x.xxx_hidden_Favorite = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 13)
+}
+
+func (x *Credential) SetLastAccessedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastAccessedAt = v
+}
+
+func (x *Credential) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 13)
+}
+
+func (x *Credential) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *Credential) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *Credential) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *Credential) HasLogin() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *Credential) HasPassword() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *Credential) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *Credential) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *Credential) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *Credential) HasFolder() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 8)
+}
+
+func (x *Credential) HasFavorite() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *Credential) HasLastAccessedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastAccessedAt != nil
+}
+
+func (x *Credential) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *Credential) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *Credential) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UserId = nil
+}
+
+func (x *Credential) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *Credential) ClearLogin() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Login = nil
+}
+
+func (x *Credential) ClearPassword() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Password = nil
+}
+
+func (x *Credential) ClearMetadata() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Metadata = nil
+}
+
+func (x *Credential) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *Credential) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *Credential) ClearFolder() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 8)
+	x.xxx_hidden_Folder = nil
+}
+
+func (x *Credential) ClearFavorite() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Favorite = false
+}
+
+func (x *Credential) ClearLastAccessedAt() {
+	x.xxx_hidden_LastAccessedAt = nil
+}
+
+func (x *Credential) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_Version = 0
+}
+
+type Credential_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id             *string
+	UserId         *string
+	Title          *string
+	Login          *string
+	Password       *string
+	Metadata       *string
+	CreatedAt      *timestamppb.Timestamp
+	UpdatedAt      *timestamppb.Timestamp
+	Folder         *string
+	Tags           []string
+	Favorite       *bool
+	LastAccessedAt *timestamppb.Timestamp
+	Version        *int64
+}
+
+func (b0 Credential_builder) Build() *Credential {
+	m0 := &Credential{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 13)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 13)
+		x.xxx_hidden_UserId = b.UserId
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 13)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.Login != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 13)
+		x.xxx_hidden_Login = b.Login
+	}
+	if b.Password != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 13)
+		x.xxx_hidden_Password = b.Password
+	}
+	if b.Metadata != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 13)
+		x.xxx_hidden_Metadata = b.Metadata
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	if b.Folder != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 8, 13)
+		x.xxx_hidden_Folder = b.Folder
+	}
+	x.xxx_hidden_Tags = b.Tags
+	if b.Favorite != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 13)
+		x.xxx_hidden_Favorite = *b.Favorite
+	}
+	x.xxx_hidden_LastAccessedAt = b.LastAccessedAt
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 13)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+type CreateCredentialRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateCredentialRequest) Reset() {
+	*x = CreateCredentialRequest{}
+	mi := &file_api_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCredentialRequest) ProtoMessage() {}
+
+func (x *CreateCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateCredentialRequest) GetCredential() *Credential {
+	if x != nil {
+		return x.xxx_hidden_Credential
+	}
+	return nil
+}
+
+func (x *CreateCredentialRequest) SetCredential(v *Credential) {
+	x.xxx_hidden_Credential = v
+}
+
+func (x *CreateCredentialRequest) HasCredential() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Credential != nil
+}
+
+func (x *CreateCredentialRequest) ClearCredential() {
+	x.xxx_hidden_Credential = nil
+}
+
+type CreateCredentialRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Credential *Credential
+}
+
+func (b0 CreateCredentialRequest_builder) Build() *CreateCredentialRequest {
+	m0 := &CreateCredentialRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Credential = b.Credential
+	return m0
+}
+
+type CreateCredentialResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CreateCredentialResponse) Reset() {
+	*x = CreateCredentialResponse{}
+	mi := &file_api_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCredentialResponse) ProtoMessage() {}
+
+func (x *CreateCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateCredentialResponse) GetCredential() *Credential {
+	if x != nil {
+		return x.xxx_hidden_Credential
+	}
+	return nil
+}
+
+func (x *CreateCredentialResponse) SetCredential(v *Credential) {
+	x.xxx_hidden_Credential = v
+}
+
+func (x *CreateCredentialResponse) HasCredential() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Credential != nil
+}
+
+func (x *CreateCredentialResponse) ClearCredential() {
+	x.xxx_hidden_Credential = nil
+}
+
+type CreateCredentialResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Credential *Credential
+}
+
+func (b0 CreateCredentialResponse_builder) Build() *CreateCredentialResponse {
+	m0 := &CreateCredentialResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Credential = b.Credential
+	return m0
+}
+
+type GetCredentialByIDRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetCredentialByIDRequest) Reset() {
+	*x = GetCredentialByIDRequest{}
+	mi := &file_api_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialByIDRequest) ProtoMessage() {}
+
+func (x *GetCredentialByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCredentialByIDRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetCredentialByIDRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetCredentialByIDRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetCredentialByIDRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type GetCredentialByIDRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 GetCredentialByIDRequest_builder) Build() *GetCredentialByIDRequest {
+	m0 := &GetCredentialByIDRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type GetCredentialByIDResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *GetCredentialByIDResponse) Reset() {
+	*x = GetCredentialByIDResponse{}
+	mi := &file_api_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialByIDResponse) ProtoMessage() {}
+
+func (x *GetCredentialByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCredentialByIDResponse) GetCredential() *Credential {
+	if x != nil {
+		return x.xxx_hidden_Credential
+	}
+	return nil
+}
+
+func (x *GetCredentialByIDResponse) SetCredential(v *Credential) {
+	x.xxx_hidden_Credential = v
+}
+
+func (x *GetCredentialByIDResponse) HasCredential() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Credential != nil
+}
+
+func (x *GetCredentialByIDResponse) ClearCredential() {
+	x.xxx_hidden_Credential = nil
+}
+
+type GetCredentialByIDResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Credential *Credential
+}
+
+func (b0 GetCredentialByIDResponse_builder) Build() *GetCredentialByIDResponse {
+	m0 := &GetCredentialByIDResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Credential = b.Credential
+	return m0
+}
+
+type GetCredentialsRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Filter *ListFilter            `protobuf:"bytes,1,opt,name=filter"`
+	xxx_hidden_Page   *PageRequest           `protobuf:"bytes,2,opt,name=page"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCredentialsRequest) Reset() {
+	*x = GetCredentialsRequest{}
+	mi := &file_api_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialsRequest) ProtoMessage() {}
+
+func (x *GetCredentialsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCredentialsRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.xxx_hidden_Filter
+	}
+	return nil
+}
+
+func (x *GetCredentialsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.xxx_hidden_Page
+	}
+	return nil
+}
+
+func (x *GetCredentialsRequest) SetFilter(v *ListFilter) {
+	x.xxx_hidden_Filter = v
+}
+
+func (x *GetCredentialsRequest) SetPage(v *PageRequest) {
+	x.xxx_hidden_Page = v
+}
+
+func (x *GetCredentialsRequest) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Filter != nil
+}
+
+func (x *GetCredentialsRequest) HasPage() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Page != nil
+}
+
+func (x *GetCredentialsRequest) ClearFilter() {
+	x.xxx_hidden_Filter = nil
+}
+
+func (x *GetCredentialsRequest) ClearPage() {
+	x.xxx_hidden_Page = nil
+}
+
+type GetCredentialsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Filter *ListFilter
+	Page   *PageRequest
+}
+
+func (b0 GetCredentialsRequest_builder) Build() *GetCredentialsRequest {
+	m0 := &GetCredentialsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Filter = b.Filter
+	x.xxx_hidden_Page = b.Page
+	return m0
+}
+
+type GetCredentialsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Credentials   *[]*Credential         `protobuf:"bytes,1,rep,name=credentials"`
+	xxx_hidden_NextPageToken *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetCredentialsResponse) Reset() {
+	*x = GetCredentialsResponse{}
+	mi := &file_api_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCredentialsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCredentialsResponse) ProtoMessage() {}
+
+func (x *GetCredentialsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetCredentialsResponse) GetCredentials() []*Credential {
+	if x != nil {
+		if x.xxx_hidden_Credentials != nil {
+			return *x.xxx_hidden_Credentials
+		}
+	}
+	return nil
+}
+
+func (x *GetCredentialsResponse) GetNextPageToken() string {
+	if x != nil {
+		if x.xxx_hidden_NextPageToken != nil {
+			return *x.xxx_hidden_NextPageToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetCredentialsResponse) SetCredentials(v []*Credential) {
+	x.xxx_hidden_Credentials = &v
+}
+
+func (x *GetCredentialsResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetCredentialsResponse) HasNextPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetCredentialsResponse) ClearNextPageToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NextPageToken = nil
+}
+
+type GetCredentialsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Credentials   []*Credential
+	NextPageToken *string
+}
+
+func (b0 GetCredentialsResponse_builder) Build() *GetCredentialsResponse {
+	m0 := &GetCredentialsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Credentials = &b.Credentials
+	if b.NextPageToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NextPageToken = b.NextPageToken
+	}
+	return m0
+}
+
+type UpdateCredentialRequest struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *UpdateCredentialRequest) Reset() {
+	*x = UpdateCredentialRequest{}
+	mi := &file_api_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCredentialRequest) ProtoMessage() {}
+
+func (x *UpdateCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateCredentialRequest) GetCredential() *Credential {
+	if x != nil {
+		return x.xxx_hidden_Credential
+	}
+	return nil
+}
+
+func (x *UpdateCredentialRequest) SetCredential(v *Credential) {
+	x.xxx_hidden_Credential = v
+}
+
+func (x *UpdateCredentialRequest) HasCredential() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Credential != nil
+}
+
+func (x *UpdateCredentialRequest) ClearCredential() {
+	x.xxx_hidden_Credential = nil
+}
+
+type UpdateCredentialRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Credential *Credential
+}
+
+func (b0 UpdateCredentialRequest_builder) Build() *UpdateCredentialRequest {
+	m0 := &UpdateCredentialRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Credential = b.Credential
+	return m0
+}
+
+type UpdateCredentialResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Credential  *Credential            `protobuf:"bytes,1,opt,name=credential"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,2,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateCredentialResponse) Reset() {
+	*x = UpdateCredentialResponse{}
+	mi := &file_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCredentialResponse) ProtoMessage() {}
+
+func (x *UpdateCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateCredentialResponse) GetCredential() *Credential {
+	if x != nil {
+		return x.xxx_hidden_Credential
+	}
+	return nil
+}
+
+func (x *UpdateCredentialResponse) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *UpdateCredentialResponse) SetCredential(v *Credential) {
+	x.xxx_hidden_Credential = v
+}
+
+func (x *UpdateCredentialResponse) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UpdateCredentialResponse) HasCredential() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Credential != nil
+}
+
+func (x *UpdateCredentialResponse) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateCredentialResponse) ClearCredential() {
+	x.xxx_hidden_Credential = nil
+}
+
+func (x *UpdateCredentialResponse) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+type UpdateCredentialResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Credential *Credential
+	Version    *int64
+}
+
+func (b0 UpdateCredentialResponse_builder) Build() *UpdateCredentialResponse {
+	m0 := &UpdateCredentialResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Credential = b.Credential
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+type DeleteCredentialRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteCredentialRequest) Reset() {
+	*x = DeleteCredentialRequest{}
+	mi := &file_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCredentialRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCredentialRequest) ProtoMessage() {}
+
+func (x *DeleteCredentialRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteCredentialRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteCredentialRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteCredentialRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteCredentialRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type DeleteCredentialRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 DeleteCredentialRequest_builder) Build() *DeleteCredentialRequest {
+	m0 := &DeleteCredentialRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type DeleteCredentialResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteCredentialResponse) Reset() {
+	*x = DeleteCredentialResponse{}
+	mi := &file_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCredentialResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCredentialResponse) ProtoMessage() {}
+
+func (x *DeleteCredentialResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteCredentialResponse) GetSuccess() bool {
+	if x != nil {
+		return x.xxx_hidden_Success
+	}
+	return false
+}
+
+func (x *DeleteCredentialResponse) SetSuccess(v bool) {
+	x.xxx_hidden_Success = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteCredentialResponse) HasSuccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteCredentialResponse) ClearSuccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Success = false
+}
+
+type DeleteCredentialResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Success *bool
+}
+
+func (b0 DeleteCredentialResponse_builder) Build() *DeleteCredentialResponse {
+	m0 := &DeleteCredentialResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Success != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Success = *b.Success
+	}
+	return m0
+}
+
+// Сообщения для BankCard
+type BankCard struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id             *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_UserId         *string                `protobuf:"bytes,2,opt,name=user_id,json=userId"`
+	xxx_hidden_Title          *string                `protobuf:"bytes,3,opt,name=title"`
+	xxx_hidden_CardholderName *string                `protobuf:"bytes,4,opt,name=cardholder_name,json=cardholderName"`
+	xxx_hidden_CardNumber     *string                `protobuf:"bytes,5,opt,name=card_number,json=cardNumber"`
+	xxx_hidden_ExpiryDate     *string                `protobuf:"bytes,6,opt,name=expiry_date,json=expiryDate"`
+	xxx_hidden_Cvv            *string                `protobuf:"bytes,7,opt,name=cvv"`
+	xxx_hidden_Metadata       *string                `protobuf:"bytes,8,opt,name=metadata"`
+	xxx_hidden_CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_Folder         *string                `protobuf:"bytes,11,opt,name=folder"`
+	xxx_hidden_Tags           []string               `protobuf:"bytes,12,rep,name=tags"`
+	xxx_hidden_Favorite       bool                   `protobuf:"varint,13,opt,name=favorite"`
+	xxx_hidden_LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_accessed_at,json=lastAccessedAt"`
+	xxx_hidden_Version        int64                  `protobuf:"varint,15,opt,name=version"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *BankCard) Reset() {
+	*x = BankCard{}
+	mi := &file_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BankCard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BankCard) ProtoMessage() {}
+
+func (x *BankCard) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BankCard) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetUserId() string {
+	if x != nil {
+		if x.xxx_hidden_UserId != nil {
+			return *x.xxx_hidden_UserId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetCardholderName() string {
+	if x != nil {
+		if x.xxx_hidden_CardholderName != nil {
+			return *x.xxx_hidden_CardholderName
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetCardNumber() string {
+	if x != nil {
+		if x.xxx_hidden_CardNumber != nil {
+			return *x.xxx_hidden_CardNumber
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetExpiryDate() string {
+	if x != nil {
+		if x.xxx_hidden_ExpiryDate != nil {
+			return *x.xxx_hidden_ExpiryDate
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetCvv() string {
+	if x != nil {
+		if x.xxx_hidden_Cvv != nil {
+			return *x.xxx_hidden_Cvv
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetMetadata() string {
+	if x != nil {
+		if x.xxx_hidden_Metadata != nil {
+			return *x.xxx_hidden_Metadata
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *BankCard) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *BankCard) GetFolder() string {
+	if x != nil {
+		if x.xxx_hidden_Folder != nil {
+			return *x.xxx_hidden_Folder
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BankCard) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *BankCard) GetFavorite() bool {
+	if x != nil {
+		return x.xxx_hidden_Favorite
+	}
+	return false
+}
+
+func (x *BankCard) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastAccessedAt
+	}
+	return nil
+}
+
+func (x *BankCard) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *BankCard) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 15)
+}
+
+func (x *BankCard) SetUserId(v string) {
+	x.xxx_hidden_UserId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 15)
+}
+
+func (x *BankCard) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 15)
+}
+
+func (x *BankCard) SetCardholderName(v string) {
+	x.xxx_hidden_CardholderName = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 15)
+}
+
+func (x *BankCard) SetCardNumber(v string) {
+	x.xxx_hidden_CardNumber = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 15)
+}
+
+func (x *BankCard) SetExpiryDate(v string) {
+	x.xxx_hidden_ExpiryDate = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 15)
+}
+
+func (x *BankCard) SetCvv(v string) {
+	x.xxx_hidden_Cvv = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 15)
+}
+
+func (x *BankCard) SetMetadata(v string) {
+	x.xxx_hidden_Metadata = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 15)
+}
+
+func (x *BankCard) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *BankCard) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *BankCard) SetFolder(v string) {
+	x.xxx_hidden_Folder = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 15)
+}
+
+func (x *BankCard) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *BankCard) SetFavorite(v bool) {
+	x.xxx_hidden_Favorite = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 15)
+}
+
+func (x *BankCard) SetLastAccessedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastAccessedAt = v
+}
+
+func (x *BankCard) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 15)
+}
+
+func (x *BankCard) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *BankCard) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *BankCard) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *BankCard) HasCardholderName() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *BankCard) HasCardNumber() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *BankCard) HasExpiryDate() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
+
+func (x *BankCard) HasCvv() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+}
+
+func (x *BankCard) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *BankCard) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *BankCard) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *BankCard) HasFolder() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
+}
+
+func (x *BankCard) HasFavorite() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *BankCard) HasLastAccessedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastAccessedAt != nil
+}
+
+func (x *BankCard) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *BankCard) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *BankCard) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UserId = nil
+}
+
+func (x *BankCard) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *BankCard) ClearCardholderName() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_CardholderName = nil
+}
+
+func (x *BankCard) ClearCardNumber() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_CardNumber = nil
+}
+
+func (x *BankCard) ClearExpiryDate() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_ExpiryDate = nil
+}
+
+func (x *BankCard) ClearCvv() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_Cvv = nil
+}
+
+func (x *BankCard) ClearMetadata() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Metadata = nil
+}
+
+func (x *BankCard) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *BankCard) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *BankCard) ClearFolder() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_Folder = nil
+}
+
+func (x *BankCard) ClearFavorite() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_Favorite = false
+}
+
+func (x *BankCard) ClearLastAccessedAt() {
+	x.xxx_hidden_LastAccessedAt = nil
+}
+
+func (x *BankCard) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_Version = 0
+}
+
+type BankCard_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id             *string
+	UserId         *string
+	Title          *string
+	CardholderName *string
+	CardNumber     *string
+	ExpiryDate     *string
+	Cvv            *string
+	Metadata       *string
+	CreatedAt      *timestamppb.Timestamp
+	UpdatedAt      *timestamppb.Timestamp
+	Folder         *string
+	Tags           []string
+	Favorite       *bool
+	LastAccessedAt *timestamppb.Timestamp
+	Version        *int64
+}
+
+func (b0 BankCard_builder) Build() *BankCard {
+	m0 := &BankCard{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 15)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 15)
+		x.xxx_hidden_UserId = b.UserId
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 15)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.CardholderName != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 15)
+		x.xxx_hidden_CardholderName = b.CardholderName
+	}
+	if b.CardNumber != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 15)
+		x.xxx_hidden_CardNumber = b.CardNumber
+	}
+	if b.ExpiryDate != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 15)
+		x.xxx_hidden_ExpiryDate = b.ExpiryDate
+	}
+	if b.Cvv != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 15)
+		x.xxx_hidden_Cvv = b.Cvv
+	}
+	if b.Metadata != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 15)
+		x.xxx_hidden_Metadata = b.Metadata
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	if b.Folder != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 15)
+		x.xxx_hidden_Folder = b.Folder
+	}
+	x.xxx_hidden_Tags = b.Tags
+	if b.Favorite != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 15)
+		x.xxx_hidden_Favorite = *b.Favorite
+	}
+	x.xxx_hidden_LastAccessedAt = b.LastAccessedAt
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 15)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+type CreateBankCardRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateBankCardRequest) Reset() {
+	*x = CreateBankCardRequest{}
+	mi := &file_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBankCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBankCardRequest) ProtoMessage() {}
+
+func (x *CreateBankCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateBankCardRequest) GetBankCard() *BankCard {
+	if x != nil {
+		return x.xxx_hidden_BankCard
+	}
+	return nil
+}
+
+func (x *CreateBankCardRequest) SetBankCard(v *BankCard) {
+	x.xxx_hidden_BankCard = v
+}
+
+func (x *CreateBankCardRequest) HasBankCard() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_BankCard != nil
+}
+
+func (x *CreateBankCardRequest) ClearBankCard() {
+	x.xxx_hidden_BankCard = nil
+}
+
+type CreateBankCardRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BankCard *BankCard
+}
+
+func (b0 CreateBankCardRequest_builder) Build() *CreateBankCardRequest {
+	m0 := &CreateBankCardRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_BankCard = b.BankCard
+	return m0
+}
+
+type CreateBankCardResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateBankCardResponse) Reset() {
+	*x = CreateBankCardResponse{}
+	mi := &file_api_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateBankCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateBankCardResponse) ProtoMessage() {}
+
+func (x *CreateBankCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateBankCardResponse) GetBankCard() *BankCard {
+	if x != nil {
+		return x.xxx_hidden_BankCard
+	}
+	return nil
+}
+
+func (x *CreateBankCardResponse) SetBankCard(v *BankCard) {
+	x.xxx_hidden_BankCard = v
+}
+
+func (x *CreateBankCardResponse) HasBankCard() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_BankCard != nil
+}
+
+func (x *CreateBankCardResponse) ClearBankCard() {
+	x.xxx_hidden_BankCard = nil
+}
+
+type CreateBankCardResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BankCard *BankCard
+}
+
+func (b0 CreateBankCardResponse_builder) Build() *CreateBankCardResponse {
+	m0 := &CreateBankCardResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_BankCard = b.BankCard
+	return m0
+}
+
+type GetBankCardByIDRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetBankCardByIDRequest) Reset() {
+	*x = GetBankCardByIDRequest{}
+	mi := &file_api_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBankCardByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBankCardByIDRequest) ProtoMessage() {}
+
+func (x *GetBankCardByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetBankCardByIDRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetBankCardByIDRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetBankCardByIDRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetBankCardByIDRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type GetBankCardByIDRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 GetBankCardByIDRequest_builder) Build() *GetBankCardByIDRequest {
+	m0 := &GetBankCardByIDRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type GetBankCardByIDResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetBankCardByIDResponse) Reset() {
+	*x = GetBankCardByIDResponse{}
+	mi := &file_api_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBankCardByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBankCardByIDResponse) ProtoMessage() {}
+
+func (x *GetBankCardByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetBankCardByIDResponse) GetBankCard() *BankCard {
+	if x != nil {
+		return x.xxx_hidden_BankCard
+	}
+	return nil
+}
+
+func (x *GetBankCardByIDResponse) SetBankCard(v *BankCard) {
+	x.xxx_hidden_BankCard = v
+}
+
+func (x *GetBankCardByIDResponse) HasBankCard() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_BankCard != nil
+}
+
+func (x *GetBankCardByIDResponse) ClearBankCard() {
+	x.xxx_hidden_BankCard = nil
+}
+
+type GetBankCardByIDResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BankCard *BankCard
+}
+
+func (b0 GetBankCardByIDResponse_builder) Build() *GetBankCardByIDResponse {
+	m0 := &GetBankCardByIDResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_BankCard = b.BankCard
+	return m0
+}
+
+type GetBankCardsRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Filter *ListFilter            `protobuf:"bytes,1,opt,name=filter"`
+	xxx_hidden_Page   *PageRequest           `protobuf:"bytes,2,opt,name=page"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetBankCardsRequest) Reset() {
+	*x = GetBankCardsRequest{}
+	mi := &file_api_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBankCardsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBankCardsRequest) ProtoMessage() {}
+
+func (x *GetBankCardsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetBankCardsRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.xxx_hidden_Filter
+	}
+	return nil
+}
+
+func (x *GetBankCardsRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.xxx_hidden_Page
+	}
+	return nil
+}
+
+func (x *GetBankCardsRequest) SetFilter(v *ListFilter) {
+	x.xxx_hidden_Filter = v
+}
+
+func (x *GetBankCardsRequest) SetPage(v *PageRequest) {
+	x.xxx_hidden_Page = v
+}
+
+func (x *GetBankCardsRequest) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Filter != nil
+}
+
+func (x *GetBankCardsRequest) HasPage() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Page != nil
+}
+
+func (x *GetBankCardsRequest) ClearFilter() {
+	x.xxx_hidden_Filter = nil
+}
+
+func (x *GetBankCardsRequest) ClearPage() {
+	x.xxx_hidden_Page = nil
+}
+
+type GetBankCardsRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Filter *ListFilter
+	Page   *PageRequest
+}
+
+func (b0 GetBankCardsRequest_builder) Build() *GetBankCardsRequest {
+	m0 := &GetBankCardsRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Filter = b.Filter
+	x.xxx_hidden_Page = b.Page
+	return m0
+}
+
+type GetBankCardsResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BankCards     *[]*BankCard           `protobuf:"bytes,1,rep,name=bank_cards,json=bankCards"`
+	xxx_hidden_NextPageToken *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetBankCardsResponse) Reset() {
+	*x = GetBankCardsResponse{}
+	mi := &file_api_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetBankCardsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBankCardsResponse) ProtoMessage() {}
+
+func (x *GetBankCardsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetBankCardsResponse) GetBankCards() []*BankCard {
+	if x != nil {
+		if x.xxx_hidden_BankCards != nil {
+			return *x.xxx_hidden_BankCards
+		}
+	}
+	return nil
+}
+
+func (x *GetBankCardsResponse) GetNextPageToken() string {
+	if x != nil {
+		if x.xxx_hidden_NextPageToken != nil {
+			return *x.xxx_hidden_NextPageToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetBankCardsResponse) SetBankCards(v []*BankCard) {
+	x.xxx_hidden_BankCards = &v
+}
+
+func (x *GetBankCardsResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetBankCardsResponse) HasNextPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetBankCardsResponse) ClearNextPageToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NextPageToken = nil
+}
+
+type GetBankCardsResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BankCards     []*BankCard
+	NextPageToken *string
+}
+
+func (b0 GetBankCardsResponse_builder) Build() *GetBankCardsResponse {
+	m0 := &GetBankCardsResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_BankCards = &b.BankCards
+	if b.NextPageToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NextPageToken = b.NextPageToken
+	}
+	return m0
+}
+
+type UpdateBankCardRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateBankCardRequest) Reset() {
+	*x = UpdateBankCardRequest{}
+	mi := &file_api_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBankCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBankCardRequest) ProtoMessage() {}
+
+func (x *UpdateBankCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateBankCardRequest) GetBankCard() *BankCard {
+	if x != nil {
+		return x.xxx_hidden_BankCard
+	}
+	return nil
+}
+
+func (x *UpdateBankCardRequest) SetBankCard(v *BankCard) {
+	x.xxx_hidden_BankCard = v
+}
+
+func (x *UpdateBankCardRequest) HasBankCard() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_BankCard != nil
+}
+
+func (x *UpdateBankCardRequest) ClearBankCard() {
+	x.xxx_hidden_BankCard = nil
+}
+
+type UpdateBankCardRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BankCard *BankCard
+}
+
+func (b0 UpdateBankCardRequest_builder) Build() *UpdateBankCardRequest {
+	m0 := &UpdateBankCardRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_BankCard = b.BankCard
+	return m0
+}
+
+type UpdateBankCardResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BankCard    *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,2,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateBankCardResponse) Reset() {
+	*x = UpdateBankCardResponse{}
+	mi := &file_api_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateBankCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateBankCardResponse) ProtoMessage() {}
+
+func (x *UpdateBankCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateBankCardResponse) GetBankCard() *BankCard {
+	if x != nil {
+		return x.xxx_hidden_BankCard
+	}
+	return nil
+}
+
+func (x *UpdateBankCardResponse) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *UpdateBankCardResponse) SetBankCard(v *BankCard) {
+	x.xxx_hidden_BankCard = v
+}
+
+func (x *UpdateBankCardResponse) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UpdateBankCardResponse) HasBankCard() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_BankCard != nil
+}
+
+func (x *UpdateBankCardResponse) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateBankCardResponse) ClearBankCard() {
+	x.xxx_hidden_BankCard = nil
+}
+
+func (x *UpdateBankCardResponse) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+type UpdateBankCardResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	BankCard *BankCard
+	Version  *int64
+}
+
+func (b0 UpdateBankCardResponse_builder) Build() *UpdateBankCardResponse {
+	m0 := &UpdateBankCardResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_BankCard = b.BankCard
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+type DeleteBankCardRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteBankCardRequest) Reset() {
+	*x = DeleteBankCardRequest{}
+	mi := &file_api_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBankCardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBankCardRequest) ProtoMessage() {}
+
+func (x *DeleteBankCardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteBankCardRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteBankCardRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteBankCardRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteBankCardRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type DeleteBankCardRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 DeleteBankCardRequest_builder) Build() *DeleteBankCardRequest {
+	m0 := &DeleteBankCardRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type DeleteBankCardResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteBankCardResponse) Reset() {
+	*x = DeleteBankCardResponse{}
+	mi := &file_api_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBankCardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBankCardResponse) ProtoMessage() {}
+
+func (x *DeleteBankCardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteBankCardResponse) GetSuccess() bool {
+	if x != nil {
+		return x.xxx_hidden_Success
+	}
+	return false
+}
+
+func (x *DeleteBankCardResponse) SetSuccess(v bool) {
+	x.xxx_hidden_Success = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteBankCardResponse) HasSuccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteBankCardResponse) ClearSuccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Success = false
+}
+
+type DeleteBankCardResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Success *bool
+}
+
+func (b0 DeleteBankCardResponse_builder) Build() *DeleteBankCardResponse {
+	m0 := &DeleteBankCardResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Success != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Success = *b.Success
+	}
+	return m0
+}
+
+// Сообщение для TextData
+type TextData struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id             *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_UserId         *string                `protobuf:"bytes,2,opt,name=user_id,json=userId"`
+	xxx_hidden_Title          *string                `protobuf:"bytes,3,opt,name=title"`
+	xxx_hidden_Content        []byte                 `protobuf:"bytes,4,opt,name=content"`
+	xxx_hidden_Metadata       *string                `protobuf:"bytes,5,opt,name=metadata"`
+	xxx_hidden_CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_Folder         *string                `protobuf:"bytes,8,opt,name=folder"`
+	xxx_hidden_Tags           []string               `protobuf:"bytes,9,rep,name=tags"`
+	xxx_hidden_Favorite       bool                   `protobuf:"varint,10,opt,name=favorite"`
+	xxx_hidden_LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_accessed_at,json=lastAccessedAt"`
+	xxx_hidden_Version        int64                  `protobuf:"varint,12,opt,name=version"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *TextData) Reset() {
+	*x = TextData{}
+	mi := &file_api_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TextData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TextData) ProtoMessage() {}
+
+func (x *TextData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *TextData) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TextData) GetUserId() string {
+	if x != nil {
+		if x.xxx_hidden_UserId != nil {
+			return *x.xxx_hidden_UserId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TextData) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TextData) GetContent() []byte {
+	if x != nil {
+		return x.xxx_hidden_Content
+	}
+	return nil
+}
+
+func (x *TextData) GetMetadata() string {
+	if x != nil {
+		if x.xxx_hidden_Metadata != nil {
+			return *x.xxx_hidden_Metadata
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TextData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *TextData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *TextData) GetFolder() string {
+	if x != nil {
+		if x.xxx_hidden_Folder != nil {
+			return *x.xxx_hidden_Folder
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *TextData) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *TextData) GetFavorite() bool {
+	if x != nil {
+		return x.xxx_hidden_Favorite
+	}
+	return false
+}
+
+func (x *TextData) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastAccessedAt
+	}
+	return nil
+}
+
+func (x *TextData) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *TextData) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 12)
+}
+
+func (x *TextData) SetUserId(v string) {
+	x.xxx_hidden_UserId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 12)
+}
+
+func (x *TextData) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 12)
+}
+
+func (x *TextData) SetContent(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Content = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 12)
+}
+
+func (x *TextData) SetMetadata(v string) {
+	x.xxx_hidden_Metadata = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 12)
+}
+
+func (x *TextData) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *TextData) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *TextData) SetFolder(v string) {
+	x.xxx_hidden_Folder = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 12)
+}
+
+func (x *TextData) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *TextData) SetFavorite(v bool) {
+	x.xxx_hidden_Favorite = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 12)
+}
+
+func (x *TextData) SetLastAccessedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastAccessedAt = v
+}
+
+func (x *TextData) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 12)
+}
+
+func (x *TextData) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *TextData) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *TextData) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *TextData) HasContent() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *TextData) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *TextData) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *TextData) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *TextData) HasFolder() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *TextData) HasFavorite() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *TextData) HasLastAccessedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastAccessedAt != nil
+}
+
+func (x *TextData) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *TextData) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *TextData) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_UserId = nil
+}
+
+func (x *TextData) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *TextData) ClearContent() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Content = nil
+}
+
+func (x *TextData) ClearMetadata() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_Metadata = nil
+}
+
+func (x *TextData) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *TextData) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *TextData) ClearFolder() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Folder = nil
+}
+
+func (x *TextData) ClearFavorite() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Favorite = false
+}
+
+func (x *TextData) ClearLastAccessedAt() {
+	x.xxx_hidden_LastAccessedAt = nil
+}
+
+func (x *TextData) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_Version = 0
+}
+
+type TextData_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id             *string
+	UserId         *string
+	Title          *string
+	Content        []byte
+	Metadata       *string
+	CreatedAt      *timestamppb.Timestamp
+	UpdatedAt      *timestamppb.Timestamp
+	Folder         *string
+	Tags           []string
+	Favorite       *bool
+	LastAccessedAt *timestamppb.Timestamp
+	Version        *int64
+}
+
+func (b0 TextData_builder) Build() *TextData {
+	m0 := &TextData{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 12)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 12)
+		x.xxx_hidden_UserId = b.UserId
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 12)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.Content != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 12)
+		x.xxx_hidden_Content = b.Content
+	}
+	if b.Metadata != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 12)
+		x.xxx_hidden_Metadata = b.Metadata
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	if b.Folder != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 12)
+		x.xxx_hidden_Folder = b.Folder
+	}
+	x.xxx_hidden_Tags = b.Tags
+	if b.Favorite != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 12)
+		x.xxx_hidden_Favorite = *b.Favorite
+	}
+	x.xxx_hidden_LastAccessedAt = b.LastAccessedAt
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 12)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+// Запрос и ответ на создание TextData
+type CreateTextDataRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TextData *TextData              `protobuf:"bytes,1,opt,name=text_data,json=textData"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateTextDataRequest) Reset() {
+	*x = CreateTextDataRequest{}
+	mi := &file_api_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTextDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTextDataRequest) ProtoMessage() {}
+
+func (x *CreateTextDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateTextDataRequest) GetTextData() *TextData {
+	if x != nil {
+		return x.xxx_hidden_TextData
+	}
+	return nil
+}
+
+func (x *CreateTextDataRequest) SetTextData(v *TextData) {
+	x.xxx_hidden_TextData = v
+}
+
+func (x *CreateTextDataRequest) HasTextData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TextData != nil
+}
+
+func (x *CreateTextDataRequest) ClearTextData() {
+	x.xxx_hidden_TextData = nil
+}
+
+type CreateTextDataRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TextData *TextData
+}
+
+func (b0 CreateTextDataRequest_builder) Build() *CreateTextDataRequest {
+	m0 := &CreateTextDataRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TextData = b.TextData
+	return m0
+}
+
+type CreateTextDataResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TextData *TextData              `protobuf:"bytes,1,opt,name=text_data,json=textData"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateTextDataResponse) Reset() {
+	*x = CreateTextDataResponse{}
+	mi := &file_api_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTextDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTextDataResponse) ProtoMessage() {}
+
+func (x *CreateTextDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CreateTextDataResponse) GetTextData() *TextData {
+	if x != nil {
+		return x.xxx_hidden_TextData
+	}
+	return nil
+}
+
+func (x *CreateTextDataResponse) SetTextData(v *TextData) {
+	x.xxx_hidden_TextData = v
+}
+
+func (x *CreateTextDataResponse) HasTextData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TextData != nil
+}
+
+func (x *CreateTextDataResponse) ClearTextData() {
+	x.xxx_hidden_TextData = nil
+}
+
+type CreateTextDataResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TextData *TextData
+}
+
+func (b0 CreateTextDataResponse_builder) Build() *CreateTextDataResponse {
+	m0 := &CreateTextDataResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TextData = b.TextData
+	return m0
+}
+
+// Запрос и ответ на получение TextData по ID
+type GetTextDataByIDRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetTextDataByIDRequest) Reset() {
+	*x = GetTextDataByIDRequest{}
+	mi := &file_api_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTextDataByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTextDataByIDRequest) ProtoMessage() {}
+
+func (x *GetTextDataByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTextDataByIDRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetTextDataByIDRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetTextDataByIDRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetTextDataByIDRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type GetTextDataByIDRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 GetTextDataByIDRequest_builder) Build() *GetTextDataByIDRequest {
+	m0 := &GetTextDataByIDRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type GetTextDataByIDResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TextData *TextData              `protobuf:"bytes,1,opt,name=text_data,json=textData"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetTextDataByIDResponse) Reset() {
+	*x = GetTextDataByIDResponse{}
+	mi := &file_api_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTextDataByIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTextDataByIDResponse) ProtoMessage() {}
+
+func (x *GetTextDataByIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTextDataByIDResponse) GetTextData() *TextData {
+	if x != nil {
+		return x.xxx_hidden_TextData
+	}
+	return nil
+}
+
+func (x *GetTextDataByIDResponse) SetTextData(v *TextData) {
+	x.xxx_hidden_TextData = v
+}
+
+func (x *GetTextDataByIDResponse) HasTextData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TextData != nil
+}
+
+func (x *GetTextDataByIDResponse) ClearTextData() {
+	x.xxx_hidden_TextData = nil
+}
+
+type GetTextDataByIDResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TextData *TextData
+}
+
+func (b0 GetTextDataByIDResponse_builder) Build() *GetTextDataByIDResponse {
+	m0 := &GetTextDataByIDResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TextData = b.TextData
+	return m0
+}
+
+// Запрос и ответ на получение списка заголовков TextData
+type GetTextDataTitlesRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UserId      *string                `protobuf:"bytes,1,opt,name=user_id,json=userId"`
+	xxx_hidden_Filter      *ListFilter            `protobuf:"bytes,2,opt,name=filter"`
+	xxx_hidden_Page        *PageRequest           `protobuf:"bytes,3,opt,name=page"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetTextDataTitlesRequest) Reset() {
+	*x = GetTextDataTitlesRequest{}
+	mi := &file_api_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTextDataTitlesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTextDataTitlesRequest) ProtoMessage() {}
+
+func (x *GetTextDataTitlesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTextDataTitlesRequest) GetUserId() string {
+	if x != nil {
+		if x.xxx_hidden_UserId != nil {
+			return *x.xxx_hidden_UserId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetTextDataTitlesRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.xxx_hidden_Filter
+	}
+	return nil
+}
+
+func (x *GetTextDataTitlesRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.xxx_hidden_Page
+	}
+	return nil
+}
+
+func (x *GetTextDataTitlesRequest) SetUserId(v string) {
+	x.xxx_hidden_UserId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *GetTextDataTitlesRequest) SetFilter(v *ListFilter) {
+	x.xxx_hidden_Filter = v
+}
+
+func (x *GetTextDataTitlesRequest) SetPage(v *PageRequest) {
+	x.xxx_hidden_Page = v
+}
+
+func (x *GetTextDataTitlesRequest) HasUserId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetTextDataTitlesRequest) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Filter != nil
+}
+
+func (x *GetTextDataTitlesRequest) HasPage() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Page != nil
+}
+
+func (x *GetTextDataTitlesRequest) ClearUserId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UserId = nil
+}
+
+func (x *GetTextDataTitlesRequest) ClearFilter() {
+	x.xxx_hidden_Filter = nil
+}
+
+func (x *GetTextDataTitlesRequest) ClearPage() {
+	x.xxx_hidden_Page = nil
+}
+
+type GetTextDataTitlesRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UserId *string
+	Filter *ListFilter
+	Page   *PageRequest
+}
+
+func (b0 GetTextDataTitlesRequest_builder) Build() *GetTextDataTitlesRequest {
+	m0 := &GetTextDataTitlesRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_UserId = b.UserId
+	}
+	x.xxx_hidden_Filter = b.Filter
+	x.xxx_hidden_Page = b.Page
+	return m0
+}
+
+type GetTextDataTitlesResponse struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TextDataTitles *[]*TextData           `protobuf:"bytes,1,rep,name=text_data_titles,json=textDataTitles"`
+	xxx_hidden_NextPageToken  *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *GetTextDataTitlesResponse) Reset() {
+	*x = GetTextDataTitlesResponse{}
+	mi := &file_api_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTextDataTitlesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTextDataTitlesResponse) ProtoMessage() {}
+
+func (x *GetTextDataTitlesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetTextDataTitlesResponse) GetTextDataTitles() []*TextData {
+	if x != nil {
+		if x.xxx_hidden_TextDataTitles != nil {
+			return *x.xxx_hidden_TextDataTitles
+		}
+	}
+	return nil
+}
+
+func (x *GetTextDataTitlesResponse) GetNextPageToken() string {
+	if x != nil {
+		if x.xxx_hidden_NextPageToken != nil {
+			return *x.xxx_hidden_NextPageToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetTextDataTitlesResponse) SetTextDataTitles(v []*TextData) {
+	x.xxx_hidden_TextDataTitles = &v
+}
+
+func (x *GetTextDataTitlesResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetTextDataTitlesResponse) HasNextPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetTextDataTitlesResponse) ClearNextPageToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NextPageToken = nil
+}
+
+type GetTextDataTitlesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TextDataTitles []*TextData
+	NextPageToken  *string
+}
+
+func (b0 GetTextDataTitlesResponse_builder) Build() *GetTextDataTitlesResponse {
+	m0 := &GetTextDataTitlesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TextDataTitles = &b.TextDataTitles
+	if b.NextPageToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NextPageToken = b.NextPageToken
+	}
+	return m0
+}
+
+// Запрос и ответ на обновление TextData
+type UpdateTextDataRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_TextData *TextData              `protobuf:"bytes,1,opt,name=text_data,json=textData"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateTextDataRequest) Reset() {
+	*x = UpdateTextDataRequest{}
+	mi := &file_api_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTextDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTextDataRequest) ProtoMessage() {}
+
+func (x *UpdateTextDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateTextDataRequest) GetTextData() *TextData {
+	if x != nil {
+		return x.xxx_hidden_TextData
+	}
+	return nil
+}
+
+func (x *UpdateTextDataRequest) SetTextData(v *TextData) {
+	x.xxx_hidden_TextData = v
+}
+
+func (x *UpdateTextDataRequest) HasTextData() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_TextData != nil
+}
+
+func (x *UpdateTextDataRequest) ClearTextData() {
+	x.xxx_hidden_TextData = nil
+}
+
+type UpdateTextDataRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	TextData *TextData
+}
+
+func (b0 UpdateTextDataRequest_builder) Build() *UpdateTextDataRequest {
+	m0 := &UpdateTextDataRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_TextData = b.TextData
+	return m0
+}
+
+type UpdateTextDataResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,2,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateTextDataResponse) Reset() {
+	*x = UpdateTextDataResponse{}
+	mi := &file_api_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTextDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTextDataResponse) ProtoMessage() {}
+
+func (x *UpdateTextDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UpdateTextDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.xxx_hidden_Success
+	}
+	return false
+}
+
+func (x *UpdateTextDataResponse) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *UpdateTextDataResponse) SetSuccess(v bool) {
+	x.xxx_hidden_Success = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *UpdateTextDataResponse) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UpdateTextDataResponse) HasSuccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UpdateTextDataResponse) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateTextDataResponse) ClearSuccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Success = false
+}
+
+func (x *UpdateTextDataResponse) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+type UpdateTextDataResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Success *bool
+	Version *int64
+}
+
+func (b0 UpdateTextDataResponse_builder) Build() *UpdateTextDataResponse {
+	m0 := &UpdateTextDataResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Success != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Success = *b.Success
+	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+// Запрос и ответ на удаление TextData
+type DeleteTextDataRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteTextDataRequest) Reset() {
+	*x = DeleteTextDataRequest{}
+	mi := &file_api_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTextDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTextDataRequest) ProtoMessage() {}
+
+func (x *DeleteTextDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteTextDataRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteTextDataRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteTextDataRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteTextDataRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type DeleteTextDataRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 DeleteTextDataRequest_builder) Build() *DeleteTextDataRequest {
+	m0 := &DeleteTextDataRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type DeleteTextDataResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteTextDataResponse) Reset() {
+	*x = DeleteTextDataResponse{}
+	mi := &file_api_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTextDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTextDataResponse) ProtoMessage() {}
+
+func (x *DeleteTextDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteTextDataResponse) GetSuccess() bool {
+	if x != nil {
+		return x.xxx_hidden_Success
+	}
+	return false
+}
+
+func (x *DeleteTextDataResponse) SetSuccess(v bool) {
+	x.xxx_hidden_Success = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteTextDataResponse) HasSuccess() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteTextDataResponse) ClearSuccess() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Success = false
+}
+
+type DeleteTextDataResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Success *bool
+}
+
+func (b0 DeleteTextDataResponse_builder) Build() *DeleteTextDataResponse {
+	m0 := &DeleteTextDataResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Success != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Success = *b.Success
+	}
+	return m0
+}
+
+// Сообщения для BinaryData
+type UploadBinaryDataRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Chunk       []byte                 `protobuf:"bytes,1,opt,name=chunk"`
+	xxx_hidden_Info        *BinaryDataInfo        `protobuf:"bytes,2,opt,name=info"`
+	xxx_hidden_UploadId    *string                `protobuf:"bytes,3,opt,name=upload_id,json=uploadId"`
+	xxx_hidden_Offset      int64                  `protobuf:"varint,4,opt,name=offset"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UploadBinaryDataRequest) Reset() {
+	*x = UploadBinaryDataRequest{}
+	mi := &file_api_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadBinaryDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBinaryDataRequest) ProtoMessage() {}
+
+func (x *UploadBinaryDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UploadBinaryDataRequest) GetChunk() []byte {
+	if x != nil {
+		return x.xxx_hidden_Chunk
+	}
+	return nil
+}
+
+func (x *UploadBinaryDataRequest) GetInfo() *BinaryDataInfo {
+	if x != nil {
+		return x.xxx_hidden_Info
+	}
+	return nil
+}
+
+func (x *UploadBinaryDataRequest) GetUploadId() string {
+	if x != nil {
+		if x.xxx_hidden_UploadId != nil {
+			return *x.xxx_hidden_UploadId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UploadBinaryDataRequest) GetOffset() int64 {
+	if x != nil {
+		return x.xxx_hidden_Offset
+	}
+	return 0
+}
+
+func (x *UploadBinaryDataRequest) SetChunk(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Chunk = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *UploadBinaryDataRequest) SetInfo(v *BinaryDataInfo) {
+	x.xxx_hidden_Info = v
+}
+
+func (x *UploadBinaryDataRequest) SetUploadId(v string) {
+	x.xxx_hidden_UploadId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *UploadBinaryDataRequest) SetOffset(v int64) {
+	x.xxx_hidden_Offset = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *UploadBinaryDataRequest) HasChunk() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UploadBinaryDataRequest) HasInfo() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Info != nil
+}
+
+func (x *UploadBinaryDataRequest) HasUploadId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *UploadBinaryDataRequest) HasOffset() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *UploadBinaryDataRequest) ClearChunk() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Chunk = nil
+}
+
+func (x *UploadBinaryDataRequest) ClearInfo() {
+	x.xxx_hidden_Info = nil
+}
+
+func (x *UploadBinaryDataRequest) ClearUploadId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_UploadId = nil
+}
+
+func (x *UploadBinaryDataRequest) ClearOffset() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Offset = 0
+}
+
+type UploadBinaryDataRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Chunk    []byte
+	Info     *BinaryDataInfo
+	UploadId *string
+	Offset   *int64
+}
+
+func (b0 UploadBinaryDataRequest_builder) Build() *UploadBinaryDataRequest {
+	m0 := &UploadBinaryDataRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Chunk != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Chunk = b.Chunk
+	}
+	x.xxx_hidden_Info = b.Info
+	if b.UploadId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_UploadId = b.UploadId
+	}
+	if b.Offset != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Offset = *b.Offset
+	}
+	return m0
+}
+
+type UploadBinaryDataResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,2,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UploadBinaryDataResponse) Reset() {
+	*x = UploadBinaryDataResponse{}
+	mi := &file_api_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadBinaryDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadBinaryDataResponse) ProtoMessage() {}
+
+func (x *UploadBinaryDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UploadBinaryDataResponse) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UploadBinaryDataResponse) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *UploadBinaryDataResponse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *UploadBinaryDataResponse) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UploadBinaryDataResponse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UploadBinaryDataResponse) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UploadBinaryDataResponse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *UploadBinaryDataResponse) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+type UploadBinaryDataResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id      *string
+	Version *int64
+}
+
+func (b0 UploadBinaryDataResponse_builder) Build() *UploadBinaryDataResponse {
+	m0 := &UploadBinaryDataResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+type DownloadBinaryDataRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Offset      int64                  `protobuf:"varint,2,opt,name=offset"`
+	xxx_hidden_Length      int64                  `protobuf:"varint,3,opt,name=length"`
+	xxx_hidden_VersionId   *string                `protobuf:"bytes,4,opt,name=version_id,json=versionId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DownloadBinaryDataRequest) Reset() {
+	*x = DownloadBinaryDataRequest{}
+	mi := &file_api_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadBinaryDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinaryDataRequest) ProtoMessage() {}
+
+func (x *DownloadBinaryDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DownloadBinaryDataRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DownloadBinaryDataRequest) GetOffset() int64 {
+	if x != nil {
+		return x.xxx_hidden_Offset
+	}
+	return 0
+}
+
+func (x *DownloadBinaryDataRequest) GetLength() int64 {
+	if x != nil {
+		return x.xxx_hidden_Length
+	}
+	return 0
+}
+
+func (x *DownloadBinaryDataRequest) GetVersionId() string {
+	if x != nil {
+		if x.xxx_hidden_VersionId != nil {
+			return *x.xxx_hidden_VersionId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DownloadBinaryDataRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *DownloadBinaryDataRequest) SetOffset(v int64) {
+	x.xxx_hidden_Offset = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *DownloadBinaryDataRequest) SetLength(v int64) {
+	x.xxx_hidden_Length = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *DownloadBinaryDataRequest) SetVersionId(v string) {
+	x.xxx_hidden_VersionId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *DownloadBinaryDataRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DownloadBinaryDataRequest) HasOffset() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *DownloadBinaryDataRequest) HasLength() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *DownloadBinaryDataRequest) HasVersionId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *DownloadBinaryDataRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *DownloadBinaryDataRequest) ClearOffset() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Offset = 0
+}
+
+func (x *DownloadBinaryDataRequest) ClearLength() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Length = 0
+}
+
+func (x *DownloadBinaryDataRequest) ClearVersionId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_VersionId = nil
+}
+
+type DownloadBinaryDataRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	Offset    *int64
+	Length    *int64
+	VersionId *string
+}
+
+func (b0 DownloadBinaryDataRequest_builder) Build() *DownloadBinaryDataRequest {
+	m0 := &DownloadBinaryDataRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Offset != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Offset = *b.Offset
+	}
+	if b.Length != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Length = *b.Length
+	}
+	if b.VersionId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_VersionId = b.VersionId
+	}
+	return m0
+}
+
+type DownloadBinaryDataResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Chunk       []byte                 `protobuf:"bytes,1,opt,name=chunk"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DownloadBinaryDataResponse) Reset() {
+	*x = DownloadBinaryDataResponse{}
+	mi := &file_api_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadBinaryDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadBinaryDataResponse) ProtoMessage() {}
+
+func (x *DownloadBinaryDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DownloadBinaryDataResponse) GetChunk() []byte {
+	if x != nil {
+		return x.xxx_hidden_Chunk
+	}
+	return nil
+}
+
+func (x *DownloadBinaryDataResponse) SetChunk(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Chunk = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DownloadBinaryDataResponse) HasChunk() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DownloadBinaryDataResponse) ClearChunk() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Chunk = nil
+}
+
+type DownloadBinaryDataResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Chunk []byte
+}
+
+func (b0 DownloadBinaryDataResponse_builder) Build() *DownloadBinaryDataResponse {
+	m0 := &DownloadBinaryDataResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Chunk != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Chunk = b.Chunk
+	}
+	return m0
+}
+
+type ListBinaryDataRequest struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Filter *ListFilter            `protobuf:"bytes,1,opt,name=filter"`
+	xxx_hidden_Page   *PageRequest           `protobuf:"bytes,2,opt,name=page"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListBinaryDataRequest) Reset() {
+	*x = ListBinaryDataRequest{}
+	mi := &file_api_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBinaryDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBinaryDataRequest) ProtoMessage() {}
+
+func (x *ListBinaryDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListBinaryDataRequest) GetFilter() *ListFilter {
+	if x != nil {
+		return x.xxx_hidden_Filter
+	}
+	return nil
+}
+
+func (x *ListBinaryDataRequest) GetPage() *PageRequest {
+	if x != nil {
+		return x.xxx_hidden_Page
+	}
+	return nil
+}
+
+func (x *ListBinaryDataRequest) SetFilter(v *ListFilter) {
+	x.xxx_hidden_Filter = v
+}
+
+func (x *ListBinaryDataRequest) SetPage(v *PageRequest) {
+	x.xxx_hidden_Page = v
+}
+
+func (x *ListBinaryDataRequest) HasFilter() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Filter != nil
+}
+
+func (x *ListBinaryDataRequest) HasPage() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Page != nil
+}
+
+func (x *ListBinaryDataRequest) ClearFilter() {
+	x.xxx_hidden_Filter = nil
+}
+
+func (x *ListBinaryDataRequest) ClearPage() {
+	x.xxx_hidden_Page = nil
+}
+
+type ListBinaryDataRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Filter *ListFilter
+	Page   *PageRequest
+}
+
+func (b0 ListBinaryDataRequest_builder) Build() *ListBinaryDataRequest {
+	m0 := &ListBinaryDataRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Filter = b.Filter
+	x.xxx_hidden_Page = b.Page
+	return m0
+}
+
+type ListBinaryDataResponse struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Items         *[]*BinaryDataInfo     `protobuf:"bytes,1,rep,name=items"`
+	xxx_hidden_NextPageToken *string                `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *ListBinaryDataResponse) Reset() {
+	*x = ListBinaryDataResponse{}
+	mi := &file_api_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBinaryDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBinaryDataResponse) ProtoMessage() {}
+
+func (x *ListBinaryDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ListBinaryDataResponse) GetItems() []*BinaryDataInfo {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
+	}
+	return nil
+}
+
+func (x *ListBinaryDataResponse) GetNextPageToken() string {
+	if x != nil {
+		if x.xxx_hidden_NextPageToken != nil {
+			return *x.xxx_hidden_NextPageToken
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ListBinaryDataResponse) SetItems(v []*BinaryDataInfo) {
+	x.xxx_hidden_Items = &v
+}
+
+func (x *ListBinaryDataResponse) SetNextPageToken(v string) {
+	x.xxx_hidden_NextPageToken = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListBinaryDataResponse) HasNextPageToken() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListBinaryDataResponse) ClearNextPageToken() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_NextPageToken = nil
+}
+
+type ListBinaryDataResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Items         []*BinaryDataInfo
+	NextPageToken *string
+}
+
+func (b0 ListBinaryDataResponse_builder) Build() *ListBinaryDataResponse {
+	m0 := &ListBinaryDataResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Items = &b.Items
+	if b.NextPageToken != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_NextPageToken = b.NextPageToken
+	}
+	return m0
+}
+
+type BinaryDataInfo struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id             *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Title          *string                `protobuf:"bytes,2,opt,name=title"`
+	xxx_hidden_Metadata       *string                `protobuf:"bytes,3,opt,name=metadata"`
+	xxx_hidden_Size           int64                  `protobuf:"varint,4,opt,name=size"`
+	xxx_hidden_ClientPath     *string                `protobuf:"bytes,5,opt,name=client_path,json=clientPath"`
+	xxx_hidden_CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt"`
+	xxx_hidden_UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_Folder         *string                `protobuf:"bytes,8,opt,name=folder"`
+	xxx_hidden_Tags           []string               `protobuf:"bytes,9,rep,name=tags"`
+	xxx_hidden_Favorite       bool                   `protobuf:"varint,10,opt,name=favorite"`
+	xxx_hidden_LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_accessed_at,json=lastAccessedAt"`
+	xxx_hidden_Version        int64                  `protobuf:"varint,12,opt,name=version"`
+	xxx_hidden_Chunked        bool                   `protobuf:"varint,13,opt,name=chunked"`
+	xxx_hidden_Checksum       *string                `protobuf:"bytes,14,opt,name=checksum"`
+	xxx_hidden_PlainChecksum  *string                `protobuf:"bytes,15,opt,name=plain_checksum,json=plainChecksum"`
+	xxx_hidden_Archive        *string                `protobuf:"bytes,16,opt,name=archive"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *BinaryDataInfo) Reset() {
+	*x = BinaryDataInfo{}
+	mi := &file_api_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BinaryDataInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BinaryDataInfo) ProtoMessage() {}
+
+func (x *BinaryDataInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *BinaryDataInfo) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BinaryDataInfo) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BinaryDataInfo) GetMetadata() string {
+	if x != nil {
+		if x.xxx_hidden_Metadata != nil {
+			return *x.xxx_hidden_Metadata
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BinaryDataInfo) GetSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *BinaryDataInfo) GetClientPath() string {
+	if x != nil {
+		if x.xxx_hidden_ClientPath != nil {
+			return *x.xxx_hidden_ClientPath
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BinaryDataInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_CreatedAt
+	}
+	return nil
+}
+
+func (x *BinaryDataInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_UpdatedAt
+	}
+	return nil
+}
+
+func (x *BinaryDataInfo) GetFolder() string {
+	if x != nil {
+		if x.xxx_hidden_Folder != nil {
+			return *x.xxx_hidden_Folder
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BinaryDataInfo) GetTags() []string {
+	if x != nil {
+		return x.xxx_hidden_Tags
+	}
+	return nil
+}
+
+func (x *BinaryDataInfo) GetFavorite() bool {
+	if x != nil {
+		return x.xxx_hidden_Favorite
+	}
+	return false
+}
+
+func (x *BinaryDataInfo) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastAccessedAt
+	}
+	return nil
+}
+
+func (x *BinaryDataInfo) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *BinaryDataInfo) GetChunked() bool {
+	if x != nil {
+		return x.xxx_hidden_Chunked
+	}
+	return false
+}
+
+func (x *BinaryDataInfo) GetChecksum() string {
+	if x != nil {
+		if x.xxx_hidden_Checksum != nil {
+			return *x.xxx_hidden_Checksum
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BinaryDataInfo) GetPlainChecksum() string {
+	if x != nil {
+		if x.xxx_hidden_PlainChecksum != nil {
+			return *x.xxx_hidden_PlainChecksum
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BinaryDataInfo) GetArchive() string {
+	if x != nil {
+		if x.xxx_hidden_Archive != nil {
+			return *x.xxx_hidden_Archive
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BinaryDataInfo) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 16)
+}
+
+func (x *BinaryDataInfo) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 16)
+}
+
+func (x *BinaryDataInfo) SetMetadata(v string) {
+	x.xxx_hidden_Metadata = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 16)
+}
+
+func (x *BinaryDataInfo) SetSize(v int64) {
+	x.xxx_hidden_Size = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 16)
+}
+
+func (x *BinaryDataInfo) SetClientPath(v string) {
+	x.xxx_hidden_ClientPath = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 16)
+}
+
+func (x *BinaryDataInfo) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *BinaryDataInfo) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
+
+func (x *BinaryDataInfo) SetFolder(v string) {
+	x.xxx_hidden_Folder = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 16)
+}
+
+func (x *BinaryDataInfo) SetTags(v []string) {
+	x.xxx_hidden_Tags = v
+}
+
+func (x *BinaryDataInfo) SetFavorite(v bool) {
+	x.xxx_hidden_Favorite = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 9, 16)
+}
+
+func (x *BinaryDataInfo) SetLastAccessedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastAccessedAt = v
+}
+
+func (x *BinaryDataInfo) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 16)
+}
+
+func (x *BinaryDataInfo) SetChunked(v bool) {
+	x.xxx_hidden_Chunked = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 12, 16)
+}
+
+func (x *BinaryDataInfo) SetChecksum(v string) {
+	x.xxx_hidden_Checksum = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 13, 16)
+}
+
+func (x *BinaryDataInfo) SetPlainChecksum(v string) {
+	x.xxx_hidden_PlainChecksum = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 14, 16)
+}
+
+func (x *BinaryDataInfo) SetArchive(v string) {
+	x.xxx_hidden_Archive = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 15, 16)
+}
+
+func (x *BinaryDataInfo) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *BinaryDataInfo) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *BinaryDataInfo) HasMetadata() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *BinaryDataInfo) HasSize() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *BinaryDataInfo) HasClientPath() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+}
+
+func (x *BinaryDataInfo) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
+}
+
+func (x *BinaryDataInfo) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
+}
+
+func (x *BinaryDataInfo) HasFolder() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+}
+
+func (x *BinaryDataInfo) HasFavorite() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 9)
+}
+
+func (x *BinaryDataInfo) HasLastAccessedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastAccessedAt != nil
+}
+
+func (x *BinaryDataInfo) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
+}
+
+func (x *BinaryDataInfo) HasChunked() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 12)
+}
+
+func (x *BinaryDataInfo) HasChecksum() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 13)
+}
+
+func (x *BinaryDataInfo) HasPlainChecksum() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 14)
+}
+
+func (x *BinaryDataInfo) HasArchive() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 15)
+}
+
+func (x *BinaryDataInfo) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *BinaryDataInfo) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *BinaryDataInfo) ClearMetadata() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Metadata = nil
+}
+
+func (x *BinaryDataInfo) ClearSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Size = 0
+}
+
+func (x *BinaryDataInfo) ClearClientPath() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_ClientPath = nil
+}
+
+func (x *BinaryDataInfo) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+func (x *BinaryDataInfo) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
+}
+
+func (x *BinaryDataInfo) ClearFolder() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
+	x.xxx_hidden_Folder = nil
+}
+
+func (x *BinaryDataInfo) ClearFavorite() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 9)
+	x.xxx_hidden_Favorite = false
+}
+
+func (x *BinaryDataInfo) ClearLastAccessedAt() {
+	x.xxx_hidden_LastAccessedAt = nil
+}
+
+func (x *BinaryDataInfo) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_Version = 0
+}
+
+func (x *BinaryDataInfo) ClearChunked() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 12)
+	x.xxx_hidden_Chunked = false
+}
+
+func (x *BinaryDataInfo) ClearChecksum() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 13)
+	x.xxx_hidden_Checksum = nil
+}
+
+func (x *BinaryDataInfo) ClearPlainChecksum() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 14)
+	x.xxx_hidden_PlainChecksum = nil
+}
+
+func (x *BinaryDataInfo) ClearArchive() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 15)
+	x.xxx_hidden_Archive = nil
+}
+
+type BinaryDataInfo_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id             *string
+	Title          *string
+	Metadata       *string
+	Size           *int64
+	ClientPath     *string
+	CreatedAt      *timestamppb.Timestamp
+	UpdatedAt      *timestamppb.Timestamp
+	Folder         *string
+	Tags           []string
+	Favorite       *bool
+	LastAccessedAt *timestamppb.Timestamp
+	Version        *int64
+	Chunked        *bool
+	Checksum       *string
+	PlainChecksum  *string
+	Archive        *string
+}
+
+func (b0 BinaryDataInfo_builder) Build() *BinaryDataInfo {
+	m0 := &BinaryDataInfo{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 16)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 16)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.Metadata != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 16)
+		x.xxx_hidden_Metadata = b.Metadata
+	}
+	if b.Size != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 16)
+		x.xxx_hidden_Size = *b.Size
+	}
+	if b.ClientPath != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 16)
+		x.xxx_hidden_ClientPath = b.ClientPath
+	}
+	x.xxx_hidden_CreatedAt = b.CreatedAt
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	if b.Folder != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 16)
+		x.xxx_hidden_Folder = b.Folder
+	}
+	x.xxx_hidden_Tags = b.Tags
+	if b.Favorite != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 9, 16)
+		x.xxx_hidden_Favorite = *b.Favorite
+	}
+	x.xxx_hidden_LastAccessedAt = b.LastAccessedAt
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 16)
+		x.xxx_hidden_Version = *b.Version
+	}
+	if b.Chunked != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 12, 16)
+		x.xxx_hidden_Chunked = *b.Chunked
+	}
+	if b.Checksum != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 13, 16)
+		x.xxx_hidden_Checksum = b.Checksum
+	}
+	if b.PlainChecksum != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 14, 16)
+		x.xxx_hidden_PlainChecksum = b.PlainChecksum
+	}
+	if b.Archive != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 15, 16)
+		x.xxx_hidden_Archive = b.Archive
+	}
+	return m0
+}
+
+type DeleteBinaryDataRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DeleteBinaryDataRequest) Reset() {
+	*x = DeleteBinaryDataRequest{}
+	mi := &file_api_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBinaryDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBinaryDataRequest) ProtoMessage() {}
+
+func (x *DeleteBinaryDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DeleteBinaryDataRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DeleteBinaryDataRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DeleteBinaryDataRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DeleteBinaryDataRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type DeleteBinaryDataRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 DeleteBinaryDataRequest_builder) Build() *DeleteBinaryDataRequest {
+	m0 := &DeleteBinaryDataRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type DeleteBinaryDataResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteBinaryDataResponse) Reset() {
+	*x = DeleteBinaryDataResponse{}
+	mi := &file_api_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteBinaryDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteBinaryDataResponse) ProtoMessage() {}
+
+func (x *DeleteBinaryDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type DeleteBinaryDataResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 DeleteBinaryDataResponse_builder) Build() *DeleteBinaryDataResponse {
+	m0 := &DeleteBinaryDataResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// Запрос состояния сессии докачки
+type GetUploadStatusRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_UploadId    *string                `protobuf:"bytes,1,opt,name=upload_id,json=uploadId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetUploadStatusRequest) Reset() {
+	*x = GetUploadStatusRequest{}
+	mi := &file_api_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadStatusRequest) ProtoMessage() {}
+
+func (x *GetUploadStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUploadStatusRequest) GetUploadId() string {
+	if x != nil {
+		if x.xxx_hidden_UploadId != nil {
+			return *x.xxx_hidden_UploadId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetUploadStatusRequest) SetUploadId(v string) {
+	x.xxx_hidden_UploadId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetUploadStatusRequest) HasUploadId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetUploadStatusRequest) ClearUploadId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_UploadId = nil
+}
+
+type GetUploadStatusRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	UploadId *string
+}
+
+func (b0 GetUploadStatusRequest_builder) Build() *GetUploadStatusRequest {
+	m0 := &GetUploadStatusRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.UploadId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_UploadId = b.UploadId
+	}
+	return m0
+}
+
+// Состояние сессии докачки
+type GetUploadStatusResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Offset      int64                  `protobuf:"varint,1,opt,name=offset"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetUploadStatusResponse) Reset() {
+	*x = GetUploadStatusResponse{}
+	mi := &file_api_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUploadStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadStatusResponse) ProtoMessage() {}
+
+func (x *GetUploadStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetUploadStatusResponse) GetOffset() int64 {
+	if x != nil {
+		return x.xxx_hidden_Offset
+	}
+	return 0
+}
+
+func (x *GetUploadStatusResponse) SetOffset(v int64) {
+	x.xxx_hidden_Offset = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *GetUploadStatusResponse) HasOffset() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetUploadStatusResponse) ClearOffset() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Offset = 0
+}
+
+type GetUploadStatusResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Offset *int64
+}
+
+func (b0 GetUploadStatusResponse_builder) Build() *GetUploadStatusResponse {
+	m0 := &GetUploadStatusResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Offset != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Offset = *b.Offset
+	}
+	return m0
+}
+
+// Ссылка на зашифрованный фрагмент файла в манифесте
+type ChunkRef struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Size        int64                  `protobuf:"varint,2,opt,name=size"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ChunkRef) Reset() {
+	*x = ChunkRef{}
+	mi := &file_api_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChunkRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChunkRef) ProtoMessage() {}
+
+func (x *ChunkRef) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *ChunkRef) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *ChunkRef) GetSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_Size
+	}
+	return 0
+}
+
+func (x *ChunkRef) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ChunkRef) SetSize(v int64) {
+	x.xxx_hidden_Size = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ChunkRef) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *ChunkRef) HasSize() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ChunkRef) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ChunkRef) ClearSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Size = 0
+}
+
+type ChunkRef_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id   *string
+	Size *int64
+}
+
+func (b0 ChunkRef_builder) Build() *ChunkRef {
+	m0 := &ChunkRef{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Size != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Size = *b.Size
+	}
+	return m0
+}
+
+// Запрос списка фрагментов, которых нет на сервере
+type FindMissingChunksRequest struct {
+	state          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Ids []string               `protobuf:"bytes,1,rep,name=ids"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FindMissingChunksRequest) Reset() {
+	*x = FindMissingChunksRequest{}
+	mi := &file_api_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindMissingChunksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMissingChunksRequest) ProtoMessage() {}
+
+func (x *FindMissingChunksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FindMissingChunksRequest) GetIds() []string {
+	if x != nil {
+		return x.xxx_hidden_Ids
+	}
+	return nil
+}
+
+func (x *FindMissingChunksRequest) SetIds(v []string) {
+	x.xxx_hidden_Ids = v
+}
+
+type FindMissingChunksRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Ids []string
+}
+
+func (b0 FindMissingChunksRequest_builder) Build() *FindMissingChunksRequest {
+	m0 := &FindMissingChunksRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Ids = b.Ids
+	return m0
+}
+
+type FindMissingChunksResponse struct {
+	state          protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Ids []string               `protobuf:"bytes,1,rep,name=ids"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *FindMissingChunksResponse) Reset() {
+	*x = FindMissingChunksResponse{}
+	mi := &file_api_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FindMissingChunksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMissingChunksResponse) ProtoMessage() {}
+
+func (x *FindMissingChunksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *FindMissingChunksResponse) GetIds() []string {
+	if x != nil {
+		return x.xxx_hidden_Ids
+	}
+	return nil
+}
+
+func (x *FindMissingChunksResponse) SetIds(v []string) {
+	x.xxx_hidden_Ids = v
+}
+
+type FindMissingChunksResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Ids []string
+}
+
+func (b0 FindMissingChunksResponse_builder) Build() *FindMissingChunksResponse {
+	m0 := &FindMissingChunksResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Ids = b.Ids
+	return m0
+}
+
+// Загрузка одного зашифрованного фрагмента
+type UploadChunkRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Data        []byte                 `protobuf:"bytes,2,opt,name=data"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UploadChunkRequest) Reset() {
+	*x = UploadChunkRequest{}
+	mi := &file_api_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChunkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunkRequest) ProtoMessage() {}
+
+func (x *UploadChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *UploadChunkRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *UploadChunkRequest) GetData() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data
+	}
+	return nil
+}
+
+func (x *UploadChunkRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *UploadChunkRequest) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UploadChunkRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *UploadChunkRequest) HasData() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UploadChunkRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *UploadChunkRequest) ClearData() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Data = nil
+}
+
+type UploadChunkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id   *string
+	Data []byte
+}
+
+func (b0 UploadChunkRequest_builder) Build() *UploadChunkRequest {
+	m0 := &UploadChunkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Data != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Data = b.Data
+	}
+	return m0
+}
+
+type UploadChunkResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadChunkResponse) Reset() {
+	*x = UploadChunkResponse{}
+	mi := &file_api_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadChunkResponse) ProtoMessage() {}
+
+func (x *UploadChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type UploadChunkResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+}
+
+func (b0 UploadChunkResponse_builder) Build() *UploadChunkResponse {
+	m0 := &UploadChunkResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
+}
+
+// Создание или обновление файла из загруженных фрагментов
+type CommitChunkedUploadRequest struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Info     *BinaryDataInfo        `protobuf:"bytes,1,opt,name=info"`
+	xxx_hidden_ChunkIds []string               `protobuf:"bytes,2,rep,name=chunk_ids,json=chunkIds"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CommitChunkedUploadRequest) Reset() {
+	*x = CommitChunkedUploadRequest{}
+	mi := &file_api_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitChunkedUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitChunkedUploadRequest) ProtoMessage() {}
+
+func (x *CommitChunkedUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CommitChunkedUploadRequest) GetInfo() *BinaryDataInfo {
+	if x != nil {
+		return x.xxx_hidden_Info
+	}
+	return nil
+}
+
+func (x *CommitChunkedUploadRequest) GetChunkIds() []string {
+	if x != nil {
+		return x.xxx_hidden_ChunkIds
+	}
+	return nil
+}
+
+func (x *CommitChunkedUploadRequest) SetInfo(v *BinaryDataInfo) {
+	x.xxx_hidden_Info = v
+}
+
+func (x *CommitChunkedUploadRequest) SetChunkIds(v []string) {
+	x.xxx_hidden_ChunkIds = v
+}
+
+func (x *CommitChunkedUploadRequest) HasInfo() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Info != nil
+}
+
+func (x *CommitChunkedUploadRequest) ClearInfo() {
+	x.xxx_hidden_Info = nil
+}
+
+type CommitChunkedUploadRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Info     *BinaryDataInfo
+	ChunkIds []string
+}
+
+func (b0 CommitChunkedUploadRequest_builder) Build() *CommitChunkedUploadRequest {
+	m0 := &CommitChunkedUploadRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Info = b.Info
+	x.xxx_hidden_ChunkIds = b.ChunkIds
+	return m0
+}
+
+type CommitChunkedUploadResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,2,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CommitChunkedUploadResponse) Reset() {
+	*x = CommitChunkedUploadResponse{}
+	mi := &file_api_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitChunkedUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitChunkedUploadResponse) ProtoMessage() {}
+
+func (x *CommitChunkedUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *CommitChunkedUploadResponse) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *CommitChunkedUploadResponse) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *CommitChunkedUploadResponse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *CommitChunkedUploadResponse) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *CommitChunkedUploadResponse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *CommitChunkedUploadResponse) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *CommitChunkedUploadResponse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *CommitChunkedUploadResponse) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+type CommitChunkedUploadResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id      *string
+	Version *int64
+}
+
+func (b0 CommitChunkedUploadResponse_builder) Build() *CommitChunkedUploadResponse {
+	m0 := &CommitChunkedUploadResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
+}
+
+// Запрос манифеста файла
+type GetManifestRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_VersionId   *string                `protobuf:"bytes,2,opt,name=version_id,json=versionId"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetManifestRequest) Reset() {
+	*x = GetManifestRequest{}
+	mi := &file_api_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetManifestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetManifestRequest) ProtoMessage() {}
+
+func (x *GetManifestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *GetManifestRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetManifestRequest) GetVersionId() string {
+	if x != nil {
+		if x.xxx_hidden_VersionId != nil {
+			return *x.xxx_hidden_VersionId
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *GetManifestRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *GetManifestRequest) SetVersionId(v string) {
+	x.xxx_hidden_VersionId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *GetManifestRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *GetManifestRequest) HasVersionId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *GetManifestRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *GetManifestRequest) ClearVersionId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_VersionId = nil
+}
+
+type GetManifestRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	VersionId *string
+}
+
+func (b0 GetManifestRequest_builder) Build() *GetManifestRequest {
+	m0 := &GetManifestRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.VersionId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_VersionId = b.VersionId
+	}
+	return m0
+}
+
+type GetManifestResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Chunked     bool                   `protobuf:"varint,1,opt,name=chunked"`
+	xxx_hidden_Chunks      *[]*ChunkRef           `protobuf:"bytes,2,rep,name=chunks"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *GetManifestResponse) Reset() {
+	*x = GetManifestResponse{}
+	mi := &file_api_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCredentialRequest) String() string {
+func (x *GetManifestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCredentialRequest) ProtoMessage() {}
+func (*GetManifestResponse) ProtoMessage() {}
 
-func (x *CreateCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[5]
+func (x *GetManifestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -764,64 +6796,87 @@ func (x *CreateCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateCredentialRequest) GetCredential() *Credential {
+func (x *GetManifestResponse) GetChunked() bool {
 	if x != nil {
-		return x.xxx_hidden_Credential
+		return x.xxx_hidden_Chunked
+	}
+	return false
+}
+
+func (x *GetManifestResponse) GetChunks() []*ChunkRef {
+	if x != nil {
+		if x.xxx_hidden_Chunks != nil {
+			return *x.xxx_hidden_Chunks
+		}
 	}
 	return nil
 }
 
-func (x *CreateCredentialRequest) SetCredential(v *Credential) {
-	x.xxx_hidden_Credential = v
+func (x *GetManifestResponse) SetChunked(v bool) {
+	x.xxx_hidden_Chunked = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *CreateCredentialRequest) HasCredential() bool {
+func (x *GetManifestResponse) SetChunks(v []*ChunkRef) {
+	x.xxx_hidden_Chunks = &v
+}
+
+func (x *GetManifestResponse) HasChunked() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Credential != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *CreateCredentialRequest) ClearCredential() {
-	x.xxx_hidden_Credential = nil
+func (x *GetManifestResponse) ClearChunked() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Chunked = false
 }
 
-type CreateCredentialRequest_builder struct {
+type GetManifestResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Credential *Credential
+	Chunked *bool
+	Chunks  []*ChunkRef
 }
 
-func (b0 CreateCredentialRequest_builder) Build() *CreateCredentialRequest {
-	m0 := &CreateCredentialRequest{}
+func (b0 GetManifestResponse_builder) Build() *GetManifestResponse {
+	m0 := &GetManifestResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Credential = b.Credential
+	if b.Chunked != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Chunked = *b.Chunked
+	}
+	x.xxx_hidden_Chunks = &b.Chunks
 	return m0
 }
 
-type CreateCredentialResponse struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+// Скачивание одного зашифрованного фрагмента
+type DownloadChunkRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *CreateCredentialResponse) Reset() {
-	*x = CreateCredentialResponse{}
-	mi := &file_api_proto_msgTypes[6]
+func (x *DownloadChunkRequest) Reset() {
+	*x = DownloadChunkRequest{}
+	mi := &file_api_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateCredentialResponse) String() string {
+func (x *DownloadChunkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateCredentialResponse) ProtoMessage() {}
+func (*DownloadChunkRequest) ProtoMessage() {}
 
-func (x *CreateCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[6]
+func (x *DownloadChunkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,43 +6887,130 @@ func (x *CreateCredentialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateCredentialResponse) GetCredential() *Credential {
+func (x *DownloadChunkRequest) GetId() string {
 	if x != nil {
-		return x.xxx_hidden_Credential
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *DownloadChunkRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *DownloadChunkRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *DownloadChunkRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type DownloadChunkRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 DownloadChunkRequest_builder) Build() *DownloadChunkRequest {
+	m0 := &DownloadChunkRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type DownloadChunkResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Data        []byte                 `protobuf:"bytes,1,opt,name=data"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *DownloadChunkResponse) Reset() {
+	*x = DownloadChunkResponse{}
+	mi := &file_api_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadChunkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadChunkResponse) ProtoMessage() {}
+
+func (x *DownloadChunkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *DownloadChunkResponse) GetData() []byte {
+	if x != nil {
+		return x.xxx_hidden_Data
 	}
 	return nil
 }
 
-func (x *CreateCredentialResponse) SetCredential(v *Credential) {
-	x.xxx_hidden_Credential = v
+func (x *DownloadChunkResponse) SetData(v []byte) {
+	if v == nil {
+		v = []byte{}
+	}
+	x.xxx_hidden_Data = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *CreateCredentialResponse) HasCredential() bool {
+func (x *DownloadChunkResponse) HasData() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Credential != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *CreateCredentialResponse) ClearCredential() {
-	x.xxx_hidden_Credential = nil
+func (x *DownloadChunkResponse) ClearData() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Data = nil
 }
 
-type CreateCredentialResponse_builder struct {
+type DownloadChunkResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Credential *Credential
+	Data []byte
 }
 
-func (b0 CreateCredentialResponse_builder) Build() *CreateCredentialResponse {
-	m0 := &CreateCredentialResponse{}
+func (b0 DownloadChunkResponse_builder) Build() *DownloadChunkResponse {
+	m0 := &DownloadChunkResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Credential = b.Credential
+	if b.Data != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Data = b.Data
+	}
 	return m0
 }
 
-type GetCredentialByIDRequest struct {
+// Проверка целостности хранимого на сервере содержимого файла
+type VerifyBinaryDataRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
@@ -877,21 +7019,21 @@ type GetCredentialByIDRequest struct {
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetCredentialByIDRequest) Reset() {
-	*x = GetCredentialByIDRequest{}
-	mi := &file_api_proto_msgTypes[7]
+func (x *VerifyBinaryDataRequest) Reset() {
+	*x = VerifyBinaryDataRequest{}
+	mi := &file_api_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCredentialByIDRequest) String() string {
+func (x *VerifyBinaryDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCredentialByIDRequest) ProtoMessage() {}
+func (*VerifyBinaryDataRequest) ProtoMessage() {}
 
-func (x *GetCredentialByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[7]
+func (x *VerifyBinaryDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -902,7 +7044,7 @@ func (x *GetCredentialByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetCredentialByIDRequest) GetId() string {
+func (x *VerifyBinaryDataRequest) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -912,62 +7054,181 @@ func (x *GetCredentialByIDRequest) GetId() string {
 	return ""
 }
 
-func (x *GetCredentialByIDRequest) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *VerifyBinaryDataRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *VerifyBinaryDataRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *VerifyBinaryDataRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type VerifyBinaryDataRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id *string
+}
+
+func (b0 VerifyBinaryDataRequest_builder) Build() *VerifyBinaryDataRequest {
+	m0 := &VerifyBinaryDataRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type VerifyBinaryDataResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Valid       bool                   `protobuf:"varint,1,opt,name=valid"`
+	xxx_hidden_Detail      *string                `protobuf:"bytes,2,opt,name=detail"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *VerifyBinaryDataResponse) Reset() {
+	*x = VerifyBinaryDataResponse{}
+	mi := &file_api_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyBinaryDataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyBinaryDataResponse) ProtoMessage() {}
+
+func (x *VerifyBinaryDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+func (x *VerifyBinaryDataResponse) GetValid() bool {
+	if x != nil {
+		return x.xxx_hidden_Valid
+	}
+	return false
+}
+
+func (x *VerifyBinaryDataResponse) GetDetail() string {
+	if x != nil {
+		if x.xxx_hidden_Detail != nil {
+			return *x.xxx_hidden_Detail
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *VerifyBinaryDataResponse) SetValid(v bool) {
+	x.xxx_hidden_Valid = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *VerifyBinaryDataResponse) SetDetail(v string) {
+	x.xxx_hidden_Detail = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (x *GetCredentialByIDRequest) HasId() bool {
+func (x *VerifyBinaryDataResponse) HasValid() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GetCredentialByIDRequest) ClearId() {
+func (x *VerifyBinaryDataResponse) HasDetail() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *VerifyBinaryDataResponse) ClearValid() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_Valid = false
 }
 
-type GetCredentialByIDRequest_builder struct {
+func (x *VerifyBinaryDataResponse) ClearDetail() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Detail = nil
+}
+
+type VerifyBinaryDataResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	Valid  *bool
+	Detail *string
 }
 
-func (b0 GetCredentialByIDRequest_builder) Build() *GetCredentialByIDRequest {
-	m0 := &GetCredentialByIDRequest{}
+func (b0 VerifyBinaryDataResponse_builder) Build() *VerifyBinaryDataResponse {
+	m0 := &VerifyBinaryDataResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
+	if b.Valid != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Valid = *b.Valid
+	}
+	if b.Detail != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Detail = b.Detail
 	}
 	return m0
 }
 
-type GetCredentialByIDResponse struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
-}
-
-func (x *GetCredentialByIDResponse) Reset() {
-	*x = GetCredentialByIDResponse{}
-	mi := &file_api_proto_msgTypes[8]
+// Прежняя версия содержимого файла, сохранённая сервером при его замене
+type BinaryVersionInfo struct {
+	state                    protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id            *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Size          int64                  `protobuf:"varint,2,opt,name=size"`
+	xxx_hidden_Chunked       bool                   `protobuf:"varint,3,opt,name=chunked"`
+	xxx_hidden_Checksum      *string                `protobuf:"bytes,4,opt,name=checksum"`
+	xxx_hidden_PlainChecksum *string                `protobuf:"bytes,5,opt,name=plain_checksum,json=plainChecksum"`
+	xxx_hidden_Archive       *string                `protobuf:"bytes,6,opt,name=archive"`
+	xxx_hidden_ClientPath    *string                `protobuf:"bytes,7,opt,name=client_path,json=clientPath"`
+	xxx_hidden_UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt"`
+	XXX_raceDetectHookData   protoimpl.RaceDetectHookData
+	XXX_presence             [1]uint32
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *BinaryVersionInfo) Reset() {
+	*x = BinaryVersionInfo{}
+	mi := &file_api_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCredentialByIDResponse) String() string {
+func (x *BinaryVersionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCredentialByIDResponse) ProtoMessage() {}
+func (*BinaryVersionInfo) ProtoMessage() {}
 
-func (x *GetCredentialByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[8]
+func (x *BinaryVersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -978,238 +7239,286 @@ func (x *GetCredentialByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetCredentialByIDResponse) GetCredential() *Credential {
+func (x *BinaryVersionInfo) GetId() string {
 	if x != nil {
-		return x.xxx_hidden_Credential
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
 	}
-	return nil
-}
-
-func (x *GetCredentialByIDResponse) SetCredential(v *Credential) {
-	x.xxx_hidden_Credential = v
+	return ""
 }
 
-func (x *GetCredentialByIDResponse) HasCredential() bool {
-	if x == nil {
-		return false
+func (x *BinaryVersionInfo) GetSize() int64 {
+	if x != nil {
+		return x.xxx_hidden_Size
 	}
-	return x.xxx_hidden_Credential != nil
-}
-
-func (x *GetCredentialByIDResponse) ClearCredential() {
-	x.xxx_hidden_Credential = nil
+	return 0
 }
 
-type GetCredentialByIDResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	Credential *Credential
+func (x *BinaryVersionInfo) GetChunked() bool {
+	if x != nil {
+		return x.xxx_hidden_Chunked
+	}
+	return false
 }
 
-func (b0 GetCredentialByIDResponse_builder) Build() *GetCredentialByIDResponse {
-	m0 := &GetCredentialByIDResponse{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_Credential = b.Credential
-	return m0
+func (x *BinaryVersionInfo) GetChecksum() string {
+	if x != nil {
+		if x.xxx_hidden_Checksum != nil {
+			return *x.xxx_hidden_Checksum
+		}
+		return ""
+	}
+	return ""
 }
 
-type GetCredentialsResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Credentials *[]*Credential         `protobuf:"bytes,1,rep,name=credentials"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *BinaryVersionInfo) GetPlainChecksum() string {
+	if x != nil {
+		if x.xxx_hidden_PlainChecksum != nil {
+			return *x.xxx_hidden_PlainChecksum
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *GetCredentialsResponse) Reset() {
-	*x = GetCredentialsResponse{}
-	mi := &file_api_proto_msgTypes[9]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BinaryVersionInfo) GetArchive() string {
+	if x != nil {
+		if x.xxx_hidden_Archive != nil {
+			return *x.xxx_hidden_Archive
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *GetCredentialsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BinaryVersionInfo) GetClientPath() string {
+	if x != nil {
+		if x.xxx_hidden_ClientPath != nil {
+			return *x.xxx_hidden_ClientPath
+		}
+		return ""
+	}
+	return ""
 }
 
-func (*GetCredentialsResponse) ProtoMessage() {}
-
-func (x *GetCredentialsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[9]
+func (x *BinaryVersionInfo) GetUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.xxx_hidden_UpdatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-func (x *GetCredentialsResponse) GetCredentials() []*Credential {
+func (x *BinaryVersionInfo) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		if x.xxx_hidden_Credentials != nil {
-			return *x.xxx_hidden_Credentials
-		}
+		return x.xxx_hidden_CreatedAt
 	}
 	return nil
 }
 
-func (x *GetCredentialsResponse) SetCredentials(v []*Credential) {
-	x.xxx_hidden_Credentials = &v
+func (x *BinaryVersionInfo) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 9)
 }
 
-type GetCredentialsResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *BinaryVersionInfo) SetSize(v int64) {
+	x.xxx_hidden_Size = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 9)
+}
 
-	Credentials []*Credential
+func (x *BinaryVersionInfo) SetChunked(v bool) {
+	x.xxx_hidden_Chunked = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 9)
 }
 
-func (b0 GetCredentialsResponse_builder) Build() *GetCredentialsResponse {
-	m0 := &GetCredentialsResponse{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_Credentials = &b.Credentials
-	return m0
+func (x *BinaryVersionInfo) SetChecksum(v string) {
+	x.xxx_hidden_Checksum = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 9)
 }
 
-type UpdateCredentialRequest struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *BinaryVersionInfo) SetPlainChecksum(v string) {
+	x.xxx_hidden_PlainChecksum = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 9)
 }
 
-func (x *UpdateCredentialRequest) Reset() {
-	*x = UpdateCredentialRequest{}
-	mi := &file_api_proto_msgTypes[10]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BinaryVersionInfo) SetArchive(v string) {
+	x.xxx_hidden_Archive = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 9)
 }
 
-func (x *UpdateCredentialRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BinaryVersionInfo) SetClientPath(v string) {
+	x.xxx_hidden_ClientPath = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 9)
 }
 
-func (*UpdateCredentialRequest) ProtoMessage() {}
+func (x *BinaryVersionInfo) SetUpdatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_UpdatedAt = v
+}
 
-func (x *UpdateCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[10]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BinaryVersionInfo) SetCreatedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_CreatedAt = v
+}
+
+func (x *BinaryVersionInfo) HasId() bool {
+	if x == nil {
+		return false
 	}
-	return mi.MessageOf(x)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UpdateCredentialRequest) GetCredential() *Credential {
-	if x != nil {
-		return x.xxx_hidden_Credential
+func (x *BinaryVersionInfo) HasSize() bool {
+	if x == nil {
+		return false
 	}
-	return nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *UpdateCredentialRequest) SetCredential(v *Credential) {
-	x.xxx_hidden_Credential = v
+func (x *BinaryVersionInfo) HasChunked() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *UpdateCredentialRequest) HasCredential() bool {
+func (x *BinaryVersionInfo) HasChecksum() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Credential != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *UpdateCredentialRequest) ClearCredential() {
-	x.xxx_hidden_Credential = nil
+func (x *BinaryVersionInfo) HasPlainChecksum() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
 }
 
-type UpdateCredentialRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *BinaryVersionInfo) HasArchive() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
 
-	Credential *Credential
+func (x *BinaryVersionInfo) HasClientPath() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
 }
 
-func (b0 UpdateCredentialRequest_builder) Build() *UpdateCredentialRequest {
-	m0 := &UpdateCredentialRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_Credential = b.Credential
-	return m0
+func (x *BinaryVersionInfo) HasUpdatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_UpdatedAt != nil
 }
 
-type UpdateCredentialResponse struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Credential *Credential            `protobuf:"bytes,1,opt,name=credential"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *BinaryVersionInfo) HasCreatedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_CreatedAt != nil
 }
 
-func (x *UpdateCredentialResponse) Reset() {
-	*x = UpdateCredentialResponse{}
-	mi := &file_api_proto_msgTypes[11]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *BinaryVersionInfo) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
 }
 
-func (x *UpdateCredentialResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BinaryVersionInfo) ClearSize() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Size = 0
 }
 
-func (*UpdateCredentialResponse) ProtoMessage() {}
+func (x *BinaryVersionInfo) ClearChunked() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Chunked = false
+}
 
-func (x *UpdateCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[11]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *BinaryVersionInfo) ClearChecksum() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Checksum = nil
 }
 
-func (x *UpdateCredentialResponse) GetCredential() *Credential {
-	if x != nil {
-		return x.xxx_hidden_Credential
-	}
-	return nil
+func (x *BinaryVersionInfo) ClearPlainChecksum() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_PlainChecksum = nil
 }
 
-func (x *UpdateCredentialResponse) SetCredential(v *Credential) {
-	x.xxx_hidden_Credential = v
+func (x *BinaryVersionInfo) ClearArchive() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_Archive = nil
 }
 
-func (x *UpdateCredentialResponse) HasCredential() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_Credential != nil
+func (x *BinaryVersionInfo) ClearClientPath() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_ClientPath = nil
 }
 
-func (x *UpdateCredentialResponse) ClearCredential() {
-	x.xxx_hidden_Credential = nil
+func (x *BinaryVersionInfo) ClearUpdatedAt() {
+	x.xxx_hidden_UpdatedAt = nil
 }
 
-type UpdateCredentialResponse_builder struct {
+func (x *BinaryVersionInfo) ClearCreatedAt() {
+	x.xxx_hidden_CreatedAt = nil
+}
+
+type BinaryVersionInfo_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Credential *Credential
+	Id            *string
+	Size          *int64
+	Chunked       *bool
+	Checksum      *string
+	PlainChecksum *string
+	Archive       *string
+	ClientPath    *string
+	UpdatedAt     *timestamppb.Timestamp
+	CreatedAt     *timestamppb.Timestamp
 }
 
-func (b0 UpdateCredentialResponse_builder) Build() *UpdateCredentialResponse {
-	m0 := &UpdateCredentialResponse{}
+func (b0 BinaryVersionInfo_builder) Build() *BinaryVersionInfo {
+	m0 := &BinaryVersionInfo{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Credential = b.Credential
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 9)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Size != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 9)
+		x.xxx_hidden_Size = *b.Size
+	}
+	if b.Chunked != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 9)
+		x.xxx_hidden_Chunked = *b.Chunked
+	}
+	if b.Checksum != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 9)
+		x.xxx_hidden_Checksum = b.Checksum
+	}
+	if b.PlainChecksum != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 9)
+		x.xxx_hidden_PlainChecksum = b.PlainChecksum
+	}
+	if b.Archive != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 9)
+		x.xxx_hidden_Archive = b.Archive
+	}
+	if b.ClientPath != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 9)
+		x.xxx_hidden_ClientPath = b.ClientPath
+	}
+	x.xxx_hidden_UpdatedAt = b.UpdatedAt
+	x.xxx_hidden_CreatedAt = b.CreatedAt
 	return m0
 }
 
-type DeleteCredentialRequest struct {
+// Запрос прежних версий содержимого файла
+type ListBinaryVersionsRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
@@ -1218,21 +7527,21 @@ type DeleteCredentialRequest struct {
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *DeleteCredentialRequest) Reset() {
-	*x = DeleteCredentialRequest{}
-	mi := &file_api_proto_msgTypes[12]
+func (x *ListBinaryVersionsRequest) Reset() {
+	*x = ListBinaryVersionsRequest{}
+	mi := &file_api_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCredentialRequest) String() string {
+func (x *ListBinaryVersionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCredentialRequest) ProtoMessage() {}
+func (*ListBinaryVersionsRequest) ProtoMessage() {}
 
-func (x *DeleteCredentialRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[12]
+func (x *ListBinaryVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1243,7 +7552,7 @@ func (x *DeleteCredentialRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteCredentialRequest) GetId() string {
+func (x *ListBinaryVersionsRequest) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -1253,31 +7562,31 @@ func (x *DeleteCredentialRequest) GetId() string {
 	return ""
 }
 
-func (x *DeleteCredentialRequest) SetId(v string) {
+func (x *ListBinaryVersionsRequest) SetId(v string) {
 	x.xxx_hidden_Id = &v
 	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *DeleteCredentialRequest) HasId() bool {
+func (x *ListBinaryVersionsRequest) HasId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *DeleteCredentialRequest) ClearId() {
+func (x *ListBinaryVersionsRequest) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
 }
 
-type DeleteCredentialRequest_builder struct {
+type ListBinaryVersionsRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
 	Id *string
 }
 
-func (b0 DeleteCredentialRequest_builder) Build() *DeleteCredentialRequest {
-	m0 := &DeleteCredentialRequest{}
+func (b0 ListBinaryVersionsRequest_builder) Build() *ListBinaryVersionsRequest {
+	m0 := &ListBinaryVersionsRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
@@ -1287,30 +7596,28 @@ func (b0 DeleteCredentialRequest_builder) Build() *DeleteCredentialRequest {
 	return m0
 }
 
-type DeleteCredentialResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type ListBinaryVersionsResponse struct {
+	state               protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Versions *[]*BinaryVersionInfo  `protobuf:"bytes,1,rep,name=versions"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *DeleteCredentialResponse) Reset() {
-	*x = DeleteCredentialResponse{}
-	mi := &file_api_proto_msgTypes[13]
+func (x *ListBinaryVersionsResponse) Reset() {
+	*x = ListBinaryVersionsResponse{}
+	mi := &file_api_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteCredentialResponse) String() string {
+func (x *ListBinaryVersionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteCredentialResponse) ProtoMessage() {}
+func (*ListBinaryVersionsResponse) ProtoMessage() {}
 
-func (x *DeleteCredentialResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[13]
+func (x *ListBinaryVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1321,81 +7628,60 @@ func (x *DeleteCredentialResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteCredentialResponse) GetSuccess() bool {
+func (x *ListBinaryVersionsResponse) GetVersions() []*BinaryVersionInfo {
 	if x != nil {
-		return x.xxx_hidden_Success
-	}
-	return false
-}
-
-func (x *DeleteCredentialResponse) SetSuccess(v bool) {
-	x.xxx_hidden_Success = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
-}
-
-func (x *DeleteCredentialResponse) HasSuccess() bool {
-	if x == nil {
-		return false
+		if x.xxx_hidden_Versions != nil {
+			return *x.xxx_hidden_Versions
+		}
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return nil
 }
 
-func (x *DeleteCredentialResponse) ClearSuccess() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Success = false
+func (x *ListBinaryVersionsResponse) SetVersions(v []*BinaryVersionInfo) {
+	x.xxx_hidden_Versions = &v
 }
 
-type DeleteCredentialResponse_builder struct {
+type ListBinaryVersionsResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Success *bool
+	Versions []*BinaryVersionInfo
 }
 
-func (b0 DeleteCredentialResponse_builder) Build() *DeleteCredentialResponse {
-	m0 := &DeleteCredentialResponse{}
+func (b0 ListBinaryVersionsResponse_builder) Build() *ListBinaryVersionsResponse {
+	m0 := &ListBinaryVersionsResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Success != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Success = *b.Success
-	}
+	x.xxx_hidden_Versions = &b.Versions
 	return m0
 }
 
-// Сообщения для BankCard
-type BankCard struct {
-	state                     protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id             *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_UserId         *string                `protobuf:"bytes,2,opt,name=user_id,json=userId"`
-	xxx_hidden_Title          *string                `protobuf:"bytes,3,opt,name=title"`
-	xxx_hidden_CardholderName *string                `protobuf:"bytes,4,opt,name=cardholder_name,json=cardholderName"`
-	xxx_hidden_CardNumber     *string                `protobuf:"bytes,5,opt,name=card_number,json=cardNumber"`
-	xxx_hidden_ExpiryDate     *string                `protobuf:"bytes,6,opt,name=expiry_date,json=expiryDate"`
-	xxx_hidden_Cvv            *string                `protobuf:"bytes,7,opt,name=cvv"`
-	xxx_hidden_Metadata       *string                `protobuf:"bytes,8,opt,name=metadata"`
-	xxx_hidden_CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt"`
-	xxx_hidden_UpdatedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt"`
-	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
-	XXX_presence              [1]uint32
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+// Восстановление прежней версии содержимого файла
+type RestoreBinaryVersionRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_VersionId   *string                `protobuf:"bytes,2,opt,name=version_id,json=versionId"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,3,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *BankCard) Reset() {
-	*x = BankCard{}
-	mi := &file_api_proto_msgTypes[14]
+func (x *RestoreBinaryVersionRequest) Reset() {
+	*x = RestoreBinaryVersionRequest{}
+	mi := &file_api_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BankCard) String() string {
+func (x *RestoreBinaryVersionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BankCard) ProtoMessage() {}
+func (*RestoreBinaryVersionRequest) ProtoMessage() {}
 
-func (x *BankCard) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[14]
+func (x *RestoreBinaryVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1406,7 +7692,7 @@ func (x *BankCard) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *BankCard) GetId() string {
+func (x *RestoreBinaryVersionRequest) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -1416,334 +7702,278 @@ func (x *BankCard) GetId() string {
 	return ""
 }
 
-func (x *BankCard) GetUserId() string {
-	if x != nil {
-		if x.xxx_hidden_UserId != nil {
-			return *x.xxx_hidden_UserId
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *BankCard) GetTitle() string {
-	if x != nil {
-		if x.xxx_hidden_Title != nil {
-			return *x.xxx_hidden_Title
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *BankCard) GetCardholderName() string {
-	if x != nil {
-		if x.xxx_hidden_CardholderName != nil {
-			return *x.xxx_hidden_CardholderName
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *BankCard) GetCardNumber() string {
-	if x != nil {
-		if x.xxx_hidden_CardNumber != nil {
-			return *x.xxx_hidden_CardNumber
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *BankCard) GetExpiryDate() string {
-	if x != nil {
-		if x.xxx_hidden_ExpiryDate != nil {
-			return *x.xxx_hidden_ExpiryDate
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *BankCard) GetCvv() string {
-	if x != nil {
-		if x.xxx_hidden_Cvv != nil {
-			return *x.xxx_hidden_Cvv
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *BankCard) GetMetadata() string {
+func (x *RestoreBinaryVersionRequest) GetVersionId() string {
 	if x != nil {
-		if x.xxx_hidden_Metadata != nil {
-			return *x.xxx_hidden_Metadata
+		if x.xxx_hidden_VersionId != nil {
+			return *x.xxx_hidden_VersionId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *BankCard) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.xxx_hidden_CreatedAt
-	}
-	return nil
-}
-
-func (x *BankCard) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *RestoreBinaryVersionRequest) GetVersion() int64 {
 	if x != nil {
-		return x.xxx_hidden_UpdatedAt
+		return x.xxx_hidden_Version
 	}
-	return nil
+	return 0
 }
 
-func (x *BankCard) SetId(v string) {
+func (x *RestoreBinaryVersionRequest) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 10)
-}
-
-func (x *BankCard) SetUserId(v string) {
-	x.xxx_hidden_UserId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 10)
-}
-
-func (x *BankCard) SetTitle(v string) {
-	x.xxx_hidden_Title = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 10)
-}
-
-func (x *BankCard) SetCardholderName(v string) {
-	x.xxx_hidden_CardholderName = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 10)
-}
-
-func (x *BankCard) SetCardNumber(v string) {
-	x.xxx_hidden_CardNumber = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 10)
-}
-
-func (x *BankCard) SetExpiryDate(v string) {
-	x.xxx_hidden_ExpiryDate = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 10)
-}
-
-func (x *BankCard) SetCvv(v string) {
-	x.xxx_hidden_Cvv = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 10)
-}
-
-func (x *BankCard) SetMetadata(v string) {
-	x.xxx_hidden_Metadata = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 7, 10)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
 }
 
-func (x *BankCard) SetCreatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_CreatedAt = v
+func (x *RestoreBinaryVersionRequest) SetVersionId(v string) {
+	x.xxx_hidden_VersionId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *BankCard) SetUpdatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_UpdatedAt = v
+func (x *RestoreBinaryVersionRequest) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (x *BankCard) HasId() bool {
+func (x *RestoreBinaryVersionRequest) HasId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *BankCard) HasUserId() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
-}
-
-func (x *BankCard) HasTitle() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
-}
-
-func (x *BankCard) HasCardholderName() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
-}
-
-func (x *BankCard) HasCardNumber() bool {
+func (x *RestoreBinaryVersionRequest) HasVersionId() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *BankCard) HasExpiryDate() bool {
+func (x *RestoreBinaryVersionRequest) HasVersion() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *BankCard) HasCvv() bool {
-	if x == nil {
-		return false
+func (x *RestoreBinaryVersionRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *RestoreBinaryVersionRequest) ClearVersionId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_VersionId = nil
+}
+
+func (x *RestoreBinaryVersionRequest) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Version = 0
+}
+
+type RestoreBinaryVersionRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Id        *string
+	VersionId *string
+	Version   *int64
+}
+
+func (b0 RestoreBinaryVersionRequest_builder) Build() *RestoreBinaryVersionRequest {
+	m0 := &RestoreBinaryVersionRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Id = b.Id
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
+	if b.VersionId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_VersionId = b.VersionId
+	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
 }
 
-func (x *BankCard) HasMetadata() bool {
-	if x == nil {
-		return false
+type RestoreBinaryVersionResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,1,opt,name=version"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RestoreBinaryVersionResponse) Reset() {
+	*x = RestoreBinaryVersionResponse{}
+	mi := &file_api_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreBinaryVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreBinaryVersionResponse) ProtoMessage() {}
+
+func (x *RestoreBinaryVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 7)
+	return mi.MessageOf(x)
 }
 
-func (x *BankCard) HasCreatedAt() bool {
-	if x == nil {
-		return false
+func (x *RestoreBinaryVersionResponse) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
 	}
-	return x.xxx_hidden_CreatedAt != nil
+	return 0
 }
 
-func (x *BankCard) HasUpdatedAt() bool {
+func (x *RestoreBinaryVersionResponse) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+}
+
+func (x *RestoreBinaryVersionResponse) HasVersion() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_UpdatedAt != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *BankCard) ClearId() {
+func (x *RestoreBinaryVersionResponse) ClearVersion() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_Version = 0
 }
 
-func (x *BankCard) ClearUserId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_UserId = nil
+type RestoreBinaryVersionResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Version *int64
 }
 
-func (x *BankCard) ClearTitle() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Title = nil
+func (b0 RestoreBinaryVersionResponse_builder) Build() *RestoreBinaryVersionResponse {
+	m0 := &RestoreBinaryVersionResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Version = *b.Version
+	}
+	return m0
 }
 
-func (x *BankCard) ClearCardholderName() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_CardholderName = nil
+// Запрос информации о файле
+type GetBinaryDataInfoRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *BankCard) ClearCardNumber() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_CardNumber = nil
+func (x *GetBinaryDataInfoRequest) Reset() {
+	*x = GetBinaryDataInfoRequest{}
+	mi := &file_api_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *BankCard) ClearExpiryDate() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
-	x.xxx_hidden_ExpiryDate = nil
+func (x *GetBinaryDataInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BankCard) ClearCvv() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
-	x.xxx_hidden_Cvv = nil
+func (*GetBinaryDataInfoRequest) ProtoMessage() {}
+
+func (x *GetBinaryDataInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *BankCard) ClearMetadata() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 7)
-	x.xxx_hidden_Metadata = nil
+func (x *GetBinaryDataInfoRequest) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *BankCard) ClearCreatedAt() {
-	x.xxx_hidden_CreatedAt = nil
+func (x *GetBinaryDataInfoRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *BankCard) ClearUpdatedAt() {
-	x.xxx_hidden_UpdatedAt = nil
+func (x *GetBinaryDataInfoRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-type BankCard_builder struct {
+func (x *GetBinaryDataInfoRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type GetBinaryDataInfoRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id             *string
-	UserId         *string
-	Title          *string
-	CardholderName *string
-	CardNumber     *string
-	ExpiryDate     *string
-	Cvv            *string
-	Metadata       *string
-	CreatedAt      *timestamppb.Timestamp
-	UpdatedAt      *timestamppb.Timestamp
+	Id *string
 }
 
-func (b0 BankCard_builder) Build() *BankCard {
-	m0 := &BankCard{}
+func (b0 GetBinaryDataInfoRequest_builder) Build() *GetBinaryDataInfoRequest {
+	m0 := &GetBinaryDataInfoRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 10)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
 		x.xxx_hidden_Id = b.Id
 	}
-	if b.UserId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 10)
-		x.xxx_hidden_UserId = b.UserId
-	}
-	if b.Title != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 10)
-		x.xxx_hidden_Title = b.Title
-	}
-	if b.CardholderName != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 10)
-		x.xxx_hidden_CardholderName = b.CardholderName
-	}
-	if b.CardNumber != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 10)
-		x.xxx_hidden_CardNumber = b.CardNumber
-	}
-	if b.ExpiryDate != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 10)
-		x.xxx_hidden_ExpiryDate = b.ExpiryDate
-	}
-	if b.Cvv != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 10)
-		x.xxx_hidden_Cvv = b.Cvv
-	}
-	if b.Metadata != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 7, 10)
-		x.xxx_hidden_Metadata = b.Metadata
-	}
-	x.xxx_hidden_CreatedAt = b.CreatedAt
-	x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	return m0
 }
 
-type CreateBankCardRequest struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// Ответ с метаданными файла
+type GetBinaryDataInfoResponse struct {
+	state                 protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_BinaryInfo *BinaryDataInfo        `protobuf:"bytes,1,opt,name=binary_info,json=binaryInfo"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *CreateBankCardRequest) Reset() {
-	*x = CreateBankCardRequest{}
-	mi := &file_api_proto_msgTypes[15]
+func (x *GetBinaryDataInfoResponse) Reset() {
+	*x = GetBinaryDataInfoResponse{}
+	mi := &file_api_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBankCardRequest) String() string {
+func (x *GetBinaryDataInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBankCardRequest) ProtoMessage() {}
+func (*GetBinaryDataInfoResponse) ProtoMessage() {}
 
-func (x *CreateBankCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[15]
+func (x *GetBinaryDataInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1754,64 +7984,65 @@ func (x *CreateBankCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateBankCardRequest) GetBankCard() *BankCard {
+func (x *GetBinaryDataInfoResponse) GetBinaryInfo() *BinaryDataInfo {
 	if x != nil {
-		return x.xxx_hidden_BankCard
+		return x.xxx_hidden_BinaryInfo
 	}
 	return nil
 }
 
-func (x *CreateBankCardRequest) SetBankCard(v *BankCard) {
-	x.xxx_hidden_BankCard = v
+func (x *GetBinaryDataInfoResponse) SetBinaryInfo(v *BinaryDataInfo) {
+	x.xxx_hidden_BinaryInfo = v
 }
 
-func (x *CreateBankCardRequest) HasBankCard() bool {
+func (x *GetBinaryDataInfoResponse) HasBinaryInfo() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_BankCard != nil
+	return x.xxx_hidden_BinaryInfo != nil
 }
 
-func (x *CreateBankCardRequest) ClearBankCard() {
-	x.xxx_hidden_BankCard = nil
+func (x *GetBinaryDataInfoResponse) ClearBinaryInfo() {
+	x.xxx_hidden_BinaryInfo = nil
 }
 
-type CreateBankCardRequest_builder struct {
+type GetBinaryDataInfoResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	BankCard *BankCard
+	BinaryInfo *BinaryDataInfo
 }
 
-func (b0 CreateBankCardRequest_builder) Build() *CreateBankCardRequest {
-	m0 := &CreateBankCardRequest{}
+func (b0 GetBinaryDataInfoResponse_builder) Build() *GetBinaryDataInfoResponse {
+	m0 := &GetBinaryDataInfoResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_BankCard = b.BankCard
+	x.xxx_hidden_BinaryInfo = b.BinaryInfo
 	return m0
 }
 
-type CreateBankCardResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
-}
-
-func (x *CreateBankCardResponse) Reset() {
-	*x = CreateBankCardResponse{}
-	mi := &file_api_proto_msgTypes[16]
+// Запрос для обновления бинарных данных (поток)
+type UpdateBinaryDataRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Info *BinaryDataInfo        `protobuf:"bytes,2,opt,name=info"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateBinaryDataRequest) Reset() {
+	*x = UpdateBinaryDataRequest{}
+	mi := &file_api_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateBankCardResponse) String() string {
+func (x *UpdateBinaryDataRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBankCardResponse) ProtoMessage() {}
+func (*UpdateBinaryDataRequest) ProtoMessage() {}
 
-func (x *CreateBankCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[16]
+func (x *UpdateBinaryDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1822,66 +8053,68 @@ func (x *CreateBankCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateBankCardResponse) GetBankCard() *BankCard {
+func (x *UpdateBinaryDataRequest) GetInfo() *BinaryDataInfo {
 	if x != nil {
-		return x.xxx_hidden_BankCard
+		return x.xxx_hidden_Info
 	}
 	return nil
 }
 
-func (x *CreateBankCardResponse) SetBankCard(v *BankCard) {
-	x.xxx_hidden_BankCard = v
+func (x *UpdateBinaryDataRequest) SetInfo(v *BinaryDataInfo) {
+	x.xxx_hidden_Info = v
 }
 
-func (x *CreateBankCardResponse) HasBankCard() bool {
+func (x *UpdateBinaryDataRequest) HasInfo() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_BankCard != nil
+	return x.xxx_hidden_Info != nil
 }
 
-func (x *CreateBankCardResponse) ClearBankCard() {
-	x.xxx_hidden_BankCard = nil
+func (x *UpdateBinaryDataRequest) ClearInfo() {
+	x.xxx_hidden_Info = nil
 }
 
-type CreateBankCardResponse_builder struct {
+type UpdateBinaryDataRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	BankCard *BankCard
+	Info *BinaryDataInfo
 }
 
-func (b0 CreateBankCardResponse_builder) Build() *CreateBankCardResponse {
-	m0 := &CreateBankCardResponse{}
+func (b0 UpdateBinaryDataRequest_builder) Build() *UpdateBinaryDataRequest {
+	m0 := &UpdateBinaryDataRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_BankCard = b.BankCard
+	x.xxx_hidden_Info = b.Info
 	return m0
 }
 
-type GetBankCardByIDRequest struct {
+// Ответ после завершения обновления
+type UpdateBinaryDataResponse struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,2,opt,name=version"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetBankCardByIDRequest) Reset() {
-	*x = GetBankCardByIDRequest{}
-	mi := &file_api_proto_msgTypes[17]
+func (x *UpdateBinaryDataResponse) Reset() {
+	*x = UpdateBinaryDataResponse{}
+	mi := &file_api_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBankCardByIDRequest) String() string {
+func (x *UpdateBinaryDataResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBankCardByIDRequest) ProtoMessage() {}
+func (*UpdateBinaryDataResponse) ProtoMessage() {}
 
-func (x *GetBankCardByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[17]
+func (x *UpdateBinaryDataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1892,7 +8125,7 @@ func (x *GetBankCardByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetBankCardByIDRequest) GetId() string {
+func (x *UpdateBinaryDataResponse) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -1902,62 +8135,91 @@ func (x *GetBankCardByIDRequest) GetId() string {
 	return ""
 }
 
-func (x *GetBankCardByIDRequest) SetId(v string) {
+func (x *UpdateBinaryDataResponse) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *UpdateBinaryDataResponse) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
 }
 
-func (x *GetBankCardByIDRequest) HasId() bool {
+func (x *UpdateBinaryDataResponse) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *UpdateBinaryDataResponse) HasId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *GetBankCardByIDRequest) ClearId() {
+func (x *UpdateBinaryDataResponse) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *UpdateBinaryDataResponse) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
 }
 
-type GetBankCardByIDRequest_builder struct {
+func (x *UpdateBinaryDataResponse) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+type UpdateBinaryDataResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	Id      *string
+	Version *int64
 }
 
-func (b0 GetBankCardByIDRequest_builder) Build() *GetBankCardByIDRequest {
-	m0 := &GetBankCardByIDRequest{}
+func (b0 UpdateBinaryDataResponse_builder) Build() *UpdateBinaryDataResponse {
+	m0 := &UpdateBinaryDataResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
 		x.xxx_hidden_Id = b.Id
 	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Version = *b.Version
+	}
 	return m0
 }
 
-type GetBankCardByIDResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type SaveBinaryDataInfoRequest struct {
+	state           protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Info *BinaryDataInfo        `protobuf:"bytes,1,opt,name=info"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *GetBankCardByIDResponse) Reset() {
-	*x = GetBankCardByIDResponse{}
-	mi := &file_api_proto_msgTypes[18]
+func (x *SaveBinaryDataInfoRequest) Reset() {
+	*x = SaveBinaryDataInfoRequest{}
+	mi := &file_api_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBankCardByIDResponse) String() string {
+func (x *SaveBinaryDataInfoRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBankCardByIDResponse) ProtoMessage() {}
+func (*SaveBinaryDataInfoRequest) ProtoMessage() {}
 
-func (x *GetBankCardByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[18]
+func (x *SaveBinaryDataInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,64 +8230,66 @@ func (x *GetBankCardByIDResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetBankCardByIDResponse) GetBankCard() *BankCard {
+func (x *SaveBinaryDataInfoRequest) GetInfo() *BinaryDataInfo {
 	if x != nil {
-		return x.xxx_hidden_BankCard
+		return x.xxx_hidden_Info
 	}
 	return nil
 }
 
-func (x *GetBankCardByIDResponse) SetBankCard(v *BankCard) {
-	x.xxx_hidden_BankCard = v
+func (x *SaveBinaryDataInfoRequest) SetInfo(v *BinaryDataInfo) {
+	x.xxx_hidden_Info = v
 }
 
-func (x *GetBankCardByIDResponse) HasBankCard() bool {
+func (x *SaveBinaryDataInfoRequest) HasInfo() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_BankCard != nil
+	return x.xxx_hidden_Info != nil
 }
 
-func (x *GetBankCardByIDResponse) ClearBankCard() {
-	x.xxx_hidden_BankCard = nil
+func (x *SaveBinaryDataInfoRequest) ClearInfo() {
+	x.xxx_hidden_Info = nil
 }
 
-type GetBankCardByIDResponse_builder struct {
+type SaveBinaryDataInfoRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	BankCard *BankCard
+	Info *BinaryDataInfo
 }
 
-func (b0 GetBankCardByIDResponse_builder) Build() *GetBankCardByIDResponse {
-	m0 := &GetBankCardByIDResponse{}
+func (b0 SaveBinaryDataInfoRequest_builder) Build() *SaveBinaryDataInfoRequest {
+	m0 := &SaveBinaryDataInfoRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_BankCard = b.BankCard
+	x.xxx_hidden_Info = b.Info
 	return m0
 }
 
-type GetBankCardsResponse struct {
-	state                protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_BankCards *[]*BankCard           `protobuf:"bytes,1,rep,name=bank_cards,json=bankCards"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type SaveBinaryDataInfoResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetBankCardsResponse) Reset() {
-	*x = GetBankCardsResponse{}
-	mi := &file_api_proto_msgTypes[19]
+func (x *SaveBinaryDataInfoResponse) Reset() {
+	*x = SaveBinaryDataInfoResponse{}
+	mi := &file_api_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetBankCardsResponse) String() string {
+func (x *SaveBinaryDataInfoResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBankCardsResponse) ProtoMessage() {}
+func (*SaveBinaryDataInfoResponse) ProtoMessage() {}
 
-func (x *GetBankCardsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[19]
+func (x *SaveBinaryDataInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2036,55 +8300,79 @@ func (x *GetBankCardsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetBankCardsResponse) GetBankCards() []*BankCard {
+func (x *SaveBinaryDataInfoResponse) GetId() string {
 	if x != nil {
-		if x.xxx_hidden_BankCards != nil {
-			return *x.xxx_hidden_BankCards
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
 		}
+		return ""
 	}
-	return nil
+	return ""
 }
 
-func (x *GetBankCardsResponse) SetBankCards(v []*BankCard) {
-	x.xxx_hidden_BankCards = &v
+func (x *SaveBinaryDataInfoResponse) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-type GetBankCardsResponse_builder struct {
+func (x *SaveBinaryDataInfoResponse) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *SaveBinaryDataInfoResponse) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Id = nil
+}
+
+type SaveBinaryDataInfoResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	BankCards []*BankCard
+	Id *string
 }
 
-func (b0 GetBankCardsResponse_builder) Build() *GetBankCardsResponse {
-	m0 := &GetBankCardsResponse{}
+func (b0 SaveBinaryDataInfoResponse_builder) Build() *SaveBinaryDataInfoResponse {
+	m0 := &SaveBinaryDataInfoResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_BankCards = &b.BankCards
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Id = b.Id
+	}
 	return m0
 }
 
-type UpdateBankCardRequest struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// Краткое описание записи любого типа
+type ItemSummary struct {
+	state                     protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Type           ItemType               `protobuf:"varint,1,opt,name=type,enum=gophkeeper.proto.ItemType"`
+	xxx_hidden_Id             *string                `protobuf:"bytes,2,opt,name=id"`
+	xxx_hidden_Title          *string                `protobuf:"bytes,3,opt,name=title"`
+	xxx_hidden_Favorite       bool                   `protobuf:"varint,4,opt,name=favorite"`
+	xxx_hidden_LastAccessedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_accessed_at,json=lastAccessedAt"`
+	XXX_raceDetectHookData    protoimpl.RaceDetectHookData
+	XXX_presence              [1]uint32
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *UpdateBankCardRequest) Reset() {
-	*x = UpdateBankCardRequest{}
-	mi := &file_api_proto_msgTypes[20]
+func (x *ItemSummary) Reset() {
+	*x = ItemSummary{}
+	mi := &file_api_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBankCardRequest) String() string {
+func (x *ItemSummary) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBankCardRequest) ProtoMessage() {}
+func (*ItemSummary) ProtoMessage() {}
 
-func (x *UpdateBankCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[20]
+func (x *ItemSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2095,134 +8383,192 @@ func (x *UpdateBankCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateBankCardRequest) GetBankCard() *BankCard {
+func (x *ItemSummary) GetType() ItemType {
 	if x != nil {
-		return x.xxx_hidden_BankCard
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Type
+		}
 	}
-	return nil
+	return ItemType_ITEM_TYPE_UNSPECIFIED
 }
 
-func (x *UpdateBankCardRequest) SetBankCard(v *BankCard) {
-	x.xxx_hidden_BankCard = v
+func (x *ItemSummary) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
+	}
+	return ""
 }
 
-func (x *UpdateBankCardRequest) HasBankCard() bool {
-	if x == nil {
-		return false
+func (x *ItemSummary) GetTitle() string {
+	if x != nil {
+		if x.xxx_hidden_Title != nil {
+			return *x.xxx_hidden_Title
+		}
+		return ""
 	}
-	return x.xxx_hidden_BankCard != nil
+	return ""
 }
 
-func (x *UpdateBankCardRequest) ClearBankCard() {
-	x.xxx_hidden_BankCard = nil
+func (x *ItemSummary) GetFavorite() bool {
+	if x != nil {
+		return x.xxx_hidden_Favorite
+	}
+	return false
 }
 
-type UpdateBankCardRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	BankCard *BankCard
+func (x *ItemSummary) GetLastAccessedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_LastAccessedAt
+	}
+	return nil
 }
-
-func (b0 UpdateBankCardRequest_builder) Build() *UpdateBankCardRequest {
-	m0 := &UpdateBankCardRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_BankCard = b.BankCard
-	return m0
+
+func (x *ItemSummary) SetType(v ItemType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 5)
 }
 
-type UpdateBankCardResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_BankCard *BankCard              `protobuf:"bytes,1,opt,name=bank_card,json=bankCard"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *ItemSummary) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 5)
 }
 
-func (x *UpdateBankCardResponse) Reset() {
-	*x = UpdateBankCardResponse{}
-	mi := &file_api_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ItemSummary) SetTitle(v string) {
+	x.xxx_hidden_Title = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 5)
 }
 
-func (x *UpdateBankCardResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ItemSummary) SetFavorite(v bool) {
+	x.xxx_hidden_Favorite = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 5)
 }
 
-func (*UpdateBankCardResponse) ProtoMessage() {}
+func (x *ItemSummary) SetLastAccessedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_LastAccessedAt = v
+}
 
-func (x *UpdateBankCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[21]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ItemSummary) HasType() bool {
+	if x == nil {
+		return false
 	}
-	return mi.MessageOf(x)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UpdateBankCardResponse) GetBankCard() *BankCard {
-	if x != nil {
-		return x.xxx_hidden_BankCard
+func (x *ItemSummary) HasId() bool {
+	if x == nil {
+		return false
 	}
-	return nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *UpdateBankCardResponse) SetBankCard(v *BankCard) {
-	x.xxx_hidden_BankCard = v
+func (x *ItemSummary) HasTitle() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *UpdateBankCardResponse) HasBankCard() bool {
+func (x *ItemSummary) HasFavorite() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_BankCard != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *UpdateBankCardResponse) ClearBankCard() {
-	x.xxx_hidden_BankCard = nil
+func (x *ItemSummary) HasLastAccessedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_LastAccessedAt != nil
 }
 
-type UpdateBankCardResponse_builder struct {
+func (x *ItemSummary) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Type = ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *ItemSummary) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ItemSummary) ClearTitle() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Title = nil
+}
+
+func (x *ItemSummary) ClearFavorite() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Favorite = false
+}
+
+func (x *ItemSummary) ClearLastAccessedAt() {
+	x.xxx_hidden_LastAccessedAt = nil
+}
+
+type ItemSummary_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	BankCard *BankCard
+	Type           *ItemType
+	Id             *string
+	Title          *string
+	Favorite       *bool
+	LastAccessedAt *timestamppb.Timestamp
 }
 
-func (b0 UpdateBankCardResponse_builder) Build() *UpdateBankCardResponse {
-	m0 := &UpdateBankCardResponse{}
+func (b0 ItemSummary_builder) Build() *ItemSummary {
+	m0 := &ItemSummary{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_BankCard = b.BankCard
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 5)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 5)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Title != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 5)
+		x.xxx_hidden_Title = b.Title
+	}
+	if b.Favorite != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 5)
+		x.xxx_hidden_Favorite = *b.Favorite
+	}
+	x.xxx_hidden_LastAccessedAt = b.LastAccessedAt
 	return m0
 }
 
-type DeleteBankCardRequest struct {
+type SetFavoriteRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Type        ItemType               `protobuf:"varint,1,opt,name=type,enum=gophkeeper.proto.ItemType"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,2,opt,name=id"`
+	xxx_hidden_Favorite    bool                   `protobuf:"varint,3,opt,name=favorite"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *DeleteBankCardRequest) Reset() {
-	*x = DeleteBankCardRequest{}
-	mi := &file_api_proto_msgTypes[22]
+func (x *SetFavoriteRequest) Reset() {
+	*x = SetFavoriteRequest{}
+	mi := &file_api_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBankCardRequest) String() string {
+func (x *SetFavoriteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBankCardRequest) ProtoMessage() {}
+func (*SetFavoriteRequest) ProtoMessage() {}
 
-func (x *DeleteBankCardRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[22]
+func (x *SetFavoriteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2233,7 +8579,16 @@ func (x *DeleteBankCardRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteBankCardRequest) GetId() string {
+func (x *SetFavoriteRequest) GetType() ItemType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *SetFavoriteRequest) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -2243,64 +8598,112 @@ func (x *DeleteBankCardRequest) GetId() string {
 	return ""
 }
 
-func (x *DeleteBankCardRequest) SetId(v string) {
+func (x *SetFavoriteRequest) GetFavorite() bool {
+	if x != nil {
+		return x.xxx_hidden_Favorite
+	}
+	return false
+}
+
+func (x *SetFavoriteRequest) SetType(v ItemType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 3)
+}
+
+func (x *SetFavoriteRequest) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
 }
 
-func (x *DeleteBankCardRequest) HasId() bool {
+func (x *SetFavoriteRequest) SetFavorite(v bool) {
+	x.xxx_hidden_Favorite = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
+}
+
+func (x *SetFavoriteRequest) HasType() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *DeleteBankCardRequest) ClearId() {
+func (x *SetFavoriteRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *SetFavoriteRequest) HasFavorite() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *SetFavoriteRequest) ClearType() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Type = ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *SetFavoriteRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
 	x.xxx_hidden_Id = nil
 }
 
-type DeleteBankCardRequest_builder struct {
+func (x *SetFavoriteRequest) ClearFavorite() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Favorite = false
+}
+
+type SetFavoriteRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	Type     *ItemType
+	Id       *string
+	Favorite *bool
 }
 
-func (b0 DeleteBankCardRequest_builder) Build() *DeleteBankCardRequest {
-	m0 := &DeleteBankCardRequest{}
+func (b0 SetFavoriteRequest_builder) Build() *SetFavoriteRequest {
+	m0 := &SetFavoriteRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 3)
+		x.xxx_hidden_Type = *b.Type
+	}
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
 		x.xxx_hidden_Id = b.Id
 	}
+	if b.Favorite != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_Favorite = *b.Favorite
+	}
 	return m0
 }
 
-type DeleteBankCardResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type SetFavoriteResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteBankCardResponse) Reset() {
-	*x = DeleteBankCardResponse{}
-	mi := &file_api_proto_msgTypes[23]
+func (x *SetFavoriteResponse) Reset() {
+	*x = SetFavoriteResponse{}
+	mi := &file_api_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBankCardResponse) String() string {
+func (x *SetFavoriteResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBankCardResponse) ProtoMessage() {}
+func (*SetFavoriteResponse) ProtoMessage() {}
 
-func (x *DeleteBankCardResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[23]
+func (x *SetFavoriteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2311,78 +8714,43 @@ func (x *DeleteBankCardResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteBankCardResponse) GetSuccess() bool {
-	if x != nil {
-		return x.xxx_hidden_Success
-	}
-	return false
-}
-
-func (x *DeleteBankCardResponse) SetSuccess(v bool) {
-	x.xxx_hidden_Success = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
-}
-
-func (x *DeleteBankCardResponse) HasSuccess() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
-}
-
-func (x *DeleteBankCardResponse) ClearSuccess() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Success = false
-}
-
-type DeleteBankCardResponse_builder struct {
+type SetFavoriteResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Success *bool
 }
 
-func (b0 DeleteBankCardResponse_builder) Build() *DeleteBankCardResponse {
-	m0 := &DeleteBankCardResponse{}
+func (b0 SetFavoriteResponse_builder) Build() *SetFavoriteResponse {
+	m0 := &SetFavoriteResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Success != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Success = *b.Success
-	}
 	return m0
 }
 
-// Сообщение для TextData
-type TextData struct {
+type MarkAccessedRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_UserId      *string                `protobuf:"bytes,2,opt,name=user_id,json=userId"`
-	xxx_hidden_Title       *string                `protobuf:"bytes,3,opt,name=title"`
-	xxx_hidden_Content     []byte                 `protobuf:"bytes,4,opt,name=content"`
-	xxx_hidden_Metadata    *string                `protobuf:"bytes,5,opt,name=metadata"`
-	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt"`
-	xxx_hidden_UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_Type        ItemType               `protobuf:"varint,1,opt,name=type,enum=gophkeeper.proto.ItemType"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,2,opt,name=id"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *TextData) Reset() {
-	*x = TextData{}
-	mi := &file_api_proto_msgTypes[24]
+func (x *MarkAccessedRequest) Reset() {
+	*x = MarkAccessedRequest{}
+	mi := &file_api_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextData) String() string {
+func (x *MarkAccessedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextData) ProtoMessage() {}
+func (*MarkAccessedRequest) ProtoMessage() {}
 
-func (x *TextData) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[24]
+func (x *MarkAccessedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,249 +8761,329 @@ func (x *TextData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *TextData) GetId() string {
+func (x *MarkAccessedRequest) GetType() ItemType {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Type
 		}
-		return ""
 	}
-	return ""
+	return ItemType_ITEM_TYPE_UNSPECIFIED
 }
 
-func (x *TextData) GetUserId() string {
+func (x *MarkAccessedRequest) GetId() string {
 	if x != nil {
-		if x.xxx_hidden_UserId != nil {
-			return *x.xxx_hidden_UserId
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *TextData) GetTitle() string {
-	if x != nil {
-		if x.xxx_hidden_Title != nil {
-			return *x.xxx_hidden_Title
-		}
-		return ""
+func (x *MarkAccessedRequest) SetType(v ItemType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *MarkAccessedRequest) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *MarkAccessedRequest) HasType() bool {
+	if x == nil {
+		return false
 	}
-	return ""
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+}
+
+func (x *MarkAccessedRequest) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *MarkAccessedRequest) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Type = ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *MarkAccessedRequest) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Id = nil
+}
+
+type MarkAccessedRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Type *ItemType
+	Id   *string
+}
+
+func (b0 MarkAccessedRequest_builder) Build() *MarkAccessedRequest {
+	m0 := &MarkAccessedRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Id = b.Id
+	}
+	return m0
+}
+
+type MarkAccessedResponse struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAccessedResponse) Reset() {
+	*x = MarkAccessedResponse{}
+	mi := &file_api_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextData) GetContent() []byte {
-	if x != nil {
-		return x.xxx_hidden_Content
-	}
-	return nil
+func (x *MarkAccessedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TextData) GetMetadata() string {
+func (*MarkAccessedResponse) ProtoMessage() {}
+
+func (x *MarkAccessedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[78]
 	if x != nil {
-		if x.xxx_hidden_Metadata != nil {
-			return *x.xxx_hidden_Metadata
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		return ""
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *TextData) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.xxx_hidden_CreatedAt
-	}
-	return nil
-}
+type MarkAccessedResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-func (x *TextData) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.xxx_hidden_UpdatedAt
-	}
-	return nil
 }
 
-func (x *TextData) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
+func (b0 MarkAccessedResponse_builder) Build() *MarkAccessedResponse {
+	m0 := &MarkAccessedResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	return m0
 }
 
-func (x *TextData) SetUserId(v string) {
-	x.xxx_hidden_UserId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+type ListFavoritesRecentRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Limit       int32                  `protobuf:"varint,1,opt,name=limit"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *TextData) SetTitle(v string) {
-	x.xxx_hidden_Title = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
+func (x *ListFavoritesRecentRequest) Reset() {
+	*x = ListFavoritesRecentRequest{}
+	mi := &file_api_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextData) SetContent(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
-	x.xxx_hidden_Content = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
+func (x *ListFavoritesRecentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TextData) SetMetadata(v string) {
-	x.xxx_hidden_Metadata = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
+func (*ListFavoritesRecentRequest) ProtoMessage() {}
+
+func (x *ListFavoritesRecentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *TextData) SetCreatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_CreatedAt = v
+func (x *ListFavoritesRecentRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
 }
 
-func (x *TextData) SetUpdatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_UpdatedAt = v
+func (x *ListFavoritesRecentRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
 }
 
-func (x *TextData) HasId() bool {
+func (x *ListFavoritesRecentRequest) HasLimit() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *TextData) HasUserId() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+func (x *ListFavoritesRecentRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Limit = 0
 }
 
-func (x *TextData) HasTitle() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+type ListFavoritesRecentRequest_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Limit *int32
 }
 
-func (x *TextData) HasContent() bool {
-	if x == nil {
-		return false
+func (b0 ListFavoritesRecentRequest_builder) Build() *ListFavoritesRecentRequest {
+	m0 := &ListFavoritesRecentRequest{}
+	b, x := &b0, m0
+	_, _ = b, x
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		x.xxx_hidden_Limit = *b.Limit
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+	return m0
 }
 
-func (x *TextData) HasMetadata() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+type ListFavoritesRecentResponse struct {
+	state            protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Items *[]*ItemSummary        `protobuf:"bytes,1,rep,name=items"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
-func (x *TextData) HasCreatedAt() bool {
-	if x == nil {
-		return false
+func (x *ListFavoritesRecentResponse) Reset() {
+	*x = ListFavoritesRecentResponse{}
+	mi := &file_api_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFavoritesRecentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFavoritesRecentResponse) ProtoMessage() {}
+
+func (x *ListFavoritesRecentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return x.xxx_hidden_CreatedAt != nil
+	return mi.MessageOf(x)
 }
 
-func (x *TextData) HasUpdatedAt() bool {
-	if x == nil {
-		return false
+func (x *ListFavoritesRecentResponse) GetItems() []*ItemSummary {
+	if x != nil {
+		if x.xxx_hidden_Items != nil {
+			return *x.xxx_hidden_Items
+		}
 	}
-	return x.xxx_hidden_UpdatedAt != nil
+	return nil
 }
 
-func (x *TextData) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *ListFavoritesRecentResponse) SetItems(v []*ItemSummary) {
+	x.xxx_hidden_Items = &v
 }
 
-func (x *TextData) ClearUserId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_UserId = nil
+type ListFavoritesRecentResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	Items []*ItemSummary
 }
 
-func (x *TextData) ClearTitle() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Title = nil
+func (b0 ListFavoritesRecentResponse_builder) Build() *ListFavoritesRecentResponse {
+	m0 := &ListFavoritesRecentResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Items = &b.Items
+	return m0
 }
 
-func (x *TextData) ClearContent() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_Content = nil
+type GetUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TextData) ClearMetadata() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_Metadata = nil
+func (x *GetUsageRequest) Reset() {
+	*x = GetUsageRequest{}
+	mi := &file_api_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TextData) ClearCreatedAt() {
-	x.xxx_hidden_CreatedAt = nil
+func (x *GetUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TextData) ClearUpdatedAt() {
-	x.xxx_hidden_UpdatedAt = nil
+func (*GetUsageRequest) ProtoMessage() {}
+
+func (x *GetUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type TextData_builder struct {
+type GetUsageRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id        *string
-	UserId    *string
-	Title     *string
-	Content   []byte
-	Metadata  *string
-	CreatedAt *timestamppb.Timestamp
-	UpdatedAt *timestamppb.Timestamp
 }
 
-func (b0 TextData_builder) Build() *TextData {
-	m0 := &TextData{}
+func (b0 GetUsageRequest_builder) Build() *GetUsageRequest {
+	m0 := &GetUsageRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
-		x.xxx_hidden_Id = b.Id
-	}
-	if b.UserId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
-		x.xxx_hidden_UserId = b.UserId
-	}
-	if b.Title != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
-		x.xxx_hidden_Title = b.Title
-	}
-	if b.Content != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
-		x.xxx_hidden_Content = b.Content
-	}
-	if b.Metadata != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
-		x.xxx_hidden_Metadata = b.Metadata
-	}
-	x.xxx_hidden_CreatedAt = b.CreatedAt
-	x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	return m0
 }
 
-// Запрос и ответ на создание TextData
-type CreateTextDataRequest struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TextData *TextData              `protobuf:"bytes,1,opt,name=text_data,json=textData"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+// Занятое пользователем место и ограничения сервера (0 — без ограничения)
+type GetUsageResponse struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Bytes       int64                  `protobuf:"varint,1,opt,name=bytes"`
+	xxx_hidden_Items       int64                  `protobuf:"varint,2,opt,name=items"`
+	xxx_hidden_MaxBytes    int64                  `protobuf:"varint,3,opt,name=max_bytes,json=maxBytes"`
+	xxx_hidden_MaxItems    int64                  `protobuf:"varint,4,opt,name=max_items,json=maxItems"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *CreateTextDataRequest) Reset() {
-	*x = CreateTextDataRequest{}
-	mi := &file_api_proto_msgTypes[25]
+func (x *GetUsageResponse) Reset() {
+	*x = GetUsageResponse{}
+	mi := &file_api_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateTextDataRequest) String() string {
+func (x *GetUsageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateTextDataRequest) ProtoMessage() {}
+func (*GetUsageResponse) ProtoMessage() {}
 
-func (x *CreateTextDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[25]
+func (x *GetUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2646,135 +9094,164 @@ func (x *CreateTextDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *CreateTextDataRequest) GetTextData() *TextData {
+func (x *GetUsageResponse) GetBytes() int64 {
 	if x != nil {
-		return x.xxx_hidden_TextData
+		return x.xxx_hidden_Bytes
 	}
-	return nil
-}
-
-func (x *CreateTextDataRequest) SetTextData(v *TextData) {
-	x.xxx_hidden_TextData = v
+	return 0
 }
 
-func (x *CreateTextDataRequest) HasTextData() bool {
-	if x == nil {
-		return false
+func (x *GetUsageResponse) GetItems() int64 {
+	if x != nil {
+		return x.xxx_hidden_Items
 	}
-	return x.xxx_hidden_TextData != nil
-}
-
-func (x *CreateTextDataRequest) ClearTextData() {
-	x.xxx_hidden_TextData = nil
+	return 0
 }
 
-type CreateTextDataRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	TextData *TextData
+func (x *GetUsageResponse) GetMaxBytes() int64 {
+	if x != nil {
+		return x.xxx_hidden_MaxBytes
+	}
+	return 0
 }
 
-func (b0 CreateTextDataRequest_builder) Build() *CreateTextDataRequest {
-	m0 := &CreateTextDataRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_TextData = b.TextData
-	return m0
+func (x *GetUsageResponse) GetMaxItems() int64 {
+	if x != nil {
+		return x.xxx_hidden_MaxItems
+	}
+	return 0
 }
 
-type CreateTextDataResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TextData *TextData              `protobuf:"bytes,1,opt,name=text_data,json=textData"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *GetUsageResponse) SetBytes(v int64) {
+	x.xxx_hidden_Bytes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
-func (x *CreateTextDataResponse) Reset() {
-	*x = CreateTextDataResponse{}
-	mi := &file_api_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetUsageResponse) SetItems(v int64) {
+	x.xxx_hidden_Items = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
-
-func (x *CreateTextDataResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+
+func (x *GetUsageResponse) SetMaxBytes(v int64) {
+	x.xxx_hidden_MaxBytes = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
 }
 
-func (*CreateTextDataResponse) ProtoMessage() {}
+func (x *GetUsageResponse) SetMaxItems(v int64) {
+	x.xxx_hidden_MaxItems = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
 
-func (x *CreateTextDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[26]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetUsageResponse) HasBytes() bool {
+	if x == nil {
+		return false
 	}
-	return mi.MessageOf(x)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *CreateTextDataResponse) GetTextData() *TextData {
-	if x != nil {
-		return x.xxx_hidden_TextData
+func (x *GetUsageResponse) HasItems() bool {
+	if x == nil {
+		return false
 	}
-	return nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *CreateTextDataResponse) SetTextData(v *TextData) {
-	x.xxx_hidden_TextData = v
+func (x *GetUsageResponse) HasMaxBytes() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *CreateTextDataResponse) HasTextData() bool {
+func (x *GetUsageResponse) HasMaxItems() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_TextData != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *CreateTextDataResponse) ClearTextData() {
-	x.xxx_hidden_TextData = nil
+func (x *GetUsageResponse) ClearBytes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Bytes = 0
 }
 
-type CreateTextDataResponse_builder struct {
+func (x *GetUsageResponse) ClearItems() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Items = 0
+}
+
+func (x *GetUsageResponse) ClearMaxBytes() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_MaxBytes = 0
+}
+
+func (x *GetUsageResponse) ClearMaxItems() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_MaxItems = 0
+}
+
+type GetUsageResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	TextData *TextData
+	Bytes    *int64
+	Items    *int64
+	MaxBytes *int64
+	MaxItems *int64
 }
 
-func (b0 CreateTextDataResponse_builder) Build() *CreateTextDataResponse {
-	m0 := &CreateTextDataResponse{}
+func (b0 GetUsageResponse_builder) Build() *GetUsageResponse {
+	m0 := &GetUsageResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_TextData = b.TextData
+	if b.Bytes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Bytes = *b.Bytes
+	}
+	if b.Items != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Items = *b.Items
+	}
+	if b.MaxBytes != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_MaxBytes = *b.MaxBytes
+	}
+	if b.MaxItems != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_MaxItems = *b.MaxItems
+	}
 	return m0
 }
 
-// Запрос и ответ на получение TextData по ID
-type GetTextDataByIDRequest struct {
+// Изменение записи из журнала изменений пользователя
+type Change struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Seq         int64                  `protobuf:"varint,1,opt,name=seq"`
+	xxx_hidden_Type        ItemType               `protobuf:"varint,2,opt,name=type,enum=gophkeeper.proto.ItemType"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,3,opt,name=id"`
+	xxx_hidden_Deleted     bool                   `protobuf:"varint,4,opt,name=deleted"`
+	xxx_hidden_ChangedAt   *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=changed_at,json=changedAt"`
+	xxx_hidden_Item        isChange_Item          `protobuf_oneof:"item"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *GetTextDataByIDRequest) Reset() {
-	*x = GetTextDataByIDRequest{}
-	mi := &file_api_proto_msgTypes[27]
+func (x *Change) Reset() {
+	*x = Change{}
+	mi := &file_api_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTextDataByIDRequest) String() string {
+func (x *Change) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTextDataByIDRequest) ProtoMessage() {}
+func (*Change) ProtoMessage() {}
 
-func (x *GetTextDataByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[27]
+func (x *Change) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2785,7 +9262,23 @@ func (x *GetTextDataByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *GetTextDataByIDRequest) GetId() string {
+func (x *Change) GetSeq() int64 {
+	if x != nil {
+		return x.xxx_hidden_Seq
+	}
+	return 0
+}
+
+func (x *Change) GetType() ItemType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *Change) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -2795,339 +9288,377 @@ func (x *GetTextDataByIDRequest) GetId() string {
 	return ""
 }
 
-func (x *GetTextDataByIDRequest) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *Change) GetDeleted() bool {
+	if x != nil {
+		return x.xxx_hidden_Deleted
+	}
+	return false
 }
 
-func (x *GetTextDataByIDRequest) HasId() bool {
-	if x == nil {
-		return false
+func (x *Change) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_ChangedAt
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return nil
 }
 
-func (x *GetTextDataByIDRequest) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *Change) GetCredential() *Credential {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Item.(*change_Credential); ok {
+			return x.Credential
+		}
+	}
+	return nil
 }
 
-type GetTextDataByIDRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *Change) GetBankCard() *BankCard {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Item.(*change_BankCard); ok {
+			return x.BankCard
+		}
+	}
+	return nil
+}
 
-	Id *string
+func (x *Change) GetTextData() *TextData {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Item.(*change_TextData); ok {
+			return x.TextData
+		}
+	}
+	return nil
 }
 
-func (b0 GetTextDataByIDRequest_builder) Build() *GetTextDataByIDRequest {
-	m0 := &GetTextDataByIDRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
+func (x *Change) GetBinaryData() *BinaryDataInfo {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Item.(*change_BinaryData); ok {
+			return x.BinaryData
+		}
 	}
-	return m0
+	return nil
 }
 
-type GetTextDataByIDResponse struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TextData *TextData              `protobuf:"bytes,1,opt,name=text_data,json=textData"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *Change) SetSeq(v int64) {
+	x.xxx_hidden_Seq = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 6)
 }
 
-func (x *GetTextDataByIDResponse) Reset() {
-	*x = GetTextDataByIDResponse{}
-	mi := &file_api_proto_msgTypes[28]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Change) SetType(v ItemType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 6)
 }
 
-func (x *GetTextDataByIDResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Change) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 6)
 }
 
-func (*GetTextDataByIDResponse) ProtoMessage() {}
+func (x *Change) SetDeleted(v bool) {
+	x.xxx_hidden_Deleted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 6)
+}
 
-func (x *GetTextDataByIDResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[28]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *Change) SetChangedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_ChangedAt = v
 }
 
-func (x *GetTextDataByIDResponse) GetTextData() *TextData {
-	if x != nil {
-		return x.xxx_hidden_TextData
+func (x *Change) SetCredential(v *Credential) {
+	if v == nil {
+		x.xxx_hidden_Item = nil
+		return
 	}
-	return nil
+	x.xxx_hidden_Item = &change_Credential{v}
 }
 
-func (x *GetTextDataByIDResponse) SetTextData(v *TextData) {
-	x.xxx_hidden_TextData = v
+func (x *Change) SetBankCard(v *BankCard) {
+	if v == nil {
+		x.xxx_hidden_Item = nil
+		return
+	}
+	x.xxx_hidden_Item = &change_BankCard{v}
 }
 
-func (x *GetTextDataByIDResponse) HasTextData() bool {
-	if x == nil {
-		return false
+func (x *Change) SetTextData(v *TextData) {
+	if v == nil {
+		x.xxx_hidden_Item = nil
+		return
 	}
-	return x.xxx_hidden_TextData != nil
+	x.xxx_hidden_Item = &change_TextData{v}
 }
 
-func (x *GetTextDataByIDResponse) ClearTextData() {
-	x.xxx_hidden_TextData = nil
+func (x *Change) SetBinaryData(v *BinaryDataInfo) {
+	if v == nil {
+		x.xxx_hidden_Item = nil
+		return
+	}
+	x.xxx_hidden_Item = &change_BinaryData{v}
 }
 
-type GetTextDataByIDResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	TextData *TextData
+func (x *Change) HasSeq() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (b0 GetTextDataByIDResponse_builder) Build() *GetTextDataByIDResponse {
-	m0 := &GetTextDataByIDResponse{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_TextData = b.TextData
-	return m0
+func (x *Change) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-// Запрос и ответ на получение списка заголовков TextData
-type GetTextDataTitlesRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_UserId      *string                `protobuf:"bytes,1,opt,name=user_id,json=userId"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *Change) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *GetTextDataTitlesRequest) Reset() {
-	*x = GetTextDataTitlesRequest{}
-	mi := &file_api_proto_msgTypes[29]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Change) HasDeleted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *GetTextDataTitlesRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Change) HasChangedAt() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_ChangedAt != nil
 }
 
-func (*GetTextDataTitlesRequest) ProtoMessage() {}
+func (x *Change) HasItem() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Item != nil
+}
 
-func (x *GetTextDataTitlesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[29]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Change) HasCredential() bool {
+	if x == nil {
+		return false
 	}
-	return mi.MessageOf(x)
+	_, ok := x.xxx_hidden_Item.(*change_Credential)
+	return ok
 }
 
-func (x *GetTextDataTitlesRequest) GetUserId() string {
-	if x != nil {
-		if x.xxx_hidden_UserId != nil {
-			return *x.xxx_hidden_UserId
-		}
-		return ""
+func (x *Change) HasBankCard() bool {
+	if x == nil {
+		return false
 	}
-	return ""
+	_, ok := x.xxx_hidden_Item.(*change_BankCard)
+	return ok
 }
 
-func (x *GetTextDataTitlesRequest) SetUserId(v string) {
-	x.xxx_hidden_UserId = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *Change) HasTextData() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Item.(*change_TextData)
+	return ok
 }
 
-func (x *GetTextDataTitlesRequest) HasUserId() bool {
+func (x *Change) HasBinaryData() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	_, ok := x.xxx_hidden_Item.(*change_BinaryData)
+	return ok
 }
 
-func (x *GetTextDataTitlesRequest) ClearUserId() {
+func (x *Change) ClearSeq() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_UserId = nil
+	x.xxx_hidden_Seq = 0
 }
 
-type GetTextDataTitlesRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	UserId *string
+func (x *Change) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Type = ItemType_ITEM_TYPE_UNSPECIFIED
 }
 
-func (b0 GetTextDataTitlesRequest_builder) Build() *GetTextDataTitlesRequest {
-	m0 := &GetTextDataTitlesRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.UserId != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_UserId = b.UserId
-	}
-	return m0
+func (x *Change) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Id = nil
 }
 
-type GetTextDataTitlesResponse struct {
-	state                     protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TextDataTitles *[]*TextData           `protobuf:"bytes,1,rep,name=text_data_titles,json=textDataTitles"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+func (x *Change) ClearDeleted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Deleted = false
 }
 
-func (x *GetTextDataTitlesResponse) Reset() {
-	*x = GetTextDataTitlesResponse{}
-	mi := &file_api_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Change) ClearChangedAt() {
+	x.xxx_hidden_ChangedAt = nil
 }
 
-func (x *GetTextDataTitlesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Change) ClearItem() {
+	x.xxx_hidden_Item = nil
 }
 
-func (*GetTextDataTitlesResponse) ProtoMessage() {}
+func (x *Change) ClearCredential() {
+	if _, ok := x.xxx_hidden_Item.(*change_Credential); ok {
+		x.xxx_hidden_Item = nil
+	}
+}
 
-func (x *GetTextDataTitlesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[30]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Change) ClearBankCard() {
+	if _, ok := x.xxx_hidden_Item.(*change_BankCard); ok {
+		x.xxx_hidden_Item = nil
 	}
-	return mi.MessageOf(x)
 }
 
-func (x *GetTextDataTitlesResponse) GetTextDataTitles() []*TextData {
-	if x != nil {
-		if x.xxx_hidden_TextDataTitles != nil {
-			return *x.xxx_hidden_TextDataTitles
-		}
+func (x *Change) ClearTextData() {
+	if _, ok := x.xxx_hidden_Item.(*change_TextData); ok {
+		x.xxx_hidden_Item = nil
 	}
-	return nil
 }
 
-func (x *GetTextDataTitlesResponse) SetTextDataTitles(v []*TextData) {
-	x.xxx_hidden_TextDataTitles = &v
+func (x *Change) ClearBinaryData() {
+	if _, ok := x.xxx_hidden_Item.(*change_BinaryData); ok {
+		x.xxx_hidden_Item = nil
+	}
 }
 
-type GetTextDataTitlesResponse_builder struct {
+const Change_Item_not_set_case case_Change_Item = 0
+const Change_Credential_case case_Change_Item = 6
+const Change_BankCard_case case_Change_Item = 7
+const Change_TextData_case case_Change_Item = 8
+const Change_BinaryData_case case_Change_Item = 9
+
+func (x *Change) WhichItem() case_Change_Item {
+	if x == nil {
+		return Change_Item_not_set_case
+	}
+	switch x.xxx_hidden_Item.(type) {
+	case *change_Credential:
+		return Change_Credential_case
+	case *change_BankCard:
+		return Change_BankCard_case
+	case *change_TextData:
+		return Change_TextData_case
+	case *change_BinaryData:
+		return Change_BinaryData_case
+	default:
+		return Change_Item_not_set_case
+	}
+}
+
+type Change_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	TextDataTitles []*TextData
+	Seq       *int64
+	Type      *ItemType
+	Id        *string
+	Deleted   *bool
+	ChangedAt *timestamppb.Timestamp
+	// Fields of oneof xxx_hidden_Item:
+	Credential *Credential
+	BankCard   *BankCard
+	TextData   *TextData
+	BinaryData *BinaryDataInfo
+	// -- end of xxx_hidden_Item
 }
 
-func (b0 GetTextDataTitlesResponse_builder) Build() *GetTextDataTitlesResponse {
-	m0 := &GetTextDataTitlesResponse{}
+func (b0 Change_builder) Build() *Change {
+	m0 := &Change{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_TextDataTitles = &b.TextDataTitles
+	if b.Seq != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 6)
+		x.xxx_hidden_Seq = *b.Seq
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 6)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 6)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Deleted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 6)
+		x.xxx_hidden_Deleted = *b.Deleted
+	}
+	x.xxx_hidden_ChangedAt = b.ChangedAt
+	if b.Credential != nil {
+		x.xxx_hidden_Item = &change_Credential{b.Credential}
+	}
+	if b.BankCard != nil {
+		x.xxx_hidden_Item = &change_BankCard{b.BankCard}
+	}
+	if b.TextData != nil {
+		x.xxx_hidden_Item = &change_TextData{b.TextData}
+	}
+	if b.BinaryData != nil {
+		x.xxx_hidden_Item = &change_BinaryData{b.BinaryData}
+	}
 	return m0
 }
 
-// Запрос и ответ на обновление TextData
-type UpdateTextDataRequest struct {
-	state               protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_TextData *TextData              `protobuf:"bytes,1,opt,name=text_data,json=textData"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
-}
+type case_Change_Item protoreflect.FieldNumber
 
-func (x *UpdateTextDataRequest) Reset() {
-	*x = UpdateTextDataRequest{}
-	mi := &file_api_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x case_Change_Item) String() string {
+	md := file_api_proto_msgTypes[83].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
 }
 
-func (x *UpdateTextDataRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type isChange_Item interface {
+	isChange_Item()
 }
 
-func (*UpdateTextDataRequest) ProtoMessage() {}
-
-func (x *UpdateTextDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[31]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type change_Credential struct {
+	Credential *Credential `protobuf:"bytes,6,opt,name=credential,oneof"`
 }
 
-func (x *UpdateTextDataRequest) GetTextData() *TextData {
-	if x != nil {
-		return x.xxx_hidden_TextData
-	}
-	return nil
+type change_BankCard struct {
+	BankCard *BankCard `protobuf:"bytes,7,opt,name=bank_card,json=bankCard,oneof"`
 }
 
-func (x *UpdateTextDataRequest) SetTextData(v *TextData) {
-	x.xxx_hidden_TextData = v
+type change_TextData struct {
+	TextData *TextData `protobuf:"bytes,8,opt,name=text_data,json=textData,oneof"`
 }
 
-func (x *UpdateTextDataRequest) HasTextData() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_TextData != nil
+type change_BinaryData struct {
+	BinaryData *BinaryDataInfo `protobuf:"bytes,9,opt,name=binary_data,json=binaryData,oneof"`
 }
 
-func (x *UpdateTextDataRequest) ClearTextData() {
-	x.xxx_hidden_TextData = nil
-}
+func (*change_Credential) isChange_Item() {}
 
-type UpdateTextDataRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (*change_BankCard) isChange_Item() {}
 
-	TextData *TextData
-}
+func (*change_TextData) isChange_Item() {}
 
-func (b0 UpdateTextDataRequest_builder) Build() *UpdateTextDataRequest {
-	m0 := &UpdateTextDataRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	x.xxx_hidden_TextData = b.TextData
-	return m0
-}
+func (*change_BinaryData) isChange_Item() {}
 
-type UpdateTextDataResponse struct {
+type ListChangesRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
+	xxx_hidden_Cursor      int64                  `protobuf:"varint,1,opt,name=cursor"`
+	xxx_hidden_Limit       int32                  `protobuf:"varint,2,opt,name=limit"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *UpdateTextDataResponse) Reset() {
-	*x = UpdateTextDataResponse{}
-	mi := &file_api_proto_msgTypes[32]
+func (x *ListChangesRequest) Reset() {
+	*x = ListChangesRequest{}
+	mi := &file_api_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTextDataResponse) String() string {
+func (x *ListChangesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTextDataResponse) ProtoMessage() {}
+func (*ListChangesRequest) ProtoMessage() {}
 
-func (x *UpdateTextDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[32]
+func (x *ListChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3138,72 +9669,102 @@ func (x *UpdateTextDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateTextDataResponse) GetSuccess() bool {
+func (x *ListChangesRequest) GetCursor() int64 {
 	if x != nil {
-		return x.xxx_hidden_Success
+		return x.xxx_hidden_Cursor
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateTextDataResponse) SetSuccess(v bool) {
-	x.xxx_hidden_Success = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *ListChangesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.xxx_hidden_Limit
+	}
+	return 0
 }
 
-func (x *UpdateTextDataResponse) HasSuccess() bool {
+func (x *ListChangesRequest) SetCursor(v int64) {
+	x.xxx_hidden_Cursor = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *ListChangesRequest) SetLimit(v int32) {
+	x.xxx_hidden_Limit = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *ListChangesRequest) HasCursor() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UpdateTextDataResponse) ClearSuccess() {
+func (x *ListChangesRequest) HasLimit() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *ListChangesRequest) ClearCursor() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Success = false
+	x.xxx_hidden_Cursor = 0
 }
 
-type UpdateTextDataResponse_builder struct {
+func (x *ListChangesRequest) ClearLimit() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Limit = 0
+}
+
+type ListChangesRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Success *bool
+	Cursor *int64
+	Limit  *int32
 }
 
-func (b0 UpdateTextDataResponse_builder) Build() *UpdateTextDataResponse {
-	m0 := &UpdateTextDataResponse{}
+func (b0 ListChangesRequest_builder) Build() *ListChangesRequest {
+	m0 := &ListChangesRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Success != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Success = *b.Success
+	if b.Cursor != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Cursor = *b.Cursor
+	}
+	if b.Limit != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Limit = *b.Limit
 	}
 	return m0
 }
 
-// Запрос и ответ на удаление TextData
-type DeleteTextDataRequest struct {
+type ListChangesResponse struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Changes     *[]*Change             `protobuf:"bytes,1,rep,name=changes"`
+	xxx_hidden_Cursor      int64                  `protobuf:"varint,2,opt,name=cursor"`
+	xxx_hidden_HasMore     bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *DeleteTextDataRequest) Reset() {
-	*x = DeleteTextDataRequest{}
-	mi := &file_api_proto_msgTypes[33]
+func (x *ListChangesResponse) Reset() {
+	*x = ListChangesResponse{}
+	mi := &file_api_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTextDataRequest) String() string {
+func (x *ListChangesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTextDataRequest) ProtoMessage() {}
+func (*ListChangesResponse) ProtoMessage() {}
 
-func (x *DeleteTextDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[33]
+func (x *ListChangesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3214,151 +9775,163 @@ func (x *DeleteTextDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteTextDataRequest) GetId() string {
+func (x *ListChangesResponse) GetChanges() []*Change {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
+		if x.xxx_hidden_Changes != nil {
+			return *x.xxx_hidden_Changes
 		}
-		return ""
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteTextDataRequest) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *ListChangesResponse) GetCursor() int64 {
+	if x != nil {
+		return x.xxx_hidden_Cursor
+	}
+	return 0
 }
 
-func (x *DeleteTextDataRequest) HasId() bool {
-	if x == nil {
-		return false
+func (x *ListChangesResponse) GetHasMore() bool {
+	if x != nil {
+		return x.xxx_hidden_HasMore
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return false
 }
 
-func (x *DeleteTextDataRequest) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *ListChangesResponse) SetChanges(v []*Change) {
+	x.xxx_hidden_Changes = &v
 }
 
-type DeleteTextDataRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *ListChangesResponse) SetCursor(v int64) {
+	x.xxx_hidden_Cursor = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 3)
+}
 
-	Id *string
+func (x *ListChangesResponse) SetHasMore(v bool) {
+	x.xxx_hidden_HasMore = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 3)
 }
 
-func (b0 DeleteTextDataRequest_builder) Build() *DeleteTextDataRequest {
-	m0 := &DeleteTextDataRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
+func (x *ListChangesResponse) HasCursor() bool {
+	if x == nil {
+		return false
 	}
-	return m0
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-type DeleteTextDataResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Success     bool                   `protobuf:"varint,1,opt,name=success"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *ListChangesResponse) HasHasMore() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *DeleteTextDataResponse) Reset() {
-	*x = DeleteTextDataResponse{}
-	mi := &file_api_proto_msgTypes[34]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ListChangesResponse) ClearCursor() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Cursor = 0
 }
 
-func (x *DeleteTextDataResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ListChangesResponse) ClearHasMore() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_HasMore = false
 }
 
-func (*DeleteTextDataResponse) ProtoMessage() {}
+type ListChangesResponse_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-func (x *DeleteTextDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[34]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+	Changes []*Change
+	Cursor  *int64
+	HasMore *bool
 }
 
-func (x *DeleteTextDataResponse) GetSuccess() bool {
-	if x != nil {
-		return x.xxx_hidden_Success
+func (b0 ListChangesResponse_builder) Build() *ListChangesResponse {
+	m0 := &ListChangesResponse{}
+	b, x := &b0, m0
+	_, _ = b, x
+	x.xxx_hidden_Changes = &b.Changes
+	if b.Cursor != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 3)
+		x.xxx_hidden_Cursor = *b.Cursor
 	}
-	return false
+	if b.HasMore != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 3)
+		x.xxx_hidden_HasMore = *b.HasMore
+	}
+	return m0
 }
 
-func (x *DeleteTextDataResponse) SetSuccess(v bool) {
-	x.xxx_hidden_Success = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+type WatchChangesRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTextDataResponse) HasSuccess() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+func (x *WatchChangesRequest) Reset() {
+	*x = WatchChangesRequest{}
+	mi := &file_api_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTextDataResponse) ClearSuccess() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Success = false
+func (x *WatchChangesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type DeleteTextDataResponse_builder struct {
+func (*WatchChangesRequest) ProtoMessage() {}
+
+func (x *WatchChangesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+type WatchChangesRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Success *bool
 }
 
-func (b0 DeleteTextDataResponse_builder) Build() *DeleteTextDataResponse {
-	m0 := &DeleteTextDataResponse{}
+func (b0 WatchChangesRequest_builder) Build() *WatchChangesRequest {
+	m0 := &WatchChangesRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Success != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Success = *b.Success
-	}
 	return m0
 }
 
-// Сообщения для BinaryData
-type UploadBinaryDataRequest struct {
+// Уведомление об изменении записи. Содержимое записи не передаётся:
+// клиент получает его через ListChanges или повторную загрузку списка.
+type ChangeEvent struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Chunk       []byte                 `protobuf:"bytes,1,opt,name=chunk"`
-	xxx_hidden_Info        *BinaryDataInfo        `protobuf:"bytes,2,opt,name=info"`
+	xxx_hidden_Seq         int64                  `protobuf:"varint,1,opt,name=seq"`
+	xxx_hidden_Type        ItemType               `protobuf:"varint,2,opt,name=type,enum=gophkeeper.proto.ItemType"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,3,opt,name=id"`
+	xxx_hidden_Deleted     bool                   `protobuf:"varint,4,opt,name=deleted"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *UploadBinaryDataRequest) Reset() {
-	*x = UploadBinaryDataRequest{}
-	mi := &file_api_proto_msgTypes[35]
+func (x *ChangeEvent) Reset() {
+	*x = ChangeEvent{}
+	mi := &file_api_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadBinaryDataRequest) String() string {
+func (x *ChangeEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadBinaryDataRequest) ProtoMessage() {}
+func (*ChangeEvent) ProtoMessage() {}
 
-func (x *UploadBinaryDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[35]
+func (x *ChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3369,98 +9942,169 @@ func (x *UploadBinaryDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UploadBinaryDataRequest) GetChunk() []byte {
+func (x *ChangeEvent) GetSeq() int64 {
 	if x != nil {
-		return x.xxx_hidden_Chunk
+		return x.xxx_hidden_Seq
 	}
-	return nil
+	return 0
 }
 
-func (x *UploadBinaryDataRequest) GetInfo() *BinaryDataInfo {
+func (x *ChangeEvent) GetType() ItemType {
 	if x != nil {
-		return x.xxx_hidden_Info
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Type
+		}
 	}
-	return nil
+	return ItemType_ITEM_TYPE_UNSPECIFIED
 }
 
-func (x *UploadBinaryDataRequest) SetChunk(v []byte) {
-	if v == nil {
-		v = []byte{}
+func (x *ChangeEvent) GetId() string {
+	if x != nil {
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
+		}
+		return ""
 	}
-	x.xxx_hidden_Chunk = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+	return ""
 }
 
-func (x *UploadBinaryDataRequest) SetInfo(v *BinaryDataInfo) {
-	x.xxx_hidden_Info = v
+func (x *ChangeEvent) GetDeleted() bool {
+	if x != nil {
+		return x.xxx_hidden_Deleted
+	}
+	return false
 }
 
-func (x *UploadBinaryDataRequest) HasChunk() bool {
+func (x *ChangeEvent) SetSeq(v int64) {
+	x.xxx_hidden_Seq = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *ChangeEvent) SetType(v ItemType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *ChangeEvent) SetId(v string) {
+	x.xxx_hidden_Id = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *ChangeEvent) SetDeleted(v bool) {
+	x.xxx_hidden_Deleted = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *ChangeEvent) HasSeq() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UploadBinaryDataRequest) HasInfo() bool {
+func (x *ChangeEvent) HasType() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Info != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *UploadBinaryDataRequest) ClearChunk() {
+func (x *ChangeEvent) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *ChangeEvent) HasDeleted() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *ChangeEvent) ClearSeq() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Chunk = nil
+	x.xxx_hidden_Seq = 0
 }
 
-func (x *UploadBinaryDataRequest) ClearInfo() {
-	x.xxx_hidden_Info = nil
+func (x *ChangeEvent) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Type = ItemType_ITEM_TYPE_UNSPECIFIED
 }
 
-type UploadBinaryDataRequest_builder struct {
+func (x *ChangeEvent) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Id = nil
+}
+
+func (x *ChangeEvent) ClearDeleted() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Deleted = false
+}
+
+type ChangeEvent_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Chunk []byte
-	Info  *BinaryDataInfo
+	Seq     *int64
+	Type    *ItemType
+	Id      *string
+	Deleted *bool
 }
 
-func (b0 UploadBinaryDataRequest_builder) Build() *UploadBinaryDataRequest {
-	m0 := &UploadBinaryDataRequest{}
+func (b0 ChangeEvent_builder) Build() *ChangeEvent {
+	m0 := &ChangeEvent{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Chunk != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
-		x.xxx_hidden_Chunk = b.Chunk
+	if b.Seq != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Seq = *b.Seq
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Type = *b.Type
+	}
+	if b.Id != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Id = b.Id
+	}
+	if b.Deleted != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Deleted = *b.Deleted
 	}
-	x.xxx_hidden_Info = b.Info
 	return m0
 }
 
-type UploadBinaryDataResponse struct {
+// Операция пакетного изменения. Для создания и обновления передаётся запись
+// (идентификатор обновляемой записи — в ней), для удаления — тип и id.
+// Бинарные данные в пакете не поддерживаются.
+type BatchOperation struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Kind        BatchOperationKind     `protobuf:"varint,1,opt,name=kind,enum=gophkeeper.proto.BatchOperationKind"`
+	xxx_hidden_Type        ItemType               `protobuf:"varint,2,opt,name=type,enum=gophkeeper.proto.ItemType"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,3,opt,name=id"`
+	xxx_hidden_Item        isBatchOperation_Item  `protobuf_oneof:"item"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *UploadBinaryDataResponse) Reset() {
-	*x = UploadBinaryDataResponse{}
-	mi := &file_api_proto_msgTypes[36]
+func (x *BatchOperation) Reset() {
+	*x = BatchOperation{}
+	mi := &file_api_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UploadBinaryDataResponse) String() string {
+func (x *BatchOperation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadBinaryDataResponse) ProtoMessage() {}
+func (*BatchOperation) ProtoMessage() {}
 
-func (x *UploadBinaryDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[36]
+func (x *BatchOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3471,7 +10115,25 @@ func (x *UploadBinaryDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UploadBinaryDataResponse) GetId() string {
+func (x *BatchOperation) GetKind() BatchOperationKind {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 0) {
+			return x.xxx_hidden_Kind
+		}
+	}
+	return BatchOperationKind_BATCH_OPERATION_KIND_UNSPECIFIED
+}
+
+func (x *BatchOperation) GetType() ItemType {
+	if x != nil {
+		if protoimpl.X.Present(&(x.XXX_presence[0]), 1) {
+			return x.xxx_hidden_Type
+		}
+	}
+	return ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *BatchOperation) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -3481,64 +10143,283 @@ func (x *UploadBinaryDataResponse) GetId() string {
 	return ""
 }
 
-func (x *UploadBinaryDataResponse) SetId(v string) {
+func (x *BatchOperation) GetCredential() *Credential {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Item.(*batchOperation_Credential); ok {
+			return x.Credential
+		}
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetBankCard() *BankCard {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Item.(*batchOperation_BankCard); ok {
+			return x.BankCard
+		}
+	}
+	return nil
+}
+
+func (x *BatchOperation) GetTextData() *TextData {
+	if x != nil {
+		if x, ok := x.xxx_hidden_Item.(*batchOperation_TextData); ok {
+			return x.TextData
+		}
+	}
+	return nil
+}
+
+func (x *BatchOperation) SetKind(v BatchOperationKind) {
+	x.xxx_hidden_Kind = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
+}
+
+func (x *BatchOperation) SetType(v ItemType) {
+	x.xxx_hidden_Type = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *BatchOperation) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
 }
 
-func (x *UploadBinaryDataResponse) HasId() bool {
+func (x *BatchOperation) SetCredential(v *Credential) {
+	if v == nil {
+		x.xxx_hidden_Item = nil
+		return
+	}
+	x.xxx_hidden_Item = &batchOperation_Credential{v}
+}
+
+func (x *BatchOperation) SetBankCard(v *BankCard) {
+	if v == nil {
+		x.xxx_hidden_Item = nil
+		return
+	}
+	x.xxx_hidden_Item = &batchOperation_BankCard{v}
+}
+
+func (x *BatchOperation) SetTextData(v *TextData) {
+	if v == nil {
+		x.xxx_hidden_Item = nil
+		return
+	}
+	x.xxx_hidden_Item = &batchOperation_TextData{v}
+}
+
+func (x *BatchOperation) HasKind() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UploadBinaryDataResponse) ClearId() {
+func (x *BatchOperation) HasType() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *BatchOperation) HasId() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *BatchOperation) HasItem() bool {
+	if x == nil {
+		return false
+	}
+	return x.xxx_hidden_Item != nil
+}
+
+func (x *BatchOperation) HasCredential() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Item.(*batchOperation_Credential)
+	return ok
+}
+
+func (x *BatchOperation) HasBankCard() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Item.(*batchOperation_BankCard)
+	return ok
+}
+
+func (x *BatchOperation) HasTextData() bool {
+	if x == nil {
+		return false
+	}
+	_, ok := x.xxx_hidden_Item.(*batchOperation_TextData)
+	return ok
+}
+
+func (x *BatchOperation) ClearKind() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Kind = BatchOperationKind_BATCH_OPERATION_KIND_UNSPECIFIED
+}
+
+func (x *BatchOperation) ClearType() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Type = ItemType_ITEM_TYPE_UNSPECIFIED
+}
+
+func (x *BatchOperation) ClearId() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
 	x.xxx_hidden_Id = nil
 }
 
-type UploadBinaryDataResponse_builder struct {
+func (x *BatchOperation) ClearItem() {
+	x.xxx_hidden_Item = nil
+}
+
+func (x *BatchOperation) ClearCredential() {
+	if _, ok := x.xxx_hidden_Item.(*batchOperation_Credential); ok {
+		x.xxx_hidden_Item = nil
+	}
+}
+
+func (x *BatchOperation) ClearBankCard() {
+	if _, ok := x.xxx_hidden_Item.(*batchOperation_BankCard); ok {
+		x.xxx_hidden_Item = nil
+	}
+}
+
+func (x *BatchOperation) ClearTextData() {
+	if _, ok := x.xxx_hidden_Item.(*batchOperation_TextData); ok {
+		x.xxx_hidden_Item = nil
+	}
+}
+
+const BatchOperation_Item_not_set_case case_BatchOperation_Item = 0
+const BatchOperation_Credential_case case_BatchOperation_Item = 4
+const BatchOperation_BankCard_case case_BatchOperation_Item = 5
+const BatchOperation_TextData_case case_BatchOperation_Item = 6
+
+func (x *BatchOperation) WhichItem() case_BatchOperation_Item {
+	if x == nil {
+		return BatchOperation_Item_not_set_case
+	}
+	switch x.xxx_hidden_Item.(type) {
+	case *batchOperation_Credential:
+		return BatchOperation_Credential_case
+	case *batchOperation_BankCard:
+		return BatchOperation_BankCard_case
+	case *batchOperation_TextData:
+		return BatchOperation_TextData_case
+	default:
+		return BatchOperation_Item_not_set_case
+	}
+}
+
+type BatchOperation_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	Kind *BatchOperationKind
+	Type *ItemType
+	Id   *string
+	// Fields of oneof xxx_hidden_Item:
+	Credential *Credential
+	BankCard   *BankCard
+	TextData   *TextData
+	// -- end of xxx_hidden_Item
 }
 
-func (b0 UploadBinaryDataResponse_builder) Build() *UploadBinaryDataResponse {
-	m0 := &UploadBinaryDataResponse{}
+func (b0 BatchOperation_builder) Build() *BatchOperation {
+	m0 := &BatchOperation{}
 	b, x := &b0, m0
 	_, _ = b, x
+	if b.Kind != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_Kind = *b.Kind
+	}
+	if b.Type != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Type = *b.Type
+	}
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
 		x.xxx_hidden_Id = b.Id
 	}
+	if b.Credential != nil {
+		x.xxx_hidden_Item = &batchOperation_Credential{b.Credential}
+	}
+	if b.BankCard != nil {
+		x.xxx_hidden_Item = &batchOperation_BankCard{b.BankCard}
+	}
+	if b.TextData != nil {
+		x.xxx_hidden_Item = &batchOperation_TextData{b.TextData}
+	}
 	return m0
 }
 
-type DownloadBinaryDataRequest struct {
+type case_BatchOperation_Item protoreflect.FieldNumber
+
+func (x case_BatchOperation_Item) String() string {
+	md := file_api_proto_msgTypes[88].Descriptor()
+	if x == 0 {
+		return "not set"
+	}
+	return protoimpl.X.MessageFieldStringOf(md, protoreflect.FieldNumber(x))
+}
+
+type isBatchOperation_Item interface {
+	isBatchOperation_Item()
+}
+
+type batchOperation_Credential struct {
+	Credential *Credential `protobuf:"bytes,4,opt,name=credential,oneof"`
+}
+
+type batchOperation_BankCard struct {
+	BankCard *BankCard `protobuf:"bytes,5,opt,name=bank_card,json=bankCard,oneof"`
+}
+
+type batchOperation_TextData struct {
+	TextData *TextData `protobuf:"bytes,6,opt,name=text_data,json=textData,oneof"`
+}
+
+func (*batchOperation_Credential) isBatchOperation_Item() {}
+
+func (*batchOperation_BankCard) isBatchOperation_Item() {}
+
+func (*batchOperation_TextData) isBatchOperation_Item() {}
+
+// Результат операции пакета (в порядке операций запроса)
+type BatchResult struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
 	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
+	xxx_hidden_Version     int64                  `protobuf:"varint,2,opt,name=version"`
+	xxx_hidden_Code        int32                  `protobuf:"varint,3,opt,name=code"`
+	xxx_hidden_Error       *string                `protobuf:"bytes,4,opt,name=error"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
-
-func (x *DownloadBinaryDataRequest) Reset() {
-	*x = DownloadBinaryDataRequest{}
-	mi := &file_api_proto_msgTypes[37]
+
+func (x *BatchResult) Reset() {
+	*x = BatchResult{}
+	mi := &file_api_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadBinaryDataRequest) String() string {
+func (x *BatchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadBinaryDataRequest) ProtoMessage() {}
+func (*BatchResult) ProtoMessage() {}
 
-func (x *DownloadBinaryDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[37]
+func (x *BatchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3549,7 +10430,7 @@ func (x *DownloadBinaryDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DownloadBinaryDataRequest) GetId() string {
+func (x *BatchResult) GetId() string {
 	if x != nil {
 		if x.xxx_hidden_Id != nil {
 			return *x.xxx_hidden_Id
@@ -3559,64 +10440,155 @@ func (x *DownloadBinaryDataRequest) GetId() string {
 	return ""
 }
 
-func (x *DownloadBinaryDataRequest) SetId(v string) {
+func (x *BatchResult) GetVersion() int64 {
+	if x != nil {
+		return x.xxx_hidden_Version
+	}
+	return 0
+}
+
+func (x *BatchResult) GetCode() int32 {
+	if x != nil {
+		return x.xxx_hidden_Code
+	}
+	return 0
+}
+
+func (x *BatchResult) GetError() string {
+	if x != nil {
+		if x.xxx_hidden_Error != nil {
+			return *x.xxx_hidden_Error
+		}
+		return ""
+	}
+	return ""
+}
+
+func (x *BatchResult) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
-func (x *DownloadBinaryDataRequest) HasId() bool {
+func (x *BatchResult) SetVersion(v int64) {
+	x.xxx_hidden_Version = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
+}
+
+func (x *BatchResult) SetCode(v int32) {
+	x.xxx_hidden_Code = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
+}
+
+func (x *BatchResult) SetError(v string) {
+	x.xxx_hidden_Error = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
+}
+
+func (x *BatchResult) HasId() bool {
 	if x == nil {
 		return false
 	}
 	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *DownloadBinaryDataRequest) ClearId() {
+func (x *BatchResult) HasVersion() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+}
+
+func (x *BatchResult) HasCode() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+}
+
+func (x *BatchResult) HasError() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+}
+
+func (x *BatchResult) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
 	x.xxx_hidden_Id = nil
 }
 
-type DownloadBinaryDataRequest_builder struct {
+func (x *BatchResult) ClearVersion() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Version = 0
+}
+
+func (x *BatchResult) ClearCode() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Code = 0
+}
+
+func (x *BatchResult) ClearError() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Error = nil
+}
+
+type BatchResult_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	Id      *string
+	Version *int64
+	Code    *int32
+	Error   *string
 }
 
-func (b0 DownloadBinaryDataRequest_builder) Build() *DownloadBinaryDataRequest {
-	m0 := &DownloadBinaryDataRequest{}
+func (b0 BatchResult_builder) Build() *BatchResult {
+	m0 := &BatchResult{}
 	b, x := &b0, m0
 	_, _ = b, x
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
 		x.xxx_hidden_Id = b.Id
 	}
+	if b.Version != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
+		x.xxx_hidden_Version = *b.Version
+	}
+	if b.Code != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_Code = *b.Code
+	}
+	if b.Error != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Error = b.Error
+	}
 	return m0
 }
 
-type DownloadBinaryDataResponse struct {
+type BatchMutateRequest struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Chunk       []byte                 `protobuf:"bytes,1,opt,name=chunk"`
+	xxx_hidden_Operations  *[]*BatchOperation     `protobuf:"bytes,1,rep,name=operations"`
+	xxx_hidden_Atomic      bool                   `protobuf:"varint,2,opt,name=atomic"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *DownloadBinaryDataResponse) Reset() {
-	*x = DownloadBinaryDataResponse{}
-	mi := &file_api_proto_msgTypes[38]
+func (x *BatchMutateRequest) Reset() {
+	*x = BatchMutateRequest{}
+	mi := &file_api_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadBinaryDataResponse) String() string {
+func (x *BatchMutateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadBinaryDataResponse) ProtoMessage() {}
+func (*BatchMutateRequest) ProtoMessage() {}
 
-func (x *DownloadBinaryDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[38]
+func (x *BatchMutateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3627,115 +10599,84 @@ func (x *DownloadBinaryDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DownloadBinaryDataResponse) GetChunk() []byte {
+func (x *BatchMutateRequest) GetOperations() []*BatchOperation {
 	if x != nil {
-		return x.xxx_hidden_Chunk
+		if x.xxx_hidden_Operations != nil {
+			return *x.xxx_hidden_Operations
+		}
 	}
 	return nil
 }
 
-func (x *DownloadBinaryDataResponse) SetChunk(v []byte) {
-	if v == nil {
-		v = []byte{}
-	}
-	x.xxx_hidden_Chunk = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
-}
-
-func (x *DownloadBinaryDataResponse) HasChunk() bool {
-	if x == nil {
-		return false
+func (x *BatchMutateRequest) GetAtomic() bool {
+	if x != nil {
+		return x.xxx_hidden_Atomic
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return false
 }
 
-func (x *DownloadBinaryDataResponse) ClearChunk() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Chunk = nil
+func (x *BatchMutateRequest) SetOperations(v []*BatchOperation) {
+	x.xxx_hidden_Operations = &v
 }
 
-type DownloadBinaryDataResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
-
-	Chunk []byte
+func (x *BatchMutateRequest) SetAtomic(v bool) {
+	x.xxx_hidden_Atomic = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
 }
 
-func (b0 DownloadBinaryDataResponse_builder) Build() *DownloadBinaryDataResponse {
-	m0 := &DownloadBinaryDataResponse{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.Chunk != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Chunk = b.Chunk
+func (x *BatchMutateRequest) HasAtomic() bool {
+	if x == nil {
+		return false
 	}
-	return m0
-}
-
-type ListBinaryDataRequest struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListBinaryDataRequest) Reset() {
-	*x = ListBinaryDataRequest{}
-	mi := &file_api_proto_msgTypes[39]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListBinaryDataRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (*ListBinaryDataRequest) ProtoMessage() {}
-
-func (x *ListBinaryDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[39]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *BatchMutateRequest) ClearAtomic() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Atomic = false
 }
 
-type ListBinaryDataRequest_builder struct {
+type BatchMutateRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
+	Operations []*BatchOperation
+	Atomic     *bool
 }
 
-func (b0 ListBinaryDataRequest_builder) Build() *ListBinaryDataRequest {
-	m0 := &ListBinaryDataRequest{}
+func (b0 BatchMutateRequest_builder) Build() *BatchMutateRequest {
+	m0 := &BatchMutateRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
+	x.xxx_hidden_Operations = &b.Operations
+	if b.Atomic != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Atomic = *b.Atomic
+	}
 	return m0
 }
 
-type ListBinaryDataResponse struct {
-	state            protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Items *[]*BinaryDataInfo     `protobuf:"bytes,1,rep,name=items"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+type BatchMutateResponse struct {
+	state              protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Results *[]*BatchResult        `protobuf:"bytes,1,rep,name=results"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *ListBinaryDataResponse) Reset() {
-	*x = ListBinaryDataResponse{}
-	mi := &file_api_proto_msgTypes[40]
+func (x *BatchMutateResponse) Reset() {
+	*x = BatchMutateResponse{}
+	mi := &file_api_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListBinaryDataResponse) String() string {
+func (x *BatchMutateResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBinaryDataResponse) ProtoMessage() {}
+func (*BatchMutateResponse) ProtoMessage() {}
 
-func (x *ListBinaryDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[40]
+func (x *BatchMutateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3746,63 +10687,61 @@ func (x *ListBinaryDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *ListBinaryDataResponse) GetItems() []*BinaryDataInfo {
+func (x *BatchMutateResponse) GetResults() []*BatchResult {
 	if x != nil {
-		if x.xxx_hidden_Items != nil {
-			return *x.xxx_hidden_Items
+		if x.xxx_hidden_Results != nil {
+			return *x.xxx_hidden_Results
 		}
 	}
 	return nil
 }
 
-func (x *ListBinaryDataResponse) SetItems(v []*BinaryDataInfo) {
-	x.xxx_hidden_Items = &v
+func (x *BatchMutateResponse) SetResults(v []*BatchResult) {
+	x.xxx_hidden_Results = &v
 }
 
-type ListBinaryDataResponse_builder struct {
+type BatchMutateResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Items []*BinaryDataInfo
+	Results []*BatchResult
 }
 
-func (b0 ListBinaryDataResponse_builder) Build() *ListBinaryDataResponse {
-	m0 := &ListBinaryDataResponse{}
+func (b0 BatchMutateResponse_builder) Build() *BatchMutateResponse {
+	m0 := &BatchMutateResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Items = &b.Items
+	x.xxx_hidden_Results = &b.Results
 	return m0
 }
 
-type BinaryDataInfo struct {
+// Ссылка записи или фрагмента на файл хранилища бинарных данных
+type StoredRef struct {
 	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	xxx_hidden_Title       *string                `protobuf:"bytes,2,opt,name=title"`
-	xxx_hidden_Metadata    *string                `protobuf:"bytes,3,opt,name=metadata"`
-	xxx_hidden_Size        int64                  `protobuf:"varint,4,opt,name=size"`
-	xxx_hidden_ClientPath  *string                `protobuf:"bytes,5,opt,name=client_path,json=clientPath"`
-	xxx_hidden_CreatedAt   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt"`
-	xxx_hidden_UpdatedAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt"`
+	xxx_hidden_UserId      *string                `protobuf:"bytes,1,opt,name=user_id,json=userId"`
+	xxx_hidden_Id          *string                `protobuf:"bytes,2,opt,name=id"`
+	xxx_hidden_StoragePath *string                `protobuf:"bytes,3,opt,name=storage_path,json=storagePath"`
+	xxx_hidden_Chunk       bool                   `protobuf:"varint,4,opt,name=chunk"`
 	XXX_raceDetectHookData protoimpl.RaceDetectHookData
 	XXX_presence           [1]uint32
 	unknownFields          protoimpl.UnknownFields
 	sizeCache              protoimpl.SizeCache
 }
 
-func (x *BinaryDataInfo) Reset() {
-	*x = BinaryDataInfo{}
-	mi := &file_api_proto_msgTypes[41]
+func (x *StoredRef) Reset() {
+	*x = StoredRef{}
+	mi := &file_api_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BinaryDataInfo) String() string {
+func (x *StoredRef) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BinaryDataInfo) ProtoMessage() {}
+func (*StoredRef) ProtoMessage() {}
 
-func (x *BinaryDataInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[41]
+func (x *StoredRef) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3813,247 +10752,180 @@ func (x *BinaryDataInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *BinaryDataInfo) GetId() string {
-	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
-	}
-	return ""
-}
-
-func (x *BinaryDataInfo) GetTitle() string {
+func (x *StoredRef) GetUserId() string {
 	if x != nil {
-		if x.xxx_hidden_Title != nil {
-			return *x.xxx_hidden_Title
+		if x.xxx_hidden_UserId != nil {
+			return *x.xxx_hidden_UserId
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *BinaryDataInfo) GetMetadata() string {
+func (x *StoredRef) GetId() string {
 	if x != nil {
-		if x.xxx_hidden_Metadata != nil {
-			return *x.xxx_hidden_Metadata
+		if x.xxx_hidden_Id != nil {
+			return *x.xxx_hidden_Id
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *BinaryDataInfo) GetSize() int64 {
-	if x != nil {
-		return x.xxx_hidden_Size
-	}
-	return 0
-}
-
-func (x *BinaryDataInfo) GetClientPath() string {
+func (x *StoredRef) GetStoragePath() string {
 	if x != nil {
-		if x.xxx_hidden_ClientPath != nil {
-			return *x.xxx_hidden_ClientPath
+		if x.xxx_hidden_StoragePath != nil {
+			return *x.xxx_hidden_StoragePath
 		}
 		return ""
 	}
 	return ""
 }
 
-func (x *BinaryDataInfo) GetCreatedAt() *timestamppb.Timestamp {
+func (x *StoredRef) GetChunk() bool {
 	if x != nil {
-		return x.xxx_hidden_CreatedAt
+		return x.xxx_hidden_Chunk
 	}
-	return nil
+	return false
 }
 
-func (x *BinaryDataInfo) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.xxx_hidden_UpdatedAt
-	}
-	return nil
+func (x *StoredRef) SetUserId(v string) {
+	x.xxx_hidden_UserId = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 4)
 }
 
-func (x *BinaryDataInfo) SetId(v string) {
+func (x *StoredRef) SetId(v string) {
 	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 7)
-}
-
-func (x *BinaryDataInfo) SetTitle(v string) {
-	x.xxx_hidden_Title = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 7)
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 4)
 }
 
-func (x *BinaryDataInfo) SetMetadata(v string) {
-	x.xxx_hidden_Metadata = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 7)
-}
-
-func (x *BinaryDataInfo) SetSize(v int64) {
-	x.xxx_hidden_Size = v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 7)
-}
-
-func (x *BinaryDataInfo) SetClientPath(v string) {
-	x.xxx_hidden_ClientPath = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 7)
-}
-
-func (x *BinaryDataInfo) SetCreatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_CreatedAt = v
-}
-
-func (x *BinaryDataInfo) SetUpdatedAt(v *timestamppb.Timestamp) {
-	x.xxx_hidden_UpdatedAt = v
-}
-
-func (x *BinaryDataInfo) HasId() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
-}
-
-func (x *BinaryDataInfo) HasTitle() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
+func (x *StoredRef) SetStoragePath(v string) {
+	x.xxx_hidden_StoragePath = &v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 4)
 }
 
-func (x *BinaryDataInfo) HasMetadata() bool {
-	if x == nil {
-		return false
-	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
+func (x *StoredRef) SetChunk(v bool) {
+	x.xxx_hidden_Chunk = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 4)
 }
 
-func (x *BinaryDataInfo) HasSize() bool {
+func (x *StoredRef) HasUserId() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *BinaryDataInfo) HasClientPath() bool {
+func (x *StoredRef) HasId() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-func (x *BinaryDataInfo) HasCreatedAt() bool {
+func (x *StoredRef) HasStoragePath() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_CreatedAt != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *BinaryDataInfo) HasUpdatedAt() bool {
+func (x *StoredRef) HasChunk() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_UpdatedAt != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *BinaryDataInfo) ClearId() {
+func (x *StoredRef) ClearUserId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+	x.xxx_hidden_UserId = nil
 }
 
-func (x *BinaryDataInfo) ClearTitle() {
+func (x *StoredRef) ClearId() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
-	x.xxx_hidden_Title = nil
+	x.xxx_hidden_Id = nil
 }
 
-func (x *BinaryDataInfo) ClearMetadata() {
+func (x *StoredRef) ClearStoragePath() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
-	x.xxx_hidden_Metadata = nil
+	x.xxx_hidden_StoragePath = nil
 }
 
-func (x *BinaryDataInfo) ClearSize() {
+func (x *StoredRef) ClearChunk() {
 	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
-	x.xxx_hidden_Size = 0
-}
-
-func (x *BinaryDataInfo) ClearClientPath() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
-	x.xxx_hidden_ClientPath = nil
-}
-
-func (x *BinaryDataInfo) ClearCreatedAt() {
-	x.xxx_hidden_CreatedAt = nil
-}
-
-func (x *BinaryDataInfo) ClearUpdatedAt() {
-	x.xxx_hidden_UpdatedAt = nil
+	x.xxx_hidden_Chunk = false
 }
 
-type BinaryDataInfo_builder struct {
+type StoredRef_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id         *string
-	Title      *string
-	Metadata   *string
-	Size       *int64
-	ClientPath *string
-	CreatedAt  *timestamppb.Timestamp
-	UpdatedAt  *timestamppb.Timestamp
+	UserId      *string
+	Id          *string
+	StoragePath *string
+	Chunk       *bool
 }
 
-func (b0 BinaryDataInfo_builder) Build() *BinaryDataInfo {
-	m0 := &BinaryDataInfo{}
+func (b0 StoredRef_builder) Build() *StoredRef {
+	m0 := &StoredRef{}
 	b, x := &b0, m0
 	_, _ = b, x
+	if b.UserId != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 4)
+		x.xxx_hidden_UserId = b.UserId
+	}
 	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 7)
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 4)
 		x.xxx_hidden_Id = b.Id
 	}
-	if b.Title != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 7)
-		x.xxx_hidden_Title = b.Title
-	}
-	if b.Metadata != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 7)
-		x.xxx_hidden_Metadata = b.Metadata
-	}
-	if b.Size != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 7)
-		x.xxx_hidden_Size = *b.Size
+	if b.StoragePath != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 4)
+		x.xxx_hidden_StoragePath = b.StoragePath
 	}
-	if b.ClientPath != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 7)
-		x.xxx_hidden_ClientPath = b.ClientPath
+	if b.Chunk != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 4)
+		x.xxx_hidden_Chunk = *b.Chunk
 	}
-	x.xxx_hidden_CreatedAt = b.CreatedAt
-	x.xxx_hidden_UpdatedAt = b.UpdatedAt
 	return m0
 }
 
-type DeleteBinaryDataRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
-}
-
-func (x *DeleteBinaryDataRequest) Reset() {
-	*x = DeleteBinaryDataRequest{}
-	mi := &file_api_proto_msgTypes[42]
+// Результат сверки хранилища бинарных данных с записями
+type ScrubReport struct {
+	state                         protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_StartedAt          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=started_at,json=startedAt"`
+	xxx_hidden_FinishedAt         *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=finished_at,json=finishedAt"`
+	xxx_hidden_Fix                bool                   `protobuf:"varint,3,opt,name=fix"`
+	xxx_hidden_Rehash             bool                   `protobuf:"varint,4,opt,name=rehash"`
+	xxx_hidden_FilesScanned       int64                  `protobuf:"varint,5,opt,name=files_scanned,json=filesScanned"`
+	xxx_hidden_RecordsScanned     int64                  `protobuf:"varint,6,opt,name=records_scanned,json=recordsScanned"`
+	xxx_hidden_FilesRehashed      int64                  `protobuf:"varint,7,opt,name=files_rehashed,json=filesRehashed"`
+	xxx_hidden_OrphanFiles        []string               `protobuf:"bytes,8,rep,name=orphan_files,json=orphanFiles"`
+	xxx_hidden_MissingFiles       *[]*StoredRef          `protobuf:"bytes,9,rep,name=missing_files,json=missingFiles"`
+	xxx_hidden_ChecksumMismatches *[]*StoredRef          `protobuf:"bytes,10,rep,name=checksum_mismatches,json=checksumMismatches"`
+	xxx_hidden_RemovedFiles       int64                  `protobuf:"varint,11,opt,name=removed_files,json=removedFiles"`
+	xxx_hidden_RemovedRecords     int64                  `protobuf:"varint,12,opt,name=removed_records,json=removedRecords"`
+	xxx_hidden_Errors             []string               `protobuf:"bytes,13,rep,name=errors"`
+	XXX_raceDetectHookData        protoimpl.RaceDetectHookData
+	XXX_presence                  [1]uint32
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
+}
+
+func (x *ScrubReport) Reset() {
+	*x = ScrubReport{}
+	mi := &file_api_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteBinaryDataRequest) String() string {
+func (x *ScrubReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteBinaryDataRequest) ProtoMessage() {}
+func (*ScrubReport) ProtoMessage() {}
 
-func (x *DeleteBinaryDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[42]
+func (x *ScrubReport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4064,264 +10936,350 @@ func (x *DeleteBinaryDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *DeleteBinaryDataRequest) GetId() string {
+func (x *ScrubReport) GetStartedAt() *timestamppb.Timestamp {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
+		return x.xxx_hidden_StartedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *DeleteBinaryDataRequest) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *ScrubReport) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.xxx_hidden_FinishedAt
+	}
+	return nil
 }
 
-func (x *DeleteBinaryDataRequest) HasId() bool {
-	if x == nil {
-		return false
+func (x *ScrubReport) GetFix() bool {
+	if x != nil {
+		return x.xxx_hidden_Fix
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return false
 }
 
-func (x *DeleteBinaryDataRequest) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *ScrubReport) GetRehash() bool {
+	if x != nil {
+		return x.xxx_hidden_Rehash
+	}
+	return false
 }
 
-type DeleteBinaryDataRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *ScrubReport) GetFilesScanned() int64 {
+	if x != nil {
+		return x.xxx_hidden_FilesScanned
+	}
+	return 0
+}
 
-	Id *string
+func (x *ScrubReport) GetRecordsScanned() int64 {
+	if x != nil {
+		return x.xxx_hidden_RecordsScanned
+	}
+	return 0
 }
 
-func (b0 DeleteBinaryDataRequest_builder) Build() *DeleteBinaryDataRequest {
-	m0 := &DeleteBinaryDataRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
+func (x *ScrubReport) GetFilesRehashed() int64 {
+	if x != nil {
+		return x.xxx_hidden_FilesRehashed
 	}
-	return m0
+	return 0
 }
 
-type DeleteBinaryDataResponse struct {
-	state         protoimpl.MessageState `protogen:"opaque.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ScrubReport) GetOrphanFiles() []string {
+	if x != nil {
+		return x.xxx_hidden_OrphanFiles
+	}
+	return nil
 }
 
-func (x *DeleteBinaryDataResponse) Reset() {
-	*x = DeleteBinaryDataResponse{}
-	mi := &file_api_proto_msgTypes[43]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScrubReport) GetMissingFiles() []*StoredRef {
+	if x != nil {
+		if x.xxx_hidden_MissingFiles != nil {
+			return *x.xxx_hidden_MissingFiles
+		}
+	}
+	return nil
 }
 
-func (x *DeleteBinaryDataResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScrubReport) GetChecksumMismatches() []*StoredRef {
+	if x != nil {
+		if x.xxx_hidden_ChecksumMismatches != nil {
+			return *x.xxx_hidden_ChecksumMismatches
+		}
+	}
+	return nil
 }
 
-func (*DeleteBinaryDataResponse) ProtoMessage() {}
+func (x *ScrubReport) GetRemovedFiles() int64 {
+	if x != nil {
+		return x.xxx_hidden_RemovedFiles
+	}
+	return 0
+}
 
-func (x *DeleteBinaryDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[43]
+func (x *ScrubReport) GetRemovedRecords() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.xxx_hidden_RemovedRecords
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-type DeleteBinaryDataResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *ScrubReport) GetErrors() []string {
+	if x != nil {
+		return x.xxx_hidden_Errors
+	}
+	return nil
+}
 
+func (x *ScrubReport) SetStartedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_StartedAt = v
 }
 
-func (b0 DeleteBinaryDataResponse_builder) Build() *DeleteBinaryDataResponse {
-	m0 := &DeleteBinaryDataResponse{}
-	b, x := &b0, m0
-	_, _ = b, x
-	return m0
+func (x *ScrubReport) SetFinishedAt(v *timestamppb.Timestamp) {
+	x.xxx_hidden_FinishedAt = v
 }
 
-// Запрос информации о файле
-type GetBinaryDataInfoRequest struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *ScrubReport) SetFix(v bool) {
+	x.xxx_hidden_Fix = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 2, 13)
 }
 
-func (x *GetBinaryDataInfoRequest) Reset() {
-	*x = GetBinaryDataInfoRequest{}
-	mi := &file_api_proto_msgTypes[44]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScrubReport) SetRehash(v bool) {
+	x.xxx_hidden_Rehash = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 3, 13)
 }
 
-func (x *GetBinaryDataInfoRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScrubReport) SetFilesScanned(v int64) {
+	x.xxx_hidden_FilesScanned = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 4, 13)
 }
 
-func (*GetBinaryDataInfoRequest) ProtoMessage() {}
+func (x *ScrubReport) SetRecordsScanned(v int64) {
+	x.xxx_hidden_RecordsScanned = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 5, 13)
+}
 
-func (x *GetBinaryDataInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[44]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ScrubReport) SetFilesRehashed(v int64) {
+	x.xxx_hidden_FilesRehashed = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 6, 13)
+}
+
+func (x *ScrubReport) SetOrphanFiles(v []string) {
+	x.xxx_hidden_OrphanFiles = v
+}
+
+func (x *ScrubReport) SetMissingFiles(v []*StoredRef) {
+	x.xxx_hidden_MissingFiles = &v
+}
+
+func (x *ScrubReport) SetChecksumMismatches(v []*StoredRef) {
+	x.xxx_hidden_ChecksumMismatches = &v
+}
+
+func (x *ScrubReport) SetRemovedFiles(v int64) {
+	x.xxx_hidden_RemovedFiles = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 10, 13)
+}
+
+func (x *ScrubReport) SetRemovedRecords(v int64) {
+	x.xxx_hidden_RemovedRecords = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 11, 13)
+}
+
+func (x *ScrubReport) SetErrors(v []string) {
+	x.xxx_hidden_Errors = v
+}
+
+func (x *ScrubReport) HasStartedAt() bool {
+	if x == nil {
+		return false
 	}
-	return mi.MessageOf(x)
+	return x.xxx_hidden_StartedAt != nil
 }
 
-func (x *GetBinaryDataInfoRequest) GetId() string {
-	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
+func (x *ScrubReport) HasFinishedAt() bool {
+	if x == nil {
+		return false
 	}
-	return ""
+	return x.xxx_hidden_FinishedAt != nil
 }
 
-func (x *GetBinaryDataInfoRequest) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *ScrubReport) HasFix() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 2)
 }
 
-func (x *GetBinaryDataInfoRequest) HasId() bool {
+func (x *ScrubReport) HasRehash() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 3)
 }
 
-func (x *GetBinaryDataInfoRequest) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *ScrubReport) HasFilesScanned() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 4)
 }
 
-type GetBinaryDataInfoRequest_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *ScrubReport) HasRecordsScanned() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 5)
+}
 
-	Id *string
+func (x *ScrubReport) HasFilesRehashed() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 6)
 }
 
-func (b0 GetBinaryDataInfoRequest_builder) Build() *GetBinaryDataInfoRequest {
-	m0 := &GetBinaryDataInfoRequest{}
-	b, x := &b0, m0
-	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
+func (x *ScrubReport) HasRemovedFiles() bool {
+	if x == nil {
+		return false
 	}
-	return m0
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 10)
 }
 
-// Ответ с метаданными файла
-type GetBinaryDataInfoResponse struct {
-	state                 protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_BinaryInfo *BinaryDataInfo        `protobuf:"bytes,1,opt,name=binary_info,json=binaryInfo"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+func (x *ScrubReport) HasRemovedRecords() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 11)
 }
 
-func (x *GetBinaryDataInfoResponse) Reset() {
-	*x = GetBinaryDataInfoResponse{}
-	mi := &file_api_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ScrubReport) ClearStartedAt() {
+	x.xxx_hidden_StartedAt = nil
 }
 
-func (x *GetBinaryDataInfoResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ScrubReport) ClearFinishedAt() {
+	x.xxx_hidden_FinishedAt = nil
 }
 
-func (*GetBinaryDataInfoResponse) ProtoMessage() {}
-
-func (x *GetBinaryDataInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[45]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *ScrubReport) ClearFix() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 2)
+	x.xxx_hidden_Fix = false
 }
 
-func (x *GetBinaryDataInfoResponse) GetBinaryInfo() *BinaryDataInfo {
-	if x != nil {
-		return x.xxx_hidden_BinaryInfo
-	}
-	return nil
+func (x *ScrubReport) ClearRehash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 3)
+	x.xxx_hidden_Rehash = false
 }
 
-func (x *GetBinaryDataInfoResponse) SetBinaryInfo(v *BinaryDataInfo) {
-	x.xxx_hidden_BinaryInfo = v
+func (x *ScrubReport) ClearFilesScanned() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 4)
+	x.xxx_hidden_FilesScanned = 0
 }
 
-func (x *GetBinaryDataInfoResponse) HasBinaryInfo() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_BinaryInfo != nil
+func (x *ScrubReport) ClearRecordsScanned() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 5)
+	x.xxx_hidden_RecordsScanned = 0
 }
 
-func (x *GetBinaryDataInfoResponse) ClearBinaryInfo() {
-	x.xxx_hidden_BinaryInfo = nil
+func (x *ScrubReport) ClearFilesRehashed() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 6)
+	x.xxx_hidden_FilesRehashed = 0
 }
 
-type GetBinaryDataInfoResponse_builder struct {
-	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+func (x *ScrubReport) ClearRemovedFiles() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 10)
+	x.xxx_hidden_RemovedFiles = 0
+}
 
-	BinaryInfo *BinaryDataInfo
+func (x *ScrubReport) ClearRemovedRecords() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 11)
+	x.xxx_hidden_RemovedRecords = 0
 }
 
-func (b0 GetBinaryDataInfoResponse_builder) Build() *GetBinaryDataInfoResponse {
-	m0 := &GetBinaryDataInfoResponse{}
+type ScrubReport_builder struct {
+	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
+
+	StartedAt          *timestamppb.Timestamp
+	FinishedAt         *timestamppb.Timestamp
+	Fix                *bool
+	Rehash             *bool
+	FilesScanned       *int64
+	RecordsScanned     *int64
+	FilesRehashed      *int64
+	OrphanFiles        []string
+	MissingFiles       []*StoredRef
+	ChecksumMismatches []*StoredRef
+	RemovedFiles       *int64
+	RemovedRecords     *int64
+	Errors             []string
+}
+
+func (b0 ScrubReport_builder) Build() *ScrubReport {
+	m0 := &ScrubReport{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_BinaryInfo = b.BinaryInfo
+	x.xxx_hidden_StartedAt = b.StartedAt
+	x.xxx_hidden_FinishedAt = b.FinishedAt
+	if b.Fix != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 2, 13)
+		x.xxx_hidden_Fix = *b.Fix
+	}
+	if b.Rehash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 3, 13)
+		x.xxx_hidden_Rehash = *b.Rehash
+	}
+	if b.FilesScanned != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 4, 13)
+		x.xxx_hidden_FilesScanned = *b.FilesScanned
+	}
+	if b.RecordsScanned != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 5, 13)
+		x.xxx_hidden_RecordsScanned = *b.RecordsScanned
+	}
+	if b.FilesRehashed != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 6, 13)
+		x.xxx_hidden_FilesRehashed = *b.FilesRehashed
+	}
+	x.xxx_hidden_OrphanFiles = b.OrphanFiles
+	x.xxx_hidden_MissingFiles = &b.MissingFiles
+	x.xxx_hidden_ChecksumMismatches = &b.ChecksumMismatches
+	if b.RemovedFiles != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 10, 13)
+		x.xxx_hidden_RemovedFiles = *b.RemovedFiles
+	}
+	if b.RemovedRecords != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 11, 13)
+		x.xxx_hidden_RemovedRecords = *b.RemovedRecords
+	}
+	x.xxx_hidden_Errors = b.Errors
 	return m0
 }
 
-// Запрос для обновления бинарных данных (поток)
-type UpdateBinaryDataRequest struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Info *BinaryDataInfo        `protobuf:"bytes,2,opt,name=info"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type RunScrubRequest struct {
+	state                  protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Fix         bool                   `protobuf:"varint,1,opt,name=fix"`
+	xxx_hidden_Rehash      bool                   `protobuf:"varint,2,opt,name=rehash"`
+	XXX_raceDetectHookData protoimpl.RaceDetectHookData
+	XXX_presence           [1]uint32
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *UpdateBinaryDataRequest) Reset() {
-	*x = UpdateBinaryDataRequest{}
-	mi := &file_api_proto_msgTypes[46]
+func (x *RunScrubRequest) Reset() {
+	*x = RunScrubRequest{}
+	mi := &file_api_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBinaryDataRequest) String() string {
+func (x *RunScrubRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBinaryDataRequest) ProtoMessage() {}
+func (*RunScrubRequest) ProtoMessage() {}
 
-func (x *UpdateBinaryDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[46]
+func (x *RunScrubRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4332,67 +11290,98 @@ func (x *UpdateBinaryDataRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateBinaryDataRequest) GetInfo() *BinaryDataInfo {
+func (x *RunScrubRequest) GetFix() bool {
 	if x != nil {
-		return x.xxx_hidden_Info
+		return x.xxx_hidden_Fix
 	}
-	return nil
+	return false
 }
 
-func (x *UpdateBinaryDataRequest) SetInfo(v *BinaryDataInfo) {
-	x.xxx_hidden_Info = v
+func (x *RunScrubRequest) GetRehash() bool {
+	if x != nil {
+		return x.xxx_hidden_Rehash
+	}
+	return false
 }
 
-func (x *UpdateBinaryDataRequest) HasInfo() bool {
+func (x *RunScrubRequest) SetFix(v bool) {
+	x.xxx_hidden_Fix = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 2)
+}
+
+func (x *RunScrubRequest) SetRehash(v bool) {
+	x.xxx_hidden_Rehash = v
+	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 1, 2)
+}
+
+func (x *RunScrubRequest) HasFix() bool {
 	if x == nil {
 		return false
 	}
-	return x.xxx_hidden_Info != nil
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
 }
 
-func (x *UpdateBinaryDataRequest) ClearInfo() {
-	x.xxx_hidden_Info = nil
+func (x *RunScrubRequest) HasRehash() bool {
+	if x == nil {
+		return false
+	}
+	return protoimpl.X.Present(&(x.XXX_presence[0]), 1)
 }
 
-type UpdateBinaryDataRequest_builder struct {
+func (x *RunScrubRequest) ClearFix() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
+	x.xxx_hidden_Fix = false
+}
+
+func (x *RunScrubRequest) ClearRehash() {
+	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 1)
+	x.xxx_hidden_Rehash = false
+}
+
+type RunScrubRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Info *BinaryDataInfo
+	Fix    *bool
+	Rehash *bool
 }
 
-func (b0 UpdateBinaryDataRequest_builder) Build() *UpdateBinaryDataRequest {
-	m0 := &UpdateBinaryDataRequest{}
+func (b0 RunScrubRequest_builder) Build() *RunScrubRequest {
+	m0 := &RunScrubRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Info = b.Info
+	if b.Fix != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 2)
+		x.xxx_hidden_Fix = *b.Fix
+	}
+	if b.Rehash != nil {
+		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 1, 2)
+		x.xxx_hidden_Rehash = *b.Rehash
+	}
 	return m0
 }
 
-// Ответ после завершения обновления
-type UpdateBinaryDataResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type RunScrubResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Report *ScrubReport           `protobuf:"bytes,1,opt,name=report"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *UpdateBinaryDataResponse) Reset() {
-	*x = UpdateBinaryDataResponse{}
-	mi := &file_api_proto_msgTypes[47]
+func (x *RunScrubResponse) Reset() {
+	*x = RunScrubResponse{}
+	mi := &file_api_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateBinaryDataResponse) String() string {
+func (x *RunScrubResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateBinaryDataResponse) ProtoMessage() {}
+func (*RunScrubResponse) ProtoMessage() {}
 
-func (x *UpdateBinaryDataResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[47]
+func (x *RunScrubResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4403,72 +11392,63 @@ func (x *UpdateBinaryDataResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *UpdateBinaryDataResponse) GetId() string {
+func (x *RunScrubResponse) GetReport() *ScrubReport {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
+		return x.xxx_hidden_Report
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateBinaryDataResponse) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *RunScrubResponse) SetReport(v *ScrubReport) {
+	x.xxx_hidden_Report = v
 }
 
-func (x *UpdateBinaryDataResponse) HasId() bool {
+func (x *RunScrubResponse) HasReport() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return x.xxx_hidden_Report != nil
 }
 
-func (x *UpdateBinaryDataResponse) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *RunScrubResponse) ClearReport() {
+	x.xxx_hidden_Report = nil
 }
 
-type UpdateBinaryDataResponse_builder struct {
+type RunScrubResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	Report *ScrubReport
 }
 
-func (b0 UpdateBinaryDataResponse_builder) Build() *UpdateBinaryDataResponse {
-	m0 := &UpdateBinaryDataResponse{}
+func (b0 RunScrubResponse_builder) Build() *RunScrubResponse {
+	m0 := &RunScrubResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
-	}
+	x.xxx_hidden_Report = b.Report
 	return m0
 }
 
-type SaveBinaryDataInfoRequest struct {
-	state           protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Info *BinaryDataInfo        `protobuf:"bytes,1,opt,name=info"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type GetScrubReportRequest struct {
+	state         protoimpl.MessageState `protogen:"opaque.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SaveBinaryDataInfoRequest) Reset() {
-	*x = SaveBinaryDataInfoRequest{}
-	mi := &file_api_proto_msgTypes[48]
+func (x *GetScrubReportRequest) Reset() {
+	*x = GetScrubReportRequest{}
+	mi := &file_api_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveBinaryDataInfoRequest) String() string {
+func (x *GetScrubReportRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveBinaryDataInfoRequest) ProtoMessage() {}
+func (*GetScrubReportRequest) ProtoMessage() {}
 
-func (x *SaveBinaryDataInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[48]
+func (x *GetScrubReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4479,66 +11459,40 @@ func (x *SaveBinaryDataInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *SaveBinaryDataInfoRequest) GetInfo() *BinaryDataInfo {
-	if x != nil {
-		return x.xxx_hidden_Info
-	}
-	return nil
-}
-
-func (x *SaveBinaryDataInfoRequest) SetInfo(v *BinaryDataInfo) {
-	x.xxx_hidden_Info = v
-}
-
-func (x *SaveBinaryDataInfoRequest) HasInfo() bool {
-	if x == nil {
-		return false
-	}
-	return x.xxx_hidden_Info != nil
-}
-
-func (x *SaveBinaryDataInfoRequest) ClearInfo() {
-	x.xxx_hidden_Info = nil
-}
-
-type SaveBinaryDataInfoRequest_builder struct {
+type GetScrubReportRequest_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Info *BinaryDataInfo
 }
 
-func (b0 SaveBinaryDataInfoRequest_builder) Build() *SaveBinaryDataInfoRequest {
-	m0 := &SaveBinaryDataInfoRequest{}
+func (b0 GetScrubReportRequest_builder) Build() *GetScrubReportRequest {
+	m0 := &GetScrubReportRequest{}
 	b, x := &b0, m0
 	_, _ = b, x
-	x.xxx_hidden_Info = b.Info
 	return m0
 }
 
-type SaveBinaryDataInfoResponse struct {
-	state                  protoimpl.MessageState `protogen:"opaque.v1"`
-	xxx_hidden_Id          *string                `protobuf:"bytes,1,opt,name=id"`
-	XXX_raceDetectHookData protoimpl.RaceDetectHookData
-	XXX_presence           [1]uint32
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type GetScrubReportResponse struct {
+	state             protoimpl.MessageState `protogen:"opaque.v1"`
+	xxx_hidden_Report *ScrubReport           `protobuf:"bytes,1,opt,name=report"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *SaveBinaryDataInfoResponse) Reset() {
-	*x = SaveBinaryDataInfoResponse{}
-	mi := &file_api_proto_msgTypes[49]
+func (x *GetScrubReportResponse) Reset() {
+	*x = GetScrubReportResponse{}
+	mi := &file_api_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SaveBinaryDataInfoResponse) String() string {
+func (x *GetScrubReportResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SaveBinaryDataInfoResponse) ProtoMessage() {}
+func (*GetScrubReportResponse) ProtoMessage() {}
 
-func (x *SaveBinaryDataInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[49]
+func (x *GetScrubReportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4549,47 +11503,39 @@ func (x *SaveBinaryDataInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-func (x *SaveBinaryDataInfoResponse) GetId() string {
+func (x *GetScrubReportResponse) GetReport() *ScrubReport {
 	if x != nil {
-		if x.xxx_hidden_Id != nil {
-			return *x.xxx_hidden_Id
-		}
-		return ""
+		return x.xxx_hidden_Report
 	}
-	return ""
+	return nil
 }
 
-func (x *SaveBinaryDataInfoResponse) SetId(v string) {
-	x.xxx_hidden_Id = &v
-	protoimpl.X.SetPresent(&(x.XXX_presence[0]), 0, 1)
+func (x *GetScrubReportResponse) SetReport(v *ScrubReport) {
+	x.xxx_hidden_Report = v
 }
 
-func (x *SaveBinaryDataInfoResponse) HasId() bool {
+func (x *GetScrubReportResponse) HasReport() bool {
 	if x == nil {
 		return false
 	}
-	return protoimpl.X.Present(&(x.XXX_presence[0]), 0)
+	return x.xxx_hidden_Report != nil
 }
 
-func (x *SaveBinaryDataInfoResponse) ClearId() {
-	protoimpl.X.ClearPresent(&(x.XXX_presence[0]), 0)
-	x.xxx_hidden_Id = nil
+func (x *GetScrubReportResponse) ClearReport() {
+	x.xxx_hidden_Report = nil
 }
 
-type SaveBinaryDataInfoResponse_builder struct {
+type GetScrubReportResponse_builder struct {
 	_ [0]func() // Prevents comparability and use of unkeyed literals for the builder.
 
-	Id *string
+	Report *ScrubReport
 }
 
-func (b0 SaveBinaryDataInfoResponse_builder) Build() *SaveBinaryDataInfoResponse {
-	m0 := &SaveBinaryDataInfoResponse{}
+func (b0 GetScrubReportResponse_builder) Build() *GetScrubReportResponse {
+	m0 := &GetScrubReportResponse{}
 	b, x := &b0, m0
 	_, _ = b, x
-	if b.Id != nil {
-		protoimpl.X.SetPresentNonAtomic(&(x.XXX_presence[0]), 0, 1)
-		x.xxx_hidden_Id = b.Id
-	}
+	x.xxx_hidden_Report = b.Report
 	return m0
 }
 
@@ -4597,7 +11543,7 @@ var File_api_proto protoreflect.FileDescriptor
 
 const file_api_proto_rawDesc = "" +
 	"\n" +
-	"\tapi.proto\x12\x10gophkeeper.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\x1a\x1bgoogle/protobuf/empty.proto\"C\n" +
+	"\tapi.proto\x12\x10gophkeeper.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a!google/protobuf/go_features.proto\"C\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05login\x18\x01 \x01(\tR\x05login\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\",\n" +
@@ -4608,7 +11554,19 @@ const file_api_proto_rawDesc = "" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"F\n" +
 	"\rLoginResponse\x12!\n" +
 	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\x12\x12\n" +
-	"\x04salt\x18\x02 \x01(\fR\x04salt\"\x8f\x02\n" +
+	"\x04salt\x18\x02 \x01(\fR\x04salt\"8\n" +
+	"\n" +
+	"ListFilter\x12\x16\n" +
+	"\x06folder\x18\x01 \x01(\tR\x06folder\x12\x12\n" +
+	"\x04tags\x18\x02 \x03(\tR\x04tags\"\x9f\x01\n" +
+	"\vPageRequest\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x02 \x01(\tR\tpageToken\x124\n" +
+	"\asort_by\x18\x03 \x01(\x0e2\x1b.gophkeeper.proto.SortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x04 \x01(\bR\n" +
+	"descending\"\xb7\x03\n" +
 	"\n" +
 	"Credential\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
@@ -4620,7 +11578,13 @@ const file_api_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"W\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06folder\x18\t \x01(\tR\x06folder\x12\x12\n" +
+	"\x04tags\x18\n" +
+	" \x03(\tR\x04tags\x12\x1a\n" +
+	"\bfavorite\x18\v \x01(\bR\bfavorite\x12D\n" +
+	"\x10last_accessed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12\x18\n" +
+	"\aversion\x18\r \x01(\x03R\aversion\"W\n" +
 	"\x17CreateCredentialRequest\x12<\n" +
 	"\n" +
 	"credential\x18\x01 \x01(\v2\x1c.gophkeeper.proto.CredentialR\n" +
@@ -4634,21 +11598,26 @@ const file_api_proto_rawDesc = "" +
 	"\x19GetCredentialByIDResponse\x12<\n" +
 	"\n" +
 	"credential\x18\x01 \x01(\v2\x1c.gophkeeper.proto.CredentialR\n" +
-	"credential\"X\n" +
+	"credential\"\x80\x01\n" +
+	"\x15GetCredentialsRequest\x124\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.gophkeeper.proto.ListFilterR\x06filter\x121\n" +
+	"\x04page\x18\x02 \x01(\v2\x1d.gophkeeper.proto.PageRequestR\x04page\"\x80\x01\n" +
 	"\x16GetCredentialsResponse\x12>\n" +
-	"\vcredentials\x18\x01 \x03(\v2\x1c.gophkeeper.proto.CredentialR\vcredentials\"W\n" +
+	"\vcredentials\x18\x01 \x03(\v2\x1c.gophkeeper.proto.CredentialR\vcredentials\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"W\n" +
 	"\x17UpdateCredentialRequest\x12<\n" +
 	"\n" +
 	"credential\x18\x01 \x01(\v2\x1c.gophkeeper.proto.CredentialR\n" +
-	"credential\"X\n" +
+	"credential\"r\n" +
 	"\x18UpdateCredentialResponse\x12<\n" +
 	"\n" +
 	"credential\x18\x01 \x01(\v2\x1c.gophkeeper.proto.CredentialR\n" +
-	"credential\")\n" +
+	"credential\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\")\n" +
 	"\x17DeleteCredentialRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
 	"\x18DeleteCredentialResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd8\x02\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x80\x04\n" +
 	"\bBankCard\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -4664,7 +11633,12 @@ const file_api_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"P\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06folder\x18\v \x01(\tR\x06folder\x12\x12\n" +
+	"\x04tags\x18\f \x03(\tR\x04tags\x12\x1a\n" +
+	"\bfavorite\x18\r \x01(\bR\bfavorite\x12D\n" +
+	"\x10last_accessed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12\x18\n" +
+	"\aversion\x18\x0f \x01(\x03R\aversion\"P\n" +
 	"\x15CreateBankCardRequest\x127\n" +
 	"\tbank_card\x18\x01 \x01(\v2\x1a.gophkeeper.proto.BankCardR\bbankCard\"Q\n" +
 	"\x16CreateBankCardResponse\x127\n" +
@@ -4672,18 +11646,23 @@ const file_api_proto_rawDesc = "" +
 	"\x16GetBankCardByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
 	"\x17GetBankCardByIDResponse\x127\n" +
-	"\tbank_card\x18\x01 \x01(\v2\x1a.gophkeeper.proto.BankCardR\bbankCard\"Q\n" +
+	"\tbank_card\x18\x01 \x01(\v2\x1a.gophkeeper.proto.BankCardR\bbankCard\"~\n" +
+	"\x13GetBankCardsRequest\x124\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.gophkeeper.proto.ListFilterR\x06filter\x121\n" +
+	"\x04page\x18\x02 \x01(\v2\x1d.gophkeeper.proto.PageRequestR\x04page\"y\n" +
 	"\x14GetBankCardsResponse\x129\n" +
 	"\n" +
-	"bank_cards\x18\x01 \x03(\v2\x1a.gophkeeper.proto.BankCardR\tbankCards\"P\n" +
+	"bank_cards\x18\x01 \x03(\v2\x1a.gophkeeper.proto.BankCardR\tbankCards\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"P\n" +
 	"\x15UpdateBankCardRequest\x127\n" +
-	"\tbank_card\x18\x01 \x01(\v2\x1a.gophkeeper.proto.BankCardR\bbankCard\"Q\n" +
+	"\tbank_card\x18\x01 \x01(\v2\x1a.gophkeeper.proto.BankCardR\bbankCard\"k\n" +
 	"\x16UpdateBankCardResponse\x127\n" +
-	"\tbank_card\x18\x01 \x01(\v2\x1a.gophkeeper.proto.BankCardR\bbankCard\"'\n" +
+	"\tbank_card\x18\x01 \x01(\v2\x1a.gophkeeper.proto.BankCardR\bbankCard\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"'\n" +
 	"\x15DeleteBankCardRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
 	"\x16DeleteBankCardResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf5\x01\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9d\x03\n" +
 	"\bTextData\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
@@ -4693,7 +11672,13 @@ const file_api_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"P\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06folder\x18\b \x01(\tR\x06folder\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1a\n" +
+	"\bfavorite\x18\n" +
+	" \x01(\bR\bfavorite\x12D\n" +
+	"\x10last_accessed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\"P\n" +
 	"\x15CreateTextDataRequest\x127\n" +
 	"\ttext_data\x18\x01 \x01(\v2\x1a.gophkeeper.proto.TextDataR\btextData\"Q\n" +
 	"\x16CreateTextDataResponse\x127\n" +
@@ -4701,31 +11686,45 @@ const file_api_proto_rawDesc = "" +
 	"\x16GetTextDataByIDRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"R\n" +
 	"\x17GetTextDataByIDResponse\x127\n" +
-	"\ttext_data\x18\x01 \x01(\v2\x1a.gophkeeper.proto.TextDataR\btextData\"3\n" +
+	"\ttext_data\x18\x01 \x01(\v2\x1a.gophkeeper.proto.TextDataR\btextData\"\x9c\x01\n" +
 	"\x18GetTextDataTitlesRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\tR\x06userId\"a\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x124\n" +
+	"\x06filter\x18\x02 \x01(\v2\x1c.gophkeeper.proto.ListFilterR\x06filter\x121\n" +
+	"\x04page\x18\x03 \x01(\v2\x1d.gophkeeper.proto.PageRequestR\x04page\"\x89\x01\n" +
 	"\x19GetTextDataTitlesResponse\x12D\n" +
-	"\x10text_data_titles\x18\x01 \x03(\v2\x1a.gophkeeper.proto.TextDataR\x0etextDataTitles\"P\n" +
+	"\x10text_data_titles\x18\x01 \x03(\v2\x1a.gophkeeper.proto.TextDataR\x0etextDataTitles\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"P\n" +
 	"\x15UpdateTextDataRequest\x127\n" +
-	"\ttext_data\x18\x01 \x01(\v2\x1a.gophkeeper.proto.TextDataR\btextData\"2\n" +
+	"\ttext_data\x18\x01 \x01(\v2\x1a.gophkeeper.proto.TextDataR\btextData\"L\n" +
 	"\x16UpdateTextDataResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"'\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"'\n" +
 	"\x15DeleteTextDataRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
 	"\x16DeleteTextDataResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"e\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x9a\x01\n" +
 	"\x17UploadBinaryDataRequest\x12\x14\n" +
 	"\x05chunk\x18\x01 \x01(\fR\x05chunk\x124\n" +
-	"\x04info\x18\x02 \x01(\v2 .gophkeeper.proto.BinaryDataInfoR\x04info\"*\n" +
+	"\x04info\x18\x02 \x01(\v2 .gophkeeper.proto.BinaryDataInfoR\x04info\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x03R\x06offset\"D\n" +
 	"\x18UploadBinaryDataResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"z\n" +
 	"\x19DownloadBinaryDataRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"2\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x03R\x06offset\x12\x16\n" +
+	"\x06length\x18\x03 \x01(\x03R\x06length\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x04 \x01(\tR\tversionId\"2\n" +
 	"\x1aDownloadBinaryDataResponse\x12\x14\n" +
-	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\x17\n" +
-	"\x15ListBinaryDataRequest\"P\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\x80\x01\n" +
+	"\x15ListBinaryDataRequest\x124\n" +
+	"\x06filter\x18\x01 \x01(\v2\x1c.gophkeeper.proto.ListFilterR\x06filter\x121\n" +
+	"\x04page\x18\x02 \x01(\v2\x1d.gophkeeper.proto.PageRequestR\x04page\"x\n" +
 	"\x16ListBinaryDataResponse\x126\n" +
-	"\x05items\x18\x01 \x03(\v2 .gophkeeper.proto.BinaryDataInfoR\x05items\"\xfd\x01\n" +
+	"\x05items\x18\x01 \x03(\v2 .gophkeeper.proto.BinaryDataInfoR\x05items\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\x9c\x04\n" +
 	"\x0eBinaryDataInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
@@ -4736,36 +11735,229 @@ const file_api_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\")\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06folder\x18\b \x01(\tR\x06folder\x12\x12\n" +
+	"\x04tags\x18\t \x03(\tR\x04tags\x12\x1a\n" +
+	"\bfavorite\x18\n" +
+	" \x01(\bR\bfavorite\x12D\n" +
+	"\x10last_accessed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\x12\x18\n" +
+	"\aversion\x18\f \x01(\x03R\aversion\x12\x18\n" +
+	"\achunked\x18\r \x01(\bR\achunked\x12\x1a\n" +
+	"\bchecksum\x18\x0e \x01(\tR\bchecksum\x12%\n" +
+	"\x0eplain_checksum\x18\x0f \x01(\tR\rplainChecksum\x12\x18\n" +
+	"\aarchive\x18\x10 \x01(\tR\aarchive\")\n" +
 	"\x17DeleteBinaryDataRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"\x1a\n" +
-	"\x18DeleteBinaryDataResponse\"*\n" +
+	"\x18DeleteBinaryDataResponse\"5\n" +
+	"\x16GetUploadStatusRequest\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\tR\buploadId\"1\n" +
+	"\x17GetUploadStatusResponse\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\".\n" +
+	"\bChunkRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\",\n" +
+	"\x18FindMissingChunksRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"-\n" +
+	"\x19FindMissingChunksResponse\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"8\n" +
+	"\x12UploadChunkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"\x15\n" +
+	"\x13UploadChunkResponse\"o\n" +
+	"\x1aCommitChunkedUploadRequest\x124\n" +
+	"\x04info\x18\x01 \x01(\v2 .gophkeeper.proto.BinaryDataInfoR\x04info\x12\x1b\n" +
+	"\tchunk_ids\x18\x02 \x03(\tR\bchunkIds\"G\n" +
+	"\x1bCommitChunkedUploadResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"C\n" +
+	"\x12GetManifestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\"c\n" +
+	"\x13GetManifestResponse\x12\x18\n" +
+	"\achunked\x18\x01 \x01(\bR\achunked\x122\n" +
+	"\x06chunks\x18\x02 \x03(\v2\x1a.gophkeeper.proto.ChunkRefR\x06chunks\"&\n" +
+	"\x14DownloadChunkRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"+\n" +
+	"\x15DownloadChunkResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\")\n" +
+	"\x17VerifyBinaryDataRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"H\n" +
+	"\x18VerifyBinaryDataResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x16\n" +
+	"\x06detail\x18\x02 \x01(\tR\x06detail\"\xc5\x02\n" +
+	"\x11BinaryVersionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12\x18\n" +
+	"\achunked\x18\x03 \x01(\bR\achunked\x12\x1a\n" +
+	"\bchecksum\x18\x04 \x01(\tR\bchecksum\x12%\n" +
+	"\x0eplain_checksum\x18\x05 \x01(\tR\rplainChecksum\x12\x18\n" +
+	"\aarchive\x18\x06 \x01(\tR\aarchive\x12\x1f\n" +
+	"\vclient_path\x18\a \x01(\tR\n" +
+	"clientPath\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"+\n" +
+	"\x19ListBinaryVersionsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"]\n" +
+	"\x1aListBinaryVersionsResponse\x12?\n" +
+	"\bversions\x18\x01 \x03(\v2#.gophkeeper.proto.BinaryVersionInfoR\bversions\"f\n" +
+	"\x1bRestoreBinaryVersionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"version_id\x18\x02 \x01(\tR\tversionId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"8\n" +
+	"\x1cRestoreBinaryVersionResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"*\n" +
 	"\x18GetBinaryDataInfoRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\"^\n" +
 	"\x19GetBinaryDataInfoResponse\x12A\n" +
 	"\vbinary_info\x18\x01 \x01(\v2 .gophkeeper.proto.BinaryDataInfoR\n" +
 	"binaryInfo\"O\n" +
 	"\x17UpdateBinaryDataRequest\x124\n" +
-	"\x04info\x18\x02 \x01(\v2 .gophkeeper.proto.BinaryDataInfoR\x04info\"*\n" +
+	"\x04info\x18\x02 \x01(\v2 .gophkeeper.proto.BinaryDataInfoR\x04info\"D\n" +
 	"\x18UpdateBinaryDataResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"Q\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"Q\n" +
 	"\x19SaveBinaryDataInfoRequest\x124\n" +
 	"\x04info\x18\x01 \x01(\v2 .gophkeeper.proto.BinaryDataInfoR\x04info\",\n" +
 	"\x1aSaveBinaryDataInfoResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id2\xaa\x01\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\xc5\x01\n" +
+	"\vItemSummary\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.gophkeeper.proto.ItemTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
+	"\bfavorite\x18\x04 \x01(\bR\bfavorite\x12D\n" +
+	"\x10last_accessed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastAccessedAt\"p\n" +
+	"\x12SetFavoriteRequest\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.gophkeeper.proto.ItemTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12\x1a\n" +
+	"\bfavorite\x18\x03 \x01(\bR\bfavorite\"\x15\n" +
+	"\x13SetFavoriteResponse\"U\n" +
+	"\x13MarkAccessedRequest\x12.\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1a.gophkeeper.proto.ItemTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x16\n" +
+	"\x14MarkAccessedResponse\"2\n" +
+	"\x1aListFavoritesRecentRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"R\n" +
+	"\x1bListFavoritesRecentResponse\x123\n" +
+	"\x05items\x18\x01 \x03(\v2\x1d.gophkeeper.proto.ItemSummaryR\x05items\"\x11\n" +
+	"\x0fGetUsageRequest\"x\n" +
+	"\x10GetUsageResponse\x12\x14\n" +
+	"\x05bytes\x18\x01 \x01(\x03R\x05bytes\x12\x14\n" +
+	"\x05items\x18\x02 \x01(\x03R\x05items\x12\x1b\n" +
+	"\tmax_bytes\x18\x03 \x01(\x03R\bmaxBytes\x12\x1b\n" +
+	"\tmax_items\x18\x04 \x01(\x03R\bmaxItems\"\xb2\x03\n" +
+	"\x06Change\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.gophkeeper.proto.ItemTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\bR\adeleted\x129\n" +
+	"\n" +
+	"changed_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\x12>\n" +
+	"\n" +
+	"credential\x18\x06 \x01(\v2\x1c.gophkeeper.proto.CredentialH\x00R\n" +
+	"credential\x129\n" +
+	"\tbank_card\x18\a \x01(\v2\x1a.gophkeeper.proto.BankCardH\x00R\bbankCard\x129\n" +
+	"\ttext_data\x18\b \x01(\v2\x1a.gophkeeper.proto.TextDataH\x00R\btextData\x12C\n" +
+	"\vbinary_data\x18\t \x01(\v2 .gophkeeper.proto.BinaryDataInfoH\x00R\n" +
+	"binaryDataB\x06\n" +
+	"\x04item\"B\n" +
+	"\x12ListChangesRequest\x12\x16\n" +
+	"\x06cursor\x18\x01 \x01(\x03R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"|\n" +
+	"\x13ListChangesResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.gophkeeper.proto.ChangeR\achanges\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\x03R\x06cursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\x15\n" +
+	"\x13WatchChangesRequest\"y\n" +
+	"\vChangeEvent\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.gophkeeper.proto.ItemTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12\x18\n" +
+	"\adeleted\x18\x04 \x01(\bR\adeleted\"\xc8\x02\n" +
+	"\x0eBatchOperation\x128\n" +
+	"\x04kind\x18\x01 \x01(\x0e2$.gophkeeper.proto.BatchOperationKindR\x04kind\x12.\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x1a.gophkeeper.proto.ItemTypeR\x04type\x12\x0e\n" +
+	"\x02id\x18\x03 \x01(\tR\x02id\x12>\n" +
+	"\n" +
+	"credential\x18\x04 \x01(\v2\x1c.gophkeeper.proto.CredentialH\x00R\n" +
+	"credential\x129\n" +
+	"\tbank_card\x18\x05 \x01(\v2\x1a.gophkeeper.proto.BankCardH\x00R\bbankCard\x129\n" +
+	"\ttext_data\x18\x06 \x01(\v2\x1a.gophkeeper.proto.TextDataH\x00R\btextDataB\x06\n" +
+	"\x04item\"a\n" +
+	"\vBatchResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\x05R\x04code\x12\x14\n" +
+	"\x05error\x18\x04 \x01(\tR\x05error\"n\n" +
+	"\x12BatchMutateRequest\x12@\n" +
+	"\n" +
+	"operations\x18\x01 \x03(\v2 .gophkeeper.proto.BatchOperationR\n" +
+	"operations\x12\x16\n" +
+	"\x06atomic\x18\x02 \x01(\bR\x06atomic\"N\n" +
+	"\x13BatchMutateResponse\x127\n" +
+	"\aresults\x18\x01 \x03(\v2\x1d.gophkeeper.proto.BatchResultR\aresults\"m\n" +
+	"\tStoredRef\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\x12!\n" +
+	"\fstorage_path\x18\x03 \x01(\tR\vstoragePath\x12\x14\n" +
+	"\x05chunk\x18\x04 \x01(\bR\x05chunk\"\xbd\x04\n" +
+	"\vScrubReport\x129\n" +
+	"\n" +
+	"started_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12\x10\n" +
+	"\x03fix\x18\x03 \x01(\bR\x03fix\x12\x16\n" +
+	"\x06rehash\x18\x04 \x01(\bR\x06rehash\x12#\n" +
+	"\rfiles_scanned\x18\x05 \x01(\x03R\ffilesScanned\x12'\n" +
+	"\x0frecords_scanned\x18\x06 \x01(\x03R\x0erecordsScanned\x12%\n" +
+	"\x0efiles_rehashed\x18\a \x01(\x03R\rfilesRehashed\x12!\n" +
+	"\forphan_files\x18\b \x03(\tR\vorphanFiles\x12@\n" +
+	"\rmissing_files\x18\t \x03(\v2\x1b.gophkeeper.proto.StoredRefR\fmissingFiles\x12L\n" +
+	"\x13checksum_mismatches\x18\n" +
+	" \x03(\v2\x1b.gophkeeper.proto.StoredRefR\x12checksumMismatches\x12#\n" +
+	"\rremoved_files\x18\v \x01(\x03R\fremovedFiles\x12'\n" +
+	"\x0fremoved_records\x18\f \x01(\x03R\x0eremovedRecords\x12\x16\n" +
+	"\x06errors\x18\r \x03(\tR\x06errors\";\n" +
+	"\x0fRunScrubRequest\x12\x10\n" +
+	"\x03fix\x18\x01 \x01(\bR\x03fix\x12\x16\n" +
+	"\x06rehash\x18\x02 \x01(\bR\x06rehash\"I\n" +
+	"\x10RunScrubResponse\x125\n" +
+	"\x06report\x18\x01 \x01(\v2\x1d.gophkeeper.proto.ScrubReportR\x06report\"\x17\n" +
+	"\x15GetScrubReportRequest\"O\n" +
+	"\x16GetScrubReportResponse\x125\n" +
+	"\x06report\x18\x01 \x01(\v2\x1d.gophkeeper.proto.ScrubReportR\x06report*m\n" +
+	"\tSortField\x12\x1a\n" +
+	"\x16SORT_FIELD_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12SORT_FIELD_CREATED\x10\x01\x12\x16\n" +
+	"\x12SORT_FIELD_UPDATED\x10\x02\x12\x14\n" +
+	"\x10SORT_FIELD_TITLE\x10\x03*\x8c\x01\n" +
+	"\bItemType\x12\x19\n" +
+	"\x15ITEM_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14ITEM_TYPE_CREDENTIAL\x10\x01\x12\x17\n" +
+	"\x13ITEM_TYPE_BANK_CARD\x10\x02\x12\x17\n" +
+	"\x13ITEM_TYPE_TEXT_DATA\x10\x03\x12\x19\n" +
+	"\x15ITEM_TYPE_BINARY_DATA\x10\x04*\x9d\x01\n" +
+	"\x12BatchOperationKind\x12$\n" +
+	" BATCH_OPERATION_KIND_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bBATCH_OPERATION_KIND_CREATE\x10\x01\x12\x1f\n" +
+	"\x1bBATCH_OPERATION_KIND_UPDATE\x10\x02\x12\x1f\n" +
+	"\x1bBATCH_OPERATION_KIND_DELETE\x10\x032\xaa\x01\n" +
 	"\vAuthService\x12Q\n" +
 	"\bRegister\x12!.gophkeeper.proto.RegisterRequest\x1a\".gophkeeper.proto.RegisterResponse\x12H\n" +
-	"\x05Login\x12\x1e.gophkeeper.proto.LoginRequest\x1a\x1f.gophkeeper.proto.LoginResponse2\x96\x04\n" +
+	"\x05Login\x12\x1e.gophkeeper.proto.LoginRequest\x1a\x1f.gophkeeper.proto.LoginResponse2\xa7\x04\n" +
 	"\x11CredentialService\x12i\n" +
 	"\x10CreateCredential\x12).gophkeeper.proto.CreateCredentialRequest\x1a*.gophkeeper.proto.CreateCredentialResponse\x12l\n" +
-	"\x11GetCredentialByID\x12*.gophkeeper.proto.GetCredentialByIDRequest\x1a+.gophkeeper.proto.GetCredentialByIDResponse\x12R\n" +
-	"\x0eGetCredentials\x12\x16.google.protobuf.Empty\x1a(.gophkeeper.proto.GetCredentialsResponse\x12i\n" +
+	"\x11GetCredentialByID\x12*.gophkeeper.proto.GetCredentialByIDRequest\x1a+.gophkeeper.proto.GetCredentialByIDResponse\x12c\n" +
+	"\x0eGetCredentials\x12'.gophkeeper.proto.GetCredentialsRequest\x1a(.gophkeeper.proto.GetCredentialsResponse\x12i\n" +
 	"\x10UpdateCredential\x12).gophkeeper.proto.UpdateCredentialRequest\x1a*.gophkeeper.proto.UpdateCredentialResponse\x12i\n" +
-	"\x10DeleteCredential\x12).gophkeeper.proto.DeleteCredentialRequest\x1a*.gophkeeper.proto.DeleteCredentialResponse2\xf8\x03\n" +
+	"\x10DeleteCredential\x12).gophkeeper.proto.DeleteCredentialRequest\x1a*.gophkeeper.proto.DeleteCredentialResponse2\x87\x04\n" +
 	"\x0fBankCardService\x12c\n" +
 	"\x0eCreateBankCard\x12'.gophkeeper.proto.CreateBankCardRequest\x1a(.gophkeeper.proto.CreateBankCardResponse\x12f\n" +
-	"\x0fGetBankCardByID\x12(.gophkeeper.proto.GetBankCardByIDRequest\x1a).gophkeeper.proto.GetBankCardByIDResponse\x12N\n" +
-	"\fGetBankCards\x12\x16.google.protobuf.Empty\x1a&.gophkeeper.proto.GetBankCardsResponse\x12c\n" +
+	"\x0fGetBankCardByID\x12(.gophkeeper.proto.GetBankCardByIDRequest\x1a).gophkeeper.proto.GetBankCardByIDResponse\x12]\n" +
+	"\fGetBankCards\x12%.gophkeeper.proto.GetBankCardsRequest\x1a&.gophkeeper.proto.GetBankCardsResponse\x12c\n" +
 	"\x0eUpdateBankCard\x12'.gophkeeper.proto.UpdateBankCardRequest\x1a(.gophkeeper.proto.UpdateBankCardResponse\x12c\n" +
 	"\x0eDeleteBankCard\x12'.gophkeeper.proto.DeleteBankCardRequest\x1a(.gophkeeper.proto.DeleteBankCardResponse2\x96\x04\n" +
 	"\x0fTextDataService\x12c\n" +
@@ -4773,155 +11965,308 @@ const file_api_proto_rawDesc = "" +
 	"\x0fGetTextDataByID\x12(.gophkeeper.proto.GetTextDataByIDRequest\x1a).gophkeeper.proto.GetTextDataByIDResponse\x12l\n" +
 	"\x11GetTextDataTitles\x12*.gophkeeper.proto.GetTextDataTitlesRequest\x1a+.gophkeeper.proto.GetTextDataTitlesResponse\x12c\n" +
 	"\x0eUpdateTextData\x12'.gophkeeper.proto.UpdateTextDataRequest\x1a(.gophkeeper.proto.UpdateTextDataResponse\x12c\n" +
-	"\x0eDeleteTextData\x12'.gophkeeper.proto.DeleteTextDataRequest\x1a(.gophkeeper.proto.DeleteTextDataResponse2\x91\x06\n" +
+	"\x0eDeleteTextData\x12'.gophkeeper.proto.DeleteTextDataRequest\x1a(.gophkeeper.proto.DeleteTextDataResponse2\xc8\r\n" +
 	"\x11BinaryDataService\x12o\n" +
 	"\x12SaveBinaryDataInfo\x12+.gophkeeper.proto.SaveBinaryDataInfoRequest\x1a,.gophkeeper.proto.SaveBinaryDataInfoResponse\x12l\n" +
 	"\x11GetBinaryDataInfo\x12*.gophkeeper.proto.GetBinaryDataInfoRequest\x1a+.gophkeeper.proto.GetBinaryDataInfoResponse\x12c\n" +
 	"\x0eListBinaryData\x12'.gophkeeper.proto.ListBinaryDataRequest\x1a(.gophkeeper.proto.ListBinaryDataResponse\x12m\n" +
 	"\x14UpdateBinaryDataInfo\x12).gophkeeper.proto.UpdateBinaryDataRequest\x1a*.gophkeeper.proto.UpdateBinaryDataResponse\x12i\n" +
 	"\x10DeleteBinaryData\x12).gophkeeper.proto.DeleteBinaryDataRequest\x1a*.gophkeeper.proto.DeleteBinaryDataResponse\x12k\n" +
-	"\x10UploadBinaryData\x12).gophkeeper.proto.UploadBinaryDataRequest\x1a*.gophkeeper.proto.UploadBinaryDataResponse(\x01\x12q\n" +
-	"\x12DownloadBinaryData\x12+.gophkeeper.proto.DownloadBinaryDataRequest\x1a,.gophkeeper.proto.DownloadBinaryDataResponse0\x01B<Z2github.com/ryabkov82/gophkeeper/internal/pkg/proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
-
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+	"\x10UploadBinaryData\x12).gophkeeper.proto.UploadBinaryDataRequest\x1a*.gophkeeper.proto.UploadBinaryDataResponse(\x01\x12f\n" +
+	"\x0fGetUploadStatus\x12(.gophkeeper.proto.GetUploadStatusRequest\x1a).gophkeeper.proto.GetUploadStatusResponse\x12q\n" +
+	"\x12DownloadBinaryData\x12+.gophkeeper.proto.DownloadBinaryDataRequest\x1a,.gophkeeper.proto.DownloadBinaryDataResponse0\x01\x12l\n" +
+	"\x11FindMissingChunks\x12*.gophkeeper.proto.FindMissingChunksRequest\x1a+.gophkeeper.proto.FindMissingChunksResponse\x12Z\n" +
+	"\vUploadChunk\x12$.gophkeeper.proto.UploadChunkRequest\x1a%.gophkeeper.proto.UploadChunkResponse\x12r\n" +
+	"\x13CommitChunkedUpload\x12,.gophkeeper.proto.CommitChunkedUploadRequest\x1a-.gophkeeper.proto.CommitChunkedUploadResponse\x12Z\n" +
+	"\vGetManifest\x12$.gophkeeper.proto.GetManifestRequest\x1a%.gophkeeper.proto.GetManifestResponse\x12`\n" +
+	"\rDownloadChunk\x12&.gophkeeper.proto.DownloadChunkRequest\x1a'.gophkeeper.proto.DownloadChunkResponse\x12i\n" +
+	"\x10VerifyBinaryData\x12).gophkeeper.proto.VerifyBinaryDataRequest\x1a*.gophkeeper.proto.VerifyBinaryDataResponse\x12o\n" +
+	"\x12ListBinaryVersions\x12+.gophkeeper.proto.ListBinaryVersionsRequest\x1a,.gophkeeper.proto.ListBinaryVersionsResponse\x12u\n" +
+	"\x14RestoreBinaryVersion\x12-.gophkeeper.proto.RestoreBinaryVersionRequest\x1a..gophkeeper.proto.RestoreBinaryVersionResponse2\x8f\x03\n" +
+	"\vItemService\x12Z\n" +
+	"\vSetFavorite\x12$.gophkeeper.proto.SetFavoriteRequest\x1a%.gophkeeper.proto.SetFavoriteResponse\x12]\n" +
+	"\fMarkAccessed\x12%.gophkeeper.proto.MarkAccessedRequest\x1a&.gophkeeper.proto.MarkAccessedResponse\x12r\n" +
+	"\x13ListFavoritesRecent\x12,.gophkeeper.proto.ListFavoritesRecentRequest\x1a-.gophkeeper.proto.ListFavoritesRecentResponse\x12Q\n" +
+	"\bGetUsage\x12!.gophkeeper.proto.GetUsageRequest\x1a\".gophkeeper.proto.GetUsageResponse2\xc1\x01\n" +
+	"\vSyncService\x12Z\n" +
+	"\vListChanges\x12$.gophkeeper.proto.ListChangesRequest\x1a%.gophkeeper.proto.ListChangesResponse\x12V\n" +
+	"\fWatchChanges\x12%.gophkeeper.proto.WatchChangesRequest\x1a\x1d.gophkeeper.proto.ChangeEvent0\x012j\n" +
+	"\fBatchService\x12Z\n" +
+	"\vBatchMutate\x12$.gophkeeper.proto.BatchMutateRequest\x1a%.gophkeeper.proto.BatchMutateResponse2\xc6\x01\n" +
+	"\fAdminService\x12Q\n" +
+	"\bRunScrub\x12!.gophkeeper.proto.RunScrubRequest\x1a\".gophkeeper.proto.RunScrubResponse\x12c\n" +
+	"\x0eGetScrubReport\x12'.gophkeeper.proto.GetScrubReportRequest\x1a(.gophkeeper.proto.GetScrubReportResponseB<Z2github.com/ryabkov82/gophkeeper/internal/pkg/proto\x92\x03\x05\xd2>\x02\x10\x03b\beditionsp\xe8\a"
+
+var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
 var file_api_proto_goTypes = []any{
-	(*RegisterRequest)(nil),            // 0: gophkeeper.proto.RegisterRequest
-	(*RegisterResponse)(nil),           // 1: gophkeeper.proto.RegisterResponse
-	(*LoginRequest)(nil),               // 2: gophkeeper.proto.LoginRequest
-	(*LoginResponse)(nil),              // 3: gophkeeper.proto.LoginResponse
-	(*Credential)(nil),                 // 4: gophkeeper.proto.Credential
-	(*CreateCredentialRequest)(nil),    // 5: gophkeeper.proto.CreateCredentialRequest
-	(*CreateCredentialResponse)(nil),   // 6: gophkeeper.proto.CreateCredentialResponse
-	(*GetCredentialByIDRequest)(nil),   // 7: gophkeeper.proto.GetCredentialByIDRequest
-	(*GetCredentialByIDResponse)(nil),  // 8: gophkeeper.proto.GetCredentialByIDResponse
-	(*GetCredentialsResponse)(nil),     // 9: gophkeeper.proto.GetCredentialsResponse
-	(*UpdateCredentialRequest)(nil),    // 10: gophkeeper.proto.UpdateCredentialRequest
-	(*UpdateCredentialResponse)(nil),   // 11: gophkeeper.proto.UpdateCredentialResponse
-	(*DeleteCredentialRequest)(nil),    // 12: gophkeeper.proto.DeleteCredentialRequest
-	(*DeleteCredentialResponse)(nil),   // 13: gophkeeper.proto.DeleteCredentialResponse
-	(*BankCard)(nil),                   // 14: gophkeeper.proto.BankCard
-	(*CreateBankCardRequest)(nil),      // 15: gophkeeper.proto.CreateBankCardRequest
-	(*CreateBankCardResponse)(nil),     // 16: gophkeeper.proto.CreateBankCardResponse
-	(*GetBankCardByIDRequest)(nil),     // 17: gophkeeper.proto.GetBankCardByIDRequest
-	(*GetBankCardByIDResponse)(nil),    // 18: gophkeeper.proto.GetBankCardByIDResponse
-	(*GetBankCardsResponse)(nil),       // 19: gophkeeper.proto.GetBankCardsResponse
-	(*UpdateBankCardRequest)(nil),      // 20: gophkeeper.proto.UpdateBankCardRequest
-	(*UpdateBankCardResponse)(nil),     // 21: gophkeeper.proto.UpdateBankCardResponse
-	(*DeleteBankCardRequest)(nil),      // 22: gophkeeper.proto.DeleteBankCardRequest
-	(*DeleteBankCardResponse)(nil),     // 23: gophkeeper.proto.DeleteBankCardResponse
-	(*TextData)(nil),                   // 24: gophkeeper.proto.TextData
-	(*CreateTextDataRequest)(nil),      // 25: gophkeeper.proto.CreateTextDataRequest
-	(*CreateTextDataResponse)(nil),     // 26: gophkeeper.proto.CreateTextDataResponse
-	(*GetTextDataByIDRequest)(nil),     // 27: gophkeeper.proto.GetTextDataByIDRequest
-	(*GetTextDataByIDResponse)(nil),    // 28: gophkeeper.proto.GetTextDataByIDResponse
-	(*GetTextDataTitlesRequest)(nil),   // 29: gophkeeper.proto.GetTextDataTitlesRequest
-	(*GetTextDataTitlesResponse)(nil),  // 30: gophkeeper.proto.GetTextDataTitlesResponse
-	(*UpdateTextDataRequest)(nil),      // 31: gophkeeper.proto.UpdateTextDataRequest
-	(*UpdateTextDataResponse)(nil),     // 32: gophkeeper.proto.UpdateTextDataResponse
-	(*DeleteTextDataRequest)(nil),      // 33: gophkeeper.proto.DeleteTextDataRequest
-	(*DeleteTextDataResponse)(nil),     // 34: gophkeeper.proto.DeleteTextDataResponse
-	(*UploadBinaryDataRequest)(nil),    // 35: gophkeeper.proto.UploadBinaryDataRequest
-	(*UploadBinaryDataResponse)(nil),   // 36: gophkeeper.proto.UploadBinaryDataResponse
-	(*DownloadBinaryDataRequest)(nil),  // 37: gophkeeper.proto.DownloadBinaryDataRequest
-	(*DownloadBinaryDataResponse)(nil), // 38: gophkeeper.proto.DownloadBinaryDataResponse
-	(*ListBinaryDataRequest)(nil),      // 39: gophkeeper.proto.ListBinaryDataRequest
-	(*ListBinaryDataResponse)(nil),     // 40: gophkeeper.proto.ListBinaryDataResponse
-	(*BinaryDataInfo)(nil),             // 41: gophkeeper.proto.BinaryDataInfo
-	(*DeleteBinaryDataRequest)(nil),    // 42: gophkeeper.proto.DeleteBinaryDataRequest
-	(*DeleteBinaryDataResponse)(nil),   // 43: gophkeeper.proto.DeleteBinaryDataResponse
-	(*GetBinaryDataInfoRequest)(nil),   // 44: gophkeeper.proto.GetBinaryDataInfoRequest
-	(*GetBinaryDataInfoResponse)(nil),  // 45: gophkeeper.proto.GetBinaryDataInfoResponse
-	(*UpdateBinaryDataRequest)(nil),    // 46: gophkeeper.proto.UpdateBinaryDataRequest
-	(*UpdateBinaryDataResponse)(nil),   // 47: gophkeeper.proto.UpdateBinaryDataResponse
-	(*SaveBinaryDataInfoRequest)(nil),  // 48: gophkeeper.proto.SaveBinaryDataInfoRequest
-	(*SaveBinaryDataInfoResponse)(nil), // 49: gophkeeper.proto.SaveBinaryDataInfoResponse
-	(*timestamppb.Timestamp)(nil),      // 50: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),              // 51: google.protobuf.Empty
+	(SortField)(0),                       // 0: gophkeeper.proto.SortField
+	(ItemType)(0),                        // 1: gophkeeper.proto.ItemType
+	(BatchOperationKind)(0),              // 2: gophkeeper.proto.BatchOperationKind
+	(*RegisterRequest)(nil),              // 3: gophkeeper.proto.RegisterRequest
+	(*RegisterResponse)(nil),             // 4: gophkeeper.proto.RegisterResponse
+	(*LoginRequest)(nil),                 // 5: gophkeeper.proto.LoginRequest
+	(*LoginResponse)(nil),                // 6: gophkeeper.proto.LoginResponse
+	(*ListFilter)(nil),                   // 7: gophkeeper.proto.ListFilter
+	(*PageRequest)(nil),                  // 8: gophkeeper.proto.PageRequest
+	(*Credential)(nil),                   // 9: gophkeeper.proto.Credential
+	(*CreateCredentialRequest)(nil),      // 10: gophkeeper.proto.CreateCredentialRequest
+	(*CreateCredentialResponse)(nil),     // 11: gophkeeper.proto.CreateCredentialResponse
+	(*GetCredentialByIDRequest)(nil),     // 12: gophkeeper.proto.GetCredentialByIDRequest
+	(*GetCredentialByIDResponse)(nil),    // 13: gophkeeper.proto.GetCredentialByIDResponse
+	(*GetCredentialsRequest)(nil),        // 14: gophkeeper.proto.GetCredentialsRequest
+	(*GetCredentialsResponse)(nil),       // 15: gophkeeper.proto.GetCredentialsResponse
+	(*UpdateCredentialRequest)(nil),      // 16: gophkeeper.proto.UpdateCredentialRequest
+	(*UpdateCredentialResponse)(nil),     // 17: gophkeeper.proto.UpdateCredentialResponse
+	(*DeleteCredentialRequest)(nil),      // 18: gophkeeper.proto.DeleteCredentialRequest
+	(*DeleteCredentialResponse)(nil),     // 19: gophkeeper.proto.DeleteCredentialResponse
+	(*BankCard)(nil),                     // 20: gophkeeper.proto.BankCard
+	(*CreateBankCardRequest)(nil),        // 21: gophkeeper.proto.CreateBankCardRequest
+	(*CreateBankCardResponse)(nil),       // 22: gophkeeper.proto.CreateBankCardResponse
+	(*GetBankCardByIDRequest)(nil),       // 23: gophkeeper.proto.GetBankCardByIDRequest
+	(*GetBankCardByIDResponse)(nil),      // 24: gophkeeper.proto.GetBankCardByIDResponse
+	(*GetBankCardsRequest)(nil),          // 25: gophkeeper.proto.GetBankCardsRequest
+	(*GetBankCardsResponse)(nil),         // 26: gophkeeper.proto.GetBankCardsResponse
+	(*UpdateBankCardRequest)(nil),        // 27: gophkeeper.proto.UpdateBankCardRequest
+	(*UpdateBankCardResponse)(nil),       // 28: gophkeeper.proto.UpdateBankCardResponse
+	(*DeleteBankCardRequest)(nil),        // 29: gophkeeper.proto.DeleteBankCardRequest
+	(*DeleteBankCardResponse)(nil),       // 30: gophkeeper.proto.DeleteBankCardResponse
+	(*TextData)(nil),                     // 31: gophkeeper.proto.TextData
+	(*CreateTextDataRequest)(nil),        // 32: gophkeeper.proto.CreateTextDataRequest
+	(*CreateTextDataResponse)(nil),       // 33: gophkeeper.proto.CreateTextDataResponse
+	(*GetTextDataByIDRequest)(nil),       // 34: gophkeeper.proto.GetTextDataByIDRequest
+	(*GetTextDataByIDResponse)(nil),      // 35: gophkeeper.proto.GetTextDataByIDResponse
+	(*GetTextDataTitlesRequest)(nil),     // 36: gophkeeper.proto.GetTextDataTitlesRequest
+	(*GetTextDataTitlesResponse)(nil),    // 37: gophkeeper.proto.GetTextDataTitlesResponse
+	(*UpdateTextDataRequest)(nil),        // 38: gophkeeper.proto.UpdateTextDataRequest
+	(*UpdateTextDataResponse)(nil),       // 39: gophkeeper.proto.UpdateTextDataResponse
+	(*DeleteTextDataRequest)(nil),        // 40: gophkeeper.proto.DeleteTextDataRequest
+	(*DeleteTextDataResponse)(nil),       // 41: gophkeeper.proto.DeleteTextDataResponse
+	(*UploadBinaryDataRequest)(nil),      // 42: gophkeeper.proto.UploadBinaryDataRequest
+	(*UploadBinaryDataResponse)(nil),     // 43: gophkeeper.proto.UploadBinaryDataResponse
+	(*DownloadBinaryDataRequest)(nil),    // 44: gophkeeper.proto.DownloadBinaryDataRequest
+	(*DownloadBinaryDataResponse)(nil),   // 45: gophkeeper.proto.DownloadBinaryDataResponse
+	(*ListBinaryDataRequest)(nil),        // 46: gophkeeper.proto.ListBinaryDataRequest
+	(*ListBinaryDataResponse)(nil),       // 47: gophkeeper.proto.ListBinaryDataResponse
+	(*BinaryDataInfo)(nil),               // 48: gophkeeper.proto.BinaryDataInfo
+	(*DeleteBinaryDataRequest)(nil),      // 49: gophkeeper.proto.DeleteBinaryDataRequest
+	(*DeleteBinaryDataResponse)(nil),     // 50: gophkeeper.proto.DeleteBinaryDataResponse
+	(*GetUploadStatusRequest)(nil),       // 51: gophkeeper.proto.GetUploadStatusRequest
+	(*GetUploadStatusResponse)(nil),      // 52: gophkeeper.proto.GetUploadStatusResponse
+	(*ChunkRef)(nil),                     // 53: gophkeeper.proto.ChunkRef
+	(*FindMissingChunksRequest)(nil),     // 54: gophkeeper.proto.FindMissingChunksRequest
+	(*FindMissingChunksResponse)(nil),    // 55: gophkeeper.proto.FindMissingChunksResponse
+	(*UploadChunkRequest)(nil),           // 56: gophkeeper.proto.UploadChunkRequest
+	(*UploadChunkResponse)(nil),          // 57: gophkeeper.proto.UploadChunkResponse
+	(*CommitChunkedUploadRequest)(nil),   // 58: gophkeeper.proto.CommitChunkedUploadRequest
+	(*CommitChunkedUploadResponse)(nil),  // 59: gophkeeper.proto.CommitChunkedUploadResponse
+	(*GetManifestRequest)(nil),           // 60: gophkeeper.proto.GetManifestRequest
+	(*GetManifestResponse)(nil),          // 61: gophkeeper.proto.GetManifestResponse
+	(*DownloadChunkRequest)(nil),         // 62: gophkeeper.proto.DownloadChunkRequest
+	(*DownloadChunkResponse)(nil),        // 63: gophkeeper.proto.DownloadChunkResponse
+	(*VerifyBinaryDataRequest)(nil),      // 64: gophkeeper.proto.VerifyBinaryDataRequest
+	(*VerifyBinaryDataResponse)(nil),     // 65: gophkeeper.proto.VerifyBinaryDataResponse
+	(*BinaryVersionInfo)(nil),            // 66: gophkeeper.proto.BinaryVersionInfo
+	(*ListBinaryVersionsRequest)(nil),    // 67: gophkeeper.proto.ListBinaryVersionsRequest
+	(*ListBinaryVersionsResponse)(nil),   // 68: gophkeeper.proto.ListBinaryVersionsResponse
+	(*RestoreBinaryVersionRequest)(nil),  // 69: gophkeeper.proto.RestoreBinaryVersionRequest
+	(*RestoreBinaryVersionResponse)(nil), // 70: gophkeeper.proto.RestoreBinaryVersionResponse
+	(*GetBinaryDataInfoRequest)(nil),     // 71: gophkeeper.proto.GetBinaryDataInfoRequest
+	(*GetBinaryDataInfoResponse)(nil),    // 72: gophkeeper.proto.GetBinaryDataInfoResponse
+	(*UpdateBinaryDataRequest)(nil),      // 73: gophkeeper.proto.UpdateBinaryDataRequest
+	(*UpdateBinaryDataResponse)(nil),     // 74: gophkeeper.proto.UpdateBinaryDataResponse
+	(*SaveBinaryDataInfoRequest)(nil),    // 75: gophkeeper.proto.SaveBinaryDataInfoRequest
+	(*SaveBinaryDataInfoResponse)(nil),   // 76: gophkeeper.proto.SaveBinaryDataInfoResponse
+	(*ItemSummary)(nil),                  // 77: gophkeeper.proto.ItemSummary
+	(*SetFavoriteRequest)(nil),           // 78: gophkeeper.proto.SetFavoriteRequest
+	(*SetFavoriteResponse)(nil),          // 79: gophkeeper.proto.SetFavoriteResponse
+	(*MarkAccessedRequest)(nil),          // 80: gophkeeper.proto.MarkAccessedRequest
+	(*MarkAccessedResponse)(nil),         // 81: gophkeeper.proto.MarkAccessedResponse
+	(*ListFavoritesRecentRequest)(nil),   // 82: gophkeeper.proto.ListFavoritesRecentRequest
+	(*ListFavoritesRecentResponse)(nil),  // 83: gophkeeper.proto.ListFavoritesRecentResponse
+	(*GetUsageRequest)(nil),              // 84: gophkeeper.proto.GetUsageRequest
+	(*GetUsageResponse)(nil),             // 85: gophkeeper.proto.GetUsageResponse
+	(*Change)(nil),                       // 86: gophkeeper.proto.Change
+	(*ListChangesRequest)(nil),           // 87: gophkeeper.proto.ListChangesRequest
+	(*ListChangesResponse)(nil),          // 88: gophkeeper.proto.ListChangesResponse
+	(*WatchChangesRequest)(nil),          // 89: gophkeeper.proto.WatchChangesRequest
+	(*ChangeEvent)(nil),                  // 90: gophkeeper.proto.ChangeEvent
+	(*BatchOperation)(nil),               // 91: gophkeeper.proto.BatchOperation
+	(*BatchResult)(nil),                  // 92: gophkeeper.proto.BatchResult
+	(*BatchMutateRequest)(nil),           // 93: gophkeeper.proto.BatchMutateRequest
+	(*BatchMutateResponse)(nil),          // 94: gophkeeper.proto.BatchMutateResponse
+	(*StoredRef)(nil),                    // 95: gophkeeper.proto.StoredRef
+	(*ScrubReport)(nil),                  // 96: gophkeeper.proto.ScrubReport
+	(*RunScrubRequest)(nil),              // 97: gophkeeper.proto.RunScrubRequest
+	(*RunScrubResponse)(nil),             // 98: gophkeeper.proto.RunScrubResponse
+	(*GetScrubReportRequest)(nil),        // 99: gophkeeper.proto.GetScrubReportRequest
+	(*GetScrubReportResponse)(nil),       // 100: gophkeeper.proto.GetScrubReportResponse
+	(*timestamppb.Timestamp)(nil),        // 101: google.protobuf.Timestamp
 }
 var file_api_proto_depIdxs = []int32{
-	50, // 0: gophkeeper.proto.Credential.created_at:type_name -> google.protobuf.Timestamp
-	50, // 1: gophkeeper.proto.Credential.updated_at:type_name -> google.protobuf.Timestamp
-	4,  // 2: gophkeeper.proto.CreateCredentialRequest.credential:type_name -> gophkeeper.proto.Credential
-	4,  // 3: gophkeeper.proto.CreateCredentialResponse.credential:type_name -> gophkeeper.proto.Credential
-	4,  // 4: gophkeeper.proto.GetCredentialByIDResponse.credential:type_name -> gophkeeper.proto.Credential
-	4,  // 5: gophkeeper.proto.GetCredentialsResponse.credentials:type_name -> gophkeeper.proto.Credential
-	4,  // 6: gophkeeper.proto.UpdateCredentialRequest.credential:type_name -> gophkeeper.proto.Credential
-	4,  // 7: gophkeeper.proto.UpdateCredentialResponse.credential:type_name -> gophkeeper.proto.Credential
-	50, // 8: gophkeeper.proto.BankCard.created_at:type_name -> google.protobuf.Timestamp
-	50, // 9: gophkeeper.proto.BankCard.updated_at:type_name -> google.protobuf.Timestamp
-	14, // 10: gophkeeper.proto.CreateBankCardRequest.bank_card:type_name -> gophkeeper.proto.BankCard
-	14, // 11: gophkeeper.proto.CreateBankCardResponse.bank_card:type_name -> gophkeeper.proto.BankCard
-	14, // 12: gophkeeper.proto.GetBankCardByIDResponse.bank_card:type_name -> gophkeeper.proto.BankCard
-	14, // 13: gophkeeper.proto.GetBankCardsResponse.bank_cards:type_name -> gophkeeper.proto.BankCard
-	14, // 14: gophkeeper.proto.UpdateBankCardRequest.bank_card:type_name -> gophkeeper.proto.BankCard
-	14, // 15: gophkeeper.proto.UpdateBankCardResponse.bank_card:type_name -> gophkeeper.proto.BankCard
-	50, // 16: gophkeeper.proto.TextData.created_at:type_name -> google.protobuf.Timestamp
-	50, // 17: gophkeeper.proto.TextData.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 18: gophkeeper.proto.CreateTextDataRequest.text_data:type_name -> gophkeeper.proto.TextData
-	24, // 19: gophkeeper.proto.CreateTextDataResponse.text_data:type_name -> gophkeeper.proto.TextData
-	24, // 20: gophkeeper.proto.GetTextDataByIDResponse.text_data:type_name -> gophkeeper.proto.TextData
-	24, // 21: gophkeeper.proto.GetTextDataTitlesResponse.text_data_titles:type_name -> gophkeeper.proto.TextData
-	24, // 22: gophkeeper.proto.UpdateTextDataRequest.text_data:type_name -> gophkeeper.proto.TextData
-	41, // 23: gophkeeper.proto.UploadBinaryDataRequest.info:type_name -> gophkeeper.proto.BinaryDataInfo
-	41, // 24: gophkeeper.proto.ListBinaryDataResponse.items:type_name -> gophkeeper.proto.BinaryDataInfo
-	50, // 25: gophkeeper.proto.BinaryDataInfo.created_at:type_name -> google.protobuf.Timestamp
-	50, // 26: gophkeeper.proto.BinaryDataInfo.updated_at:type_name -> google.protobuf.Timestamp
-	41, // 27: gophkeeper.proto.GetBinaryDataInfoResponse.binary_info:type_name -> gophkeeper.proto.BinaryDataInfo
-	41, // 28: gophkeeper.proto.UpdateBinaryDataRequest.info:type_name -> gophkeeper.proto.BinaryDataInfo
-	41, // 29: gophkeeper.proto.SaveBinaryDataInfoRequest.info:type_name -> gophkeeper.proto.BinaryDataInfo
-	0,  // 30: gophkeeper.proto.AuthService.Register:input_type -> gophkeeper.proto.RegisterRequest
-	2,  // 31: gophkeeper.proto.AuthService.Login:input_type -> gophkeeper.proto.LoginRequest
-	5,  // 32: gophkeeper.proto.CredentialService.CreateCredential:input_type -> gophkeeper.proto.CreateCredentialRequest
-	7,  // 33: gophkeeper.proto.CredentialService.GetCredentialByID:input_type -> gophkeeper.proto.GetCredentialByIDRequest
-	51, // 34: gophkeeper.proto.CredentialService.GetCredentials:input_type -> google.protobuf.Empty
-	10, // 35: gophkeeper.proto.CredentialService.UpdateCredential:input_type -> gophkeeper.proto.UpdateCredentialRequest
-	12, // 36: gophkeeper.proto.CredentialService.DeleteCredential:input_type -> gophkeeper.proto.DeleteCredentialRequest
-	15, // 37: gophkeeper.proto.BankCardService.CreateBankCard:input_type -> gophkeeper.proto.CreateBankCardRequest
-	17, // 38: gophkeeper.proto.BankCardService.GetBankCardByID:input_type -> gophkeeper.proto.GetBankCardByIDRequest
-	51, // 39: gophkeeper.proto.BankCardService.GetBankCards:input_type -> google.protobuf.Empty
-	20, // 40: gophkeeper.proto.BankCardService.UpdateBankCard:input_type -> gophkeeper.proto.UpdateBankCardRequest
-	22, // 41: gophkeeper.proto.BankCardService.DeleteBankCard:input_type -> gophkeeper.proto.DeleteBankCardRequest
-	25, // 42: gophkeeper.proto.TextDataService.CreateTextData:input_type -> gophkeeper.proto.CreateTextDataRequest
-	27, // 43: gophkeeper.proto.TextDataService.GetTextDataByID:input_type -> gophkeeper.proto.GetTextDataByIDRequest
-	29, // 44: gophkeeper.proto.TextDataService.GetTextDataTitles:input_type -> gophkeeper.proto.GetTextDataTitlesRequest
-	31, // 45: gophkeeper.proto.TextDataService.UpdateTextData:input_type -> gophkeeper.proto.UpdateTextDataRequest
-	33, // 46: gophkeeper.proto.TextDataService.DeleteTextData:input_type -> gophkeeper.proto.DeleteTextDataRequest
-	48, // 47: gophkeeper.proto.BinaryDataService.SaveBinaryDataInfo:input_type -> gophkeeper.proto.SaveBinaryDataInfoRequest
-	44, // 48: gophkeeper.proto.BinaryDataService.GetBinaryDataInfo:input_type -> gophkeeper.proto.GetBinaryDataInfoRequest
-	39, // 49: gophkeeper.proto.BinaryDataService.ListBinaryData:input_type -> gophkeeper.proto.ListBinaryDataRequest
-	46, // 50: gophkeeper.proto.BinaryDataService.UpdateBinaryDataInfo:input_type -> gophkeeper.proto.UpdateBinaryDataRequest
-	42, // 51: gophkeeper.proto.BinaryDataService.DeleteBinaryData:input_type -> gophkeeper.proto.DeleteBinaryDataRequest
-	35, // 52: gophkeeper.proto.BinaryDataService.UploadBinaryData:input_type -> gophkeeper.proto.UploadBinaryDataRequest
-	37, // 53: gophkeeper.proto.BinaryDataService.DownloadBinaryData:input_type -> gophkeeper.proto.DownloadBinaryDataRequest
-	1,  // 54: gophkeeper.proto.AuthService.Register:output_type -> gophkeeper.proto.RegisterResponse
-	3,  // 55: gophkeeper.proto.AuthService.Login:output_type -> gophkeeper.proto.LoginResponse
-	6,  // 56: gophkeeper.proto.CredentialService.CreateCredential:output_type -> gophkeeper.proto.CreateCredentialResponse
-	8,  // 57: gophkeeper.proto.CredentialService.GetCredentialByID:output_type -> gophkeeper.proto.GetCredentialByIDResponse
-	9,  // 58: gophkeeper.proto.CredentialService.GetCredentials:output_type -> gophkeeper.proto.GetCredentialsResponse
-	11, // 59: gophkeeper.proto.CredentialService.UpdateCredential:output_type -> gophkeeper.proto.UpdateCredentialResponse
-	13, // 60: gophkeeper.proto.CredentialService.DeleteCredential:output_type -> gophkeeper.proto.DeleteCredentialResponse
-	16, // 61: gophkeeper.proto.BankCardService.CreateBankCard:output_type -> gophkeeper.proto.CreateBankCardResponse
-	18, // 62: gophkeeper.proto.BankCardService.GetBankCardByID:output_type -> gophkeeper.proto.GetBankCardByIDResponse
-	19, // 63: gophkeeper.proto.BankCardService.GetBankCards:output_type -> gophkeeper.proto.GetBankCardsResponse
-	21, // 64: gophkeeper.proto.BankCardService.UpdateBankCard:output_type -> gophkeeper.proto.UpdateBankCardResponse
-	23, // 65: gophkeeper.proto.BankCardService.DeleteBankCard:output_type -> gophkeeper.proto.DeleteBankCardResponse
-	26, // 66: gophkeeper.proto.TextDataService.CreateTextData:output_type -> gophkeeper.proto.CreateTextDataResponse
-	28, // 67: gophkeeper.proto.TextDataService.GetTextDataByID:output_type -> gophkeeper.proto.GetTextDataByIDResponse
-	30, // 68: gophkeeper.proto.TextDataService.GetTextDataTitles:output_type -> gophkeeper.proto.GetTextDataTitlesResponse
-	32, // 69: gophkeeper.proto.TextDataService.UpdateTextData:output_type -> gophkeeper.proto.UpdateTextDataResponse
-	34, // 70: gophkeeper.proto.TextDataService.DeleteTextData:output_type -> gophkeeper.proto.DeleteTextDataResponse
-	49, // 71: gophkeeper.proto.BinaryDataService.SaveBinaryDataInfo:output_type -> gophkeeper.proto.SaveBinaryDataInfoResponse
-	45, // 72: gophkeeper.proto.BinaryDataService.GetBinaryDataInfo:output_type -> gophkeeper.proto.GetBinaryDataInfoResponse
-	40, // 73: gophkeeper.proto.BinaryDataService.ListBinaryData:output_type -> gophkeeper.proto.ListBinaryDataResponse
-	47, // 74: gophkeeper.proto.BinaryDataService.UpdateBinaryDataInfo:output_type -> gophkeeper.proto.UpdateBinaryDataResponse
-	43, // 75: gophkeeper.proto.BinaryDataService.DeleteBinaryData:output_type -> gophkeeper.proto.DeleteBinaryDataResponse
-	36, // 76: gophkeeper.proto.BinaryDataService.UploadBinaryData:output_type -> gophkeeper.proto.UploadBinaryDataResponse
-	38, // 77: gophkeeper.proto.BinaryDataService.DownloadBinaryData:output_type -> gophkeeper.proto.DownloadBinaryDataResponse
-	54, // [54:78] is the sub-list for method output_type
-	30, // [30:54] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	0,   // 0: gophkeeper.proto.PageRequest.sort_by:type_name -> gophkeeper.proto.SortField
+	101, // 1: gophkeeper.proto.Credential.created_at:type_name -> google.protobuf.Timestamp
+	101, // 2: gophkeeper.proto.Credential.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 3: gophkeeper.proto.Credential.last_accessed_at:type_name -> google.protobuf.Timestamp
+	9,   // 4: gophkeeper.proto.CreateCredentialRequest.credential:type_name -> gophkeeper.proto.Credential
+	9,   // 5: gophkeeper.proto.CreateCredentialResponse.credential:type_name -> gophkeeper.proto.Credential
+	9,   // 6: gophkeeper.proto.GetCredentialByIDResponse.credential:type_name -> gophkeeper.proto.Credential
+	7,   // 7: gophkeeper.proto.GetCredentialsRequest.filter:type_name -> gophkeeper.proto.ListFilter
+	8,   // 8: gophkeeper.proto.GetCredentialsRequest.page:type_name -> gophkeeper.proto.PageRequest
+	9,   // 9: gophkeeper.proto.GetCredentialsResponse.credentials:type_name -> gophkeeper.proto.Credential
+	9,   // 10: gophkeeper.proto.UpdateCredentialRequest.credential:type_name -> gophkeeper.proto.Credential
+	9,   // 11: gophkeeper.proto.UpdateCredentialResponse.credential:type_name -> gophkeeper.proto.Credential
+	101, // 12: gophkeeper.proto.BankCard.created_at:type_name -> google.protobuf.Timestamp
+	101, // 13: gophkeeper.proto.BankCard.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 14: gophkeeper.proto.BankCard.last_accessed_at:type_name -> google.protobuf.Timestamp
+	20,  // 15: gophkeeper.proto.CreateBankCardRequest.bank_card:type_name -> gophkeeper.proto.BankCard
+	20,  // 16: gophkeeper.proto.CreateBankCardResponse.bank_card:type_name -> gophkeeper.proto.BankCard
+	20,  // 17: gophkeeper.proto.GetBankCardByIDResponse.bank_card:type_name -> gophkeeper.proto.BankCard
+	7,   // 18: gophkeeper.proto.GetBankCardsRequest.filter:type_name -> gophkeeper.proto.ListFilter
+	8,   // 19: gophkeeper.proto.GetBankCardsRequest.page:type_name -> gophkeeper.proto.PageRequest
+	20,  // 20: gophkeeper.proto.GetBankCardsResponse.bank_cards:type_name -> gophkeeper.proto.BankCard
+	20,  // 21: gophkeeper.proto.UpdateBankCardRequest.bank_card:type_name -> gophkeeper.proto.BankCard
+	20,  // 22: gophkeeper.proto.UpdateBankCardResponse.bank_card:type_name -> gophkeeper.proto.BankCard
+	101, // 23: gophkeeper.proto.TextData.created_at:type_name -> google.protobuf.Timestamp
+	101, // 24: gophkeeper.proto.TextData.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 25: gophkeeper.proto.TextData.last_accessed_at:type_name -> google.protobuf.Timestamp
+	31,  // 26: gophkeeper.proto.CreateTextDataRequest.text_data:type_name -> gophkeeper.proto.TextData
+	31,  // 27: gophkeeper.proto.CreateTextDataResponse.text_data:type_name -> gophkeeper.proto.TextData
+	31,  // 28: gophkeeper.proto.GetTextDataByIDResponse.text_data:type_name -> gophkeeper.proto.TextData
+	7,   // 29: gophkeeper.proto.GetTextDataTitlesRequest.filter:type_name -> gophkeeper.proto.ListFilter
+	8,   // 30: gophkeeper.proto.GetTextDataTitlesRequest.page:type_name -> gophkeeper.proto.PageRequest
+	31,  // 31: gophkeeper.proto.GetTextDataTitlesResponse.text_data_titles:type_name -> gophkeeper.proto.TextData
+	31,  // 32: gophkeeper.proto.UpdateTextDataRequest.text_data:type_name -> gophkeeper.proto.TextData
+	48,  // 33: gophkeeper.proto.UploadBinaryDataRequest.info:type_name -> gophkeeper.proto.BinaryDataInfo
+	7,   // 34: gophkeeper.proto.ListBinaryDataRequest.filter:type_name -> gophkeeper.proto.ListFilter
+	8,   // 35: gophkeeper.proto.ListBinaryDataRequest.page:type_name -> gophkeeper.proto.PageRequest
+	48,  // 36: gophkeeper.proto.ListBinaryDataResponse.items:type_name -> gophkeeper.proto.BinaryDataInfo
+	101, // 37: gophkeeper.proto.BinaryDataInfo.created_at:type_name -> google.protobuf.Timestamp
+	101, // 38: gophkeeper.proto.BinaryDataInfo.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 39: gophkeeper.proto.BinaryDataInfo.last_accessed_at:type_name -> google.protobuf.Timestamp
+	48,  // 40: gophkeeper.proto.CommitChunkedUploadRequest.info:type_name -> gophkeeper.proto.BinaryDataInfo
+	53,  // 41: gophkeeper.proto.GetManifestResponse.chunks:type_name -> gophkeeper.proto.ChunkRef
+	101, // 42: gophkeeper.proto.BinaryVersionInfo.updated_at:type_name -> google.protobuf.Timestamp
+	101, // 43: gophkeeper.proto.BinaryVersionInfo.created_at:type_name -> google.protobuf.Timestamp
+	66,  // 44: gophkeeper.proto.ListBinaryVersionsResponse.versions:type_name -> gophkeeper.proto.BinaryVersionInfo
+	48,  // 45: gophkeeper.proto.GetBinaryDataInfoResponse.binary_info:type_name -> gophkeeper.proto.BinaryDataInfo
+	48,  // 46: gophkeeper.proto.UpdateBinaryDataRequest.info:type_name -> gophkeeper.proto.BinaryDataInfo
+	48,  // 47: gophkeeper.proto.SaveBinaryDataInfoRequest.info:type_name -> gophkeeper.proto.BinaryDataInfo
+	1,   // 48: gophkeeper.proto.ItemSummary.type:type_name -> gophkeeper.proto.ItemType
+	101, // 49: gophkeeper.proto.ItemSummary.last_accessed_at:type_name -> google.protobuf.Timestamp
+	1,   // 50: gophkeeper.proto.SetFavoriteRequest.type:type_name -> gophkeeper.proto.ItemType
+	1,   // 51: gophkeeper.proto.MarkAccessedRequest.type:type_name -> gophkeeper.proto.ItemType
+	77,  // 52: gophkeeper.proto.ListFavoritesRecentResponse.items:type_name -> gophkeeper.proto.ItemSummary
+	1,   // 53: gophkeeper.proto.Change.type:type_name -> gophkeeper.proto.ItemType
+	101, // 54: gophkeeper.proto.Change.changed_at:type_name -> google.protobuf.Timestamp
+	9,   // 55: gophkeeper.proto.Change.credential:type_name -> gophkeeper.proto.Credential
+	20,  // 56: gophkeeper.proto.Change.bank_card:type_name -> gophkeeper.proto.BankCard
+	31,  // 57: gophkeeper.proto.Change.text_data:type_name -> gophkeeper.proto.TextData
+	48,  // 58: gophkeeper.proto.Change.binary_data:type_name -> gophkeeper.proto.BinaryDataInfo
+	86,  // 59: gophkeeper.proto.ListChangesResponse.changes:type_name -> gophkeeper.proto.Change
+	1,   // 60: gophkeeper.proto.ChangeEvent.type:type_name -> gophkeeper.proto.ItemType
+	2,   // 61: gophkeeper.proto.BatchOperation.kind:type_name -> gophkeeper.proto.BatchOperationKind
+	1,   // 62: gophkeeper.proto.BatchOperation.type:type_name -> gophkeeper.proto.ItemType
+	9,   // 63: gophkeeper.proto.BatchOperation.credential:type_name -> gophkeeper.proto.Credential
+	20,  // 64: gophkeeper.proto.BatchOperation.bank_card:type_name -> gophkeeper.proto.BankCard
+	31,  // 65: gophkeeper.proto.BatchOperation.text_data:type_name -> gophkeeper.proto.TextData
+	91,  // 66: gophkeeper.proto.BatchMutateRequest.operations:type_name -> gophkeeper.proto.BatchOperation
+	92,  // 67: gophkeeper.proto.BatchMutateResponse.results:type_name -> gophkeeper.proto.BatchResult
+	101, // 68: gophkeeper.proto.ScrubReport.started_at:type_name -> google.protobuf.Timestamp
+	101, // 69: gophkeeper.proto.ScrubReport.finished_at:type_name -> google.protobuf.Timestamp
+	95,  // 70: gophkeeper.proto.ScrubReport.missing_files:type_name -> gophkeeper.proto.StoredRef
+	95,  // 71: gophkeeper.proto.ScrubReport.checksum_mismatches:type_name -> gophkeeper.proto.StoredRef
+	96,  // 72: gophkeeper.proto.RunScrubResponse.report:type_name -> gophkeeper.proto.ScrubReport
+	96,  // 73: gophkeeper.proto.GetScrubReportResponse.report:type_name -> gophkeeper.proto.ScrubReport
+	3,   // 74: gophkeeper.proto.AuthService.Register:input_type -> gophkeeper.proto.RegisterRequest
+	5,   // 75: gophkeeper.proto.AuthService.Login:input_type -> gophkeeper.proto.LoginRequest
+	10,  // 76: gophkeeper.proto.CredentialService.CreateCredential:input_type -> gophkeeper.proto.CreateCredentialRequest
+	12,  // 77: gophkeeper.proto.CredentialService.GetCredentialByID:input_type -> gophkeeper.proto.GetCredentialByIDRequest
+	14,  // 78: gophkeeper.proto.CredentialService.GetCredentials:input_type -> gophkeeper.proto.GetCredentialsRequest
+	16,  // 79: gophkeeper.proto.CredentialService.UpdateCredential:input_type -> gophkeeper.proto.UpdateCredentialRequest
+	18,  // 80: gophkeeper.proto.CredentialService.DeleteCredential:input_type -> gophkeeper.proto.DeleteCredentialRequest
+	21,  // 81: gophkeeper.proto.BankCardService.CreateBankCard:input_type -> gophkeeper.proto.CreateBankCardRequest
+	23,  // 82: gophkeeper.proto.BankCardService.GetBankCardByID:input_type -> gophkeeper.proto.GetBankCardByIDRequest
+	25,  // 83: gophkeeper.proto.BankCardService.GetBankCards:input_type -> gophkeeper.proto.GetBankCardsRequest
+	27,  // 84: gophkeeper.proto.BankCardService.UpdateBankCard:input_type -> gophkeeper.proto.UpdateBankCardRequest
+	29,  // 85: gophkeeper.proto.BankCardService.DeleteBankCard:input_type -> gophkeeper.proto.DeleteBankCardRequest
+	32,  // 86: gophkeeper.proto.TextDataService.CreateTextData:input_type -> gophkeeper.proto.CreateTextDataRequest
+	34,  // 87: gophkeeper.proto.TextDataService.GetTextDataByID:input_type -> gophkeeper.proto.GetTextDataByIDRequest
+	36,  // 88: gophkeeper.proto.TextDataService.GetTextDataTitles:input_type -> gophkeeper.proto.GetTextDataTitlesRequest
+	38,  // 89: gophkeeper.proto.TextDataService.UpdateTextData:input_type -> gophkeeper.proto.UpdateTextDataRequest
+	40,  // 90: gophkeeper.proto.TextDataService.DeleteTextData:input_type -> gophkeeper.proto.DeleteTextDataRequest
+	75,  // 91: gophkeeper.proto.BinaryDataService.SaveBinaryDataInfo:input_type -> gophkeeper.proto.SaveBinaryDataInfoRequest
+	71,  // 92: gophkeeper.proto.BinaryDataService.GetBinaryDataInfo:input_type -> gophkeeper.proto.GetBinaryDataInfoRequest
+	46,  // 93: gophkeeper.proto.BinaryDataService.ListBinaryData:input_type -> gophkeeper.proto.ListBinaryDataRequest
+	73,  // 94: gophkeeper.proto.BinaryDataService.UpdateBinaryDataInfo:input_type -> gophkeeper.proto.UpdateBinaryDataRequest
+	49,  // 95: gophkeeper.proto.BinaryDataService.DeleteBinaryData:input_type -> gophkeeper.proto.DeleteBinaryDataRequest
+	42,  // 96: gophkeeper.proto.BinaryDataService.UploadBinaryData:input_type -> gophkeeper.proto.UploadBinaryDataRequest
+	51,  // 97: gophkeeper.proto.BinaryDataService.GetUploadStatus:input_type -> gophkeeper.proto.GetUploadStatusRequest
+	44,  // 98: gophkeeper.proto.BinaryDataService.DownloadBinaryData:input_type -> gophkeeper.proto.DownloadBinaryDataRequest
+	54,  // 99: gophkeeper.proto.BinaryDataService.FindMissingChunks:input_type -> gophkeeper.proto.FindMissingChunksRequest
+	56,  // 100: gophkeeper.proto.BinaryDataService.UploadChunk:input_type -> gophkeeper.proto.UploadChunkRequest
+	58,  // 101: gophkeeper.proto.BinaryDataService.CommitChunkedUpload:input_type -> gophkeeper.proto.CommitChunkedUploadRequest
+	60,  // 102: gophkeeper.proto.BinaryDataService.GetManifest:input_type -> gophkeeper.proto.GetManifestRequest
+	62,  // 103: gophkeeper.proto.BinaryDataService.DownloadChunk:input_type -> gophkeeper.proto.DownloadChunkRequest
+	64,  // 104: gophkeeper.proto.BinaryDataService.VerifyBinaryData:input_type -> gophkeeper.proto.VerifyBinaryDataRequest
+	67,  // 105: gophkeeper.proto.BinaryDataService.ListBinaryVersions:input_type -> gophkeeper.proto.ListBinaryVersionsRequest
+	69,  // 106: gophkeeper.proto.BinaryDataService.RestoreBinaryVersion:input_type -> gophkeeper.proto.RestoreBinaryVersionRequest
+	78,  // 107: gophkeeper.proto.ItemService.SetFavorite:input_type -> gophkeeper.proto.SetFavoriteRequest
+	80,  // 108: gophkeeper.proto.ItemService.MarkAccessed:input_type -> gophkeeper.proto.MarkAccessedRequest
+	82,  // 109: gophkeeper.proto.ItemService.ListFavoritesRecent:input_type -> gophkeeper.proto.ListFavoritesRecentRequest
+	84,  // 110: gophkeeper.proto.ItemService.GetUsage:input_type -> gophkeeper.proto.GetUsageRequest
+	87,  // 111: gophkeeper.proto.SyncService.ListChanges:input_type -> gophkeeper.proto.ListChangesRequest
+	89,  // 112: gophkeeper.proto.SyncService.WatchChanges:input_type -> gophkeeper.proto.WatchChangesRequest
+	93,  // 113: gophkeeper.proto.BatchService.BatchMutate:input_type -> gophkeeper.proto.BatchMutateRequest
+	97,  // 114: gophkeeper.proto.AdminService.RunScrub:input_type -> gophkeeper.proto.RunScrubRequest
+	99,  // 115: gophkeeper.proto.AdminService.GetScrubReport:input_type -> gophkeeper.proto.GetScrubReportRequest
+	4,   // 116: gophkeeper.proto.AuthService.Register:output_type -> gophkeeper.proto.RegisterResponse
+	6,   // 117: gophkeeper.proto.AuthService.Login:output_type -> gophkeeper.proto.LoginResponse
+	11,  // 118: gophkeeper.proto.CredentialService.CreateCredential:output_type -> gophkeeper.proto.CreateCredentialResponse
+	13,  // 119: gophkeeper.proto.CredentialService.GetCredentialByID:output_type -> gophkeeper.proto.GetCredentialByIDResponse
+	15,  // 120: gophkeeper.proto.CredentialService.GetCredentials:output_type -> gophkeeper.proto.GetCredentialsResponse
+	17,  // 121: gophkeeper.proto.CredentialService.UpdateCredential:output_type -> gophkeeper.proto.UpdateCredentialResponse
+	19,  // 122: gophkeeper.proto.CredentialService.DeleteCredential:output_type -> gophkeeper.proto.DeleteCredentialResponse
+	22,  // 123: gophkeeper.proto.BankCardService.CreateBankCard:output_type -> gophkeeper.proto.CreateBankCardResponse
+	24,  // 124: gophkeeper.proto.BankCardService.GetBankCardByID:output_type -> gophkeeper.proto.GetBankCardByIDResponse
+	26,  // 125: gophkeeper.proto.BankCardService.GetBankCards:output_type -> gophkeeper.proto.GetBankCardsResponse
+	28,  // 126: gophkeeper.proto.BankCardService.UpdateBankCard:output_type -> gophkeeper.proto.UpdateBankCardResponse
+	30,  // 127: gophkeeper.proto.BankCardService.DeleteBankCard:output_type -> gophkeeper.proto.DeleteBankCardResponse
+	33,  // 128: gophkeeper.proto.TextDataService.CreateTextData:output_type -> gophkeeper.proto.CreateTextDataResponse
+	35,  // 129: gophkeeper.proto.TextDataService.GetTextDataByID:output_type -> gophkeeper.proto.GetTextDataByIDResponse
+	37,  // 130: gophkeeper.proto.TextDataService.GetTextDataTitles:output_type -> gophkeeper.proto.GetTextDataTitlesResponse
+	39,  // 131: gophkeeper.proto.TextDataService.UpdateTextData:output_type -> gophkeeper.proto.UpdateTextDataResponse
+	41,  // 132: gophkeeper.proto.TextDataService.DeleteTextData:output_type -> gophkeeper.proto.DeleteTextDataResponse
+	76,  // 133: gophkeeper.proto.BinaryDataService.SaveBinaryDataInfo:output_type -> gophkeeper.proto.SaveBinaryDataInfoResponse
+	72,  // 134: gophkeeper.proto.BinaryDataService.GetBinaryDataInfo:output_type -> gophkeeper.proto.GetBinaryDataInfoResponse
+	47,  // 135: gophkeeper.proto.BinaryDataService.ListBinaryData:output_type -> gophkeeper.proto.ListBinaryDataResponse
+	74,  // 136: gophkeeper.proto.BinaryDataService.UpdateBinaryDataInfo:output_type -> gophkeeper.proto.UpdateBinaryDataResponse
+	50,  // 137: gophkeeper.proto.BinaryDataService.DeleteBinaryData:output_type -> gophkeeper.proto.DeleteBinaryDataResponse
+	43,  // 138: gophkeeper.proto.BinaryDataService.UploadBinaryData:output_type -> gophkeeper.proto.UploadBinaryDataResponse
+	52,  // 139: gophkeeper.proto.BinaryDataService.GetUploadStatus:output_type -> gophkeeper.proto.GetUploadStatusResponse
+	45,  // 140: gophkeeper.proto.BinaryDataService.DownloadBinaryData:output_type -> gophkeeper.proto.DownloadBinaryDataResponse
+	55,  // 141: gophkeeper.proto.BinaryDataService.FindMissingChunks:output_type -> gophkeeper.proto.FindMissingChunksResponse
+	57,  // 142: gophkeeper.proto.BinaryDataService.UploadChunk:output_type -> gophkeeper.proto.UploadChunkResponse
+	59,  // 143: gophkeeper.proto.BinaryDataService.CommitChunkedUpload:output_type -> gophkeeper.proto.CommitChunkedUploadResponse
+	61,  // 144: gophkeeper.proto.BinaryDataService.GetManifest:output_type -> gophkeeper.proto.GetManifestResponse
+	63,  // 145: gophkeeper.proto.BinaryDataService.DownloadChunk:output_type -> gophkeeper.proto.DownloadChunkResponse
+	65,  // 146: gophkeeper.proto.BinaryDataService.VerifyBinaryData:output_type -> gophkeeper.proto.VerifyBinaryDataResponse
+	68,  // 147: gophkeeper.proto.BinaryDataService.ListBinaryVersions:output_type -> gophkeeper.proto.ListBinaryVersionsResponse
+	70,  // 148: gophkeeper.proto.BinaryDataService.RestoreBinaryVersion:output_type -> gophkeeper.proto.RestoreBinaryVersionResponse
+	79,  // 149: gophkeeper.proto.ItemService.SetFavorite:output_type -> gophkeeper.proto.SetFavoriteResponse
+	81,  // 150: gophkeeper.proto.ItemService.MarkAccessed:output_type -> gophkeeper.proto.MarkAccessedResponse
+	83,  // 151: gophkeeper.proto.ItemService.ListFavoritesRecent:output_type -> gophkeeper.proto.ListFavoritesRecentResponse
+	85,  // 152: gophkeeper.proto.ItemService.GetUsage:output_type -> gophkeeper.proto.GetUsageResponse
+	88,  // 153: gophkeeper.proto.SyncService.ListChanges:output_type -> gophkeeper.proto.ListChangesResponse
+	90,  // 154: gophkeeper.proto.SyncService.WatchChanges:output_type -> gophkeeper.proto.ChangeEvent
+	94,  // 155: gophkeeper.proto.BatchService.BatchMutate:output_type -> gophkeeper.proto.BatchMutateResponse
+	98,  // 156: gophkeeper.proto.AdminService.RunScrub:output_type -> gophkeeper.proto.RunScrubResponse
+	100, // 157: gophkeeper.proto.AdminService.GetScrubReport:output_type -> gophkeeper.proto.GetScrubReportResponse
+	116, // [116:158] is the sub-list for method output_type
+	74,  // [74:116] is the sub-list for method input_type
+	74,  // [74:74] is the sub-list for extension type_name
+	74,  // [74:74] is the sub-list for extension extendee
+	0,   // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -4929,18 +12274,30 @@ func file_api_proto_init() {
 	if File_api_proto != nil {
 		return
 	}
+	file_api_proto_msgTypes[83].OneofWrappers = []any{
+		(*change_Credential)(nil),
+		(*change_BankCard)(nil),
+		(*change_TextData)(nil),
+		(*change_BinaryData)(nil),
+	}
+	file_api_proto_msgTypes[88].OneofWrappers = []any{
+		(*batchOperation_Credential)(nil),
+		(*batchOperation_BankCard)(nil),
+		(*batchOperation_TextData)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_rawDesc), len(file_api_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   50,
+			NumEnums:      3,
+			NumMessages:   98,
 			NumExtensions: 0,
-			NumServices:   5,
+			NumServices:   9,
 		},
 		GoTypes:           file_api_proto_goTypes,
 		DependencyIndexes: file_api_proto_depIdxs,
+		EnumInfos:         file_api_proto_enumTypes,
 		MessageInfos:      file_api_proto_msgTypes,
 	}.Build()
 	File_api_proto = out.File
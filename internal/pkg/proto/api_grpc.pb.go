@@ -11,7 +11,6 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -179,7 +178,7 @@ const (
 type CredentialServiceClient interface {
 	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*CreateCredentialResponse, error)
 	GetCredentialByID(ctx context.Context, in *GetCredentialByIDRequest, opts ...grpc.CallOption) (*GetCredentialByIDResponse, error)
-	GetCredentials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCredentialsResponse, error)
+	GetCredentials(ctx context.Context, in *GetCredentialsRequest, opts ...grpc.CallOption) (*GetCredentialsResponse, error)
 	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*UpdateCredentialResponse, error)
 	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*DeleteCredentialResponse, error)
 }
@@ -212,7 +211,7 @@ func (c *credentialServiceClient) GetCredentialByID(ctx context.Context, in *Get
 	return out, nil
 }
 
-func (c *credentialServiceClient) GetCredentials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetCredentialsResponse, error) {
+func (c *credentialServiceClient) GetCredentials(ctx context.Context, in *GetCredentialsRequest, opts ...grpc.CallOption) (*GetCredentialsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCredentialsResponse)
 	err := c.cc.Invoke(ctx, CredentialService_GetCredentials_FullMethodName, in, out, cOpts...)
@@ -250,7 +249,7 @@ func (c *credentialServiceClient) DeleteCredential(ctx context.Context, in *Dele
 type CredentialServiceServer interface {
 	CreateCredential(context.Context, *CreateCredentialRequest) (*CreateCredentialResponse, error)
 	GetCredentialByID(context.Context, *GetCredentialByIDRequest) (*GetCredentialByIDResponse, error)
-	GetCredentials(context.Context, *emptypb.Empty) (*GetCredentialsResponse, error)
+	GetCredentials(context.Context, *GetCredentialsRequest) (*GetCredentialsResponse, error)
 	UpdateCredential(context.Context, *UpdateCredentialRequest) (*UpdateCredentialResponse, error)
 	DeleteCredential(context.Context, *DeleteCredentialRequest) (*DeleteCredentialResponse, error)
 	mustEmbedUnimplementedCredentialServiceServer()
@@ -269,7 +268,7 @@ func (UnimplementedCredentialServiceServer) CreateCredential(context.Context, *C
 func (UnimplementedCredentialServiceServer) GetCredentialByID(context.Context, *GetCredentialByIDRequest) (*GetCredentialByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCredentialByID not implemented")
 }
-func (UnimplementedCredentialServiceServer) GetCredentials(context.Context, *emptypb.Empty) (*GetCredentialsResponse, error) {
+func (UnimplementedCredentialServiceServer) GetCredentials(context.Context, *GetCredentialsRequest) (*GetCredentialsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCredentials not implemented")
 }
 func (UnimplementedCredentialServiceServer) UpdateCredential(context.Context, *UpdateCredentialRequest) (*UpdateCredentialResponse, error) {
@@ -336,7 +335,7 @@ func _CredentialService_GetCredentialByID_Handler(srv interface{}, ctx context.C
 }
 
 func _CredentialService_GetCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetCredentialsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -348,7 +347,7 @@ func _CredentialService_GetCredentials_Handler(srv interface{}, ctx context.Cont
 		FullMethod: CredentialService_GetCredentials_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CredentialServiceServer).GetCredentials(ctx, req.(*emptypb.Empty))
+		return srv.(CredentialServiceServer).GetCredentials(ctx, req.(*GetCredentialsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -437,7 +436,7 @@ const (
 type BankCardServiceClient interface {
 	CreateBankCard(ctx context.Context, in *CreateBankCardRequest, opts ...grpc.CallOption) (*CreateBankCardResponse, error)
 	GetBankCardByID(ctx context.Context, in *GetBankCardByIDRequest, opts ...grpc.CallOption) (*GetBankCardByIDResponse, error)
-	GetBankCards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBankCardsResponse, error)
+	GetBankCards(ctx context.Context, in *GetBankCardsRequest, opts ...grpc.CallOption) (*GetBankCardsResponse, error)
 	UpdateBankCard(ctx context.Context, in *UpdateBankCardRequest, opts ...grpc.CallOption) (*UpdateBankCardResponse, error)
 	DeleteBankCard(ctx context.Context, in *DeleteBankCardRequest, opts ...grpc.CallOption) (*DeleteBankCardResponse, error)
 }
@@ -470,7 +469,7 @@ func (c *bankCardServiceClient) GetBankCardByID(ctx context.Context, in *GetBank
 	return out, nil
 }
 
-func (c *bankCardServiceClient) GetBankCards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBankCardsResponse, error) {
+func (c *bankCardServiceClient) GetBankCards(ctx context.Context, in *GetBankCardsRequest, opts ...grpc.CallOption) (*GetBankCardsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetBankCardsResponse)
 	err := c.cc.Invoke(ctx, BankCardService_GetBankCards_FullMethodName, in, out, cOpts...)
@@ -508,7 +507,7 @@ func (c *bankCardServiceClient) DeleteBankCard(ctx context.Context, in *DeleteBa
 type BankCardServiceServer interface {
 	CreateBankCard(context.Context, *CreateBankCardRequest) (*CreateBankCardResponse, error)
 	GetBankCardByID(context.Context, *GetBankCardByIDRequest) (*GetBankCardByIDResponse, error)
-	GetBankCards(context.Context, *emptypb.Empty) (*GetBankCardsResponse, error)
+	GetBankCards(context.Context, *GetBankCardsRequest) (*GetBankCardsResponse, error)
 	UpdateBankCard(context.Context, *UpdateBankCardRequest) (*UpdateBankCardResponse, error)
 	DeleteBankCard(context.Context, *DeleteBankCardRequest) (*DeleteBankCardResponse, error)
 	mustEmbedUnimplementedBankCardServiceServer()
@@ -527,7 +526,7 @@ func (UnimplementedBankCardServiceServer) CreateBankCard(context.Context, *Creat
 func (UnimplementedBankCardServiceServer) GetBankCardByID(context.Context, *GetBankCardByIDRequest) (*GetBankCardByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBankCardByID not implemented")
 }
-func (UnimplementedBankCardServiceServer) GetBankCards(context.Context, *emptypb.Empty) (*GetBankCardsResponse, error) {
+func (UnimplementedBankCardServiceServer) GetBankCards(context.Context, *GetBankCardsRequest) (*GetBankCardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBankCards not implemented")
 }
 func (UnimplementedBankCardServiceServer) UpdateBankCard(context.Context, *UpdateBankCardRequest) (*UpdateBankCardResponse, error) {
@@ -594,7 +593,7 @@ func _BankCardService_GetBankCardByID_Handler(srv interface{}, ctx context.Conte
 }
 
 func _BankCardService_GetBankCards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(GetBankCardsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -606,7 +605,7 @@ func _BankCardService_GetBankCards_Handler(srv interface{}, ctx context.Context,
 		FullMethod: BankCardService_GetBankCards_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(BankCardServiceServer).GetBankCards(ctx, req.(*emptypb.Empty))
+		return srv.(BankCardServiceServer).GetBankCards(ctx, req.(*GetBankCardsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -944,7 +943,16 @@ const (
 	BinaryDataService_UpdateBinaryDataInfo_FullMethodName = "/gophkeeper.proto.BinaryDataService/UpdateBinaryDataInfo"
 	BinaryDataService_DeleteBinaryData_FullMethodName     = "/gophkeeper.proto.BinaryDataService/DeleteBinaryData"
 	BinaryDataService_UploadBinaryData_FullMethodName     = "/gophkeeper.proto.BinaryDataService/UploadBinaryData"
+	BinaryDataService_GetUploadStatus_FullMethodName      = "/gophkeeper.proto.BinaryDataService/GetUploadStatus"
 	BinaryDataService_DownloadBinaryData_FullMethodName   = "/gophkeeper.proto.BinaryDataService/DownloadBinaryData"
+	BinaryDataService_FindMissingChunks_FullMethodName    = "/gophkeeper.proto.BinaryDataService/FindMissingChunks"
+	BinaryDataService_UploadChunk_FullMethodName          = "/gophkeeper.proto.BinaryDataService/UploadChunk"
+	BinaryDataService_CommitChunkedUpload_FullMethodName  = "/gophkeeper.proto.BinaryDataService/CommitChunkedUpload"
+	BinaryDataService_GetManifest_FullMethodName          = "/gophkeeper.proto.BinaryDataService/GetManifest"
+	BinaryDataService_DownloadChunk_FullMethodName        = "/gophkeeper.proto.BinaryDataService/DownloadChunk"
+	BinaryDataService_VerifyBinaryData_FullMethodName     = "/gophkeeper.proto.BinaryDataService/VerifyBinaryData"
+	BinaryDataService_ListBinaryVersions_FullMethodName   = "/gophkeeper.proto.BinaryDataService/ListBinaryVersions"
+	BinaryDataService_RestoreBinaryVersion_FullMethodName = "/gophkeeper.proto.BinaryDataService/RestoreBinaryVersion"
 )
 
 // BinaryDataServiceClient is the client API for BinaryDataService service.
@@ -959,7 +967,16 @@ type BinaryDataServiceClient interface {
 	UpdateBinaryDataInfo(ctx context.Context, in *UpdateBinaryDataRequest, opts ...grpc.CallOption) (*UpdateBinaryDataResponse, error)
 	DeleteBinaryData(ctx context.Context, in *DeleteBinaryDataRequest, opts ...grpc.CallOption) (*DeleteBinaryDataResponse, error)
 	UploadBinaryData(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadBinaryDataRequest, UploadBinaryDataResponse], error)
+	GetUploadStatus(ctx context.Context, in *GetUploadStatusRequest, opts ...grpc.CallOption) (*GetUploadStatusResponse, error)
 	DownloadBinaryData(ctx context.Context, in *DownloadBinaryDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadBinaryDataResponse], error)
+	FindMissingChunks(ctx context.Context, in *FindMissingChunksRequest, opts ...grpc.CallOption) (*FindMissingChunksResponse, error)
+	UploadChunk(ctx context.Context, in *UploadChunkRequest, opts ...grpc.CallOption) (*UploadChunkResponse, error)
+	CommitChunkedUpload(ctx context.Context, in *CommitChunkedUploadRequest, opts ...grpc.CallOption) (*CommitChunkedUploadResponse, error)
+	GetManifest(ctx context.Context, in *GetManifestRequest, opts ...grpc.CallOption) (*GetManifestResponse, error)
+	DownloadChunk(ctx context.Context, in *DownloadChunkRequest, opts ...grpc.CallOption) (*DownloadChunkResponse, error)
+	VerifyBinaryData(ctx context.Context, in *VerifyBinaryDataRequest, opts ...grpc.CallOption) (*VerifyBinaryDataResponse, error)
+	ListBinaryVersions(ctx context.Context, in *ListBinaryVersionsRequest, opts ...grpc.CallOption) (*ListBinaryVersionsResponse, error)
+	RestoreBinaryVersion(ctx context.Context, in *RestoreBinaryVersionRequest, opts ...grpc.CallOption) (*RestoreBinaryVersionResponse, error)
 }
 
 type binaryDataServiceClient struct {
@@ -1033,6 +1050,16 @@ func (c *binaryDataServiceClient) UploadBinaryData(ctx context.Context, opts ...
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BinaryDataService_UploadBinaryDataClient = grpc.ClientStreamingClient[UploadBinaryDataRequest, UploadBinaryDataResponse]
 
+func (c *binaryDataServiceClient) GetUploadStatus(ctx context.Context, in *GetUploadStatusRequest, opts ...grpc.CallOption) (*GetUploadStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUploadStatusResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_GetUploadStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *binaryDataServiceClient) DownloadBinaryData(ctx context.Context, in *DownloadBinaryDataRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadBinaryDataResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &BinaryDataService_ServiceDesc.Streams[1], BinaryDataService_DownloadBinaryData_FullMethodName, cOpts...)
@@ -1052,6 +1079,86 @@ func (c *binaryDataServiceClient) DownloadBinaryData(ctx context.Context, in *Do
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BinaryDataService_DownloadBinaryDataClient = grpc.ServerStreamingClient[DownloadBinaryDataResponse]
 
+func (c *binaryDataServiceClient) FindMissingChunks(ctx context.Context, in *FindMissingChunksRequest, opts ...grpc.CallOption) (*FindMissingChunksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FindMissingChunksResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_FindMissingChunks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryDataServiceClient) UploadChunk(ctx context.Context, in *UploadChunkRequest, opts ...grpc.CallOption) (*UploadChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UploadChunkResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_UploadChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryDataServiceClient) CommitChunkedUpload(ctx context.Context, in *CommitChunkedUploadRequest, opts ...grpc.CallOption) (*CommitChunkedUploadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommitChunkedUploadResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_CommitChunkedUpload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryDataServiceClient) GetManifest(ctx context.Context, in *GetManifestRequest, opts ...grpc.CallOption) (*GetManifestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetManifestResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_GetManifest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryDataServiceClient) DownloadChunk(ctx context.Context, in *DownloadChunkRequest, opts ...grpc.CallOption) (*DownloadChunkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DownloadChunkResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_DownloadChunk_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryDataServiceClient) VerifyBinaryData(ctx context.Context, in *VerifyBinaryDataRequest, opts ...grpc.CallOption) (*VerifyBinaryDataResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyBinaryDataResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_VerifyBinaryData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryDataServiceClient) ListBinaryVersions(ctx context.Context, in *ListBinaryVersionsRequest, opts ...grpc.CallOption) (*ListBinaryVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBinaryVersionsResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_ListBinaryVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *binaryDataServiceClient) RestoreBinaryVersion(ctx context.Context, in *RestoreBinaryVersionRequest, opts ...grpc.CallOption) (*RestoreBinaryVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreBinaryVersionResponse)
+	err := c.cc.Invoke(ctx, BinaryDataService_RestoreBinaryVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BinaryDataServiceServer is the server API for BinaryDataService service.
 // All implementations must embed UnimplementedBinaryDataServiceServer
 // for forward compatibility.
@@ -1064,7 +1171,16 @@ type BinaryDataServiceServer interface {
 	UpdateBinaryDataInfo(context.Context, *UpdateBinaryDataRequest) (*UpdateBinaryDataResponse, error)
 	DeleteBinaryData(context.Context, *DeleteBinaryDataRequest) (*DeleteBinaryDataResponse, error)
 	UploadBinaryData(grpc.ClientStreamingServer[UploadBinaryDataRequest, UploadBinaryDataResponse]) error
+	GetUploadStatus(context.Context, *GetUploadStatusRequest) (*GetUploadStatusResponse, error)
 	DownloadBinaryData(*DownloadBinaryDataRequest, grpc.ServerStreamingServer[DownloadBinaryDataResponse]) error
+	FindMissingChunks(context.Context, *FindMissingChunksRequest) (*FindMissingChunksResponse, error)
+	UploadChunk(context.Context, *UploadChunkRequest) (*UploadChunkResponse, error)
+	CommitChunkedUpload(context.Context, *CommitChunkedUploadRequest) (*CommitChunkedUploadResponse, error)
+	GetManifest(context.Context, *GetManifestRequest) (*GetManifestResponse, error)
+	DownloadChunk(context.Context, *DownloadChunkRequest) (*DownloadChunkResponse, error)
+	VerifyBinaryData(context.Context, *VerifyBinaryDataRequest) (*VerifyBinaryDataResponse, error)
+	ListBinaryVersions(context.Context, *ListBinaryVersionsRequest) (*ListBinaryVersionsResponse, error)
+	RestoreBinaryVersion(context.Context, *RestoreBinaryVersionRequest) (*RestoreBinaryVersionResponse, error)
 	mustEmbedUnimplementedBinaryDataServiceServer()
 }
 
@@ -1093,9 +1209,36 @@ func (UnimplementedBinaryDataServiceServer) DeleteBinaryData(context.Context, *D
 func (UnimplementedBinaryDataServiceServer) UploadBinaryData(grpc.ClientStreamingServer[UploadBinaryDataRequest, UploadBinaryDataResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method UploadBinaryData not implemented")
 }
+func (UnimplementedBinaryDataServiceServer) GetUploadStatus(context.Context, *GetUploadStatusRequest) (*GetUploadStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUploadStatus not implemented")
+}
 func (UnimplementedBinaryDataServiceServer) DownloadBinaryData(*DownloadBinaryDataRequest, grpc.ServerStreamingServer[DownloadBinaryDataResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method DownloadBinaryData not implemented")
 }
+func (UnimplementedBinaryDataServiceServer) FindMissingChunks(context.Context, *FindMissingChunksRequest) (*FindMissingChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMissingChunks not implemented")
+}
+func (UnimplementedBinaryDataServiceServer) UploadChunk(context.Context, *UploadChunkRequest) (*UploadChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadChunk not implemented")
+}
+func (UnimplementedBinaryDataServiceServer) CommitChunkedUpload(context.Context, *CommitChunkedUploadRequest) (*CommitChunkedUploadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitChunkedUpload not implemented")
+}
+func (UnimplementedBinaryDataServiceServer) GetManifest(context.Context, *GetManifestRequest) (*GetManifestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManifest not implemented")
+}
+func (UnimplementedBinaryDataServiceServer) DownloadChunk(context.Context, *DownloadChunkRequest) (*DownloadChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DownloadChunk not implemented")
+}
+func (UnimplementedBinaryDataServiceServer) VerifyBinaryData(context.Context, *VerifyBinaryDataRequest) (*VerifyBinaryDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyBinaryData not implemented")
+}
+func (UnimplementedBinaryDataServiceServer) ListBinaryVersions(context.Context, *ListBinaryVersionsRequest) (*ListBinaryVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBinaryVersions not implemented")
+}
+func (UnimplementedBinaryDataServiceServer) RestoreBinaryVersion(context.Context, *RestoreBinaryVersionRequest) (*RestoreBinaryVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreBinaryVersion not implemented")
+}
 func (UnimplementedBinaryDataServiceServer) mustEmbedUnimplementedBinaryDataServiceServer() {}
 func (UnimplementedBinaryDataServiceServer) testEmbeddedByValue()                           {}
 
@@ -1214,6 +1357,24 @@ func _BinaryDataService_UploadBinaryData_Handler(srv interface{}, stream grpc.Se
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BinaryDataService_UploadBinaryDataServer = grpc.ClientStreamingServer[UploadBinaryDataRequest, UploadBinaryDataResponse]
 
+func _BinaryDataService_GetUploadStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUploadStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).GetUploadStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_GetUploadStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).GetUploadStatus(ctx, req.(*GetUploadStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BinaryDataService_DownloadBinaryData_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(DownloadBinaryDataRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1225,6 +1386,150 @@ func _BinaryDataService_DownloadBinaryData_Handler(srv interface{}, stream grpc.
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type BinaryDataService_DownloadBinaryDataServer = grpc.ServerStreamingServer[DownloadBinaryDataResponse]
 
+func _BinaryDataService_FindMissingChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMissingChunksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).FindMissingChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_FindMissingChunks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).FindMissingChunks(ctx, req.(*FindMissingChunksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryDataService_UploadChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).UploadChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_UploadChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).UploadChunk(ctx, req.(*UploadChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryDataService_CommitChunkedUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitChunkedUploadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).CommitChunkedUpload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_CommitChunkedUpload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).CommitChunkedUpload(ctx, req.(*CommitChunkedUploadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryDataService_GetManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetManifestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).GetManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_GetManifest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).GetManifest(ctx, req.(*GetManifestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryDataService_DownloadChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DownloadChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).DownloadChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_DownloadChunk_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).DownloadChunk(ctx, req.(*DownloadChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryDataService_VerifyBinaryData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyBinaryDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).VerifyBinaryData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_VerifyBinaryData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).VerifyBinaryData(ctx, req.(*VerifyBinaryDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryDataService_ListBinaryVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBinaryVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).ListBinaryVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_ListBinaryVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).ListBinaryVersions(ctx, req.(*ListBinaryVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BinaryDataService_RestoreBinaryVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreBinaryVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BinaryDataServiceServer).RestoreBinaryVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BinaryDataService_RestoreBinaryVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BinaryDataServiceServer).RestoreBinaryVersion(ctx, req.(*RestoreBinaryVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // BinaryDataService_ServiceDesc is the grpc.ServiceDesc for BinaryDataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1252,6 +1557,42 @@ var BinaryDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBinaryData",
 			Handler:    _BinaryDataService_DeleteBinaryData_Handler,
 		},
+		{
+			MethodName: "GetUploadStatus",
+			Handler:    _BinaryDataService_GetUploadStatus_Handler,
+		},
+		{
+			MethodName: "FindMissingChunks",
+			Handler:    _BinaryDataService_FindMissingChunks_Handler,
+		},
+		{
+			MethodName: "UploadChunk",
+			Handler:    _BinaryDataService_UploadChunk_Handler,
+		},
+		{
+			MethodName: "CommitChunkedUpload",
+			Handler:    _BinaryDataService_CommitChunkedUpload_Handler,
+		},
+		{
+			MethodName: "GetManifest",
+			Handler:    _BinaryDataService_GetManifest_Handler,
+		},
+		{
+			MethodName: "DownloadChunk",
+			Handler:    _BinaryDataService_DownloadChunk_Handler,
+		},
+		{
+			MethodName: "VerifyBinaryData",
+			Handler:    _BinaryDataService_VerifyBinaryData_Handler,
+		},
+		{
+			MethodName: "ListBinaryVersions",
+			Handler:    _BinaryDataService_ListBinaryVersions_Handler,
+		},
+		{
+			MethodName: "RestoreBinaryVersion",
+			Handler:    _BinaryDataService_RestoreBinaryVersion_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1267,3 +1608,623 @@ var BinaryDataService_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "api.proto",
 }
+
+const (
+	ItemService_SetFavorite_FullMethodName         = "/gophkeeper.proto.ItemService/SetFavorite"
+	ItemService_MarkAccessed_FullMethodName        = "/gophkeeper.proto.ItemService/MarkAccessed"
+	ItemService_ListFavoritesRecent_FullMethodName = "/gophkeeper.proto.ItemService/ListFavoritesRecent"
+	ItemService_GetUsage_FullMethodName            = "/gophkeeper.proto.ItemService/GetUsage"
+)
+
+// ItemServiceClient is the client API for ItemService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис операций, общих для записей всех типов (избранное, недавние, занятое место)
+type ItemServiceClient interface {
+	SetFavorite(ctx context.Context, in *SetFavoriteRequest, opts ...grpc.CallOption) (*SetFavoriteResponse, error)
+	MarkAccessed(ctx context.Context, in *MarkAccessedRequest, opts ...grpc.CallOption) (*MarkAccessedResponse, error)
+	ListFavoritesRecent(ctx context.Context, in *ListFavoritesRecentRequest, opts ...grpc.CallOption) (*ListFavoritesRecentResponse, error)
+	GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error)
+}
+
+type itemServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewItemServiceClient(cc grpc.ClientConnInterface) ItemServiceClient {
+	return &itemServiceClient{cc}
+}
+
+func (c *itemServiceClient) SetFavorite(ctx context.Context, in *SetFavoriteRequest, opts ...grpc.CallOption) (*SetFavoriteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFavoriteResponse)
+	err := c.cc.Invoke(ctx, ItemService_SetFavorite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) MarkAccessed(ctx context.Context, in *MarkAccessedRequest, opts ...grpc.CallOption) (*MarkAccessedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAccessedResponse)
+	err := c.cc.Invoke(ctx, ItemService_MarkAccessed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) ListFavoritesRecent(ctx context.Context, in *ListFavoritesRecentRequest, opts ...grpc.CallOption) (*ListFavoritesRecentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFavoritesRecentResponse)
+	err := c.cc.Invoke(ctx, ItemService_ListFavoritesRecent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemServiceClient) GetUsage(ctx context.Context, in *GetUsageRequest, opts ...grpc.CallOption) (*GetUsageResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUsageResponse)
+	err := c.cc.Invoke(ctx, ItemService_GetUsage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ItemServiceServer is the server API for ItemService service.
+// All implementations must embed UnimplementedItemServiceServer
+// for forward compatibility.
+//
+// Сервис операций, общих для записей всех типов (избранное, недавние, занятое место)
+type ItemServiceServer interface {
+	SetFavorite(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error)
+	MarkAccessed(context.Context, *MarkAccessedRequest) (*MarkAccessedResponse, error)
+	ListFavoritesRecent(context.Context, *ListFavoritesRecentRequest) (*ListFavoritesRecentResponse, error)
+	GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error)
+	mustEmbedUnimplementedItemServiceServer()
+}
+
+// UnimplementedItemServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedItemServiceServer struct{}
+
+func (UnimplementedItemServiceServer) SetFavorite(context.Context, *SetFavoriteRequest) (*SetFavoriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFavorite not implemented")
+}
+func (UnimplementedItemServiceServer) MarkAccessed(context.Context, *MarkAccessedRequest) (*MarkAccessedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAccessed not implemented")
+}
+func (UnimplementedItemServiceServer) ListFavoritesRecent(context.Context, *ListFavoritesRecentRequest) (*ListFavoritesRecentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFavoritesRecent not implemented")
+}
+func (UnimplementedItemServiceServer) GetUsage(context.Context, *GetUsageRequest) (*GetUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedItemServiceServer) mustEmbedUnimplementedItemServiceServer() {}
+func (UnimplementedItemServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeItemServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ItemServiceServer will
+// result in compilation errors.
+type UnsafeItemServiceServer interface {
+	mustEmbedUnimplementedItemServiceServer()
+}
+
+func RegisterItemServiceServer(s grpc.ServiceRegistrar, srv ItemServiceServer) {
+	// If the following call pancis, it indicates UnimplementedItemServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ItemService_ServiceDesc, srv)
+}
+
+func _ItemService_SetFavorite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFavoriteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).SetFavorite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_SetFavorite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).SetFavorite(ctx, req.(*SetFavoriteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_MarkAccessed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAccessedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).MarkAccessed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_MarkAccessed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).MarkAccessed(ctx, req.(*MarkAccessedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_ListFavoritesRecent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFavoritesRecentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).ListFavoritesRecent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_ListFavoritesRecent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).ListFavoritesRecent(ctx, req.(*ListFavoritesRecentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemService_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemServiceServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ItemService_GetUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemServiceServer).GetUsage(ctx, req.(*GetUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ItemService_ServiceDesc is the grpc.ServiceDesc for ItemService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ItemService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.proto.ItemService",
+	HandlerType: (*ItemServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetFavorite",
+			Handler:    _ItemService_SetFavorite_Handler,
+		},
+		{
+			MethodName: "MarkAccessed",
+			Handler:    _ItemService_MarkAccessed_Handler,
+		},
+		{
+			MethodName: "ListFavoritesRecent",
+			Handler:    _ItemService_ListFavoritesRecent_Handler,
+		},
+		{
+			MethodName: "GetUsage",
+			Handler:    _ItemService_GetUsage_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
+
+const (
+	SyncService_ListChanges_FullMethodName  = "/gophkeeper.proto.SyncService/ListChanges"
+	SyncService_WatchChanges_FullMethodName = "/gophkeeper.proto.SyncService/WatchChanges"
+)
+
+// SyncServiceClient is the client API for SyncService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис разностной синхронизации
+type SyncServiceClient interface {
+	ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error)
+	WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangeEvent], error)
+}
+
+type syncServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSyncServiceClient(cc grpc.ClientConnInterface) SyncServiceClient {
+	return &syncServiceClient{cc}
+}
+
+func (c *syncServiceClient) ListChanges(ctx context.Context, in *ListChangesRequest, opts ...grpc.CallOption) (*ListChangesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListChangesResponse)
+	err := c.cc.Invoke(ctx, SyncService_ListChanges_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *syncServiceClient) WatchChanges(ctx context.Context, in *WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ChangeEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &SyncService_ServiceDesc.Streams[0], SyncService_WatchChanges_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchChangesRequest, ChangeEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SyncService_WatchChangesClient = grpc.ServerStreamingClient[ChangeEvent]
+
+// SyncServiceServer is the server API for SyncService service.
+// All implementations must embed UnimplementedSyncServiceServer
+// for forward compatibility.
+//
+// Сервис разностной синхронизации
+type SyncServiceServer interface {
+	ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error)
+	WatchChanges(*WatchChangesRequest, grpc.ServerStreamingServer[ChangeEvent]) error
+	mustEmbedUnimplementedSyncServiceServer()
+}
+
+// UnimplementedSyncServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSyncServiceServer struct{}
+
+func (UnimplementedSyncServiceServer) ListChanges(context.Context, *ListChangesRequest) (*ListChangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChanges not implemented")
+}
+func (UnimplementedSyncServiceServer) WatchChanges(*WatchChangesRequest, grpc.ServerStreamingServer[ChangeEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchChanges not implemented")
+}
+func (UnimplementedSyncServiceServer) mustEmbedUnimplementedSyncServiceServer() {}
+func (UnimplementedSyncServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeSyncServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SyncServiceServer will
+// result in compilation errors.
+type UnsafeSyncServiceServer interface {
+	mustEmbedUnimplementedSyncServiceServer()
+}
+
+func RegisterSyncServiceServer(s grpc.ServiceRegistrar, srv SyncServiceServer) {
+	// If the following call pancis, it indicates UnimplementedSyncServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SyncService_ServiceDesc, srv)
+}
+
+func _SyncService_ListChanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncServiceServer).ListChanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SyncService_ListChanges_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncServiceServer).ListChanges(ctx, req.(*ListChangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SyncService_WatchChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SyncServiceServer).WatchChanges(m, &grpc.GenericServerStream[WatchChangesRequest, ChangeEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type SyncService_WatchChangesServer = grpc.ServerStreamingServer[ChangeEvent]
+
+// SyncService_ServiceDesc is the grpc.ServiceDesc for SyncService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SyncService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.proto.SyncService",
+	HandlerType: (*SyncServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListChanges",
+			Handler:    _SyncService_ListChanges_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchChanges",
+			Handler:       _SyncService_WatchChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "api.proto",
+}
+
+const (
+	BatchService_BatchMutate_FullMethodName = "/gophkeeper.proto.BatchService/BatchMutate"
+)
+
+// BatchServiceClient is the client API for BatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Сервис пакетного изменения записей в одной транзакции
+type BatchServiceClient interface {
+	BatchMutate(ctx context.Context, in *BatchMutateRequest, opts ...grpc.CallOption) (*BatchMutateResponse, error)
+}
+
+type batchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBatchServiceClient(cc grpc.ClientConnInterface) BatchServiceClient {
+	return &batchServiceClient{cc}
+}
+
+func (c *batchServiceClient) BatchMutate(ctx context.Context, in *BatchMutateRequest, opts ...grpc.CallOption) (*BatchMutateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchMutateResponse)
+	err := c.cc.Invoke(ctx, BatchService_BatchMutate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BatchServiceServer is the server API for BatchService service.
+// All implementations must embed UnimplementedBatchServiceServer
+// for forward compatibility.
+//
+// Сервис пакетного изменения записей в одной транзакции
+type BatchServiceServer interface {
+	BatchMutate(context.Context, *BatchMutateRequest) (*BatchMutateResponse, error)
+	mustEmbedUnimplementedBatchServiceServer()
+}
+
+// UnimplementedBatchServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedBatchServiceServer struct{}
+
+func (UnimplementedBatchServiceServer) BatchMutate(context.Context, *BatchMutateRequest) (*BatchMutateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchMutate not implemented")
+}
+func (UnimplementedBatchServiceServer) mustEmbedUnimplementedBatchServiceServer() {}
+func (UnimplementedBatchServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeBatchServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BatchServiceServer will
+// result in compilation errors.
+type UnsafeBatchServiceServer interface {
+	mustEmbedUnimplementedBatchServiceServer()
+}
+
+func RegisterBatchServiceServer(s grpc.ServiceRegistrar, srv BatchServiceServer) {
+	// If the following call pancis, it indicates UnimplementedBatchServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&BatchService_ServiceDesc, srv)
+}
+
+func _BatchService_BatchMutate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchMutateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BatchServiceServer).BatchMutate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BatchService_BatchMutate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BatchServiceServer).BatchMutate(ctx, req.(*BatchMutateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BatchService_ServiceDesc is the grpc.ServiceDesc for BatchService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BatchService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.proto.BatchService",
+	HandlerType: (*BatchServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BatchMutate",
+			Handler:    _BatchService_BatchMutate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
+
+const (
+	AdminService_RunScrub_FullMethodName       = "/gophkeeper.proto.AdminService/RunScrub"
+	AdminService_GetScrubReport_FullMethodName = "/gophkeeper.proto.AdminService/GetScrubReport"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Служебный сервис администратора сервера. Вызовы авторизуются не JWT,
+// а токеном администратора в метаданных x-admin-token.
+type AdminServiceClient interface {
+	RunScrub(ctx context.Context, in *RunScrubRequest, opts ...grpc.CallOption) (*RunScrubResponse, error)
+	GetScrubReport(ctx context.Context, in *GetScrubReportRequest, opts ...grpc.CallOption) (*GetScrubReportResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) RunScrub(ctx context.Context, in *RunScrubRequest, opts ...grpc.CallOption) (*RunScrubResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunScrubResponse)
+	err := c.cc.Invoke(ctx, AdminService_RunScrub_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetScrubReport(ctx context.Context, in *GetScrubReportRequest, opts ...grpc.CallOption) (*GetScrubReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetScrubReportResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetScrubReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// Служебный сервис администратора сервера. Вызовы авторизуются не JWT,
+// а токеном администратора в метаданных x-admin-token.
+type AdminServiceServer interface {
+	RunScrub(context.Context, *RunScrubRequest) (*RunScrubResponse, error)
+	GetScrubReport(context.Context, *GetScrubReportRequest) (*GetScrubReportResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) RunScrub(context.Context, *RunScrubRequest) (*RunScrubResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunScrub not implemented")
+}
+func (UnimplementedAdminServiceServer) GetScrubReport(context.Context, *GetScrubReportRequest) (*GetScrubReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScrubReport not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_RunScrub_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunScrubRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RunScrub(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RunScrub_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RunScrub(ctx, req.(*RunScrubRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetScrubReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScrubReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetScrubReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetScrubReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetScrubReport(ctx, req.(*GetScrubReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gophkeeper.proto.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RunScrub",
+			Handler:    _AdminService_RunScrub_Handler,
+		},
+		{
+			MethodName: "GetScrubReport",
+			Handler:    _AdminService_GetScrubReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api.proto",
+}
@@ -16,7 +16,6 @@ import (
 	proto "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
 	gomock "go.uber.org/mock/gomock"
 	grpc "google.golang.org/grpc"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // MockAuthServiceClient is a mock of AuthServiceClient interface.
@@ -270,7 +269,7 @@ func (mr *MockCredentialServiceClientMockRecorder) GetCredentialByID(ctx, in any
 }
 
 // GetCredentials mocks base method.
-func (m *MockCredentialServiceClient) GetCredentials(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*proto.GetCredentialsResponse, error) {
+func (m *MockCredentialServiceClient) GetCredentials(ctx context.Context, in *proto.GetCredentialsRequest, opts ...grpc.CallOption) (*proto.GetCredentialsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
@@ -379,7 +378,7 @@ func (mr *MockCredentialServiceServerMockRecorder) GetCredentialByID(arg0, arg1
 }
 
 // GetCredentials mocks base method.
-func (m *MockCredentialServiceServer) GetCredentials(arg0 context.Context, arg1 *emptypb.Empty) (*proto.GetCredentialsResponse, error) {
+func (m *MockCredentialServiceServer) GetCredentials(arg0 context.Context, arg1 *proto.GetCredentialsRequest) (*proto.GetCredentialsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetCredentials", arg0, arg1)
 	ret0, _ := ret[0].(*proto.GetCredentialsResponse)
@@ -541,7 +540,7 @@ func (mr *MockBankCardServiceClientMockRecorder) GetBankCardByID(ctx, in any, op
 }
 
 // GetBankCards mocks base method.
-func (m *MockBankCardServiceClient) GetBankCards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*proto.GetBankCardsResponse, error) {
+func (m *MockBankCardServiceClient) GetBankCards(ctx context.Context, in *proto.GetBankCardsRequest, opts ...grpc.CallOption) (*proto.GetBankCardsResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
@@ -650,7 +649,7 @@ func (mr *MockBankCardServiceServerMockRecorder) GetBankCardByID(arg0, arg1 any)
 }
 
 // GetBankCards mocks base method.
-func (m *MockBankCardServiceServer) GetBankCards(arg0 context.Context, arg1 *emptypb.Empty) (*proto.GetBankCardsResponse, error) {
+func (m *MockBankCardServiceServer) GetBankCards(arg0 context.Context, arg1 *proto.GetBankCardsRequest) (*proto.GetBankCardsResponse, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetBankCards", arg0, arg1)
 	ret0, _ := ret[0].(*proto.GetBankCardsResponse)
@@ -1022,6 +1021,26 @@ func (m *MockBinaryDataServiceClient) EXPECT() *MockBinaryDataServiceClientMockR
 	return m.recorder
 }
 
+// CommitChunkedUpload mocks base method.
+func (m *MockBinaryDataServiceClient) CommitChunkedUpload(ctx context.Context, in *proto.CommitChunkedUploadRequest, opts ...grpc.CallOption) (*proto.CommitChunkedUploadResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CommitChunkedUpload", varargs...)
+	ret0, _ := ret[0].(*proto.CommitChunkedUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitChunkedUpload indicates an expected call of CommitChunkedUpload.
+func (mr *MockBinaryDataServiceClientMockRecorder) CommitChunkedUpload(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitChunkedUpload", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).CommitChunkedUpload), varargs...)
+}
+
 // DeleteBinaryData mocks base method.
 func (m *MockBinaryDataServiceClient) DeleteBinaryData(ctx context.Context, in *proto.DeleteBinaryDataRequest, opts ...grpc.CallOption) (*proto.DeleteBinaryDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1062,6 +1081,46 @@ func (mr *MockBinaryDataServiceClientMockRecorder) DownloadBinaryData(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadBinaryData", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).DownloadBinaryData), varargs...)
 }
 
+// DownloadChunk mocks base method.
+func (m *MockBinaryDataServiceClient) DownloadChunk(ctx context.Context, in *proto.DownloadChunkRequest, opts ...grpc.CallOption) (*proto.DownloadChunkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DownloadChunk", varargs...)
+	ret0, _ := ret[0].(*proto.DownloadChunkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadChunk indicates an expected call of DownloadChunk.
+func (mr *MockBinaryDataServiceClientMockRecorder) DownloadChunk(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadChunk", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).DownloadChunk), varargs...)
+}
+
+// FindMissingChunks mocks base method.
+func (m *MockBinaryDataServiceClient) FindMissingChunks(ctx context.Context, in *proto.FindMissingChunksRequest, opts ...grpc.CallOption) (*proto.FindMissingChunksResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindMissingChunks", varargs...)
+	ret0, _ := ret[0].(*proto.FindMissingChunksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMissingChunks indicates an expected call of FindMissingChunks.
+func (mr *MockBinaryDataServiceClientMockRecorder) FindMissingChunks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMissingChunks", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).FindMissingChunks), varargs...)
+}
+
 // GetBinaryDataInfo mocks base method.
 func (m *MockBinaryDataServiceClient) GetBinaryDataInfo(ctx context.Context, in *proto.GetBinaryDataInfoRequest, opts ...grpc.CallOption) (*proto.GetBinaryDataInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -1082,6 +1141,46 @@ func (mr *MockBinaryDataServiceClientMockRecorder) GetBinaryDataInfo(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBinaryDataInfo", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).GetBinaryDataInfo), varargs...)
 }
 
+// GetManifest mocks base method.
+func (m *MockBinaryDataServiceClient) GetManifest(ctx context.Context, in *proto.GetManifestRequest, opts ...grpc.CallOption) (*proto.GetManifestResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManifest", varargs...)
+	ret0, _ := ret[0].(*proto.GetManifestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManifest indicates an expected call of GetManifest.
+func (mr *MockBinaryDataServiceClientMockRecorder) GetManifest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManifest", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).GetManifest), varargs...)
+}
+
+// GetUploadStatus mocks base method.
+func (m *MockBinaryDataServiceClient) GetUploadStatus(ctx context.Context, in *proto.GetUploadStatusRequest, opts ...grpc.CallOption) (*proto.GetUploadStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUploadStatus", varargs...)
+	ret0, _ := ret[0].(*proto.GetUploadStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadStatus indicates an expected call of GetUploadStatus.
+func (mr *MockBinaryDataServiceClientMockRecorder) GetUploadStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadStatus", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).GetUploadStatus), varargs...)
+}
+
 // ListBinaryData mocks base method.
 func (m *MockBinaryDataServiceClient) ListBinaryData(ctx context.Context, in *proto.ListBinaryDataRequest, opts ...grpc.CallOption) (*proto.ListBinaryDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1102,6 +1201,46 @@ func (mr *MockBinaryDataServiceClientMockRecorder) ListBinaryData(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBinaryData", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).ListBinaryData), varargs...)
 }
 
+// ListBinaryVersions mocks base method.
+func (m *MockBinaryDataServiceClient) ListBinaryVersions(ctx context.Context, in *proto.ListBinaryVersionsRequest, opts ...grpc.CallOption) (*proto.ListBinaryVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBinaryVersions", varargs...)
+	ret0, _ := ret[0].(*proto.ListBinaryVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBinaryVersions indicates an expected call of ListBinaryVersions.
+func (mr *MockBinaryDataServiceClientMockRecorder) ListBinaryVersions(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBinaryVersions", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).ListBinaryVersions), varargs...)
+}
+
+// RestoreBinaryVersion mocks base method.
+func (m *MockBinaryDataServiceClient) RestoreBinaryVersion(ctx context.Context, in *proto.RestoreBinaryVersionRequest, opts ...grpc.CallOption) (*proto.RestoreBinaryVersionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreBinaryVersion", varargs...)
+	ret0, _ := ret[0].(*proto.RestoreBinaryVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBinaryVersion indicates an expected call of RestoreBinaryVersion.
+func (mr *MockBinaryDataServiceClientMockRecorder) RestoreBinaryVersion(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBinaryVersion", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).RestoreBinaryVersion), varargs...)
+}
+
 // SaveBinaryDataInfo mocks base method.
 func (m *MockBinaryDataServiceClient) SaveBinaryDataInfo(ctx context.Context, in *proto.SaveBinaryDataInfoRequest, opts ...grpc.CallOption) (*proto.SaveBinaryDataInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -1162,6 +1301,46 @@ func (mr *MockBinaryDataServiceClientMockRecorder) UploadBinaryData(ctx any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadBinaryData", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).UploadBinaryData), varargs...)
 }
 
+// UploadChunk mocks base method.
+func (m *MockBinaryDataServiceClient) UploadChunk(ctx context.Context, in *proto.UploadChunkRequest, opts ...grpc.CallOption) (*proto.UploadChunkResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UploadChunk", varargs...)
+	ret0, _ := ret[0].(*proto.UploadChunkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadChunk indicates an expected call of UploadChunk.
+func (mr *MockBinaryDataServiceClientMockRecorder) UploadChunk(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadChunk", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).UploadChunk), varargs...)
+}
+
+// VerifyBinaryData mocks base method.
+func (m *MockBinaryDataServiceClient) VerifyBinaryData(ctx context.Context, in *proto.VerifyBinaryDataRequest, opts ...grpc.CallOption) (*proto.VerifyBinaryDataResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyBinaryData", varargs...)
+	ret0, _ := ret[0].(*proto.VerifyBinaryDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyBinaryData indicates an expected call of VerifyBinaryData.
+func (mr *MockBinaryDataServiceClientMockRecorder) VerifyBinaryData(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyBinaryData", reflect.TypeOf((*MockBinaryDataServiceClient)(nil).VerifyBinaryData), varargs...)
+}
+
 // MockBinaryDataServiceServer is a mock of BinaryDataServiceServer interface.
 type MockBinaryDataServiceServer struct {
 	ctrl     *gomock.Controller
@@ -1186,6 +1365,21 @@ func (m *MockBinaryDataServiceServer) EXPECT() *MockBinaryDataServiceServerMockR
 	return m.recorder
 }
 
+// CommitChunkedUpload mocks base method.
+func (m *MockBinaryDataServiceServer) CommitChunkedUpload(arg0 context.Context, arg1 *proto.CommitChunkedUploadRequest) (*proto.CommitChunkedUploadResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitChunkedUpload", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CommitChunkedUploadResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CommitChunkedUpload indicates an expected call of CommitChunkedUpload.
+func (mr *MockBinaryDataServiceServerMockRecorder) CommitChunkedUpload(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitChunkedUpload", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).CommitChunkedUpload), arg0, arg1)
+}
+
 // DeleteBinaryData mocks base method.
 func (m *MockBinaryDataServiceServer) DeleteBinaryData(arg0 context.Context, arg1 *proto.DeleteBinaryDataRequest) (*proto.DeleteBinaryDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1215,6 +1409,36 @@ func (mr *MockBinaryDataServiceServerMockRecorder) DownloadBinaryData(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadBinaryData", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).DownloadBinaryData), arg0, arg1)
 }
 
+// DownloadChunk mocks base method.
+func (m *MockBinaryDataServiceServer) DownloadChunk(arg0 context.Context, arg1 *proto.DownloadChunkRequest) (*proto.DownloadChunkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadChunk", arg0, arg1)
+	ret0, _ := ret[0].(*proto.DownloadChunkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadChunk indicates an expected call of DownloadChunk.
+func (mr *MockBinaryDataServiceServerMockRecorder) DownloadChunk(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadChunk", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).DownloadChunk), arg0, arg1)
+}
+
+// FindMissingChunks mocks base method.
+func (m *MockBinaryDataServiceServer) FindMissingChunks(arg0 context.Context, arg1 *proto.FindMissingChunksRequest) (*proto.FindMissingChunksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMissingChunks", arg0, arg1)
+	ret0, _ := ret[0].(*proto.FindMissingChunksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindMissingChunks indicates an expected call of FindMissingChunks.
+func (mr *MockBinaryDataServiceServerMockRecorder) FindMissingChunks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMissingChunks", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).FindMissingChunks), arg0, arg1)
+}
+
 // GetBinaryDataInfo mocks base method.
 func (m *MockBinaryDataServiceServer) GetBinaryDataInfo(arg0 context.Context, arg1 *proto.GetBinaryDataInfoRequest) (*proto.GetBinaryDataInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -1230,6 +1454,36 @@ func (mr *MockBinaryDataServiceServerMockRecorder) GetBinaryDataInfo(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBinaryDataInfo", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).GetBinaryDataInfo), arg0, arg1)
 }
 
+// GetManifest mocks base method.
+func (m *MockBinaryDataServiceServer) GetManifest(arg0 context.Context, arg1 *proto.GetManifestRequest) (*proto.GetManifestResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManifest", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetManifestResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManifest indicates an expected call of GetManifest.
+func (mr *MockBinaryDataServiceServerMockRecorder) GetManifest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManifest", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).GetManifest), arg0, arg1)
+}
+
+// GetUploadStatus mocks base method.
+func (m *MockBinaryDataServiceServer) GetUploadStatus(arg0 context.Context, arg1 *proto.GetUploadStatusRequest) (*proto.GetUploadStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadStatus", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetUploadStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadStatus indicates an expected call of GetUploadStatus.
+func (mr *MockBinaryDataServiceServerMockRecorder) GetUploadStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadStatus", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).GetUploadStatus), arg0, arg1)
+}
+
 // ListBinaryData mocks base method.
 func (m *MockBinaryDataServiceServer) ListBinaryData(arg0 context.Context, arg1 *proto.ListBinaryDataRequest) (*proto.ListBinaryDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -1245,6 +1499,36 @@ func (mr *MockBinaryDataServiceServerMockRecorder) ListBinaryData(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBinaryData", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).ListBinaryData), arg0, arg1)
 }
 
+// ListBinaryVersions mocks base method.
+func (m *MockBinaryDataServiceServer) ListBinaryVersions(arg0 context.Context, arg1 *proto.ListBinaryVersionsRequest) (*proto.ListBinaryVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBinaryVersions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListBinaryVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBinaryVersions indicates an expected call of ListBinaryVersions.
+func (mr *MockBinaryDataServiceServerMockRecorder) ListBinaryVersions(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBinaryVersions", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).ListBinaryVersions), arg0, arg1)
+}
+
+// RestoreBinaryVersion mocks base method.
+func (m *MockBinaryDataServiceServer) RestoreBinaryVersion(arg0 context.Context, arg1 *proto.RestoreBinaryVersionRequest) (*proto.RestoreBinaryVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBinaryVersion", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RestoreBinaryVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBinaryVersion indicates an expected call of RestoreBinaryVersion.
+func (mr *MockBinaryDataServiceServerMockRecorder) RestoreBinaryVersion(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBinaryVersion", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).RestoreBinaryVersion), arg0, arg1)
+}
+
 // SaveBinaryDataInfo mocks base method.
 func (m *MockBinaryDataServiceServer) SaveBinaryDataInfo(arg0 context.Context, arg1 *proto.SaveBinaryDataInfoRequest) (*proto.SaveBinaryDataInfoResponse, error) {
 	m.ctrl.T.Helper()
@@ -1289,6 +1573,36 @@ func (mr *MockBinaryDataServiceServerMockRecorder) UploadBinaryData(arg0 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadBinaryData", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).UploadBinaryData), arg0)
 }
 
+// UploadChunk mocks base method.
+func (m *MockBinaryDataServiceServer) UploadChunk(arg0 context.Context, arg1 *proto.UploadChunkRequest) (*proto.UploadChunkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadChunk", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UploadChunkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadChunk indicates an expected call of UploadChunk.
+func (mr *MockBinaryDataServiceServerMockRecorder) UploadChunk(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadChunk", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).UploadChunk), arg0, arg1)
+}
+
+// VerifyBinaryData mocks base method.
+func (m *MockBinaryDataServiceServer) VerifyBinaryData(arg0 context.Context, arg1 *proto.VerifyBinaryDataRequest) (*proto.VerifyBinaryDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyBinaryData", arg0, arg1)
+	ret0, _ := ret[0].(*proto.VerifyBinaryDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyBinaryData indicates an expected call of VerifyBinaryData.
+func (mr *MockBinaryDataServiceServerMockRecorder) VerifyBinaryData(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyBinaryData", reflect.TypeOf((*MockBinaryDataServiceServer)(nil).VerifyBinaryData), arg0, arg1)
+}
+
 // mustEmbedUnimplementedBinaryDataServiceServer mocks base method.
 func (m *MockBinaryDataServiceServer) mustEmbedUnimplementedBinaryDataServiceServer() {
 	m.ctrl.T.Helper()
@@ -1336,3 +1650,701 @@ func (mr *MockUnsafeBinaryDataServiceServerMockRecorder) mustEmbedUnimplementedB
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedBinaryDataServiceServer", reflect.TypeOf((*MockUnsafeBinaryDataServiceServer)(nil).mustEmbedUnimplementedBinaryDataServiceServer))
 }
+
+// MockItemServiceClient is a mock of ItemServiceClient interface.
+type MockItemServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockItemServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockItemServiceClientMockRecorder is the mock recorder for MockItemServiceClient.
+type MockItemServiceClientMockRecorder struct {
+	mock *MockItemServiceClient
+}
+
+// NewMockItemServiceClient creates a new mock instance.
+func NewMockItemServiceClient(ctrl *gomock.Controller) *MockItemServiceClient {
+	mock := &MockItemServiceClient{ctrl: ctrl}
+	mock.recorder = &MockItemServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockItemServiceClient) EXPECT() *MockItemServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetUsage mocks base method.
+func (m *MockItemServiceClient) GetUsage(ctx context.Context, in *proto.GetUsageRequest, opts ...grpc.CallOption) (*proto.GetUsageResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUsage", varargs...)
+	ret0, _ := ret[0].(*proto.GetUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockItemServiceClientMockRecorder) GetUsage(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockItemServiceClient)(nil).GetUsage), varargs...)
+}
+
+// ListFavoritesRecent mocks base method.
+func (m *MockItemServiceClient) ListFavoritesRecent(ctx context.Context, in *proto.ListFavoritesRecentRequest, opts ...grpc.CallOption) (*proto.ListFavoritesRecentResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFavoritesRecent", varargs...)
+	ret0, _ := ret[0].(*proto.ListFavoritesRecentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavoritesRecent indicates an expected call of ListFavoritesRecent.
+func (mr *MockItemServiceClientMockRecorder) ListFavoritesRecent(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavoritesRecent", reflect.TypeOf((*MockItemServiceClient)(nil).ListFavoritesRecent), varargs...)
+}
+
+// MarkAccessed mocks base method.
+func (m *MockItemServiceClient) MarkAccessed(ctx context.Context, in *proto.MarkAccessedRequest, opts ...grpc.CallOption) (*proto.MarkAccessedResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MarkAccessed", varargs...)
+	ret0, _ := ret[0].(*proto.MarkAccessedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAccessed indicates an expected call of MarkAccessed.
+func (mr *MockItemServiceClientMockRecorder) MarkAccessed(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAccessed", reflect.TypeOf((*MockItemServiceClient)(nil).MarkAccessed), varargs...)
+}
+
+// SetFavorite mocks base method.
+func (m *MockItemServiceClient) SetFavorite(ctx context.Context, in *proto.SetFavoriteRequest, opts ...grpc.CallOption) (*proto.SetFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetFavorite", varargs...)
+	ret0, _ := ret[0].(*proto.SetFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFavorite indicates an expected call of SetFavorite.
+func (mr *MockItemServiceClientMockRecorder) SetFavorite(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavorite", reflect.TypeOf((*MockItemServiceClient)(nil).SetFavorite), varargs...)
+}
+
+// MockItemServiceServer is a mock of ItemServiceServer interface.
+type MockItemServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockItemServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockItemServiceServerMockRecorder is the mock recorder for MockItemServiceServer.
+type MockItemServiceServerMockRecorder struct {
+	mock *MockItemServiceServer
+}
+
+// NewMockItemServiceServer creates a new mock instance.
+func NewMockItemServiceServer(ctrl *gomock.Controller) *MockItemServiceServer {
+	mock := &MockItemServiceServer{ctrl: ctrl}
+	mock.recorder = &MockItemServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockItemServiceServer) EXPECT() *MockItemServiceServerMockRecorder {
+	return m.recorder
+}
+
+// GetUsage mocks base method.
+func (m *MockItemServiceServer) GetUsage(arg0 context.Context, arg1 *proto.GetUsageRequest) (*proto.GetUsageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsage", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetUsageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsage indicates an expected call of GetUsage.
+func (mr *MockItemServiceServerMockRecorder) GetUsage(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsage", reflect.TypeOf((*MockItemServiceServer)(nil).GetUsage), arg0, arg1)
+}
+
+// ListFavoritesRecent mocks base method.
+func (m *MockItemServiceServer) ListFavoritesRecent(arg0 context.Context, arg1 *proto.ListFavoritesRecentRequest) (*proto.ListFavoritesRecentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFavoritesRecent", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListFavoritesRecentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFavoritesRecent indicates an expected call of ListFavoritesRecent.
+func (mr *MockItemServiceServerMockRecorder) ListFavoritesRecent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFavoritesRecent", reflect.TypeOf((*MockItemServiceServer)(nil).ListFavoritesRecent), arg0, arg1)
+}
+
+// MarkAccessed mocks base method.
+func (m *MockItemServiceServer) MarkAccessed(arg0 context.Context, arg1 *proto.MarkAccessedRequest) (*proto.MarkAccessedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAccessed", arg0, arg1)
+	ret0, _ := ret[0].(*proto.MarkAccessedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAccessed indicates an expected call of MarkAccessed.
+func (mr *MockItemServiceServerMockRecorder) MarkAccessed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAccessed", reflect.TypeOf((*MockItemServiceServer)(nil).MarkAccessed), arg0, arg1)
+}
+
+// SetFavorite mocks base method.
+func (m *MockItemServiceServer) SetFavorite(arg0 context.Context, arg1 *proto.SetFavoriteRequest) (*proto.SetFavoriteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFavorite", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SetFavoriteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetFavorite indicates an expected call of SetFavorite.
+func (mr *MockItemServiceServerMockRecorder) SetFavorite(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFavorite", reflect.TypeOf((*MockItemServiceServer)(nil).SetFavorite), arg0, arg1)
+}
+
+// mustEmbedUnimplementedItemServiceServer mocks base method.
+func (m *MockItemServiceServer) mustEmbedUnimplementedItemServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedItemServiceServer")
+}
+
+// mustEmbedUnimplementedItemServiceServer indicates an expected call of mustEmbedUnimplementedItemServiceServer.
+func (mr *MockItemServiceServerMockRecorder) mustEmbedUnimplementedItemServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedItemServiceServer", reflect.TypeOf((*MockItemServiceServer)(nil).mustEmbedUnimplementedItemServiceServer))
+}
+
+// MockUnsafeItemServiceServer is a mock of UnsafeItemServiceServer interface.
+type MockUnsafeItemServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeItemServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockUnsafeItemServiceServerMockRecorder is the mock recorder for MockUnsafeItemServiceServer.
+type MockUnsafeItemServiceServerMockRecorder struct {
+	mock *MockUnsafeItemServiceServer
+}
+
+// NewMockUnsafeItemServiceServer creates a new mock instance.
+func NewMockUnsafeItemServiceServer(ctrl *gomock.Controller) *MockUnsafeItemServiceServer {
+	mock := &MockUnsafeItemServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeItemServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeItemServiceServer) EXPECT() *MockUnsafeItemServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedItemServiceServer mocks base method.
+func (m *MockUnsafeItemServiceServer) mustEmbedUnimplementedItemServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedItemServiceServer")
+}
+
+// mustEmbedUnimplementedItemServiceServer indicates an expected call of mustEmbedUnimplementedItemServiceServer.
+func (mr *MockUnsafeItemServiceServerMockRecorder) mustEmbedUnimplementedItemServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedItemServiceServer", reflect.TypeOf((*MockUnsafeItemServiceServer)(nil).mustEmbedUnimplementedItemServiceServer))
+}
+
+// MockSyncServiceClient is a mock of SyncServiceClient interface.
+type MockSyncServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncServiceClientMockRecorder is the mock recorder for MockSyncServiceClient.
+type MockSyncServiceClientMockRecorder struct {
+	mock *MockSyncServiceClient
+}
+
+// NewMockSyncServiceClient creates a new mock instance.
+func NewMockSyncServiceClient(ctrl *gomock.Controller) *MockSyncServiceClient {
+	mock := &MockSyncServiceClient{ctrl: ctrl}
+	mock.recorder = &MockSyncServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncServiceClient) EXPECT() *MockSyncServiceClientMockRecorder {
+	return m.recorder
+}
+
+// ListChanges mocks base method.
+func (m *MockSyncServiceClient) ListChanges(ctx context.Context, in *proto.ListChangesRequest, opts ...grpc.CallOption) (*proto.ListChangesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListChanges", varargs...)
+	ret0, _ := ret[0].(*proto.ListChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChanges indicates an expected call of ListChanges.
+func (mr *MockSyncServiceClientMockRecorder) ListChanges(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChanges", reflect.TypeOf((*MockSyncServiceClient)(nil).ListChanges), varargs...)
+}
+
+// WatchChanges mocks base method.
+func (m *MockSyncServiceClient) WatchChanges(ctx context.Context, in *proto.WatchChangesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[proto.ChangeEvent], error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "WatchChanges", varargs...)
+	ret0, _ := ret[0].(grpc.ServerStreamingClient[proto.ChangeEvent])
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WatchChanges indicates an expected call of WatchChanges.
+func (mr *MockSyncServiceClientMockRecorder) WatchChanges(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchChanges", reflect.TypeOf((*MockSyncServiceClient)(nil).WatchChanges), varargs...)
+}
+
+// MockSyncServiceServer is a mock of SyncServiceServer interface.
+type MockSyncServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockSyncServiceServerMockRecorder is the mock recorder for MockSyncServiceServer.
+type MockSyncServiceServerMockRecorder struct {
+	mock *MockSyncServiceServer
+}
+
+// NewMockSyncServiceServer creates a new mock instance.
+func NewMockSyncServiceServer(ctrl *gomock.Controller) *MockSyncServiceServer {
+	mock := &MockSyncServiceServer{ctrl: ctrl}
+	mock.recorder = &MockSyncServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncServiceServer) EXPECT() *MockSyncServiceServerMockRecorder {
+	return m.recorder
+}
+
+// ListChanges mocks base method.
+func (m *MockSyncServiceServer) ListChanges(arg0 context.Context, arg1 *proto.ListChangesRequest) (*proto.ListChangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChanges", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListChangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChanges indicates an expected call of ListChanges.
+func (mr *MockSyncServiceServerMockRecorder) ListChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChanges", reflect.TypeOf((*MockSyncServiceServer)(nil).ListChanges), arg0, arg1)
+}
+
+// WatchChanges mocks base method.
+func (m *MockSyncServiceServer) WatchChanges(arg0 *proto.WatchChangesRequest, arg1 grpc.ServerStreamingServer[proto.ChangeEvent]) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchChanges", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WatchChanges indicates an expected call of WatchChanges.
+func (mr *MockSyncServiceServerMockRecorder) WatchChanges(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchChanges", reflect.TypeOf((*MockSyncServiceServer)(nil).WatchChanges), arg0, arg1)
+}
+
+// mustEmbedUnimplementedSyncServiceServer mocks base method.
+func (m *MockSyncServiceServer) mustEmbedUnimplementedSyncServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedSyncServiceServer")
+}
+
+// mustEmbedUnimplementedSyncServiceServer indicates an expected call of mustEmbedUnimplementedSyncServiceServer.
+func (mr *MockSyncServiceServerMockRecorder) mustEmbedUnimplementedSyncServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedSyncServiceServer", reflect.TypeOf((*MockSyncServiceServer)(nil).mustEmbedUnimplementedSyncServiceServer))
+}
+
+// MockUnsafeSyncServiceServer is a mock of UnsafeSyncServiceServer interface.
+type MockUnsafeSyncServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeSyncServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockUnsafeSyncServiceServerMockRecorder is the mock recorder for MockUnsafeSyncServiceServer.
+type MockUnsafeSyncServiceServerMockRecorder struct {
+	mock *MockUnsafeSyncServiceServer
+}
+
+// NewMockUnsafeSyncServiceServer creates a new mock instance.
+func NewMockUnsafeSyncServiceServer(ctrl *gomock.Controller) *MockUnsafeSyncServiceServer {
+	mock := &MockUnsafeSyncServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeSyncServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeSyncServiceServer) EXPECT() *MockUnsafeSyncServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedSyncServiceServer mocks base method.
+func (m *MockUnsafeSyncServiceServer) mustEmbedUnimplementedSyncServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedSyncServiceServer")
+}
+
+// mustEmbedUnimplementedSyncServiceServer indicates an expected call of mustEmbedUnimplementedSyncServiceServer.
+func (mr *MockUnsafeSyncServiceServerMockRecorder) mustEmbedUnimplementedSyncServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedSyncServiceServer", reflect.TypeOf((*MockUnsafeSyncServiceServer)(nil).mustEmbedUnimplementedSyncServiceServer))
+}
+
+// MockBatchServiceClient is a mock of BatchServiceClient interface.
+type MockBatchServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchServiceClientMockRecorder is the mock recorder for MockBatchServiceClient.
+type MockBatchServiceClientMockRecorder struct {
+	mock *MockBatchServiceClient
+}
+
+// NewMockBatchServiceClient creates a new mock instance.
+func NewMockBatchServiceClient(ctrl *gomock.Controller) *MockBatchServiceClient {
+	mock := &MockBatchServiceClient{ctrl: ctrl}
+	mock.recorder = &MockBatchServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchServiceClient) EXPECT() *MockBatchServiceClientMockRecorder {
+	return m.recorder
+}
+
+// BatchMutate mocks base method.
+func (m *MockBatchServiceClient) BatchMutate(ctx context.Context, in *proto.BatchMutateRequest, opts ...grpc.CallOption) (*proto.BatchMutateResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "BatchMutate", varargs...)
+	ret0, _ := ret[0].(*proto.BatchMutateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchMutate indicates an expected call of BatchMutate.
+func (mr *MockBatchServiceClientMockRecorder) BatchMutate(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchMutate", reflect.TypeOf((*MockBatchServiceClient)(nil).BatchMutate), varargs...)
+}
+
+// MockBatchServiceServer is a mock of BatchServiceServer interface.
+type MockBatchServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchServiceServerMockRecorder is the mock recorder for MockBatchServiceServer.
+type MockBatchServiceServerMockRecorder struct {
+	mock *MockBatchServiceServer
+}
+
+// NewMockBatchServiceServer creates a new mock instance.
+func NewMockBatchServiceServer(ctrl *gomock.Controller) *MockBatchServiceServer {
+	mock := &MockBatchServiceServer{ctrl: ctrl}
+	mock.recorder = &MockBatchServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchServiceServer) EXPECT() *MockBatchServiceServerMockRecorder {
+	return m.recorder
+}
+
+// BatchMutate mocks base method.
+func (m *MockBatchServiceServer) BatchMutate(arg0 context.Context, arg1 *proto.BatchMutateRequest) (*proto.BatchMutateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BatchMutate", arg0, arg1)
+	ret0, _ := ret[0].(*proto.BatchMutateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BatchMutate indicates an expected call of BatchMutate.
+func (mr *MockBatchServiceServerMockRecorder) BatchMutate(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BatchMutate", reflect.TypeOf((*MockBatchServiceServer)(nil).BatchMutate), arg0, arg1)
+}
+
+// mustEmbedUnimplementedBatchServiceServer mocks base method.
+func (m *MockBatchServiceServer) mustEmbedUnimplementedBatchServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedBatchServiceServer")
+}
+
+// mustEmbedUnimplementedBatchServiceServer indicates an expected call of mustEmbedUnimplementedBatchServiceServer.
+func (mr *MockBatchServiceServerMockRecorder) mustEmbedUnimplementedBatchServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedBatchServiceServer", reflect.TypeOf((*MockBatchServiceServer)(nil).mustEmbedUnimplementedBatchServiceServer))
+}
+
+// MockUnsafeBatchServiceServer is a mock of UnsafeBatchServiceServer interface.
+type MockUnsafeBatchServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeBatchServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockUnsafeBatchServiceServerMockRecorder is the mock recorder for MockUnsafeBatchServiceServer.
+type MockUnsafeBatchServiceServerMockRecorder struct {
+	mock *MockUnsafeBatchServiceServer
+}
+
+// NewMockUnsafeBatchServiceServer creates a new mock instance.
+func NewMockUnsafeBatchServiceServer(ctrl *gomock.Controller) *MockUnsafeBatchServiceServer {
+	mock := &MockUnsafeBatchServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeBatchServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeBatchServiceServer) EXPECT() *MockUnsafeBatchServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedBatchServiceServer mocks base method.
+func (m *MockUnsafeBatchServiceServer) mustEmbedUnimplementedBatchServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedBatchServiceServer")
+}
+
+// mustEmbedUnimplementedBatchServiceServer indicates an expected call of mustEmbedUnimplementedBatchServiceServer.
+func (mr *MockUnsafeBatchServiceServerMockRecorder) mustEmbedUnimplementedBatchServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedBatchServiceServer", reflect.TypeOf((*MockUnsafeBatchServiceServer)(nil).mustEmbedUnimplementedBatchServiceServer))
+}
+
+// MockAdminServiceClient is a mock of AdminServiceClient interface.
+type MockAdminServiceClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceClientMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminServiceClientMockRecorder is the mock recorder for MockAdminServiceClient.
+type MockAdminServiceClientMockRecorder struct {
+	mock *MockAdminServiceClient
+}
+
+// NewMockAdminServiceClient creates a new mock instance.
+func NewMockAdminServiceClient(ctrl *gomock.Controller) *MockAdminServiceClient {
+	mock := &MockAdminServiceClient{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminServiceClient) EXPECT() *MockAdminServiceClientMockRecorder {
+	return m.recorder
+}
+
+// GetScrubReport mocks base method.
+func (m *MockAdminServiceClient) GetScrubReport(ctx context.Context, in *proto.GetScrubReportRequest, opts ...grpc.CallOption) (*proto.GetScrubReportResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetScrubReport", varargs...)
+	ret0, _ := ret[0].(*proto.GetScrubReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScrubReport indicates an expected call of GetScrubReport.
+func (mr *MockAdminServiceClientMockRecorder) GetScrubReport(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScrubReport", reflect.TypeOf((*MockAdminServiceClient)(nil).GetScrubReport), varargs...)
+}
+
+// RunScrub mocks base method.
+func (m *MockAdminServiceClient) RunScrub(ctx context.Context, in *proto.RunScrubRequest, opts ...grpc.CallOption) (*proto.RunScrubResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RunScrub", varargs...)
+	ret0, _ := ret[0].(*proto.RunScrubResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunScrub indicates an expected call of RunScrub.
+func (mr *MockAdminServiceClientMockRecorder) RunScrub(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunScrub", reflect.TypeOf((*MockAdminServiceClient)(nil).RunScrub), varargs...)
+}
+
+// MockAdminServiceServer is a mock of AdminServiceServer interface.
+type MockAdminServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAdminServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockAdminServiceServerMockRecorder is the mock recorder for MockAdminServiceServer.
+type MockAdminServiceServerMockRecorder struct {
+	mock *MockAdminServiceServer
+}
+
+// NewMockAdminServiceServer creates a new mock instance.
+func NewMockAdminServiceServer(ctrl *gomock.Controller) *MockAdminServiceServer {
+	mock := &MockAdminServiceServer{ctrl: ctrl}
+	mock.recorder = &MockAdminServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAdminServiceServer) EXPECT() *MockAdminServiceServerMockRecorder {
+	return m.recorder
+}
+
+// GetScrubReport mocks base method.
+func (m *MockAdminServiceServer) GetScrubReport(arg0 context.Context, arg1 *proto.GetScrubReportRequest) (*proto.GetScrubReportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScrubReport", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetScrubReportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScrubReport indicates an expected call of GetScrubReport.
+func (mr *MockAdminServiceServerMockRecorder) GetScrubReport(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScrubReport", reflect.TypeOf((*MockAdminServiceServer)(nil).GetScrubReport), arg0, arg1)
+}
+
+// RunScrub mocks base method.
+func (m *MockAdminServiceServer) RunScrub(arg0 context.Context, arg1 *proto.RunScrubRequest) (*proto.RunScrubResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunScrub", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RunScrubResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunScrub indicates an expected call of RunScrub.
+func (mr *MockAdminServiceServerMockRecorder) RunScrub(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunScrub", reflect.TypeOf((*MockAdminServiceServer)(nil).RunScrub), arg0, arg1)
+}
+
+// mustEmbedUnimplementedAdminServiceServer mocks base method.
+func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedAdminServiceServer")
+}
+
+// mustEmbedUnimplementedAdminServiceServer indicates an expected call of mustEmbedUnimplementedAdminServiceServer.
+func (mr *MockAdminServiceServerMockRecorder) mustEmbedUnimplementedAdminServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAdminServiceServer", reflect.TypeOf((*MockAdminServiceServer)(nil).mustEmbedUnimplementedAdminServiceServer))
+}
+
+// MockUnsafeAdminServiceServer is a mock of UnsafeAdminServiceServer interface.
+type MockUnsafeAdminServiceServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeAdminServiceServerMockRecorder
+	isgomock struct{}
+}
+
+// MockUnsafeAdminServiceServerMockRecorder is the mock recorder for MockUnsafeAdminServiceServer.
+type MockUnsafeAdminServiceServerMockRecorder struct {
+	mock *MockUnsafeAdminServiceServer
+}
+
+// NewMockUnsafeAdminServiceServer creates a new mock instance.
+func NewMockUnsafeAdminServiceServer(ctrl *gomock.Controller) *MockUnsafeAdminServiceServer {
+	mock := &MockUnsafeAdminServiceServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeAdminServiceServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeAdminServiceServer) EXPECT() *MockUnsafeAdminServiceServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedAdminServiceServer mocks base method.
+func (m *MockUnsafeAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedAdminServiceServer")
+}
+
+// mustEmbedUnimplementedAdminServiceServer indicates an expected call of mustEmbedUnimplementedAdminServiceServer.
+func (mr *MockUnsafeAdminServiceServerMockRecorder) mustEmbedUnimplementedAdminServiceServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAdminServiceServer", reflect.TypeOf((*MockUnsafeAdminServiceServer)(nil).mustEmbedUnimplementedAdminServiceServer))
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +20,7 @@ func TestConfig(t *testing.T) {
 		require.NoError(t, err)
 		require.Equal(t, "localhost:50051", cfg.GRPCServerAddr)
 		require.Equal(t, "info", cfg.LogLevel)
+		require.Equal(t, DBDriverPostgres, cfg.DBDriver)
 		require.Equal(t, false, cfg.EnableTLS)
 	})
 
@@ -139,6 +141,160 @@ func TestConfig(t *testing.T) {
 		}
 	})
 
+	t.Run("S3 backend from env", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("s3_env", flag.PanicOnError)
+		os.Args = []string{"cmd"}
+		t.Setenv("BINARY_DATA_BACKEND", "s3")
+		t.Setenv("S3_ENDPOINT", "http://localhost:9000")
+		t.Setenv("S3_BUCKET", "gophkeeper")
+		t.Setenv("S3_ACCESS_KEY", "access")
+		t.Setenv("S3_SECRET_KEY", "secret")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.Equal(t, BinaryBackendS3, cfg.BinaryDataBackend)
+		require.Equal(t, "http://localhost:9000", cfg.S3Endpoint)
+		require.Equal(t, "us-east-1", cfg.S3Region)
+		require.Equal(t, "gophkeeper", cfg.S3Bucket)
+		require.Equal(t, "access", cfg.S3AccessKey)
+		require.Equal(t, "secret", cfg.S3SecretKey)
+	})
+
+	t.Run("Scrub settings from env", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("scrub_env", flag.PanicOnError)
+		os.Args = []string{"cmd"}
+		t.Setenv("SCRUB_INTERVAL", "6h")
+		t.Setenv("SCRUB_FIX", "true")
+		t.Setenv("ADMIN_TOKEN", "admin-secret")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		period, err := cfg.ScrubPeriod()
+		require.NoError(t, err)
+		require.Equal(t, 6*time.Hour, period)
+		require.True(t, cfg.ScrubFix)
+		require.False(t, cfg.ScrubRehash)
+		require.Equal(t, "admin-secret", cfg.AdminToken)
+	})
+
+	t.Run("Invalid scrub interval", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("scrub_invalid", flag.PanicOnError)
+		os.Args = []string{"cmd"}
+		t.Setenv("SCRUB_INTERVAL", "daily")
+
+		_, err := Load()
+		require.Error(t, err)
+	})
+
+	t.Run("User quotas from env", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("quota_env", flag.PanicOnError)
+		os.Args = []string{"cmd"}
+		t.Setenv("USER_QUOTA_BYTES", "1073741824")
+		t.Setenv("USER_ITEM_LIMIT", "500")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.Equal(t, int64(1073741824), cfg.UserQuotaBytes)
+		require.Equal(t, int64(500), cfg.UserItemLimit)
+	})
+
+	t.Run("Binary versions from env", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("versions_env", flag.PanicOnError)
+		os.Args = []string{"cmd"}
+		t.Setenv("BINARY_VERSIONS", "5")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.Equal(t, 5, cfg.BinaryVersions)
+
+		t.Setenv("BINARY_VERSIONS", "-1")
+		flag.CommandLine = flag.NewFlagSet("versions_invalid", flag.PanicOnError)
+		_, err = Load()
+		require.Error(t, err)
+	})
+
+	t.Run("Storage keys from env", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("storage_keys_env", flag.PanicOnError)
+		os.Args = []string{"cmd", "-storage-key-file", "/etc/gophkeeper/keys"}
+		t.Setenv("STORAGE_KEYS", "aa,bb")
+		t.Setenv("STORAGE_REENCRYPT", "true")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.Equal(t, "aa,bb", cfg.StorageKeys)
+		require.Equal(t, "/etc/gophkeeper/keys", cfg.StorageKeyFile)
+		require.True(t, cfg.StorageReencrypt)
+
+		t.Setenv("STORAGE_REENCRYPT", "maybe")
+		flag.CommandLine = flag.NewFlagSet("storage_reencrypt_invalid", flag.PanicOnError)
+		_, err = Load()
+		require.Error(t, err)
+	})
+
+	t.Run("Database driver from env", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("db_driver_env", flag.PanicOnError)
+		os.Args = []string{"cmd", "-db", "/var/lib/gophkeeper/keeper.db"}
+		t.Setenv("DATABASE_DRIVER", "sqlite")
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.Equal(t, DBDriverSQLite, cfg.DBDriver)
+		require.Equal(t, "/var/lib/gophkeeper/keeper.db", cfg.DBConnect)
+
+		t.Setenv("DATABASE_DRIVER", "oracle")
+		flag.CommandLine = flag.NewFlagSet("db_driver_invalid", flag.PanicOnError)
+		_, err = Load()
+		require.Error(t, err)
+	})
+
+	t.Run("In-memory mode", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("in_memory", flag.PanicOnError)
+		os.Args = []string{"cmd", "-in-memory", "-seed", "demo.json"}
+
+		cfg, err := Load()
+		require.NoError(t, err)
+		require.Equal(t, DBDriverMemory, cfg.DBDriver)
+		require.Equal(t, BinaryBackendMemory, cfg.BinaryDataBackend)
+		require.Equal(t, "demo.json", cfg.SeedFile)
+
+		// начальные данные загружаются только в хранилище в памяти
+		flag.CommandLine = flag.NewFlagSet("seed_without_memory", flag.PanicOnError)
+		os.Args = []string{"cmd", "-seed", "demo.json"}
+		_, err = Load()
+		require.Error(t, err)
+
+		t.Setenv("IN_MEMORY", "yes please")
+		flag.CommandLine = flag.NewFlagSet("in_memory_invalid", flag.PanicOnError)
+		os.Args = []string{"cmd"}
+		_, err = Load()
+		require.Error(t, err)
+	})
+
+	t.Run("Negative user quota", func(t *testing.T) {
+		flag.CommandLine = flag.NewFlagSet("quota_invalid", flag.PanicOnError)
+		os.Args = []string{"cmd"}
+		t.Setenv("USER_QUOTA_BYTES", "-1")
+
+		_, err := Load()
+		require.Error(t, err)
+	})
+
+	t.Run("validateBinaryBackend", func(t *testing.T) {
+		require.NoError(t, validateBinaryBackend(&Config{BinaryDataBackend: BinaryBackendFS}))
+		require.NoError(t, validateBinaryBackend(&Config{
+			BinaryDataBackend: BinaryBackendS3, S3Endpoint: "http://localhost:9000", S3Bucket: "b",
+		}))
+		require.Error(t, validateBinaryBackend(&Config{BinaryDataBackend: BinaryBackendS3, S3Bucket: "b"}))
+		require.Error(t, validateBinaryBackend(&Config{BinaryDataBackend: BinaryBackendS3, S3Endpoint: "http://localhost:9000"}))
+		require.Error(t, validateBinaryBackend(&Config{BinaryDataBackend: "ftp"}))
+		require.NoError(t, validateBinaryBackend(&Config{BinaryDataBackend: BinaryBackendFS, StorageKeyFile: "keys"}))
+		require.Error(t, validateBinaryBackend(&Config{
+			BinaryDataBackend: BinaryBackendS3, S3Endpoint: "http://localhost:9000", S3Bucket: "b", StorageKeys: "00",
+		}))
+		require.NoError(t, validateBinaryBackend(&Config{BinaryDataBackend: BinaryBackendMemory}))
+		require.Error(t, validateBinaryBackend(&Config{BinaryDataBackend: BinaryBackendMemory, StorageKeys: "00"}))
+	})
+
 	t.Run("mergeConfigs", func(t *testing.T) {
 		dst := &Config{
 			GRPCServerAddr: "default:1234",
@@ -0,0 +1,178 @@
+// Package embedded запускает сервер GophKeeper внутри процесса клиента
+// (персональный режим, в котором не нужны отдельный сервер и PostgreSQL).
+//
+// Сервер работает с теми же сервисами и gRPC-обработчиками, что и отдельный
+// сервер, но записи хранит во встроенной базе SQLite (драйвер на чистом Go,
+// клиент собирается без cgo), а файлы — в локальной директории. Соединение
+// с клиентом идёт через буфер в памяти (bufconn) и наружу не открывается.
+// Клиент шифрует данные так же, как при работе с отдельным сервером, поэтому
+// на диске хранятся только шифртексты.
+//
+// Все данные лежат в одной директории:
+//
+//	keeper.db — база SQLite;
+//	binary/   — содержимое файлов;
+//	jwt.key   — секрет подписи токенов (создаётся при первом запуске, чтобы
+//	            вход сохранялся между запусками клиента).
+package embedded
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	"github.com/ryabkov82/gophkeeper/internal/server"
+	"github.com/ryabkov82/gophkeeper/internal/server/config"
+	srvgrpc "github.com/ryabkov82/gophkeeper/internal/server/grpc"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Address — адрес, под которым клиент видит встроенный сервер. Реальное
+// соединение устанавливает Server.Dial, адрес служит только для логов.
+const Address = "embedded"
+
+const (
+	dbFileName     = "keeper.db"
+	binaryDirName  = "binary"
+	jwtKeyFileName = "jwt.key"
+
+	// bufferSize — размер буфера соединения bufconn.
+	bufferSize = 1 << 20
+
+	// shutdownTimeout — сколько Close ждёт завершения активных вызовов.
+	shutdownTimeout = 5 * time.Second
+)
+
+// Server — сервер GophKeeper, работающий в процессе клиента.
+type Server struct {
+	lis      *bufconn.Listener
+	srv      *grpc.Server
+	services service.ServiceFactory
+	log      *zap.Logger
+	done     chan struct{}
+}
+
+// Start создаёт директорию dataDir (если её нет), открывает в ней хранилища
+// и запускает встроенный сервер.
+func Start(log *zap.Logger, dataDir string) (*Server, error) {
+	cfg, err := Config(dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := server.NewServices(log, cfg)
+	if err != nil {
+		return nil, err
+	}
+	srv, err := srvgrpc.NewGRPCServer(cfg, log, services)
+	if err != nil {
+		services.Close()
+		return nil, err
+	}
+
+	s := &Server{
+		lis:      bufconn.Listen(bufferSize),
+		srv:      srv,
+		services: services,
+		log:      log,
+		done:     make(chan struct{}),
+	}
+	go func() {
+		defer close(s.done)
+		if err := srv.Serve(s.lis); err != nil {
+			log.Error("Embedded gRPC server stopped", zap.Error(err))
+		}
+	}()
+	log.Info("Embedded server started", zap.String("dir", dataDir))
+	return s, nil
+}
+
+// Config возвращает конфигурацию встроенного сервера с данными в dataDir.
+// Директория и секрет подписи токенов создаются при первом вызове.
+func Config(dataDir string) (*config.Config, error) {
+	if dataDir == "" {
+		return nil, errors.New("data directory is required")
+	}
+	binaryDir := filepath.Join(dataDir, binaryDirName)
+	if err := os.MkdirAll(binaryDir, 0o700); err != nil {
+		return nil, fmt.Errorf("cannot create data directory: %w", err)
+	}
+	jwtKey, err := loadOrCreateSecret(filepath.Join(dataDir, jwtKeyFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	return &config.Config{
+		GRPCServerAddr:      Address,
+		DBDriver:            config.DBDriverSQLite,
+		DBConnect:           filepath.Join(dataDir, dbFileName),
+		JwtKey:              jwtKey,
+		BinaryDataBackend:   config.BinaryBackendFS,
+		BinaryDataStorePath: binaryDir,
+		// Клиент запускается ненадолго, периодическая сверка ему не нужна
+		ScrubInterval: "0",
+	}, nil
+}
+
+// Dial устанавливает соединение с сервером. Подходит для grpc.WithContextDialer;
+// адрес игнорируется.
+func (s *Server) Dial(ctx context.Context, _ string) (net.Conn, error) {
+	return s.lis.DialContext(ctx)
+}
+
+// Close останавливает сервер, дождавшись завершения активных вызовов
+// (не дольше shutdownTimeout), и закрывает хранилища.
+func (s *Server) Close() error {
+	stopped := make(chan struct{})
+	go func() {
+		s.srv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(shutdownTimeout):
+		s.log.Warn("Embedded server graceful shutdown timed out")
+		s.srv.Stop()
+	}
+	<-s.done
+
+	s.services.Close()
+	s.log.Info("Embedded server stopped")
+	return nil
+}
+
+// loadOrCreateSecret читает секрет из path или, если файла нет, создаёт
+// случайный секрет и сохраняет его с правами 0600.
+func loadOrCreateSecret(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		secret := strings.TrimSpace(string(data))
+		if secret == "" {
+			return "", fmt.Errorf("secret file %s is empty", path)
+		}
+		return secret, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", fmt.Errorf("failed to read secret: %w", err)
+	}
+
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate secret: %w", err)
+	}
+	secret := hex.EncodeToString(buf)
+	if err := os.WriteFile(path, []byte(secret+"\n"), 0o600); err != nil {
+		return "", fmt.Errorf("failed to save secret: %w", err)
+	}
+	return secret, nil
+}
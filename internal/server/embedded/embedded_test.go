@@ -0,0 +1,89 @@
+package embedded_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	api "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/ryabkov82/gophkeeper/internal/server/config"
+	"github.com/ryabkov82/gophkeeper/internal/server/embedded"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+func dial(t *testing.T, s *embedded.Server) api.AuthServiceClient {
+	conn, err := grpc.NewClient("passthrough:///"+embedded.Address,
+		grpc.WithContextDialer(s.Dial),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return api.NewAuthServiceClient(conn)
+}
+
+// TestServer_PersistsBetweenStarts в CI выполняется с CGO_ENABLED=0, как
+// собираются релизные клиенты: встроенный сервер должен запускаться без cgo.
+func TestServer_PersistsBetweenStarts(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	s, err := embedded.Start(zap.NewNop(), dir)
+	require.NoError(t, err)
+	_, err = dial(t, s).Register(ctx, api.RegisterRequest_builder{
+		Login:    proto.String("alice"),
+		Password: proto.String("secret"),
+	}.Build())
+	require.NoError(t, err)
+	first, err := dial(t, s).Login(ctx, api.LoginRequest_builder{
+		Login:    proto.String("alice"),
+		Password: proto.String("secret"),
+	}.Build())
+	require.NoError(t, err)
+	require.NoError(t, s.Close())
+
+	assert.FileExists(t, filepath.Join(dir, "keeper.db"))
+	assert.DirExists(t, filepath.Join(dir, "binary"))
+	secret, err := os.ReadFile(filepath.Join(dir, "jwt.key"))
+	require.NoError(t, err)
+
+	// Пользователь и секрет подписи токенов сохраняются между запусками
+	s, err = embedded.Start(zap.NewNop(), dir)
+	require.NoError(t, err)
+	defer s.Close()
+	second, err := dial(t, s).Login(ctx, api.LoginRequest_builder{
+		Login:    proto.String("alice"),
+		Password: proto.String("secret"),
+	}.Build())
+	require.NoError(t, err)
+	assert.Equal(t, first.GetSalt(), second.GetSalt())
+
+	again, err := os.ReadFile(filepath.Join(dir, "jwt.key"))
+	require.NoError(t, err)
+	assert.Equal(t, secret, again)
+}
+
+func TestConfig(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "nested", "data")
+
+	cfg, err := embedded.Config(dir)
+	require.NoError(t, err)
+	assert.Equal(t, config.DBDriverSQLite, cfg.DBDriver)
+	assert.Equal(t, filepath.Join(dir, "keeper.db"), cfg.DBConnect)
+	assert.Equal(t, config.BinaryBackendFS, cfg.BinaryDataBackend)
+	assert.Equal(t, filepath.Join(dir, "binary"), cfg.BinaryDataStorePath)
+	assert.Len(t, cfg.JwtKey, 64)
+	assert.False(t, cfg.EnableTLS)
+
+	info, err := os.Stat(filepath.Join(dir, "jwt.key"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	_, err = embedded.Config("")
+	assert.Error(t, err)
+}
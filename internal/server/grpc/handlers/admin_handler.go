@@ -0,0 +1,58 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// AdminHandler реализует gRPC сервер для AdminService. Доступ к методам
+// проверяет interceptors.UnaryAdminInterceptor.
+type AdminHandler struct {
+	pb.UnimplementedAdminServiceServer
+	scrub  service.ScrubService
+	logger *zap.Logger
+}
+
+// NewAdminHandler создает новый AdminHandler с внедрением сервиса сверки и логгера.
+func NewAdminHandler(scrub service.ScrubService, logger *zap.Logger) *AdminHandler {
+	return &AdminHandler{
+		scrub:  scrub,
+		logger: logger,
+	}
+}
+
+// RunScrub выполняет сверку хранилища бинарных данных и возвращает отчёт.
+func (h *AdminHandler) RunScrub(ctx context.Context, req *pb.RunScrubRequest) (*pb.RunScrubResponse, error) {
+	h.logger.Info("Scrub requested by admin", zap.Bool("fix", req.GetFix()), zap.Bool("rehash", req.GetRehash()))
+
+	report, err := h.scrub.Run(ctx, model.ScrubOptions{Fix: req.GetFix(), Rehash: req.GetRehash()})
+	if errors.Is(err, model.ErrScrubInProgress) {
+		return nil, status.Error(codes.Aborted, err.Error())
+	}
+	if err != nil {
+		h.logger.Error("Scrub failed", zap.Error(err))
+		return nil, status.Errorf(codes.Internal, "scrub failed: %v", err)
+	}
+
+	resp := &pb.RunScrubResponse{}
+	resp.SetReport(mapper.ScrubReportToPB(report))
+	return resp, nil
+}
+
+// GetScrubReport возвращает отчёт последней завершённой сверки.
+func (h *AdminHandler) GetScrubReport(ctx context.Context, req *pb.GetScrubReportRequest) (*pb.GetScrubReportResponse, error) {
+	resp := &pb.GetScrubReportResponse{}
+	if report := h.scrub.LastReport(); report != nil {
+		resp.SetReport(mapper.ScrubReportToPB(report))
+	}
+	return resp, nil
+}
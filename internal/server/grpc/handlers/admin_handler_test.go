@@ -0,0 +1,106 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/ryabkov82/gophkeeper/internal/server/grpc/handlers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Мок ScrubService
+type mockScrubService struct {
+	mock.Mock
+}
+
+func (m *mockScrubService) Run(ctx context.Context, opts model.ScrubOptions) (*model.ScrubReport, error) {
+	args := m.Called(ctx, opts)
+	if v := args.Get(0); v != nil {
+		return v.(*model.ScrubReport), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockScrubService) LastReport() *model.ScrubReport {
+	args := m.Called()
+	if v := args.Get(0); v != nil {
+		return v.(*model.ScrubReport)
+	}
+	return nil
+}
+
+func (m *mockScrubService) Start(interval time.Duration, opts model.ScrubOptions) {}
+
+func (m *mockScrubService) Close() {}
+
+func TestAdminHandler_RunScrub(t *testing.T) {
+	mockSvc := new(mockScrubService)
+	h := handlers.NewAdminHandler(mockSvc, zap.NewNop())
+	ctx := context.Background()
+
+	report := &model.ScrubReport{
+		StartedAt:      time.Now(),
+		FinishedAt:     time.Now(),
+		Options:        model.ScrubOptions{Fix: true},
+		FilesScanned:   3,
+		OrphanFiles:    []string{"user1/orphan.bin"},
+		MissingFiles:   []model.StoredRef{{UserID: "user1", ID: "file1", StoragePath: "user1/file1.bin"}},
+		RemovedFiles:   1,
+		RemovedRecords: 1,
+	}
+	mockSvc.On("Run", ctx, model.ScrubOptions{Fix: true}).Return(report, nil)
+
+	req := &pb.RunScrubRequest{}
+	req.SetFix(true)
+	resp, err := h.RunScrub(ctx, req)
+	require.NoError(t, err)
+
+	got := resp.GetReport()
+	assert.True(t, got.GetFix())
+	assert.False(t, got.GetRehash())
+	assert.Equal(t, int64(3), got.GetFilesScanned())
+	assert.Equal(t, []string{"user1/orphan.bin"}, got.GetOrphanFiles())
+	require.Len(t, got.GetMissingFiles(), 1)
+	assert.Equal(t, "file1", got.GetMissingFiles()[0].GetId())
+	assert.Equal(t, int64(1), got.GetRemovedFiles())
+	assert.Equal(t, int64(1), got.GetRemovedRecords())
+}
+
+func TestAdminHandler_RunScrub_Errors(t *testing.T) {
+	mockSvc := new(mockScrubService)
+	h := handlers.NewAdminHandler(mockSvc, zap.NewNop())
+	ctx := context.Background()
+
+	mockSvc.On("Run", ctx, model.ScrubOptions{}).Return(nil, model.ErrScrubInProgress).Once()
+	_, err := h.RunScrub(ctx, &pb.RunScrubRequest{})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+
+	mockSvc.On("Run", ctx, model.ScrubOptions{}).Return(nil, errors.New("db down")).Once()
+	_, err = h.RunScrub(ctx, &pb.RunScrubRequest{})
+	assert.Equal(t, codes.Internal, status.Code(err))
+}
+
+func TestAdminHandler_GetScrubReport(t *testing.T) {
+	mockSvc := new(mockScrubService)
+	h := handlers.NewAdminHandler(mockSvc, zap.NewNop())
+	ctx := context.Background()
+
+	mockSvc.On("LastReport").Return(nil).Once()
+	resp, err := h.GetScrubReport(ctx, &pb.GetScrubReportRequest{})
+	require.NoError(t, err)
+	assert.False(t, resp.HasReport())
+
+	mockSvc.On("LastReport").Return(&model.ScrubReport{FilesScanned: 5}).Once()
+	resp, err = h.GetScrubReport(ctx, &pb.GetScrubReportRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), resp.GetReport().GetFilesScanned())
+}
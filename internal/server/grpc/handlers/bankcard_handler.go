@@ -12,7 +12,6 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // BankCardHandler реализует gRPC сервер для BankCardService
@@ -50,6 +49,8 @@ func (h *BankCardHandler) CreateBankCard(ctx context.Context, req *pb.CreateBank
 		ExpiryDate:     req.GetBankCard().GetExpiryDate(),
 		CVV:            req.GetBankCard().GetCvv(),
 		Metadata:       req.GetBankCard().GetMetadata(),
+		Folder:         req.GetBankCard().GetFolder(),
+		Tags:           req.GetBankCard().GetTags(),
 	}
 
 	err = h.service.Create(ctx, card)
@@ -59,7 +60,7 @@ func (h *BankCardHandler) CreateBankCard(ctx context.Context, req *pb.CreateBank
 			zap.String("title", req.GetBankCard().GetTitle()),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, quotaError(err)
 	}
 
 	h.logger.Info("CreateBankCard succeeded",
@@ -112,8 +113,8 @@ func (h *BankCardHandler) GetBankCardByID(ctx context.Context, req *pb.GetBankCa
 	return resp, nil
 }
 
-// GetBankCards возвращает все банковские карты пользователя.
-func (h *BankCardHandler) GetBankCards(ctx context.Context, _ *emptypb.Empty) (*pb.GetBankCardsResponse, error) {
+// GetBankCards возвращает страницу банковских карт пользователя, удовлетворяющих фильтру.
+func (h *BankCardHandler) GetBankCards(ctx context.Context, req *pb.GetBankCardsRequest) (*pb.GetBankCardsResponse, error) {
 	userID, err := jwtauth.FromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
@@ -123,13 +124,14 @@ func (h *BankCardHandler) GetBankCards(ctx context.Context, _ *emptypb.Empty) (*
 		zap.String("userID", userID),
 	)
 
-	cards, err := h.service.GetByUserID(ctx, userID)
+	cards, next, err := h.service.GetByUserID(ctx, userID,
+		mapper.ListFilterFromPB(req.GetFilter()), mapper.PageRequestFromPB(req.GetPage()))
 	if err != nil {
 		h.logger.Warn("GetBankCards failed",
 			zap.String("userID", userID),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, listError(err)
 	}
 
 	h.logger.Info("GetBankCards succeeded",
@@ -141,6 +143,7 @@ func (h *BankCardHandler) GetBankCards(ctx context.Context, _ *emptypb.Empty) (*
 	for i := range cards {
 		resp.SetBankCards(append(resp.GetBankCards(), mapper.BankCardToPB(&cards[i])))
 	}
+	resp.SetNextPageToken(next)
 
 	return resp, nil
 }
@@ -167,6 +170,9 @@ func (h *BankCardHandler) UpdateBankCard(ctx context.Context, req *pb.UpdateBank
 		ExpiryDate:     cardProto.GetExpiryDate(),
 		CVV:            cardProto.GetCvv(),
 		Metadata:       cardProto.GetMetadata(),
+		Folder:         cardProto.GetFolder(),
+		Tags:           cardProto.GetTags(),
+		Version:        cardProto.GetVersion(),
 	}
 
 	existing, err := h.service.GetByID(ctx, card.ID)
@@ -184,7 +190,7 @@ func (h *BankCardHandler) UpdateBankCard(ctx context.Context, req *pb.UpdateBank
 			zap.String("bankCardID", cardProto.GetId()),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, updateError(err)
 	}
 
 	h.logger.Info("UpdateBankCard succeeded",
@@ -194,6 +200,7 @@ func (h *BankCardHandler) UpdateBankCard(ctx context.Context, req *pb.UpdateBank
 
 	resp := &pb.UpdateBankCardResponse{}
 	resp.SetBankCard(mapper.BankCardToPB(card))
+	resp.SetVersion(card.Version)
 	return resp, nil
 }
 
@@ -34,13 +34,13 @@ func (m *mockBankCardService) GetByID(ctx context.Context, id string) (*model.Ba
 	return c.(*model.BankCard), args.Error(1)
 }
 
-func (m *mockBankCardService) GetByUserID(ctx context.Context, userID string) ([]model.BankCard, error) {
-	args := m.Called(ctx, userID)
+func (m *mockBankCardService) GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
+	args := m.Called(ctx, userID, filter, page)
 	cards := args.Get(0)
 	if cards == nil {
-		return nil, args.Error(1)
+		return nil, args.String(1), args.Error(2)
 	}
-	return cards.([]model.BankCard), args.Error(1)
+	return cards.([]model.BankCard), args.String(1), args.Error(2)
 }
 
 func (m *mockBankCardService) Update(ctx context.Context, card *model.BankCard) error {
@@ -125,7 +125,7 @@ func TestGetBankCards_Success(t *testing.T) {
 		{ID: uuid.NewString(), UserID: userID, Title: "Card2", CreatedAt: time.Now(), UpdatedAt: time.Now()},
 	}
 
-	mockSvc.On("GetByUserID", mock.Anything, userID).Return(cards, nil)
+	mockSvc.On("GetByUserID", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}).Return(cards, "", nil)
 
 	resp, err := h.GetBankCards(ctx, nil)
 	assert.NoError(t, err)
@@ -277,7 +277,7 @@ func TestGetBankCards_ServiceError(t *testing.T) {
 	userID := uuid.NewString()
 	ctx := mockJWTContext(userID)
 
-	mockSvc.On("GetByUserID", mock.Anything, userID).Return(nil, errors.New("service error"))
+	mockSvc.On("GetByUserID", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}).Return(nil, "", errors.New("service error"))
 
 	_, err := h.GetBankCards(ctx, nil)
 	assert.Error(t, err)
@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/jwtauth"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BatchHandler реализует gRPC сервер для BatchService
+type BatchHandler struct {
+	pb.UnimplementedBatchServiceServer
+	service service.BatchService
+	logger  *zap.Logger
+}
+
+// NewBatchHandler создает новый BatchHandler с внедрением сервиса и логгера.
+func NewBatchHandler(srv service.BatchService, logger *zap.Logger) *BatchHandler {
+	return &BatchHandler{
+		service: srv,
+		logger:  logger,
+	}
+}
+
+// BatchMutate применяет операции создания, обновления и удаления записей в одной транзакции.
+// Результат каждой операции возвращается отдельно с кодом gRPC-статуса; ошибка RPC
+// означает, что пакет некорректен или транзакцию выполнить не удалось.
+func (h *BatchHandler) BatchMutate(ctx context.Context, req *pb.BatchMutateRequest) (*pb.BatchMutateResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	if len(req.GetOperations()) > model.MaxBatchOps {
+		return nil, status.Errorf(codes.InvalidArgument, "too many operations in batch: %d (max %d)",
+			len(req.GetOperations()), model.MaxBatchOps)
+	}
+
+	ops := make([]model.BatchOp, 0, len(req.GetOperations()))
+	for _, op := range req.GetOperations() {
+		ops = append(ops, mapper.BatchOpFromPB(op))
+	}
+
+	results, err := h.service.Mutate(ctx, userID, ops, req.GetAtomic())
+	if err != nil {
+		h.logger.Warn("BatchMutate failed",
+			zap.String("userID", userID),
+			zap.Int("operations", len(ops)),
+			zap.Error(err),
+		)
+		return nil, quotaError(err)
+	}
+
+	failed := 0
+	out := make([]*pb.BatchResult, 0, len(results))
+	for _, res := range results {
+		r := &pb.BatchResult{}
+		r.SetId(res.ItemID)
+		r.SetVersion(res.Version)
+		if res.Err != nil {
+			failed++
+			r.SetCode(int32(status.Code(batchOpError(res.Err))))
+			r.SetError(res.Err.Error())
+		}
+		out = append(out, r)
+	}
+
+	h.logger.Info("BatchMutate completed",
+		zap.String("userID", userID),
+		zap.Int("operations", len(ops)),
+		zap.Int("failed", failed),
+		zap.Bool("atomic", req.GetAtomic()),
+	)
+
+	resp := &pb.BatchMutateResponse{}
+	resp.SetResults(out)
+	return resp, nil
+}
+
+// batchOpError преобразует ошибку операции пакета в gRPC-статус: конфликт
+// версии — Aborted, отсутствующая запись — NotFound, операция атомарного
+// пакета, отменённая из-за ошибки другой операции, — Canceled.
+func batchOpError(err error) error {
+	if errors.Is(err, model.ErrBatchAborted) {
+		return status.Error(codes.Canceled, err.Error())
+	}
+	if errors.Is(err, model.ErrItemNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return itemError(updateError(err))
+}
@@ -0,0 +1,121 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/ryabkov82/gophkeeper/internal/server/grpc/handlers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Мок BatchService
+type mockBatchService struct {
+	mock.Mock
+}
+
+func (m *mockBatchService) Mutate(ctx context.Context, userID string, ops []model.BatchOp, atomic bool) ([]model.BatchResult, error) {
+	args := m.Called(ctx, userID, ops, atomic)
+	results := args.Get(0)
+	if results == nil {
+		return nil, args.Error(1)
+	}
+	return results.([]model.BatchResult), args.Error(1)
+}
+
+func TestBatchMutate_Success(t *testing.T) {
+	mockSvc := new(mockBatchService)
+	h := handlers.NewBatchHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	create := &pb.BatchOperation{}
+	create.SetKind(pb.BatchOperationKind_BATCH_OPERATION_KIND_CREATE)
+	create.SetType(pb.ItemType_ITEM_TYPE_CREDENTIAL)
+	cred := &pb.Credential{}
+	cred.SetTitle("Gmail")
+	create.SetCredential(cred)
+
+	del := &pb.BatchOperation{}
+	del.SetKind(pb.BatchOperationKind_BATCH_OPERATION_KIND_DELETE)
+	del.SetType(pb.ItemType_ITEM_TYPE_BANK_CARD)
+	del.SetId("card1")
+
+	upd := &pb.BatchOperation{}
+	upd.SetKind(pb.BatchOperationKind_BATCH_OPERATION_KIND_UPDATE)
+	upd.SetType(pb.ItemType_ITEM_TYPE_CREDENTIAL)
+	cred2 := &pb.Credential{}
+	cred2.SetId("cred2")
+	upd.SetCredential(cred2)
+
+	mockSvc.On("Mutate", ctx, "user1", mock.MatchedBy(func(ops []model.BatchOp) bool {
+		return len(ops) == 3 &&
+			ops[0].Kind == model.BatchCreate && ops[0].Credential != nil && ops[0].Credential.Title == "Gmail" &&
+			ops[1].Kind == model.BatchDelete && ops[1].Type == model.ItemTypeBankCard && ops[1].ItemID == "card1" &&
+			ops[2].Kind == model.BatchUpdate
+	}), false).Return([]model.BatchResult{
+		{ItemID: "cred1", Version: 1},
+		{ItemID: "card1", Err: model.ErrItemNotFound},
+		{ItemID: "cred2", Err: model.ErrVersionConflict},
+	}, nil)
+
+	req := &pb.BatchMutateRequest{}
+	req.SetOperations([]*pb.BatchOperation{create, del, upd})
+
+	resp, err := h.BatchMutate(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 3)
+
+	assert.Equal(t, "cred1", resp.GetResults()[0].GetId())
+	assert.Equal(t, int64(1), resp.GetResults()[0].GetVersion())
+	assert.Equal(t, int32(codes.OK), resp.GetResults()[0].GetCode())
+	assert.Equal(t, int32(codes.NotFound), resp.GetResults()[1].GetCode())
+	assert.Equal(t, int32(codes.Aborted), resp.GetResults()[2].GetCode())
+	assert.NotEmpty(t, resp.GetResults()[2].GetError())
+	mockSvc.AssertExpectations(t)
+}
+
+func TestBatchMutate_Aborted(t *testing.T) {
+	mockSvc := new(mockBatchService)
+	h := handlers.NewBatchHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	mockSvc.On("Mutate", ctx, "user1", mock.Anything, true).Return([]model.BatchResult{
+		{Err: model.ErrBatchAborted},
+	}, nil)
+
+	req := &pb.BatchMutateRequest{}
+	req.SetOperations([]*pb.BatchOperation{{}})
+	req.SetAtomic(true)
+
+	resp, err := h.BatchMutate(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int32(codes.Canceled), resp.GetResults()[0].GetCode())
+}
+
+func TestBatchMutate_Errors(t *testing.T) {
+	mockSvc := new(mockBatchService)
+	h := handlers.NewBatchHandler(mockSvc, zap.NewNop())
+
+	_, err := h.BatchMutate(context.Background(), &pb.BatchMutateRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	req := &pb.BatchMutateRequest{}
+	req.SetOperations(make([]*pb.BatchOperation, model.MaxBatchOps+1))
+	_, err = h.BatchMutate(mockJWTContext("user1"), req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	ctx := mockJWTContext("user1")
+	mockSvc.On("Mutate", ctx, "user1", mock.Anything, false).Return(nil, errors.New("db error"))
+	_, err = h.BatchMutate(ctx, &pb.BatchMutateRequest{})
+	assert.Error(t, err)
+	mockSvc.AssertNotCalled(t, "Mutate", mock.Anything, "user1", mock.MatchedBy(func(ops []model.BatchOp) bool {
+		return len(ops) > model.MaxBatchOps
+	}), mock.Anything)
+}
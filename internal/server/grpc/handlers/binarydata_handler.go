@@ -2,11 +2,17 @@ package handlers
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"hash"
 	"io"
 
+	"github.com/google/uuid"
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/jwtauth"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
 	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
@@ -50,12 +56,23 @@ func (h *BinaryDataHandler) UploadBinaryData(stream pb.BinaryDataService_UploadB
 	}
 	data.UserID = userID
 
+	if uploadID := req.GetUploadId(); uploadID != "" {
+		if _, err := uuid.Parse(uploadID); err != nil {
+			return status.Error(codes.InvalidArgument, "upload_id must be a UUID")
+		}
+	}
+
 	h.logger.Debug("UploadBinaryData started",
 		zap.String("userID", userID),
 		zap.String("title", data.Title),
+		zap.String("uploadID", req.GetUploadId()),
+		zap.Int64("offset", req.GetOffset()),
 	)
 
 	pr, pw := io.Pipe()
+	// Если сервис прекратил чтение (например, превышена квота), горутина
+	// приёма не должна остаться заблокированной на записи в канал
+	defer pr.Close()
 
 	// Запускаем горутину для асинхронного получения чанков
 	go func() {
@@ -79,7 +96,10 @@ func (h *BinaryDataHandler) UploadBinaryData(stream pb.BinaryDataService_UploadB
 		}
 	}()
 
-	if data.ID == "" {
+	if uploadID := req.GetUploadId(); uploadID != "" {
+		// Загрузка с докачкой: при обрыве принятые данные остаются в сессии
+		data, err = h.binarySvc.ResumeUpload(stream.Context(), data, uploadID, req.GetOffset(), pr)
+	} else if data.ID == "" {
 		// Создаем запись в сервисе
 		data, err = h.binarySvc.Create(stream.Context(), data, pr)
 	} else {
@@ -87,17 +107,53 @@ func (h *BinaryDataHandler) UploadBinaryData(stream pb.BinaryDataService_UploadB
 		data, err = h.binarySvc.Update(stream.Context(), data, pr)
 	}
 	if err != nil {
-		h.logger.Warn("UploadBinaryData failed", zap.String("userID", userID), zap.String("title", data.Title), zap.Error(err))
-		return err
+		h.logger.Warn("UploadBinaryData failed", zap.String("userID", userID), zap.String("title", req.GetInfo().GetTitle()), zap.Error(err))
+		return uploadError(err)
 	}
 
 	h.logger.Info("UploadBinaryData succeeded", zap.String("userID", userID), zap.String("binaryDataID", data.ID))
 
 	resp := &pb.UploadBinaryDataResponse{}
 	resp.SetId(data.ID)
+	resp.SetVersion(data.Version)
 	return stream.SendAndClose(resp)
 }
 
+// GetUploadStatus возвращает число байт, принятых сервером в сессии загрузки
+// с докачкой. Клиент продолжает прерванную загрузку с этого смещения.
+func (h *BinaryDataHandler) GetUploadStatus(ctx context.Context, req *pb.GetUploadStatusRequest) (*pb.GetUploadStatusResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+	if _, err := uuid.Parse(req.GetUploadId()); err != nil {
+		return nil, status.Error(codes.InvalidArgument, "upload_id must be a UUID")
+	}
+
+	offset, err := h.binarySvc.UploadStatus(ctx, userID, req.GetUploadId())
+	if err != nil {
+		h.logger.Warn("GetUploadStatus failed",
+			zap.String("userID", userID),
+			zap.String("uploadID", req.GetUploadId()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	resp := &pb.GetUploadStatusResponse{}
+	resp.SetOffset(offset)
+	return resp, nil
+}
+
+// uploadError дополняет updateError: смещение докачки за пределами
+// принятых данных — FailedPrecondition.
+func uploadError(err error) error {
+	if errors.Is(err, storage.ErrUploadOffset) {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return updateError(err)
+}
+
 // UpdateBinaryDataInfo обновляет только метаданные бинарных данных
 func (h *BinaryDataHandler) UpdateBinaryDataInfo(ctx context.Context, req *pb.UpdateBinaryDataRequest) (*pb.UpdateBinaryDataResponse, error) {
 	userID, err := jwtauth.FromContext(ctx)
@@ -115,14 +171,15 @@ func (h *BinaryDataHandler) UpdateBinaryDataInfo(ctx context.Context, req *pb.Up
 	if err != nil {
 		h.logger.Warn("UpdateBinaryDataInfo failed",
 			zap.String("userID", userID),
-			zap.String("id", data.ID),
+			zap.String("id", req.GetInfo().GetId()),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, updateError(err)
 	}
 
 	resp := &pb.UpdateBinaryDataResponse{}
 	resp.SetId(data.ID)
+	resp.SetVersion(data.Version)
 
 	h.logger.Info("UpdateBinaryDataInfo succeeded",
 		zap.String("userID", userID),
@@ -132,7 +189,12 @@ func (h *BinaryDataHandler) UpdateBinaryDataInfo(ctx context.Context, req *pb.Up
 	return resp, nil
 }
 
-// DownloadBinaryData возвращает бинарные данные пользователю
+// DownloadBinaryData возвращает бинарные данные пользователю.
+// Поля offset и length запроса задают диапазон содержимого, что позволяет
+// клиенту продолжить прерванное скачивание.
+//
+// При скачивании файла целиком содержимое сверяется с контрольной суммой,
+// записанной при загрузке; при несовпадении поток завершается с DataLoss.
 func (h *BinaryDataHandler) DownloadBinaryData(
 	req *pb.DownloadBinaryDataRequest,
 	stream pb.BinaryDataService_DownloadBinaryDataServer,
@@ -142,17 +204,44 @@ func (h *BinaryDataHandler) DownloadBinaryData(
 		return status.Error(codes.Unauthenticated, "userID not found in context")
 	}
 
-	data, reader, err := h.binarySvc.Get(stream.Context(), userID, req.GetId())
+	if req.GetOffset() < 0 || req.GetLength() < 0 {
+		return status.Error(codes.InvalidArgument, "offset and length must not be negative")
+	}
+
+	var data *model.BinaryData
+	var reader io.ReadSeekCloser
+	if versionID := req.GetVersionId(); versionID != "" {
+		data, reader, err = h.binarySvc.GetVersion(stream.Context(), userID, req.GetId(), versionID)
+	} else {
+		data, reader, err = h.binarySvc.Get(stream.Context(), userID, req.GetId())
+	}
 	if err != nil {
-		return err
+		return chunkError(err)
 	}
 	defer reader.Close()
 
-	h.logger.Debug("DownloadBinaryData started", zap.String("userID", userID), zap.String("id", data.ID))
+	src, err := downloadRange(reader, req.GetOffset(), req.GetLength())
+	if err != nil {
+		return err
+	}
+
+	var digest hash.Hash
+	if req.GetOffset() == 0 && req.GetLength() == 0 && data.Checksum != "" {
+		digest = sha256.New()
+		src = io.TeeReader(src, digest)
+	}
+
+	h.logger.Debug("DownloadBinaryData started",
+		zap.String("userID", userID),
+		zap.String("id", data.ID),
+		zap.String("versionID", req.GetVersionId()),
+		zap.Int64("offset", req.GetOffset()),
+		zap.Int64("length", req.GetLength()),
+	)
 
 	buf := make([]byte, 32*1024) // 32KB
 	for {
-		n, err := reader.Read(buf)
+		n, err := src.Read(buf)
 		if err != nil && err != io.EOF {
 			return status.Errorf(codes.Internal, "failed to read file: %v", err)
 		}
@@ -172,21 +261,54 @@ func (h *BinaryDataHandler) DownloadBinaryData(
 		}
 	}
 
+	if digest != nil {
+		if sum := hex.EncodeToString(digest.Sum(nil)); sum != data.Checksum {
+			h.logger.Error("DownloadBinaryData checksum mismatch",
+				zap.String("userID", userID),
+				zap.String("id", data.ID),
+				zap.String("expected", data.Checksum),
+				zap.String("actual", sum),
+			)
+			return status.Error(codes.DataLoss, "stored content does not match its checksum")
+		}
+	}
+
 	h.logger.Info("DownloadBinaryData completed", zap.String("userID", userID), zap.String("id", data.ID))
 	return nil
 }
 
-// ListBinaryData возвращает список бинарных данных пользователя
+// downloadRange перематывает содержимое на offset и ограничивает его length
+// байтами (0 — до конца). Смещение за концом файла — OutOfRange; смещение,
+// равное размеру, допустимо и даёт пустой поток (скачивание уже завершено).
+func downloadRange(r io.ReadSeeker, offset, length int64) (io.Reader, error) {
+	size, err := r.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read file: %v", err)
+	}
+	if offset > size {
+		return nil, status.Errorf(codes.OutOfRange, "offset %d exceeds file size %d", offset, size)
+	}
+	if _, err := r.Seek(offset, io.SeekStart); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to read file: %v", err)
+	}
+	if length > 0 {
+		return io.LimitReader(r, length), nil
+	}
+	return r, nil
+}
+
+// ListBinaryData возвращает страницу бинарных данных пользователя
 func (h *BinaryDataHandler) ListBinaryData(ctx context.Context, req *pb.ListBinaryDataRequest) (*pb.ListBinaryDataResponse, error) {
 	userID, err := jwtauth.FromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
 	}
 
-	list, err := h.binarySvc.List(ctx, userID)
+	list, next, err := h.binarySvc.List(ctx, userID,
+		mapper.ListFilterFromPB(req.GetFilter()), mapper.PageRequestFromPB(req.GetPage()))
 	if err != nil {
 		h.logger.Warn("ListBinaryData failed", zap.String("userID", userID), zap.Error(err))
-		return nil, err
+		return nil, listError(err)
 	}
 
 	resp := &pb.ListBinaryDataResponse{}
@@ -194,6 +316,7 @@ func (h *BinaryDataHandler) ListBinaryData(ctx context.Context, req *pb.ListBina
 		d.Metadata = ""
 		resp.SetItems(append(resp.GetItems(), mapper.BinaryDataToPB(d)))
 	}
+	resp.SetNextPageToken(next)
 
 	h.logger.Info("ListBinaryData succeeded", zap.String("userID", userID), zap.Int("items", len(list)))
 	return resp, nil
@@ -250,7 +373,7 @@ func (h *BinaryDataHandler) SaveBinaryDataInfo(ctx context.Context, req *pb.Save
 			zap.String("binaryDataID", data.ID),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, quotaError(err)
 	}
 
 	h.logger.Info("SaveBinaryDataInfo succeeded",
@@ -279,3 +402,237 @@ func (h *BinaryDataHandler) DeleteBinaryData(ctx context.Context, req *pb.Delete
 	resp := &pb.DeleteBinaryDataResponse{}
 	return resp, nil
 }
+
+// validChunkIDs проверяет формат идентификаторов фрагментов.
+func validChunkIDs(ids []string) error {
+	for _, id := range ids {
+		if !model.ValidChunkID(id) {
+			return status.Errorf(codes.InvalidArgument, "invalid chunk id %q", id)
+		}
+	}
+	return nil
+}
+
+// FindMissingChunks возвращает идентификаторы фрагментов из запроса, которых
+// ещё нет на сервере; клиент загружает только их.
+func (h *BinaryDataHandler) FindMissingChunks(ctx context.Context, req *pb.FindMissingChunksRequest) (*pb.FindMissingChunksResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+	if err := validChunkIDs(req.GetIds()); err != nil {
+		return nil, err
+	}
+
+	missing, err := h.binarySvc.MissingChunks(ctx, userID, req.GetIds())
+	if err != nil {
+		h.logger.Warn("FindMissingChunks failed", zap.String("userID", userID), zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.FindMissingChunksResponse{}
+	resp.SetIds(missing)
+	return resp, nil
+}
+
+// UploadChunk сохраняет один зашифрованный фрагмент файла.
+func (h *BinaryDataHandler) UploadChunk(ctx context.Context, req *pb.UploadChunkRequest) (*pb.UploadChunkResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+	if err := validChunkIDs([]string{req.GetId()}); err != nil {
+		return nil, err
+	}
+	if len(req.GetData()) == 0 || len(req.GetData()) > model.MaxChunkSize {
+		return nil, status.Errorf(codes.InvalidArgument, "chunk size must be between 1 and %d bytes", model.MaxChunkSize)
+	}
+
+	if err := h.binarySvc.PutChunk(ctx, userID, req.GetId(), req.GetData()); err != nil {
+		h.logger.Warn("UploadChunk failed", zap.String("userID", userID), zap.String("chunkID", req.GetId()), zap.Error(err))
+		return nil, quotaError(err)
+	}
+
+	h.logger.Debug("UploadChunk succeeded", zap.String("userID", userID), zap.String("chunkID", req.GetId()))
+	return &pb.UploadChunkResponse{}, nil
+}
+
+// CommitChunkedUpload создаёт или обновляет запись, содержимое которой
+// состоит из уже загруженных фрагментов. Если каких-то фрагментов нет,
+// возвращается FailedPrecondition, и клиент должен догрузить их.
+func (h *BinaryDataHandler) CommitChunkedUpload(ctx context.Context, req *pb.CommitChunkedUploadRequest) (*pb.CommitChunkedUploadResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	data := mapper.BinaryDataFromPB(req.GetInfo())
+	if data == nil {
+		return nil, status.Error(codes.InvalidArgument, "info is required")
+	}
+	data.UserID = userID
+	if err := validChunkIDs(req.GetChunkIds()); err != nil {
+		return nil, err
+	}
+
+	data, err = h.binarySvc.CommitChunked(ctx, data, req.GetChunkIds())
+	if err != nil {
+		h.logger.Warn("CommitChunkedUpload failed",
+			zap.String("userID", userID),
+			zap.String("id", req.GetInfo().GetId()),
+			zap.Error(err),
+		)
+		return nil, chunkError(err)
+	}
+
+	h.logger.Info("CommitChunkedUpload succeeded",
+		zap.String("userID", userID),
+		zap.String("binaryDataID", data.ID),
+		zap.Int("chunks", len(req.GetChunkIds())),
+	)
+
+	resp := &pb.CommitChunkedUploadResponse{}
+	resp.SetId(data.ID)
+	resp.SetVersion(data.Version)
+	return resp, nil
+}
+
+// GetManifest возвращает список фрагментов файла или его прежней версии.
+// Для содержимого, хранящегося одним потоком, chunked равно false, и
+// скачивать его нужно через DownloadBinaryData.
+func (h *BinaryDataHandler) GetManifest(ctx context.Context, req *pb.GetManifestRequest) (*pb.GetManifestResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	var refs []model.ChunkRef
+	var chunked bool
+	if versionID := req.GetVersionId(); versionID != "" {
+		refs, chunked, err = h.binarySvc.VersionManifest(ctx, userID, req.GetId(), versionID)
+	} else {
+		refs, chunked, err = h.binarySvc.Manifest(ctx, userID, req.GetId())
+	}
+	if err != nil {
+		h.logger.Warn("GetManifest failed", zap.String("userID", userID), zap.String("binaryDataID", req.GetId()), zap.Error(err))
+		return nil, chunkError(err)
+	}
+
+	resp := &pb.GetManifestResponse{}
+	resp.SetChunked(chunked)
+	resp.SetChunks(mapper.ChunkRefsToPB(refs))
+	return resp, nil
+}
+
+// DownloadChunk возвращает содержимое одного зашифрованного фрагмента.
+func (h *BinaryDataHandler) DownloadChunk(ctx context.Context, req *pb.DownloadChunkRequest) (*pb.DownloadChunkResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+	if err := validChunkIDs([]string{req.GetId()}); err != nil {
+		return nil, err
+	}
+
+	data, err := h.binarySvc.GetChunk(ctx, userID, req.GetId())
+	if err != nil {
+		h.logger.Warn("DownloadChunk failed", zap.String("userID", userID), zap.String("chunkID", req.GetId()), zap.Error(err))
+		return nil, chunkError(err)
+	}
+
+	resp := &pb.DownloadChunkResponse{}
+	resp.SetData(data)
+	return resp, nil
+}
+
+// VerifyBinaryData перечитывает хранимое содержимое файла и сверяет его с
+// контрольными суммами, записанными при загрузке. Повреждение содержимого —
+// не ошибка запроса: оно возвращается как valid = false с описанием.
+// Для файла без записанной суммы возвращается FailedPrecondition.
+func (h *BinaryDataHandler) VerifyBinaryData(ctx context.Context, req *pb.VerifyBinaryDataRequest) (*pb.VerifyBinaryDataResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	resp := &pb.VerifyBinaryDataResponse{}
+	err = h.binarySvc.Verify(ctx, userID, req.GetId())
+	switch {
+	case err == nil:
+		resp.SetValid(true)
+	case errors.Is(err, model.ErrChecksumMismatch):
+		h.logger.Error("VerifyBinaryData found corrupted content",
+			zap.String("userID", userID),
+			zap.String("binaryDataID", req.GetId()),
+			zap.Error(err),
+		)
+		resp.SetDetail(err.Error())
+	case errors.Is(err, model.ErrNoChecksum):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		h.logger.Warn("VerifyBinaryData failed", zap.String("userID", userID), zap.String("binaryDataID", req.GetId()), zap.Error(err))
+		return nil, err
+	}
+
+	h.logger.Info("VerifyBinaryData completed",
+		zap.String("userID", userID),
+		zap.String("binaryDataID", req.GetId()),
+		zap.Bool("valid", resp.GetValid()),
+	)
+	return resp, nil
+}
+
+// ListBinaryVersions возвращает прежние версии содержимого файла, начиная с
+// последней.
+func (h *BinaryDataHandler) ListBinaryVersions(ctx context.Context, req *pb.ListBinaryVersionsRequest) (*pb.ListBinaryVersionsResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	versions, err := h.binarySvc.ListVersions(ctx, userID, req.GetId())
+	if err != nil {
+		h.logger.Warn("ListBinaryVersions failed", zap.String("userID", userID), zap.String("binaryDataID", req.GetId()), zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.ListBinaryVersionsResponse{}
+	for _, v := range versions {
+		resp.SetVersions(append(resp.GetVersions(), mapper.BinaryVersionToPB(v)))
+	}
+	return resp, nil
+}
+
+// RestoreBinaryVersion делает содержимое прежней версии текущим содержимым
+// файла. Несовпадение версии записи — Aborted, неизвестная прежняя
+// версия — NotFound.
+func (h *BinaryDataHandler) RestoreBinaryVersion(ctx context.Context, req *pb.RestoreBinaryVersionRequest) (*pb.RestoreBinaryVersionResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+	if req.GetVersionId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "version_id is required")
+	}
+
+	data, err := h.binarySvc.RestoreVersion(ctx, userID, req.GetId(), req.GetVersionId(), req.GetVersion())
+	if err != nil {
+		h.logger.Warn("RestoreBinaryVersion failed",
+			zap.String("userID", userID),
+			zap.String("binaryDataID", req.GetId()),
+			zap.String("versionID", req.GetVersionId()),
+			zap.Error(err),
+		)
+		return nil, chunkError(err)
+	}
+
+	h.logger.Info("RestoreBinaryVersion succeeded",
+		zap.String("userID", userID),
+		zap.String("binaryDataID", data.ID),
+		zap.String("versionID", req.GetVersionId()),
+	)
+
+	resp := &pb.RestoreBinaryVersionResponse{}
+	resp.SetVersion(data.Version)
+	return resp, nil
+}
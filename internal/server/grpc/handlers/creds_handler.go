@@ -12,7 +12,6 @@ import (
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // CredentialHandler реализует gRPC сервер для CredentialService
@@ -50,6 +49,8 @@ func (h *CredentialHandler) CreateCredential(ctx context.Context, req *pb.Create
 		Login:    req.GetCredential().GetLogin(),
 		Password: req.GetCredential().GetPassword(),
 		Metadata: req.GetCredential().GetMetadata(),
+		Folder:   req.GetCredential().GetFolder(),
+		Tags:     req.GetCredential().GetTags(),
 	}
 
 	err = h.service.Create(ctx, cred)
@@ -59,7 +60,7 @@ func (h *CredentialHandler) CreateCredential(ctx context.Context, req *pb.Create
 			zap.String("title", req.GetCredential().GetTitle()),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, quotaError(err)
 	}
 
 	h.logger.Info("CreateCredential succeeded",
@@ -112,9 +113,9 @@ func (h *CredentialHandler) GetCredentialByID(ctx context.Context, req *pb.GetCr
 	return resp, nil
 }
 
-// GetCredentialsByUserID возвращает все учётные данные пользователя.
-// Получает userID из контекста, логирует количество возвращаемых записей.
-func (h *CredentialHandler) GetCredentials(ctx context.Context, _ *emptypb.Empty) (*pb.GetCredentialsResponse, error) {
+// GetCredentialsByUserID возвращает страницу учётных данных пользователя, удовлетворяющих
+// фильтру по папке и тегам, и токен следующей страницы. Получает userID из контекста, логирует количество возвращаемых записей.
+func (h *CredentialHandler) GetCredentials(ctx context.Context, req *pb.GetCredentialsRequest) (*pb.GetCredentialsResponse, error) {
 	userID, err := jwtauth.FromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
@@ -124,13 +125,14 @@ func (h *CredentialHandler) GetCredentials(ctx context.Context, _ *emptypb.Empty
 		zap.String("userID", userID),
 	)
 
-	creds, err := h.service.GetByUserID(ctx, userID)
+	creds, next, err := h.service.GetByUserID(ctx, userID,
+		mapper.ListFilterFromPB(req.GetFilter()), mapper.PageRequestFromPB(req.GetPage()))
 	if err != nil {
 		h.logger.Warn("GetCredentialsByUserID failed",
 			zap.String("userID", userID),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, listError(err)
 	}
 
 	h.logger.Info("GetCredentialsByUserID succeeded",
@@ -142,6 +144,7 @@ func (h *CredentialHandler) GetCredentials(ctx context.Context, _ *emptypb.Empty
 	for i := range creds {
 		resp.SetCredentials(append(resp.GetCredentials(), mapper.CredentialToPB(&creds[i])))
 	}
+	resp.SetNextPageToken(next)
 
 	return resp, nil
 }
@@ -167,6 +170,9 @@ func (h *CredentialHandler) UpdateCredential(ctx context.Context, req *pb.Update
 		Login:    credProto.GetLogin(),
 		Password: credProto.GetPassword(),
 		Metadata: credProto.GetMetadata(),
+		Folder:   credProto.GetFolder(),
+		Tags:     credProto.GetTags(),
+		Version:  credProto.GetVersion(),
 	}
 
 	existing, err := h.service.GetByID(ctx, cred.ID)
@@ -184,7 +190,7 @@ func (h *CredentialHandler) UpdateCredential(ctx context.Context, req *pb.Update
 			zap.String("credentialID", credProto.GetId()),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, updateError(err)
 	}
 
 	h.logger.Info("UpdateCredential succeeded",
@@ -192,7 +198,9 @@ func (h *CredentialHandler) UpdateCredential(ctx context.Context, req *pb.Update
 		zap.String("credentialID", credProto.GetId()),
 	)
 
-	return &pb.UpdateCredentialResponse{}, nil
+	resp := &pb.UpdateCredentialResponse{}
+	resp.SetVersion(cred.Version)
+	return resp, nil
 }
 
 // DeleteCredential удаляет запись учётных данных по идентификатору.
@@ -13,7 +13,6 @@ import (
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type CredentialServiceMock struct {
@@ -30,9 +29,12 @@ func (m *CredentialServiceMock) GetByID(ctx context.Context, id string) (*model.
 	return args.Get(0).(*model.Credential), args.Error(1)
 }
 
-func (m *CredentialServiceMock) GetByUserID(ctx context.Context, userID string) ([]model.Credential, error) {
-	args := m.Called(ctx, userID)
-	return args.Get(0).([]model.Credential), args.Error(1)
+func (m *CredentialServiceMock) GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
+	args := m.Called(ctx, userID, filter, page)
+	if v := args.Get(0); v != nil {
+		return v.([]model.Credential), args.String(1), args.Error(2)
+	}
+	return nil, args.String(1), args.Error(2)
 }
 
 func (m *CredentialServiceMock) Update(ctx context.Context, cred *model.Credential) error {
@@ -75,6 +77,20 @@ func TestCreateCredential_Success(t *testing.T) {
 	mockService.AssertExpectations(t)
 }
 
+func TestCreateCredential_QuotaExceeded(t *testing.T) {
+	mockService := new(CredentialServiceMock)
+	h := handlers.NewCredentialHandler(mockService, zap.NewNop())
+
+	req := &pb.CreateCredentialRequest{}
+	req.SetCredential(&pb.Credential{})
+
+	ctx := contextWithUserID("user-123")
+	mockService.On("Create", ctx, mock.Anything).Return(model.ErrQuotaExceeded)
+
+	_, err := h.CreateCredential(ctx, req)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+}
+
 func TestCreateCredential_Unauthenticated(t *testing.T) {
 	mockService := new(CredentialServiceMock)
 	h := handlers.NewCredentialHandler(mockService, zap.NewNop())
@@ -149,15 +165,83 @@ func TestGetCredentialsByUserID_Success(t *testing.T) {
 
 	ctx := contextWithUserID(userID)
 
-	mockService.On("GetByUserID", ctx, userID).Return(creds, nil)
+	mockService.On("GetByUserID", ctx, userID, model.ListFilter{}, model.PageRequest{}).Return(creds, "", nil)
 
-	resp, err := h.GetCredentials(ctx, &emptypb.Empty{})
+	resp, err := h.GetCredentials(ctx, &pb.GetCredentialsRequest{})
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
 	assert.Len(t, resp.GetCredentials(), 2)
 	mockService.AssertExpectations(t)
 }
 
+func TestGetCredentials_WithFilter(t *testing.T) {
+	userID := "user-123"
+	mockService := new(CredentialServiceMock)
+	h := handlers.NewCredentialHandler(mockService, zap.NewNop())
+
+	creds := []model.Credential{
+		{ID: "1", UserID: userID, Title: "Site1", Folder: "Work", Tags: model.Tags{"enc-tag"}},
+	}
+
+	ctx := contextWithUserID(userID)
+	filter := model.ListFilter{Folder: "Work", Tags: []string{"enc-tag"}}
+
+	mockService.On("GetByUserID", ctx, userID, filter, model.PageRequest{}).Return(creds, "", nil)
+
+	req := &pb.GetCredentialsRequest{}
+	pbFilter := &pb.ListFilter{}
+	pbFilter.SetFolder("Work")
+	pbFilter.SetTags([]string{"enc-tag"})
+	req.SetFilter(pbFilter)
+
+	resp, err := h.GetCredentials(ctx, req)
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetCredentials(), 1)
+	assert.Equal(t, "Work", resp.GetCredentials()[0].GetFolder())
+	assert.Equal(t, []string{"enc-tag"}, resp.GetCredentials()[0].GetTags())
+	mockService.AssertExpectations(t)
+}
+
+func TestGetCredentials_WithPage(t *testing.T) {
+	userID := "user-123"
+	mockService := new(CredentialServiceMock)
+	h := handlers.NewCredentialHandler(mockService, zap.NewNop())
+
+	ctx := contextWithUserID(userID)
+	page := model.PageRequest{Size: 20, Token: "tok", SortBy: model.SortByTitle, Desc: true}
+
+	mockService.On("GetByUserID", ctx, userID, model.ListFilter{}, page).
+		Return([]model.Credential{{ID: "1", UserID: userID, Title: "Site1"}}, "next-token", nil)
+
+	req := &pb.GetCredentialsRequest{}
+	pbPage := &pb.PageRequest{}
+	pbPage.SetPageSize(20)
+	pbPage.SetPageToken("tok")
+	pbPage.SetSortBy(pb.SortField_SORT_FIELD_TITLE)
+	pbPage.SetDescending(true)
+	req.SetPage(pbPage)
+
+	resp, err := h.GetCredentials(ctx, req)
+	assert.NoError(t, err)
+	assert.Len(t, resp.GetCredentials(), 1)
+	assert.Equal(t, "next-token", resp.GetNextPageToken())
+	mockService.AssertExpectations(t)
+}
+
+func TestGetCredentials_InvalidPageToken(t *testing.T) {
+	userID := "user-123"
+	mockService := new(CredentialServiceMock)
+	h := handlers.NewCredentialHandler(mockService, zap.NewNop())
+
+	ctx := contextWithUserID(userID)
+	mockService.On("GetByUserID", ctx, userID, model.ListFilter{}, model.PageRequest{}).
+		Return(nil, "", model.ErrInvalidPageToken)
+
+	_, err := h.GetCredentials(ctx, &pb.GetCredentialsRequest{})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
 func TestUpdateCredential_Success(t *testing.T) {
 	userID := "user-123"
 	credID := "cred-1"
@@ -5,7 +5,21 @@
 //   - CredentialService: управление учетными данными (создание, получение, обновление, удаление).
 //   - BankCardService: управление банковскими картами пользователя.
 //   - TextDataService: управление текстовыми данными пользователя.
-//   - BinaryDataService: работа с бинарными данными, включая потоковую загрузку и скачивание чанками, а также управление метаданными.
+//   - BinaryDataService: работа с бинарными данными, включая потоковую загрузку и скачивание чанками,
+//     загрузку с докачкой (сессии загрузки и GetUploadStatus), скачивание диапазонов
+//     (offset/length), загрузку и скачивание файлов фрагментами с дедупликацией
+//     (FindMissingChunks, UploadChunk, CommitChunkedUpload, GetManifest,
+//     DownloadChunk), проверку контрольных сумм при скачивании и по запросу
+//     (VerifyBinaryData), прежние версии содержимого (ListBinaryVersions,
+//     RestoreBinaryVersion, version_id при скачивании), а также управление метаданными.
+//   - ItemService: избранное и недавно открытые записи всех типов, занятое
+//     пользователем место и его ограничения (GetUsage). Превышение ограничений
+//     при создании записей и загрузке файлов возвращается как ResourceExhausted.
+//   - BatchService: пакетное создание, обновление и удаление записей в одной транзакции.
+//   - SyncService: разностная синхронизация по журналу изменений с курсором
+//     и поток уведомлений об изменениях (WatchChanges).
+//   - AdminService: запуск сверки хранилища бинарных данных и последний отчёт
+//     о ней (RunScrub, GetScrubReport).
 //
-// Все обработчики используют JWT для идентификации пользователя и интегрированы с zap.Logger для детального логирования операций.
+// Все обработчики, кроме AdminService, использующего токен администратора, используют JWT для идентификации пользователя и интегрированы с zap.Logger для детального логирования операций.
 package handlers
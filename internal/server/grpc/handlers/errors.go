@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// quotaError преобразует превышение ограничений пользователя на объём
+// файлов или число записей в статус ResourceExhausted, остальные ошибки
+// возвращаются без изменений.
+func quotaError(err error) error {
+	if errors.Is(err, model.ErrQuotaExceeded) {
+		return status.Error(codes.ResourceExhausted, err.Error())
+	}
+	return err
+}
+
+// updateError преобразует ошибку обновления записи в gRPC-статус:
+// несовпадение версии — Aborted (клиент должен перечитать запись и
+// разрешить конфликт), остальные ошибки — как в quotaError.
+func updateError(err error) error {
+	if errors.Is(err, model.ErrVersionConflict) {
+		return status.Error(codes.Aborted, err.Error())
+	}
+	return quotaError(err)
+}
+
+// chunkError преобразует ошибку работы с содержимым файла в gRPC-статус:
+// отсутствие фрагментов манифеста и обращение к файлу из фрагментов как к
+// единому потоку — FailedPrecondition, повреждённое содержимое — DataLoss,
+// неизвестная прежняя версия — NotFound, остальные — как в updateError.
+func chunkError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrMissingChunks), errors.Is(err, model.ErrChunkedFile):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrChecksumMismatch):
+		return status.Error(codes.DataLoss, err.Error())
+	case errors.Is(err, model.ErrBinaryVersionNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return updateError(err)
+}
@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/jwtauth"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ItemHandler реализует gRPC сервер для ItemService
+type ItemHandler struct {
+	pb.UnimplementedItemServiceServer
+	service service.ItemService
+	logger  *zap.Logger
+}
+
+// NewItemHandler создает новый ItemHandler с внедрением сервиса и логгера.
+func NewItemHandler(srv service.ItemService, logger *zap.Logger) *ItemHandler {
+	return &ItemHandler{
+		service: srv,
+		logger:  logger,
+	}
+}
+
+// SetFavorite устанавливает или снимает отметку «избранное» у записи
+func (h *ItemHandler) SetFavorite(ctx context.Context, req *pb.SetFavoriteRequest) (*pb.SetFavoriteResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	itemType := mapper.ItemTypeFromPB(req.GetType())
+	if err := itemType.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.service.SetFavorite(ctx, userID, itemType, req.GetId(), req.GetFavorite()); err != nil {
+		h.logger.Warn("SetFavorite failed",
+			zap.String("userID", userID),
+			zap.String("itemType", string(itemType)),
+			zap.String("itemID", req.GetId()),
+			zap.Error(err),
+		)
+		return nil, itemError(err)
+	}
+
+	return &pb.SetFavoriteResponse{}, nil
+}
+
+// MarkAccessed фиксирует время последнего открытия записи
+func (h *ItemHandler) MarkAccessed(ctx context.Context, req *pb.MarkAccessedRequest) (*pb.MarkAccessedResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	itemType := mapper.ItemTypeFromPB(req.GetType())
+	if err := itemType.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := h.service.MarkAccessed(ctx, userID, itemType, req.GetId()); err != nil {
+		h.logger.Warn("MarkAccessed failed",
+			zap.String("userID", userID),
+			zap.String("itemType", string(itemType)),
+			zap.String("itemID", req.GetId()),
+			zap.Error(err),
+		)
+		return nil, itemError(err)
+	}
+
+	return &pb.MarkAccessedResponse{}, nil
+}
+
+// ListFavoritesRecent возвращает избранные и недавно открытые записи пользователя всех типов
+func (h *ItemHandler) ListFavoritesRecent(ctx context.Context, req *pb.ListFavoritesRecentRequest) (*pb.ListFavoritesRecentResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	items, err := h.service.ListFavoritesRecent(ctx, userID, int(req.GetLimit()))
+	if err != nil {
+		h.logger.Warn("ListFavoritesRecent failed", zap.String("userID", userID), zap.Error(err))
+		return nil, err
+	}
+
+	resp := &pb.ListFavoritesRecentResponse{}
+	for _, item := range items {
+		resp.SetItems(append(resp.GetItems(), mapper.ItemSummaryToPB(item)))
+	}
+	return resp, nil
+}
+
+// GetUsage возвращает занятое пользователем место и ограничения сервера
+func (h *ItemHandler) GetUsage(ctx context.Context, req *pb.GetUsageRequest) (*pb.GetUsageResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	usage, err := h.service.Usage(ctx, userID)
+	if err != nil {
+		h.logger.Warn("GetUsage failed", zap.String("userID", userID), zap.Error(err))
+		return nil, err
+	}
+	return mapper.UsageToPB(usage), nil
+}
+
+// itemError преобразует ошибку «запись не найдена» в статус NotFound.
+func itemError(err error) error {
+	if strings.Contains(err.Error(), "not found") {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	return err
+}
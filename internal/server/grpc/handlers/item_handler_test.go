@@ -0,0 +1,144 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/ryabkov82/gophkeeper/internal/server/grpc/handlers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Мок ItemService
+type mockItemService struct {
+	mock.Mock
+}
+
+func (m *mockItemService) SetFavorite(ctx context.Context, userID string, itemType model.ItemType, id string, favorite bool) error {
+	return m.Called(ctx, userID, itemType, id, favorite).Error(0)
+}
+
+func (m *mockItemService) MarkAccessed(ctx context.Context, userID string, itemType model.ItemType, id string) error {
+	return m.Called(ctx, userID, itemType, id).Error(0)
+}
+
+func (m *mockItemService) ListFavoritesRecent(ctx context.Context, userID string, limit int) ([]model.ItemSummary, error) {
+	args := m.Called(ctx, userID, limit)
+	items := args.Get(0)
+	if items == nil {
+		return nil, args.Error(1)
+	}
+	return items.([]model.ItemSummary), args.Error(1)
+}
+
+func (m *mockItemService) Usage(ctx context.Context, userID string) (*model.Usage, error) {
+	args := m.Called(ctx, userID)
+	if v := args.Get(0); v != nil {
+		return v.(*model.Usage), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func TestSetFavorite_Success(t *testing.T) {
+	mockSvc := new(mockItemService)
+	h := handlers.NewItemHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	mockSvc.On("SetFavorite", ctx, "user1", model.ItemTypeBankCard, "card1", true).Return(nil)
+
+	req := &pb.SetFavoriteRequest{}
+	req.SetType(pb.ItemType_ITEM_TYPE_BANK_CARD)
+	req.SetId("card1")
+	req.SetFavorite(true)
+
+	_, err := h.SetFavorite(ctx, req)
+	assert.NoError(t, err)
+	mockSvc.AssertExpectations(t)
+}
+
+func TestSetFavorite_InvalidType(t *testing.T) {
+	h := handlers.NewItemHandler(new(mockItemService), zap.NewNop())
+
+	req := &pb.SetFavoriteRequest{}
+	req.SetId("card1")
+
+	_, err := h.SetFavorite(mockJWTContext("user1"), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestMarkAccessed_NotFound(t *testing.T) {
+	mockSvc := new(mockItemService)
+	h := handlers.NewItemHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	mockSvc.On("MarkAccessed", ctx, "user1", model.ItemTypeCredential, "cred1").
+		Return(errors.New("credential with id cred1 not found"))
+
+	req := &pb.MarkAccessedRequest{}
+	req.SetType(pb.ItemType_ITEM_TYPE_CREDENTIAL)
+	req.SetId("cred1")
+
+	_, err := h.MarkAccessed(ctx, req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.NotFound, st.Code())
+}
+
+func TestMarkAccessed_Unauthenticated(t *testing.T) {
+	h := handlers.NewItemHandler(new(mockItemService), zap.NewNop())
+
+	_, err := h.MarkAccessed(context.Background(), &pb.MarkAccessedRequest{})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+func TestListFavoritesRecent_Success(t *testing.T) {
+	mockSvc := new(mockItemService)
+	h := handlers.NewItemHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	accessed := time.Now()
+	items := []model.ItemSummary{
+		{Type: model.ItemTypeCredential, ID: "cred1", Title: "GitHub", Favorite: true},
+		{Type: model.ItemTypeTextData, ID: "note1", Title: "Notes", LastAccessedAt: &accessed},
+	}
+	mockSvc.On("ListFavoritesRecent", ctx, "user1", 20).Return(items, nil)
+
+	req := &pb.ListFavoritesRecentRequest{}
+	req.SetLimit(20)
+
+	resp, err := h.ListFavoritesRecent(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.GetItems(), 2)
+	assert.Equal(t, pb.ItemType_ITEM_TYPE_CREDENTIAL, resp.GetItems()[0].GetType())
+	assert.True(t, resp.GetItems()[0].GetFavorite())
+	assert.False(t, resp.GetItems()[0].HasLastAccessedAt())
+	assert.Equal(t, pb.ItemType_ITEM_TYPE_TEXT_DATA, resp.GetItems()[1].GetType())
+	assert.True(t, resp.GetItems()[1].HasLastAccessedAt())
+	mockSvc.AssertExpectations(t)
+}
+
+func TestGetUsage_Success(t *testing.T) {
+	mockSvc := new(mockItemService)
+	h := handlers.NewItemHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	mockSvc.On("Usage", ctx, "user1").Return(&model.Usage{
+		Bytes: 2048, Items: 12, Limits: model.Quota{MaxBytes: 1 << 20},
+	}, nil)
+
+	resp, err := h.GetUsage(ctx, &pb.GetUsageRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2048), resp.GetBytes())
+	assert.Equal(t, int64(12), resp.GetItems())
+	assert.Equal(t, int64(1<<20), resp.GetMaxBytes())
+	assert.Zero(t, resp.GetMaxItems())
+}
@@ -0,0 +1,19 @@
+package handlers
+
+import (
+	"errors"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// listError преобразует ошибку получения страницы списка в gRPC-статус:
+// повреждённый или чужой токен страницы — InvalidArgument, остальные ошибки
+// возвращаются без изменений.
+func listError(err error) error {
+	if errors.Is(err, model.ErrInvalidPageToken) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return err
+}
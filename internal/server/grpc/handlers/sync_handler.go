@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/jwtauth"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/mapper"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"go.uber.org/zap"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SyncHandler реализует gRPC сервер для SyncService
+type SyncHandler struct {
+	pb.UnimplementedSyncServiceServer
+	service service.SyncService
+	logger  *zap.Logger
+}
+
+// NewSyncHandler создает новый SyncHandler с внедрением сервиса и логгера.
+func NewSyncHandler(srv service.SyncService, logger *zap.Logger) *SyncHandler {
+	return &SyncHandler{
+		service: srv,
+		logger:  logger,
+	}
+}
+
+// ListChanges возвращает изменения записей пользователя после переданного курсора
+func (h *SyncHandler) ListChanges(ctx context.Context, req *pb.ListChangesRequest) (*pb.ListChangesResponse, error) {
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	if req.GetCursor() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "cursor must not be negative")
+	}
+
+	batch, err := h.service.ListChanges(ctx, userID, req.GetCursor(), int(req.GetLimit()))
+	if err != nil {
+		h.logger.Warn("ListChanges failed",
+			zap.String("userID", userID),
+			zap.Int64("cursor", req.GetCursor()),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	resp := &pb.ListChangesResponse{}
+	changes := make([]*pb.Change, 0, len(batch.Changes))
+	for _, ch := range batch.Changes {
+		changes = append(changes, mapper.ChangeToPB(ch))
+	}
+	resp.SetChanges(changes)
+	resp.SetCursor(batch.Cursor)
+	resp.SetHasMore(batch.HasMore)
+	return resp, nil
+}
+
+// WatchChanges отправляет клиенту уведомления об изменениях его записей,
+// пока клиент не закроет поток.
+func (h *SyncHandler) WatchChanges(req *pb.WatchChangesRequest, stream pb.SyncService_WatchChangesServer) error {
+	ctx := stream.Context()
+	userID, err := jwtauth.FromContext(ctx)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, "userID not found in context")
+	}
+
+	events, err := h.service.WatchChanges(ctx, userID)
+	if err != nil {
+		h.logger.Warn("WatchChanges failed", zap.String("userID", userID), zap.Error(err))
+		return status.Error(codes.Unavailable, "change notifications are unavailable")
+	}
+
+	h.logger.Debug("WatchChanges started", zap.String("userID", userID))
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-events:
+			if !ok {
+				// Источник уведомлений остановлен (например, сервер завершает работу)
+				return status.Error(codes.Unavailable, "change notifications stopped")
+			}
+			if err := stream.Send(mapper.ChangeEventToPB(ev)); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package handlers_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	pb "github.com/ryabkov82/gophkeeper/internal/pkg/proto"
+	"github.com/ryabkov82/gophkeeper/internal/server/grpc/handlers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Мок SyncService
+type mockSyncService struct {
+	mock.Mock
+}
+
+func (m *mockSyncService) ListChanges(ctx context.Context, userID string, cursor int64, limit int) (model.ChangeBatch, error) {
+	args := m.Called(ctx, userID, cursor, limit)
+	return args.Get(0).(model.ChangeBatch), args.Error(1)
+}
+
+func (m *mockSyncService) WatchChanges(ctx context.Context, userID string) (<-chan model.ChangeEvent, error) {
+	args := m.Called(ctx, userID)
+	ch, _ := args.Get(0).(chan model.ChangeEvent)
+	if ch == nil {
+		return nil, args.Error(1)
+	}
+	return ch, args.Error(1)
+}
+
+// --- мок стрима для WatchChanges ---
+type mockWatchStream struct {
+	pb.SyncService_WatchChangesServer
+	ctx  context.Context
+	sent []*pb.ChangeEvent
+}
+
+func (m *mockWatchStream) Send(ev *pb.ChangeEvent) error {
+	m.sent = append(m.sent, ev)
+	return nil
+}
+
+func (m *mockWatchStream) Context() context.Context {
+	return m.ctx
+}
+
+func TestListChanges_Success(t *testing.T) {
+	mockSvc := new(mockSyncService)
+	h := handlers.NewSyncHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	now := time.Now()
+	mockSvc.On("ListChanges", ctx, "user1", int64(10), 50).Return(model.ChangeBatch{
+		Changes: []model.Change{
+			{Seq: 11, Type: model.ItemTypeCredential, ItemID: "c1", ChangedAt: now,
+				Credential: &model.Credential{ID: "c1", Title: "GitHub"}},
+			{Seq: 12, Type: model.ItemTypeBankCard, ItemID: "b1", Deleted: true, ChangedAt: now},
+		},
+		Cursor:  12,
+		HasMore: true,
+	}, nil)
+
+	req := &pb.ListChangesRequest{}
+	req.SetCursor(10)
+	req.SetLimit(50)
+
+	resp, err := h.ListChanges(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.GetChanges(), 2)
+	assert.Equal(t, int64(12), resp.GetCursor())
+	assert.True(t, resp.GetHasMore())
+
+	first := resp.GetChanges()[0]
+	assert.Equal(t, pb.ItemType_ITEM_TYPE_CREDENTIAL, first.GetType())
+	assert.Equal(t, "GitHub", first.GetCredential().GetTitle())
+
+	tombstone := resp.GetChanges()[1]
+	assert.True(t, tombstone.GetDeleted())
+	assert.Equal(t, "b1", tombstone.GetId())
+	assert.False(t, tombstone.HasItem())
+	mockSvc.AssertExpectations(t)
+}
+
+func TestListChanges_NegativeCursor(t *testing.T) {
+	h := handlers.NewSyncHandler(new(mockSyncService), zap.NewNop())
+
+	req := &pb.ListChangesRequest{}
+	req.SetCursor(-1)
+
+	_, err := h.ListChanges(mockJWTContext("user1"), req)
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+}
+
+func TestListChanges_Unauthenticated(t *testing.T) {
+	h := handlers.NewSyncHandler(new(mockSyncService), zap.NewNop())
+
+	_, err := h.ListChanges(context.Background(), &pb.ListChangesRequest{})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+func TestListChanges_ServiceError(t *testing.T) {
+	mockSvc := new(mockSyncService)
+	h := handlers.NewSyncHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	mockSvc.On("ListChanges", ctx, "user1", int64(0), 0).Return(model.ChangeBatch{}, errors.New("db down"))
+
+	_, err := h.ListChanges(ctx, &pb.ListChangesRequest{})
+	assert.Error(t, err)
+}
+
+func TestWatchChanges_SendsEvents(t *testing.T) {
+	mockSvc := new(mockSyncService)
+	h := handlers.NewSyncHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	events := make(chan model.ChangeEvent, 2)
+	events <- model.ChangeEvent{UserID: "user1", Seq: 4, Type: model.ItemTypeTextData, ItemID: "t1"}
+	events <- model.ChangeEvent{UserID: "user1", Seq: 5, Type: model.ItemTypeCredential, ItemID: "c1", Deleted: true}
+	close(events)
+	mockSvc.On("WatchChanges", ctx, "user1").Return(events, nil)
+
+	stream := &mockWatchStream{ctx: ctx}
+	err := h.WatchChanges(&pb.WatchChangesRequest{}, stream)
+
+	// Закрытие канала означает остановку источника уведомлений
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unavailable, st.Code())
+	require.Len(t, stream.sent, 2)
+	assert.Equal(t, int64(4), stream.sent[0].GetSeq())
+	assert.Equal(t, pb.ItemType_ITEM_TYPE_TEXT_DATA, stream.sent[0].GetType())
+	assert.Equal(t, "c1", stream.sent[1].GetId())
+	assert.True(t, stream.sent[1].GetDeleted())
+}
+
+func TestWatchChanges_ClientCancel(t *testing.T) {
+	mockSvc := new(mockSyncService)
+	h := handlers.NewSyncHandler(mockSvc, zap.NewNop())
+	ctx, cancel := context.WithCancel(mockJWTContext("user1"))
+
+	mockSvc.On("WatchChanges", ctx, "user1").Return(make(chan model.ChangeEvent), nil)
+	cancel()
+
+	err := h.WatchChanges(&pb.WatchChangesRequest{}, &mockWatchStream{ctx: ctx})
+	assert.NoError(t, err)
+}
+
+func TestWatchChanges_Unauthenticated(t *testing.T) {
+	h := handlers.NewSyncHandler(new(mockSyncService), zap.NewNop())
+
+	err := h.WatchChanges(&pb.WatchChangesRequest{}, &mockWatchStream{ctx: context.Background()})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unauthenticated, st.Code())
+}
+
+func TestWatchChanges_ServiceError(t *testing.T) {
+	mockSvc := new(mockSyncService)
+	h := handlers.NewSyncHandler(mockSvc, zap.NewNop())
+	ctx := mockJWTContext("user1")
+
+	mockSvc.On("WatchChanges", ctx, "user1").Return(nil, errors.New("no feed"))
+
+	err := h.WatchChanges(&pb.WatchChangesRequest{}, &mockWatchStream{ctx: ctx})
+	st, _ := status.FromError(err)
+	assert.Equal(t, codes.Unavailable, st.Code())
+}
@@ -42,6 +42,8 @@ func (h *TextDataHandler) CreateTextData(ctx context.Context, req *pb.CreateText
 		Title:    req.GetTextData().GetTitle(),
 		Content:  req.GetTextData().GetContent(),
 		Metadata: req.GetTextData().GetMetadata(),
+		Folder:   req.GetTextData().GetFolder(),
+		Tags:     req.GetTextData().GetTags(),
 	}
 
 	h.logger.Debug("CreateTextData request received",
@@ -55,7 +57,7 @@ func (h *TextDataHandler) CreateTextData(ctx context.Context, req *pb.CreateText
 			zap.String("title", text.Title),
 			zap.Error(err),
 		)
-		return nil, err
+		return nil, quotaError(err)
 	}
 
 	h.logger.Info("CreateTextData succeeded",
@@ -97,16 +99,17 @@ func (h *TextDataHandler) GetTextDataByID(ctx context.Context, req *pb.GetTextDa
 	return resp, nil
 }
 
-// GetTextDataTitles возвращает список заголовков текстовых данных пользователя
+// GetTextDataTitles возвращает страницу заголовков текстовых данных пользователя
 func (h *TextDataHandler) GetTextDataTitles(ctx context.Context, req *pb.GetTextDataTitlesRequest) (*pb.GetTextDataTitlesResponse, error) {
 	userID, err := jwtauth.FromContext(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Unauthenticated, "userID not found in context")
 	}
 
-	titles, err := h.service.ListTitles(ctx, userID)
+	titles, next, err := h.service.ListTitles(ctx, userID,
+		mapper.ListFilterFromPB(req.GetFilter()), mapper.PageRequestFromPB(req.GetPage()))
 	if err != nil {
-		return nil, err
+		return nil, listError(err)
 	}
 
 	resp := &pb.GetTextDataTitlesResponse{}
@@ -119,6 +122,7 @@ func (h *TextDataHandler) GetTextDataTitles(ctx context.Context, req *pb.GetText
 		td.SetUpdatedAt(nil)
 		resp.SetTextDataTitles(append(resp.GetTextDataTitles(), td))
 	}
+	resp.SetNextPageToken(next)
 	return resp, nil
 }
 
@@ -135,15 +139,19 @@ func (h *TextDataHandler) UpdateTextData(ctx context.Context, req *pb.UpdateText
 		Title:    req.GetTextData().GetTitle(),
 		Content:  req.GetTextData().GetContent(),
 		Metadata: req.GetTextData().GetMetadata(),
+		Folder:   req.GetTextData().GetFolder(),
+		Tags:     req.GetTextData().GetTags(),
+		Version:  req.GetTextData().GetVersion(),
 	}
 
 	err = h.service.Update(ctx, data)
 	if err != nil {
-		return nil, err
+		return nil, updateError(err)
 	}
 
 	resp := &pb.UpdateTextDataResponse{}
 	resp.SetSuccess(true)
+	resp.SetVersion(data.Version)
 	return resp, nil
 }
 
@@ -13,6 +13,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Мок TextDataService
@@ -41,13 +43,13 @@ func (m *mockTextDataService) Delete(ctx context.Context, userID, id string) err
 	return m.Called(ctx, userID, id).Error(0)
 }
 
-func (m *mockTextDataService) ListTitles(ctx context.Context, userID string) ([]*model.TextData, error) {
-	args := m.Called(ctx, userID)
+func (m *mockTextDataService) ListTitles(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	args := m.Called(ctx, userID, filter, page)
 	data := args.Get(0)
 	if data == nil {
-		return nil, args.Error(1)
+		return nil, args.String(1), args.Error(2)
 	}
-	return data.([]*model.TextData), args.Error(1)
+	return data.([]*model.TextData), args.String(1), args.Error(2)
 }
 
 func TestCreateTextData_Success(t *testing.T) {
@@ -73,6 +75,29 @@ func TestCreateTextData_Success(t *testing.T) {
 	assert.Empty(t, resp.GetTextData().GetContent()) // Content не возвращаем
 }
 
+func TestCreateTextData_KeepsFolderAndTags(t *testing.T) {
+	mockSvc := new(mockTextDataService)
+	h := handlers.NewTextDataHandler(mockSvc, zap.NewNop())
+
+	ctx := mockJWTContext(uuid.NewString())
+
+	req := &pb.CreateTextDataRequest{}
+	tdProto := &pb.TextData{}
+	tdProto.SetTitle("My Note")
+	tdProto.SetContent([]byte("Secret content"))
+	tdProto.SetFolder("Work")
+	tdProto.SetTags([]string{"enc-tag"})
+	req.SetTextData(tdProto)
+
+	mockSvc.On("Create", mock.Anything, mock.MatchedBy(func(td *model.TextData) bool {
+		return td.Folder == "Work" && len(td.Tags) == 1 && td.Tags[0] == "enc-tag"
+	})).Return(nil)
+
+	_, err := h.CreateTextData(ctx, req)
+	assert.NoError(t, err)
+	mockSvc.AssertExpectations(t)
+}
+
 func TestCreateTextData_Unauthenticated(t *testing.T) {
 	mockSvc := new(mockTextDataService)
 	logger := zap.NewNop()
@@ -139,7 +164,7 @@ func TestGetTextDataTitles_Success(t *testing.T) {
 		{ID: uuid.NewString(), UserID: userID, Title: "Note2"},
 	}
 
-	mockSvc.On("ListTitles", mock.Anything, userID).Return(tds, nil)
+	mockSvc.On("ListTitles", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}).Return(tds, "", nil)
 
 	// Вызов хендлера
 	resp, err := h.GetTextDataTitles(ctx, &pb.GetTextDataTitlesRequest{})
@@ -157,7 +182,7 @@ func TestGetTextDataTitles_ServiceError(t *testing.T) {
 	userID := uuid.NewString()
 	ctx := mockJWTContext(userID)
 
-	mockSvc.On("ListTitles", mock.Anything, userID).Return(nil, errors.New("service error"))
+	mockSvc.On("ListTitles", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}).Return(nil, "", errors.New("service error"))
 
 	_, err := h.GetTextDataTitles(ctx, &pb.GetTextDataTitlesRequest{})
 	assert.Error(t, err)
@@ -223,3 +248,44 @@ func TestDeleteTextData_NotFound(t *testing.T) {
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "not found")
 }
+
+func TestUpdateTextData_VersionConflict(t *testing.T) {
+	mockSvc := new(mockTextDataService)
+	h := handlers.NewTextDataHandler(mockSvc, zap.NewNop())
+
+	ctx := mockJWTContext(uuid.NewString())
+
+	mockSvc.On("Update", mock.Anything, mock.MatchedBy(func(td *model.TextData) bool {
+		return td.Version == 3
+	})).Return(model.ErrVersionConflict)
+
+	tdProto := &pb.TextData{}
+	tdProto.SetId(uuid.NewString())
+	tdProto.SetVersion(3)
+	req := &pb.UpdateTextDataRequest{}
+	req.SetTextData(tdProto)
+
+	_, err := h.UpdateTextData(ctx, req)
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
+
+func TestUpdateTextData_ReturnsNewVersion(t *testing.T) {
+	mockSvc := new(mockTextDataService)
+	h := handlers.NewTextDataHandler(mockSvc, zap.NewNop())
+
+	ctx := mockJWTContext(uuid.NewString())
+
+	mockSvc.On("Update", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(*model.TextData).Version = 4
+	}).Return(nil)
+
+	tdProto := &pb.TextData{}
+	tdProto.SetId(uuid.NewString())
+	tdProto.SetVersion(3)
+	req := &pb.UpdateTextDataRequest{}
+	req.SetTextData(tdProto)
+
+	resp, err := h.UpdateTextData(ctx, req)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), resp.GetVersion())
+}
@@ -0,0 +1,51 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/subtle"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// adminServicePrefix — префикс полных имён методов AdminService.
+const adminServicePrefix = "/gophkeeper.proto.AdminService/"
+
+// AdminTokenHeader — ключ метаданных с токеном администратора.
+const AdminTokenHeader = "x-admin-token"
+
+// isAdminMethod сообщает, относится ли метод к AdminService.
+func isAdminMethod(method string) bool {
+	return strings.HasPrefix(method, adminServicePrefix)
+}
+
+// UnaryAdminInterceptor возвращает интерцептор, который пропускает к методам
+// AdminService только запросы с токеном администратора token в метаданных
+// x-admin-token. Если token пуст, AdminService недоступен. Остальные методы
+// интерцептор не проверяет: их аутентифицирует UnaryAuthInterceptor.
+func UnaryAdminInterceptor(token string, logger *zap.Logger) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isAdminMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if token == "" {
+			return nil, status.Error(codes.PermissionDenied, "admin API is disabled")
+		}
+
+		var got string
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if vals := md.Get(AdminTokenHeader); len(vals) > 0 {
+				got = vals[0]
+			}
+		}
+		if subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			logger.Warn("Invalid admin token", zap.String("method", info.FullMethod))
+			return nil, status.Error(codes.Unauthenticated, "invalid admin token")
+		}
+		return handler(ctx, req)
+	}
+}
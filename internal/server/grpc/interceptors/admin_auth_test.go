@@ -0,0 +1,52 @@
+package interceptors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/server/grpc/interceptors"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnaryAdminInterceptor(t *testing.T) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	adminInfo := &grpc.UnaryServerInfo{FullMethod: "/gophkeeper.proto.AdminService/RunScrub"}
+	withToken := func(token string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(interceptors.AdminTokenHeader, token))
+	}
+
+	interceptor := interceptors.UnaryAdminInterceptor("secret", zap.NewNop())
+
+	t.Run("valid token", func(t *testing.T) {
+		resp, err := interceptor(withToken("secret"), "req", adminInfo, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		_, err := interceptor(withToken("wrong"), "req", adminInfo, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = interceptor(context.Background(), "req", adminInfo, handler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("other methods pass through", func(t *testing.T) {
+		resp, err := interceptor(context.Background(), "req", &grpc.UnaryServerInfo{FullMethod: "/test.Method"}, handler)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	t.Run("disabled without token", func(t *testing.T) {
+		disabled := interceptors.UnaryAdminInterceptor("", zap.NewNop())
+		_, err := disabled(withToken(""), "req", adminInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -2,6 +2,7 @@
 //
 // Включает:
 //   - UnaryAuthInterceptor — аутентификация на основе JWT токена.
+//   - UnaryAdminInterceptor — доступ к AdminService по токену администратора.
 //   - LoggingInterceptor — централизованное логирование gRPC-запросов.
 //
 // Интерцепторы используются для добавления сквозной функциональности
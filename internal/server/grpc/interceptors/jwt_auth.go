@@ -67,7 +67,8 @@ func StreamAuthInterceptor(tm *jwtutils.TokenManager, logger *zap.Logger) grpc.S
 }
 
 func authenticateCtx(ctx context.Context, tm *jwtutils.TokenManager, logger *zap.Logger, fullMethod string) (context.Context, error) {
-	if isPublicMethod(fullMethod) {
+	// Методы AdminService авторизуются токеном администратора (UnaryAdminInterceptor)
+	if isPublicMethod(fullMethod) || isAdminMethod(fullMethod) {
 		return ctx, nil
 	}
 
@@ -29,6 +29,7 @@ func NewGRPCServer(cfg *config.Config, logger *zap.Logger, serviceFactory servic
 	opts = append(opts,
 		grpc.ChainUnaryInterceptor(
 			interceptors.LoggingInterceptor(logger),
+			interceptors.UnaryAdminInterceptor(cfg.AdminToken, logger),
 			interceptors.UnaryAuthInterceptor(jwtManager, logger),
 			// можно добавить другие
 		),
@@ -71,6 +72,22 @@ func NewGRPCServer(cfg *config.Config, logger *zap.Logger, serviceFactory servic
 	binaryDataHandler := handlers.NewBinaryDataHandler(serviceFactory.BinaryData(), logger)
 	api.RegisterBinaryDataServiceServer(s, binaryDataHandler)
 
+	// Регистрируем хендлер избранного и недавних записей
+	itemHandler := handlers.NewItemHandler(serviceFactory.Item(), logger)
+	api.RegisterItemServiceServer(s, itemHandler)
+
+	// Регистрируем хендлер разностной синхронизации
+	syncHandler := handlers.NewSyncHandler(serviceFactory.Sync(), logger)
+	api.RegisterSyncServiceServer(s, syncHandler)
+
+	// Регистрируем хендлер пакетных изменений
+	batchHandler := handlers.NewBatchHandler(serviceFactory.Batch(), logger)
+	api.RegisterBatchServiceServer(s, batchHandler)
+
+	// Регистрируем служебный хендлер администратора
+	adminHandler := handlers.NewAdminHandler(serviceFactory.Scrub(), logger)
+	api.RegisterAdminServiceServer(s, adminHandler)
+
 	return s, nil
 }
 
@@ -70,6 +70,20 @@ func (m *mockBinaryDataService) Update(ctx context.Context, data *model.BinaryDa
 	return bd, args.Error(1)
 }
 
+func (m *mockBinaryDataService) ResumeUpload(ctx context.Context, data *model.BinaryData, uploadID string, offset int64, r io.Reader) (*model.BinaryData, error) {
+	args := m.Called(ctx, data, uploadID, offset, r)
+	var bd *model.BinaryData
+	if v := args.Get(0); v != nil {
+		bd = v.(*model.BinaryData)
+	}
+	return bd, args.Error(1)
+}
+
+func (m *mockBinaryDataService) UploadStatus(ctx context.Context, userID, uploadID string) (int64, error) {
+	args := m.Called(ctx, userID, uploadID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *mockBinaryDataService) CreateInfo(ctx context.Context, data *model.BinaryData) (*model.BinaryData, error) {
 	args := m.Called(ctx, data)
 	var bd *model.BinaryData
@@ -87,15 +101,15 @@ func (m *mockBinaryDataService) UpdateInfo(ctx context.Context, data *model.Bina
 	return bd, args.Error(1)
 }
 
-func (m *mockBinaryDataService) Get(ctx context.Context, userID, id string) (*model.BinaryData, io.ReadCloser, error) {
+func (m *mockBinaryDataService) Get(ctx context.Context, userID, id string) (*model.BinaryData, io.ReadSeekCloser, error) {
 	args := m.Called(ctx, userID, id)
 	var bd *model.BinaryData
 	if v := args.Get(0); v != nil {
 		bd = v.(*model.BinaryData)
 	}
-	var rc io.ReadCloser
+	var rc io.ReadSeekCloser
 	if v := args.Get(1); v != nil {
-		rc = v.(io.ReadCloser)
+		rc = v.(io.ReadSeekCloser)
 	}
 	return bd, rc, args.Error(2)
 }
@@ -109,23 +123,100 @@ func (m *mockBinaryDataService) GetInfo(ctx context.Context, userID, id string)
 	return bd, args.Error(1)
 }
 
-func (m *mockBinaryDataService) List(ctx context.Context, userID string) ([]*model.BinaryData, error) {
+func (m *mockBinaryDataService) List(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.BinaryData, string, error) {
 	args := m.Called(ctx, userID)
 	var list []*model.BinaryData
 	if v := args.Get(0); v != nil {
 		list = v.([]*model.BinaryData)
 	}
-	return list, args.Error(1)
+	return list, "", args.Error(1)
 }
 
 func (m *mockBinaryDataService) Delete(ctx context.Context, userID, id string) error {
 	return m.Called(ctx, userID, id).Error(0)
 }
 
+func (m *mockBinaryDataService) MissingChunks(ctx context.Context, userID string, ids []string) ([]string, error) {
+	args := m.Called(ctx, userID, ids)
+	if v := args.Get(0); v != nil {
+		return v.([]string), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockBinaryDataService) PutChunk(ctx context.Context, userID, id string, data []byte) error {
+	return m.Called(ctx, userID, id, data).Error(0)
+}
+
+func (m *mockBinaryDataService) CommitChunked(ctx context.Context, data *model.BinaryData, ids []string) (*model.BinaryData, error) {
+	args := m.Called(ctx, data, ids)
+	if v := args.Get(0); v != nil {
+		return v.(*model.BinaryData), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockBinaryDataService) Manifest(ctx context.Context, userID, id string) ([]model.ChunkRef, bool, error) {
+	args := m.Called(ctx, userID, id)
+	if v := args.Get(0); v != nil {
+		return v.([]model.ChunkRef), args.Bool(1), args.Error(2)
+	}
+	return nil, args.Bool(1), args.Error(2)
+}
+
+func (m *mockBinaryDataService) GetChunk(ctx context.Context, userID, id string) ([]byte, error) {
+	args := m.Called(ctx, userID, id)
+	if v := args.Get(0); v != nil {
+		return v.([]byte), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockBinaryDataService) Verify(ctx context.Context, userID, id string) error {
+	return m.Called(ctx, userID, id).Error(0)
+}
+
 func (m *mockBinaryDataService) Close() {
 	m.Called()
 }
 
+func (m *mockBinaryDataService) ListVersions(ctx context.Context, userID, id string) ([]*model.BinaryVersion, error) {
+	args := m.Called(ctx, userID, id)
+	if v := args.Get(0); v != nil {
+		return v.([]*model.BinaryVersion), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockBinaryDataService) GetVersion(ctx context.Context, userID, id, versionID string) (*model.BinaryData, io.ReadSeekCloser, error) {
+	args := m.Called(ctx, userID, id, versionID)
+	var data *model.BinaryData
+	if v := args.Get(0); v != nil {
+		data = v.(*model.BinaryData)
+	}
+	var r io.ReadSeekCloser
+	if v := args.Get(1); v != nil {
+		r = v.(io.ReadSeekCloser)
+	}
+	return data, r, args.Error(2)
+}
+
+func (m *mockBinaryDataService) VersionManifest(ctx context.Context, userID, id, versionID string) ([]model.ChunkRef, bool, error) {
+	args := m.Called(ctx, userID, id, versionID)
+	if v := args.Get(0); v != nil {
+		return v.([]model.ChunkRef), args.Bool(1), args.Error(2)
+	}
+	return nil, args.Bool(1), args.Error(2)
+}
+
+func (m *mockBinaryDataService) RestoreVersion(ctx context.Context, userID, id, versionID string, version int64) (*model.BinaryData, error) {
+	args := m.Called(ctx, userID, id, versionID, version)
+	if v := args.Get(0); v != nil {
+		return v.(*model.BinaryData), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 // --- мок ServiceFactory ---
 type mockServiceFactory struct {
 	binarySvc *mockBinaryDataService
@@ -149,6 +240,22 @@ func (m *mockServiceFactory) BinaryData() service.BinaryDataService {
 	return m.binarySvc
 }
 
+func (m *mockServiceFactory) Item() service.ItemService {
+	return nil
+}
+
+func (m *mockServiceFactory) Sync() service.SyncService {
+	return nil
+}
+
+func (m *mockServiceFactory) Batch() service.BatchService {
+	return nil
+}
+
+func (m *mockServiceFactory) Scrub() service.ScrubService {
+	return nil
+}
+
 func (m *mockServiceFactory) Close() {
 	if m.binarySvc != nil {
 		m.binarySvc.Close()
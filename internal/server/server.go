@@ -1,23 +1,29 @@
 package server
 
 import (
+	"context"
+	"fmt"
+	"sync"
 	"time"
 
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	domainstorage "github.com/ryabkov82/gophkeeper/internal/domain/storage"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/jwtutils"
 	"github.com/ryabkov82/gophkeeper/internal/server/config"
 	"github.com/ryabkov82/gophkeeper/internal/server/grpc"
-	"github.com/ryabkov82/gophkeeper/internal/server/service"
+	srvservice "github.com/ryabkov82/gophkeeper/internal/server/service"
 	"github.com/ryabkov82/gophkeeper/internal/server/storage"
-	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/memory"
 	"go.uber.org/zap"
 )
 
 // StartServer выполняет полную инициализацию и запуск gRPC-сервера приложения.
 //
 // Последовательно выполняются следующие шаги:
-//  1. Инициализация хранилища данных (PostgreSQL) через Init;
-//  2. Создание слоёв репозиториев и сервисов, включая JWT-менеджер;
-//  3. Запуск gRPC-сервера с зарегистрированными сервисами.
+//  1. Создание хранилищ и сервисов (см. NewServices);
+//  2. Запуск gRPC-сервера с зарегистрированными сервисами.
 //
 // В случае ошибки на любом этапе, функция логирует критическую ошибку
 // и завершает выполнение приложения.
@@ -29,26 +35,155 @@ import (
 //
 // Ошибки не возвращаются, функция завершает приложение через log.Fatal в случае сбоя.
 func StartServer(log *zap.Logger, cfg *config.Config) {
-	// 1. Инициализация БД
-	db, err := postgres.Init(cfg.DBConnect)
+	serviceFactory, err := NewServices(log, cfg)
 	if err != nil {
-		log.Fatal("Failed to initialize storage", zap.Error(err))
+		log.Fatal("Failed to initialize services", zap.Error(err))
 	}
 
-	// 2. Слои: repository -> services
-	storageFactory, err := storage.NewStorageFactory("postgres", db)
+	// Запуск gRPC сервера с набором сервисов
+	if err := grpc.StartGRPCServer(log, cfg, serviceFactory); err != nil {
+		log.Fatal("gRPC server failed", zap.Error(err))
+	}
+}
+
+// NewServices создаёт хранилища и сервисы сервера по конфигурации cfg:
+//  1. Инициализация хранилища записей (см. openStorage) и хранилища бинарных данных;
+//  2. Создание слоя сервисов, включая JWT-менеджер;
+//  3. Запуск периодической сверки хранилища бинарных данных и, если задан
+//     cfg.StorageReencrypt, фонового перешифрования файлов активным ключом.
+//     Close фабрики прерывает перешифрование и дожидается его остановки
+//     до закрытия хранилищ.
+//
+// Используется как отдельным сервером (StartServer), так и сервером,
+// встроенным в клиент (пакет embedded). Ресурсы освобождает Close
+// возвращённой фабрики.
+func NewServices(log *zap.Logger, cfg *config.Config) (service.ServiceFactory, error) {
+	// 1. Инициализация хранилищ
+	storageFactory, err := openStorage(cfg)
 	if err != nil {
-		log.Fatal("Failed to initialize storage", zap.Error(err))
+		return nil, fmt.Errorf("failed to initialize storage: %w", err)
+	}
+	if cfg.DBDriver == config.DBDriverMemory {
+		log.Warn("Using in-memory storage: all data is lost on restart", zap.String("seed", cfg.SeedFile))
 	}
 
-	binaryFactory := storage.NewBinaryDataFactory(cfg)
+	binaryFactory, err := storage.NewBinaryDataFactory(cfg)
+	if err != nil {
+		_ = storageFactory.Close()
+		return nil, fmt.Errorf("failed to initialize binary data storage: %w", err)
+	}
 	binaryStorage := binaryFactory.BinaryData()
 
+	scrubPeriod, err := cfg.ScrubPeriod()
+	if err != nil {
+		binaryStorage.Close()
+		_ = storageFactory.Close()
+		return nil, fmt.Errorf("invalid scrub configuration: %w", err)
+	}
+
+	// 2. Слои: repository -> services
 	jwtManager := jwtutils.New(cfg.JwtKey, 24*time.Hour)
-	serviceFactory := service.NewServiceFactory(storageFactory, binaryStorage, jwtManager)
+	limits := model.Quota{MaxBytes: cfg.UserQuotaBytes, MaxItems: cfg.UserItemLimit}
+	serviceFactory := srvservice.NewServiceFactory(storageFactory, binaryStorage, jwtManager, limits, cfg.BinaryVersions, log)
 
-	// 3. Запуск gRPC сервера с набором сервисов
-	if err := grpc.StartGRPCServer(log, cfg, serviceFactory); err != nil {
-		log.Fatal("gRPC server failed", zap.Error(err))
+	// 3. Фоновые задачи
+
+	// Перешифрование файлов активным ключом хранилища после смены ключа
+	if cfg.StorageReencrypt {
+		if r, ok := binaryStorage.(domainstorage.Reencryptor); ok {
+			tasks := newBackgroundServices(serviceFactory)
+			tasks.Go(func(ctx context.Context) { reencryptStorage(ctx, log, r) })
+			serviceFactory = tasks
+		} else {
+			log.Warn("Binary data storage does not support re-encryption")
+		}
+	}
+
+	// Фоновая сверка хранилища бинарных данных с записями
+	if scrubPeriod > 0 {
+		serviceFactory.Scrub().Start(scrubPeriod, model.ScrubOptions{Fix: cfg.ScrubFix, Rehash: cfg.ScrubRehash})
+		log.Info("Binary store scrub scheduled", zap.Duration("interval", scrubPeriod), zap.Bool("fix", cfg.ScrubFix))
+	}
+
+	return serviceFactory, nil
+}
+
+// openStorage создаёт фабрику репозиториев для cfg.DBDriver. Для SQL-хранилищ
+// открывается база данных и применяются миграции; хранилище в памяти
+// создаётся пустым и при заданном cfg.SeedFile заполняется начальными данными.
+func openStorage(cfg *config.Config) (repository.StorageFactory, error) {
+	if cfg.DBDriver == config.DBDriverMemory {
+		store := memory.NewStore()
+		if cfg.SeedFile != "" {
+			if err := memory.LoadFixtureFile(context.Background(), store, cfg.SeedFile); err != nil {
+				return nil, err
+			}
+		}
+		return storage.NewMemoryFactory(store), nil
+	}
+
+	db, err := storage.InitDB(cfg.DBDriver, cfg.DBConnect)
+	if err != nil {
+		return nil, err
+	}
+	return storage.NewStorageFactory(cfg.DBDriver, db)
+}
+
+// reencryptStorage перешифровывает файлы хранилища и сообщает итог в лог.
+// Сервер в это время продолжает работу: файлы заменяются по одному.
+// Отмена ctx (остановка сервера) прерывает перешифрование; при следующем
+// запуске оно продолжится с файлов, ещё не зашифрованных активным ключом.
+func reencryptStorage(ctx context.Context, log *zap.Logger, r domainstorage.Reencryptor) {
+	log.Info("Binary store re-encryption started")
+	res, err := r.Reencrypt(ctx)
+	fields := []zap.Field{
+		zap.Int64("scanned", res.Scanned),
+		zap.Int64("reencrypted", res.Reencrypted),
+		zap.Int64("failed", res.Failed),
+	}
+	switch {
+	case err != nil && ctx.Err() != nil:
+		log.Info("Binary store re-encryption interrupted", fields...)
+		return
+	case err != nil:
+		log.Error("Binary store re-encryption failed", append(fields, zap.Error(err))...)
+		return
 	}
+	for _, e := range res.Errors {
+		log.Warn("Binary store re-encryption skipped file", zap.String("error", e))
+	}
+	log.Info("Binary store re-encryption finished", fields...)
+}
+
+// backgroundServices — фабрика сервисов с фоновыми задачами сервера.
+// Close отменяет контекст задач и дожидается их завершения, прежде чем
+// закрыть сервисы и хранилища, с которыми задачи работают.
+type backgroundServices struct {
+	service.ServiceFactory
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// newBackgroundServices оборачивает фабрику f.
+func newBackgroundServices(f service.ServiceFactory) *backgroundServices {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &backgroundServices{ServiceFactory: f, ctx: ctx, cancel: cancel}
+}
+
+// Go запускает фоновую задачу fn с контекстом, отменяемым в Close.
+func (b *backgroundServices) Go(fn func(ctx context.Context)) {
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+		fn(b.ctx)
+	}()
+}
+
+// Close останавливает фоновые задачи и закрывает фабрику сервисов.
+func (b *backgroundServices) Close() {
+	b.cancel()
+	b.wg.Wait()
+	b.ServiceFactory.Close()
 }
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	"github.com/stretchr/testify/assert"
+)
+
+// closeRecorder — фабрика сервисов, отмечающая вызов Close.
+type closeRecorder struct {
+	service.ServiceFactory
+	closed chan struct{}
+}
+
+func (f *closeRecorder) Close() { close(f.closed) }
+
+func TestBackgroundServices_Close(t *testing.T) {
+	inner := &closeRecorder{closed: make(chan struct{})}
+	b := newBackgroundServices(inner)
+
+	started := make(chan struct{})
+	var closedBeforeStop bool
+	b.Go(func(ctx context.Context) {
+		close(started)
+		<-ctx.Done()
+		// Задача ещё работает с хранилищами: они не должны быть закрыты
+		select {
+		case <-inner.closed:
+			closedBeforeStop = true
+		case <-time.After(50 * time.Millisecond):
+		}
+	})
+	<-started
+
+	b.Close()
+	assert.False(t, closedBeforeStop, "фабрика закрывается после остановки задач")
+	select {
+	case <-inner.closed:
+	default:
+		t.Fatal("фабрика сервисов не закрыта")
+	}
+}
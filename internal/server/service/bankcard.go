@@ -13,12 +13,14 @@ import (
 
 // BankCardService реализует интерфейс service.BankCardService
 type BankCardService struct {
-	repo repository.BankCardRepository
+	repo  repository.BankCardRepository
+	quota *QuotaGuard
 }
 
-// NewBankCardService создаёт новый сервис с указанным репозиторием
-func NewBankCardService(repo repository.BankCardRepository) domainService.BankCardService {
-	return &BankCardService{repo: repo}
+// NewBankCardService создаёт новый сервис с указанным репозиторием.
+// quota ограничивает число записей пользователя (nil — без ограничений).
+func NewBankCardService(repo repository.BankCardRepository, quota *QuotaGuard) domainService.BankCardService {
+	return &BankCardService{repo: repo, quota: quota}
 }
 
 // Create создаёт новую запись банковской карты с генерацией UUID и датой создания
@@ -40,6 +42,9 @@ func (s *BankCardService) Create(ctx context.Context, card *model.BankCard) erro
 	if card.CardholderName == "" {
 		return errors.New("cardholder name is required")
 	}
+	if err := s.quota.CheckItems(ctx, card.UserID, 1); err != nil {
+		return err
+	}
 
 	return s.repo.Create(ctx, card)
 }
@@ -52,12 +57,15 @@ func (s *BankCardService) GetByID(ctx context.Context, id string) (*model.BankCa
 	return s.repo.GetByID(ctx, id)
 }
 
-// GetByUserID возвращает все банковские карты пользователя
-func (s *BankCardService) GetByUserID(ctx context.Context, userID string) ([]model.BankCard, error) {
+// GetByUserID возвращает страницу банковских карт пользователя, удовлетворяющих фильтру
+func (s *BankCardService) GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
 	if userID == "" {
-		return nil, errors.New("userID is required")
+		return nil, "", errors.New("userID is required")
+	}
+	if err := page.Validate(); err != nil {
+		return nil, "", err
 	}
-	return s.repo.GetByUser(ctx, userID)
+	return s.repo.GetByUser(ctx, userID, filter, page.Normalize())
 }
 
 // Update обновляет существующую запись банковской карты с обновлением времени
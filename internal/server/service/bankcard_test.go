@@ -32,13 +32,13 @@ func (m *mockBankCardRepo) GetByID(ctx context.Context, id string) (*model.BankC
 	return card.(*model.BankCard), args.Error(1)
 }
 
-func (m *mockBankCardRepo) GetByUser(ctx context.Context, userID string) ([]model.BankCard, error) {
-	args := m.Called(ctx, userID)
+func (m *mockBankCardRepo) GetByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
+	args := m.Called(ctx, userID, filter, page)
 	cards := args.Get(0)
 	if cards == nil {
-		return nil, args.Error(1)
+		return nil, args.String(1), args.Error(2)
 	}
-	return cards.([]model.BankCard), args.Error(1)
+	return cards.([]model.BankCard), args.String(1), args.Error(2)
 }
 
 func (m *mockBankCardRepo) Update(ctx context.Context, card *model.BankCard) error {
@@ -53,7 +53,7 @@ func (m *mockBankCardRepo) Delete(ctx context.Context, id string) error {
 
 func TestBankCardService_Create(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	card := &model.BankCard{
 		CardNumber:     "1234123412341234",
@@ -72,7 +72,7 @@ func TestBankCardService_Create(t *testing.T) {
 
 func TestBankCardService_Create_ValidationError(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	card := &model.BankCard{}
 
@@ -83,7 +83,7 @@ func TestBankCardService_Create_ValidationError(t *testing.T) {
 
 func TestBankCardService_GetByID(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	id := uuid.NewString()
 	card := &model.BankCard{ID: id}
@@ -97,7 +97,7 @@ func TestBankCardService_GetByID(t *testing.T) {
 
 func TestBankCardService_GetByID_Error(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	mockRepo.On("GetByID", mock.Anything, "unknown").Return(nil, errors.New("not found"))
 
@@ -108,21 +108,21 @@ func TestBankCardService_GetByID_Error(t *testing.T) {
 
 func TestBankCardService_GetByUserID(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	userID := uuid.NewString()
 	cards := []model.BankCard{{ID: uuid.NewString()}, {ID: uuid.NewString()}}
 
-	mockRepo.On("GetByUser", mock.Anything, userID).Return(cards, nil)
+	mockRepo.On("GetByUser", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}.Normalize()).Return(cards, "", nil)
 
-	result, err := svc.GetByUserID(context.Background(), userID)
+	result, _, err := svc.GetByUserID(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 }
 
 func TestBankCardService_Update(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	card := &model.BankCard{ID: uuid.NewString()}
 	mockRepo.On("GetByID", mock.Anything, card.ID).Return(card, nil)
@@ -135,7 +135,7 @@ func TestBankCardService_Update(t *testing.T) {
 
 func TestBankCardService_Update_NotFound(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	card := &model.BankCard{ID: uuid.NewString()}
 	mockRepo.On("GetByID", mock.Anything, card.ID).Return(nil, nil)
@@ -147,7 +147,7 @@ func TestBankCardService_Update_NotFound(t *testing.T) {
 
 func TestBankCardService_Delete(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	id := uuid.NewString()
 	card := &model.BankCard{ID: id}
@@ -161,7 +161,7 @@ func TestBankCardService_Delete(t *testing.T) {
 
 func TestBankCardService_Delete_NotFound(t *testing.T) {
 	mockRepo := new(mockBankCardRepo)
-	svc := service.NewBankCardService(mockRepo)
+	svc := service.NewBankCardService(mockRepo, nil)
 
 	id := uuid.NewString()
 	mockRepo.On("GetByID", mock.Anything, id).Return(nil, nil)
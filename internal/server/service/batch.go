@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+)
+
+// errBatchFailed прерывает транзакцию атомарного пакета после ошибки операции.
+var errBatchFailed = errors.New("batch operation failed")
+
+// BatchServiceImpl реализует интерфейс service.BatchService
+type BatchServiceImpl struct {
+	repo  repository.BatchRepository
+	quota *QuotaGuard
+}
+
+// NewBatchService создаёт новый сервис с указанным репозиторием пакетных изменений.
+// quota ограничивает число записей пользователя (nil — без ограничений).
+func NewBatchService(repo repository.BatchRepository, quota *QuotaGuard) service.BatchService {
+	return &BatchServiceImpl{repo: repo, quota: quota}
+}
+
+// Mutate применяет операции пакета в одной транзакции.
+//
+// Каждая операция выполняется в собственной точке сохранения, поэтому ошибка
+// одной операции не прерывает транзакцию. В атомарном режиме первая ошибка
+// откатывает весь пакет: остальные операции (выполненные и нет) получают
+// model.ErrBatchAborted.
+//
+// Если созданные пакетом записи превысят ограничение пользователя на число
+// записей, пакет не выполняется и возвращается model.ErrQuotaExceeded.
+func (s *BatchServiceImpl) Mutate(ctx context.Context, userID string, ops []model.BatchOp, atomic bool) ([]model.BatchResult, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if len(ops) > model.MaxBatchOps {
+		return nil, fmt.Errorf("too many operations in batch: %d (max %d)", len(ops), model.MaxBatchOps)
+	}
+	if err := s.quota.CheckItems(ctx, userID, countCreates(ops)); err != nil {
+		return nil, err
+	}
+
+	results := make([]model.BatchResult, len(ops))
+	failed := -1
+	err := s.repo.WithinTx(ctx, func(tx repository.BatchTx) error {
+		// Квота на число записей проверена для всего пакета
+		b := batchApplier{
+			tx:         tx,
+			userID:     userID,
+			credential: NewCredentialService(tx.Credential(), nil),
+			bankCard:   NewBankCardService(tx.BankCard(), nil),
+			textData:   NewTextDataService(tx.TextData(), nil),
+		}
+		for i := range ops {
+			err := tx.Savepoint(ctx, func() error {
+				return b.apply(ctx, ops[i], &results[i])
+			})
+			if err != nil {
+				results[i] = model.BatchResult{ItemID: opItemID(ops[i]), Err: err}
+				if atomic {
+					failed = i
+					return errBatchFailed
+				}
+			}
+		}
+		return nil
+	})
+
+	if errors.Is(err, errBatchFailed) {
+		for i := range results {
+			if i != failed {
+				results[i] = model.BatchResult{ItemID: opItemID(ops[i]), Err: model.ErrBatchAborted}
+			}
+		}
+		return results, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// countCreates возвращает число операций создания в пакете.
+func countCreates(ops []model.BatchOp) int64 {
+	var n int64
+	for _, op := range ops {
+		if op.Kind == model.BatchCreate {
+			n++
+		}
+	}
+	return n
+}
+
+// batchApplier применяет операции пакета через сервисы записей,
+// привязанные к транзакции пакета.
+type batchApplier struct {
+	tx         repository.BatchTx
+	userID     string
+	credential service.CredentialService
+	bankCard   service.BankCardService
+	textData   service.TextDataService
+}
+
+// apply выполняет одну операцию и записывает её результат в res.
+func (b *batchApplier) apply(ctx context.Context, op model.BatchOp, res *model.BatchResult) error {
+	switch op.Kind {
+	case model.BatchCreate:
+		return b.create(ctx, op, res)
+	case model.BatchUpdate:
+		return b.update(ctx, op, res)
+	case model.BatchDelete:
+		return b.delete(ctx, op, res)
+	}
+	return fmt.Errorf("unknown batch operation %q", op.Kind)
+}
+
+// create создаёт запись от имени пользователя пакета; идентификатор назначает сервер.
+func (b *batchApplier) create(ctx context.Context, op model.BatchOp, res *model.BatchResult) error {
+	switch {
+	case op.Type == model.ItemTypeCredential && op.Credential != nil:
+		cred := *op.Credential
+		cred.ID, cred.UserID = "", b.userID
+		if err := b.credential.Create(ctx, &cred); err != nil {
+			return err
+		}
+		*res = model.BatchResult{ItemID: cred.ID, Version: cred.Version}
+	case op.Type == model.ItemTypeBankCard && op.BankCard != nil:
+		card := *op.BankCard
+		card.ID, card.UserID = "", b.userID
+		if err := b.bankCard.Create(ctx, &card); err != nil {
+			return err
+		}
+		*res = model.BatchResult{ItemID: card.ID, Version: card.Version}
+	case op.Type == model.ItemTypeTextData && op.TextData != nil:
+		data := *op.TextData
+		data.ID, data.UserID = "", b.userID
+		if err := b.textData.Create(ctx, &data); err != nil {
+			return err
+		}
+		*res = model.BatchResult{ItemID: data.ID, Version: data.Version}
+	default:
+		return invalidBatchOp(op)
+	}
+	return nil
+}
+
+// update обновляет запись пользователя пакета с проверкой версии, если она указана.
+func (b *batchApplier) update(ctx context.Context, op model.BatchOp, res *model.BatchResult) error {
+	switch {
+	case op.Type == model.ItemTypeCredential && op.Credential != nil:
+		cred := *op.Credential
+		if err := b.checkUpdateTarget(ctx, op, &cred.ID); err != nil {
+			return err
+		}
+		cred.UserID = b.userID
+		if err := b.credential.Update(ctx, &cred); err != nil {
+			return err
+		}
+		*res = model.BatchResult{ItemID: cred.ID, Version: cred.Version}
+	case op.Type == model.ItemTypeBankCard && op.BankCard != nil:
+		card := *op.BankCard
+		if err := b.checkUpdateTarget(ctx, op, &card.ID); err != nil {
+			return err
+		}
+		card.UserID = b.userID
+		if err := b.bankCard.Update(ctx, &card); err != nil {
+			return err
+		}
+		*res = model.BatchResult{ItemID: card.ID, Version: card.Version}
+	case op.Type == model.ItemTypeTextData && op.TextData != nil:
+		data := *op.TextData
+		if err := b.checkUpdateTarget(ctx, op, &data.ID); err != nil {
+			return err
+		}
+		data.UserID = b.userID
+		if err := b.textData.Update(ctx, &data); err != nil {
+			return err
+		}
+		*res = model.BatchResult{ItemID: data.ID, Version: data.Version}
+	default:
+		return invalidBatchOp(op)
+	}
+	return nil
+}
+
+// delete удаляет запись пользователя пакета.
+func (b *batchApplier) delete(ctx context.Context, op model.BatchOp, res *model.BatchResult) error {
+	if err := b.checkOwner(ctx, op.Type, op.ItemID); err != nil {
+		return err
+	}
+	var err error
+	switch op.Type {
+	case model.ItemTypeCredential:
+		err = b.credential.Delete(ctx, op.ItemID)
+	case model.ItemTypeBankCard:
+		err = b.bankCard.Delete(ctx, op.ItemID)
+	case model.ItemTypeTextData:
+		err = b.textData.Delete(ctx, b.userID, op.ItemID)
+	default:
+		return invalidBatchOp(op)
+	}
+	if err != nil {
+		return err
+	}
+	*res = model.BatchResult{ItemID: op.ItemID}
+	return nil
+}
+
+// checkUpdateTarget определяет, какую запись обновляет операция, и проверяет,
+// что она принадлежит пользователю пакета. id — идентификатор из тела записи;
+// если он пуст, берётся op.ItemID. Операция, в которой op.ItemID и
+// идентификатор записи различаются, отклоняется: иначе владелец проверялся бы
+// у одной записи, а обновлялась бы другая.
+func (b *batchApplier) checkUpdateTarget(ctx context.Context, op model.BatchOp, id *string) error {
+	switch {
+	case *id == "":
+		*id = op.ItemID
+	case op.ItemID != "" && op.ItemID != *id:
+		return fmt.Errorf("operation id %q does not match %s id %q", op.ItemID, op.Type, *id)
+	}
+	return b.checkOwner(ctx, op.Type, *id)
+}
+
+// checkOwner проверяет, что запись существует и принадлежит пользователю пакета.
+func (b *batchApplier) checkOwner(ctx context.Context, t model.ItemType, id string) error {
+	if id == "" {
+		return errors.New("id is required")
+	}
+	var owner string
+	switch t {
+	case model.ItemTypeCredential:
+		cred, err := b.tx.Credential().GetByID(ctx, id)
+		if err != nil || cred == nil {
+			return model.ErrItemNotFound
+		}
+		owner = cred.UserID
+	case model.ItemTypeBankCard:
+		card, err := b.tx.BankCard().GetByID(ctx, id)
+		if err != nil || card == nil {
+			return model.ErrItemNotFound
+		}
+		owner = card.UserID
+	case model.ItemTypeTextData:
+		data, err := b.tx.TextData().GetByID(ctx, b.userID, id)
+		if err != nil || data == nil {
+			return model.ErrItemNotFound
+		}
+		owner = data.UserID
+	default:
+		return fmt.Errorf("item type %q is not supported in batch", t)
+	}
+	if owner != b.userID {
+		return model.ErrItemNotFound
+	}
+	return nil
+}
+
+// invalidBatchOp возвращает ошибку операции с неподдерживаемым типом или без записи.
+func invalidBatchOp(op model.BatchOp) error {
+	if err := op.Type.Validate(); err != nil {
+		return err
+	}
+	if op.Type == model.ItemTypeBinaryData {
+		return fmt.Errorf("item type %q is not supported in batch", op.Type)
+	}
+	return fmt.Errorf("%s operation requires %s item", op.Kind, op.Type)
+}
+
+// opItemID возвращает идентификатор записи, к которой относится операция.
+func opItemID(op model.BatchOp) string {
+	switch {
+	case op.ItemID != "":
+		return op.ItemID
+	case op.Credential != nil:
+		return op.Credential.ID
+	case op.BankCard != nil:
+		return op.BankCard.ID
+	case op.TextData != nil:
+		return op.TextData.ID
+	}
+	return ""
+}
@@ -0,0 +1,211 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/service"
+)
+
+// Фейковая транзакция пакета: точки сохранения лишь считаются
+type fakeBatchTx struct {
+	creds *MockCredentialRepository
+	cards *mockBankCardRepo
+	texts *mockTextDataRepo
+
+	savepoints int
+	rolledBack int
+}
+
+func (t *fakeBatchTx) Credential() repository.CredentialRepository { return t.creds }
+func (t *fakeBatchTx) BankCard() repository.BankCardRepository     { return t.cards }
+func (t *fakeBatchTx) TextData() repository.TextDataRepository     { return t.texts }
+
+func (t *fakeBatchTx) Savepoint(ctx context.Context, fn func() error) error {
+	t.savepoints++
+	err := fn()
+	if err != nil {
+		t.rolledBack++
+	}
+	return err
+}
+
+// Фейковый репозиторий пакета: запоминает, была ли транзакция зафиксирована
+type fakeBatchRepo struct {
+	tx        *fakeBatchTx
+	committed bool
+	beginErr  error
+}
+
+func (r *fakeBatchRepo) WithinTx(ctx context.Context, fn func(tx repository.BatchTx) error) error {
+	if r.beginErr != nil {
+		return r.beginErr
+	}
+	err := fn(r.tx)
+	r.committed = err == nil
+	return err
+}
+
+func newFakeBatchRepo() *fakeBatchRepo {
+	return &fakeBatchRepo{tx: &fakeBatchTx{
+		creds: new(MockCredentialRepository),
+		cards: new(mockBankCardRepo),
+		texts: new(mockTextDataRepo),
+	}}
+}
+
+func TestBatchService_Mutate_Success(t *testing.T) {
+	repo := newFakeBatchRepo()
+	svc := service.NewBatchService(repo, nil)
+	ctx := context.Background()
+
+	repo.tx.creds.On("Create", mock.Anything, mock.MatchedBy(func(c *model.Credential) bool {
+		return c.UserID == "user1" && c.ID != "foreign"
+	})).Return(nil)
+	repo.tx.cards.On("GetByID", mock.Anything, "card1").Return(&model.BankCard{ID: "card1", UserID: "user1"}, nil)
+	repo.tx.cards.On("Update", mock.Anything, mock.AnythingOfType("*model.BankCard")).Return(nil)
+	repo.tx.texts.On("GetByID", mock.Anything, "user1", "text1").Return(&model.TextData{ID: "text1", UserID: "user1"}, nil)
+	repo.tx.texts.On("Delete", mock.Anything, "user1", "text1").Return(nil)
+
+	ops := []model.BatchOp{
+		{Kind: model.BatchCreate, Type: model.ItemTypeCredential, Credential: &model.Credential{ID: "foreign", Title: "new"}},
+		{Kind: model.BatchUpdate, Type: model.ItemTypeBankCard, BankCard: &model.BankCard{ID: "card1", Title: "card"}},
+		{Kind: model.BatchDelete, Type: model.ItemTypeTextData, ItemID: "text1"},
+	}
+	results, err := svc.Mutate(ctx, "user1", ops, true)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	for _, r := range results {
+		assert.NoError(t, r.Err)
+	}
+	assert.NotEmpty(t, results[0].ItemID)
+	assert.NotEqual(t, "foreign", results[0].ItemID)
+	assert.Equal(t, "card1", results[1].ItemID)
+	assert.Equal(t, "text1", results[2].ItemID)
+	assert.True(t, repo.committed)
+	assert.Equal(t, 3, repo.tx.savepoints)
+
+	repo.tx.creds.AssertExpectations(t)
+	repo.tx.cards.AssertExpectations(t)
+	repo.tx.texts.AssertExpectations(t)
+}
+
+func TestBatchService_Mutate_PartialFailure(t *testing.T) {
+	repo := newFakeBatchRepo()
+	svc := service.NewBatchService(repo, nil)
+
+	repo.tx.creds.On("Create", mock.Anything, mock.AnythingOfType("*model.Credential")).Return(nil)
+	// Чужая запись выглядит как отсутствующая
+	repo.tx.creds.On("GetByID", mock.Anything, "cred2").Return(&model.Credential{ID: "cred2", UserID: "other"}, nil)
+
+	ops := []model.BatchOp{
+		{Kind: model.BatchCreate, Type: model.ItemTypeCredential, Credential: &model.Credential{Title: "a"}},
+		{Kind: model.BatchDelete, Type: model.ItemTypeCredential, ItemID: "cred2"},
+		{Kind: model.BatchCreate, Type: model.ItemTypeBinaryData},
+	}
+	results, err := svc.Mutate(context.Background(), "user1", ops, false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, model.ErrItemNotFound)
+	assert.Equal(t, "cred2", results[1].ItemID)
+	assert.Error(t, results[2].Err)
+	assert.True(t, repo.committed)
+	assert.Equal(t, 2, repo.tx.rolledBack)
+	repo.tx.creds.AssertNotCalled(t, "Delete", mock.Anything, "cred2")
+}
+
+func TestBatchService_Mutate_AtomicAbort(t *testing.T) {
+	repo := newFakeBatchRepo()
+	svc := service.NewBatchService(repo, nil)
+
+	repo.tx.creds.On("Create", mock.Anything, mock.AnythingOfType("*model.Credential")).Return(nil)
+	repo.tx.creds.On("GetByID", mock.Anything, "cred1").Return(&model.Credential{ID: "cred1", UserID: "user1"}, nil)
+	repo.tx.creds.On("Update", mock.Anything, mock.AnythingOfType("*model.Credential")).Return(model.ErrVersionConflict)
+
+	ops := []model.BatchOp{
+		{Kind: model.BatchCreate, Type: model.ItemTypeCredential, Credential: &model.Credential{Title: "a"}},
+		{Kind: model.BatchUpdate, Type: model.ItemTypeCredential, Credential: &model.Credential{ID: "cred1", Version: 1}},
+		{Kind: model.BatchDelete, Type: model.ItemTypeCredential, ItemID: "cred3"},
+	}
+	results, err := svc.Mutate(context.Background(), "user1", ops, true)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.ErrorIs(t, results[0].Err, model.ErrBatchAborted)
+	assert.ErrorIs(t, results[1].Err, model.ErrVersionConflict)
+	assert.ErrorIs(t, results[2].Err, model.ErrBatchAborted)
+	assert.Equal(t, "cred3", results[2].ItemID)
+	assert.False(t, repo.committed)
+	// Операции после ошибки не выполняются
+	assert.Equal(t, 2, repo.tx.savepoints)
+}
+
+func TestBatchService_Mutate_UpdateMismatchedID(t *testing.T) {
+	repo := newFakeBatchRepo()
+	svc := service.NewBatchService(repo, nil)
+
+	repo.tx.creds.On("GetByID", mock.Anything, "cred1").Return(&model.Credential{ID: "cred1", UserID: "user1"}, nil)
+	repo.tx.creds.On("Update", mock.Anything, mock.MatchedBy(func(c *model.Credential) bool {
+		return c.ID == "cred1" && c.UserID == "user1"
+	})).Return(nil)
+	repo.tx.cards.On("GetByID", mock.Anything, "foreign").Return(&model.BankCard{ID: "foreign", UserID: "other"}, nil)
+
+	ops := []model.BatchOp{
+		// Своя запись в ItemID, чужая — в теле операции
+		{Kind: model.BatchUpdate, Type: model.ItemTypeCredential, ItemID: "cred1", Credential: &model.Credential{ID: "foreign"}},
+		// Идентификатор только в ItemID
+		{Kind: model.BatchUpdate, Type: model.ItemTypeCredential, ItemID: "cred1", Credential: &model.Credential{Title: "t"}},
+		// Владелец проверяется у записи из тела операции
+		{Kind: model.BatchUpdate, Type: model.ItemTypeBankCard, BankCard: &model.BankCard{ID: "foreign"}},
+	}
+	results, err := svc.Mutate(context.Background(), "user1", ops, false)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.ErrorContains(t, results[0].Err, "does not match")
+	assert.NoError(t, results[1].Err)
+	assert.Equal(t, "cred1", results[1].ItemID)
+	assert.ErrorIs(t, results[2].Err, model.ErrItemNotFound)
+
+	repo.tx.creds.AssertNumberOfCalls(t, "Update", 1)
+	repo.tx.cards.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestBatchService_Mutate_Validation(t *testing.T) {
+	repo := newFakeBatchRepo()
+	svc := service.NewBatchService(repo, nil)
+	ctx := context.Background()
+
+	_, err := svc.Mutate(ctx, "", nil, false)
+	assert.Error(t, err)
+
+	_, err = svc.Mutate(ctx, "user1", make([]model.BatchOp, model.MaxBatchOps+1), false)
+	assert.Error(t, err)
+
+	repo.beginErr = errors.New("db down")
+	_, err = svc.Mutate(ctx, "user1", []model.BatchOp{{Kind: model.BatchDelete}}, false)
+	assert.ErrorContains(t, err, "db down")
+}
+
+func TestBatchService_Mutate_ItemLimit(t *testing.T) {
+	repo := newFakeBatchRepo()
+	items := new(mockItemRepo)
+	svc := service.NewBatchService(repo, service.NewQuotaGuard(items, nil, model.Quota{MaxItems: 3}))
+	ctx := context.Background()
+
+	items.On("Usage", ctx, "user1").Return(&model.Usage{Items: 2}, nil)
+
+	ops := []model.BatchOp{
+		{Kind: model.BatchCreate, Type: model.ItemTypeCredential, Credential: &model.Credential{Title: "a"}},
+		{Kind: model.BatchCreate, Type: model.ItemTypeCredential, Credential: &model.Credential{Title: "b"}},
+	}
+	_, err := svc.Mutate(ctx, "user1", ops, false)
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+	assert.Zero(t, repo.tx.savepoints)
+}
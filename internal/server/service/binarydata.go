@@ -1,8 +1,12 @@
 package service
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 	"time"
 
@@ -14,26 +18,52 @@ import (
 
 // BinaryDataService реализует бизнес-логику работы с бинарными данными.
 type BinaryDataService struct {
-	repo    repository.BinaryDataRepository
-	storage storage.BinaryDataStorage
+	repo         repository.BinaryDataRepository
+	storage      storage.BinaryDataStorage
+	chunks       repository.ChunkRepository
+	quota        *QuotaGuard
+	versions     repository.BinaryVersionRepository
+	keepVersions int
 }
 
 // NewBinaryDataService создаёт новый сервис для работы с бинарными данными.
-func NewBinaryDataService(repo repository.BinaryDataRepository, storage storage.BinaryDataStorage) *BinaryDataService {
-	return &BinaryDataService{repo: repo, storage: storage}
+// chunks — репозиторий фрагментов для файлов, загруженных с дедупликацией
+// (nil — такие файлы не поддерживаются); quota — ограничения пользователя
+// на число записей и объём файлов (nil — без ограничений); versions —
+// репозиторий прежних версий содержимого, keepVersions — сколько прежних
+// версий хранить для каждой записи (nil или 0 — при замене содержимое
+// удаляется).
+func NewBinaryDataService(repo repository.BinaryDataRepository, storage storage.BinaryDataStorage, chunks repository.ChunkRepository, quota *QuotaGuard, versions repository.BinaryVersionRepository, keepVersions int) *BinaryDataService {
+	return &BinaryDataService{repo: repo, storage: storage, chunks: chunks, quota: quota, versions: versions, keepVersions: keepVersions}
 }
 
-// Create сохраняет файл и метаданные
+// Create сохраняет файл и метаданные. Загрузка прерывается ошибкой
+// model.ErrQuotaExceeded, как только файл превысит квоту пользователя.
 func (s *BinaryDataService) Create(ctx context.Context, data *model.BinaryData, r io.Reader) (*model.BinaryData, error) {
-	// Сохраняем файл в хранилище
-	storagePath, size, err := s.storage.Save(ctx, data.UserID, r)
+	if err := s.quota.CheckItems(ctx, data.UserID, 1); err != nil {
+		return nil, err
+	}
+	r, err := s.quota.LimitReader(ctx, data.UserID, 0, r)
 	if err != nil {
 		return nil, err
 	}
 
+	// Сохраняем файл в хранилище, попутно вычисляя его контрольную сумму
+	h := sha256.New()
+	storagePath, size, err := s.storage.Save(ctx, data.UserID, io.TeeReader(r, h))
+	if err != nil {
+		return nil, err
+	}
+	return s.createStored(ctx, data, storagePath, size, hex.EncodeToString(h.Sum(nil)))
+}
+
+// createStored сохраняет метаданные новой записи для уже сохранённого файла
+// с контрольной суммой checksum.
+func (s *BinaryDataService) createStored(ctx context.Context, data *model.BinaryData, storagePath string, size int64, checksum string) (*model.BinaryData, error) {
 	data.ID = uuid.NewString()
 	data.StoragePath = storagePath
 	data.Size = size
+	data.Checksum = checksum
 	data.CreatedAt = time.Now()
 	data.UpdatedAt = time.Now()
 
@@ -49,10 +79,16 @@ func (s *BinaryDataService) Create(ctx context.Context, data *model.BinaryData,
 
 // CreateInfo сохраняет только метаданные без бинарного содержимого.
 func (s *BinaryDataService) CreateInfo(ctx context.Context, data *model.BinaryData) (*model.BinaryData, error) {
+	if err := s.quota.CheckItems(ctx, data.UserID, 1); err != nil {
+		return nil, err
+	}
 
 	data.ID = uuid.NewString()
 	data.StoragePath = ""
 	data.Size = 0
+	data.Checksum = ""
+	data.PlainChecksum = ""
+	data.Archive = ""
 	data.CreatedAt = time.Now()
 	data.UpdatedAt = time.Now()
 
@@ -65,7 +101,43 @@ func (s *BinaryDataService) CreateInfo(ctx context.Context, data *model.BinaryDa
 
 // Update перезаписывает бинарные данные и/или метаданные существующей записи.
 func (s *BinaryDataService) Update(ctx context.Context, data *model.BinaryData, r io.Reader) (*model.BinaryData, error) {
-	// Получаем существующую запись
+	// Конфликт версий проверяем до загрузки содержимого, чтобы не сохранять файл зря
+	stored, err := s.getForUpdate(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var newStoragePath, checksum string
+	var newSize int64
+	// Если передан поток новых данных, сохраняем их в хранилище
+	if r != nil {
+		if r, err = s.quota.LimitReader(ctx, data.UserID, s.replacedSize(stored), r); err != nil {
+			return nil, err
+		}
+		h := sha256.New()
+		newStoragePath, newSize, err = s.storage.Save(ctx, data.UserID, io.TeeReader(r, h))
+		if err != nil {
+			return nil, err
+		}
+		checksum = hex.EncodeToString(h.Sum(nil))
+	}
+	return s.updateStored(ctx, stored, data, newStoragePath, newSize, checksum)
+}
+
+// replacedSize возвращает объём, который освободит замена содержимого
+// записи stored. Фрагменты могут быть общими с другими файлами, поэтому
+// для файла из фрагментов он не учитывается; заменённое содержимое,
+// сохраняемое прежней версией, тоже ничего не освобождает.
+func (s *BinaryDataService) replacedSize(stored *model.BinaryData) int64 {
+	if stored.Chunked || s.keepsVersions() {
+		return 0
+	}
+	return stored.Size
+}
+
+// getForUpdate возвращает сохранённую запись, которую обновляет data,
+// проверяя версию, если она указана.
+func (s *BinaryDataService) getForUpdate(ctx context.Context, data *model.BinaryData) (*model.BinaryData, error) {
 	stored, err := s.repo.GetByID(ctx, data.UserID, data.ID)
 	if err != nil {
 		return nil, err
@@ -74,42 +146,140 @@ func (s *BinaryDataService) Update(ctx context.Context, data *model.BinaryData,
 	if stored == nil {
 		return nil, errors.New("binary data not found")
 	}
+	if data.Version != 0 && data.Version != stored.Version {
+		return nil, model.ErrVersionConflict
+	}
+	return stored, nil
+}
 
-	var newStoragePath, oldStoragePath string
-	var newSize int64
-	// Если передан поток новых данных, сохраняем их в хранилище
-	if r != nil {
-		newStoragePath, newSize, err = s.storage.Save(ctx, data.UserID, r)
-		if err != nil {
+// updateStored записывает в stored метаданные из data и, если newStoragePath
+// не пуст, заменяет файл записи новым с контрольной суммой checksum.
+// Прежнее содержимое сохраняется версией (см. saveVersion) или удаляется.
+func (s *BinaryDataService) updateStored(ctx context.Context, stored, data *model.BinaryData, newStoragePath string, newSize int64, checksum string) (*model.BinaryData, error) {
+	oldStoragePath := stored.StoragePath
+	var version *model.BinaryVersion
+	if newStoragePath != "" {
+		var err error
+		if version, err = s.saveVersion(ctx, stored); err != nil {
+			_ = s.storage.Delete(ctx, newStoragePath)
 			return nil, err
 		}
-		oldStoragePath = stored.StoragePath
 		stored.StoragePath = newStoragePath
+		stored.Checksum = checksum
+		stored.PlainChecksum = data.PlainChecksum
+		stored.Archive = data.Archive
 	}
 
 	// Обновляем метаданные, если они изменились
-	stored.Title = data.Title
-	stored.Metadata = data.Metadata
-	stored.ClientPath = data.ClientPath
+	applyContentUpdate(stored, data)
 	stored.Size = newSize
-	stored.UpdatedAt = time.Now()
 
-	// Сохраняем изменения в репозитории
-	if err := s.repo.Update(ctx, stored); err != nil {
+	// Сохраняем изменения в репозитории; фрагменты прежнего содержимого,
+	// если оно хранилось фрагментами, освобождаются вместе с манифестом
+	var err error
+	if stored.Chunked {
+		stored.Chunked = false
+		var released []model.Chunk
+		if released, err = s.chunks.UpdateFile(ctx, stored, nil); err == nil {
+			s.deleteChunks(ctx, released)
+		}
+	} else {
+		err = s.repo.Update(ctx, stored)
+	}
+	if err != nil {
 		// Если запись в БД не удалась, восстанавливаем старый файл при необходимости
 		if newStoragePath != "" {
+			s.dropVersion(ctx, version)
 			_ = s.storage.Delete(ctx, newStoragePath)
 		}
 		return nil, err
 	}
 	if newStoragePath != "" {
-		// Удаляем старый файл после успешной записи нового
-		_ = s.storage.Delete(ctx, oldStoragePath)
+		s.releaseReplaced(ctx, stored, version, oldStoragePath)
 	}
 
 	return stored, nil
 }
 
+// applyContentUpdate переносит в stored поля data, которые меняются при
+// замене содержимого файла.
+func applyContentUpdate(stored, data *model.BinaryData) {
+	stored.Title = data.Title
+	stored.Metadata = data.Metadata
+	stored.ClientPath = data.ClientPath
+	stored.Version = data.Version
+	stored.UpdatedAt = time.Now()
+}
+
+// ResumeUpload принимает очередную часть содержимого в сессии загрузки
+// uploadID, начиная со смещения offset. Если поток r дочитан до конца,
+// сессия завершается и запись создаётся (пустой data.ID) или обновляется.
+// При обрыве потока принятые данные сохраняются в сессии, и загрузку можно
+// продолжить со смещения, которое вернёт UploadStatus.
+func (s *BinaryDataService) ResumeUpload(ctx context.Context, data *model.BinaryData, uploadID string, offset int64, r io.Reader) (*model.BinaryData, error) {
+	var stored *model.BinaryData
+	var freed int64
+	if data.ID != "" {
+		var err error
+		if stored, err = s.getForUpdate(ctx, data); err != nil {
+			return nil, err
+		}
+		freed = s.replacedSize(stored)
+	} else if err := s.quota.CheckItems(ctx, data.UserID, 1); err != nil {
+		return nil, err
+	}
+
+	// Принятые ранее offset байт сессии тоже займут место
+	r, err := s.quota.LimitUploadReader(ctx, data.UserID, uploadID, freed, offset, r)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.storage.AppendUpload(ctx, data.UserID, uploadID, offset, r); err != nil {
+		return nil, err
+	}
+	storagePath, size, err := s.storage.CommitUpload(ctx, data.UserID, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	// Содержимое пришло частями в разных запросах, поэтому контрольная
+	// сумма вычисляется по собранному файлу
+	checksum, err := s.fileChecksum(ctx, storagePath)
+	if err != nil {
+		_ = s.storage.Delete(ctx, storagePath)
+		return nil, err
+	}
+
+	if stored == nil {
+		return s.createStored(ctx, data, storagePath, size, checksum)
+	}
+	return s.updateStored(ctx, stored, data, storagePath, size, checksum)
+}
+
+// fileChecksum вычисляет SHA-256 файла хранилища.
+func (s *BinaryDataService) fileChecksum(ctx context.Context, storagePath string) (string, error) {
+	return storageChecksum(ctx, s.storage, storagePath)
+}
+
+// storageChecksum вычисляет SHA-256 файла storagePath хранилища st.
+func storageChecksum(ctx context.Context, st storage.BinaryDataStorage, storagePath string) (string, error) {
+	r, err := st.Load(ctx, storagePath)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// UploadStatus возвращает число байт, принятых в сессии загрузки uploadID.
+func (s *BinaryDataService) UploadStatus(ctx context.Context, userID, uploadID string) (int64, error) {
+	return s.storage.UploadOffset(ctx, userID, uploadID)
+}
+
 // UpdateInfo изменяет только метаданные файла без перезаписи его содержимого.
 func (s *BinaryDataService) UpdateInfo(ctx context.Context, data *model.BinaryData) (*model.BinaryData, error) {
 
@@ -123,6 +293,9 @@ func (s *BinaryDataService) UpdateInfo(ctx context.Context, data *model.BinaryDa
 
 	stored.Title = data.Title
 	stored.Metadata = data.Metadata
+	stored.Folder = data.Folder
+	stored.Tags = data.Tags
+	stored.Version = data.Version
 	stored.UpdatedAt = time.Now()
 
 	if err := s.repo.Update(ctx, stored); err != nil {
@@ -131,12 +304,16 @@ func (s *BinaryDataService) UpdateInfo(ctx context.Context, data *model.BinaryDa
 	return stored, nil
 }
 
-// Get возвращает метаданные и открытый поток для чтения
-func (s *BinaryDataService) Get(ctx context.Context, userID, id string) (*model.BinaryData, io.ReadCloser, error) {
+// Get возвращает метаданные и открытый поток для чтения.
+// Для файла, хранящегося фрагментами, возвращается model.ErrChunkedFile.
+func (s *BinaryDataService) Get(ctx context.Context, userID, id string) (*model.BinaryData, io.ReadSeekCloser, error) {
 	data, err := s.repo.GetByID(ctx, userID, id)
 	if err != nil {
 		return nil, nil, err
 	}
+	if data.Chunked {
+		return nil, nil, model.ErrChunkedFile
+	}
 
 	reader, err := s.storage.Load(ctx, data.StoragePath)
 	if err != nil {
@@ -158,9 +335,12 @@ func (s *BinaryDataService) GetInfo(ctx context.Context, userID, id string) (*mo
 	return data, nil
 }
 
-// List возвращает список метаданных
-func (s *BinaryDataService) List(ctx context.Context, userID string) ([]*model.BinaryData, error) {
-	return s.repo.ListByUser(ctx, userID)
+// List возвращает страницу метаданных, удовлетворяющих фильтру
+func (s *BinaryDataService) List(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.BinaryData, string, error) {
+	if err := page.Validate(); err != nil {
+		return nil, "", err
+	}
+	return s.repo.ListByUser(ctx, userID, filter, page.Normalize())
 }
 
 // Delete удаляет файл и метаданные
@@ -170,6 +350,21 @@ func (s *BinaryDataService) Delete(ctx context.Context, userID, id string) error
 		return err
 	}
 
+	// Прежние версии удаляются первыми: после удаления записи их
+	// содержимое уже не найти
+	if err := s.pruneVersions(ctx, userID, id, 0); err != nil {
+		return err
+	}
+
+	if data.Chunked {
+		released, err := s.chunks.DeleteFile(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		s.deleteChunks(ctx, released)
+		return nil
+	}
+
 	if err := s.repo.Delete(ctx, userID, id); err != nil {
 		return err
 	}
@@ -177,6 +372,419 @@ func (s *BinaryDataService) Delete(ctx context.Context, userID, id string) error
 	return s.storage.Delete(ctx, data.StoragePath)
 }
 
+// MissingChunks возвращает идентификаторы из ids, фрагментов с которыми
+// ещё нет у пользователя.
+func (s *BinaryDataService) MissingChunks(ctx context.Context, userID string, ids []string) ([]string, error) {
+	sizes, err := s.chunks.Sizes(ctx, userID, ids)
+	if err != nil {
+		return nil, err
+	}
+	missing := make([]string, 0, len(ids)-len(sizes))
+	for _, id := range ids {
+		if _, ok := sizes[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing, nil
+}
+
+// PutChunk сохраняет зашифрованный фрагмент id. Уже имеющийся фрагмент
+// повторно не сохраняется.
+func (s *BinaryDataService) PutChunk(ctx context.Context, userID, id string, data []byte) error {
+	sizes, err := s.chunks.Sizes(ctx, userID, []string{id})
+	if err != nil {
+		return err
+	}
+	if _, ok := sizes[id]; ok {
+		return nil
+	}
+	if err := s.quota.CheckBytes(ctx, userID, int64(len(data))); err != nil {
+		return err
+	}
+
+	storagePath, size, err := s.storage.Save(ctx, userID, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	chunk := &model.Chunk{UserID: userID, ID: id, StoragePath: storagePath, Size: size, Checksum: hex.EncodeToString(sum[:])}
+	added, err := s.chunks.Add(ctx, chunk)
+	if err != nil || !added {
+		// Фрагмент не зарегистрирован или его уже сохранила параллельная загрузка
+		_ = s.storage.Delete(ctx, storagePath)
+	}
+	return err
+}
+
+// CommitChunked создаёт (пустой data.ID) или обновляет запись, содержимое
+// которой — уже загруженные фрагменты ids по порядку. Если каких-то
+// фрагментов нет, возвращается model.ErrMissingChunks.
+func (s *BinaryDataService) CommitChunked(ctx context.Context, data *model.BinaryData, ids []string) (*model.BinaryData, error) {
+	var stored *model.BinaryData
+	if data.ID != "" {
+		var err error
+		if stored, err = s.getForUpdate(ctx, data); err != nil {
+			return nil, err
+		}
+	} else if err := s.quota.CheckItems(ctx, data.UserID, 1); err != nil {
+		return nil, err
+	}
+
+	size, err := s.chunkedSize(ctx, data.UserID, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	if stored == nil {
+		data.StoragePath = ""
+		data.Size = size
+		data.Chunked = true
+		data.Checksum = "" // контрольные суммы хранятся для каждого фрагмента
+		data.CreatedAt = time.Now()
+		data.UpdatedAt = time.Now()
+		if err := s.chunks.CreateFile(ctx, data, ids); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
+	var oldStoragePath string
+	if !stored.Chunked {
+		oldStoragePath = stored.StoragePath
+	}
+	version, err := s.saveVersion(ctx, stored)
+	if err != nil {
+		return nil, err
+	}
+	applyContentUpdate(stored, data)
+	stored.StoragePath = ""
+	stored.Size = size
+	stored.Chunked = true
+	stored.Checksum = ""
+	stored.PlainChecksum = data.PlainChecksum
+	stored.Archive = data.Archive
+
+	released, err := s.chunks.UpdateFile(ctx, stored, ids)
+	if err != nil {
+		s.dropVersion(ctx, version)
+		return nil, err
+	}
+	s.deleteChunks(ctx, released)
+	s.releaseReplaced(ctx, stored, version, oldStoragePath)
+	return stored, nil
+}
+
+// chunkedSize возвращает суммарный размер фрагментов ids или
+// model.ErrMissingChunks, если каких-то из них нет.
+func (s *BinaryDataService) chunkedSize(ctx context.Context, userID string, ids []string) (int64, error) {
+	sizes, err := s.chunks.Sizes(ctx, userID, ids)
+	if err != nil {
+		return 0, err
+	}
+	var size int64
+	for _, id := range ids {
+		chunkSize, ok := sizes[id]
+		if !ok {
+			return 0, fmt.Errorf("%w: %s", model.ErrMissingChunks, id)
+		}
+		size += chunkSize
+	}
+	return size, nil
+}
+
+// Manifest возвращает фрагменты файла по порядку. Для файла, хранящегося
+// одним потоком, возвращает false и пустой манифест.
+func (s *BinaryDataService) Manifest(ctx context.Context, userID, id string) ([]model.ChunkRef, bool, error) {
+	data, err := s.repo.GetByID(ctx, userID, id)
+	if err != nil {
+		return nil, false, err
+	}
+	if !data.Chunked {
+		return nil, false, nil
+	}
+	refs, err := s.chunks.Manifest(ctx, userID, id)
+	if err != nil {
+		return nil, false, err
+	}
+	return refs, true, nil
+}
+
+// GetChunk возвращает содержимое зашифрованного фрагмента id. Если
+// содержимое не совпадает с контрольной суммой, записанной при загрузке,
+// возвращается model.ErrChecksumMismatch.
+func (s *BinaryDataService) GetChunk(ctx context.Context, userID, id string) ([]byte, error) {
+	chunk, err := s.chunks.Get(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.loadChunk(ctx, chunk)
+}
+
+// loadChunk читает фрагмент из хранилища и сверяет его контрольную сумму
+// (у фрагментов, загруженных до её появления, сумма не проверяется).
+func (s *BinaryDataService) loadChunk(ctx context.Context, chunk *model.Chunk) ([]byte, error) {
+	r, err := s.storage.Load(ctx, chunk.StoragePath)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if chunk.Checksum != "" {
+		if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != chunk.Checksum {
+			return nil, fmt.Errorf("%w: chunk %s", model.ErrChecksumMismatch, chunk.ID)
+		}
+	}
+	return data, nil
+}
+
+// Verify перечитывает хранимое содержимое файла и сверяет его с
+// контрольными суммами, записанными при загрузке: для файла из фрагментов —
+// с суммой каждого фрагмента. Возвращает model.ErrChecksumMismatch, если
+// содержимое повреждено, и model.ErrNoChecksum, если сумма не записана.
+func (s *BinaryDataService) Verify(ctx context.Context, userID, id string) error {
+	data, err := s.repo.GetByID(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+
+	if data.Chunked {
+		refs, err := s.chunks.Manifest(ctx, userID, id)
+		if err != nil {
+			return err
+		}
+		checked := make(map[string]bool, len(refs))
+		for _, ref := range refs {
+			if checked[ref.ID] {
+				continue
+			}
+			checked[ref.ID] = true
+			chunk, err := s.chunks.Get(ctx, userID, ref.ID)
+			if err != nil {
+				return err
+			}
+			if _, err := s.loadChunk(ctx, chunk); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if data.StoragePath == "" || data.Checksum == "" {
+		return model.ErrNoChecksum
+	}
+	sum, err := s.fileChecksum(ctx, data.StoragePath)
+	if err != nil {
+		return err
+	}
+	if sum != data.Checksum {
+		return fmt.Errorf("%w: expected %s, got %s", model.ErrChecksumMismatch, data.Checksum, sum)
+	}
+	return nil
+}
+
+// ListVersions возвращает прежние версии содержимого записи id, начиная с
+// последней.
+func (s *BinaryDataService) ListVersions(ctx context.Context, userID, id string) ([]*model.BinaryVersion, error) {
+	if s.versions == nil {
+		return nil, nil
+	}
+	return s.versions.List(ctx, userID, id)
+}
+
+// GetVersion возвращает запись id с содержимым прежней версии versionID
+// (см. model.BinaryVersion.ApplyTo) и поток для его чтения. Для версии,
+// хранящейся фрагментами, возвращается model.ErrChunkedFile.
+func (s *BinaryDataService) GetVersion(ctx context.Context, userID, id, versionID string) (*model.BinaryData, io.ReadSeekCloser, error) {
+	v, err := s.version(ctx, userID, id, versionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if v.Chunked {
+		return nil, nil, model.ErrChunkedFile
+	}
+
+	reader, err := s.storage.Load(ctx, v.StoragePath)
+	if err != nil {
+		return nil, nil, err
+	}
+	data := &model.BinaryData{ID: id, UserID: userID}
+	v.ApplyTo(data)
+	return data, reader, nil
+}
+
+// VersionManifest возвращает фрагменты прежней версии versionID записи id
+// и true, если версия хранится фрагментами (false — одним потоком, см.
+// GetVersion).
+func (s *BinaryDataService) VersionManifest(ctx context.Context, userID, id, versionID string) ([]model.ChunkRef, bool, error) {
+	v, err := s.version(ctx, userID, id, versionID)
+	if err != nil {
+		return nil, false, err
+	}
+	if !v.Chunked {
+		return nil, false, nil
+	}
+	refs, err := s.versions.Manifest(ctx, userID, v.ID)
+	if err != nil {
+		return nil, false, err
+	}
+	return refs, true, nil
+}
+
+// RestoreVersion делает содержимое прежней версии versionID текущим
+// содержимым записи id; version — ожидаемая версия записи (0 — без
+// проверки). Заменяемое содержимое, как и при загрузке нового, сохраняется
+// прежней версией, а восстановленная версия из списка удаляется.
+func (s *BinaryDataService) RestoreVersion(ctx context.Context, userID, id, versionID string, version int64) (*model.BinaryData, error) {
+	stored, err := s.getForUpdate(ctx, &model.BinaryData{UserID: userID, ID: id, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	v, err := s.version(ctx, userID, id, versionID)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	if v.Chunked {
+		refs, err := s.versions.Manifest(ctx, userID, v.ID)
+		if err != nil {
+			return nil, err
+		}
+		ids = chunkIDs(refs)
+	}
+
+	current, err := s.saveVersion(ctx, stored)
+	if err != nil {
+		return nil, err
+	}
+	oldStoragePath, wasChunked := stored.StoragePath, stored.Chunked
+	v.ApplyTo(stored)
+	stored.Version = version
+	stored.UpdatedAt = time.Now()
+
+	var released []model.Chunk
+	if stored.Chunked || wasChunked {
+		released, err = s.chunks.UpdateFile(ctx, stored, ids)
+	} else {
+		err = s.repo.Update(ctx, stored)
+	}
+	if err != nil {
+		s.dropVersion(ctx, current)
+		return nil, err
+	}
+	s.deleteChunks(ctx, released)
+
+	// Файл и фрагменты восстановленной версии теперь принадлежат записи,
+	// поэтому из хранилища ничего не удаляется
+	if released, err := s.versions.Delete(ctx, userID, v.ID); err == nil {
+		s.deleteChunks(ctx, released)
+	}
+	if wasChunked {
+		oldStoragePath = ""
+	}
+	s.releaseReplaced(ctx, stored, current, oldStoragePath)
+	return stored, nil
+}
+
+// version возвращает прежнюю версию versionID записи id.
+func (s *BinaryDataService) version(ctx context.Context, userID, id, versionID string) (*model.BinaryVersion, error) {
+	if s.versions == nil {
+		return nil, model.ErrBinaryVersionNotFound
+	}
+	return s.versions.Get(ctx, userID, id, versionID)
+}
+
+// keepsVersions сообщает, сохраняется ли заменённое содержимое записей.
+func (s *BinaryDataService) keepsVersions() bool {
+	return s.versions != nil && s.keepVersions > 0
+}
+
+// saveVersion сохраняет текущее содержимое записи stored прежней версией
+// перед его заменой. Возвращает nil, если версии не хранятся или у записи
+// нет содержимого.
+func (s *BinaryDataService) saveVersion(ctx context.Context, stored *model.BinaryData) (*model.BinaryVersion, error) {
+	if !s.keepsVersions() || (stored.StoragePath == "" && !stored.Chunked) {
+		return nil, nil
+	}
+	var ids []string
+	if stored.Chunked {
+		refs, err := s.chunks.Manifest(ctx, stored.UserID, stored.ID)
+		if err != nil {
+			return nil, err
+		}
+		ids = chunkIDs(refs)
+	}
+	v := model.NewBinaryVersion(stored)
+	if err := s.versions.Save(ctx, v, ids); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// dropVersion удаляет версию, сохранённую saveVersion, если заменить
+// содержимое не удалось: оно по-прежнему принадлежит записи.
+func (s *BinaryDataService) dropVersion(ctx context.Context, v *model.BinaryVersion) {
+	if v == nil {
+		return
+	}
+	if released, err := s.versions.Delete(ctx, v.UserID, v.ID); err == nil {
+		s.deleteChunks(ctx, released)
+	}
+}
+
+// releaseReplaced освобождает содержимое, заменённое в записи stored: если
+// оно сохранено версией version, удаляет самые старые версии сверх
+// ограничения, иначе удаляет файл oldStoragePath прежнего содержимого.
+// Ошибки не возвращаются: запись уже обновлена, а лишнее найдёт сверка
+// хранилища.
+func (s *BinaryDataService) releaseReplaced(ctx context.Context, stored *model.BinaryData, version *model.BinaryVersion, oldStoragePath string) {
+	if version != nil {
+		_ = s.pruneVersions(ctx, stored.UserID, stored.ID, s.keepVersions)
+		return
+	}
+	if oldStoragePath != "" {
+		_ = s.storage.Delete(ctx, oldStoragePath)
+	}
+}
+
+// pruneVersions удаляет версии записи id, кроме keep последних, вместе с
+// их содержимым.
+func (s *BinaryDataService) pruneVersions(ctx context.Context, userID, id string, keep int) error {
+	if s.versions == nil {
+		return nil
+	}
+	pruned, released, err := s.versions.Prune(ctx, userID, id, keep)
+	if err != nil {
+		return err
+	}
+	for _, v := range pruned {
+		if !v.Chunked && v.StoragePath != "" {
+			_ = s.storage.Delete(ctx, v.StoragePath)
+		}
+	}
+	s.deleteChunks(ctx, released)
+	return nil
+}
+
+// chunkIDs возвращает идентификаторы фрагментов манифеста refs.
+func chunkIDs(refs []model.ChunkRef) []string {
+	ids := make([]string, len(refs))
+	for i, ref := range refs {
+		ids[i] = ref.ID
+	}
+	return ids
+}
+
+// deleteChunks удаляет из хранилища содержимое освобождённых фрагментов.
+func (s *BinaryDataService) deleteChunks(ctx context.Context, chunks []model.Chunk) {
+	for _, chunk := range chunks {
+		_ = s.storage.Delete(ctx, chunk.StoragePath)
+	}
+}
+
 // Close освобождает ресурсы, используемые хранилищем бинарных данных.
 func (s *BinaryDataService) Close() {
 	s.storage.Close()
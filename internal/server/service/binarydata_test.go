@@ -12,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
 	"github.com/ryabkov82/gophkeeper/internal/server/service"
 )
 
@@ -39,12 +40,12 @@ func (m *mockRepo) GetByID(ctx context.Context, userID, id string) (*model.Binar
 	return nil, args.Error(1)
 }
 
-func (m *mockRepo) ListByUser(ctx context.Context, userID string) ([]*model.BinaryData, error) {
-	args := m.Called(ctx, userID)
+func (m *mockRepo) ListByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.BinaryData, string, error) {
+	args := m.Called(ctx, userID, filter, page)
 	if v := args.Get(0); v != nil {
-		return v.([]*model.BinaryData), args.Error(1)
+		return v.([]*model.BinaryData), args.String(1), args.Error(2)
 	}
-	return nil, args.Error(1)
+	return nil, args.String(1), args.Error(2)
 }
 
 func (m *mockRepo) Delete(ctx context.Context, userID, id string) error {
@@ -52,6 +53,14 @@ func (m *mockRepo) Delete(ctx context.Context, userID, id string) error {
 	return args.Error(0)
 }
 
+func (m *mockRepo) StoredRefs(ctx context.Context) ([]model.StoredRef, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]model.StoredRef), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
 // ----
 
 type mockStorage struct {
@@ -63,10 +72,10 @@ func (m *mockStorage) Save(ctx context.Context, userID string, r io.Reader) (str
 	return args.String(0), args.Get(1).(int64), args.Error(2)
 }
 
-func (m *mockStorage) Load(ctx context.Context, path string) (io.ReadCloser, error) {
+func (m *mockStorage) Load(ctx context.Context, path string) (io.ReadSeekCloser, error) {
 	args := m.Called(ctx, path)
 	if v := args.Get(0); v != nil {
-		return v.(io.ReadCloser), args.Error(1)
+		return v.(io.ReadSeekCloser), args.Error(1)
 	}
 	return nil, args.Error(1)
 }
@@ -76,17 +85,178 @@ func (m *mockStorage) Delete(ctx context.Context, path string) error {
 	return args.Error(0)
 }
 
+func (m *mockStorage) UploadOffset(ctx context.Context, userID, uploadID string) (int64, error) {
+	args := m.Called(ctx, userID, uploadID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockStorage) UploadUsage(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockStorage) AppendUpload(ctx context.Context, userID, uploadID string, offset int64, r io.Reader) (int64, error) {
+	args := m.Called(ctx, userID, uploadID, offset, r)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *mockStorage) CommitUpload(ctx context.Context, userID, uploadID string) (string, int64, error) {
+	args := m.Called(ctx, userID, uploadID)
+	return args.String(0), args.Get(1).(int64), args.Error(2)
+}
+
+func (m *mockStorage) List(ctx context.Context, fn func(obj storage.StoredObject) error) error {
+	args := m.Called(ctx)
+	if objs, ok := args.Get(0).([]storage.StoredObject); ok {
+		for _, obj := range objs {
+			if err := fn(obj); err != nil {
+				return err
+			}
+		}
+	}
+	return args.Error(1)
+}
+
 func (m *mockStorage) Close() {
 	m.Called()
 }
 
+// ----
+
+type mockChunkRepo struct {
+	mock.Mock
+}
+
+func (m *mockChunkRepo) Sizes(ctx context.Context, userID string, ids []string) (map[string]int64, error) {
+	args := m.Called(ctx, userID, ids)
+	if v := args.Get(0); v != nil {
+		return v.(map[string]int64), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockChunkRepo) Add(ctx context.Context, chunk *model.Chunk) (bool, error) {
+	args := m.Called(ctx, chunk)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *mockChunkRepo) Get(ctx context.Context, userID, id string) (*model.Chunk, error) {
+	args := m.Called(ctx, userID, id)
+	if v := args.Get(0); v != nil {
+		return v.(*model.Chunk), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockChunkRepo) Manifest(ctx context.Context, userID, binaryID string) ([]model.ChunkRef, error) {
+	args := m.Called(ctx, userID, binaryID)
+	if v := args.Get(0); v != nil {
+		return v.([]model.ChunkRef), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockChunkRepo) CreateFile(ctx context.Context, data *model.BinaryData, ids []string) error {
+	return m.Called(ctx, data, ids).Error(0)
+}
+
+func (m *mockChunkRepo) UpdateFile(ctx context.Context, data *model.BinaryData, ids []string) ([]model.Chunk, error) {
+	args := m.Called(ctx, data, ids)
+	if v := args.Get(0); v != nil {
+		return v.([]model.Chunk), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockChunkRepo) DeleteFile(ctx context.Context, userID, binaryID string) ([]model.Chunk, error) {
+	args := m.Called(ctx, userID, binaryID)
+	if v := args.Get(0); v != nil {
+		return v.([]model.Chunk), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockChunkRepo) StoredRefs(ctx context.Context) ([]model.StoredRef, error) {
+	args := m.Called(ctx)
+	if v := args.Get(0); v != nil {
+		return v.([]model.StoredRef), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+// ----
+
+type mockVersionRepo struct {
+	mock.Mock
+}
+
+func (m *mockVersionRepo) Save(ctx context.Context, v *model.BinaryVersion, ids []string) error {
+	args := m.Called(ctx, v, ids)
+	if args.Error(0) == nil {
+		v.ID = "v-new"
+	}
+	return args.Error(0)
+}
+
+func (m *mockVersionRepo) List(ctx context.Context, userID, binaryID string) ([]*model.BinaryVersion, error) {
+	args := m.Called(ctx, userID, binaryID)
+	if v := args.Get(0); v != nil {
+		return v.([]*model.BinaryVersion), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockVersionRepo) Get(ctx context.Context, userID, binaryID, id string) (*model.BinaryVersion, error) {
+	args := m.Called(ctx, userID, binaryID, id)
+	if v := args.Get(0); v != nil {
+		return v.(*model.BinaryVersion), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockVersionRepo) Manifest(ctx context.Context, userID, id string) ([]model.ChunkRef, error) {
+	args := m.Called(ctx, userID, id)
+	if v := args.Get(0); v != nil {
+		return v.([]model.ChunkRef), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockVersionRepo) Delete(ctx context.Context, userID, id string) ([]model.Chunk, error) {
+	args := m.Called(ctx, userID, id)
+	if v := args.Get(0); v != nil {
+		return v.([]model.Chunk), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (m *mockVersionRepo) Prune(ctx context.Context, userID, binaryID string, keep int) ([]*model.BinaryVersion, []model.Chunk, error) {
+	args := m.Called(ctx, userID, binaryID, keep)
+	var pruned []*model.BinaryVersion
+	if v := args.Get(0); v != nil {
+		pruned = v.([]*model.BinaryVersion)
+	}
+	var released []model.Chunk
+	if v := args.Get(1); v != nil {
+		released = v.([]model.Chunk)
+	}
+	return pruned, released, args.Error(2)
+}
+
+// nopSeekCloser добавляет пустой Close к io.ReadSeeker
+type nopSeekCloser struct {
+	io.ReadSeeker
+}
+
+func (nopSeekCloser) Close() error { return nil }
+
 // --- тесты ---
 
 func TestBinaryDataService_Create_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	content := []byte("hello world")
@@ -116,7 +286,7 @@ func TestBinaryDataService_Create_RepoError(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	reader := bytes.NewReader([]byte("fail"))
@@ -140,7 +310,7 @@ func TestBinaryDataService_CreateInfo(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	bd := &model.BinaryData{UserID: "user1", Title: "title", Metadata: "meta", ClientPath: "path"}
 
@@ -158,7 +328,7 @@ func TestBinaryDataService_UpdateInfo(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	id := "id1"
@@ -167,10 +337,12 @@ func TestBinaryDataService_UpdateInfo(t *testing.T) {
 	repo.On("GetByID", ctx, userID, id).Return(existing, nil).Once()
 	repo.On("Update", ctx, mock.Anything).Return(nil).Once()
 
-	bd := &model.BinaryData{ID: id, UserID: userID, Title: "new", Metadata: "new", ClientPath: "new"}
+	bd := &model.BinaryData{ID: id, UserID: userID, Title: "new", Metadata: "new", ClientPath: "new", Folder: "Docs", Tags: model.Tags{"t"}}
 	updated, err := svc.UpdateInfo(ctx, bd)
 	assert.NoError(t, err)
 	assert.Equal(t, "new", updated.Title)
+	assert.Equal(t, "Docs", updated.Folder)
+	assert.Equal(t, model.Tags{"t"}, updated.Tags)
 
 	repo.AssertExpectations(t)
 	storage.AssertExpectations(t)
@@ -180,12 +352,12 @@ func TestBinaryDataService_Get_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	id := uuid.NewString()
 	bd := &model.BinaryData{ID: id, UserID: userID, StoragePath: "path/to/file"}
-	reader := io.NopCloser(bytes.NewReader([]byte("data")))
+	reader := nopSeekCloser{bytes.NewReader([]byte("data"))}
 
 	repo.On("GetByID", ctx, userID, id).Return(bd, nil).Once()
 	storage.On("Load", ctx, bd.StoragePath).Return(reader, nil).Once()
@@ -203,7 +375,7 @@ func TestBinaryDataService_GetInfo_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage) // нужен для конструктора, но в тесте не используется
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	id := uuid.NewString()
@@ -224,14 +396,14 @@ func TestBinaryDataService_List(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	expected := []*model.BinaryData{{ID: "1"}, {ID: "2"}}
 
-	repo.On("ListByUser", ctx, userID).Return(expected, nil).Once()
+	repo.On("ListByUser", ctx, userID, model.ListFilter{}, model.PageRequest{}.Normalize()).Return(expected, "", nil).Once()
 
-	got, err := svc.List(ctx, userID)
+	got, _, err := svc.List(ctx, userID, model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Equal(t, expected, got)
 
@@ -242,7 +414,7 @@ func TestBinaryDataService_Delete_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	id := "id1"
@@ -262,7 +434,7 @@ func TestBinaryDataService_Delete_Success(t *testing.T) {
 func TestBinaryDataService_Close(t *testing.T) {
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	storage.On("Close").Once()
 	svc.Close()
@@ -273,7 +445,7 @@ func TestBinaryDataService_Update_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	id := "file123"
@@ -318,7 +490,7 @@ func TestBinaryDataService_UpdateInfo_Success(t *testing.T) {
 	ctx := context.Background()
 	repo := new(mockRepo)
 	storage := new(mockStorage)
-	svc := service.NewBinaryDataService(repo, storage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
 
 	userID := "user1"
 	id := "file123"
@@ -345,3 +517,416 @@ func TestBinaryDataService_UpdateInfo_Success(t *testing.T) {
 	repo.AssertExpectations(t)
 	storage.AssertExpectations(t)
 }
+
+func TestBinaryDataService_Update_VersionConflict(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
+
+	existing := &model.BinaryData{ID: "file123", UserID: "user1", Version: 5}
+	repo.On("GetByID", ctx, "user1", "file123").Return(existing, nil).Once()
+
+	// Конфликт обнаруживается до сохранения содержимого
+	bd := &model.BinaryData{ID: "file123", UserID: "user1", Version: 4}
+	_, err := svc.Update(ctx, bd, bytes.NewReader([]byte("data")))
+
+	assert.ErrorIs(t, err, model.ErrVersionConflict)
+	repo.AssertExpectations(t)
+	storage.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBinaryDataService_ResumeUpload_Create(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
+
+	r := bytes.NewReader([]byte("tail"))
+	storage.On("AppendUpload", ctx, "user1", "up1", int64(100), r).Return(int64(104), nil).Once()
+	storage.On("CommitUpload", ctx, "user1", "up1").Return("user1/file.bin", int64(104), nil).Once()
+	repo.On("Save", ctx, mock.AnythingOfType("*model.BinaryData")).Return(nil).Once()
+	storage.On("Load", ctx, "user1/file.bin").Return(nopSeekCloser{bytes.NewReader([]byte("test"))}, nil).Once()
+
+	data, err := svc.ResumeUpload(ctx, &model.BinaryData{UserID: "user1", Title: "big"}, "up1", 100, r)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, data.ID)
+	assert.Equal(t, "user1/file.bin", data.StoragePath)
+	assert.EqualValues(t, 104, data.Size)
+	// SHA-256 собранного файла
+	assert.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", data.Checksum)
+
+	repo.AssertExpectations(t)
+	storage.AssertExpectations(t)
+}
+
+func TestBinaryDataService_ResumeUpload_Interrupted(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
+
+	existing := &model.BinaryData{ID: "file123", UserID: "user1", StoragePath: "user1/old.bin", Version: 2}
+	repo.On("GetByID", ctx, "user1", "file123").Return(existing, nil).Once()
+	storage.On("AppendUpload", ctx, "user1", "up1", int64(0), mock.Anything).
+		Return(int64(10), errors.New("stream closed")).Once()
+
+	// Обрыв потока: сессия не завершается, запись не меняется
+	bd := &model.BinaryData{ID: "file123", UserID: "user1", Version: 2}
+	_, err := svc.ResumeUpload(ctx, bd, "up1", 0, bytes.NewReader(nil))
+	assert.Error(t, err)
+	storage.AssertNotCalled(t, "CommitUpload", mock.Anything, mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestBinaryDataService_ResumeUpload_Update(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
+
+	existing := &model.BinaryData{ID: "file123", UserID: "user1", StoragePath: "user1/old.bin", Version: 2}
+	repo.On("GetByID", ctx, "user1", "file123").Return(existing, nil).Once()
+	repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+	storage.On("AppendUpload", ctx, "user1", "up1", int64(0), mock.Anything).Return(int64(5), nil).Once()
+	storage.On("CommitUpload", ctx, "user1", "up1").Return("user1/new.bin", int64(5), nil).Once()
+	storage.On("Load", ctx, "user1/new.bin").Return(nopSeekCloser{bytes.NewReader([]byte("hello"))}, nil).Once()
+	storage.On("Delete", ctx, "user1/old.bin").Return(nil).Once()
+
+	bd := &model.BinaryData{ID: "file123", UserID: "user1", Version: 2}
+	updated, err := svc.ResumeUpload(ctx, bd, "up1", 0, bytes.NewReader([]byte("hello")))
+	assert.NoError(t, err)
+	assert.Equal(t, "user1/new.bin", updated.StoragePath)
+
+	repo.AssertExpectations(t)
+	storage.AssertExpectations(t)
+}
+
+func TestBinaryDataService_UploadStatus(t *testing.T) {
+	ctx := context.Background()
+	storage := new(mockStorage)
+	svc := service.NewBinaryDataService(new(mockRepo), storage, nil, nil, nil, 0)
+
+	storage.On("UploadOffset", ctx, "user1", "up1").Return(int64(2048), nil).Once()
+
+	offset, err := svc.UploadStatus(ctx, "user1", "up1")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2048, offset)
+}
+
+func TestBinaryDataService_MissingChunks(t *testing.T) {
+	ctx := context.Background()
+	chunks := new(mockChunkRepo)
+	svc := service.NewBinaryDataService(new(mockRepo), new(mockStorage), chunks, nil, nil, 0)
+
+	ids := []string{"a", "b", "c"}
+	chunks.On("Sizes", ctx, "user1", ids).Return(map[string]int64{"b": 10}, nil).Once()
+
+	missing, err := svc.MissingChunks(ctx, "user1", ids)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "c"}, missing)
+	chunks.AssertExpectations(t)
+}
+
+func TestBinaryDataService_PutChunk(t *testing.T) {
+	ctx := context.Background()
+	storage := new(mockStorage)
+	chunks := new(mockChunkRepo)
+	svc := service.NewBinaryDataService(new(mockRepo), storage, chunks, nil, nil, 0)
+
+	// Уже имеющийся фрагмент не сохраняется повторно
+	chunks.On("Sizes", ctx, "user1", []string{"a"}).Return(map[string]int64{"a": 3}, nil).Once()
+	assert.NoError(t, svc.PutChunk(ctx, "user1", "a", []byte("abc")))
+
+	// Новый фрагмент сохраняется и регистрируется
+	chunks.On("Sizes", ctx, "user1", []string{"b"}).Return(map[string]int64{}, nil).Once()
+	storage.On("Save", ctx, "user1", mock.Anything).Return("user1/b.bin", int64(3), nil).Once()
+	chunks.On("Add", ctx, &model.Chunk{
+		UserID: "user1", ID: "b", StoragePath: "user1/b.bin", Size: 3,
+		Checksum: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad", // SHA-256 "abc"
+	}).Return(true, nil).Once()
+	assert.NoError(t, svc.PutChunk(ctx, "user1", "b", []byte("abc")))
+
+	// Параллельная загрузка успела зарегистрировать фрагмент — копия удаляется
+	chunks.On("Sizes", ctx, "user1", []string{"c"}).Return(map[string]int64{}, nil).Once()
+	storage.On("Save", ctx, "user1", mock.Anything).Return("user1/c.bin", int64(3), nil).Once()
+	chunks.On("Add", ctx, mock.Anything).Return(false, nil).Once()
+	storage.On("Delete", ctx, "user1/c.bin").Return(nil).Once()
+	assert.NoError(t, svc.PutChunk(ctx, "user1", "c", []byte("abc")))
+
+	storage.AssertExpectations(t)
+	chunks.AssertExpectations(t)
+}
+
+func TestBinaryDataService_CommitChunked_Create(t *testing.T) {
+	ctx := context.Background()
+	chunks := new(mockChunkRepo)
+	svc := service.NewBinaryDataService(new(mockRepo), new(mockStorage), chunks, nil, nil, 0)
+
+	ids := []string{"a", "b", "a"}
+	chunks.On("Sizes", ctx, "user1", ids).Return(map[string]int64{"a": 10, "b": 5}, nil).Once()
+	chunks.On("CreateFile", ctx, mock.AnythingOfType("*model.BinaryData"), ids).Return(nil).Once()
+
+	res, err := svc.CommitChunked(ctx, &model.BinaryData{UserID: "user1", Title: "file"}, ids)
+	assert.NoError(t, err)
+	assert.True(t, res.Chunked)
+	assert.Equal(t, int64(25), res.Size)
+	assert.Empty(t, res.StoragePath)
+	chunks.AssertExpectations(t)
+}
+
+func TestBinaryDataService_CommitChunked_MissingChunks(t *testing.T) {
+	ctx := context.Background()
+	chunks := new(mockChunkRepo)
+	svc := service.NewBinaryDataService(new(mockRepo), new(mockStorage), chunks, nil, nil, 0)
+
+	ids := []string{"a", "b"}
+	chunks.On("Sizes", ctx, "user1", ids).Return(map[string]int64{"a": 10}, nil).Once()
+
+	_, err := svc.CommitChunked(ctx, &model.BinaryData{UserID: "user1"}, ids)
+	assert.ErrorIs(t, err, model.ErrMissingChunks)
+	chunks.AssertNotCalled(t, "CreateFile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBinaryDataService_CommitChunked_ReplacesStream(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	chunks := new(mockChunkRepo)
+	svc := service.NewBinaryDataService(repo, storage, chunks, nil, nil, 0)
+
+	id := uuid.NewString()
+	stored := &model.BinaryData{ID: id, UserID: "user1", StoragePath: "user1/old.bin", Version: 2}
+	repo.On("GetByID", ctx, "user1", id).Return(stored, nil).Once()
+	chunks.On("Sizes", ctx, "user1", []string{"a"}).Return(map[string]int64{"a": 7}, nil).Once()
+	chunks.On("UpdateFile", ctx, stored, []string{"a"}).Return([]model.Chunk{{StoragePath: "user1/x.bin"}}, nil).Once()
+	storage.On("Delete", ctx, "user1/x.bin").Return(nil).Once()
+	storage.On("Delete", ctx, "user1/old.bin").Return(nil).Once()
+
+	res, err := svc.CommitChunked(ctx, &model.BinaryData{ID: id, UserID: "user1", Title: "new", Version: 2}, []string{"a"})
+	assert.NoError(t, err)
+	assert.True(t, res.Chunked)
+	assert.Equal(t, "new", res.Title)
+	assert.Equal(t, int64(7), res.Size)
+	storage.AssertExpectations(t)
+	chunks.AssertExpectations(t)
+}
+
+func TestBinaryDataService_ChunkedFile_GetAndDelete(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	chunks := new(mockChunkRepo)
+	svc := service.NewBinaryDataService(repo, storage, chunks, nil, nil, 0)
+
+	id := uuid.NewString()
+	stored := &model.BinaryData{ID: id, UserID: "user1", Chunked: true}
+	repo.On("GetByID", ctx, "user1", id).Return(stored, nil)
+
+	_, _, err := svc.Get(ctx, "user1", id)
+	assert.ErrorIs(t, err, model.ErrChunkedFile)
+
+	refs := []model.ChunkRef{{ID: "a", Size: 10}}
+	chunks.On("Manifest", ctx, "user1", id).Return(refs, nil).Once()
+	got, chunked, err := svc.Manifest(ctx, "user1", id)
+	assert.NoError(t, err)
+	assert.True(t, chunked)
+	assert.Equal(t, refs, got)
+
+	chunks.On("Get", ctx, "user1", "a").Return(&model.Chunk{StoragePath: "user1/a.bin"}, nil).Once()
+	storage.On("Load", ctx, "user1/a.bin").Return(nopSeekCloser{bytes.NewReader([]byte("enc"))}, nil).Once()
+	data, err := svc.GetChunk(ctx, "user1", "a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("enc"), data)
+
+	chunks.On("DeleteFile", ctx, "user1", id).Return([]model.Chunk{{StoragePath: "user1/a.bin"}}, nil).Once()
+	storage.On("Delete", ctx, "user1/a.bin").Return(nil).Once()
+	assert.NoError(t, svc.Delete(ctx, "user1", id))
+	repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+	storage.AssertExpectations(t)
+	chunks.AssertExpectations(t)
+}
+
+func TestBinaryDataService_Create_RecordsChecksum(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
+
+	storage.On("Save", ctx, "user1", mock.Anything).Run(func(args mock.Arguments) {
+		_, _ = io.Copy(io.Discard, args.Get(2).(io.Reader))
+	}).Return("user1/file.bin", int64(4), nil).Once()
+	repo.On("Save", ctx, mock.AnythingOfType("*model.BinaryData")).Return(nil).Once()
+
+	data, err := svc.Create(ctx, &model.BinaryData{UserID: "user1"}, bytes.NewReader([]byte("test")))
+	assert.NoError(t, err)
+	assert.Equal(t, "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08", data.Checksum)
+}
+
+func TestBinaryDataService_Verify(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, nil, 0)
+
+	stored := &model.BinaryData{
+		ID: "file1", UserID: "user1", StoragePath: "user1/file.bin",
+		Checksum: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	}
+	repo.On("GetByID", ctx, "user1", "file1").Return(stored, nil)
+
+	storage.On("Load", ctx, "user1/file.bin").Return(nopSeekCloser{bytes.NewReader([]byte("test"))}, nil).Once()
+	assert.NoError(t, svc.Verify(ctx, "user1", "file1"))
+
+	// Содержимое на диске повреждено
+	storage.On("Load", ctx, "user1/file.bin").Return(nopSeekCloser{bytes.NewReader([]byte("tesT"))}, nil).Once()
+	assert.ErrorIs(t, svc.Verify(ctx, "user1", "file1"), model.ErrChecksumMismatch)
+
+	// Сумма не записана
+	repo.On("GetByID", ctx, "user1", "legacy").Return(&model.BinaryData{StoragePath: "user1/legacy.bin"}, nil)
+	assert.ErrorIs(t, svc.Verify(ctx, "user1", "legacy"), model.ErrNoChecksum)
+}
+
+func TestBinaryDataService_VerifyChunked(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	chunks := new(mockChunkRepo)
+	svc := service.NewBinaryDataService(repo, storage, chunks, nil, nil, 0)
+
+	repo.On("GetByID", ctx, "user1", "file1").Return(&model.BinaryData{ID: "file1", Chunked: true}, nil)
+	chunks.On("Manifest", ctx, "user1", "file1").Return([]model.ChunkRef{{ID: "a"}, {ID: "b"}, {ID: "a"}}, nil)
+	chunks.On("Get", ctx, "user1", "a").Return(&model.Chunk{ID: "a", StoragePath: "user1/a.bin",
+		Checksum: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"}, nil)
+	chunks.On("Get", ctx, "user1", "b").Return(&model.Chunk{ID: "b", StoragePath: "user1/b.bin",
+		Checksum: "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad"}, nil)
+	storage.On("Load", ctx, "user1/a.bin").Return(nopSeekCloser{bytes.NewReader([]byte("abc"))}, nil)
+	storage.On("Load", ctx, "user1/b.bin").Return(nopSeekCloser{bytes.NewReader([]byte("abd"))}, nil).Once()
+
+	err := svc.Verify(ctx, "user1", "file1")
+	assert.ErrorIs(t, err, model.ErrChecksumMismatch)
+	assert.Contains(t, err.Error(), "chunk b")
+	// Повторяющийся фрагмент проверяется один раз
+	chunks.AssertNumberOfCalls(t, "Get", 2)
+
+	storage.On("Load", ctx, "user1/b.bin").Return(nopSeekCloser{bytes.NewReader([]byte("abd"))}, nil).Once()
+	_, err = svc.GetChunk(ctx, "user1", "b")
+	assert.ErrorIs(t, err, model.ErrChecksumMismatch)
+}
+
+func TestBinaryDataService_Update_KeepsVersion(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	versions := new(mockVersionRepo)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, versions, 2)
+
+	existing := &model.BinaryData{ID: "file1", UserID: "user1", StoragePath: "user1/old.bin", Size: 3, Checksum: "old"}
+	repo.On("GetByID", ctx, "user1", "file1").Return(existing, nil).Once()
+	repo.On("Update", ctx, mock.Anything).Return(nil).Once()
+	storage.On("Save", ctx, "user1", mock.Anything).Return("user1/new.bin", int64(3), nil).Once()
+	versions.On("Save", ctx, mock.MatchedBy(func(v *model.BinaryVersion) bool {
+		return v.BinaryID == "file1" && v.StoragePath == "user1/old.bin" && v.Checksum == "old"
+	}), []string(nil)).Return(nil).Once()
+	pruned := []*model.BinaryVersion{{ID: "v0", StoragePath: "user1/oldest.bin"}}
+	versions.On("Prune", ctx, "user1", "file1", 2).Return(pruned, nil, nil).Once()
+	storage.On("Delete", ctx, "user1/oldest.bin").Return(nil).Once()
+
+	updated, err := svc.Update(ctx, &model.BinaryData{ID: "file1", UserID: "user1"}, bytes.NewReader([]byte("new")))
+	assert.NoError(t, err)
+	assert.Equal(t, "user1/new.bin", updated.StoragePath)
+	// Файл прежнего содержимого остаётся у версии
+	storage.AssertNotCalled(t, "Delete", ctx, "user1/old.bin")
+	storage.AssertExpectations(t)
+	versions.AssertExpectations(t)
+}
+
+func TestBinaryDataService_Update_DropsVersionOnError(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	versions := new(mockVersionRepo)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, versions, 1)
+
+	existing := &model.BinaryData{ID: "file1", UserID: "user1", StoragePath: "user1/old.bin"}
+	repo.On("GetByID", ctx, "user1", "file1").Return(existing, nil).Once()
+	repo.On("Update", ctx, mock.Anything).Return(errors.New("db error")).Once()
+	storage.On("Save", ctx, "user1", mock.Anything).Return("user1/new.bin", int64(3), nil).Once()
+	storage.On("Delete", ctx, "user1/new.bin").Return(nil).Once()
+	versions.On("Save", ctx, mock.Anything, []string(nil)).Return(nil).Once()
+	versions.On("Delete", ctx, "user1", "v-new").Return(nil, nil).Once()
+
+	_, err := svc.Update(ctx, &model.BinaryData{ID: "file1", UserID: "user1"}, bytes.NewReader([]byte("new")))
+	assert.Error(t, err)
+	versions.AssertNotCalled(t, "Prune", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	storage.AssertExpectations(t)
+	versions.AssertExpectations(t)
+}
+
+func TestBinaryDataService_GetVersion(t *testing.T) {
+	ctx := context.Background()
+	storage := new(mockStorage)
+	versions := new(mockVersionRepo)
+	svc := service.NewBinaryDataService(new(mockRepo), storage, nil, nil, versions, 1)
+
+	v := &model.BinaryVersion{ID: "v1", BinaryID: "file1", StoragePath: "user1/v1.bin", Size: 5, Checksum: "sum"}
+	versions.On("Get", ctx, "user1", "file1", "v1").Return(v, nil).Once()
+	storage.On("Load", ctx, "user1/v1.bin").Return(nopSeekCloser{bytes.NewReader([]byte("hello"))}, nil).Once()
+
+	data, r, err := svc.GetVersion(ctx, "user1", "file1", "v1")
+	assert.NoError(t, err)
+	assert.Equal(t, "file1", data.ID)
+	assert.Equal(t, "sum", data.Checksum)
+	assert.NotNil(t, r)
+
+	versions.On("Get", ctx, "user1", "file1", "v2").Return(nil, model.ErrBinaryVersionNotFound).Once()
+	_, _, err = svc.GetVersion(ctx, "user1", "file1", "v2")
+	assert.ErrorIs(t, err, model.ErrBinaryVersionNotFound)
+}
+
+func TestBinaryDataService_RestoreVersion(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	versions := new(mockVersionRepo)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, versions, 3)
+
+	stored := &model.BinaryData{ID: "file1", UserID: "user1", StoragePath: "user1/cur.bin", Checksum: "cur", Version: 4}
+	repo.On("GetByID", ctx, "user1", "file1").Return(stored, nil).Once()
+	v := &model.BinaryVersion{ID: "v1", BinaryID: "file1", UserID: "user1", StoragePath: "user1/v1.bin", Size: 5, Checksum: "old"}
+	versions.On("Get", ctx, "user1", "file1", "v1").Return(v, nil).Once()
+	versions.On("Save", ctx, mock.MatchedBy(func(v *model.BinaryVersion) bool {
+		return v.StoragePath == "user1/cur.bin"
+	}), []string(nil)).Return(nil).Once()
+	repo.On("Update", ctx, mock.MatchedBy(func(d *model.BinaryData) bool {
+		return d.StoragePath == "user1/v1.bin" && d.Checksum == "old"
+	})).Return(nil).Once()
+	versions.On("Delete", ctx, "user1", "v1").Return(nil, nil).Once()
+	versions.On("Prune", ctx, "user1", "file1", 3).Return(nil, nil, nil).Once()
+
+	res, err := svc.RestoreVersion(ctx, "user1", "file1", "v1", 4)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5), res.Size)
+	storage.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	repo.AssertExpectations(t)
+	versions.AssertExpectations(t)
+}
+
+func TestBinaryDataService_Delete_PrunesVersions(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	versions := new(mockVersionRepo)
+	svc := service.NewBinaryDataService(repo, storage, nil, nil, versions, 1)
+
+	repo.On("GetByID", ctx, "user1", "file1").Return(&model.BinaryData{ID: "file1", UserID: "user1", StoragePath: "user1/cur.bin"}, nil).Once()
+	versions.On("Prune", ctx, "user1", "file1", 0).
+		Return([]*model.BinaryVersion{{ID: "v1", StoragePath: "user1/v1.bin"}}, nil, nil).Once()
+	repo.On("Delete", ctx, "user1", "file1").Return(nil).Once()
+	storage.On("Delete", ctx, "user1/v1.bin").Return(nil).Once()
+	storage.On("Delete", ctx, "user1/cur.bin").Return(nil).Once()
+
+	assert.NoError(t, svc.Delete(ctx, "user1", "file1"))
+	storage.AssertExpectations(t)
+	versions.AssertExpectations(t)
+}
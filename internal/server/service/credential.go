@@ -13,16 +13,21 @@ import (
 
 // CredentialService реализует интерфейс service.CredentialService
 type CredentialService struct {
-	repo repository.CredentialRepository
+	repo  repository.CredentialRepository
+	quota *QuotaGuard
 }
 
-// NewCredentialService создаёт новый сервис с указанным репозиторием
-func NewCredentialService(repo repository.CredentialRepository) domainService.CredentialService {
-	return &CredentialService{repo: repo}
+// NewCredentialService создаёт новый сервис с указанным репозиторием.
+// quota ограничивает число записей пользователя (nil — без ограничений).
+func NewCredentialService(repo repository.CredentialRepository, quota *QuotaGuard) domainService.CredentialService {
+	return &CredentialService{repo: repo, quota: quota}
 }
 
 // Create создаёт новую запись учётных данных с генерацией UUID и датой создания
 func (s *CredentialService) Create(ctx context.Context, cred *model.Credential) error {
+	if err := s.quota.CheckItems(ctx, cred.UserID, 1); err != nil {
+		return err
+	}
 	if cred.ID == "" {
 		cred.ID = uuid.NewString()
 	}
@@ -40,12 +45,15 @@ func (s *CredentialService) GetByID(ctx context.Context, id string) (*model.Cred
 	return s.repo.GetByID(ctx, id)
 }
 
-// GetByUserID возвращает все учётные данные пользователя
-func (s *CredentialService) GetByUserID(ctx context.Context, userID string) ([]model.Credential, error) {
+// GetByUserID возвращает страницу учётных данных пользователя, удовлетворяющих фильтру
+func (s *CredentialService) GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
 	if userID == "" {
-		return nil, errors.New("userID is required")
+		return nil, "", errors.New("userID is required")
+	}
+	if err := page.Validate(); err != nil {
+		return nil, "", err
 	}
-	return s.repo.GetByUserID(ctx, userID)
+	return s.repo.GetByUserID(ctx, userID, filter, page.Normalize())
 }
 
 // Update обновляет существующую запись учётных данных с обновлением времени
@@ -30,12 +30,12 @@ func (m *MockCredentialRepository) GetByID(ctx context.Context, id string) (*mod
 	return nil, args.Error(1)
 }
 
-func (m *MockCredentialRepository) GetByUserID(ctx context.Context, userID string) ([]model.Credential, error) {
-	args := m.Called(ctx, userID)
+func (m *MockCredentialRepository) GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
+	args := m.Called(ctx, userID, filter, page)
 	if creds, ok := args.Get(0).([]model.Credential); ok {
-		return creds, args.Error(1)
+		return creds, args.String(1), args.Error(2)
 	}
-	return nil, args.Error(1)
+	return nil, args.String(1), args.Error(2)
 }
 
 func (m *MockCredentialRepository) Update(ctx context.Context, cred *model.Credential) error {
@@ -50,7 +50,7 @@ func (m *MockCredentialRepository) Delete(ctx context.Context, id string) error
 
 func TestCredentialService_Create(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
 	cred := &model.Credential{UserID: "user1"}
 
@@ -68,7 +68,7 @@ func TestCredentialService_Create(t *testing.T) {
 
 func TestCredentialService_GetByID(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
 	testID := uuid.NewString()
 	expectedCred := &model.Credential{ID: testID, UserID: "user1"}
@@ -84,7 +84,7 @@ func TestCredentialService_GetByID(t *testing.T) {
 
 func TestCredentialService_GetByID_EmptyID(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
 	cred, err := svc.GetByID(context.Background(), "")
 	assert.Error(t, err)
@@ -93,7 +93,7 @@ func TestCredentialService_GetByID_EmptyID(t *testing.T) {
 
 func TestCredentialService_GetByUserID(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
 	userID := "user1"
 	expectedCreds := []model.Credential{
@@ -101,27 +101,46 @@ func TestCredentialService_GetByUserID(t *testing.T) {
 		{ID: uuid.NewString(), UserID: userID, Title: "title2"},
 	}
 
-	mockRepo.On("GetByUserID", mock.Anything, userID).Return(expectedCreds, nil)
+	mockRepo.On("GetByUserID", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}.Normalize()).Return(expectedCreds, "", nil)
 
-	creds, err := svc.GetByUserID(context.Background(), userID)
+	creds, _, err := svc.GetByUserID(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Equal(t, expectedCreds, creds)
 
 	mockRepo.AssertExpectations(t)
 }
 
+func TestCredentialService_GetByUserID_Page(t *testing.T) {
+	mockRepo := new(MockCredentialRepository)
+	svc := service.NewCredentialService(mockRepo, nil)
+
+	page := model.PageRequest{Size: 10, Token: "tok", SortBy: model.SortByTitle}
+	mockRepo.On("GetByUserID", mock.Anything, "user1", model.ListFilter{}, page).
+		Return([]model.Credential{{ID: "1"}}, "next", nil)
+
+	creds, next, err := svc.GetByUserID(context.Background(), "user1", model.ListFilter{}, page)
+	assert.NoError(t, err)
+	assert.Len(t, creds, 1)
+	assert.Equal(t, "next", next)
+
+	_, _, err = svc.GetByUserID(context.Background(), "user1", model.ListFilter{}, model.PageRequest{SortBy: "size"})
+	assert.Error(t, err)
+
+	mockRepo.AssertExpectations(t)
+}
+
 func TestCredentialService_GetByUserID_EmptyUserID(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
-	creds, err := svc.GetByUserID(context.Background(), "")
+	creds, _, err := svc.GetByUserID(context.Background(), "", model.ListFilter{}, model.PageRequest{})
 	assert.Error(t, err)
 	assert.Nil(t, creds)
 }
 
 func TestCredentialService_Update(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
 	cred := &model.Credential{ID: uuid.NewString(), UserID: "user1"}
 
@@ -136,7 +155,7 @@ func TestCredentialService_Update(t *testing.T) {
 
 func TestCredentialService_Update_EmptyID(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
 	err := svc.Update(context.Background(), &model.Credential{ID: ""})
 	assert.Error(t, err)
@@ -144,7 +163,7 @@ func TestCredentialService_Update_EmptyID(t *testing.T) {
 
 func TestCredentialService_Delete(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
 	testID := uuid.NewString()
 
@@ -158,7 +177,7 @@ func TestCredentialService_Delete(t *testing.T) {
 
 func TestCredentialService_Delete_EmptyID(t *testing.T) {
 	mockRepo := new(MockCredentialRepository)
-	svc := service.NewCredentialService(mockRepo)
+	svc := service.NewCredentialService(mockRepo, nil)
 
 	err := svc.Delete(context.Background(), "")
 	assert.Error(t, err)
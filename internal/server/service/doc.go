@@ -8,7 +8,16 @@
 //   - BankCardService: управление банковскими картами пользователя.
 //   - TextDataService: работа с текстовыми данными пользователя.
 //   - BinaryDataService: работа с бинарными данными, включая сохранение в файловое хранилище,
-//     потоковую загрузку/скачивание и управление метаданными.
+//     потоковую загрузку/скачивание, хранение файлов фрагментами с подсчётом
+//     ссылок на них, контрольные суммы хранимого содержимого (Verify),
+//     прежние версии заменённого содержимого (ListVersions, RestoreVersion) и
+//     управление метаданными.
+//   - BatchService: применение пакета операций над записями разных типов в одной
+//     транзакции с результатом по каждой операции.
+//   - ScrubService: периодическая сверка хранилища бинарных данных с записями
+//     (файлы без записей, записи без файлов, пересчёт контрольных сумм).
+//   - QuotaGuard: проверка ограничений пользователя на объём файлов и число
+//     записей, в том числе во время потоковой загрузки.
 //
 // Все сервисы инкапсулируют бизнес-правила и могут быть использованы как
 // слой между gRPC/HTTP-хендлерами и репозиториями/хранилищами.
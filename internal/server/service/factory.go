@@ -3,10 +3,12 @@ package service
 import (
 	"io"
 
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
 	"github.com/ryabkov82/gophkeeper/internal/domain/service"
 	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
 	"github.com/ryabkov82/gophkeeper/internal/pkg/jwtutils"
+	"go.uber.org/zap"
 )
 
 // serviceFactory — конкретная реализация фабрики сервисов.
@@ -17,18 +19,30 @@ type serviceFactory struct {
 	bankCard   service.BankCardService
 	textData   service.TextDataService
 	binaryData service.BinaryDataService
+	item       service.ItemService
+	sync       service.SyncService
+	batch      service.BatchService
+	scrub      service.ScrubService
 }
 
 // NewServiceFactory создает фабрику сервисов.
-// repoFactory — фабрика репозиториев, jwt — менеджер токенов.
-func NewServiceFactory(repoFactory repository.StorageFactory, binaryDataStorage storage.BinaryDataStorage, jwt *jwtutils.TokenManager) service.ServiceFactory {
+// repoFactory — фабрика репозиториев, jwt — менеджер токенов, limits —
+// ограничения пользователей на число записей и объём файлов, keepVersions —
+// число хранимых прежних версий содержимого файла (0 — не хранить),
+// logger — журнал фоновых операций (сверки хранилища).
+func NewServiceFactory(repoFactory repository.StorageFactory, binaryDataStorage storage.BinaryDataStorage, jwt *jwtutils.TokenManager, limits model.Quota, keepVersions int, logger *zap.Logger) service.ServiceFactory {
+	quota := NewQuotaGuard(repoFactory.Item(), binaryDataStorage, limits)
 	return &serviceFactory{
 		repoCloser: repoFactory,
 		auth:       NewAuthService(repoFactory.User(), jwt),
-		credential: NewCredentialService(repoFactory.Credential()),
-		bankCard:   NewBankCardService(repoFactory.BankCard()),
-		textData:   NewTextDataService(repoFactory.TextData()),
-		binaryData: NewBinaryDataService(repoFactory.BinaryData(), binaryDataStorage),
+		credential: NewCredentialService(repoFactory.Credential(), quota),
+		bankCard:   NewBankCardService(repoFactory.BankCard(), quota),
+		textData:   NewTextDataService(repoFactory.TextData(), quota),
+		binaryData: NewBinaryDataService(repoFactory.BinaryData(), binaryDataStorage, repoFactory.Chunk(), quota, repoFactory.BinaryVersion(), keepVersions),
+		item:       NewItemService(repoFactory.Item(), quota),
+		sync:       NewSyncService(repoFactory.Change(), repoFactory.ChangeFeed()),
+		batch:      NewBatchService(repoFactory.Batch(), quota),
+		scrub:      NewScrubService(repoFactory.BinaryData(), repoFactory.Chunk(), binaryDataStorage, logger),
 	}
 }
 
@@ -57,8 +71,31 @@ func (f *serviceFactory) BinaryData() service.BinaryDataService {
 	return f.binaryData
 }
 
+// Item возвращает сервис избранных и недавно открытых записей.
+func (f *serviceFactory) Item() service.ItemService {
+	return f.item
+}
+
+// Sync возвращает сервис разностной синхронизации.
+func (f *serviceFactory) Sync() service.SyncService {
+	return f.sync
+}
+
+// Batch возвращает сервис пакетного изменения записей.
+func (f *serviceFactory) Batch() service.BatchService {
+	return f.batch
+}
+
+// Scrub возвращает сервис сверки хранилища бинарных данных.
+func (f *serviceFactory) Scrub() service.ScrubService {
+	return f.scrub
+}
+
 // Close освобождает ресурсы сервисов и репозиториев.
 func (f *serviceFactory) Close() {
+	if f.scrub != nil {
+		f.scrub.Close()
+	}
 	if f.binaryData != nil {
 		f.binaryData.Close()
 	}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+)
+
+// DefaultFavoritesRecentLimit — размер списка избранных и недавних записей по умолчанию.
+const DefaultFavoritesRecentLimit = 50
+
+// ItemServiceImpl реализует интерфейс service.ItemService
+type ItemServiceImpl struct {
+	repo  repository.ItemRepository
+	quota *QuotaGuard
+}
+
+// NewItemService создаёт новый сервис с указанным репозиторием.
+// quota задаёт ограничения, о которых сообщает Usage (nil — без ограничений).
+func NewItemService(repo repository.ItemRepository, quota *QuotaGuard) service.ItemService {
+	return &ItemServiceImpl{repo: repo, quota: quota}
+}
+
+// SetFavorite устанавливает или снимает отметку «избранное» у записи
+func (s *ItemServiceImpl) SetFavorite(ctx context.Context, userID string, itemType model.ItemType, id string, favorite bool) error {
+	if err := validateItemRef(userID, itemType, id); err != nil {
+		return err
+	}
+	return s.repo.SetFavorite(ctx, userID, itemType, id, favorite)
+}
+
+// MarkAccessed фиксирует текущее время как время последнего открытия записи
+func (s *ItemServiceImpl) MarkAccessed(ctx context.Context, userID string, itemType model.ItemType, id string) error {
+	if err := validateItemRef(userID, itemType, id); err != nil {
+		return err
+	}
+	return s.repo.MarkAccessed(ctx, userID, itemType, id, time.Now())
+}
+
+// ListFavoritesRecent возвращает избранные и недавно открытые записи пользователя
+func (s *ItemServiceImpl) ListFavoritesRecent(ctx context.Context, userID string, limit int) ([]model.ItemSummary, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if limit <= 0 {
+		limit = DefaultFavoritesRecentLimit
+	}
+	return s.repo.ListFavoritesRecent(ctx, userID, limit)
+}
+
+// Usage возвращает занятое пользователем место (вместе с незавершёнными
+// загрузками) и действующие ограничения
+func (s *ItemServiceImpl) Usage(ctx context.Context, userID string) (*model.Usage, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	usage, err := s.repo.Usage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	staged, err := s.quota.StagedBytes(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	usage.Bytes += staged
+	usage.Limits = s.quota.Limits()
+	return usage, nil
+}
+
+// validateItemRef проверяет обязательные параметры ссылки на запись.
+func validateItemRef(userID string, itemType model.ItemType, id string) error {
+	if userID == "" {
+		return errors.New("userID is required")
+	}
+	if id == "" {
+		return errors.New("id is required")
+	}
+	return itemType.Validate()
+}
@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/server/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// Мок репозитория общих операций над записями
+type mockItemRepo struct {
+	mock.Mock
+}
+
+func (m *mockItemRepo) SetFavorite(ctx context.Context, userID string, itemType model.ItemType, id string, favorite bool) error {
+	args := m.Called(ctx, userID, itemType, id, favorite)
+	return args.Error(0)
+}
+
+func (m *mockItemRepo) MarkAccessed(ctx context.Context, userID string, itemType model.ItemType, id string, at time.Time) error {
+	args := m.Called(ctx, userID, itemType, id, at)
+	return args.Error(0)
+}
+
+func (m *mockItemRepo) ListFavoritesRecent(ctx context.Context, userID string, limit int) ([]model.ItemSummary, error) {
+	args := m.Called(ctx, userID, limit)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.([]model.ItemSummary), args.Error(1)
+}
+
+func (m *mockItemRepo) Usage(ctx context.Context, userID string) (*model.Usage, error) {
+	args := m.Called(ctx, userID)
+	if v := args.Get(0); v != nil {
+		// Копия: сервисы дополняют результат ограничениями
+		usage := *v.(*model.Usage)
+		return &usage, args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func TestItemService_SetFavorite(t *testing.T) {
+	repo := new(mockItemRepo)
+	svc := service.NewItemService(repo, nil)
+
+	repo.On("SetFavorite", mock.Anything, "user1", model.ItemTypeCredential, "id1", true).Return(nil)
+
+	err := svc.SetFavorite(context.Background(), "user1", model.ItemTypeCredential, "id1", true)
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestItemService_SetFavorite_Validation(t *testing.T) {
+	svc := service.NewItemService(new(mockItemRepo), nil)
+	ctx := context.Background()
+
+	assert.Error(t, svc.SetFavorite(ctx, "", model.ItemTypeCredential, "id1", true))
+	assert.Error(t, svc.SetFavorite(ctx, "user1", model.ItemTypeCredential, "", true))
+	assert.Error(t, svc.SetFavorite(ctx, "user1", model.ItemType("unknown"), "id1", true))
+}
+
+func TestItemService_MarkAccessed(t *testing.T) {
+	repo := new(mockItemRepo)
+	svc := service.NewItemService(repo, nil)
+
+	repo.On("MarkAccessed", mock.Anything, "user1", model.ItemTypeTextData, "id1",
+		mock.MatchedBy(func(at time.Time) bool { return time.Since(at) < time.Second }),
+	).Return(nil)
+
+	err := svc.MarkAccessed(context.Background(), "user1", model.ItemTypeTextData, "id1")
+	assert.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestItemService_MarkAccessed_RepoError(t *testing.T) {
+	repo := new(mockItemRepo)
+	svc := service.NewItemService(repo, nil)
+
+	repo.On("MarkAccessed", mock.Anything, "user1", model.ItemTypeBankCard, "id1", mock.Anything).
+		Return(errors.New("not found"))
+
+	err := svc.MarkAccessed(context.Background(), "user1", model.ItemTypeBankCard, "id1")
+	assert.EqualError(t, err, "not found")
+}
+
+func TestItemService_ListFavoritesRecent_DefaultLimit(t *testing.T) {
+	repo := new(mockItemRepo)
+	svc := service.NewItemService(repo, nil)
+
+	items := []model.ItemSummary{{Type: model.ItemTypeBinaryData, ID: "id1", Title: "file", Favorite: true}}
+	repo.On("ListFavoritesRecent", mock.Anything, "user1", service.DefaultFavoritesRecentLimit).Return(items, nil)
+
+	result, err := svc.ListFavoritesRecent(context.Background(), "user1", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, items, result)
+	repo.AssertExpectations(t)
+}
+
+func TestItemService_ListFavoritesRecent_NoUser(t *testing.T) {
+	svc := service.NewItemService(new(mockItemRepo), nil)
+
+	_, err := svc.ListFavoritesRecent(context.Background(), "", 10)
+	assert.Error(t, err)
+}
+
+func TestItemService_Usage(t *testing.T) {
+	repo := new(mockItemRepo)
+	limits := model.Quota{MaxBytes: 1 << 20, MaxItems: 100}
+	svc := service.NewItemService(repo, service.NewQuotaGuard(repo, nil, limits))
+
+	repo.On("Usage", mock.Anything, "user1").Return(&model.Usage{Bytes: 4096, Items: 3}, nil)
+
+	usage, err := svc.Usage(context.Background(), "user1")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.Usage{Bytes: 4096, Items: 3, Limits: limits}, usage)
+
+	_, err = svc.Usage(context.Background(), "")
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
+)
+
+// QuotaGuard проверяет ограничения пользователей на число записей и объём
+// хранимых файлов. Методы допускают nil-получатель: nil-guard ничего не
+// ограничивает.
+//
+// Объём файлов включает данные незавершённых сессий загрузки: иначе
+// пользователь мог бы занять диск сессиями, которые никогда не фиксируются.
+//
+// Проверки не блокируют параллельные операции одного пользователя, поэтому
+// одновременные загрузки могут превысить квоту на размер одной из них.
+type QuotaGuard struct {
+	repo    repository.ItemRepository
+	staging storage.BinaryDataStorage
+	limits  model.Quota
+}
+
+// NewQuotaGuard создаёт проверку ограничений limits по занятому месту из repo
+// и сессиям загрузки в staging (nil — сессии не учитываются).
+func NewQuotaGuard(repo repository.ItemRepository, staging storage.BinaryDataStorage, limits model.Quota) *QuotaGuard {
+	return &QuotaGuard{repo: repo, staging: staging, limits: limits}
+}
+
+// Limits возвращает действующие ограничения.
+func (q *QuotaGuard) Limits() model.Quota {
+	if q == nil {
+		return model.Quota{}
+	}
+	return q.limits
+}
+
+// CheckItems возвращает model.ErrQuotaExceeded, если n новых записей
+// превысят ограничение пользователя на число записей.
+func (q *QuotaGuard) CheckItems(ctx context.Context, userID string, n int64) error {
+	if q == nil || q.limits.MaxItems <= 0 || n <= 0 {
+		return nil
+	}
+	usage, err := q.repo.Usage(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if usage.Items+n > q.limits.MaxItems {
+		return fmt.Errorf("%w: %d items of %d", model.ErrQuotaExceeded, usage.Items, q.limits.MaxItems)
+	}
+	return nil
+}
+
+// RemainingBytes возвращает, сколько байт пользователь ещё может сохранить,
+// и false, если объём не ограничен.
+func (q *QuotaGuard) RemainingBytes(ctx context.Context, userID string) (int64, bool, error) {
+	if q == nil || q.limits.MaxBytes <= 0 {
+		return 0, false, nil
+	}
+	usage, err := q.repo.Usage(ctx, userID)
+	if err != nil {
+		return 0, false, err
+	}
+	staged, err := q.StagedBytes(ctx, userID)
+	if err != nil {
+		return 0, false, err
+	}
+	return max(q.limits.MaxBytes-usage.Bytes-staged, 0), true, nil
+}
+
+// StagedBytes возвращает объём данных в незавершённых сессиях загрузки пользователя.
+func (q *QuotaGuard) StagedBytes(ctx context.Context, userID string) (int64, error) {
+	if q == nil || q.staging == nil {
+		return 0, nil
+	}
+	return q.staging.UploadUsage(ctx, userID)
+}
+
+// LimitReader ограничивает поток r остатком квоты пользователя, увеличенным
+// на freed — объём, который освободит операция (например, заменяемый файл).
+// Чтение сверх остатка завершается ошибкой model.ErrQuotaExceeded, так что
+// загрузка прерывается, как только квота превышена.
+func (q *QuotaGuard) LimitReader(ctx context.Context, userID string, freed int64, r io.Reader) (io.Reader, error) {
+	left, limited, err := q.RemainingBytes(ctx, userID)
+	if err != nil || !limited {
+		return r, err
+	}
+	return &quotaReader{r: r, left: max(left+freed, 0)}, nil
+}
+
+// LimitUploadReader ограничивает поток r, продолжающий сессию загрузки
+// uploadID со смещения offset, как LimitReader. Данные сессии до offset
+// остаются в ней, а принятые после offset отбрасываются, поэтому место
+// сессии учитывается по offset, а не по текущему размеру.
+func (q *QuotaGuard) LimitUploadReader(ctx context.Context, userID, uploadID string, freed, offset int64, r io.Reader) (io.Reader, error) {
+	if q == nil || q.limits.MaxBytes <= 0 {
+		return r, nil
+	}
+	var staged int64
+	if q.staging != nil {
+		var err error
+		if staged, err = q.staging.UploadOffset(ctx, userID, uploadID); err != nil {
+			return nil, err
+		}
+	}
+	return q.LimitReader(ctx, userID, freed+staged-offset, r)
+}
+
+// CheckBytes возвращает model.ErrQuotaExceeded, если n новых байт превысят
+// ограничение пользователя на объём файлов.
+func (q *QuotaGuard) CheckBytes(ctx context.Context, userID string, n int64) error {
+	left, limited, err := q.RemainingBytes(ctx, userID)
+	if err != nil || !limited {
+		return err
+	}
+	if n > left {
+		return fmt.Errorf("%w: %d bytes left", model.ErrQuotaExceeded, left)
+	}
+	return nil
+}
+
+// quotaReader читает не больше left байт и сообщает о превышении квоты,
+// если в потоке есть ещё данные.
+type quotaReader struct {
+	r    io.Reader
+	left int64
+}
+
+// Read читает из потока; байт сверх остатка квоты не возвращается.
+func (q *quotaReader) Read(p []byte) (int, error) {
+	// Читаем на байт больше остатка, чтобы заметить превышение
+	if int64(len(p)) > q.left+1 {
+		p = p[:q.left+1]
+	}
+	n, err := q.r.Read(p)
+	if int64(n) > q.left {
+		n = int(q.left)
+		q.left = 0
+		return n, model.ErrQuotaExceeded
+	}
+	q.left -= int64(n)
+	return n, err
+}
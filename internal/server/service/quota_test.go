@@ -0,0 +1,168 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/server/service"
+)
+
+func TestQuotaGuard_Nil(t *testing.T) {
+	var q *service.QuotaGuard
+	ctx := context.Background()
+
+	assert.Equal(t, model.Quota{}, q.Limits())
+	assert.NoError(t, q.CheckItems(ctx, "user1", 10))
+	assert.NoError(t, q.CheckBytes(ctx, "user1", 1<<40))
+
+	r := bytes.NewReader([]byte("data"))
+	limited, err := q.LimitReader(ctx, "user1", 0, r)
+	require.NoError(t, err)
+	assert.Same(t, r, limited)
+}
+
+func TestQuotaGuard_CheckItems(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockItemRepo)
+	q := service.NewQuotaGuard(repo, nil, model.Quota{MaxItems: 5})
+	repo.On("Usage", ctx, "user1").Return(&model.Usage{Items: 4}, nil)
+
+	assert.NoError(t, q.CheckItems(ctx, "user1", 1))
+	assert.ErrorIs(t, q.CheckItems(ctx, "user1", 2), model.ErrQuotaExceeded)
+}
+
+func TestQuotaGuard_CheckBytes(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockItemRepo)
+	q := service.NewQuotaGuard(repo, nil, model.Quota{MaxBytes: 100})
+	repo.On("Usage", ctx, "user1").Return(&model.Usage{Bytes: 90}, nil)
+
+	assert.NoError(t, q.CheckBytes(ctx, "user1", 10))
+	assert.ErrorIs(t, q.CheckBytes(ctx, "user1", 11), model.ErrQuotaExceeded)
+	// Ограничено только число байт
+	assert.NoError(t, q.CheckItems(ctx, "user1", 1000))
+}
+
+func TestQuotaGuard_LimitReader(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockItemRepo)
+	q := service.NewQuotaGuard(repo, nil, model.Quota{MaxBytes: 100})
+	repo.On("Usage", ctx, "user1").Return(&model.Usage{Bytes: 96}, nil)
+
+	// Поток укладывается в остаток
+	r, err := q.LimitReader(ctx, "user1", 0, bytes.NewReader([]byte("data")))
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "data", string(data))
+
+	// Поток больше остатка: отдаётся только остаток и ошибка
+	r, err = q.LimitReader(ctx, "user1", 0, bytes.NewReader([]byte("data+")))
+	require.NoError(t, err)
+	data, err = io.ReadAll(r)
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+	assert.Equal(t, "data", string(data))
+
+	// Освобождаемое место увеличивает остаток
+	r, err = q.LimitReader(ctx, "user1", 10, bytes.NewReader([]byte("0123456789")))
+	require.NoError(t, err)
+	_, err = io.ReadAll(r)
+	assert.NoError(t, err)
+}
+
+func TestQuotaGuard_StagedUploads(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockItemRepo)
+	staging := new(mockStorage)
+	q := service.NewQuotaGuard(repo, staging, model.Quota{MaxBytes: 100})
+	repo.On("Usage", ctx, "user1").Return(&model.Usage{Bytes: 50}, nil)
+	// Две незавершённые сессии: 20 байт в "up1" и 10 байт в другой
+	staging.On("UploadUsage", ctx, "user1").Return(int64(30), nil)
+	staging.On("UploadOffset", ctx, "user1", "up1").Return(int64(20), nil)
+
+	staged, err := q.StagedBytes(ctx, "user1")
+	require.NoError(t, err)
+	assert.EqualValues(t, 30, staged)
+	assert.NoError(t, q.CheckBytes(ctx, "user1", 20))
+	assert.ErrorIs(t, q.CheckBytes(ctx, "user1", 21), model.ErrQuotaExceeded)
+
+	// Продолжение "up1" с конца сессии: её 20 байт уже учтены
+	r, err := q.LimitUploadReader(ctx, "user1", "up1", 0, 20, bytes.NewReader(make([]byte, 21)))
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+	assert.Len(t, data, 20)
+
+	// Продолжение с середины: хвост сессии отбрасывается и освобождает место
+	r, err = q.LimitUploadReader(ctx, "user1", "up1", 0, 10, bytes.NewReader(make([]byte, 30)))
+	require.NoError(t, err)
+	_, err = io.ReadAll(r)
+	assert.NoError(t, err)
+}
+
+func TestBinaryDataService_Create_QuotaExceeded(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	storage := new(mockStorage)
+	items := new(mockItemRepo)
+	quota := service.NewQuotaGuard(items, nil, model.Quota{MaxBytes: 3, MaxItems: 10})
+	svc := service.NewBinaryDataService(repo, storage, nil, quota, nil, 0)
+
+	items.On("Usage", ctx, "user1").Return(&model.Usage{Items: 1}, nil)
+	storage.On("Save", ctx, "user1", mock.Anything).Return("", int64(0), model.ErrQuotaExceeded).
+		Run(func(args mock.Arguments) {
+			// Хранилище читает поток, ограниченный квотой
+			_, err := io.ReadAll(args.Get(2).(io.Reader))
+			assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+		})
+
+	_, err := svc.Create(ctx, &model.BinaryData{UserID: "user1"}, bytes.NewReader([]byte("test")))
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+	repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
+func TestBinaryDataService_CreateInfo_ItemLimit(t *testing.T) {
+	ctx := context.Background()
+	items := new(mockItemRepo)
+	quota := service.NewQuotaGuard(items, nil, model.Quota{MaxItems: 2})
+	svc := service.NewBinaryDataService(new(mockRepo), new(mockStorage), nil, quota, nil, 0)
+
+	items.On("Usage", ctx, "user1").Return(&model.Usage{Items: 2}, nil)
+
+	_, err := svc.CreateInfo(ctx, &model.BinaryData{UserID: "user1"})
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+}
+
+func TestBinaryDataService_PutChunk_QuotaExceeded(t *testing.T) {
+	ctx := context.Background()
+	chunks := new(mockChunkRepo)
+	items := new(mockItemRepo)
+	storage := new(mockStorage)
+	quota := service.NewQuotaGuard(items, nil, model.Quota{MaxBytes: 10})
+	svc := service.NewBinaryDataService(new(mockRepo), storage, chunks, quota, nil, 0)
+
+	chunks.On("Sizes", ctx, "user1", []string{"c1"}).Return(map[string]int64{}, nil)
+	items.On("Usage", ctx, "user1").Return(&model.Usage{Bytes: 8}, nil)
+
+	err := svc.PutChunk(ctx, "user1", "c1", []byte("test"))
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+	storage.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCredentialService_Create_ItemLimit(t *testing.T) {
+	ctx := context.Background()
+	items := new(mockItemRepo)
+	svc := service.NewCredentialService(new(MockCredentialRepository), service.NewQuotaGuard(items, nil, model.Quota{MaxItems: 1}))
+
+	items.On("Usage", ctx, "user1").Return(&model.Usage{Items: 1}, nil)
+
+	err := svc.Create(ctx, &model.Credential{UserID: "user1", Title: "GitHub"})
+	assert.ErrorIs(t, err, model.ErrQuotaExceeded)
+}
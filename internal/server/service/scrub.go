@@ -0,0 +1,269 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
+	"go.uber.org/zap"
+)
+
+// DefaultOrphanGrace — срок, в течение которого файл без записи не считается
+// лишним, если в ScrubOptions он не задан.
+const DefaultOrphanGrace = time.Hour
+
+// ScrubServiceImpl реализует интерфейс service.ScrubService.
+type ScrubServiceImpl struct {
+	repo    repository.BinaryDataRepository
+	chunks  repository.ChunkRepository
+	storage storage.BinaryDataStorage
+	logger  *zap.Logger
+
+	running sync.Mutex // удерживается на время сверки
+
+	mu     sync.Mutex
+	last   *model.ScrubReport
+	stopCh chan struct{}
+}
+
+// NewScrubService создаёт сервис сверки хранилища st с записями repo и
+// фрагментами chunks (nil — фрагменты не учитываются).
+func NewScrubService(repo repository.BinaryDataRepository, chunks repository.ChunkRepository, st storage.BinaryDataStorage, logger *zap.Logger) *ScrubServiceImpl {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &ScrubServiceImpl{repo: repo, chunks: chunks, storage: st, logger: logger}
+}
+
+var _ service.ScrubService = (*ScrubServiceImpl)(nil)
+
+// Run сверяет хранилище с записями.
+//
+// Ссылки записей на файлы читаются до и после обхода хранилища. Лишним
+// считается файл старше opts.OrphanGrace, на который не ссылается ни одна
+// из прочитанных записей; утраченным — файл записи, которая есть в обоих
+// чтениях с тем же путём, но файла которой нет в хранилище. Так сверка не
+// путает с расхождениями файлы и записи, созданные или удалённые во время
+// обхода.
+//
+// В режиме opts.Fix лишние файлы удаляются, как и записи (не фрагменты) с
+// утраченными файлами: скачать их содержимое всё равно невозможно. Фрагменты
+// без файлов только попадают в отчёт — на них ссылаются манифесты файлов;
+// как и прежние версии записей, которые удаляются вместе с записью.
+// В режиме opts.Rehash каждый файл с записанной контрольной суммой
+// перечитывается и сверяется с ней.
+func (s *ScrubServiceImpl) Run(ctx context.Context, opts model.ScrubOptions) (*model.ScrubReport, error) {
+	if !s.running.TryLock() {
+		return nil, model.ErrScrubInProgress
+	}
+	defer s.running.Unlock()
+
+	if opts.OrphanGrace <= 0 {
+		opts.OrphanGrace = DefaultOrphanGrace
+	}
+	report := &model.ScrubReport{StartedAt: time.Now(), Options: opts}
+
+	before, err := s.storedRefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cutoff := report.StartedAt.Add(-opts.OrphanGrace)
+	present := make(map[string]bool, len(before))
+	var candidates []string
+	err = s.storage.List(ctx, func(obj storage.StoredObject) error {
+		report.FilesScanned++
+		if _, ok := before[obj.Path]; ok {
+			present[obj.Path] = true
+		} else if obj.ModTime.Before(cutoff) {
+			candidates = append(candidates, obj.Path)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	after, err := s.storedRefs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	report.RecordsScanned = len(after)
+
+	for _, path := range candidates {
+		if _, ok := after[path]; ok {
+			continue
+		}
+		report.OrphanFiles = append(report.OrphanFiles, path)
+		s.logger.Warn("Scrub: file has no record", zap.String("path", path))
+		if opts.Fix {
+			if err := s.storage.Delete(ctx, path); err != nil {
+				s.addError(report, fmt.Errorf("delete orphan file %s: %w", path, err))
+				continue
+			}
+			report.RemovedFiles++
+		}
+	}
+
+	for path, ref := range before {
+		if present[path] {
+			continue
+		}
+		if cur, ok := after[path]; !ok || cur.ID != ref.ID {
+			continue
+		}
+		report.MissingFiles = append(report.MissingFiles, ref)
+		s.logger.Warn("Scrub: stored file is missing", refFields(ref)...)
+		if opts.Fix && !ref.Chunk && !ref.Version {
+			if err := s.repo.Delete(ctx, ref.UserID, ref.ID); err != nil {
+				s.addError(report, fmt.Errorf("delete record %s: %w", ref.ID, err))
+				continue
+			}
+			report.RemovedRecords++
+		}
+	}
+
+	if opts.Rehash {
+		for path, ref := range after {
+			if !present[path] || ref.Checksum == "" {
+				continue
+			}
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			sum, err := storageChecksum(ctx, s.storage, path)
+			if err != nil {
+				s.addError(report, fmt.Errorf("rehash %s: %w", path, err))
+				continue
+			}
+			report.FilesRehashed++
+			if sum != ref.Checksum {
+				report.ChecksumMismatches = append(report.ChecksumMismatches, ref)
+				s.logger.Error("Scrub: stored file checksum mismatch", refFields(ref)...)
+			}
+		}
+	}
+
+	sortRefs(report.MissingFiles)
+	sortRefs(report.ChecksumMismatches)
+	report.FinishedAt = time.Now()
+	s.logger.Info("Scrub finished",
+		zap.Int("files", report.FilesScanned),
+		zap.Int("records", report.RecordsScanned),
+		zap.Int("orphanFiles", len(report.OrphanFiles)),
+		zap.Int("missingFiles", len(report.MissingFiles)),
+		zap.Int("checksumMismatches", len(report.ChecksumMismatches)),
+		zap.Int("removedFiles", report.RemovedFiles),
+		zap.Int("removedRecords", report.RemovedRecords),
+		zap.Int("errors", len(report.Errors)),
+		zap.Duration("duration", report.FinishedAt.Sub(report.StartedAt)),
+	)
+
+	s.mu.Lock()
+	s.last = report
+	s.mu.Unlock()
+	return report, nil
+}
+
+// storedRefs возвращает ссылки записей и фрагментов на файлы по путям.
+func (s *ScrubServiceImpl) storedRefs(ctx context.Context) (map[string]model.StoredRef, error) {
+	refs, err := s.repo.StoredRefs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load binary data refs: %w", err)
+	}
+	if s.chunks != nil {
+		chunkRefs, err := s.chunks.StoredRefs(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load chunk refs: %w", err)
+		}
+		refs = append(refs, chunkRefs...)
+	}
+
+	byPath := make(map[string]model.StoredRef, len(refs))
+	for _, ref := range refs {
+		byPath[ref.StoragePath] = ref
+	}
+	return byPath, nil
+}
+
+// sortRefs упорядочивает ссылки по путям, чтобы отчёты были сравнимы.
+func sortRefs(refs []model.StoredRef) {
+	sort.Slice(refs, func(i, j int) bool { return refs[i].StoragePath < refs[j].StoragePath })
+}
+
+// addError записывает в отчёт ошибку, не прервавшую сверку.
+func (s *ScrubServiceImpl) addError(report *model.ScrubReport, err error) {
+	report.Errors = append(report.Errors, err.Error())
+	s.logger.Error("Scrub error", zap.Error(err))
+}
+
+// refFields описывает ссылку на файл полями журнала.
+func refFields(ref model.StoredRef) []zap.Field {
+	return []zap.Field{
+		zap.String("userID", ref.UserID),
+		zap.String("id", ref.ID),
+		zap.String("path", ref.StoragePath),
+		zap.Bool("chunk", ref.Chunk),
+		zap.Bool("version", ref.Version),
+	}
+}
+
+// LastReport возвращает отчёт последней завершённой сверки.
+func (s *ScrubServiceImpl) LastReport() *model.ScrubReport {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.last
+}
+
+// Start запускает фоновую горутину, выполняющую сверку каждые interval.
+// Повторный вызов останавливает прежнюю горутину.
+func (s *ScrubServiceImpl) Start(interval time.Duration, opts model.ScrubOptions) {
+	s.mu.Lock()
+	if s.stopCh != nil {
+		close(s.stopCh)
+	}
+	stopCh := make(chan struct{})
+	s.stopCh = stopCh
+	s.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			<-stopCh
+			cancel()
+		}()
+
+		for {
+			select {
+			case <-ticker.C:
+				_, err := s.Run(ctx, opts)
+				if err != nil && !errors.Is(err, model.ErrScrubInProgress) && ctx.Err() == nil {
+					s.logger.Error("Scrub failed", zap.Error(err))
+				}
+			case <-stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Close останавливает периодическую сверку.
+func (s *ScrubServiceImpl) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopCh != nil {
+		close(s.stopCh)
+		s.stopCh = nil
+	}
+}
@@ -0,0 +1,184 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
+	"github.com/ryabkov82/gophkeeper/internal/server/service"
+)
+
+// sha256 строки "test"
+const testChecksum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestScrubService_Run_ReportOnly(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	chunks := new(mockChunkRepo)
+	st := new(mockStorage)
+	svc := service.NewScrubService(repo, chunks, st, nil)
+
+	old := time.Now().Add(-2 * time.Hour)
+	repo.On("StoredRefs", ctx).Return([]model.StoredRef{
+		{UserID: "user1", ID: "file1", StoragePath: "user1/file1.bin"},
+		{UserID: "user1", ID: "file2", StoragePath: "user1/file2.bin"},
+	}, nil)
+	chunks.On("StoredRefs", ctx).Return([]model.StoredRef{
+		{UserID: "user1", ID: "c1", StoragePath: "user1/c1.bin", Chunk: true},
+	}, nil)
+	st.On("List", ctx).Return([]storage.StoredObject{
+		{Path: "user1/file1.bin", ModTime: old},
+		{Path: "user1/orphan.bin", ModTime: old},
+		{Path: "user1/fresh.bin", ModTime: time.Now()},
+	}, nil)
+
+	report, err := svc.Run(ctx, model.ScrubOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, report.FilesScanned)
+	assert.Equal(t, 3, report.RecordsScanned)
+	assert.Equal(t, []string{"user1/orphan.bin"}, report.OrphanFiles)
+	require.Len(t, report.MissingFiles, 2)
+	assert.Equal(t, "user1/c1.bin", report.MissingFiles[0].StoragePath)
+	assert.Equal(t, "user1/file2.bin", report.MissingFiles[1].StoragePath)
+	assert.Zero(t, report.RemovedFiles)
+	assert.Zero(t, report.RemovedRecords)
+	assert.False(t, report.Clean())
+	assert.Same(t, report, svc.LastReport())
+
+	st.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	repo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestScrubService_Run_Fix(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	chunks := new(mockChunkRepo)
+	st := new(mockStorage)
+	svc := service.NewScrubService(repo, chunks, st, nil)
+
+	old := time.Now().Add(-2 * time.Hour)
+	repo.On("StoredRefs", ctx).Return([]model.StoredRef{
+		{UserID: "user1", ID: "file2", StoragePath: "user1/file2.bin"},
+		{UserID: "user1", ID: "v1", StoragePath: "user1/v1.bin", Version: true},
+	}, nil)
+	chunks.On("StoredRefs", ctx).Return([]model.StoredRef{
+		{UserID: "user1", ID: "c1", StoragePath: "user1/c1.bin", Chunk: true},
+	}, nil)
+	st.On("List", ctx).Return([]storage.StoredObject{
+		{Path: "user1/orphan.bin", ModTime: old},
+	}, nil)
+	st.On("Delete", ctx, "user1/orphan.bin").Return(nil)
+	repo.On("Delete", ctx, "user1", "file2").Return(nil)
+
+	report, err := svc.Run(ctx, model.ScrubOptions{Fix: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, report.RemovedFiles)
+	assert.Equal(t, 1, report.RemovedRecords)
+	assert.Len(t, report.MissingFiles, 3)
+	// Фрагмент и прежняя версия без файла только попадают в отчёт
+	repo.AssertNumberOfCalls(t, "Delete", 1)
+	st.AssertExpectations(t)
+}
+
+func TestScrubService_Run_RecordCreatedDuringList(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	st := new(mockStorage)
+	svc := service.NewScrubService(repo, nil, st, nil)
+
+	// Запись появилась между чтениями ссылок: её файл не лишний
+	repo.On("StoredRefs", ctx).Return(nil, nil).Once()
+	repo.On("StoredRefs", ctx).Return([]model.StoredRef{
+		{UserID: "user1", ID: "file1", StoragePath: "user1/file1.bin"},
+	}, nil).Once()
+	st.On("List", ctx).Return([]storage.StoredObject{
+		{Path: "user1/file1.bin", ModTime: time.Now().Add(-2 * time.Hour)},
+	}, nil)
+
+	report, err := svc.Run(ctx, model.ScrubOptions{Fix: true})
+	require.NoError(t, err)
+	assert.True(t, report.Clean())
+	st.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+}
+
+func TestScrubService_Run_Rehash(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	st := new(mockStorage)
+	svc := service.NewScrubService(repo, nil, st, nil)
+
+	repo.On("StoredRefs", ctx).Return([]model.StoredRef{
+		{UserID: "user1", ID: "good", StoragePath: "user1/good.bin", Checksum: testChecksum},
+		{UserID: "user1", ID: "bad", StoragePath: "user1/bad.bin", Checksum: testChecksum},
+		{UserID: "user1", ID: "legacy", StoragePath: "user1/legacy.bin"},
+	}, nil)
+	st.On("List", ctx).Return([]storage.StoredObject{
+		{Path: "user1/good.bin"}, {Path: "user1/bad.bin"}, {Path: "user1/legacy.bin"},
+	}, nil)
+	st.On("Load", ctx, "user1/good.bin").Return(nopSeekCloser{bytes.NewReader([]byte("test"))}, nil)
+	st.On("Load", ctx, "user1/bad.bin").Return(nopSeekCloser{bytes.NewReader([]byte("tesT"))}, nil)
+
+	report, err := svc.Run(ctx, model.ScrubOptions{Rehash: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, report.FilesRehashed)
+	require.Len(t, report.ChecksumMismatches, 1)
+	assert.Equal(t, "bad", report.ChecksumMismatches[0].ID)
+	st.AssertNotCalled(t, "Load", ctx, "user1/legacy.bin")
+}
+
+func TestScrubService_Run_Errors(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	st := new(mockStorage)
+	svc := service.NewScrubService(repo, nil, st, nil)
+
+	repo.On("StoredRefs", ctx).Return(nil, errors.New("db down")).Once()
+	_, err := svc.Run(ctx, model.ScrubOptions{})
+	assert.ErrorContains(t, err, "db down")
+	assert.Nil(t, svc.LastReport())
+
+	repo.On("StoredRefs", ctx).Return(nil, nil)
+	st.On("List", ctx).Return(nil, errors.New("list failed"))
+	_, err = svc.Run(ctx, model.ScrubOptions{})
+	assert.ErrorContains(t, err, "list failed")
+}
+
+func TestScrubService_Run_InProgress(t *testing.T) {
+	ctx := context.Background()
+	repo := new(mockRepo)
+	st := new(mockStorage)
+	svc := service.NewScrubService(repo, nil, st, nil)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	repo.On("StoredRefs", ctx).Return(nil, nil).Once().Run(func(mock.Arguments) {
+		close(started)
+		<-release
+	})
+	repo.On("StoredRefs", ctx).Return(nil, nil)
+	st.On("List", ctx).Return(nil, nil)
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := svc.Run(ctx, model.ScrubOptions{})
+		done <- err
+	}()
+	<-started
+
+	_, err := svc.Run(ctx, model.ScrubOptions{})
+	assert.ErrorIs(t, err, model.ErrScrubInProgress)
+
+	close(release)
+	assert.NoError(t, <-done)
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/domain/service"
+)
+
+// SyncServiceImpl реализует интерфейс service.SyncService
+type SyncServiceImpl struct {
+	repo repository.ChangeRepository
+	feed repository.ChangeFeed
+}
+
+// NewSyncService создаёт новый сервис с указанным репозиторием журнала изменений
+// и источником уведомлений об изменениях
+func NewSyncService(repo repository.ChangeRepository, feed repository.ChangeFeed) service.SyncService {
+	return &SyncServiceImpl{repo: repo, feed: feed}
+}
+
+// ListChanges возвращает порцию изменений пользователя после курсора
+func (s *SyncServiceImpl) ListChanges(ctx context.Context, userID string, cursor int64, limit int) (model.ChangeBatch, error) {
+	if userID == "" {
+		return model.ChangeBatch{}, errors.New("userID is required")
+	}
+	if cursor < 0 {
+		return model.ChangeBatch{}, errors.New("cursor must not be negative")
+	}
+	if limit <= 0 {
+		limit = model.DefaultChangesLimit
+	}
+	if limit > model.MaxChangesLimit {
+		limit = model.MaxChangesLimit
+	}
+
+	// Запрашиваем на одно изменение больше, чтобы узнать, остались ли ещё
+	changes, err := s.repo.ListChanges(ctx, userID, cursor, limit+1)
+	if err != nil {
+		return model.ChangeBatch{}, err
+	}
+
+	batch := model.ChangeBatch{Cursor: cursor}
+	if len(changes) > limit {
+		changes = changes[:limit]
+		batch.HasMore = true
+	}
+	if len(changes) > 0 {
+		batch.Cursor = changes[len(changes)-1].Seq
+	}
+	batch.Changes = changes
+	return batch, nil
+}
+
+// WatchChanges подписывает на уведомления об изменениях записей пользователя
+func (s *SyncServiceImpl) WatchChanges(ctx context.Context, userID string) (<-chan model.ChangeEvent, error) {
+	if userID == "" {
+		return nil, errors.New("userID is required")
+	}
+	if s.feed == nil {
+		return nil, errors.New("change notifications are not supported by storage")
+	}
+	return s.feed.Subscribe(ctx, userID)
+}
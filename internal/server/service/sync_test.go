@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/server/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// Мок репозитория журнала изменений
+type mockChangeRepo struct {
+	mock.Mock
+}
+
+func (m *mockChangeRepo) ListChanges(ctx context.Context, userID string, since int64, limit int) ([]model.Change, error) {
+	args := m.Called(ctx, userID, since, limit)
+	result := args.Get(0)
+	if result == nil {
+		return nil, args.Error(1)
+	}
+	return result.([]model.Change), args.Error(1)
+}
+
+// Мок источника уведомлений об изменениях
+type mockChangeFeed struct {
+	ch     chan model.ChangeEvent
+	userID string
+}
+
+func (m *mockChangeFeed) Subscribe(_ context.Context, userID string) (<-chan model.ChangeEvent, error) {
+	m.userID = userID
+	return m.ch, nil
+}
+
+func TestSyncService_ListChanges(t *testing.T) {
+	repo := new(mockChangeRepo)
+	svc := service.NewSyncService(repo, nil)
+
+	changes := []model.Change{
+		{Seq: 5, Type: model.ItemTypeCredential, ItemID: "c1"},
+		{Seq: 7, Type: model.ItemTypeTextData, ItemID: "t1", Deleted: true},
+		{Seq: 9, Type: model.ItemTypeBankCard, ItemID: "b1"},
+	}
+	// Сервис запрашивает limit+1, чтобы определить наличие следующей порции
+	repo.On("ListChanges", mock.Anything, "user1", int64(4), 3).Return(changes, nil)
+
+	batch, err := svc.ListChanges(context.Background(), "user1", 4, 2)
+	require.NoError(t, err)
+	assert.Len(t, batch.Changes, 2)
+	assert.Equal(t, int64(7), batch.Cursor)
+	assert.True(t, batch.HasMore)
+	repo.AssertExpectations(t)
+}
+
+func TestSyncService_ListChanges_NoChanges(t *testing.T) {
+	repo := new(mockChangeRepo)
+	svc := service.NewSyncService(repo, nil)
+
+	repo.On("ListChanges", mock.Anything, "user1", int64(42), model.DefaultChangesLimit+1).Return(nil, nil)
+
+	batch, err := svc.ListChanges(context.Background(), "user1", 42, 0)
+	require.NoError(t, err)
+	assert.Empty(t, batch.Changes)
+	assert.Equal(t, int64(42), batch.Cursor, "cursor should stay the same when nothing changed")
+	assert.False(t, batch.HasMore)
+}
+
+func TestSyncService_ListChanges_LimitCapped(t *testing.T) {
+	repo := new(mockChangeRepo)
+	svc := service.NewSyncService(repo, nil)
+
+	repo.On("ListChanges", mock.Anything, "user1", int64(0), model.MaxChangesLimit+1).Return(nil, nil)
+
+	_, err := svc.ListChanges(context.Background(), "user1", 0, 1_000_000)
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
+
+func TestSyncService_ListChanges_Validation(t *testing.T) {
+	svc := service.NewSyncService(new(mockChangeRepo), nil)
+
+	_, err := svc.ListChanges(context.Background(), "", 0, 0)
+	assert.Error(t, err)
+
+	_, err = svc.ListChanges(context.Background(), "user1", -1, 0)
+	assert.Error(t, err)
+}
+
+func TestSyncService_ListChanges_RepoError(t *testing.T) {
+	repo := new(mockChangeRepo)
+	svc := service.NewSyncService(repo, nil)
+
+	repo.On("ListChanges", mock.Anything, "user1", int64(0), model.DefaultChangesLimit+1).Return(nil, errors.New("db error"))
+
+	_, err := svc.ListChanges(context.Background(), "user1", 0, 0)
+	assert.Error(t, err)
+}
+
+func TestSyncService_WatchChanges(t *testing.T) {
+	feed := &mockChangeFeed{ch: make(chan model.ChangeEvent, 1)}
+	svc := service.NewSyncService(new(mockChangeRepo), feed)
+
+	feed.ch <- model.ChangeEvent{UserID: "user1", Seq: 3, ItemID: "c1"}
+
+	events, err := svc.WatchChanges(context.Background(), "user1")
+	require.NoError(t, err)
+	assert.Equal(t, "user1", feed.userID)
+	assert.Equal(t, int64(3), (<-events).Seq)
+}
+
+func TestSyncService_WatchChanges_Validation(t *testing.T) {
+	svc := service.NewSyncService(new(mockChangeRepo), &mockChangeFeed{})
+	_, err := svc.WatchChanges(context.Background(), "")
+	assert.Error(t, err)
+
+	// Хранилище без уведомлений
+	svc = service.NewSyncService(new(mockChangeRepo), nil)
+	_, err = svc.WatchChanges(context.Background(), "user1")
+	assert.Error(t, err)
+}
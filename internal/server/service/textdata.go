@@ -13,12 +13,14 @@ import (
 
 // TextDataServiceImpl реализует интерфейс TextDataService
 type TextDataServiceImpl struct {
-	repo repository.TextDataRepository
+	repo  repository.TextDataRepository
+	quota *QuotaGuard
 }
 
-// NewTextDataService создаёт новый сервис с указанным репозиторием
-func NewTextDataService(repo repository.TextDataRepository) service.TextDataService {
-	return &TextDataServiceImpl{repo: repo}
+// NewTextDataService создаёт новый сервис с указанным репозиторием.
+// quota ограничивает число записей пользователя (nil — без ограничений).
+func NewTextDataService(repo repository.TextDataRepository, quota *QuotaGuard) service.TextDataService {
+	return &TextDataServiceImpl{repo: repo, quota: quota}
 }
 
 // Create создаёт новую запись TextData с генерацией UUID и датой создания
@@ -37,6 +39,9 @@ func (s *TextDataServiceImpl) Create(ctx context.Context, data *model.TextData)
 	if len(data.Content) == 0 {
 		return errors.New("content is required")
 	}
+	if err := s.quota.CheckItems(ctx, data.UserID, 1); err != nil {
+		return err
+	}
 
 	return s.repo.Create(ctx, data)
 }
@@ -52,12 +57,16 @@ func (s *TextDataServiceImpl) GetByID(ctx context.Context, userID, id string) (*
 	return s.repo.GetByID(ctx, userID, id)
 }
 
-// ListTitles возвращает список заголовков всех записей пользователя (ID + Title)
-func (s *TextDataServiceImpl) ListTitles(ctx context.Context, userID string) ([]*model.TextData, error) {
+// ListTitles возвращает страницу заголовков записей пользователя (ID, Title, Folder, Tags),
+// удовлетворяющих фильтру
+func (s *TextDataServiceImpl) ListTitles(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
 	if userID == "" {
-		return nil, errors.New("userID is required")
+		return nil, "", errors.New("userID is required")
+	}
+	if err := page.Validate(); err != nil {
+		return nil, "", err
 	}
-	return s.repo.ListTitles(ctx, userID)
+	return s.repo.ListTitles(ctx, userID, filter, page.Normalize())
 }
 
 // Update обновляет существующую запись TextData с обновлением времени
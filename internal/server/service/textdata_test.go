@@ -33,13 +33,13 @@ func (m *mockTextDataRepo) GetByID(ctx context.Context, userID, id string) (*mod
 	return data.(*model.TextData), args.Error(1)
 }
 
-func (m *mockTextDataRepo) ListTitles(ctx context.Context, userID string) ([]*model.TextData, error) {
-	args := m.Called(ctx, userID)
+func (m *mockTextDataRepo) ListTitles(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	args := m.Called(ctx, userID, filter, page)
 	result := args.Get(0)
 	if result == nil {
-		return nil, args.Error(1)
+		return nil, args.String(1), args.Error(2)
 	}
-	return result.([]*model.TextData), args.Error(1)
+	return result.([]*model.TextData), args.String(1), args.Error(2)
 }
 
 func (m *mockTextDataRepo) Update(ctx context.Context, data *model.TextData) error {
@@ -56,7 +56,7 @@ func (m *mockTextDataRepo) Delete(ctx context.Context, userID, id string) error
 
 func TestTextDataService_Create(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	data := &model.TextData{
 		UserID:  uuid.NewString(),
@@ -75,7 +75,7 @@ func TestTextDataService_Create(t *testing.T) {
 
 func TestTextDataService_Create_ValidationError(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	data := &model.TextData{UserID: uuid.NewString()}
 
@@ -86,7 +86,7 @@ func TestTextDataService_Create_ValidationError(t *testing.T) {
 
 func TestTextDataService_GetByID(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	id := uuid.NewString()
 	userID := uuid.NewString()
@@ -102,7 +102,7 @@ func TestTextDataService_GetByID(t *testing.T) {
 
 func TestTextDataService_GetByID_Error(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	mockRepo.On("GetByID", mock.Anything, "user", "unknown").Return(nil, errors.New("not found"))
 
@@ -113,7 +113,7 @@ func TestTextDataService_GetByID_Error(t *testing.T) {
 
 func TestTextDataService_ListTitles(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	userID := uuid.NewString()
 	dataList := []*model.TextData{
@@ -121,9 +121,9 @@ func TestTextDataService_ListTitles(t *testing.T) {
 		{ID: uuid.NewString(), Title: "Two"},
 	}
 
-	mockRepo.On("ListTitles", mock.Anything, userID).Return(dataList, nil)
+	mockRepo.On("ListTitles", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}.Normalize()).Return(dataList, "", nil)
 
-	result, err := svc.ListTitles(context.Background(), userID)
+	result, _, err := svc.ListTitles(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	assert.Equal(t, "One", result[0].Title)
@@ -131,7 +131,7 @@ func TestTextDataService_ListTitles(t *testing.T) {
 
 func TestTextDataService_Update(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	data := &model.TextData{ID: uuid.NewString(), UserID: uuid.NewString()}
 	mockRepo.On("GetByID", mock.Anything, data.UserID, data.ID).Return(data, nil)
@@ -144,7 +144,7 @@ func TestTextDataService_Update(t *testing.T) {
 
 func TestTextDataService_Update_NotFound(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	data := &model.TextData{ID: uuid.NewString(), UserID: uuid.NewString()}
 	mockRepo.On("GetByID", mock.Anything, data.UserID, data.ID).Return(nil, nil)
@@ -157,7 +157,7 @@ func TestTextDataService_Update_NotFound(t *testing.T) {
 
 func TestTextDataService_Delete(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	id := uuid.NewString()
 	userID := uuid.NewString()
@@ -172,7 +172,7 @@ func TestTextDataService_Delete(t *testing.T) {
 
 func TestTextDataService_Delete_NotFound(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	id := uuid.NewString()
 	userID := uuid.NewString()
@@ -186,28 +186,28 @@ func TestTextDataService_Delete_NotFound(t *testing.T) {
 
 func TestTextDataService_ListTitles_Empty(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	userID := uuid.NewString()
 
 	// Возвращаем пустой слайс
-	mockRepo.On("ListTitles", mock.Anything, userID).Return([]*model.TextData{}, nil)
+	mockRepo.On("ListTitles", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}.Normalize()).Return([]*model.TextData{}, "", nil)
 
-	result, err := svc.ListTitles(context.Background(), userID)
+	result, _, err := svc.ListTitles(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Empty(t, result) // Должен быть пустой
 }
 
 func TestTextDataService_ListTitles_Error(t *testing.T) {
 	mockRepo := new(mockTextDataRepo)
-	svc := service.NewTextDataService(mockRepo)
+	svc := service.NewTextDataService(mockRepo, nil)
 
 	userID := uuid.NewString()
 
 	// Возвращаем ошибку из репозитория
-	mockRepo.On("ListTitles", mock.Anything, userID).Return(nil, errors.New("db error"))
+	mockRepo.On("ListTitles", mock.Anything, userID, model.ListFilter{}, model.PageRequest{}.Normalize()).Return(nil, "", errors.New("db error"))
 
-	result, err := svc.ListTitles(context.Background(), userID)
+	result, _, err := svc.ListTitles(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.Error(t, err)
 	assert.Nil(t, result)
 	assert.Contains(t, err.Error(), "db error")
@@ -1,11 +1,15 @@
 package storage
 
 import (
+	"fmt"
+	"os"
 	"time"
 
 	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
 	"github.com/ryabkov82/gophkeeper/internal/server/config"
 	"github.com/ryabkov82/gophkeeper/internal/server/storage/filesystem"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/memory"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/s3"
 )
 
 // binaryDataFactory — фабрика для работы с BinaryDataStorage.
@@ -14,11 +18,44 @@ type binaryDataFactory struct {
 }
 
 // NewBinaryDataFactory создаёт фабрику бинарных данных.
-// В зависимости от конфигурации можно вернуть локальное или облачное хранилище.
-func NewBinaryDataFactory(cfg *config.Config) storage.BinaryDataStorageFactory {
-	// пример: всегда возвращаем локальное хранилище
-	return &binaryDataFactory{
-		storage: filesystem.NewBinaryDataStorage(cfg.BinaryDataStorePath, 1*time.Hour, 24*time.Hour),
+// В зависимости от cfg.BinaryDataBackend возвращает локальное хранилище,
+// S3-совместимое или хранилище в памяти процесса; для S3 локальная
+// директория BinaryDataStorePath используется для незавершённых загрузок
+// с докачкой, хранилище в памяти к диску не обращается.
+//
+// Если заданы ключи шифрования (cfg.StorageKeys, cfg.StorageKeyFile),
+// локальное хранилище шифрует файлы мастер-ключом сервера.
+func NewBinaryDataFactory(cfg *config.Config) (storage.BinaryDataStorageFactory, error) {
+	if cfg.BinaryDataBackend == config.BinaryBackendMemory {
+		return &binaryDataFactory{storage: memory.NewBlobStorage()}, nil
+	}
+
+	// Ключи шифрования допускаются только для хранилища fs (см. config.Load)
+	keys, err := storageKeyring(cfg)
+	if err != nil {
+		return nil, err
+	}
+	local := filesystem.NewEncryptedBinaryDataStorage(cfg.BinaryDataStorePath, 1*time.Hour, 24*time.Hour, keys)
+
+	switch cfg.BinaryDataBackend {
+	case "", config.BinaryBackendFS:
+		return &binaryDataFactory{storage: local}, nil
+	case config.BinaryBackendS3:
+		st, err := s3.NewBinaryDataStorage(s3.Config{
+			Endpoint:  cfg.S3Endpoint,
+			Region:    cfg.S3Region,
+			Bucket:    cfg.S3Bucket,
+			AccessKey: cfg.S3AccessKey,
+			SecretKey: cfg.S3SecretKey,
+		}, local)
+		if err != nil {
+			local.Close()
+			return nil, err
+		}
+		return &binaryDataFactory{storage: st}, nil
+	default:
+		local.Close()
+		return nil, fmt.Errorf("unsupported binary data backend: %s", cfg.BinaryDataBackend)
 	}
 }
 
@@ -26,3 +63,27 @@ func NewBinaryDataFactory(cfg *config.Config) storage.BinaryDataStorageFactory {
 func (f *binaryDataFactory) BinaryData() storage.BinaryDataStorage {
 	return f.storage
 }
+
+// storageKeyring собирает мастер-ключи хранилища из cfg.StorageKeys и файла
+// cfg.StorageKeyFile. Если ключи не заданы, возвращает nil.
+func storageKeyring(cfg *config.Config) (*filesystem.Keyring, error) {
+	text := cfg.StorageKeys
+	if cfg.StorageKeyFile != "" {
+		data, err := os.ReadFile(cfg.StorageKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read storage key file: %w", err)
+		}
+		text += "\n" + string(data)
+	}
+	keys, err := filesystem.ParseKeys(text)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		if cfg.StorageKeyFile != "" {
+			return nil, fmt.Errorf("storage key file %s contains no keys", cfg.StorageKeyFile)
+		}
+		return nil, nil
+	}
+	return filesystem.NewKeyring(keys...)
+}
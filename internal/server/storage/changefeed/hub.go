@@ -0,0 +1,128 @@
+// Package changefeed содержит внутрипроцессный брокер уведомлений об изменениях записей.
+//
+// Hub раздаёт опубликованные события подписчикам соответствующего пользователя.
+// Сам он не знает, откуда берутся события: их публикует конкретное хранилище
+// (например, слушатель LISTEN/NOTIFY в PostgreSQL).
+package changefeed
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// subscriberBuffer — размер буфера канала подписчика.
+//
+// Если подписчик не успевает читать и буфер заполнен, новое событие
+// отбрасывается: в буфере уже есть непрочитанное уведомление, по которому
+// клиент всё равно перечитает изменения.
+const subscriberBuffer = 16
+
+// Hub — брокер уведомлений об изменениях, разделённый по пользователям.
+// Безопасен для конкурентного использования.
+type Hub struct {
+	mu     sync.Mutex
+	subs   map[string]map[*subscriber]struct{}
+	closed bool
+	done   chan struct{} // закрывается в Close
+}
+
+type subscriber struct {
+	ch chan model.ChangeEvent
+}
+
+// NewHub создаёт пустой брокер уведомлений.
+func NewHub() *Hub {
+	return &Hub{
+		subs: make(map[string]map[*subscriber]struct{}),
+		done: make(chan struct{}),
+	}
+}
+
+// Subscribe регистрирует подписчика на события пользователя userID.
+// Подписка снимается, а канал закрывается после отмены ctx или вызова Close.
+func (h *Hub) Subscribe(ctx context.Context, userID string) (<-chan model.ChangeEvent, error) {
+	sub := &subscriber{ch: make(chan model.ChangeEvent, subscriberBuffer)}
+
+	h.mu.Lock()
+	if h.closed {
+		h.mu.Unlock()
+		close(sub.ch)
+		return sub.ch, nil
+	}
+	if h.subs[userID] == nil {
+		h.subs[userID] = make(map[*subscriber]struct{})
+	}
+	h.subs[userID][sub] = struct{}{}
+	h.mu.Unlock()
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			h.unsubscribe(userID, sub)
+		case <-h.done:
+		}
+	}()
+
+	return sub.ch, nil
+}
+
+// Publish рассылает событие подписчикам владельца записи.
+func (h *Hub) Publish(ev model.ChangeEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subs[ev.UserID] {
+		select {
+		case sub.ch <- ev:
+		default:
+			// Буфер заполнен — подписчик уже получит уведомление из буфера
+		}
+	}
+}
+
+// Subscribers возвращает количество активных подписок пользователя.
+func (h *Hub) Subscribers(userID string) int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return len(h.subs[userID])
+}
+
+// Close снимает все подписки и закрывает их каналы.
+// Последующие подписки получают сразу закрытый канал.
+func (h *Hub) Close() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return
+	}
+	h.closed = true
+	close(h.done)
+	for _, subs := range h.subs {
+		for sub := range subs {
+			close(sub.ch)
+		}
+	}
+	h.subs = nil
+}
+
+// unsubscribe снимает подписку и закрывает её канал, если это не сделал Close.
+func (h *Hub) unsubscribe(userID string, sub *subscriber) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	subs, ok := h.subs[userID]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subs, userID)
+	}
+	close(sub.ch)
+}
@@ -0,0 +1,88 @@
+package changefeed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_PublishToOwnerOnly(t *testing.T) {
+	h := NewHub()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch1, err := h.Subscribe(ctx, "user1")
+	require.NoError(t, err)
+	ch2, err := h.Subscribe(ctx, "user2")
+	require.NoError(t, err)
+
+	h.Publish(model.ChangeEvent{UserID: "user1", Seq: 5, Type: model.ItemTypeCredential, ItemID: "c1"})
+
+	select {
+	case ev := <-ch1:
+		assert.Equal(t, int64(5), ev.Seq)
+		assert.Equal(t, "c1", ev.ItemID)
+	case <-time.After(time.Second):
+		t.Fatal("event was not delivered")
+	}
+
+	select {
+	case ev := <-ch2:
+		t.Fatalf("unexpected event for another user: %+v", ev)
+	default:
+	}
+}
+
+func TestHub_UnsubscribeOnCancel(t *testing.T) {
+	h := NewHub()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ch, err := h.Subscribe(ctx, "user1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, h.Subscribers("user1"))
+
+	cancel()
+
+	select {
+	case _, ok := <-ch:
+		assert.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("channel was not closed")
+	}
+	assert.Equal(t, 0, h.Subscribers("user1"))
+}
+
+func TestHub_SlowSubscriberDoesNotBlock(t *testing.T) {
+	h := NewHub()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ch, err := h.Subscribe(ctx, "user1")
+	require.NoError(t, err)
+
+	for i := 0; i < subscriberBuffer*2; i++ {
+		h.Publish(model.ChangeEvent{UserID: "user1", Seq: int64(i + 1)})
+	}
+	assert.Len(t, ch, subscriberBuffer)
+}
+
+func TestHub_Close(t *testing.T) {
+	h := NewHub()
+	ch, err := h.Subscribe(context.Background(), "user1")
+	require.NoError(t, err)
+
+	h.Close()
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	// Повторное закрытие и подписка после закрытия безопасны
+	h.Close()
+	ch, err = h.Subscribe(context.Background(), "user1")
+	require.NoError(t, err)
+	_, ok = <-ch
+	assert.False(t, ok)
+}
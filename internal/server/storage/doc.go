@@ -2,7 +2,7 @@
 // и хранилищ бинарных данных для различных типов хранилищ данных в GophKeeper.
 //
 // Основная задача пакета — объединить конкретные реализации репозиториев
-// (PostgreSQL, локальная память и т.п.) и бинарных хранилищ (локальная ФС, S3)
+// (PostgreSQL, SQLite, память и т.п.) и бинарных хранилищ (локальная ФС, S3, память)
 // за едиными интерфейсами StorageFactory и BinaryDataStorageFactory, чтобы
 // верхние слои приложения могли работать с данными независимо от конкретного хранилища.
 //
@@ -10,11 +10,17 @@
 //
 //   - postgresFactory — фабрика репозиториев для PostgreSQL.
 //   - NewPostgresFactory — создаёт экземпляр postgresFactory с подключением к БД.
+//   - sqliteFactory — фабрика репозиториев для SQLite.
+//   - NewSQLiteFactory — создаёт экземпляр sqliteFactory с подключением к БД.
+//   - memoryFactory — фабрика репозиториев в памяти процесса (демонстрационный режим).
+//   - NewMemoryFactory — создаёт экземпляр memoryFactory поверх memory.Store.
 //   - NewStorageFactory — универсальная функция для создания фабрики репозиториев
 //     для заданного драйвера.
-//   - binaryDataFactory — фабрика для работы с BinaryDataStorage (локальная ФС, S3 и т.п.).
+//   - InitDB — открывает базу данных заданного драйвера и применяет миграции.
+//   - binaryDataFactory — фабрика для работы с BinaryDataStorage (локальная ФС, S3 или память);
+//     локальное хранилище шифрует файлы мастер-ключом сервера, если ключи заданы.
 //   - NewBinaryDataFactory — создаёт конкретную реализацию BinaryDataStorageFactory
-//     в зависимости от конфигурации.
+//     в зависимости от конфигурации (BinaryDataBackend).
 //
 // Пример использования:
 //
@@ -28,7 +34,10 @@
 //	    log.Fatal(err)
 //	}
 //
-//	binaryFactory := storage.NewBinaryDataFactory(cfg)
+//	binaryFactory, err := storage.NewBinaryDataFactory(cfg)
+//	if err != nil {
+//	    log.Fatal(err)
+//	}
 //	binaryStorage := binaryFactory.BinaryData()
 //
 //	userRepo := repoFactory.User()
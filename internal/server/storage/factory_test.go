@@ -2,8 +2,12 @@ package storage
 
 import (
 	"database/sql"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/ryabkov82/gophkeeper/internal/server/config"
 	"github.com/stretchr/testify/require"
 )
 
@@ -14,6 +18,8 @@ func TestNewPostgresFactory(t *testing.T) {
 	require.NotNil(t, f.Credential())
 	require.NotNil(t, f.BankCard())
 	require.NotNil(t, f.TextData())
+	require.NotNil(t, f.Chunk())
+	require.NotNil(t, f.BinaryVersion())
 }
 
 func TestNewStorageFactory(t *testing.T) {
@@ -21,7 +27,91 @@ func TestNewStorageFactory(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, f)
 
+	f, err = NewStorageFactory("sqlite", &sql.DB{})
+	require.NoError(t, err)
+	require.NotNil(t, f)
+
+	f, err = NewStorageFactory("memory", nil)
+	require.NoError(t, err)
+	require.NotNil(t, f)
+	require.NoError(t, f.Close())
+
 	f, err = NewStorageFactory("unknown", &sql.DB{})
 	require.Error(t, err)
 	require.Nil(t, f)
 }
+
+func TestInitDB(t *testing.T) {
+	db, err := InitDB("sqlite", filepath.Join(t.TempDir(), "keeper.db"))
+	require.NoError(t, err)
+	f, err := NewStorageFactory("sqlite", db)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	_, err = InitDB("unknown", "")
+	require.Error(t, err)
+}
+
+func TestNewBinaryDataFactory(t *testing.T) {
+	cfg := &config.Config{BinaryDataStorePath: t.TempDir(), BinaryDataBackend: config.BinaryBackendFS}
+	f, err := NewBinaryDataFactory(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, f.BinaryData())
+	f.BinaryData().Close()
+
+	cfg.BinaryDataBackend = config.BinaryBackendS3
+	cfg.S3Endpoint = "http://localhost:9000"
+	cfg.S3Bucket = "gophkeeper"
+	f, err = NewBinaryDataFactory(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, f.BinaryData())
+	f.BinaryData().Close()
+
+	cfg.S3Bucket = ""
+	_, err = NewBinaryDataFactory(cfg)
+	require.Error(t, err)
+
+	cfg.BinaryDataBackend = config.BinaryBackendMemory
+	cfg.BinaryDataStorePath = filepath.Join(t.TempDir(), "absent")
+	f, err = NewBinaryDataFactory(cfg)
+	require.NoError(t, err)
+	require.NotNil(t, f.BinaryData())
+	f.BinaryData().Close()
+	require.NoDirExists(t, cfg.BinaryDataStorePath, "хранилище в памяти не обращается к диску")
+
+	cfg.BinaryDataBackend = "unknown"
+	_, err = NewBinaryDataFactory(cfg)
+	require.Error(t, err)
+}
+
+func TestNewBinaryDataFactory_StorageKeys(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "keys")
+	require.NoError(t, os.WriteFile(keyFile, []byte("# active\n"+strings.Repeat("ab", 32)+"\n"), 0o600))
+
+	cfg := &config.Config{BinaryDataStorePath: dir, BinaryDataBackend: config.BinaryBackendFS, StorageKeyFile: keyFile}
+	f, err := NewBinaryDataFactory(cfg)
+	require.NoError(t, err)
+	f.BinaryData().Close()
+
+	kr, err := storageKeyring(&config.Config{StorageKeys: strings.Repeat("cd", 32), StorageKeyFile: keyFile})
+	require.NoError(t, err)
+	require.NotNil(t, kr)
+
+	kr, err = storageKeyring(&config.Config{})
+	require.NoError(t, err)
+	require.Nil(t, kr)
+
+	for _, cfg := range []*config.Config{
+		{StorageKeys: "abcd"},                                            // не 32 байта
+		{StorageKeyFile: filepath.Join(dir, "missing")},                  // нет файла
+		{StorageKeys: strings.Repeat("ab", 32), StorageKeyFile: keyFile}, // повтор ключа
+	} {
+		_, err := storageKeyring(cfg)
+		require.Error(t, err)
+	}
+
+	require.NoError(t, os.WriteFile(keyFile, []byte("# empty\n"), 0o600))
+	_, err = NewBinaryDataFactory(cfg)
+	require.Error(t, err)
+}
@@ -1,42 +1,75 @@
 // Package filesystem содержит реализацию BinaryDataStorage,
 // которая сохраняет бинарные данные в локальной файловой системе.
+//
+// Хранилище, созданное NewEncryptedBinaryDataStorage, дополнительно шифрует
+// файлы мастер-ключом сервера (формат описан в encryption.go). Файлы,
+// сохранённые без шифрования, читаются как есть, пока Reencrypt не
+// перешифрует их активным ключом.
+//
+// Временные файлы сессий загрузки с докачкой мастер-ключом не шифруются:
+// клиент продолжает загрузку с произвольного смещения, а файл сессии
+// обрезается по нему, что несовместимо с покадровым шифрованием. Сессия
+// шифруется целиком в CommitUpload, а до этого на диске лежат только
+// зашифрованные клиентом данные.
 package filesystem
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
 )
 
+// uploadFilePrefix — префикс временных файлов сессий загрузки с докачкой.
+// Файлы сессий имеют расширение .tmp, поэтому брошенные сессии удаляет
+// тот же фоновый очиститель, что и прочие временные файлы.
+const uploadFilePrefix = "upload-"
+
 // binaryDataStorage реализует интерфейс storage.BinaryDataStorage
 // и сохраняет данные в локальной файловой системе.
 type binaryDataStorage struct {
 	basePath string
+	keys     *Keyring // nil — файлы не шифруются
 	stopCh   chan struct{}
+	uploads  uploadLocks // блокировки сессий загрузки
 }
 
 // NewBinaryDataStorage создаёт локальное хранилище с фоновым удалением
 // старых .tmp файлов. interval — частота очистки, maxAge — файлы старше
 // maxAge удаляются.
 func NewBinaryDataStorage(basePath string, interval, maxAge time.Duration) storage.BinaryDataStorage {
+	return NewEncryptedBinaryDataStorage(basePath, interval, maxAge, nil)
+}
+
+// NewEncryptedBinaryDataStorage создаёт локальное хранилище, которое
+// шифрует сохраняемые файлы активным ключом набора keys и прозрачно
+// расшифровывает их при Load (nil — без шифрования). Параметры interval и
+// maxAge — как у NewBinaryDataStorage.
+func NewEncryptedBinaryDataStorage(basePath string, interval, maxAge time.Duration, keys *Keyring) storage.BinaryDataStorage {
 	fs := &binaryDataStorage{
 		basePath: basePath,
+		keys:     keys,
 		stopCh:   make(chan struct{}),
 	}
 	StartTempFileCleaner(fs.basePath, interval, maxAge, fs.stopCh)
 	return fs
 }
 
+var _ storage.Reencryptor = (*binaryDataStorage)(nil)
+
 // Save безопасно сохраняет бинарные данные в локальном хранилище и возвращает размер файла.
 // Данные сначала пишутся во временный файл (*.tmp). Если запись завершилась успешно,
 // файл переименовывается в финальное имя. В случае ошибки временный файл удаляется.
+// Если заданы ключи хранилища, файл шифруется, а возвращается размер
+// исходных данных.
 func (fs *binaryDataStorage) Save(ctx context.Context, userID string, r io.Reader) (storagePath string, size int64, err error) {
 	// Путь к каталогу пользователя
 	userDir := filepath.Join(fs.basePath, userID)
@@ -65,7 +98,7 @@ func (fs *binaryDataStorage) Save(ctx context.Context, userID string, r io.Reade
 	}()
 
 	// Копируем данные в временный файл и получаем размер
-	size, err = io.Copy(tmpFile, r)
+	size, err = fs.writeBlob(tmpFile, r)
 	if err != nil {
 		return "", 0, fmt.Errorf("failed to write data: %w", err)
 	}
@@ -85,8 +118,29 @@ func (fs *binaryDataStorage) Save(ctx context.Context, userID string, r io.Reade
 	return storagePath, size, nil
 }
 
+// writeBlob копирует r в w, шифруя данные, если заданы ключи хранилища.
+// Возвращает размер исходных данных.
+func (fs *binaryDataStorage) writeBlob(w io.Writer, r io.Reader) (int64, error) {
+	if fs.keys == nil {
+		return io.Copy(w, r)
+	}
+	bw, err := fs.keys.newBlobWriter(w)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := io.Copy(bw, r); err != nil {
+		return 0, err
+	}
+	if err := bw.Close(); err != nil {
+		return 0, err
+	}
+	return bw.size, nil
+}
+
 // Load открывает файл для чтения по относительному пути storagePath.
-func (fs *binaryDataStorage) Load(ctx context.Context, storagePath string) (io.ReadCloser, error) {
+// Возвращаемый поток поддерживает перемотку: это *os.File для файла без
+// шифрования или расшифровывающий поток для зашифрованного.
+func (fs *binaryDataStorage) Load(ctx context.Context, storagePath string) (io.ReadSeekCloser, error) {
 	filePath := filepath.Join(fs.basePath, storagePath)
 
 	f, err := os.Open(filePath)
@@ -94,7 +148,26 @@ func (fs *binaryDataStorage) Load(ctx context.Context, storagePath string) (io.R
 		return nil, fmt.Errorf("failed to open file: %w", err)
 	}
 
-	return f, nil
+	header, encrypted, err := readBlobHeader(f)
+	if err != nil || !encrypted {
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+		return f, nil
+	}
+
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to stat file: %w", err)
+	}
+	br, err := fs.keys.openBlob(f, header, fi.Size())
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("failed to open file: %w", err)
+	}
+	return br, nil
 }
 
 // Delete удаляет файл по относительному пути storagePath.
@@ -117,13 +190,288 @@ func (fs *binaryDataStorage) Delete(ctx context.Context, storagePath string) err
 	return nil
 }
 
+// UploadOffset возвращает размер временного файла сессии загрузки (0 — сессии нет).
+func (fs *binaryDataStorage) UploadOffset(ctx context.Context, userID, uploadID string) (int64, error) {
+	path, err := fs.uploadPath(userID, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	fi, err := os.Stat(path)
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to stat upload: %w", err)
+	}
+	return fi.Size(), nil
+}
+
+// UploadUsage возвращает суммарный размер временных файлов сессий загрузки
+// пользователя.
+func (fs *binaryDataStorage) UploadUsage(ctx context.Context, userID string) (int64, error) {
+	entries, err := os.ReadDir(filepath.Join(fs.basePath, userID))
+	if os.IsNotExist(err) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("failed to read user dir: %w", err)
+	}
+
+	var total int64
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasPrefix(name, uploadFilePrefix) || filepath.Ext(name) != ".tmp" {
+			continue
+		}
+		info, err := e.Info()
+		if os.IsNotExist(err) {
+			// Сессия завершена во время обхода
+			continue
+		}
+		if err != nil {
+			return 0, fmt.Errorf("failed to stat upload: %w", err)
+		}
+		total += info.Size()
+	}
+	return total, nil
+}
+
+// AppendUpload дописывает данные во временный файл сессии начиная с offset.
+// В отличие от Save, при ошибке чтения файл не удаляется: принятые байты
+// остаются для продолжения загрузки. Параллельные вызовы для одной сессии
+// выполняются по очереди.
+func (fs *binaryDataStorage) AppendUpload(ctx context.Context, userID, uploadID string, offset int64, r io.Reader) (int64, error) {
+	path, err := fs.uploadPath(userID, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	unlock := fs.uploads.lock(path)
+	defer unlock()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return 0, fmt.Errorf("failed to create user dir: %w", err)
+	}
+
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open upload: %w", err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return 0, fmt.Errorf("failed to stat upload: %w", err)
+	}
+	if offset < 0 || offset > fi.Size() {
+		return fi.Size(), storage.ErrUploadOffset
+	}
+
+	// Данные после offset клиент передаст заново
+	if err := f.Truncate(offset); err != nil {
+		return 0, fmt.Errorf("failed to truncate upload: %w", err)
+	}
+	if _, err := f.Seek(offset, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to seek upload: %w", err)
+	}
+
+	n, err := io.Copy(f, r)
+	size := offset + n
+	if err != nil {
+		return size, fmt.Errorf("failed to write data: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return size, fmt.Errorf("failed to close upload: %w", err)
+	}
+	return size, nil
+}
+
+// CommitUpload переименовывает временный файл сессии в финальный файл пользователя.
+// Если заданы ключи хранилища, сессия сохраняется зашифрованной (как при
+// Save) и удаляется.
+func (fs *binaryDataStorage) CommitUpload(ctx context.Context, userID, uploadID string) (storagePath string, size int64, err error) {
+	path, err := fs.uploadPath(userID, uploadID)
+	if err != nil {
+		return "", 0, err
+	}
+	// Сессия не фиксируется посреди дописывания
+	unlock := fs.uploads.lock(path)
+	defer unlock()
+	fi, err := os.Stat(path)
+	if err != nil {
+		return "", 0, fmt.Errorf("upload session not found: %w", err)
+	}
+
+	if fs.keys != nil {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", 0, fmt.Errorf("upload session not found: %w", err)
+		}
+		storagePath, size, err = fs.Save(ctx, userID, f)
+		f.Close()
+		if err != nil {
+			return "", 0, err
+		}
+		_ = os.Remove(path)
+		return storagePath, size, nil
+	}
+
+	fileName := uuid.New().String() + ".bin"
+	if err := os.Rename(path, filepath.Join(fs.basePath, userID, fileName)); err != nil {
+		return "", 0, fmt.Errorf("failed to finalize file: %w", err)
+	}
+	return filepath.Join(userID, fileName), fi.Size(), nil
+}
+
+// List обходит basePath и вызывает fn для каждого файла *.bin. Для
+// зашифрованных файлов сообщается размер исходных данных.
+func (fs *binaryDataStorage) List(ctx context.Context, fn func(obj storage.StoredObject) error) error {
+	err := filepath.WalkDir(fs.basePath, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".bin" {
+			return nil
+		}
+
+		info, err := d.Info()
+		if os.IsNotExist(err) {
+			// Файл удалён во время обхода
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(fs.basePath, path)
+		if err != nil {
+			return err
+		}
+		size := info.Size()
+		if fs.keys != nil && isBlob(path) {
+			size = blobPlainSize(size)
+		}
+		return fn(storage.StoredObject{Path: rel, Size: size, ModTime: info.ModTime()})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list files: %w", err)
+	}
+	return nil
+}
+
+// Reencrypt перешифровывает активным ключом файлы, зашифрованные прежними
+// ключами или сохранённые без шифрования. Новое содержимое пишется во
+// временный файл и заменяет прежнее переименованием, поэтому читатели,
+// открывшие файл раньше, дочитывают прежнее содержимое. Время изменения
+// файла сохраняется, чтобы не сбивать сверку хранилища.
+//
+// Файл, который не удалось перешифровать (например, повреждённый или
+// зашифрованный ключом, которого уже нет в конфигурации), остаётся как есть
+// и учитывается в res.Failed и res.Errors; остальные файлы обрабатываются.
+func (fs *binaryDataStorage) Reencrypt(ctx context.Context) (storage.ReencryptResult, error) {
+	var res storage.ReencryptResult
+	if fs.keys == nil {
+		return res, errors.New("no storage key is configured")
+	}
+	err := fs.List(ctx, func(obj storage.StoredObject) error {
+		res.Scanned++
+		done, err := fs.reencryptFile(ctx, obj)
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err != nil {
+			res.Failed++
+			res.Errors = append(res.Errors, fmt.Sprintf("%s: %v", obj.Path, err))
+			return nil
+		}
+		if done {
+			res.Reencrypted++
+		}
+		return nil
+	})
+	return res, err
+}
+
+// reencryptFile перешифровывает файл obj, если он зашифрован не активным
+// ключом. Файл, удалённый во время перешифрования, не восстанавливается.
+func (fs *binaryDataStorage) reencryptFile(ctx context.Context, obj storage.StoredObject) (bool, error) {
+	path := filepath.Join(fs.basePath, obj.Path)
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	header, encrypted, err := readBlobHeader(f)
+	f.Close()
+	if err != nil {
+		return false, err
+	}
+	if encrypted && fs.keys.isActive(header) {
+		return false, nil
+	}
+
+	src, err := fs.Load(ctx, obj.Path)
+	if err != nil {
+		return false, err
+	}
+	defer src.Close()
+
+	tmpPath := path + "." + uuid.New().String() + ".tmp"
+	tmp, err := os.Create(tmpPath)
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		tmp.Close()
+		_ = os.Remove(tmpPath)
+	}()
+	if _, err := fs.writeBlob(tmp, src); err != nil {
+		return false, err
+	}
+	if err := tmp.Close(); err != nil {
+		return false, err
+	}
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return false, nil
+	}
+	_ = os.Chtimes(tmpPath, obj.ModTime, obj.ModTime)
+	if err := os.Rename(tmpPath, path); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// isBlob сообщает, что файл path начинается с заголовка зашифрованного файла.
+func isBlob(path string) bool {
+	f, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer f.Close()
+	_, encrypted, _ := readBlobHeader(f)
+	return encrypted
+}
+
+// uploadPath возвращает путь временного файла сессии загрузки. Идентификатор
+// сессии задаёт клиент, поэтому допускается только UUID.
+func (fs *binaryDataStorage) uploadPath(userID, uploadID string) (string, error) {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return "", fmt.Errorf("invalid upload id: %w", err)
+	}
+	return filepath.Join(fs.basePath, userID, uploadFilePrefix+uploadID+".tmp"), nil
+}
+
 // Close останавливает фоновый очиститель.
 func (fs *binaryDataStorage) Close() {
 	close(fs.stopCh)
 }
 
 // StartTempFileCleaner запускает фоновую горутину, которая периодически
-// удаляет старые временные файлы (*.tmp) из basePath.
+// удаляет старые временные файлы (*.tmp) из basePath, в том числе файлы
+// брошенных сессий загрузки: время изменения такого файла обновляется при
+// каждой порции данных, поэтому активные сессии не удаляются.
 // interval — частота проверки (например, 1 час).
 // maxAge — файлы старше этой продолжительности будут удаляться.
 func StartTempFileCleaner(basePath string, interval, maxAge time.Duration, stopCh <-chan struct{}) {
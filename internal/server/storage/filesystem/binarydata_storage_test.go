@@ -3,12 +3,15 @@ package filesystem_test
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
 	"github.com/ryabkov82/gophkeeper/internal/server/storage/filesystem"
 	"github.com/stretchr/testify/assert"
 )
@@ -109,3 +112,197 @@ func TestStartTempFileCleaner(t *testing.T) {
 	_, err = os.Stat(newFile)
 	assert.NoError(t, err, "новый .tmp файл не должен быть удалён")
 }
+
+// errReader отдаёт данные, после чего возвращает ошибку (имитация обрыва потока)
+type errReader struct {
+	data []byte
+	err  error
+}
+
+func (r *errReader) Read(p []byte) (int, error) {
+	if len(r.data) == 0 {
+		return 0, r.err
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestBinaryDataStorage_ResumableUpload(t *testing.T) {
+	ctx := context.Background()
+	baseDir := t.TempDir()
+	fs := filesystem.NewBinaryDataStorage(baseDir, time.Hour, time.Hour)
+	defer fs.Close()
+
+	userID := "user123"
+	uploadID := uuid.NewString()
+
+	offset, err := fs.UploadOffset(ctx, userID, uploadID)
+	assert.NoError(t, err)
+	assert.Zero(t, offset, "новая сессия пуста")
+
+	// Первая попытка обрывается: принятые байты остаются в сессии
+	size, err := fs.AppendUpload(ctx, userID, uploadID, 0, &errReader{data: []byte("hello wo"), err: io.ErrUnexpectedEOF})
+	assert.Error(t, err)
+	assert.EqualValues(t, 8, size)
+
+	offset, err = fs.UploadOffset(ctx, userID, uploadID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 8, offset)
+
+	staged, err := fs.UploadUsage(ctx, userID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 8, staged, "незавершённая сессия занимает место")
+
+	// Смещение за пределами принятых данных
+	_, err = fs.AppendUpload(ctx, userID, uploadID, 9, bytes.NewReader([]byte("x")))
+	assert.ErrorIs(t, err, storage.ErrUploadOffset)
+
+	// Продолжаем чуть раньше принятого конца: хвост перезаписывается
+	size, err = fs.AppendUpload(ctx, userID, uploadID, 6, bytes.NewReader([]byte("world")))
+	assert.NoError(t, err)
+	assert.EqualValues(t, 11, size)
+
+	storagePath, size, err := fs.CommitUpload(ctx, userID, uploadID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 11, size)
+
+	content, err := os.ReadFile(filepath.Join(baseDir, storagePath))
+	assert.NoError(t, err)
+	assert.Equal(t, "hello world", string(content))
+
+	offset, err = fs.UploadOffset(ctx, userID, uploadID)
+	assert.NoError(t, err)
+	assert.Zero(t, offset, "после завершения сессия удаляется")
+}
+
+// gatedReader отдаёт data только после закрытия gate.
+type gatedReader struct {
+	gate    chan struct{}
+	started chan struct{}
+	data    []byte
+}
+
+func (r *gatedReader) Read(p []byte) (int, error) {
+	if r.started != nil {
+		close(r.started)
+		r.started = nil
+	}
+	<-r.gate
+	if len(r.data) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+func TestBinaryDataStorage_ConcurrentAppendSerialized(t *testing.T) {
+	ctx := context.Background()
+	fs := filesystem.NewBinaryDataStorage(t.TempDir(), time.Hour, time.Hour)
+	defer fs.Close()
+
+	userID, uploadID := "user123", uuid.NewString()
+
+	// Первый поток начал запись в сессию и ждёт данных клиента
+	first := &gatedReader{gate: make(chan struct{}), started: make(chan struct{}), data: []byte("first")}
+	started := first.started
+	firstDone := make(chan error, 1)
+	go func() {
+		_, err := fs.AppendUpload(ctx, userID, uploadID, 0, first)
+		firstDone <- err
+	}()
+	<-started
+
+	// Второй поток с той же сессией и фиксация ждут окончания первого
+	secondDone := make(chan error, 1)
+	go func() {
+		_, err := fs.AppendUpload(ctx, userID, uploadID, 0, bytes.NewReader([]byte("second")))
+		secondDone <- err
+	}()
+	select {
+	case <-secondDone:
+		t.Fatal("concurrent append to the same session was not serialized")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	close(first.gate)
+	assert.NoError(t, <-firstDone)
+	assert.NoError(t, <-secondDone)
+
+	storagePath, size, err := fs.CommitUpload(ctx, userID, uploadID)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 6, size)
+	rc, err := fs.Load(ctx, storagePath)
+	assert.NoError(t, err)
+	defer rc.Close()
+	got, _ := io.ReadAll(rc)
+	assert.Equal(t, "second", string(got), "данные потоков не перемешиваются")
+}
+
+func TestBinaryDataStorage_UploadInvalidID(t *testing.T) {
+	fs := filesystem.NewBinaryDataStorage(t.TempDir(), time.Hour, time.Hour)
+	defer fs.Close()
+
+	_, err := fs.AppendUpload(context.Background(), "user123", "../escape", 0, bytes.NewReader(nil))
+	assert.Error(t, err)
+}
+
+func TestStartTempFileCleaner_ExpiresUploadSessions(t *testing.T) {
+	ctx := context.Background()
+	baseDir := t.TempDir()
+	fs := filesystem.NewBinaryDataStorage(baseDir, time.Hour, time.Hour)
+	defer fs.Close()
+
+	abandoned, active := uuid.NewString(), uuid.NewString()
+	_, err := fs.AppendUpload(ctx, "user123", abandoned, 0, bytes.NewReader([]byte("old")))
+	assert.NoError(t, err)
+	_, err = fs.AppendUpload(ctx, "user123", active, 0, bytes.NewReader([]byte("new")))
+	assert.NoError(t, err)
+
+	// Брошенная сессия давно не получала данных
+	files, _ := filepath.Glob(filepath.Join(baseDir, "user123", "*"+abandoned+"*"))
+	assert.Len(t, files, 1)
+	past := time.Now().Add(-2 * time.Second)
+	assert.NoError(t, os.Chtimes(files[0], past, past))
+
+	stopCh := make(chan struct{})
+	filesystem.StartTempFileCleaner(baseDir, 50*time.Millisecond, time.Second, stopCh)
+	defer close(stopCh)
+	time.Sleep(200 * time.Millisecond)
+
+	offset, err := fs.UploadOffset(ctx, "user123", abandoned)
+	assert.NoError(t, err)
+	assert.Zero(t, offset, "брошенная сессия должна истечь")
+
+	offset, err = fs.UploadOffset(ctx, "user123", active)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, offset)
+}
+
+func TestBinaryDataStorage_List(t *testing.T) {
+	ctx := context.Background()
+	fs := filesystem.NewBinaryDataStorage(t.TempDir(), time.Hour, time.Hour)
+	defer fs.Close()
+
+	p1, _, err := fs.Save(ctx, "user1", bytes.NewReader([]byte("one")))
+	assert.NoError(t, err)
+	p2, _, err := fs.Save(ctx, "user2", bytes.NewReader([]byte("three")))
+	assert.NoError(t, err)
+	// Незавершённая сессия загрузки в список не попадает
+	_, err = fs.AppendUpload(ctx, "user1", uuid.NewString(), 0, bytes.NewReader([]byte("partial")))
+	assert.NoError(t, err)
+
+	got := map[string]int64{}
+	err = fs.List(ctx, func(obj storage.StoredObject) error {
+		got[obj.Path] = obj.Size
+		assert.False(t, obj.ModTime.IsZero())
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{p1: 3, p2: 5}, got)
+
+	stop := errors.New("stop")
+	err = fs.List(ctx, func(obj storage.StoredObject) error { return stop })
+	assert.ErrorIs(t, err, stop)
+}
@@ -0,0 +1,330 @@
+package filesystem
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Формат зашифрованного файла:
+//
+//	заголовок: "GKS1" | идентификатор ключа (8) | nonce (12) | ключ файла, зашифрованный мастер-ключом (32+16)
+//	тело: кадры по blobFrameSize байт открытых данных, каждый — AES-256-GCM ключом файла
+//
+// Nonce кадра составлен из признака последнего кадра и его номера, поэтому
+// кадры нельзя переставить, а обрезанный файл не проходит проверку: его
+// последний кадр не помечен как последний. Содержимое любой длины (в том
+// числе пустое) заканчивается ровно одним последним кадром.
+const (
+	blobMagic      = "GKS1"
+	keyIDSize      = 8
+	dataKeySize    = 32
+	blobHeaderSize = len(blobMagic) + keyIDSize + 12 + dataKeySize + 16
+	blobFrameSize  = 64 << 10
+	blobFrameOver  = 16 // тег GCM каждого кадра
+)
+
+// ErrUnknownStorageKey возвращается при чтении файла, зашифрованного
+// ключом, которого нет в наборе ключей хранилища.
+var ErrUnknownStorageKey = errors.New("blob is encrypted with unknown storage key")
+
+// ErrStorageKeyRequired возвращается при чтении зашифрованного файла
+// хранилищем, для которого ключи не заданы.
+var ErrStorageKeyRequired = errors.New("blob is encrypted but no storage key is configured")
+
+// Keyring — набор мастер-ключей хранилища. Новые файлы шифруются активным
+// (первым) ключом, прежние ключи нужны для чтения файлов до их
+// перешифрования.
+type Keyring struct {
+	active [keyIDSize]byte
+	keys   map[[keyIDSize]byte]cipher.AEAD
+}
+
+// NewKeyring создаёт набор из 32-байтовых ключей AES-256; первый ключ —
+// активный.
+func NewKeyring(keys ...[]byte) (*Keyring, error) {
+	if len(keys) == 0 {
+		return nil, errors.New("at least one storage key is required")
+	}
+	kr := &Keyring{keys: make(map[[keyIDSize]byte]cipher.AEAD, len(keys))}
+	for i, key := range keys {
+		if len(key) != dataKeySize {
+			return nil, fmt.Errorf("storage key %d: must be %d bytes, got %d", i+1, dataKeySize, len(key))
+		}
+		aead, err := newGCM(key)
+		if err != nil {
+			return nil, fmt.Errorf("storage key %d: %w", i+1, err)
+		}
+		id := keyID(key)
+		if _, ok := kr.keys[id]; ok {
+			return nil, fmt.Errorf("storage key %d: duplicate key", i+1)
+		}
+		kr.keys[id] = aead
+		if i == 0 {
+			kr.active = id
+		}
+	}
+	return kr, nil
+}
+
+// ParseKeys разбирает ключи в шестнадцатеричной записи, разделённые
+// запятыми или переводами строк. Пустые строки и строки, начинающиеся
+// с «#», пропускаются.
+func ParseKeys(s string) ([][]byte, error) {
+	var keys [][]byte
+	sc := bufio.NewScanner(strings.NewReader(strings.ReplaceAll(s, ",", "\n")))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, err := hex.DecodeString(line)
+		if err != nil {
+			return nil, fmt.Errorf("storage key %d: invalid hex: %w", len(keys)+1, err)
+		}
+		keys = append(keys, key)
+	}
+	return keys, sc.Err()
+}
+
+// keyID возвращает идентификатор ключа — начало его SHA-256.
+func keyID(key []byte) [keyIDSize]byte {
+	sum := sha256.Sum256(key)
+	var id [keyIDSize]byte
+	copy(id[:], sum[:])
+	return id
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// frameNonce возвращает nonce кадра index.
+func frameNonce(index uint64, final bool) []byte {
+	nonce := make([]byte, 12)
+	if final {
+		nonce[3] = 1
+	}
+	binary.BigEndian.PutUint64(nonce[4:], index)
+	return nonce
+}
+
+// blobPlainSize возвращает размер открытых данных зашифрованного файла
+// размером diskSize.
+func blobPlainSize(diskSize int64) int64 {
+	n := diskSize - int64(blobHeaderSize)
+	frames := (n + blobFrameSize + blobFrameOver - 1) / (blobFrameSize + blobFrameOver)
+	return n - frames*blobFrameOver
+}
+
+// blobWriter шифрует данные кадрами. Кадр записывается, когда за ним
+// появляются новые данные, поэтому последним помечается кадр, записанный
+// при Close.
+type blobWriter struct {
+	w     io.Writer
+	aead  cipher.AEAD
+	buf   []byte
+	index uint64
+	size  int64
+}
+
+// newBlobWriter пишет в w заголовок со случайным ключом файла,
+// зашифрованным активным ключом набора.
+func (kr *Keyring) newBlobWriter(w io.Writer) (*blobWriter, error) {
+	dataKey := make([]byte, dataKeySize)
+	nonce := make([]byte, 12)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, 0, blobHeaderSize)
+	header = append(header, blobMagic...)
+	header = append(header, kr.active[:]...)
+	header = append(header, nonce...)
+	header = kr.keys[kr.active].Seal(header, nonce, dataKey, header[:len(blobMagic)+keyIDSize])
+	if _, err := w.Write(header); err != nil {
+		return nil, err
+	}
+
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &blobWriter{w: w, aead: aead, buf: make([]byte, 0, blobFrameSize)}, nil
+}
+
+func (bw *blobWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		if len(bw.buf) == blobFrameSize {
+			if err := bw.flush(false); err != nil {
+				return written, err
+			}
+		}
+		n := copy(bw.buf[len(bw.buf):blobFrameSize], p)
+		bw.buf = bw.buf[:len(bw.buf)+n]
+		p = p[n:]
+		written += n
+		bw.size += int64(n)
+	}
+	return written, nil
+}
+
+// Close записывает последний кадр. Нижележащий поток не закрывается.
+func (bw *blobWriter) Close() error {
+	return bw.flush(true)
+}
+
+func (bw *blobWriter) flush(final bool) error {
+	frame := bw.aead.Seal(nil, frameNonce(bw.index, final), bw.buf, nil)
+	if _, err := bw.w.Write(frame); err != nil {
+		return err
+	}
+	bw.index++
+	bw.buf = bw.buf[:0]
+	return nil
+}
+
+// readBlobHeader читает заголовок файла f. Для файла без заголовка
+// (сохранённого без шифрования) возвращает encrypted == false.
+func readBlobHeader(f io.ReaderAt) (header []byte, encrypted bool, err error) {
+	header = make([]byte, blobHeaderSize)
+	n, err := f.ReadAt(header, 0)
+	if n >= len(blobMagic) && string(header[:len(blobMagic)]) == blobMagic {
+		if n < blobHeaderSize {
+			return nil, true, fmt.Errorf("truncated blob header: %w", io.ErrUnexpectedEOF)
+		}
+		return header, true, nil
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, false, err
+	}
+	return nil, false, nil
+}
+
+// headerKeyID возвращает идентификатор ключа из заголовка.
+func headerKeyID(header []byte) [keyIDSize]byte {
+	var id [keyIDSize]byte
+	copy(id[:], header[len(blobMagic):])
+	return id
+}
+
+// openBlob возвращает расшифровывающий поток для файла f с заголовком header.
+func (kr *Keyring) openBlob(f *os.File, header []byte, diskSize int64) (*blobReader, error) {
+	if kr == nil {
+		return nil, ErrStorageKeyRequired
+	}
+	master, ok := kr.keys[headerKeyID(header)]
+	if !ok {
+		return nil, ErrUnknownStorageKey
+	}
+	prefix := len(blobMagic) + keyIDSize
+	dataKey, err := master.Open(nil, header[prefix:prefix+12], header[prefix+12:], header[:prefix])
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap blob key: %w", err)
+	}
+	aead, err := newGCM(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if diskSize < int64(blobHeaderSize+blobFrameOver) {
+		return nil, fmt.Errorf("truncated blob: %w", io.ErrUnexpectedEOF)
+	}
+	return &blobReader{
+		f:      f,
+		aead:   aead,
+		disk:   diskSize - int64(blobHeaderSize),
+		size:   blobPlainSize(diskSize),
+		frame:  -1,
+		buffer: make([]byte, 0, blobFrameSize+blobFrameOver),
+	}, nil
+}
+
+// blobReader расшифровывает файл кадрами и поддерживает перемотку.
+type blobReader struct {
+	f      *os.File
+	aead   cipher.AEAD
+	disk   int64 // размер тела файла на диске
+	size   int64 // размер открытых данных
+	pos    int64
+	frame  int64  // номер расшифрованного кадра (-1 — нет)
+	plain  []byte // открытые данные кадра frame
+	buffer []byte
+}
+
+func (br *blobReader) Read(p []byte) (int, error) {
+	if br.pos >= br.size {
+		return 0, io.EOF
+	}
+	index := br.pos / blobFrameSize
+	if index != br.frame {
+		if err := br.load(index); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, br.plain[br.pos-index*blobFrameSize:])
+	br.pos += int64(n)
+	return n, nil
+}
+
+// load читает и расшифровывает кадр index.
+func (br *blobReader) load(index int64) error {
+	const stride = blobFrameSize + blobFrameOver
+	off := index * stride
+	n := min(int64(stride), br.disk-off)
+	buf := br.buffer[:n]
+	if _, err := br.f.ReadAt(buf, int64(blobHeaderSize)+off); err != nil {
+		return fmt.Errorf("failed to read blob frame: %w", err)
+	}
+	final := off+n == br.disk
+	plain, err := br.aead.Open(br.plain[:0], frameNonce(uint64(index), final), buf, nil)
+	if err != nil {
+		br.frame = -1
+		return fmt.Errorf("blob frame %d: %w", index, err)
+	}
+	br.plain, br.frame = plain, index
+	return nil
+}
+
+func (br *blobReader) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += br.pos
+	case io.SeekEnd:
+		offset += br.size
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if offset < 0 {
+		return 0, errors.New("negative position")
+	}
+	br.pos = offset
+	return offset, nil
+}
+
+func (br *blobReader) Close() error {
+	return br.f.Close()
+}
+
+// isActive сообщает, что заголовок указывает на активный ключ набора.
+func (kr *Keyring) isActive(header []byte) bool {
+	return kr != nil && bytes.Equal(header[len(blobMagic):len(blobMagic)+keyIDSize], kr.active[:])
+}
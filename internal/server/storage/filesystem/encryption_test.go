@@ -0,0 +1,288 @@
+package filesystem_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/filesystem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const frameSize = 64 << 10
+
+func testKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func keyring(t *testing.T, keys ...[]byte) *filesystem.Keyring {
+	t.Helper()
+	kr, err := filesystem.NewKeyring(keys...)
+	require.NoError(t, err)
+	return kr
+}
+
+func newEncrypted(t *testing.T, dir string, kr *filesystem.Keyring) storage.BinaryDataStorage {
+	t.Helper()
+	fs := filesystem.NewEncryptedBinaryDataStorage(dir, time.Hour, time.Hour, kr)
+	t.Cleanup(fs.Close)
+	return fs
+}
+
+func randomData(t *testing.T, n int) []byte {
+	t.Helper()
+	data := make([]byte, n)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	return data
+}
+
+func loadAll(t *testing.T, fs storage.BinaryDataStorage, path string) []byte {
+	t.Helper()
+	rc, err := fs.Load(context.Background(), path)
+	require.NoError(t, err)
+	defer rc.Close()
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	return data
+}
+
+func TestEncryptedStorage_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	fs := newEncrypted(t, dir, keyring(t, testKey(1)))
+
+	for _, n := range []int{0, 1, frameSize - 1, frameSize, frameSize + 1, 3*frameSize + 17} {
+		data := randomData(t, n)
+		path, size, err := fs.Save(ctx, "user1", bytes.NewReader(data))
+		require.NoError(t, err)
+		assert.EqualValues(t, n, size)
+
+		onDisk, err := os.ReadFile(filepath.Join(dir, path))
+		require.NoError(t, err)
+		assert.Equal(t, "GKS1", string(onDisk[:4]))
+		if n >= 16 {
+			assert.NotContains(t, string(onDisk), string(data), "содержимое не должно храниться открыто")
+		}
+
+		assert.Equal(t, data, loadAll(t, fs, path), "size %d", n)
+	}
+
+	var sizes []int64
+	require.NoError(t, fs.List(ctx, func(obj storage.StoredObject) error {
+		sizes = append(sizes, obj.Size)
+		return nil
+	}))
+	assert.ElementsMatch(t, []int64{0, 1, frameSize - 1, frameSize, frameSize + 1, 3*frameSize + 17}, sizes)
+}
+
+func TestEncryptedStorage_Seek(t *testing.T) {
+	ctx := context.Background()
+	fs := newEncrypted(t, t.TempDir(), keyring(t, testKey(1)))
+
+	data := randomData(t, 2*frameSize+100)
+	path, _, err := fs.Save(ctx, "user1", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	rc, err := fs.Load(ctx, path)
+	require.NoError(t, err)
+	defer rc.Close()
+
+	end, err := rc.Seek(0, io.SeekEnd)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(data), end)
+
+	// чтение через границу кадров
+	off := int64(frameSize - 10)
+	_, err = rc.Seek(off, io.SeekStart)
+	require.NoError(t, err)
+	buf := make([]byte, 30)
+	_, err = io.ReadFull(rc, buf)
+	require.NoError(t, err)
+	assert.Equal(t, data[off:off+30], buf)
+
+	_, err = rc.Seek(-5, io.SeekEnd)
+	require.NoError(t, err)
+	tail, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, data[len(data)-5:], tail)
+}
+
+func TestEncryptedStorage_Tampered(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	fs := newEncrypted(t, dir, keyring(t, testKey(1)))
+
+	data := randomData(t, 2*frameSize)
+	path, _, err := fs.Save(ctx, "user1", bytes.NewReader(data))
+	require.NoError(t, err)
+	full := filepath.Join(dir, path)
+
+	// Файл, обрезанный по границе кадра, не проходит проверку
+	fi, err := os.Stat(full)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(full, fi.Size()-(frameSize+16)))
+	rc, err := fs.Load(ctx, path)
+	require.NoError(t, err)
+	_, err = io.ReadAll(rc)
+	assert.Error(t, err)
+	rc.Close()
+
+	// Изменённый байт тела тоже
+	path, _, err = fs.Save(ctx, "user1", bytes.NewReader(data))
+	require.NoError(t, err)
+	full = filepath.Join(dir, path)
+	onDisk, err := os.ReadFile(full)
+	require.NoError(t, err)
+	onDisk[100] ^= 0xff
+	require.NoError(t, os.WriteFile(full, onDisk, 0o644))
+	rc, err = fs.Load(ctx, path)
+	require.NoError(t, err)
+	_, err = io.ReadAll(rc)
+	assert.Error(t, err)
+	rc.Close()
+
+	// Файл, зашифрованный неизвестным ключом, не открывается
+	other := newEncrypted(t, dir, keyring(t, testKey(2)))
+	_, err = other.Load(ctx, path)
+	assert.ErrorIs(t, err, filesystem.ErrUnknownStorageKey)
+
+	// Без ключей зашифрованный файл не отдаётся как есть
+	plain := filesystem.NewBinaryDataStorage(dir, time.Hour, time.Hour)
+	defer plain.Close()
+	_, err = plain.Load(ctx, path)
+	assert.ErrorIs(t, err, filesystem.ErrStorageKeyRequired)
+}
+
+func TestEncryptedStorage_CommitUpload(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	fs := newEncrypted(t, dir, keyring(t, testKey(1)))
+
+	data := randomData(t, frameSize+5)
+	uploadID := uuid.New().String()
+	_, err := fs.AppendUpload(ctx, "user1", uploadID, 0, bytes.NewReader(data[:100]))
+	require.NoError(t, err)
+	_, err = fs.AppendUpload(ctx, "user1", uploadID, 100, bytes.NewReader(data[100:]))
+	require.NoError(t, err)
+
+	path, size, err := fs.CommitUpload(ctx, "user1", uploadID)
+	require.NoError(t, err)
+	assert.EqualValues(t, len(data), size)
+	assert.Equal(t, data, loadAll(t, fs, path))
+
+	// сессия удалена
+	offset, err := fs.UploadOffset(ctx, "user1", uploadID)
+	require.NoError(t, err)
+	assert.Zero(t, offset)
+}
+
+func TestEncryptedStorage_Reencrypt(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// файл без шифрования и файл, зашифрованный прежним ключом
+	plain := filesystem.NewBinaryDataStorage(dir, time.Hour, time.Hour)
+	legacy := []byte("stored before encryption")
+	legacyPath, _, err := plain.Save(ctx, "user1", bytes.NewReader(legacy))
+	require.NoError(t, err)
+	plain.Close()
+
+	oldKey, newKey := testKey(1), testKey(2)
+	old := newEncrypted(t, dir, keyring(t, oldKey))
+	data := randomData(t, frameSize+1)
+	oldPath, _, err := old.Save(ctx, "user1", bytes.NewReader(data))
+	require.NoError(t, err)
+	past := time.Now().Add(-48 * time.Hour).Truncate(time.Second)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, oldPath), past, past))
+
+	// после смены ключа прежние файлы читаются, пока прежний ключ в наборе
+	rotated := newEncrypted(t, dir, keyring(t, newKey, oldKey))
+	assert.Equal(t, legacy, loadAll(t, rotated, legacyPath))
+	assert.Equal(t, data, loadAll(t, rotated, oldPath))
+
+	res, err := rotated.(storage.Reencryptor).Reencrypt(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, storage.ReencryptResult{Scanned: 2, Reencrypted: 2}, res)
+
+	fi, err := os.Stat(filepath.Join(dir, oldPath))
+	require.NoError(t, err)
+	assert.True(t, fi.ModTime().Equal(past), "время изменения сохраняется")
+
+	// повторный запуск ничего не переписывает
+	res, err = rotated.(storage.Reencryptor).Reencrypt(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, storage.ReencryptResult{Scanned: 2}, res)
+
+	// прежний ключ больше не нужен
+	current := newEncrypted(t, dir, keyring(t, newKey))
+	assert.Equal(t, legacy, loadAll(t, current, legacyPath))
+	assert.Equal(t, data, loadAll(t, current, oldPath))
+
+	// временных файлов не осталось
+	tmp, err := filepath.Glob(filepath.Join(dir, "user1", "*.tmp"))
+	require.NoError(t, err)
+	assert.Empty(t, tmp)
+}
+
+func TestEncryptedStorage_Reencrypt_SkipsFailedFiles(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// файл, зашифрованный ключом, которого уже нет в наборе, и два файла без шифрования
+	dropped := newEncrypted(t, dir, keyring(t, testKey(1)))
+	lostPath, _, err := dropped.Save(ctx, "user1", bytes.NewReader([]byte("lost key")))
+	require.NoError(t, err)
+	plain := filesystem.NewBinaryDataStorage(dir, time.Hour, time.Hour)
+	var plainPaths []string
+	for _, content := range []string{"first", "second"} {
+		path, _, err := plain.Save(ctx, "user2", bytes.NewReader([]byte(content)))
+		require.NoError(t, err)
+		plainPaths = append(plainPaths, path)
+	}
+	plain.Close()
+
+	current := newEncrypted(t, dir, keyring(t, testKey(2)))
+	res, err := current.(storage.Reencryptor).Reencrypt(ctx)
+	require.NoError(t, err, "ошибка одного файла не прерывает перешифрование")
+	assert.Equal(t, int64(3), res.Scanned)
+	assert.Equal(t, int64(2), res.Reencrypted)
+	assert.Equal(t, int64(1), res.Failed)
+	require.Len(t, res.Errors, 1)
+	assert.Contains(t, res.Errors[0], lostPath)
+
+	assert.Equal(t, []byte("first"), loadAll(t, current, plainPaths[0]))
+	assert.Equal(t, []byte("second"), loadAll(t, current, plainPaths[1]))
+
+	// отменённый обход прерывается с ошибкой
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = current.(storage.Reencryptor).Reencrypt(cancelled)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func TestKeyring(t *testing.T) {
+	_, err := filesystem.NewKeyring()
+	assert.Error(t, err)
+	_, err = filesystem.NewKeyring(make([]byte, 16))
+	assert.Error(t, err)
+	_, err = filesystem.NewKeyring(testKey(1), testKey(1))
+	assert.Error(t, err)
+
+	keys, err := filesystem.ParseKeys("# активный\n" +
+		"0101010101010101010101010101010101010101010101010101010101010101,\n\n" +
+		"0202020202020202020202020202020202020202020202020202020202020202\n")
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{testKey(1), testKey(2)}, keys)
+
+	_, err = filesystem.ParseKeys("not-hex")
+	assert.Error(t, err)
+}
@@ -0,0 +1,46 @@
+package filesystem
+
+import "sync"
+
+// uploadLocks сериализует операции над одной сессией загрузки: без неё два
+// потока с одним uploadID могли бы одновременно обрезать и дописывать один
+// временный файл или зафиксировать сессию посреди записи.
+//
+// Нулевое значение готово к использованию.
+type uploadLocks struct {
+	mu    sync.Mutex
+	locks map[string]*uploadLock
+}
+
+// uploadLock — блокировка одной сессии и число её ожидающих и текущих владельцев.
+type uploadLock struct {
+	mu   sync.Mutex
+	refs int
+}
+
+// lock захватывает блокировку сессии key и возвращает функцию её освобождения.
+// Запись о сессии удаляется, когда блокировку больше никто не ждёт.
+func (l *uploadLocks) lock(key string) (unlock func()) {
+	l.mu.Lock()
+	if l.locks == nil {
+		l.locks = make(map[string]*uploadLock)
+	}
+	ul := l.locks[key]
+	if ul == nil {
+		ul = &uploadLock{}
+		l.locks[key] = ul
+	}
+	ul.refs++
+	l.mu.Unlock()
+
+	ul.mu.Lock()
+	return func() {
+		ul.mu.Unlock()
+		l.mu.Lock()
+		ul.refs--
+		if ul.refs == 0 {
+			delete(l.locks, key)
+		}
+		l.mu.Unlock()
+	}
+}
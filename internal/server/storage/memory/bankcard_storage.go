@@ -0,0 +1,126 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// bankCardStorage — хранилище банковских карт в памяти.
+type bankCardStorage struct {
+	db access
+}
+
+// NewBankCardStorage создаёт хранилище банковских карт.
+func NewBankCardStorage(s *Store) repository.BankCardRepository {
+	return &bankCardStorage{db: s}
+}
+
+// Create сохраняет новую банковскую карту.
+func (s *bankCardStorage) Create(ctx context.Context, card *model.BankCard) error {
+	return s.db.write(func(st *state) error {
+		ts := now()
+		card.ID = uuid.NewString()
+		stored := copyBankCard(*card)
+		stored.Favorite, stored.LastAccessedAt = false, nil
+		stored.CreatedAt, stored.UpdatedAt, stored.Version = ts, ts, 1
+		st.bankCards[card.ID] = stored
+		st.logChange(card.UserID, model.ItemTypeBankCard, card.ID, false)
+
+		card.CreatedAt, card.UpdatedAt, card.Version = ts, ts, 1
+		return nil
+	})
+}
+
+// GetByID возвращает банковскую карту по её идентификатору.
+func (s *bankCardStorage) GetByID(ctx context.Context, id string) (*model.BankCard, error) {
+	var card *model.BankCard
+	err := s.db.read(func(st *state) error {
+		stored, ok := st.bankCards[id]
+		if !ok {
+			return fmt.Errorf("bank card with id %s not found", id)
+		}
+		c := copyBankCard(stored)
+		card = &c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return card, nil
+}
+
+// GetByUser возвращает страницу банковских карт указанного пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *bankCardStorage) GetByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
+	var cards []model.BankCard
+	err := s.db.read(func(st *state) error {
+		for _, c := range st.bankCards {
+			if c.UserID == userID && filter.Match(c.Folder, c.Tags) {
+				cards = append(cards, copyBankCard(c))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return paginate(cards, func(c model.BankCard) pageKey {
+		return pageKey{id: c.ID, title: c.Title, createdAt: c.CreatedAt, updatedAt: c.UpdatedAt}
+	}, page)
+}
+
+// Update обновляет данные существующей банковской карты.
+// Если card.Version не равна нулю, карта обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в card.
+func (s *bankCardStorage) Update(ctx context.Context, card *model.BankCard) error {
+	return s.db.write(func(st *state) error {
+		stored, ok := st.bankCards[card.ID]
+		if !ok || stored.UserID != card.UserID {
+			return fmt.Errorf("bank card with id %s not found", card.ID)
+		}
+		if card.Version != 0 && card.Version != stored.Version {
+			return model.ErrVersionConflict
+		}
+		stored.Title = card.Title
+		stored.CardholderName = card.CardholderName
+		stored.CardNumber = card.CardNumber
+		stored.ExpiryDate = card.ExpiryDate
+		stored.CVV = card.CVV
+		stored.Metadata = card.Metadata
+		stored.Folder = card.Folder
+		stored.Tags = slices.Clone(card.Tags)
+		stored.Version++
+		stored.UpdatedAt = now()
+		st.bankCards[card.ID] = stored
+		st.logChange(stored.UserID, model.ItemTypeBankCard, card.ID, false)
+
+		card.Version, card.UpdatedAt = stored.Version, stored.UpdatedAt
+		return nil
+	})
+}
+
+// Delete удаляет банковскую карту по идентификатору.
+func (s *bankCardStorage) Delete(ctx context.Context, id string) error {
+	return s.db.write(func(st *state) error {
+		stored, ok := st.bankCards[id]
+		if !ok {
+			return fmt.Errorf("bank card with id %s not found", id)
+		}
+		delete(st.bankCards, id)
+		st.logChange(stored.UserID, model.ItemTypeBankCard, id, true)
+		return nil
+	})
+}
+
+// copyBankCard возвращает копию карты, не разделяющую с ней срезы и указатели.
+func copyBankCard(c model.BankCard) model.BankCard {
+	c.Tags = slices.Clone(c.Tags)
+	c.LastAccessedAt = cloneTime(c.LastAccessedAt)
+	return c
+}
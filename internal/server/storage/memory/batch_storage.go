@@ -0,0 +1,86 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// batchStorage выполняет изменения записей разных типов как одну транзакцию.
+type batchStorage struct {
+	store *Store
+}
+
+// NewBatchStorage создаёт хранилище пакетных изменений записей.
+func NewBatchStorage(s *Store) repository.BatchRepository {
+	return &batchStorage{store: s}
+}
+
+// WithinTx выполняет fn над копией состояния под исключительной блокировкой
+// хранилища. Если fn не вернула ошибку, копия заменяет состояние хранилища
+// и публикуются уведомления об изменениях пакета; иначе копия отбрасывается.
+func (s *batchStorage) WithinTx(ctx context.Context, fn func(tx repository.BatchTx) error) error {
+	var events []model.ChangeEvent
+	err := func() error {
+		s.store.mu.Lock()
+		defer s.store.mu.Unlock()
+
+		work := s.store.st.clone()
+		if err := fn(&batchTx{st: work}); err != nil {
+			return err
+		}
+		events = work.takeEvents()
+		s.store.st = work
+		return nil
+	}()
+	if err != nil {
+		return err
+	}
+
+	s.store.publish(events)
+	return nil
+}
+
+// batchTx — репозитории записей, работающие с рабочей копией состояния пакета.
+// Блокировку хранилища удерживает WithinTx, поэтому batchTx её не берёт.
+type batchTx struct {
+	st *state
+}
+
+// read выполняет fn над рабочей копией состояния.
+func (t *batchTx) read(fn func(st *state) error) error {
+	return fn(t.st)
+}
+
+// write выполняет fn над рабочей копией состояния. Уведомления копятся
+// в ней и публикуются после фиксации пакета.
+func (t *batchTx) write(fn func(st *state) error) error {
+	return fn(t.st)
+}
+
+// Credential возвращает репозиторий учётных данных в рамках транзакции.
+func (t *batchTx) Credential() repository.CredentialRepository {
+	return &credentialStorage{db: t}
+}
+
+// BankCard возвращает репозиторий банковских карт в рамках транзакции.
+func (t *batchTx) BankCard() repository.BankCardRepository {
+	return &bankCardStorage{db: t}
+}
+
+// TextData возвращает репозиторий текстовых данных в рамках транзакции.
+func (t *batchTx) TextData() repository.TextDataRepository {
+	return &textDataStorage{db: t}
+}
+
+// Savepoint выполняет fn и при её ошибке возвращает рабочую копию к
+// состоянию до вызова; пакет продолжается со следующей операции.
+func (t *batchTx) Savepoint(ctx context.Context, fn func() error) error {
+	saved := t.st.clone()
+	if err := fn(); err != nil {
+		*t.st = *saved
+		return err
+	}
+	return nil
+}
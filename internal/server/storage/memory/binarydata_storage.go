@@ -0,0 +1,177 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// binaryDataStorage — хранилище метаданных бинарных данных в памяти.
+type binaryDataStorage struct {
+	db access
+}
+
+// NewBinaryDataStorage создаёт хранилище BinaryData.
+func NewBinaryDataStorage(s *Store) repository.BinaryDataRepository {
+	return &binaryDataStorage{db: s}
+}
+
+// Save сохраняет новую запись бинарных данных.
+func (s *binaryDataStorage) Save(ctx context.Context, data *model.BinaryData) error {
+	return s.db.write(func(st *state) error {
+		st.saveBinary(data)
+		return nil
+	})
+}
+
+// Update изменяет метаданные и пути хранения бинарных данных.
+// Если data.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в data.
+func (s *binaryDataStorage) Update(ctx context.Context, data *model.BinaryData) error {
+	return s.db.write(func(st *state) error {
+		return st.updateBinary(data)
+	})
+}
+
+// GetByID возвращает запись бинарных данных по id и userID.
+func (s *binaryDataStorage) GetByID(ctx context.Context, userID, id string) (*model.BinaryData, error) {
+	var data *model.BinaryData
+	err := s.db.read(func(st *state) error {
+		stored, ok := st.binaryData[id]
+		if !ok || stored.UserID != userID {
+			return fmt.Errorf("binary data with id %s not found", id)
+		}
+		d := copyBinaryData(stored)
+		data = &d
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// ListByUser возвращает страницу бинарных данных конкретного пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *binaryDataStorage) ListByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.BinaryData, string, error) {
+	var list []*model.BinaryData
+	err := s.db.read(func(st *state) error {
+		for _, d := range st.binaryData {
+			if d.UserID == userID && filter.Match(d.Folder, d.Tags) {
+				c := copyBinaryData(d)
+				list = append(list, &c)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return paginate(list, func(d *model.BinaryData) pageKey {
+		return pageKey{id: d.ID, title: d.Title, createdAt: d.CreatedAt, updatedAt: d.UpdatedAt}
+	}, page)
+}
+
+// StoredRefs возвращает пути файлов и контрольные суммы всех записей и
+// прежних версий, содержимое которых хранится одним файлом.
+func (s *binaryDataStorage) StoredRefs(ctx context.Context) ([]model.StoredRef, error) {
+	var refs []model.StoredRef
+	err := s.db.read(func(st *state) error {
+		for _, d := range st.binaryData {
+			if d.StoragePath != "" && !d.Chunked {
+				refs = append(refs, model.StoredRef{UserID: d.UserID, ID: d.ID, StoragePath: d.StoragePath, Checksum: d.Checksum})
+			}
+		}
+		for _, v := range st.versions {
+			if v.StoragePath != "" && !v.Chunked {
+				refs = append(refs, model.StoredRef{UserID: v.UserID, ID: v.ID, StoragePath: v.StoragePath, Checksum: v.Checksum, Version: true})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// Delete удаляет запись по id и userID.
+func (s *binaryDataStorage) Delete(ctx context.Context, userID, id string) error {
+	return s.db.write(func(st *state) error {
+		return st.deleteBinary(userID, id)
+	})
+}
+
+// saveBinary сохраняет новую запись и заполняет её идентификатор, время и версию.
+func (st *state) saveBinary(data *model.BinaryData) {
+	ts := now()
+	data.ID = uuid.NewString()
+	stored := copyBinaryData(*data)
+	stored.Favorite, stored.LastAccessedAt = false, nil
+	stored.CreatedAt, stored.UpdatedAt, stored.Version = ts, ts, 1
+	st.binaryData[data.ID] = stored
+	st.logChange(data.UserID, model.ItemTypeBinaryData, data.ID, false)
+
+	data.CreatedAt, data.UpdatedAt, data.Version = ts, ts, 1
+}
+
+// updateBinary обновляет запись с проверкой версии (см. binaryDataStorage.Update).
+func (st *state) updateBinary(data *model.BinaryData) error {
+	stored, ok := st.binaryData[data.ID]
+	if !ok || stored.UserID != data.UserID {
+		return fmt.Errorf("binary data with id %s not found", data.ID)
+	}
+	if data.Version != 0 && data.Version != stored.Version {
+		return model.ErrVersionConflict
+	}
+	stored.Title = data.Title
+	stored.StoragePath = data.StoragePath
+	stored.ClientPath = data.ClientPath
+	stored.Size = data.Size
+	stored.Chunked = data.Chunked
+	stored.Checksum = data.Checksum
+	stored.PlainChecksum = data.PlainChecksum
+	stored.Archive = data.Archive
+	stored.Metadata = data.Metadata
+	stored.Folder = data.Folder
+	stored.Tags = slices.Clone(data.Tags)
+	stored.Version++
+	stored.UpdatedAt = now()
+	st.binaryData[data.ID] = stored
+	st.logChange(stored.UserID, model.ItemTypeBinaryData, data.ID, false)
+
+	data.Version, data.UpdatedAt = stored.Version, stored.UpdatedAt
+	return nil
+}
+
+// deleteBinary удаляет запись вместе с её манифестом и прежними версиями,
+// как это делают каскадные ограничения SQL-хранилищ. Счётчики ссылок
+// фрагментов не меняются: манифесты освобождает вызывающий код.
+func (st *state) deleteBinary(userID, id string) error {
+	stored, ok := st.binaryData[id]
+	if !ok || stored.UserID != userID {
+		return fmt.Errorf("binary data with id %s not found", id)
+	}
+	delete(st.binaryData, id)
+	delete(st.fileManifests, id)
+	for vid, v := range st.versions {
+		if v.BinaryID == id {
+			delete(st.versions, vid)
+			delete(st.versionManifests, vid)
+		}
+	}
+	st.logChange(userID, model.ItemTypeBinaryData, id, true)
+	return nil
+}
+
+// copyBinaryData возвращает копию записи, не разделяющую с ней срезы и указатели.
+func copyBinaryData(d model.BinaryData) model.BinaryData {
+	d.Tags = slices.Clone(d.Tags)
+	d.LastAccessedAt = cloneTime(d.LastAccessedAt)
+	return d
+}
@@ -0,0 +1,145 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// binaryVersionStorage — хранилище прежних версий содержимого бинарных данных в памяти.
+type binaryVersionStorage struct {
+	db access
+}
+
+// NewBinaryVersionStorage создаёт хранилище прежних версий бинарных данных.
+func NewBinaryVersionStorage(s *Store) repository.BinaryVersionRepository {
+	return &binaryVersionStorage{db: s}
+}
+
+// Save сохраняет версию и её манифест.
+func (s *binaryVersionStorage) Save(ctx context.Context, v *model.BinaryVersion, ids []string) error {
+	return s.db.write(func(st *state) error {
+		if err := st.checkChunks(v.UserID, ids); err != nil {
+			return err
+		}
+		v.ID = uuid.NewString()
+		v.CreatedAt = now()
+		stored := *v
+		stored.UpdatedAt = v.UpdatedAt.UTC()
+		st.versions[v.ID] = stored
+		st.replaceManifest(st.versionManifests, v.UserID, v.ID, ids)
+		return nil
+	})
+}
+
+// List возвращает версии записи, начиная с последней.
+func (s *binaryVersionStorage) List(ctx context.Context, userID, binaryID string) ([]*model.BinaryVersion, error) {
+	var versions []*model.BinaryVersion
+	err := s.db.read(func(st *state) error {
+		versions = st.listVersions(userID, binaryID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// Get возвращает версию записи по идентификатору.
+func (s *binaryVersionStorage) Get(ctx context.Context, userID, binaryID, id string) (*model.BinaryVersion, error) {
+	var version *model.BinaryVersion
+	err := s.db.read(func(st *state) error {
+		v, ok := st.versions[id]
+		if !ok || v.BinaryID != binaryID || v.UserID != userID {
+			return model.ErrBinaryVersionNotFound
+		}
+		version = &v
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return version, nil
+}
+
+// Manifest возвращает фрагменты версии по порядку.
+func (s *binaryVersionStorage) Manifest(ctx context.Context, userID, id string) ([]model.ChunkRef, error) {
+	var refs []model.ChunkRef
+	err := s.db.read(func(st *state) error {
+		if v, ok := st.versions[id]; ok && v.UserID == userID {
+			refs = st.manifestRefs(userID, st.versionManifests[id])
+		}
+		return nil
+	})
+	return refs, err
+}
+
+// Delete удаляет версию и освобождает фрагменты её манифеста.
+func (s *binaryVersionStorage) Delete(ctx context.Context, userID, id string) ([]model.Chunk, error) {
+	var released []model.Chunk
+	err := s.db.write(func(st *state) error {
+		var err error
+		released, err = st.deleteVersion(userID, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return released, nil
+}
+
+// Prune удаляет версии записи, кроме keep последних.
+func (s *binaryVersionStorage) Prune(ctx context.Context, userID, binaryID string, keep int) ([]*model.BinaryVersion, []model.Chunk, error) {
+	var pruned []*model.BinaryVersion
+	var released []model.Chunk
+	err := s.db.write(func(st *state) error {
+		versions := st.listVersions(userID, binaryID)
+		if keep >= len(versions) {
+			return nil
+		}
+		pruned = versions[max(keep, 0):]
+		for _, v := range pruned {
+			chunks, err := st.deleteVersion(userID, v.ID)
+			if err != nil {
+				return err
+			}
+			released = append(released, chunks...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return pruned, released, nil
+}
+
+// listVersions возвращает версии записи, начиная с последней.
+func (st *state) listVersions(userID, binaryID string) []*model.BinaryVersion {
+	var versions []*model.BinaryVersion
+	for _, v := range st.versions {
+		if v.BinaryID == binaryID && v.UserID == userID {
+			versions = append(versions, &v)
+		}
+	}
+	slices.SortFunc(versions, func(a, b *model.BinaryVersion) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return versions
+}
+
+// deleteVersion удаляет версию id и возвращает освобождённые фрагменты её манифеста.
+func (st *state) deleteVersion(userID, id string) ([]model.Chunk, error) {
+	if v, ok := st.versions[id]; !ok || v.UserID != userID {
+		return nil, model.ErrBinaryVersionNotFound
+	}
+	released := st.replaceManifest(st.versionManifests, userID, id, nil)
+	delete(st.versions, id)
+	return released, nil
+}
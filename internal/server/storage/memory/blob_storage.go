@@ -0,0 +1,192 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
+)
+
+// blob — содержимое файла в памяти.
+type blob struct {
+	data    []byte
+	modTime time.Time
+}
+
+// uploadKey — ключ сессии загрузки с докачкой.
+type uploadKey struct {
+	userID   string
+	uploadID string
+}
+
+// blobStorage реализует storage.BinaryDataStorage в памяти процесса.
+// Содержимое не переживает перезапуск сервера.
+type blobStorage struct {
+	mu      sync.RWMutex
+	blobs   map[string]blob // по пути, который возвращает Save
+	uploads map[uploadKey][]byte
+}
+
+// NewBlobStorage создаёт хранилище бинарных данных в памяти.
+func NewBlobStorage() storage.BinaryDataStorage {
+	return &blobStorage{
+		blobs:   make(map[string]blob),
+		uploads: make(map[uploadKey][]byte),
+	}
+}
+
+// Save читает r целиком и сохраняет содержимое под новым путём вида
+// userID/<uuid>.bin, как локальное хранилище.
+func (s *blobStorage) Save(ctx context.Context, userID string, r io.Reader) (storagePath string, size int64, err error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to write data: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.put(userID, data), int64(len(data)), nil
+}
+
+// put сохраняет data под новым путём. Вызывается под блокировкой.
+func (s *blobStorage) put(userID string, data []byte) string {
+	storagePath := path.Join(userID, uuid.NewString()+".bin")
+	s.blobs[storagePath] = blob{data: data, modTime: time.Now()}
+	return storagePath
+}
+
+// Load возвращает поток для чтения содержимого storagePath.
+// Сохранённое содержимое не изменяется на месте, поэтому поток читает
+// его без копирования.
+func (s *blobStorage) Load(ctx context.Context, storagePath string) (io.ReadSeekCloser, error) {
+	s.mu.RLock()
+	b, ok := s.blobs[storagePath]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("failed to open file: %s not found", storagePath)
+	}
+	return nopCloser{bytes.NewReader(b.data)}, nil
+}
+
+// Delete удаляет содержимое storagePath. Отсутствие файла ошибкой не считается.
+func (s *blobStorage) Delete(ctx context.Context, storagePath string) error {
+	s.mu.Lock()
+	delete(s.blobs, storagePath)
+	s.mu.Unlock()
+	return nil
+}
+
+// UploadOffset возвращает объём данных сессии загрузки (0 — сессии нет).
+func (s *blobStorage) UploadOffset(ctx context.Context, userID, uploadID string) (int64, error) {
+	key, err := newUploadKey(userID, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return int64(len(s.uploads[key])), nil
+}
+
+// UploadUsage возвращает суммарный объём сессий загрузки пользователя.
+func (s *blobStorage) UploadUsage(ctx context.Context, userID string) (int64, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	var total int64
+	for key, buf := range s.uploads {
+		if key.userID == userID {
+			total += int64(len(buf))
+		}
+	}
+	return total, nil
+}
+
+// AppendUpload дописывает данные в сессию загрузки начиная с offset.
+// Принятые до ошибки чтения r байты остаются в сессии.
+func (s *blobStorage) AppendUpload(ctx context.Context, userID, uploadID string, offset int64, r io.Reader) (int64, error) {
+	key, err := newUploadKey(userID, uploadID)
+	if err != nil {
+		return 0, err
+	}
+	// Данные читаются без блокировки: r может быть потоком клиента
+	data, rerr := io.ReadAll(r)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	buf := s.uploads[key]
+	if offset < 0 || offset > int64(len(buf)) {
+		return int64(len(buf)), storage.ErrUploadOffset
+	}
+	// Данные после offset клиент передаёт заново
+	buf = append(buf[:offset], data...)
+	s.uploads[key] = buf
+
+	size := int64(len(buf))
+	if rerr != nil {
+		return size, fmt.Errorf("failed to write data: %w", rerr)
+	}
+	return size, nil
+}
+
+// CommitUpload переносит данные сессии в хранилище и удаляет сессию.
+func (s *blobStorage) CommitUpload(ctx context.Context, userID, uploadID string) (storagePath string, size int64, err error) {
+	key, err := newUploadKey(userID, uploadID)
+	if err != nil {
+		return "", 0, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	data, ok := s.uploads[key]
+	if !ok {
+		return "", 0, fmt.Errorf("upload session not found: %s", uploadID)
+	}
+	delete(s.uploads, key)
+	return s.put(userID, data), int64(len(data)), nil
+}
+
+// List вызывает fn для каждого сохранённого файла в порядке путей.
+// fn вызывается без блокировки хранилища и может удалять файлы.
+func (s *blobStorage) List(ctx context.Context, fn func(obj storage.StoredObject) error) error {
+	s.mu.RLock()
+	objects := make([]storage.StoredObject, 0, len(s.blobs))
+	for p, b := range s.blobs {
+		objects = append(objects, storage.StoredObject{Path: p, Size: int64(len(b.data)), ModTime: b.modTime})
+	}
+	s.mu.RUnlock()
+
+	sort.Slice(objects, func(i, j int) bool { return objects[i].Path < objects[j].Path })
+	for _, obj := range objects {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+		if err := fn(obj); err != nil {
+			return fmt.Errorf("failed to list files: %w", err)
+		}
+	}
+	return nil
+}
+
+// Close ничего не делает: у хранилища в памяти нет фоновых задач.
+func (s *blobStorage) Close() {}
+
+// newUploadKey проверяет идентификатор сессии загрузки. Его задаёт клиент,
+// поэтому, как и в локальном хранилище, допускается только UUID.
+func newUploadKey(userID, uploadID string) (uploadKey, error) {
+	if _, err := uuid.Parse(uploadID); err != nil {
+		return uploadKey{}, fmt.Errorf("invalid upload id: %w", err)
+	}
+	return uploadKey{userID: userID, uploadID: uploadID}, nil
+}
+
+// nopCloser добавляет к io.ReadSeeker пустой метод Close.
+type nopCloser struct {
+	io.ReadSeeker
+}
+
+func (nopCloser) Close() error { return nil }
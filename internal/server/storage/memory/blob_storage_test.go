@@ -0,0 +1,99 @@
+package memory_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobStorage_SaveLoadDelete(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewBlobStorage()
+	defer s.Close()
+
+	path, size, err := s.Save(ctx, "user1", bytes.NewReader([]byte("hello world")))
+	require.NoError(t, err)
+	assert.EqualValues(t, 11, size)
+	assert.Regexp(t, `^user1/[0-9a-f-]{36}\.bin$`, path)
+
+	r, err := s.Load(ctx, path)
+	require.NoError(t, err)
+	_, err = r.Seek(6, io.SeekStart)
+	require.NoError(t, err)
+	rest, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "world", string(rest))
+	require.NoError(t, r.Close())
+
+	var listed []storage.StoredObject
+	require.NoError(t, s.List(ctx, func(obj storage.StoredObject) error {
+		listed = append(listed, obj)
+		return nil
+	}))
+	require.Len(t, listed, 1)
+	assert.Equal(t, path, listed[0].Path)
+	assert.EqualValues(t, 11, listed[0].Size)
+
+	require.NoError(t, s.Delete(ctx, path))
+	require.NoError(t, s.Delete(ctx, path), "удаление отсутствующего файла не ошибка")
+	_, err = s.Load(ctx, path)
+	assert.Error(t, err)
+}
+
+func TestBlobStorage_ResumableUpload(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewBlobStorage()
+	uploadID := uuid.NewString()
+
+	offset, err := s.UploadOffset(ctx, "user1", uploadID)
+	require.NoError(t, err)
+	assert.Zero(t, offset)
+
+	// обрыв передачи: принятые байты остаются в сессии
+	size, err := s.AppendUpload(ctx, "user1", uploadID, 0, io.MultiReader(
+		bytes.NewReader([]byte("hello")), errReader{}))
+	require.Error(t, err)
+	assert.EqualValues(t, 5, size)
+
+	_, err = s.AppendUpload(ctx, "user1", uploadID, 9, bytes.NewReader([]byte("x")))
+	assert.ErrorIs(t, err, storage.ErrUploadOffset)
+
+	// продолжение с меньшего смещения отбрасывает данные после него
+	size, err = s.AppendUpload(ctx, "user1", uploadID, 4, bytes.NewReader([]byte("o world")))
+	require.NoError(t, err)
+	assert.EqualValues(t, 11, size)
+
+	staged, err := s.UploadUsage(ctx, "user1")
+	require.NoError(t, err)
+	assert.EqualValues(t, 11, staged)
+	staged, err = s.UploadUsage(ctx, "user2")
+	require.NoError(t, err)
+	assert.Zero(t, staged)
+
+	path, size, err := s.CommitUpload(ctx, "user1", uploadID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 11, size)
+	r, err := s.Load(ctx, path)
+	require.NoError(t, err)
+	data, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", string(data))
+
+	_, _, err = s.CommitUpload(ctx, "user1", uploadID)
+	assert.Error(t, err, "сессия удаляется после завершения")
+
+	_, err = s.UploadOffset(ctx, "user1", "../escape")
+	assert.Error(t, err)
+}
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) { return 0, errors.New("connection reset") }
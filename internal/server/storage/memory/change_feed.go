@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// changeFeed раздаёт подписчикам уведомления об изменениях записей хранилища.
+type changeFeed struct {
+	store *Store
+}
+
+// NewChangeFeed создаёт источник уведомлений об изменениях записей.
+// Уведомления публикуются сразу после изменения, без опроса.
+func NewChangeFeed(s *Store) repository.ChangeFeed {
+	return &changeFeed{store: s}
+}
+
+// Subscribe подписывается на уведомления об изменениях записей пользователя.
+func (f *changeFeed) Subscribe(ctx context.Context, userID string) (<-chan model.ChangeEvent, error) {
+	return f.store.hub.Subscribe(ctx, userID)
+}
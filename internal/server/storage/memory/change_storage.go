@@ -0,0 +1,81 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// changeStorage — хранилище для чтения журнала изменений.
+//
+// Журнал заполняют сами репозитории записей при каждом изменении
+// (см. state.logChange), так же как триггеры в SQL-хранилищах.
+type changeStorage struct {
+	db access
+}
+
+// NewChangeStorage создаёт хранилище журнала изменений.
+func NewChangeStorage(s *Store) repository.ChangeRepository {
+	return &changeStorage{db: s}
+}
+
+// ListChanges возвращает изменения пользователя после since вместе с содержимым записей.
+//
+// Журнал и записи читаются под одной блокировкой, поэтому содержимое записей
+// соответствует тому же состоянию, что и журнал.
+func (s *changeStorage) ListChanges(ctx context.Context, userID string, since int64, limit int) ([]model.Change, error) {
+	var changes []model.Change
+	err := s.db.read(func(st *state) error {
+		for _, ch := range st.changes[userID] {
+			if ch.Seq > since {
+				changes = append(changes, ch)
+			}
+		}
+		slices.SortFunc(changes, func(a, b model.Change) int {
+			return cmp.Compare(a.Seq, b.Seq)
+		})
+		if limit >= 0 && len(changes) > limit {
+			changes = changes[:limit]
+		}
+		for i := range changes {
+			st.attachItem(&changes[i])
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return changes, nil
+}
+
+// attachItem прикрепляет к изменению копию текущего содержимого записи.
+func (st *state) attachItem(ch *model.Change) {
+	if ch.Deleted {
+		return
+	}
+	switch ch.Type {
+	case model.ItemTypeCredential:
+		if c, ok := st.credentials[ch.ItemID]; ok {
+			c = copyCredential(c)
+			ch.Credential = &c
+		}
+	case model.ItemTypeBankCard:
+		if c, ok := st.bankCards[ch.ItemID]; ok {
+			c = copyBankCard(c)
+			ch.BankCard = &c
+		}
+	case model.ItemTypeTextData:
+		if d, ok := st.textData[ch.ItemID]; ok {
+			d = copyTextData(d)
+			ch.TextData = &d
+		}
+	case model.ItemTypeBinaryData:
+		if d, ok := st.binaryData[ch.ItemID]; ok {
+			d = copyBinaryData(d)
+			ch.BinaryData = &d
+		}
+	}
+}
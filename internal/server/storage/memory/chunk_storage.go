@@ -0,0 +1,217 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// chunkStorage — хранилище сведений о фрагментах файлов и манифестов в памяти.
+type chunkStorage struct {
+	db access
+}
+
+// NewChunkStorage создаёт хранилище фрагментов файлов.
+func NewChunkStorage(s *Store) repository.ChunkRepository {
+	return &chunkStorage{db: s}
+}
+
+// Sizes возвращает размеры имеющихся у пользователя фрагментов из ids.
+func (s *chunkStorage) Sizes(ctx context.Context, userID string, ids []string) (map[string]int64, error) {
+	sizes := make(map[string]int64, len(ids))
+	err := s.db.read(func(st *state) error {
+		for _, id := range ids {
+			if c, ok := st.chunks[chunkKey{userID: userID, id: id}]; ok {
+				sizes[id] = c.Size
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sizes, nil
+}
+
+// Add регистрирует фрагмент, если его ещё нет.
+func (s *chunkStorage) Add(ctx context.Context, chunk *model.Chunk) (bool, error) {
+	var added bool
+	err := s.db.write(func(st *state) error {
+		key := chunkKey{userID: chunk.UserID, id: chunk.ID}
+		if _, ok := st.chunks[key]; ok {
+			return nil
+		}
+		st.chunks[key] = model.Chunk{
+			UserID:      chunk.UserID,
+			ID:          chunk.ID,
+			StoragePath: chunk.StoragePath,
+			Size:        chunk.Size,
+			Checksum:    chunk.Checksum,
+			CreatedAt:   now(),
+		}
+		added = true
+		return nil
+	})
+	return added, err
+}
+
+// Get возвращает фрагмент пользователя по идентификатору.
+func (s *chunkStorage) Get(ctx context.Context, userID, id string) (*model.Chunk, error) {
+	var chunk *model.Chunk
+	err := s.db.read(func(st *state) error {
+		c, ok := st.chunks[chunkKey{userID: userID, id: id}]
+		if !ok {
+			return fmt.Errorf("chunk %s not found", id)
+		}
+		chunk = &c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return chunk, nil
+}
+
+// Manifest возвращает фрагменты файла по порядку.
+func (s *chunkStorage) Manifest(ctx context.Context, userID, binaryID string) ([]model.ChunkRef, error) {
+	var refs []model.ChunkRef
+	err := s.db.read(func(st *state) error {
+		if d, ok := st.binaryData[binaryID]; ok && d.UserID == userID {
+			refs = st.manifestRefs(userID, st.fileManifests[binaryID])
+		}
+		return nil
+	})
+	return refs, err
+}
+
+// StoredRefs возвращает пути файлов и контрольные суммы всех фрагментов.
+func (s *chunkStorage) StoredRefs(ctx context.Context) ([]model.StoredRef, error) {
+	var refs []model.StoredRef
+	err := s.db.read(func(st *state) error {
+		for _, c := range st.chunks {
+			refs = append(refs, model.StoredRef{UserID: c.UserID, ID: c.ID, StoragePath: c.StoragePath, Checksum: c.Checksum, Chunk: true})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// CreateFile сохраняет новую запись с манифестом.
+func (s *chunkStorage) CreateFile(ctx context.Context, data *model.BinaryData, ids []string) error {
+	return s.db.write(func(st *state) error {
+		if err := st.checkChunks(data.UserID, ids); err != nil {
+			return err
+		}
+		st.saveBinary(data)
+		st.replaceManifest(st.fileManifests, data.UserID, data.ID, ids)
+		return nil
+	})
+}
+
+// UpdateFile обновляет запись и заменяет её манифест.
+func (s *chunkStorage) UpdateFile(ctx context.Context, data *model.BinaryData, ids []string) ([]model.Chunk, error) {
+	var released []model.Chunk
+	err := s.db.write(func(st *state) error {
+		if err := st.checkChunks(data.UserID, ids); err != nil {
+			return err
+		}
+		if err := st.updateBinary(data); err != nil {
+			return err
+		}
+		released = st.replaceManifest(st.fileManifests, data.UserID, data.ID, ids)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return released, nil
+}
+
+// DeleteFile удаляет запись и освобождает фрагменты её манифеста.
+func (s *chunkStorage) DeleteFile(ctx context.Context, userID, binaryID string) ([]model.Chunk, error) {
+	var released []model.Chunk
+	err := s.db.write(func(st *state) error {
+		if d, ok := st.binaryData[binaryID]; !ok || d.UserID != userID {
+			return fmt.Errorf("binary data with id %s not found", binaryID)
+		}
+		released = st.replaceManifest(st.fileManifests, userID, binaryID, nil)
+		return st.deleteBinary(userID, binaryID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return released, nil
+}
+
+// checkChunks проверяет, что все фрагменты ids зарегистрированы у пользователя.
+// Вызывается до изменения состояния: манифест может ссылаться только на
+// имеющиеся фрагменты.
+func (st *state) checkChunks(userID string, ids []string) error {
+	for _, id := range ids {
+		if _, ok := st.chunks[chunkKey{userID: userID, id: id}]; !ok {
+			return fmt.Errorf("chunk %s not found", id)
+		}
+	}
+	return nil
+}
+
+// manifestRefs возвращает фрагменты манифеста ids с их размерами.
+func (st *state) manifestRefs(userID string, ids []string) []model.ChunkRef {
+	var refs []model.ChunkRef
+	for _, id := range ids {
+		if c, ok := st.chunks[chunkKey{userID: userID, id: id}]; ok {
+			refs = append(refs, model.ChunkRef{ID: id, Size: c.Size})
+		}
+	}
+	return refs
+}
+
+// replaceManifest заменяет манифест ownerID в manifests на ids, пересчитывает
+// счётчики ссылок и удаляет фрагменты прежнего манифеста, на которые больше
+// никто не ссылается. Возвращает удалённые фрагменты.
+//
+// Фрагменты ids должны быть проверены checkChunks. Только что загруженные
+// фрагменты с нулевым счётчиком не удаляются: они ещё могут войти в
+// манифест, который сохраняется параллельно.
+func (st *state) replaceManifest(manifests map[string][]string, userID, ownerID string, ids []string) []model.Chunk {
+	old := manifests[ownerID]
+	st.addRefs(userID, old, -1)
+	if len(ids) > 0 {
+		manifests[ownerID] = slices.Clone(ids)
+	} else {
+		delete(manifests, ownerID)
+	}
+	st.addRefs(userID, ids, 1)
+
+	var released []model.Chunk
+	seen := make(map[string]bool, len(old))
+	for _, id := range old {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		key := chunkKey{userID: userID, id: id}
+		if c, ok := st.chunks[key]; ok && c.RefCount <= 0 {
+			delete(st.chunks, key)
+			released = append(released, c)
+		}
+	}
+	return released
+}
+
+// addRefs изменяет счётчики ссылок фрагментов ids на delta за каждое вхождение.
+func (st *state) addRefs(userID string, ids []string, delta int64) {
+	for _, id := range ids {
+		key := chunkKey{userID: userID, id: id}
+		if c, ok := st.chunks[key]; ok {
+			c.RefCount += delta
+			st.chunks[key] = c
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// credentialStorage — хранилище учётных данных в памяти.
+type credentialStorage struct {
+	db access
+}
+
+// NewCredentialStorage создаёт хранилище учётных данных.
+func NewCredentialStorage(s *Store) repository.CredentialRepository {
+	return &credentialStorage{db: s}
+}
+
+// Create сохраняет новую запись учётных данных.
+// Идентификатор записи задаёт вызывающий код.
+func (s *credentialStorage) Create(ctx context.Context, cred *model.Credential) error {
+	return s.db.write(func(st *state) error {
+		if _, ok := st.credentials[cred.ID]; ok {
+			return fmt.Errorf("credential with id %s already exists", cred.ID)
+		}
+		ts := now()
+		stored := copyCredential(*cred)
+		stored.Favorite, stored.LastAccessedAt = false, nil
+		stored.CreatedAt, stored.UpdatedAt, stored.Version = ts, ts, 1
+		st.credentials[cred.ID] = stored
+		st.logChange(cred.UserID, model.ItemTypeCredential, cred.ID, false)
+
+		cred.CreatedAt, cred.UpdatedAt, cred.Version = ts, ts, 1
+		return nil
+	})
+}
+
+// GetByID возвращает запись по ID.
+func (s *credentialStorage) GetByID(ctx context.Context, id string) (*model.Credential, error) {
+	var cred *model.Credential
+	err := s.db.read(func(st *state) error {
+		stored, ok := st.credentials[id]
+		if !ok {
+			return errors.New("credential not found")
+		}
+		c := copyCredential(stored)
+		cred = &c
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cred, nil
+}
+
+// GetByUserID возвращает страницу записей пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *credentialStorage) GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
+	var creds []model.Credential
+	err := s.db.read(func(st *state) error {
+		for _, c := range st.credentials {
+			if c.UserID == userID && filter.Match(c.Folder, c.Tags) {
+				creds = append(creds, copyCredential(c))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return paginate(creds, func(c model.Credential) pageKey {
+		return pageKey{id: c.ID, title: c.Title, createdAt: c.CreatedAt, updatedAt: c.UpdatedAt}
+	}, page)
+}
+
+// Update изменяет существующую запись.
+// Если cred.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в cred.
+func (s *credentialStorage) Update(ctx context.Context, cred *model.Credential) error {
+	return s.db.write(func(st *state) error {
+		stored, ok := st.credentials[cred.ID]
+		if !ok || stored.UserID != cred.UserID {
+			return errors.New("credential not found")
+		}
+		if cred.Version != 0 && cred.Version != stored.Version {
+			return model.ErrVersionConflict
+		}
+		stored.Title = cred.Title
+		stored.Login = cred.Login
+		stored.Password = cred.Password
+		stored.Metadata = cred.Metadata
+		stored.Folder = cred.Folder
+		stored.Tags = slices.Clone(cred.Tags)
+		stored.Version++
+		stored.UpdatedAt = now()
+		st.credentials[cred.ID] = stored
+		st.logChange(stored.UserID, model.ItemTypeCredential, cred.ID, false)
+
+		cred.Version, cred.UpdatedAt = stored.Version, stored.UpdatedAt
+		return nil
+	})
+}
+
+// Delete удаляет запись по ID.
+func (s *credentialStorage) Delete(ctx context.Context, id string) error {
+	return s.db.write(func(st *state) error {
+		stored, ok := st.credentials[id]
+		if !ok {
+			return errors.New("credential not found")
+		}
+		delete(st.credentials, id)
+		st.logChange(stored.UserID, model.ItemTypeCredential, id, true)
+		return nil
+	})
+}
+
+// copyCredential возвращает копию записи, не разделяющую с ней срезы и указатели.
+func copyCredential(c model.Credential) model.Credential {
+	c.Tags = slices.Clone(c.Tags)
+	c.LastAccessedAt = cloneTime(c.LastAccessedAt)
+	return c
+}
@@ -0,0 +1,27 @@
+// Package memory предоставляет хранилище данных GophKeeper в памяти
+// процесса — для разработки, демонстраций и тестов, когда не нужны ни
+// база данных, ни диск. Данные не переживают перезапуск сервера.
+//
+// Репозитории повторяют поведение пакетов postgres и sqlite и работают
+// с общим состоянием Store:
+//
+//   - NewUserStorage          — управление пользователями.
+//   - NewCredentialStorage    — хранение пар логин/пароль.
+//   - NewBankCardStorage      — хранение данных банковских карт.
+//   - NewTextDataStorage      — хранение произвольных текстовых данных.
+//   - NewBinaryDataStorage    — хранение метаданных бинарных данных.
+//   - NewChunkStorage         — фрагменты файлов со счётчиками ссылок и манифесты файлов.
+//   - NewBinaryVersionStorage — прежние версии содержимого файлов.
+//   - NewItemStorage          — избранное, недавние записи и объём данных пользователя.
+//   - NewChangeStorage        — чтение журнала изменений.
+//   - NewBatchStorage         — пакетные изменения с точками сохранения.
+//   - NewChangeFeed           — уведомления об изменениях записей.
+//
+// NewBlobStorage — хранилище самих бинарных данных (storage.BinaryDataStorage)
+// в памяти. LoadFixture и LoadFixtureFile заполняют Store начальными данными
+// из JSON (см. Fixture).
+//
+// Журнал изменений ведут сами репозитории, так же как триггеры в
+// SQL-хранилищах. Пакетные изменения выполняются над копией состояния,
+// которая заменяет исходное при успешном завершении пакета.
+package memory
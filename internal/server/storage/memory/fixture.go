@@ -0,0 +1,213 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/crypto"
+)
+
+// Fixture — начальные данные хранилища в памяти (см. LoadFixture).
+type Fixture struct {
+	Users []FixtureUser `json:"users"`
+}
+
+// FixtureUser — пользователь и его записи.
+//
+// Пароль задаётся открытым текстом (password) или готовым хешем
+// (password_hash) вместе с солью (salt). Клиент выводит ключ шифрования
+// из пароля и соли, поэтому заранее зашифрованные записи пользователя
+// расшифруются только при той же соли: для таких пользователей её нужно
+// задать явно. Без соли она генерируется при загрузке.
+type FixtureUser struct {
+	Login        string `json:"login"`
+	Password     string `json:"password,omitempty"`
+	PasswordHash string `json:"password_hash,omitempty"`
+	Salt         string `json:"salt,omitempty"`
+
+	Credentials []FixtureCredential `json:"credentials,omitempty"`
+	BankCards   []FixtureBankCard   `json:"bank_cards,omitempty"`
+	TextData    []FixtureTextData   `json:"text_data,omitempty"`
+}
+
+// FixtureCredential — учётные данные пользователя. Значения полей
+// сохраняются как есть, в том виде, в каком их передаёт клиент.
+type FixtureCredential struct {
+	ID       string     `json:"id,omitempty"` // пусто — сформировать при загрузке
+	Title    string     `json:"title"`
+	Login    string     `json:"login"`
+	Password string     `json:"password"`
+	Metadata string     `json:"metadata,omitempty"`
+	Folder   string     `json:"folder,omitempty"`
+	Tags     model.Tags `json:"tags,omitempty"`
+	Favorite bool       `json:"favorite,omitempty"`
+}
+
+// FixtureBankCard — банковская карта пользователя. Значения полей
+// сохраняются как есть, в том виде, в каком их передаёт клиент.
+type FixtureBankCard struct {
+	Title          string     `json:"title"`
+	CardholderName string     `json:"cardholder_name"`
+	CardNumber     string     `json:"card_number"`
+	ExpiryDate     string     `json:"expiry_date"`
+	CVV            string     `json:"cvv"`
+	Metadata       string     `json:"metadata,omitempty"`
+	Folder         string     `json:"folder,omitempty"`
+	Tags           model.Tags `json:"tags,omitempty"`
+	Favorite       bool       `json:"favorite,omitempty"`
+}
+
+// FixtureTextData — текстовая запись пользователя. Содержимое задаётся
+// в base64, остальные поля сохраняются как есть.
+type FixtureTextData struct {
+	Title    string     `json:"title"`
+	Content  []byte     `json:"content"`
+	Metadata string     `json:"metadata,omitempty"`
+	Folder   string     `json:"folder,omitempty"`
+	Tags     model.Tags `json:"tags,omitempty"`
+	Favorite bool       `json:"favorite,omitempty"`
+}
+
+// LoadFixtureFile загружает в s начальные данные из JSON-файла path.
+func LoadFixtureFile(ctx context.Context, s *Store, path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("failed to open fixture: %w", err)
+	}
+	defer f.Close()
+	return LoadFixture(ctx, s, f)
+}
+
+// LoadFixture загружает в s начальные данные в формате JSON (см. Fixture).
+// Неизвестные поля считаются ошибкой, чтобы опечатка в файле не приводила
+// к молча пропущенным данным.
+func LoadFixture(ctx context.Context, s *Store, r io.Reader) error {
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	var fx Fixture
+	if err := dec.Decode(&fx); err != nil {
+		return fmt.Errorf("failed to parse fixture: %w", err)
+	}
+
+	for i := range fx.Users {
+		if err := loadFixtureUser(ctx, s, &fx.Users[i]); err != nil {
+			return fmt.Errorf("fixture user %q: %w", fx.Users[i].Login, err)
+		}
+	}
+	return nil
+}
+
+// loadFixtureUser создаёт пользователя и его записи через репозитории
+// хранилища, поэтому записи попадают и в журнал изменений.
+func loadFixtureUser(ctx context.Context, s *Store, u *FixtureUser) error {
+	if u.Login == "" {
+		return errors.New("login is required")
+	}
+	hash, salt, err := fixturePassword(u)
+	if err != nil {
+		return err
+	}
+
+	users := NewUserStorage(s)
+	if err := users.CreateUser(ctx, u.Login, hash, salt); err != nil {
+		return err
+	}
+	user, err := users.GetUserByLogin(ctx, u.Login)
+	if err != nil {
+		return err
+	}
+
+	items := NewItemStorage(s)
+	favorite := func(itemType model.ItemType, id string, fav bool) error {
+		if !fav {
+			return nil
+		}
+		return items.SetFavorite(ctx, user.ID, itemType, id, true)
+	}
+
+	for _, c := range u.Credentials {
+		cred := &model.Credential{
+			ID:       c.ID,
+			UserID:   user.ID,
+			Title:    c.Title,
+			Login:    c.Login,
+			Password: c.Password,
+			Metadata: c.Metadata,
+			Folder:   c.Folder,
+			Tags:     c.Tags,
+		}
+		if cred.ID == "" {
+			cred.ID = uuid.NewString()
+		}
+		if err := NewCredentialStorage(s).Create(ctx, cred); err != nil {
+			return err
+		}
+		if err := favorite(model.ItemTypeCredential, cred.ID, c.Favorite); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range u.BankCards {
+		card := &model.BankCard{
+			UserID:         user.ID,
+			Title:          c.Title,
+			CardholderName: c.CardholderName,
+			CardNumber:     c.CardNumber,
+			ExpiryDate:     c.ExpiryDate,
+			CVV:            c.CVV,
+			Metadata:       c.Metadata,
+			Folder:         c.Folder,
+			Tags:           c.Tags,
+		}
+		if err := NewBankCardStorage(s).Create(ctx, card); err != nil {
+			return err
+		}
+		if err := favorite(model.ItemTypeBankCard, card.ID, c.Favorite); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range u.TextData {
+		data := &model.TextData{
+			UserID:   user.ID,
+			Title:    t.Title,
+			Content:  t.Content,
+			Metadata: t.Metadata,
+			Folder:   t.Folder,
+			Tags:     t.Tags,
+		}
+		if err := NewTextDataStorage(s).Create(ctx, data); err != nil {
+			return err
+		}
+		if err := favorite(model.ItemTypeTextData, data.ID, t.Favorite); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fixturePassword возвращает хеш пароля и соль пользователя из начальных данных.
+func fixturePassword(u *FixtureUser) (hash, salt string, err error) {
+	switch {
+	case u.PasswordHash != "":
+		if u.Password != "" {
+			return "", "", errors.New("password and password_hash are mutually exclusive")
+		}
+		if u.Salt == "" {
+			return "", "", errors.New("salt is required with password_hash")
+		}
+		return u.PasswordHash, u.Salt, nil
+	case u.Password == "":
+		return "", "", errors.New("password or password_hash is required")
+	case u.Salt != "":
+		return crypto.HashPasswordWithSalt(u.Password, u.Salt), u.Salt, nil
+	default:
+		return crypto.HashPassword(u.Password)
+	}
+}
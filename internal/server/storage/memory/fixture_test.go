@@ -0,0 +1,86 @@
+package memory_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/crypto"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fixtureJSON = `{
+  "users": [
+    {
+      "login": "demo",
+      "password": "demo-password",
+      "salt": "c2VlZC1zYWx0LTAwMDAwMA==",
+      "credentials": [{"title": "GitHub", "login": "octo", "password": "ciphertext", "favorite": true}],
+      "bank_cards": [{"title": "Visa", "cardholder_name": "DEMO", "card_number": "ciphertext", "expiry_date": "12/30", "cvv": "ciphertext"}],
+      "text_data": [{"title": "note", "content": "AAEC", "folder": "notes", "tags": ["work"]}]
+    },
+    {"login": "empty", "password": "secret"}
+  ]
+}`
+
+func TestLoadFixture(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewStore()
+	require.NoError(t, memory.LoadFixture(ctx, s, strings.NewReader(fixtureJSON)))
+
+	user, err := memory.NewUserStorage(s).GetUserByLogin(ctx, "demo")
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	assert.Equal(t, "c2VlZC1zYWx0LTAwMDAwMA==", user.Salt, "заданная соль сохраняется")
+	assert.True(t, crypto.VerifyPassword("demo-password", user.PasswordHash, user.Salt))
+
+	creds, _, err := memory.NewCredentialStorage(s).GetByUserID(ctx, user.ID, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, "octo", creds[0].Login)
+	assert.True(t, creds[0].Favorite)
+
+	texts, _, err := memory.NewTextDataStorage(s).ListTitles(ctx, user.ID, model.ListFilter{Tags: []string{"work"}}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, texts, 1)
+	text, err := memory.NewTextDataStorage(s).GetByID(ctx, user.ID, texts[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 1, 2}, text.Content)
+
+	// записи начальных данных попадают в журнал, и клиент получает их при синхронизации
+	changes, err := memory.NewChangeStorage(s).ListChanges(ctx, user.ID, 0, 100)
+	require.NoError(t, err)
+	assert.Len(t, changes, 3)
+
+	empty, err := memory.NewUserStorage(s).GetUserByLogin(ctx, "empty")
+	require.NoError(t, err)
+	require.NotNil(t, empty)
+	assert.NotEmpty(t, empty.Salt)
+	assert.True(t, crypto.VerifyPassword("secret", empty.PasswordHash, empty.Salt))
+}
+
+func TestLoadFixture_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"not json":        `[`,
+		"unknown field":   `{"users": [{"login": "a", "password": "p", "pasword": "typo"}]}`,
+		"no login":        `{"users": [{"password": "p"}]}`,
+		"no password":     `{"users": [{"login": "a"}]}`,
+		"hash no salt":    `{"users": [{"login": "a", "password_hash": "h"}]}`,
+		"hash and pass":   `{"users": [{"login": "a", "password": "p", "password_hash": "h", "salt": "s"}]}`,
+		"duplicate login": `{"users": [{"login": "a", "password": "p"}, {"login": "a", "password": "p"}]}`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := memory.LoadFixture(context.Background(), memory.NewStore(), strings.NewReader(data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadFixtureFile_Missing(t *testing.T) {
+	err := memory.LoadFixtureFile(context.Background(), memory.NewStore(), "/nonexistent/seed.json")
+	assert.Error(t, err)
+}
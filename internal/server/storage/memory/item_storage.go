@@ -0,0 +1,211 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// itemStorage — хранилище операций, общих для записей всех типов.
+type itemStorage struct {
+	db access
+}
+
+// NewItemStorage создаёт хранилище общих операций над записями.
+func NewItemStorage(s *Store) repository.ItemRepository {
+	return &itemStorage{db: s}
+}
+
+// SetFavorite устанавливает флаг избранного у записи пользователя.
+// Изменение флага попадает в журнал изменений, как и в SQL-хранилищах.
+func (s *itemStorage) SetFavorite(ctx context.Context, userID string, itemType model.ItemType, id string, favorite bool) error {
+	if err := itemType.Validate(); err != nil {
+		return err
+	}
+	return s.db.write(func(st *state) error {
+		changed, err := st.updateItem(userID, itemType, id, func(fav *bool, _ **time.Time) bool {
+			if *fav == favorite {
+				return false
+			}
+			*fav = favorite
+			return true
+		})
+		if err != nil {
+			return err
+		}
+		if changed {
+			st.logChange(userID, itemType, id, false)
+		}
+		return nil
+	})
+}
+
+// MarkAccessed сохраняет время последнего открытия записи пользователя.
+// Открытие записи изменением не считается и в журнал не попадает.
+func (s *itemStorage) MarkAccessed(ctx context.Context, userID string, itemType model.ItemType, id string, at time.Time) error {
+	if err := itemType.Validate(); err != nil {
+		return err
+	}
+	at = at.UTC()
+	return s.db.write(func(st *state) error {
+		_, err := st.updateItem(userID, itemType, id, func(_ *bool, accessed **time.Time) bool {
+			*accessed = &at
+			return true
+		})
+		return err
+	})
+}
+
+// ListFavoritesRecent возвращает избранные и открывавшиеся записи пользователя всех типов:
+// сначала избранные, затем по убыванию времени последнего открытия.
+func (s *itemStorage) ListFavoritesRecent(ctx context.Context, userID string, limit int) ([]model.ItemSummary, error) {
+	var items []model.ItemSummary
+	add := func(itemType model.ItemType, owner, id, title string, favorite bool, accessed *time.Time) {
+		if owner == userID && (favorite || accessed != nil) {
+			items = append(items, model.ItemSummary{
+				Type:           itemType,
+				ID:             id,
+				Title:          title,
+				Favorite:       favorite,
+				LastAccessedAt: cloneTime(accessed),
+			})
+		}
+	}
+	err := s.db.read(func(st *state) error {
+		for _, c := range st.credentials {
+			add(model.ItemTypeCredential, c.UserID, c.ID, c.Title, c.Favorite, c.LastAccessedAt)
+		}
+		for _, c := range st.bankCards {
+			add(model.ItemTypeBankCard, c.UserID, c.ID, c.Title, c.Favorite, c.LastAccessedAt)
+		}
+		for _, d := range st.textData {
+			add(model.ItemTypeTextData, d.UserID, d.ID, d.Title, d.Favorite, d.LastAccessedAt)
+		}
+		for _, d := range st.binaryData {
+			add(model.ItemTypeBinaryData, d.UserID, d.ID, d.Title, d.Favorite, d.LastAccessedAt)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(items, func(a, b model.ItemSummary) int {
+		if a.Favorite != b.Favorite {
+			if a.Favorite {
+				return -1
+			}
+			return 1
+		}
+		switch {
+		case a.LastAccessedAt == nil && b.LastAccessedAt != nil:
+			return 1
+		case a.LastAccessedAt != nil && b.LastAccessedAt == nil:
+			return -1
+		case a.LastAccessedAt != nil:
+			if c := b.LastAccessedAt.Compare(*a.LastAccessedAt); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	if limit >= 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+// Usage возвращает число записей пользователя и объём его хранимых файлов.
+// Файлы из фрагментов учитываются по фрагментам: общий фрагмент нескольких
+// файлов занимает место один раз. Прежние версии файлов тоже занимают место.
+func (s *itemStorage) Usage(ctx context.Context, userID string) (*model.Usage, error) {
+	var usage model.Usage
+	err := s.db.read(func(st *state) error {
+		for _, d := range st.binaryData {
+			if d.UserID == userID {
+				usage.Items++
+				if !d.Chunked {
+					usage.Bytes += d.Size
+				}
+			}
+		}
+		for _, v := range st.versions {
+			if v.UserID == userID && !v.Chunked {
+				usage.Bytes += v.Size
+			}
+		}
+		for _, c := range st.chunks {
+			if c.UserID == userID {
+				usage.Bytes += c.Size
+			}
+		}
+		for _, c := range st.credentials {
+			if c.UserID == userID {
+				usage.Items++
+			}
+		}
+		for _, c := range st.bankCards {
+			if c.UserID == userID {
+				usage.Items++
+			}
+		}
+		for _, d := range st.textData {
+			if d.UserID == userID {
+				usage.Items++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// updateItem применяет fn к флагу избранного и времени открытия записи
+// пользователя указанного типа. fn сообщает, изменила ли она запись;
+// updateItem возвращает это значение.
+func (st *state) updateItem(userID string, itemType model.ItemType, id string, fn func(favorite *bool, accessed **time.Time) bool) (bool, error) {
+	notFound := fmt.Errorf("%s with id %s not found", itemType, id)
+	switch itemType {
+	case model.ItemTypeCredential:
+		c, ok := st.credentials[id]
+		if !ok || c.UserID != userID {
+			return false, notFound
+		}
+		changed := fn(&c.Favorite, &c.LastAccessedAt)
+		st.credentials[id] = c
+		return changed, nil
+	case model.ItemTypeBankCard:
+		c, ok := st.bankCards[id]
+		if !ok || c.UserID != userID {
+			return false, notFound
+		}
+		changed := fn(&c.Favorite, &c.LastAccessedAt)
+		st.bankCards[id] = c
+		return changed, nil
+	case model.ItemTypeTextData:
+		d, ok := st.textData[id]
+		if !ok || d.UserID != userID {
+			return false, notFound
+		}
+		changed := fn(&d.Favorite, &d.LastAccessedAt)
+		st.textData[id] = d
+		return changed, nil
+	case model.ItemTypeBinaryData:
+		d, ok := st.binaryData[id]
+		if !ok || d.UserID != userID {
+			return false, notFound
+		}
+		changed := fn(&d.Favorite, &d.LastAccessedAt)
+		st.binaryData[id] = d
+		return changed, nil
+	default:
+		return false, fmt.Errorf("unknown item type %q", string(itemType))
+	}
+}
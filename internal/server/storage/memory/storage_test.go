@@ -0,0 +1,65 @@
+package memory_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/memory"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/storagetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageSuite(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) repository.StorageFactory {
+		f := storage.NewMemoryFactory(memory.NewStore())
+		t.Cleanup(func() { _ = f.Close() })
+		return f
+	})
+}
+
+func TestStore_ReturnsCopies(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewStore()
+	repo := memory.NewTextDataStorage(s)
+
+	data := &model.TextData{UserID: "u1", Title: "note", Content: []byte("abc"), Tags: model.Tags{"a"}}
+	require.NoError(t, repo.Create(ctx, data))
+	data.Content[0], data.Tags[0] = 'x', "x"
+
+	got, err := repo.GetByID(ctx, "u1", data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("abc"), got.Content, "хранилище не разделяет срезы с вызывающим кодом")
+	assert.Equal(t, model.Tags{"a"}, got.Tags)
+
+	got.Content[0] = 'y'
+	again, err := repo.GetByID(ctx, "u1", data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("abc"), again.Content)
+}
+
+func TestStore_FailedUpdateLeavesStateUnchanged(t *testing.T) {
+	ctx := context.Background()
+	s := memory.NewStore()
+	chunks := memory.NewChunkStorage(s)
+
+	data := &model.BinaryData{UserID: "u1", Title: "file", Chunked: true}
+	require.NoError(t, chunks.CreateFile(ctx, data, nil))
+
+	// манифест с незарегистрированным фрагментом отклоняется до изменения записи
+	data.Title = "renamed"
+	_, err := chunks.UpdateFile(ctx, data, []string{"missing"})
+	require.Error(t, err)
+
+	got, err := memory.NewBinaryDataStorage(s).GetByID(ctx, "u1", data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "file", got.Title)
+	assert.EqualValues(t, 1, got.Version)
+
+	changes, err := memory.NewChangeStorage(s).ListChanges(ctx, "u1", 0, 10)
+	require.NoError(t, err)
+	assert.Len(t, changes, 1)
+}
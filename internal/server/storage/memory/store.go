@@ -0,0 +1,263 @@
+package memory
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/changefeed"
+)
+
+// Store — общее состояние репозиториев в памяти процесса.
+//
+// Все репозитории одного Store видят одни и те же данные. Изменения
+// выполняются под исключительной блокировкой и применяются целиком либо
+// не применяются вовсе; уведомления об изменениях публикуются после
+// снятия блокировки.
+type Store struct {
+	mu  sync.RWMutex
+	st  *state
+	hub *changefeed.Hub
+}
+
+// NewStore создаёт пустое хранилище.
+func NewStore() *Store {
+	return &Store{st: newState(), hub: changefeed.NewHub()}
+}
+
+// Close закрывает каналы подписчиков на уведомления об изменениях.
+// Данные хранилища остаются доступны.
+func (s *Store) Close() error {
+	s.hub.Close()
+	return nil
+}
+
+// read выполняет fn под разделяемой блокировкой.
+func (s *Store) read(fn func(st *state) error) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return fn(s.st)
+}
+
+// write выполняет fn под исключительной блокировкой и публикует
+// накопленные fn уведомления об изменениях.
+func (s *Store) write(fn func(st *state) error) error {
+	var events []model.ChangeEvent
+	err := func() error {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		err := fn(s.st)
+		events = s.st.takeEvents()
+		return err
+	}()
+
+	s.publish(events)
+	return err
+}
+
+// publish раздаёт уведомления подписчикам.
+func (s *Store) publish(events []model.ChangeEvent) {
+	for _, ev := range events {
+		s.hub.Publish(ev)
+	}
+}
+
+// access — доступ репозитория к состоянию: через Store или внутри
+// транзакции пакета, которая уже удерживает блокировку.
+type access interface {
+	read(fn func(st *state) error) error
+	write(fn func(st *state) error) error
+}
+
+// chunkKey — ключ фрагмента: фрагменты хранятся отдельно для каждого пользователя.
+type chunkKey struct {
+	userID string
+	id     string
+}
+
+// changeKey — ключ записи журнала изменений пользователя.
+type changeKey struct {
+	itemType model.ItemType
+	id       string
+}
+
+// state — данные хранилища. Значения в картах не изменяются на месте,
+// а заменяются целиком, поэтому для копии состояния (clone) достаточно
+// скопировать карты.
+type state struct {
+	users   map[string]model.User // по логину
+	userIDs map[string]string     // логин по идентификатору
+
+	credentials map[string]model.Credential
+	bankCards   map[string]model.BankCard
+	textData    map[string]model.TextData
+	binaryData  map[string]model.BinaryData
+
+	chunks           map[chunkKey]model.Chunk
+	fileManifests    map[string][]string // фрагменты файла по порядку
+	versions         map[string]model.BinaryVersion
+	versionManifests map[string][]string // фрагменты версии по порядку
+
+	changeSeq map[string]int64                      // последний номер изменения пользователя
+	changes   map[string]map[changeKey]model.Change // журнал изменений пользователя
+	events    []model.ChangeEvent                   // уведомления, ожидающие публикации
+}
+
+func newState() *state {
+	return &state{
+		users:            make(map[string]model.User),
+		userIDs:          make(map[string]string),
+		credentials:      make(map[string]model.Credential),
+		bankCards:        make(map[string]model.BankCard),
+		textData:         make(map[string]model.TextData),
+		binaryData:       make(map[string]model.BinaryData),
+		chunks:           make(map[chunkKey]model.Chunk),
+		fileManifests:    make(map[string][]string),
+		versions:         make(map[string]model.BinaryVersion),
+		versionManifests: make(map[string][]string),
+		changeSeq:        make(map[string]int64),
+		changes:          make(map[string]map[changeKey]model.Change),
+	}
+}
+
+// clone возвращает копию состояния, изменения которой не затрагивают исходное.
+func (st *state) clone() *state {
+	c := &state{
+		users:            maps.Clone(st.users),
+		userIDs:          maps.Clone(st.userIDs),
+		credentials:      maps.Clone(st.credentials),
+		bankCards:        maps.Clone(st.bankCards),
+		textData:         maps.Clone(st.textData),
+		binaryData:       maps.Clone(st.binaryData),
+		chunks:           maps.Clone(st.chunks),
+		fileManifests:    maps.Clone(st.fileManifests),
+		versions:         maps.Clone(st.versions),
+		versionManifests: maps.Clone(st.versionManifests),
+		changeSeq:        maps.Clone(st.changeSeq),
+		changes:          make(map[string]map[changeKey]model.Change, len(st.changes)),
+		events:           slices.Clone(st.events),
+	}
+	for userID, log := range st.changes {
+		c.changes[userID] = maps.Clone(log)
+	}
+	return c
+}
+
+// takeEvents возвращает накопленные уведомления и очищает очередь.
+func (st *state) takeEvents() []model.ChangeEvent {
+	events := st.events
+	st.events = nil
+	return events
+}
+
+// logChange записывает изменение записи в журнал пользователя так же, как
+// это делают триггеры SQL-хранилищ: номер изменения увеличивается, и запись
+// журнала об объекте (одна на объект) получает новый номер.
+func (st *state) logChange(userID string, itemType model.ItemType, id string, deleted bool) {
+	st.changeSeq[userID]++
+	seq := st.changeSeq[userID]
+
+	log, ok := st.changes[userID]
+	if !ok {
+		log = make(map[changeKey]model.Change)
+		st.changes[userID] = log
+	}
+	log[changeKey{itemType: itemType, id: id}] = model.Change{
+		Seq:       seq,
+		Type:      itemType,
+		ItemID:    id,
+		Deleted:   deleted,
+		ChangedAt: now(),
+	}
+	st.events = append(st.events, model.ChangeEvent{
+		UserID:  userID,
+		Seq:     seq,
+		Type:    itemType,
+		ItemID:  id,
+		Deleted: deleted,
+	})
+}
+
+// now возвращает текущее время в UTC, как его возвращают SQL-хранилища.
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// cloneTime возвращает копию времени по указателю, чтобы вызывающий код
+// не мог изменить сохранённое значение.
+func cloneTime(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	c := *t
+	return &c
+}
+
+// pageKey — значения записи, по которым строится страница списка.
+type pageKey struct {
+	id        string
+	title     string
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+// paginate сортирует items и возвращает страницу page и токен следующей
+// страницы (пустой, если страница последняя). Порядок и курсор такие же,
+// как у SQL-хранилищ: по полю page.SortBy, затем по id.
+func paginate[T any](items []T, key func(T) pageKey, page model.PageRequest) ([]T, string, error) {
+	if err := page.Validate(); err != nil {
+		return nil, "", err
+	}
+	p := page.Normalize()
+	cursor, err := p.Cursor()
+	if err != nil {
+		return nil, "", err
+	}
+
+	compare := func(a, b pageKey) int {
+		var c int
+		switch p.SortBy {
+		case model.SortByTitle:
+			c = cmp.Compare(a.title, b.title)
+		case model.SortByUpdated:
+			c = a.updatedAt.Compare(b.updatedAt)
+		default:
+			c = a.createdAt.Compare(b.createdAt)
+		}
+		if c == 0 {
+			c = cmp.Compare(a.id, b.id)
+		}
+		if p.Desc {
+			c = -c
+		}
+		return c
+	}
+
+	if cursor != nil {
+		after := pageKey{id: cursor.ID, title: cursor.Value}
+		if p.SortBy != model.SortByTitle {
+			t, err := cursor.Time()
+			if err != nil {
+				return nil, "", err
+			}
+			after.createdAt, after.updatedAt = t, t
+		}
+		items = slices.DeleteFunc(items, func(item T) bool {
+			return compare(key(item), after) <= 0
+		})
+	}
+
+	slices.SortFunc(items, func(a, b T) int {
+		return compare(key(a), key(b))
+	})
+
+	if len(items) <= p.Size {
+		return items, "", nil
+	}
+	items = items[:p.Size]
+	last := key(items[p.Size-1])
+	return items, page.NextToken(last.id, last.title, last.createdAt, last.updatedAt), nil
+}
@@ -0,0 +1,136 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// textDataStorage — хранилище произвольных текстовых данных в памяти.
+type textDataStorage struct {
+	db access
+}
+
+// NewTextDataStorage создаёт хранилище TextData.
+func NewTextDataStorage(s *Store) repository.TextDataRepository {
+	return &textDataStorage{db: s}
+}
+
+// Create сохраняет новую запись TextData.
+func (s *textDataStorage) Create(ctx context.Context, data *model.TextData) error {
+	return s.db.write(func(st *state) error {
+		ts := now()
+		data.ID = uuid.NewString()
+		stored := copyTextData(*data)
+		stored.Favorite, stored.LastAccessedAt = false, nil
+		stored.CreatedAt, stored.UpdatedAt, stored.Version = ts, ts, 1
+		st.textData[data.ID] = stored
+		st.logChange(data.UserID, model.ItemTypeTextData, data.ID, false)
+
+		data.CreatedAt, data.UpdatedAt, data.Version = ts, ts, 1
+		return nil
+	})
+}
+
+// GetByID возвращает полную запись TextData по id и userID.
+func (s *textDataStorage) GetByID(ctx context.Context, userID, id string) (*model.TextData, error) {
+	var data *model.TextData
+	err := s.db.read(func(st *state) error {
+		stored, ok := st.textData[id]
+		if !ok || stored.UserID != userID {
+			return fmt.Errorf("text data with id %s not found", id)
+		}
+		d := copyTextData(stored)
+		data = &d
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Update обновляет существующую запись TextData.
+// Если data.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в data.
+func (s *textDataStorage) Update(ctx context.Context, data *model.TextData) error {
+	return s.db.write(func(st *state) error {
+		stored, ok := st.textData[data.ID]
+		if !ok || stored.UserID != data.UserID {
+			return fmt.Errorf("text data with id %s not found", data.ID)
+		}
+		if data.Version != 0 && data.Version != stored.Version {
+			return model.ErrVersionConflict
+		}
+		stored.Title = data.Title
+		stored.Content = slices.Clone(data.Content)
+		stored.Metadata = data.Metadata
+		stored.Folder = data.Folder
+		stored.Tags = slices.Clone(data.Tags)
+		stored.Version++
+		stored.UpdatedAt = now()
+		st.textData[data.ID] = stored
+		st.logChange(stored.UserID, model.ItemTypeTextData, data.ID, false)
+
+		data.Version, data.UpdatedAt = stored.Version, stored.UpdatedAt
+		return nil
+	})
+}
+
+// Delete удаляет запись TextData по id и userID.
+func (s *textDataStorage) Delete(ctx context.Context, userID, id string) error {
+	return s.db.write(func(st *state) error {
+		stored, ok := st.textData[id]
+		if !ok || stored.UserID != userID {
+			return fmt.Errorf("text data with id %s not found", id)
+		}
+		delete(st.textData, id)
+		st.logChange(userID, model.ItemTypeTextData, id, true)
+		return nil
+	})
+}
+
+// ListTitles возвращает страницу записей пользователя с ID, Title, Folder, Tags,
+// Favorite, LastAccessedAt и временем создания/обновления,
+// удовлетворяющих фильтру, и токен следующей страницы.
+func (s *textDataStorage) ListTitles(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	var list []*model.TextData
+	err := s.db.read(func(st *state) error {
+		for _, d := range st.textData {
+			if d.UserID != userID || !filter.Match(d.Folder, d.Tags) {
+				continue
+			}
+			list = append(list, &model.TextData{
+				ID:             d.ID,
+				Title:          d.Title,
+				Folder:         d.Folder,
+				Tags:           slices.Clone(d.Tags),
+				Favorite:       d.Favorite,
+				LastAccessedAt: cloneTime(d.LastAccessedAt),
+				CreatedAt:      d.CreatedAt,
+				UpdatedAt:      d.UpdatedAt,
+				Version:        d.Version,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	return paginate(list, func(d *model.TextData) pageKey {
+		return pageKey{id: d.ID, title: d.Title, createdAt: d.CreatedAt, updatedAt: d.UpdatedAt}
+	}, page)
+}
+
+// copyTextData возвращает копию записи, не разделяющую с ней срезы и указатели.
+func copyTextData(d model.TextData) model.TextData {
+	d.Content = slices.Clone(d.Content)
+	d.Tags = slices.Clone(d.Tags)
+	d.LastAccessedAt = cloneTime(d.LastAccessedAt)
+	return d
+}
@@ -0,0 +1,50 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// userStorage — хранилище пользователей в памяти.
+type userStorage struct {
+	db access
+}
+
+// NewUserStorage создаёт хранилище пользователей.
+func NewUserStorage(s *Store) repository.UserRepository {
+	return &userStorage{db: s}
+}
+
+// CreateUser сохраняет нового пользователя.
+// Идентификатор пользователя формируется хранилищем.
+//
+// Возвращает ошибку, если логин уже занят.
+func (s *userStorage) CreateUser(ctx context.Context, login, hash, salt string) error {
+	return s.db.write(func(st *state) error {
+		if _, ok := st.users[login]; ok {
+			return fmt.Errorf("user with login %s already exists", login)
+		}
+		user := model.User{ID: uuid.NewString(), Login: login, PasswordHash: hash, Salt: salt}
+		st.users[login] = user
+		st.userIDs[user.ID] = login
+		return nil
+	})
+}
+
+// GetUserByLogin находит пользователя по логину.
+//
+// Возвращает (nil, nil), если пользователь не существует.
+func (s *userStorage) GetUserByLogin(ctx context.Context, login string) (*model.User, error) {
+	var user *model.User
+	err := s.db.read(func(st *state) error {
+		if u, ok := st.users[login]; ok {
+			user = &u
+		}
+		return nil
+	})
+	return user, err
+}
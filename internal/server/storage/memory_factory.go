@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/memory"
+)
+
+// memoryFactory — реализация StorageFactory с хранением данных в памяти процесса.
+type memoryFactory struct {
+	store          *memory.Store
+	userRepo       repository.UserRepository
+	credentialRepo repository.CredentialRepository
+	bankCardRepo   repository.BankCardRepository
+	textDataRepo   repository.TextDataRepository
+	binaryDataRepo repository.BinaryDataRepository
+	chunkRepo      repository.ChunkRepository
+	versionRepo    repository.BinaryVersionRepository
+	itemRepo       repository.ItemRepository
+	changeRepo     repository.ChangeRepository
+	changeFeed     repository.ChangeFeed
+	batchRepo      repository.BatchRepository
+}
+
+// NewMemoryFactory создаёт фабрику memoryFactory с репозиториями,
+// работающими с общим хранилищем store (см. memory.NewStore).
+func NewMemoryFactory(store *memory.Store) repository.StorageFactory {
+	return &memoryFactory{
+		store:          store,
+		userRepo:       memory.NewUserStorage(store),
+		credentialRepo: memory.NewCredentialStorage(store),
+		bankCardRepo:   memory.NewBankCardStorage(store),
+		textDataRepo:   memory.NewTextDataStorage(store),
+		binaryDataRepo: memory.NewBinaryDataStorage(store),
+		chunkRepo:      memory.NewChunkStorage(store),
+		versionRepo:    memory.NewBinaryVersionStorage(store),
+		itemRepo:       memory.NewItemStorage(store),
+		changeRepo:     memory.NewChangeStorage(store),
+		changeFeed:     memory.NewChangeFeed(store),
+		batchRepo:      memory.NewBatchStorage(store),
+	}
+}
+
+// User возвращает репозиторий для работы с пользователями.
+func (f *memoryFactory) User() repository.UserRepository {
+	return f.userRepo
+}
+
+// Credential возвращает репозиторий для работы с Credential.
+func (f *memoryFactory) Credential() repository.CredentialRepository {
+	return f.credentialRepo
+}
+
+// BankCard возвращает репозиторий для работы с BankCard.
+func (f *memoryFactory) BankCard() repository.BankCardRepository {
+	return f.bankCardRepo
+}
+
+// TextData возвращает репозиторий для работы с TextData.
+func (f *memoryFactory) TextData() repository.TextDataRepository {
+	return f.textDataRepo
+}
+
+// BinaryData возвращает репозиторий для работы с BinaryData.
+func (f *memoryFactory) BinaryData() repository.BinaryDataRepository {
+	return f.binaryDataRepo
+}
+
+// Chunk возвращает репозиторий фрагментов файлов и манифестов.
+func (f *memoryFactory) Chunk() repository.ChunkRepository {
+	return f.chunkRepo
+}
+
+// BinaryVersion возвращает репозиторий прежних версий содержимого файлов.
+func (f *memoryFactory) BinaryVersion() repository.BinaryVersionRepository {
+	return f.versionRepo
+}
+
+// Item возвращает репозиторий общих операций над записями (избранное, недавние).
+func (f *memoryFactory) Item() repository.ItemRepository {
+	return f.itemRepo
+}
+
+// Change возвращает репозиторий журнала изменений записей.
+func (f *memoryFactory) Change() repository.ChangeRepository {
+	return f.changeRepo
+}
+
+// ChangeFeed возвращает источник уведомлений об изменениях записей.
+func (f *memoryFactory) ChangeFeed() repository.ChangeFeed {
+	return f.changeFeed
+}
+
+// Batch возвращает репозиторий пакетных изменений записей.
+func (f *memoryFactory) Batch() repository.BatchRepository {
+	return f.batchRepo
+}
+
+// Close закрывает каналы подписчиков на уведомления об изменениях.
+func (f *memoryFactory) Close() error {
+	return f.store.Close()
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -12,7 +13,7 @@ import (
 )
 
 type bankCardStorage struct {
-	db *sqlx.DB
+	db sqlxExecutor
 }
 
 // NewBankCardStorage создаёт новое хранилище банковских карт.
@@ -27,12 +28,15 @@ func (s *bankCardStorage) Create(ctx context.Context, card *model.BankCard) erro
 	card.ID = uuid.NewString()
 	query := `
 		INSERT INTO bank_cards (
-			id, user_id, title, cardholder_name, card_number, expiry_date, cvv, metadata, created_at, updated_at
+			id, user_id, title, cardholder_name, card_number, expiry_date, cvv, metadata, folder, tags, created_at, updated_at
 		) VALUES (
-			:id, :user_id, :title, :cardholder_name, :card_number, :expiry_date, :cvv, :metadata, NOW(), NOW()
+			:id, :user_id, :title, :cardholder_name, :card_number, :expiry_date, :cvv, :metadata, :folder, :tags, NOW(), NOW()
 		)`
-	_, err := s.db.NamedExecContext(ctx, query, card)
-	return err
+	if _, err := s.db.NamedExecContext(ctx, query, card); err != nil {
+		return err
+	}
+	card.Version = 1 // значение по умолчанию столбца version
+	return nil
 }
 
 // GetByID возвращает банковскую карту по её идентификатору.
@@ -45,17 +49,32 @@ func (s *bankCardStorage) GetByID(ctx context.Context, id string) (*model.BankCa
 	return &card, nil
 }
 
-// GetByUser возвращает все банковские карты указанного пользователя.
-func (s *bankCardStorage) GetByUser(ctx context.Context, userID string) ([]model.BankCard, error) {
+// GetByUser возвращает страницу банковских карт указанного пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *bankCardStorage) GetByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
 	var cards []model.BankCard
-	err := s.db.SelectContext(ctx, &cards, "SELECT * FROM bank_cards WHERE user_id = $1 ORDER BY created_at DESC", userID)
+	query, args := applyListFilter("SELECT * FROM bank_cards WHERE user_id = $1", []interface{}{userID}, filter)
+	query, args, err := applyPage(query, args, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+	if err := s.db.SelectContext(ctx, &cards, query, args...); err != nil {
+		return nil, "", err
 	}
-	return cards, nil
+
+	n, more := trimPage(len(cards), page)
+	cards = cards[:n]
+	if !more {
+		return cards, "", nil
+	}
+	last := cards[n-1]
+	return cards, page.NextToken(last.ID, last.Title, last.CreatedAt, last.UpdatedAt), nil
 }
 
 // Update обновляет данные существующей банковской карты.
+// Если card.Version не равна нулю, карта обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в card.
 func (s *bankCardStorage) Update(ctx context.Context, card *model.BankCard) error {
 	query := `
 		UPDATE bank_cards
@@ -65,17 +84,21 @@ func (s *bankCardStorage) Update(ctx context.Context, card *model.BankCard) erro
 		    expiry_date = :expiry_date,
 		    cvv = :cvv,
 		    metadata = :metadata,
+		    folder = :folder,
+		    tags = :tags,
+		    version = version + 1,
 		    updated_at = NOW()
-		WHERE id = :id`
-	res, err := s.db.NamedExecContext(ctx, query, card)
+		WHERE id = :id AND user_id = :user_id AND (CAST(:version AS BIGINT) = 0 OR version = :version)
+		RETURNING version, updated_at`
+	query, args, err := s.db.BindNamed(query, card)
 	if err != nil {
 		return err
 	}
-	rows, _ := res.RowsAffected()
-	if rows == 0 {
-		return fmt.Errorf("bank card with id %s not found", card.ID)
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(&card.Version, &card.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return updateMissError(ctx, s.db, "bank_cards", card.UserID, card.ID, fmt.Errorf("bank card with id %s not found", card.ID))
 	}
-	return nil
+	return err
 }
 
 // Delete удаляет банковскую карту из базы по идентификатору.
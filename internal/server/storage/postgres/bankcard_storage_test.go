@@ -6,6 +6,7 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -39,7 +40,7 @@ func TestBankCardStorage_Create(t *testing.T) {
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO bank_cards`)).
-		WithArgs(sqlmock.AnyArg(), card.UserID, card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata).
+		WithArgs(sqlmock.AnyArg(), card.UserID, card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.Folder, card.Tags).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err := repo.Create(context.Background(), card)
@@ -85,11 +86,11 @@ func TestBankCardStorage_GetByUser(t *testing.T) {
 		AddRow(cards[0].ID, cards[0].UserID, cards[0].Title).
 		AddRow(cards[1].ID, cards[1].UserID, cards[1].Title)
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM bank_cards WHERE user_id = $1 ORDER BY created_at DESC")).
-		WithArgs(userID).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM bank_cards WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2")).
+		WithArgs(userID, model.DefaultPageSize+1).
 		WillReturnRows(rows)
 
-	result, err := repo.GetByUser(context.Background(), userID)
+	result, _, err := repo.GetByUser(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	assert.Equal(t, cards[0].Title, result[0].Title)
@@ -109,12 +110,31 @@ func TestBankCardStorage_Update(t *testing.T) {
 		Metadata:       "{}",
 	}
 
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE bank_cards`)).
-		WithArgs(card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.ID).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	card.Version = 3
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE bank_cards`)).
+		WithArgs(card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.Folder, card.Tags, card.ID, card.UserID, card.Version, card.Version).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(4, time.Now()))
 
 	err := repo.Update(context.Background(), card)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(4), card.Version)
+}
+
+func TestBankCardStorage_Update_VersionConflict(t *testing.T) {
+	db, mock, repo := setupMockDB(t)
+	defer db.Close()
+
+	card := &model.BankCard{ID: uuid.NewString(), Title: "X", Version: 2}
+
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE bank_cards`)).
+		WithArgs(card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.Folder, card.Tags, card.ID, card.UserID, card.Version, card.Version).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM bank_cards WHERE id = $1 AND user_id = $2)`)).
+		WithArgs(card.ID, card.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	err := repo.Update(context.Background(), card)
+	assert.ErrorIs(t, err, model.ErrVersionConflict)
 }
 
 func TestBankCardStorage_Delete(t *testing.T) {
@@ -155,7 +175,7 @@ func TestBankCardStorage_Create_Error(t *testing.T) {
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO bank_cards`)).
-		WithArgs(sqlmock.AnyArg(), card.UserID, card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata).
+		WithArgs(sqlmock.AnyArg(), card.UserID, card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.Folder, card.Tags).
 		WillReturnError(errors.New("insert failed"))
 
 	err := repo.Create(context.Background(), card)
@@ -182,11 +202,11 @@ func TestBankCardStorage_GetByUser_Error(t *testing.T) {
 	defer db.Close()
 
 	userID := uuid.NewString()
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM bank_cards WHERE user_id = $1 ORDER BY created_at DESC")).
-		WithArgs(userID).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM bank_cards WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2")).
+		WithArgs(userID, model.DefaultPageSize+1).
 		WillReturnError(errors.New("select failed"))
 
-	result, err := repo.GetByUser(context.Background(), userID)
+	result, _, err := repo.GetByUser(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.Error(t, err)
 	assert.Nil(t, result)
 }
@@ -197,9 +217,12 @@ func TestBankCardStorage_Update_NotFound(t *testing.T) {
 
 	card := &model.BankCard{ID: uuid.NewString(), Title: "X"}
 
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE bank_cards`)).
-		WithArgs(card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.ID).
-		WillReturnResult(sqlmock.NewResult(0, 0)) // 0 rows affected
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE bank_cards`)).
+		WithArgs(card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.Folder, card.Tags, card.ID, card.UserID, card.Version, card.Version).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"})) // 0 rows affected
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM bank_cards WHERE id = $1 AND user_id = $2)`)).
+		WithArgs(card.ID, card.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 
 	err := repo.Update(context.Background(), card)
 	assert.Error(t, err)
@@ -212,8 +235,8 @@ func TestBankCardStorage_Update_Error(t *testing.T) {
 
 	card := &model.BankCard{ID: uuid.NewString(), Title: "X"}
 
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE bank_cards`)).
-		WithArgs(card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.ID).
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE bank_cards`)).
+		WithArgs(card.Title, card.CardholderName, card.CardNumber, card.ExpiryDate, card.CVV, card.Metadata, card.Folder, card.Tags, card.ID, card.UserID, card.Version, card.Version).
 		WillReturnError(errors.New("update failed"))
 
 	err := repo.Update(context.Background(), card)
@@ -0,0 +1,65 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchStorage_WithinTx_Commit(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := postgres.NewBatchStorage(db)
+
+	data := &model.TextData{ID: uuid.NewString(), UserID: uuid.NewString(), Title: "note"}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SAVEPOINT batch_op_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO text_data`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("RELEASE SAVEPOINT batch_op_1").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("SAVEPOINT batch_op_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("ROLLBACK TO SAVEPOINT batch_op_2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	opErr := errors.New("op failed")
+	err = repo.WithinTx(context.Background(), func(tx repository.BatchTx) error {
+		err := tx.Savepoint(context.Background(), func() error {
+			return tx.TextData().Create(context.Background(), data)
+		})
+		require.NoError(t, err)
+
+		err = tx.Savepoint(context.Background(), func() error { return opErr })
+		assert.ErrorIs(t, err, opErr)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBatchStorage_WithinTx_Rollback(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := postgres.NewBatchStorage(db)
+
+	mock.ExpectBegin()
+	mock.ExpectRollback()
+
+	fnErr := errors.New("abort")
+	err = repo.WithinTx(context.Background(), func(tx repository.BatchTx) error {
+		return fnErr
+	})
+	assert.ErrorIs(t, err, fnErr)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -13,7 +14,7 @@ import (
 
 // binaryDataStorage — хранилище бинарных данных.
 type binaryDataStorage struct {
-	db *sqlx.DB
+	db sqlxExecutor
 }
 
 // NewBinaryDataStorage создаёт новое хранилище BinaryData.
@@ -27,15 +28,23 @@ func (s *binaryDataStorage) Save(ctx context.Context, data *model.BinaryData) er
 	data.ID = uuid.NewString()
 	query := `
 		INSERT INTO binary_data (
-			id, user_id, title, storage_path, client_path, size, metadata, created_at, updated_at
+			id, user_id, title, storage_path, client_path, size, chunked, checksum, plain_checksum, archive,
+			metadata, folder, tags, created_at, updated_at
 		) VALUES (
-				:id, :user_id, :title, :storage_path, :client_path, :size, :metadata, NOW(), NOW()
+				:id, :user_id, :title, :storage_path, :client_path, :size, :chunked, :checksum, :plain_checksum, :archive,
+				:metadata, :folder, :tags, NOW(), NOW()
 		)`
-	_, err := s.db.NamedExecContext(ctx, query, data)
-	return err
+	if _, err := s.db.NamedExecContext(ctx, query, data); err != nil {
+		return err
+	}
+	data.Version = 1 // значение по умолчанию столбца version
+	return nil
 }
 
 // Update изменяет метаданные и пути хранения бинарных данных.
+// Если data.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в data.
 func (s *binaryDataStorage) Update(ctx context.Context, data *model.BinaryData) error {
 	query := `
 		UPDATE binary_data
@@ -43,21 +52,27 @@ func (s *binaryDataStorage) Update(ctx context.Context, data *model.BinaryData)
 			storage_path = :storage_path,
 			client_path = :client_path,
 			size = :size,
+			chunked = :chunked,
+			checksum = :checksum,
+			plain_checksum = :plain_checksum,
+			archive = :archive,
 			metadata = :metadata,
+			folder = :folder,
+			tags = :tags,
+			version = version + 1,
 			updated_at = NOW()
-		WHERE id = :id AND user_id = :user_id`
+		WHERE id = :id AND user_id = :user_id AND (CAST(:version AS BIGINT) = 0 OR version = :version)
+		RETURNING version, updated_at`
 
-	res, err := s.db.NamedExecContext(ctx, query, data)
+	query, args, err := s.db.BindNamed(query, data)
 	if err != nil {
 		return err
 	}
-
-	rows, _ := res.RowsAffected()
-	if rows == 0 {
-		return fmt.Errorf("binary data with id %s not found", data.ID)
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(&data.Version, &data.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return updateMissError(ctx, s.db, "binary_data", data.UserID, data.ID, fmt.Errorf("binary data with id %s not found", data.ID))
 	}
-
-	return nil
+	return err
 }
 
 // GetByID возвращает запись бинарных данных по id и userID.
@@ -71,12 +86,18 @@ func (s *binaryDataStorage) GetByID(ctx context.Context, userID, id string) (*mo
 	return &data, nil
 }
 
-// ListByUser возвращает все бинарные данные конкретного пользователя.
-func (s *binaryDataStorage) ListByUser(ctx context.Context, userID string) ([]*model.BinaryData, error) {
-	query := `SELECT * FROM binary_data WHERE user_id = $1 ORDER BY created_at DESC`
-	rows, err := s.db.QueryxContext(ctx, query, userID)
+// ListByUser возвращает страницу бинарных данных конкретного пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *binaryDataStorage) ListByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.BinaryData, string, error) {
+	query, args := applyListFilter(`SELECT * FROM binary_data WHERE user_id = $1`, []interface{}{userID}, filter)
+	query, args, err := applyPage(query, args, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	rows, err := s.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
 	}
 	defer rows.Close()
 
@@ -84,11 +105,36 @@ func (s *binaryDataStorage) ListByUser(ctx context.Context, userID string) ([]*m
 	for rows.Next() {
 		var data model.BinaryData
 		if err := rows.StructScan(&data); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		list = append(list, &data)
 	}
-	return list, nil
+
+	n, more := trimPage(len(list), page)
+	list = list[:n]
+	if !more {
+		return list, "", nil
+	}
+	last := list[n-1]
+	return list, page.NextToken(last.ID, last.Title, last.CreatedAt, last.UpdatedAt), nil
+}
+
+// StoredRefs возвращает пути файлов и контрольные суммы всех записей и
+// прежних версий, содержимое которых хранится одним файлом.
+func (s *binaryDataStorage) StoredRefs(ctx context.Context) ([]model.StoredRef, error) {
+	var refs []model.StoredRef
+	query := `
+		SELECT user_id, id, storage_path, checksum, FALSE AS version
+		FROM binary_data
+		WHERE storage_path <> '' AND NOT chunked
+		UNION ALL
+		SELECT user_id, id, storage_path, checksum, TRUE AS version
+		FROM binary_versions
+		WHERE storage_path <> '' AND NOT chunked`
+	if err := s.db.SelectContext(ctx, &refs, query); err != nil {
+		return nil, err
+	}
+	return refs, nil
 }
 
 // Delete удаляет запись по id и userID.
@@ -37,10 +37,11 @@ func TestBinaryDataStorage_Save(t *testing.T) {
 		StoragePath: "/tmp/testfile.bin",
 		ClientPath:  "orig/file.bin",
 		Metadata:    "{}",
+		Checksum:    "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_data`)).
-		WithArgs(sqlmock.AnyArg(), data.UserID, data.Title, data.StoragePath, data.ClientPath, data.Size, data.Metadata).
+		WithArgs(sqlmock.AnyArg(), data.UserID, data.Title, data.StoragePath, data.ClientPath, data.Size, data.Chunked, data.Checksum, data.PlainChecksum, data.Archive, data.Metadata, data.Folder, data.Tags).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err := repo.Save(context.Background(), data)
@@ -89,11 +90,11 @@ func TestBinaryDataStorage_ListByUser(t *testing.T) {
 		AddRow(list[0].ID, userID, list[0].Title, list[0].StoragePath, list[0].ClientPath, "{}").
 		AddRow(list[1].ID, userID, list[1].Title, list[1].StoragePath, list[1].ClientPath, "{}")
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM binary_data WHERE user_id = $1 ORDER BY created_at DESC")).
-		WithArgs(userID).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM binary_data WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2")).
+		WithArgs(userID, model.DefaultPageSize+1).
 		WillReturnRows(rows)
 
-	result, err := repo.ListByUser(context.Background(), userID)
+	result, _, err := repo.ListByUser(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 }
@@ -142,7 +143,7 @@ func TestBinaryDataStorage_Save_Error(t *testing.T) {
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_data`)).
-		WithArgs(sqlmock.AnyArg(), data.UserID, data.Title, data.StoragePath, data.ClientPath, data.Size, data.Metadata).
+		WithArgs(sqlmock.AnyArg(), data.UserID, data.Title, data.StoragePath, data.ClientPath, data.Size, data.Chunked, data.Checksum, data.PlainChecksum, data.Archive, data.Metadata, data.Folder, data.Tags).
 		WillReturnError(errors.New("insert failed"))
 
 	err := repo.Save(context.Background(), data)
@@ -168,48 +169,76 @@ func TestBinaryDataStorage_Update(t *testing.T) {
 	}
 
 	// --- 1. Успешное обновление ---
-	mock.ExpectExec(`UPDATE binary_data`).
+	mock.ExpectQuery(`UPDATE binary_data`).
 		WithArgs(
 			data.Title,
 			data.StoragePath,
 			data.ClientPath,
 			data.Size,
+			data.Chunked,
+			data.Checksum,
+			data.PlainChecksum,
+			data.Archive,
 			data.Metadata,
+			data.Folder,
+			data.Tags,
 			data.ID,
 			data.UserID,
+			data.Version,
+			data.Version,
 		).
-		WillReturnResult(sqlmock.NewResult(0, 1)) // 1 строка обновлена
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(2, time.Now())) // 1 строка обновлена
 
 	err := repo.Update(context.Background(), data)
 	require.NoError(t, err)
+	require.Equal(t, int64(2), data.Version)
 
 	// --- 2. Нет обновлённых строк ---
-	mock.ExpectExec(`UPDATE binary_data`).
+	mock.ExpectQuery(`UPDATE binary_data`).
 		WithArgs(
 			data.Title,
 			data.StoragePath,
 			data.ClientPath,
 			data.Size,
+			data.Chunked,
+			data.Checksum,
+			data.PlainChecksum,
+			data.Archive,
 			data.Metadata,
+			data.Folder,
+			data.Tags,
 			data.ID,
 			data.UserID,
+			data.Version,
+			data.Version,
 		).
-		WillReturnResult(sqlmock.NewResult(0, 0)) // 0 строк обновлено
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"})) // 0 строк обновлено
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM binary_data WHERE id = $1 AND user_id = $2)`)).
+		WithArgs(data.ID, data.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 
 	err = repo.Update(context.Background(), data)
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "binary data with id")
 
 	// --- 3. Ошибка БД ---
-	mock.ExpectExec(`UPDATE binary_data`).
+	mock.ExpectQuery(`UPDATE binary_data`).
 		WithArgs(
 			data.Title,
 			data.StoragePath,
 			data.ClientPath,
 			data.Size,
+			data.Chunked,
+			data.Checksum,
+			data.PlainChecksum,
+			data.Archive,
 			data.Metadata,
+			data.Folder,
+			data.Tags,
 			data.ID,
 			data.UserID,
+			data.Version,
+			data.Version,
 		).
 		WillReturnError(sql.ErrConnDone)
 
@@ -219,3 +248,24 @@ func TestBinaryDataStorage_Update(t *testing.T) {
 
 	require.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestBinaryDataStorage_StoredRefs(t *testing.T) {
+	db, mock, repo := setupMockBinaryDataDB(t)
+	defer db.Close()
+
+	rows := sqlmock.NewRows([]string{"user_id", "id", "storage_path", "checksum", "version"}).
+		AddRow("u1", "id1", "u1/a.bin", "abc", false).
+		AddRow("u2", "id2", "u2/b.bin", "", false).
+		AddRow("u1", "v1", "u1/old.bin", "def", true)
+	mock.ExpectQuery(`SELECT user_id, id, storage_path, checksum, FALSE AS version FROM binary_data .* UNION ALL .* FROM binary_versions`).
+		WillReturnRows(rows)
+
+	refs, err := repo.StoredRefs(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []model.StoredRef{
+		{UserID: "u1", ID: "id1", StoragePath: "u1/a.bin", Checksum: "abc"},
+		{UserID: "u2", ID: "id2", StoragePath: "u2/b.bin"},
+		{UserID: "u1", ID: "v1", StoragePath: "u1/old.bin", Checksum: "def", Version: true},
+	}, refs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// binaryVersionStorage — хранилище прежних версий содержимого бинарных данных.
+type binaryVersionStorage struct {
+	db *sqlx.DB
+}
+
+// NewBinaryVersionStorage создаёт хранилище прежних версий бинарных данных.
+// Принимает стандартный *sql.DB и оборачивает его в *sqlx.DB.
+func NewBinaryVersionStorage(db *sql.DB) repository.BinaryVersionRepository {
+	return &binaryVersionStorage{db: sqlx.NewDb(db, "pgx")}
+}
+
+// Save сохраняет версию и её манифест в одной транзакции.
+func (s *binaryVersionStorage) Save(ctx context.Context, v *model.BinaryVersion, ids []string) error {
+	v.ID = uuid.NewString()
+	v.CreatedAt = time.Now()
+	query := `
+		INSERT INTO binary_versions (
+			id, binary_data_id, user_id, storage_path, size, chunked, checksum, plain_checksum,
+			archive, client_path, updated_at, created_at
+		) VALUES (
+			:id, :binary_data_id, :user_id, :storage_path, :size, :chunked, :checksum, :plain_checksum,
+			:archive, :client_path, :updated_at, :created_at
+		)`
+	return withinTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		if _, err := tx.NamedExecContext(ctx, query, v); err != nil {
+			return err
+		}
+		_, err := replaceManifestIn(ctx, tx, versionManifests, v.UserID, v.ID, ids)
+		return err
+	})
+}
+
+// List возвращает версии записи, начиная с последней.
+func (s *binaryVersionStorage) List(ctx context.Context, userID, binaryID string) ([]*model.BinaryVersion, error) {
+	var versions []*model.BinaryVersion
+	query := `
+		SELECT * FROM binary_versions
+		WHERE binary_data_id = $1 AND user_id = $2
+		ORDER BY created_at DESC, id`
+	if err := s.db.SelectContext(ctx, &versions, query, binaryID, userID); err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
+
+// Get возвращает версию записи по идентификатору.
+func (s *binaryVersionStorage) Get(ctx context.Context, userID, binaryID, id string) (*model.BinaryVersion, error) {
+	var v model.BinaryVersion
+	query := `SELECT * FROM binary_versions WHERE id = $1 AND binary_data_id = $2 AND user_id = $3`
+	if err := s.db.GetContext(ctx, &v, query, id, binaryID, userID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrBinaryVersionNotFound
+		}
+		return nil, err
+	}
+	return &v, nil
+}
+
+// Manifest возвращает фрагменты версии по порядку.
+func (s *binaryVersionStorage) Manifest(ctx context.Context, userID, id string) ([]model.ChunkRef, error) {
+	var refs []model.ChunkRef
+	query := `
+		SELECT m.chunk_id, c.size
+		FROM binary_version_chunks m
+		JOIN binary_chunks c ON c.user_id = m.user_id AND c.id = m.chunk_id
+		WHERE m.version_id = $1 AND m.user_id = $2
+		ORDER BY m.seq`
+	if err := s.db.SelectContext(ctx, &refs, query, id, userID); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// Delete удаляет версию и освобождает фрагменты её манифеста.
+func (s *binaryVersionStorage) Delete(ctx context.Context, userID, id string) ([]model.Chunk, error) {
+	var released []model.Chunk
+	err := withinTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		var err error
+		released, err = deleteVersion(ctx, tx, userID, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return released, nil
+}
+
+// Prune удаляет версии записи, кроме keep последних, в одной транзакции.
+func (s *binaryVersionStorage) Prune(ctx context.Context, userID, binaryID string, keep int) ([]*model.BinaryVersion, []model.Chunk, error) {
+	var pruned []*model.BinaryVersion
+	var released []model.Chunk
+	err := withinTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		query := `
+			SELECT * FROM binary_versions
+			WHERE binary_data_id = $1 AND user_id = $2
+			ORDER BY created_at DESC, id
+			OFFSET $3`
+		if err := tx.SelectContext(ctx, &pruned, query, binaryID, userID, keep); err != nil {
+			return err
+		}
+		for _, v := range pruned {
+			chunks, err := deleteVersion(ctx, tx, userID, v.ID)
+			if err != nil {
+				return err
+			}
+			released = append(released, chunks...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return pruned, released, nil
+}
+
+// deleteVersion удаляет версию id в транзакции tx и возвращает
+// освобождённые фрагменты её манифеста.
+func deleteVersion(ctx context.Context, tx *sqlx.Tx, userID, id string) ([]model.Chunk, error) {
+	released, err := replaceManifestIn(ctx, tx, versionManifests, userID, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := tx.ExecContext(ctx, `DELETE FROM binary_versions WHERE id = $1 AND user_id = $2`, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	if rows == 0 {
+		return nil, model.ErrBinaryVersionNotFound
+	}
+	return released, nil
+}
@@ -0,0 +1,128 @@
+package postgres_test
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockVersionDB(t *testing.T) (sqlmock.Sqlmock, repository.BinaryVersionRepository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return mock, postgres.NewBinaryVersionStorage(db)
+}
+
+var versionColumns = []string{
+	"id", "binary_data_id", "user_id", "storage_path", "size", "chunked", "checksum", "plain_checksum",
+	"archive", "client_path", "updated_at", "created_at",
+}
+
+func TestBinaryVersionStorage_SaveChunked(t *testing.T) {
+	mock, repo := setupMockVersionDB(t)
+	v := &model.BinaryVersion{BinaryID: uuid.NewString(), UserID: uuid.NewString(), Chunked: true, Size: 20}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_versions`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT chunk_id FROM binary_version_chunks WHERE version_id = $1`)).
+		WillReturnRows(sqlmock.NewRows([]string{"chunk_id"}))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_version_chunks (version_id, seq, user_id, chunk_id) VALUES ($1, $2, $3, $4)`)).
+		WithArgs(sqlmock.AnyArg(), 0, v.UserID, chunkID('a')).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`SET ref_count = c.ref_count + m.cnt`)).
+		WithArgs(sqlmock.AnyArg(), v.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	require.NoError(t, repo.Save(context.Background(), v, []string{chunkID('a')}))
+	assert.NotEmpty(t, v.ID)
+	assert.False(t, v.CreatedAt.IsZero())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBinaryVersionStorage_ListAndGet(t *testing.T) {
+	mock, repo := setupMockVersionDB(t)
+	userID, binaryID := uuid.NewString(), uuid.NewString()
+	now := time.Now()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM binary_versions WHERE binary_data_id = $1 AND user_id = $2 ORDER BY created_at DESC`)).
+		WithArgs(binaryID, userID).
+		WillReturnRows(sqlmock.NewRows(versionColumns).
+			AddRow("v2", binaryID, userID, "u/v2.bin", 20, false, "s2", "", "", "/f", now, now).
+			AddRow("v1", binaryID, userID, "u/v1.bin", 10, false, "s1", "", "", "/f", now, now))
+	versions, err := repo.List(context.Background(), userID, binaryID)
+	require.NoError(t, err)
+	require.Len(t, versions, 2)
+	assert.Equal(t, "v2", versions[0].ID)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM binary_versions WHERE id = $1`)).
+		WithArgs("v3", binaryID, userID).
+		WillReturnRows(sqlmock.NewRows(versionColumns))
+	_, err = repo.Get(context.Background(), userID, binaryID, "v3")
+	assert.ErrorIs(t, err, model.ErrBinaryVersionNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBinaryVersionStorage_Prune(t *testing.T) {
+	mock, repo := setupMockVersionDB(t)
+	userID, binaryID := uuid.NewString(), uuid.NewString()
+	now := time.Now()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`OFFSET $3`)).
+		WithArgs(binaryID, userID, 1).
+		WillReturnRows(sqlmock.NewRows(versionColumns).
+			AddRow("v1", binaryID, userID, "", 10, true, "", "", "", "/f", now, now))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT chunk_id FROM binary_version_chunks WHERE version_id = $1`)).
+		WithArgs("v1").
+		WillReturnRows(sqlmock.NewRows([]string{"chunk_id"}).AddRow(chunkID('a')))
+	mock.ExpectExec(regexp.QuoteMeta(`SET ref_count = c.ref_count - m.cnt`)).
+		WithArgs("v1", userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM binary_version_chunks WHERE version_id = $1`)).
+		WithArgs("v1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM binary_chunks`)).
+		WithArgs(userID, chunkID('a')).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "id", "storage_path", "size", "ref_count", "created_at"}).
+			AddRow(userID, chunkID('a'), "u/a.bin", 10, 0, now))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM binary_versions WHERE id = $1 AND user_id = $2`)).
+		WithArgs("v1", userID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	pruned, released, err := repo.Prune(context.Background(), userID, binaryID, 1)
+	require.NoError(t, err)
+	require.Len(t, pruned, 1)
+	assert.Equal(t, "v1", pruned[0].ID)
+	require.Len(t, released, 1)
+	assert.Equal(t, "u/a.bin", released[0].StoragePath)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestBinaryVersionStorage_Delete_NotFound(t *testing.T) {
+	mock, repo := setupMockVersionDB(t)
+	userID := uuid.NewString()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT chunk_id FROM binary_version_chunks`)).
+		WillReturnRows(sqlmock.NewRows([]string{"chunk_id"}))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM binary_versions`)).
+		WithArgs("v1", userID).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	_, err := repo.Delete(context.Background(), userID, "v1")
+	assert.ErrorIs(t, err, model.ErrBinaryVersionNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,144 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/logger"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/changefeed"
+	"go.uber.org/zap"
+)
+
+// ChangesChannel — канал NOTIFY, в который триггер журнала изменений
+// отправляет уведомления (см. миграцию 009).
+const ChangesChannel = "item_changes"
+
+// listenRetryDelay — пауза перед повторным подключением после обрыва LISTEN.
+const listenRetryDelay = 2 * time.Second
+
+// ChangeListener получает уведомления об изменениях записей через LISTEN/NOTIFY
+// и раздаёт их подписчикам.
+//
+// NOTIFY доставляется всем сессиям, слушающим канал, поэтому подписчики
+// получают изменения, сделанные любым экземпляром сервера. Для прослушивания
+// из пула берётся одно выделенное соединение; оно занимается при первой
+// подписке и освобождается в Close.
+type ChangeListener struct {
+	db  *sql.DB
+	hub *changefeed.Hub
+
+	once   sync.Once
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewChangeListener создаёт слушатель уведомлений об изменениях.
+// Подключение к каналу выполняется лениво — при первой подписке.
+func NewChangeListener(db *sql.DB) *ChangeListener {
+	return &ChangeListener{
+		db:  db,
+		hub: changefeed.NewHub(),
+	}
+}
+
+// Subscribe подписывается на уведомления об изменениях записей пользователя.
+func (l *ChangeListener) Subscribe(ctx context.Context, userID string) (<-chan model.ChangeEvent, error) {
+	l.once.Do(l.start)
+	return l.hub.Subscribe(ctx, userID)
+}
+
+// Close останавливает прослушивание и закрывает каналы подписчиков.
+func (l *ChangeListener) Close() error {
+	l.once.Do(func() {}) // после Close прослушивание уже не запустится
+	if l.cancel != nil {
+		l.cancel()
+		<-l.done
+	}
+	l.hub.Close()
+	return nil
+}
+
+// start запускает фоновое прослушивание канала.
+func (l *ChangeListener) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	l.cancel = cancel
+	l.done = make(chan struct{})
+	go l.run(ctx)
+}
+
+// run держит подписку на канал, переподключаясь после ошибок.
+//
+// Уведомления, отправленные во время переподключения, теряются; клиенты
+// восполняют их при следующем запросе изменений по курсору.
+func (l *ChangeListener) run(ctx context.Context) {
+	defer close(l.done)
+	for {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		logger.Log.Warn("change listener disconnected", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(listenRetryDelay):
+		}
+	}
+}
+
+// listen выполняет LISTEN на выделенном соединении и публикует полученные уведомления.
+// Возвращает ошибку при обрыве соединения или отмене ctx.
+func (l *ChangeListener) listen(ctx context.Context) error {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	return conn.Raw(func(driverConn any) error {
+		sc, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return fmt.Errorf("unsupported driver connection %T", driverConn)
+		}
+		pgConn := sc.Conn()
+
+		if _, err := pgConn.Exec(ctx, "LISTEN "+ChangesChannel); err != nil {
+			return err
+		}
+		// Соединение вернётся в пул, поэтому снимаем подписку (если оно ещё живо)
+		defer func() { _, _ = pgConn.Exec(context.Background(), "UNLISTEN "+ChangesChannel) }()
+
+		for {
+			n, err := pgConn.WaitForNotification(ctx)
+			if err != nil {
+				return err
+			}
+			ev, err := parseChangeEvent(n.Payload)
+			if err != nil {
+				logger.Log.Warn("invalid change notification", zap.String("payload", n.Payload), zap.Error(err))
+				continue
+			}
+			l.hub.Publish(ev)
+		}
+	})
+}
+
+// parseChangeEvent разбирает JSON-полезную нагрузку уведомления.
+func parseChangeEvent(payload string) (model.ChangeEvent, error) {
+	var ev model.ChangeEvent
+	if err := json.Unmarshal([]byte(payload), &ev); err != nil {
+		return model.ChangeEvent{}, err
+	}
+	if ev.UserID == "" || ev.ItemID == "" {
+		return model.ChangeEvent{}, errors.New("user_id and id are required")
+	}
+	return ev, nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseChangeEvent(t *testing.T) {
+	ev, err := parseChangeEvent(`{"user_id":"u1","seq":7,"type":"bank_card","id":"b1","deleted":true}`)
+	require.NoError(t, err)
+	assert.Equal(t, model.ChangeEvent{
+		UserID:  "u1",
+		Seq:     7,
+		Type:    model.ItemTypeBankCard,
+		ItemID:  "b1",
+		Deleted: true,
+	}, ev)
+
+	_, err = parseChangeEvent(`not json`)
+	assert.Error(t, err)
+
+	_, err = parseChangeEvent(`{"seq":1,"id":"b1"}`)
+	assert.Error(t, err)
+}
+
+func TestChangeListener_SubscribeAndClose(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// Соединение sqlmock не поддерживает LISTEN: слушатель уходит в цикл
+	// переподключения, что не мешает подписке и остановке.
+	l := NewChangeListener(db)
+	ch, err := l.Subscribe(context.Background(), "u1")
+	require.NoError(t, err)
+
+	l.hub.Publish(model.ChangeEvent{UserID: "u1", Seq: 1, ItemID: "c1"})
+	ev := <-ch
+	assert.Equal(t, int64(1), ev.Seq)
+
+	closed := make(chan struct{})
+	go func() {
+		_ = l.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("Close did not stop listener")
+	}
+
+	_, ok := <-ch
+	assert.False(t, ok)
+}
+
+func TestChangeListener_CloseWithoutSubscribe(t *testing.T) {
+	l := NewChangeListener(nil)
+	require.NoError(t, l.Close())
+
+	ch, err := l.Subscribe(context.Background(), "u1")
+	require.NoError(t, err)
+	_, ok := <-ch
+	assert.False(t, ok)
+}
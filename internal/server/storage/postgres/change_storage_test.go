@@ -0,0 +1,92 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const changeLogQuery = `SELECT seq, item_type, item_id, deleted, changed_at FROM change_log WHERE user_id = $1 AND seq > $2 ORDER BY seq LIMIT $3`
+
+func TestChangeStorage_ListChanges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := postgres.NewChangeStorage(db)
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(changeLogQuery)).
+		WithArgs("user1", int64(10), 100).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "item_type", "item_id", "deleted", "changed_at"}).
+			AddRow(11, "credential", "c1", false, now).
+			AddRow(12, "bank_card", "b1", true, now).
+			AddRow(13, "text_data", "t1", false, now))
+
+	mock.ExpectQuery(`SELECT t\.id, t\.user_id, .* FROM credentials t JOIN change_log l`).
+		WithArgs("credential", "user1", int64(10), int64(13)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "title", "login", "password", "metadata", "folder", "tags", "favorite", "last_accessed_at", "created_at", "updated_at", "version",
+		}).AddRow("c1", "user1", "GitHub", "l", "p", "m", "", "", false, nil, now, now, 1))
+
+	mock.ExpectQuery(`SELECT t\.\* FROM text_data t JOIN change_log l`).
+		WithArgs("text_data", "user1", int64(10), int64(13)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "title", "content", "metadata", "created_at", "updated_at"}).
+			AddRow("t1", "user1", "Note", []byte("x"), "", now, now))
+	mock.ExpectCommit()
+
+	changes, err := repo.ListChanges(context.Background(), "user1", 10, 100)
+	require.NoError(t, err)
+	require.Len(t, changes, 3)
+
+	assert.Equal(t, int64(11), changes[0].Seq)
+	require.NotNil(t, changes[0].Credential)
+	assert.Equal(t, "GitHub", changes[0].Credential.Title)
+
+	assert.True(t, changes[1].Deleted)
+	assert.Nil(t, changes[1].BankCard)
+
+	require.NotNil(t, changes[2].TextData)
+	assert.Equal(t, "Note", changes[2].TextData.Title)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChangeStorage_ListChanges_Empty(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := postgres.NewChangeStorage(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(changeLogQuery)).
+		WithArgs("user1", int64(0), 10).
+		WillReturnRows(sqlmock.NewRows([]string{"seq", "item_type", "item_id", "deleted", "changed_at"}))
+	mock.ExpectRollback()
+
+	changes, err := repo.ListChanges(context.Background(), "user1", 0, 10)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChangeStorage_ListChanges_QueryError(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := postgres.NewChangeStorage(db)
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(changeLogQuery)).WillReturnError(errors.New("db error"))
+	mock.ExpectRollback()
+
+	_, err = repo.ListChanges(context.Background(), "user1", 0, 10)
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,274 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// chunkBatch ограничивает число идентификаторов фрагментов в одном запросе,
+// чтобы не превысить предел числа параметров PostgreSQL на больших файлах.
+const chunkBatch = 1000
+
+// chunkStorage — хранилище сведений о фрагментах файлов и манифестов.
+type chunkStorage struct {
+	db *sqlx.DB
+}
+
+// NewChunkStorage создаёт хранилище фрагментов файлов.
+// Принимает стандартный *sql.DB и оборачивает его в *sqlx.DB.
+func NewChunkStorage(db *sql.DB) repository.ChunkRepository {
+	return &chunkStorage{db: sqlx.NewDb(db, "pgx")}
+}
+
+// Sizes возвращает размеры имеющихся у пользователя фрагментов из ids.
+func (s *chunkStorage) Sizes(ctx context.Context, userID string, ids []string) (map[string]int64, error) {
+	sizes := make(map[string]int64, len(ids))
+	err := inChunkBatches(ids, func(batch []string) error {
+		query, args, err := sqlx.In(`SELECT id, size FROM binary_chunks WHERE user_id = ? AND id IN (?)`, userID, batch)
+		if err != nil {
+			return err
+		}
+		rows, err := s.db.QueryContext(ctx, sqlx.Rebind(sqlx.DOLLAR, query), args...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		for rows.Next() {
+			var id string
+			var size int64
+			if err := rows.Scan(&id, &size); err != nil {
+				return err
+			}
+			sizes[id] = size
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sizes, nil
+}
+
+// Add регистрирует фрагмент, если его ещё нет.
+func (s *chunkStorage) Add(ctx context.Context, chunk *model.Chunk) (bool, error) {
+	res, err := s.db.ExecContext(ctx, `
+		INSERT INTO binary_chunks (user_id, id, storage_path, size, checksum, created_at)
+		VALUES ($1, $2, $3, $4, $5, NOW())
+		ON CONFLICT (user_id, id) DO NOTHING`,
+		chunk.UserID, chunk.ID, chunk.StoragePath, chunk.Size, chunk.Checksum)
+	if err != nil {
+		return false, err
+	}
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows == 1, nil
+}
+
+// Get возвращает фрагмент пользователя по идентификатору.
+func (s *chunkStorage) Get(ctx context.Context, userID, id string) (*model.Chunk, error) {
+	var chunk model.Chunk
+	query := `SELECT * FROM binary_chunks WHERE user_id = $1 AND id = $2`
+	if err := s.db.GetContext(ctx, &chunk, query, userID, id); err != nil {
+		return nil, err
+	}
+	return &chunk, nil
+}
+
+// Manifest возвращает фрагменты файла по порядку.
+func (s *chunkStorage) Manifest(ctx context.Context, userID, binaryID string) ([]model.ChunkRef, error) {
+	var refs []model.ChunkRef
+	query := `
+		SELECT m.chunk_id, c.size
+		FROM binary_data_chunks m
+		JOIN binary_chunks c ON c.user_id = m.user_id AND c.id = m.chunk_id
+		WHERE m.binary_data_id = $1 AND m.user_id = $2
+		ORDER BY m.seq`
+	if err := s.db.SelectContext(ctx, &refs, query, binaryID, userID); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// StoredRefs возвращает пути файлов и контрольные суммы всех фрагментов.
+func (s *chunkStorage) StoredRefs(ctx context.Context) ([]model.StoredRef, error) {
+	var refs []model.StoredRef
+	query := `SELECT user_id, id, storage_path, checksum FROM binary_chunks`
+	if err := s.db.SelectContext(ctx, &refs, query); err != nil {
+		return nil, err
+	}
+	for i := range refs {
+		refs[i].Chunk = true
+	}
+	return refs, nil
+}
+
+// CreateFile сохраняет новую запись с манифестом в одной транзакции.
+func (s *chunkStorage) CreateFile(ctx context.Context, data *model.BinaryData, ids []string) error {
+	return withinTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		if err := (&binaryDataStorage{db: tx}).Save(ctx, data); err != nil {
+			return err
+		}
+		_, err := replaceManifest(ctx, tx, data.UserID, data.ID, ids)
+		return err
+	})
+}
+
+// UpdateFile обновляет запись и заменяет её манифест в одной транзакции.
+func (s *chunkStorage) UpdateFile(ctx context.Context, data *model.BinaryData, ids []string) ([]model.Chunk, error) {
+	var released []model.Chunk
+	err := withinTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		if err := (&binaryDataStorage{db: tx}).Update(ctx, data); err != nil {
+			return err
+		}
+		var err error
+		released, err = replaceManifest(ctx, tx, data.UserID, data.ID, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return released, nil
+}
+
+// DeleteFile удаляет запись и освобождает фрагменты её манифеста.
+func (s *chunkStorage) DeleteFile(ctx context.Context, userID, binaryID string) ([]model.Chunk, error) {
+	var released []model.Chunk
+	err := withinTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		var err error
+		if released, err = replaceManifest(ctx, tx, userID, binaryID, nil); err != nil {
+			return err
+		}
+		return (&binaryDataStorage{db: tx}).Delete(ctx, userID, binaryID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return released, nil
+}
+
+// withinTx выполняет fn в транзакции db и фиксирует её, если fn не вернула ошибку.
+func withinTx(ctx context.Context, db *sqlx.DB, fn func(tx *sqlx.Tx) error) error {
+	tx, err := db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }() // после Commit откат ничего не делает
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// manifestTable — таблица манифестов: файлов или их прежних версий.
+type manifestTable struct {
+	name  string // имя таблицы
+	owner string // столбец с идентификатором файла или версии
+}
+
+var (
+	fileManifests    = manifestTable{name: "binary_data_chunks", owner: "binary_data_id"}
+	versionManifests = manifestTable{name: "binary_version_chunks", owner: "version_id"}
+)
+
+// refCountQuery изменяет счётчики ссылок фрагментов на число их вхождений
+// в манифест; %[1]s — знак изменения, %[2]s и %[3]s — таблица манифестов
+// и столбец владельца.
+const refCountQuery = `
+	UPDATE binary_chunks c SET ref_count = c.ref_count %[1]s m.cnt
+	FROM (
+		SELECT chunk_id, COUNT(*) AS cnt FROM %[2]s
+		WHERE %[3]s = $1 GROUP BY chunk_id
+	) m
+	WHERE c.user_id = $2 AND c.id = m.chunk_id`
+
+// replaceManifest заменяет манифест файла binaryID на ids, пересчитывает
+// счётчики ссылок и удаляет фрагменты прежнего манифеста, на которые больше
+// никто не ссылается. Возвращает удалённые фрагменты.
+//
+// Только что загруженные фрагменты с нулевым счётчиком не удаляются: они
+// ещё могут войти в манифест, который сохраняется параллельно.
+func replaceManifest(ctx context.Context, tx sqlxExecutor, userID, binaryID string, ids []string) ([]model.Chunk, error) {
+	return replaceManifestIn(ctx, tx, fileManifests, userID, binaryID, ids)
+}
+
+// replaceManifestIn — replaceManifest для манифеста ownerID в таблице m.
+func replaceManifestIn(ctx context.Context, tx sqlxExecutor, m manifestTable, userID, ownerID string, ids []string) ([]model.Chunk, error) {
+	var old []string
+	err := tx.SelectContext(ctx, &old, fmt.Sprintf(`SELECT DISTINCT chunk_id FROM %s WHERE %s = $1`, m.name, m.owner), ownerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(old) > 0 {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(refCountQuery, "-", m.name, m.owner), ownerID, userID); err != nil {
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE %s = $1`, m.name, m.owner), ownerID); err != nil {
+			return nil, err
+		}
+	}
+
+	for start := 0; start < len(ids); start += chunkBatch {
+		end := min(start+chunkBatch, len(ids))
+		var sb strings.Builder
+		fmt.Fprintf(&sb, `INSERT INTO %s (%s, seq, user_id, chunk_id) VALUES `, m.name, m.owner)
+		args := make([]any, 0, 4*(end-start))
+		for i := start; i < end; i++ {
+			if i > start {
+				sb.WriteString(", ")
+			}
+			n := len(args)
+			fmt.Fprintf(&sb, "($%d, $%d, $%d, $%d)", n+1, n+2, n+3, n+4)
+			args = append(args, ownerID, i, userID, ids[i])
+		}
+		if _, err := tx.ExecContext(ctx, sb.String(), args...); err != nil {
+			return nil, err
+		}
+	}
+	if len(ids) > 0 {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(refCountQuery, "+", m.name, m.owner), ownerID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	var released []model.Chunk
+	err = inChunkBatches(old, func(batch []string) error {
+		query, args, err := sqlx.In(`
+			DELETE FROM binary_chunks
+			WHERE user_id = ? AND ref_count <= 0 AND id IN (?)
+			RETURNING *`, userID, batch)
+		if err != nil {
+			return err
+		}
+		var chunks []model.Chunk
+		if err := tx.SelectContext(ctx, &chunks, sqlx.Rebind(sqlx.DOLLAR, query), args...); err != nil {
+			return err
+		}
+		released = append(released, chunks...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return released, nil
+}
+
+// inChunkBatches вызывает fn для последовательных частей ids длиной не больше chunkBatch.
+func inChunkBatches(ids []string, fn func(batch []string) error) error {
+	for start := 0; start < len(ids); start += chunkBatch {
+		if err := fn(ids[start:min(start+chunkBatch, len(ids))]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package postgres_test
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockChunkDB(t *testing.T) (sqlmock.Sqlmock, repository.ChunkRepository) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+	return mock, postgres.NewChunkStorage(db)
+}
+
+func chunkID(c byte) string {
+	return strings.Repeat(string(c), model.ChunkIDLen)
+}
+
+func TestChunkStorage_Sizes(t *testing.T) {
+	mock, repo := setupMockChunkDB(t)
+	userID := uuid.NewString()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, size FROM binary_chunks WHERE user_id = $1 AND id IN ($2, $3)`)).
+		WithArgs(userID, chunkID('a'), chunkID('b')).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "size"}).AddRow(chunkID('a'), 100))
+
+	sizes, err := repo.Sizes(context.Background(), userID, []string{chunkID('a'), chunkID('b')})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{chunkID('a'): 100}, sizes)
+
+	// Пустой список — без запроса
+	sizes, err = repo.Sizes(context.Background(), userID, nil)
+	require.NoError(t, err)
+	assert.Empty(t, sizes)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChunkStorage_Add(t *testing.T) {
+	mock, repo := setupMockChunkDB(t)
+	chunk := &model.Chunk{UserID: uuid.NewString(), ID: chunkID('a'), StoragePath: "u/a.bin", Size: 10, Checksum: "sum"}
+
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_chunks`)).
+		WithArgs(chunk.UserID, chunk.ID, chunk.StoragePath, chunk.Size, chunk.Checksum).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	added, err := repo.Add(context.Background(), chunk)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	// Фрагмент уже зарегистрирован
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_chunks`)).
+		WithArgs(chunk.UserID, chunk.ID, chunk.StoragePath, chunk.Size, chunk.Checksum).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	added, err = repo.Add(context.Background(), chunk)
+	require.NoError(t, err)
+	assert.False(t, added)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChunkStorage_GetAndManifest(t *testing.T) {
+	mock, repo := setupMockChunkDB(t)
+	userID, binaryID := uuid.NewString(), uuid.NewString()
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT * FROM binary_chunks WHERE user_id = $1 AND id = $2`)).
+		WithArgs(userID, chunkID('a')).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "id", "storage_path", "size", "ref_count", "created_at"}).
+			AddRow(userID, chunkID('a'), "u/a.bin", 10, 2, time.Now()))
+	chunk, err := repo.Get(context.Background(), userID, chunkID('a'))
+	require.NoError(t, err)
+	assert.Equal(t, "u/a.bin", chunk.StoragePath)
+	assert.Equal(t, int64(2), chunk.RefCount)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM binary_data_chunks m`)).
+		WithArgs(binaryID, userID).
+		WillReturnRows(sqlmock.NewRows([]string{"chunk_id", "size"}).
+			AddRow(chunkID('a'), 10).
+			AddRow(chunkID('b'), 20))
+	refs, err := repo.Manifest(context.Background(), userID, binaryID)
+	require.NoError(t, err)
+	assert.Equal(t, []model.ChunkRef{{ID: chunkID('a'), Size: 10}, {ID: chunkID('b'), Size: 20}}, refs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChunkStorage_CreateFile(t *testing.T) {
+	mock, repo := setupMockChunkDB(t)
+	data := &model.BinaryData{UserID: uuid.NewString(), Title: "file", Chunked: true, Size: 30}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_data`)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT chunk_id FROM binary_data_chunks`)).
+		WillReturnRows(sqlmock.NewRows([]string{"chunk_id"}))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_data_chunks (binary_data_id, seq, user_id, chunk_id) VALUES ($1, $2, $3, $4), ($5, $6, $7, $8)`)).
+		WithArgs(sqlmock.AnyArg(), 0, data.UserID, chunkID('a'), sqlmock.AnyArg(), 1, data.UserID, chunkID('a')).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`SET ref_count = c.ref_count + m.cnt`)).
+		WithArgs(sqlmock.AnyArg(), data.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.CreateFile(context.Background(), data, []string{chunkID('a'), chunkID('a')})
+	require.NoError(t, err)
+	assert.NotEmpty(t, data.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChunkStorage_UpdateFile_ReleasesChunks(t *testing.T) {
+	mock, repo := setupMockChunkDB(t)
+	data := &model.BinaryData{ID: uuid.NewString(), UserID: uuid.NewString(), Chunked: true, Version: 1}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`UPDATE binary_data`).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(2, time.Now()))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT chunk_id FROM binary_data_chunks`)).
+		WithArgs(data.ID).
+		WillReturnRows(sqlmock.NewRows([]string{"chunk_id"}).AddRow(chunkID('a')).AddRow(chunkID('b')))
+	mock.ExpectExec(regexp.QuoteMeta(`SET ref_count = c.ref_count - m.cnt`)).
+		WithArgs(data.ID, data.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM binary_data_chunks WHERE binary_data_id = $1`)).
+		WithArgs(data.ID).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO binary_data_chunks`)).
+		WithArgs(data.ID, 0, data.UserID, chunkID('b')).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta(`SET ref_count = c.ref_count + m.cnt`)).
+		WithArgs(data.ID, data.UserID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`DELETE FROM binary_chunks`)).
+		WithArgs(data.UserID, chunkID('a'), chunkID('b')).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "id", "storage_path", "size", "ref_count", "created_at"}).
+			AddRow(data.UserID, chunkID('a'), "u/a.bin", 10, 0, time.Now()))
+	mock.ExpectCommit()
+
+	released, err := repo.UpdateFile(context.Background(), data, []string{chunkID('b')})
+	require.NoError(t, err)
+	require.Len(t, released, 1)
+	assert.Equal(t, "u/a.bin", released[0].StoragePath)
+	assert.Equal(t, int64(2), data.Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChunkStorage_DeleteFile_RollbackOnError(t *testing.T) {
+	mock, repo := setupMockChunkDB(t)
+	userID, binaryID := uuid.NewString(), uuid.NewString()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT DISTINCT chunk_id FROM binary_data_chunks`)).
+		WillReturnRows(sqlmock.NewRows([]string{"chunk_id"}))
+	mock.ExpectExec(regexp.QuoteMeta(`DELETE FROM binary_data WHERE id = $1 AND user_id = $2`)).
+		WithArgs(binaryID, userID).
+		WillReturnError(errors.New("db down"))
+	mock.ExpectRollback()
+
+	_, err := repo.DeleteFile(context.Background(), userID, binaryID)
+	require.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChunkStorage_StoredRefs(t *testing.T) {
+	mock, repo := setupMockChunkDB(t)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT user_id, id, storage_path, checksum FROM binary_chunks`)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "id", "storage_path", "checksum"}).
+			AddRow("u1", chunkID('a'), "u1/a.bin", "sum"))
+
+	refs, err := repo.StoredRefs(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []model.StoredRef{{UserID: "u1", ID: chunkID('a'), StoragePath: "u1/a.bin", Checksum: "sum", Chunk: true}}, refs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -10,7 +10,7 @@ import (
 
 // PostgresStorage реализует интерфейс CredentialRepository для PostgreSQL
 type PostgresStorage struct {
-	db *sql.DB
+	db sqlExecutor
 }
 
 // NewCredentialStorage создаёт новый экземпляр PostgresStorage.
@@ -21,8 +21,8 @@ func NewCredentialStorage(db *sql.DB) *PostgresStorage {
 // Create сохраняет новую запись учётных данных
 func (s *PostgresStorage) Create(ctx context.Context, cred *model.Credential) error {
 	query := `
-		INSERT INTO credentials (id, user_id, title, login, password, metadata, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		INSERT INTO credentials (id, user_id, title, login, password, metadata, folder, tags, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
 	`
 	_, err := s.db.ExecContext(ctx, query,
 		cred.ID,
@@ -31,14 +31,20 @@ func (s *PostgresStorage) Create(ctx context.Context, cred *model.Credential) er
 		cred.Login,
 		cred.Password,
 		cred.Metadata,
+		cred.Folder,
+		cred.Tags,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+	cred.Version = 1 // значение по умолчанию столбца version
+	return nil
 }
 
 // GetByID возвращает запись по ID
 func (s *PostgresStorage) GetByID(ctx context.Context, id string) (*model.Credential, error) {
 	query := `
-		SELECT id, user_id, title, login, password, metadata, created_at, updated_at
+		SELECT id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
 		FROM credentials WHERE id = $1
 	`
 
@@ -51,8 +57,13 @@ func (s *PostgresStorage) GetByID(ctx context.Context, id string) (*model.Creden
 		&cred.Login,
 		&cred.Password,
 		&cred.Metadata,
+		&cred.Folder,
+		&cred.Tags,
+		&cred.Favorite,
+		&cred.LastAccessedAt,
 		&cred.CreatedAt,
 		&cred.UpdatedAt,
+		&cred.Version,
 	)
 	if err == sql.ErrNoRows {
 		return nil, errors.New("credential not found")
@@ -63,15 +74,20 @@ func (s *PostgresStorage) GetByID(ctx context.Context, id string) (*model.Creden
 	return &cred, nil
 }
 
-// GetByUserID возвращает все записи пользователя
-func (s *PostgresStorage) GetByUserID(ctx context.Context, userID string) ([]model.Credential, error) {
-	query := `
-		SELECT id, user_id, title, login, password, metadata, created_at, updated_at
-		FROM credentials WHERE user_id = $1 ORDER BY created_at DESC
-	`
-	rows, err := s.db.QueryContext(ctx, query, userID)
+// GetByUserID возвращает страницу записей пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *PostgresStorage) GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
+	query, args := applyListFilter(`
+		SELECT id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
+		FROM credentials WHERE user_id = $1`, []interface{}{userID}, filter)
+	query, args, err := applyPage(query, args, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
 	}
 	defer rows.Close()
 
@@ -85,44 +101,58 @@ func (s *PostgresStorage) GetByUserID(ctx context.Context, userID string) ([]mod
 			&cred.Login,
 			&cred.Password,
 			&cred.Metadata,
+			&cred.Folder,
+			&cred.Tags,
+			&cred.Favorite,
+			&cred.LastAccessedAt,
 			&cred.CreatedAt,
 			&cred.UpdatedAt,
+			&cred.Version,
 		); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		creds = append(creds, cred)
 	}
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	n, more := trimPage(len(creds), page)
+	creds = creds[:n]
+	if !more {
+		return creds, "", nil
 	}
-	return creds, nil
+	last := creds[n-1]
+	return creds, page.NextToken(last.ID, last.Title, last.CreatedAt, last.UpdatedAt), nil
 }
 
-// Update изменяет существующую запись
+// Update изменяет существующую запись.
+// Если cred.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в cred.
 func (s *PostgresStorage) Update(ctx context.Context, cred *model.Credential) error {
 	query := `
 		UPDATE credentials
-		SET title = $1, login = $2, password = $3, metadata = $4, updated_at = NOW()
-		WHERE id = $5
+		SET title = $1, login = $2, password = $3, metadata = $4, folder = $5, tags = $6,
+		    version = version + 1, updated_at = NOW()
+		WHERE id = $7 AND user_id = $9 AND ($8::bigint = 0 OR version = $8)
+		RETURNING version, updated_at
 	`
-	res, err := s.db.ExecContext(ctx, query,
+	err := s.db.QueryRowContext(ctx, query,
 		cred.Title,
 		cred.Login,
 		cred.Password,
 		cred.Metadata,
+		cred.Folder,
+		cred.Tags,
 		cred.ID,
-	)
-	if err != nil {
-		return err
-	}
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		return err
-	}
-	if rowsAffected == 0 {
-		return errors.New("credential not found")
+		cred.Version,
+		cred.UserID,
+	).Scan(&cred.Version, &cred.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return updateMissError(ctx, s.db, "credentials", cred.UserID, cred.ID, errors.New("credential not found"))
 	}
-	return nil
+	return err
 }
 
 // Delete удаляет запись по ID
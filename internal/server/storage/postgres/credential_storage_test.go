@@ -11,6 +11,7 @@ import (
 	"github.com/ryabkov82/gophkeeper/internal/domain/model"
 	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCreateCredential_Success(t *testing.T) {
@@ -30,10 +31,10 @@ func TestCreateCredential_Success(t *testing.T) {
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(`
-		INSERT INTO credentials (id, user_id, title, login, password, metadata, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, NOW(), NOW())
+		INSERT INTO credentials (id, user_id, title, login, password, metadata, folder, tags, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW(), NOW())
 	`)).
-		WithArgs(cred.ID, cred.UserID, cred.Title, cred.Login, cred.Password, cred.Metadata).
+		WithArgs(cred.ID, cred.UserID, cred.Title, cred.Login, cred.Password, cred.Metadata, cred.Folder, cred.Tags).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err = storage.Create(context.Background(), cred)
@@ -52,11 +53,11 @@ func TestGetCredentialByID_Success(t *testing.T) {
 	updatedAt := createdAt
 
 	rows := sqlmock.NewRows([]string{
-		"id", "user_id", "title", "login", "password", "metadata", "created_at", "updated_at",
-	}).AddRow("uuid-1234", "user-uuid", "GitHub", "login123", "encryptedpass", "some meta", createdAt, updatedAt)
+		"id", "user_id", "title", "login", "password", "metadata", "folder", "tags", "favorite", "last_accessed_at", "created_at", "updated_at", "version",
+	}).AddRow("uuid-1234", "user-uuid", "GitHub", "login123", "encryptedpass", "some meta", "Work", "t1,t2", true, updatedAt, createdAt, updatedAt, 3)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, user_id, title, login, password, metadata, created_at, updated_at
+		SELECT id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
 		FROM credentials WHERE id = $1
 	`)).
 		WithArgs("uuid-1234").
@@ -69,10 +70,15 @@ func TestGetCredentialByID_Success(t *testing.T) {
 	assert.Equal(t, "user-uuid", cred.UserID)
 	assert.Equal(t, "GitHub", cred.Title)
 	assert.Equal(t, "login123", cred.Login)
+	assert.Equal(t, "Work", cred.Folder)
+	assert.Equal(t, model.Tags{"t1", "t2"}, cred.Tags)
+	assert.True(t, cred.Favorite)
+	require.NotNil(t, cred.LastAccessedAt)
 	assert.Equal(t, "encryptedpass", cred.Password)
 	assert.Equal(t, "some meta", cred.Metadata)
 	assert.WithinDuration(t, createdAt, cred.CreatedAt, time.Second)
 	assert.WithinDuration(t, updatedAt, cred.UpdatedAt, time.Second)
+	assert.Equal(t, int64(3), cred.Version)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -84,7 +90,7 @@ func TestGetCredentialByID_NotFound(t *testing.T) {
 	storage := postgres.NewCredentialStorage(db)
 
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, user_id, title, login, password, metadata, created_at, updated_at
+		SELECT id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
 		FROM credentials WHERE id = $1
 	`)).
 		WithArgs("non-existent-id").
@@ -112,16 +118,19 @@ func TestUpdateCredential_Success(t *testing.T) {
 		Metadata: "updated meta",
 	}
 
-	mock.ExpectExec(regexp.QuoteMeta(`
+	mock.ExpectQuery(regexp.QuoteMeta(`
 		UPDATE credentials
-		SET title = $1, login = $2, password = $3, metadata = $4, updated_at = NOW()
-		WHERE id = $5
+		SET title = $1, login = $2, password = $3, metadata = $4, folder = $5, tags = $6,
+		    version = version + 1, updated_at = NOW()
+		WHERE id = $7 AND user_id = $9 AND ($8::bigint = 0 OR version = $8)
+		RETURNING version, updated_at
 	`)).
-		WithArgs(cred.Title, cred.Login, cred.Password, cred.Metadata, cred.ID).
-		WillReturnResult(sqlmock.NewResult(1, 1))
+		WithArgs(cred.Title, cred.Login, cred.Password, cred.Metadata, cred.Folder, cred.Tags, cred.ID, cred.Version, cred.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(2, time.Now()))
 
 	err = storage.Update(context.Background(), cred)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(2), cred.Version)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
@@ -140,13 +149,18 @@ func TestUpdateCredential_NotFound(t *testing.T) {
 		Metadata: "updated meta",
 	}
 
-	mock.ExpectExec(regexp.QuoteMeta(`
+	mock.ExpectQuery(regexp.QuoteMeta(`
 		UPDATE credentials
-		SET title = $1, login = $2, password = $3, metadata = $4, updated_at = NOW()
-		WHERE id = $5
+		SET title = $1, login = $2, password = $3, metadata = $4, folder = $5, tags = $6,
+		    version = version + 1, updated_at = NOW()
+		WHERE id = $7 AND user_id = $9 AND ($8::bigint = 0 OR version = $8)
+		RETURNING version, updated_at
 	`)).
-		WithArgs(cred.Title, cred.Login, cred.Password, cred.Metadata, cred.ID).
-		WillReturnResult(sqlmock.NewResult(0, 0)) // 0 rows affected
+		WithArgs(cred.Title, cred.Login, cred.Password, cred.Metadata, cred.Folder, cred.Tags, cred.ID, cred.Version, cred.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"})) // 0 rows affected
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM credentials WHERE id = $1 AND user_id = $2)`)).
+		WithArgs(cred.ID, cred.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 
 	err = storage.Update(context.Background(), cred)
 	assert.Error(t, err)
@@ -154,6 +168,28 @@ func TestUpdateCredential_NotFound(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestUpdateCredential_VersionConflict(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	storage := postgres.NewCredentialStorage(db)
+
+	cred := &model.Credential{ID: "uuid-1234", Title: "Updated Title", Version: 5}
+
+	mock.ExpectQuery(`UPDATE credentials`).
+		WithArgs(cred.Title, cred.Login, cred.Password, cred.Metadata, cred.Folder, cred.Tags, cred.ID, cred.Version, cred.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM credentials WHERE id = $1 AND user_id = $2)`)).
+		WithArgs(cred.ID, cred.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+	err = storage.Update(context.Background(), cred)
+	assert.ErrorIs(t, err, model.ErrVersionConflict)
+	assert.Equal(t, int64(5), cred.Version, "version must stay unchanged on conflict")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestDeleteCredential_Success(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	assert.NoError(t, err)
@@ -200,21 +236,21 @@ func TestPostgresStorage_GetByUserID(t *testing.T) {
 
 	// Создаем ожидаемые строки результата
 	rows := sqlmock.NewRows([]string{
-		"id", "user_id", "title", "login", "password", "metadata", "created_at", "updated_at",
+		"id", "user_id", "title", "login", "password", "metadata", "folder", "tags", "favorite", "last_accessed_at", "created_at", "updated_at", "version",
 	}).AddRow(
-		"cred1", userID, "Title1", "login1", "pass1", "meta1", createdAt, updatedAt,
+		"cred1", userID, "Title1", "login1", "pass1", "meta1", "", "", false, nil, createdAt, updatedAt, 1,
 	).AddRow(
-		"cred2", userID, "Title2", "login2", "pass2", "meta2", createdAt, updatedAt,
+		"cred2", userID, "Title2", "login2", "pass2", "meta2", "", "", false, nil, createdAt, updatedAt, 1,
 	)
 
 	// Ожидаемый SQL запрос
 	mock.ExpectQuery(regexp.QuoteMeta(`
-		SELECT id, user_id, title, login, password, metadata, created_at, updated_at
-		FROM credentials WHERE user_id = $1 ORDER BY created_at DESC
-	`)).WithArgs(userID).WillReturnRows(rows)
+		SELECT id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
+		FROM credentials WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2
+	`)).WithArgs(userID, model.DefaultPageSize+1).WillReturnRows(rows)
 
 	ctx := context.Background()
-	creds, err := s.GetByUserID(ctx, userID)
+	creds, _, err := s.GetByUserID(ctx, userID, model.ListFilter{}, model.PageRequest{})
 
 	assert.NoError(t, err)
 	assert.Len(t, creds, 2)
@@ -231,3 +267,94 @@ func TestPostgresStorage_GetByUserID(t *testing.T) {
 	err = mock.ExpectationsWereMet()
 	assert.NoError(t, err)
 }
+
+func TestPostgresStorage_GetByUserID_WithFilter(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+
+	s := postgres.NewCredentialStorage(db)
+
+	userID := "user123"
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{
+		"id", "user_id", "title", "login", "password", "metadata", "folder", "tags", "favorite", "last_accessed_at", "created_at", "updated_at", "version",
+	}).AddRow("cred1", userID, "Title1", "login1", "pass1", "meta1", "Work", "t1,t2", false, nil, now, now, 1)
+
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
+		FROM credentials WHERE user_id = $1 AND folder = $2
+		AND position(',' || $3 || ',' in ',' || tags || ',') > 0
+		AND position(',' || $4 || ',' in ',' || tags || ',') > 0
+		ORDER BY created_at DESC, id DESC LIMIT $5
+	`)).WithArgs(userID, "Work", "t1", "t2", model.DefaultPageSize+1).WillReturnRows(rows)
+
+	filter := model.ListFilter{Folder: "Work", Tags: []string{"t1", "t2"}}
+	creds, _, err := s.GetByUserID(context.Background(), userID, filter, model.PageRequest{})
+
+	assert.NoError(t, err)
+	assert.Len(t, creds, 1)
+	assert.Equal(t, "Work", creds[0].Folder)
+	assert.Equal(t, model.Tags{"t1", "t2"}, creds[0].Tags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStorage_GetByUserID_Pagination(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := postgres.NewCredentialStorage(db)
+
+	userID := "user123"
+	now := time.Now()
+	columns := []string{
+		"id", "user_id", "title", "login", "password", "metadata", "folder", "tags", "favorite", "last_accessed_at", "created_at", "updated_at", "version",
+	}
+
+	// Первая страница: запрашивается на одну запись больше размера страницы
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
+		FROM credentials WHERE user_id = $1 ORDER BY title ASC, id ASC LIMIT $2
+	`)).WithArgs(userID, 2).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow("cred1", userID, "Alpha", "l", "p", "m", "", "", false, nil, now, now, 1).
+		AddRow("cred2", userID, "Beta", "l", "p", "m", "", "", false, nil, now, now, 1))
+
+	page := model.PageRequest{Size: 1, SortBy: model.SortByTitle}
+	creds, next, err := s.GetByUserID(context.Background(), userID, model.ListFilter{}, page)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, "cred1", creds[0].ID)
+	require.NotEmpty(t, next)
+
+	// Вторая страница: курсор (title, id) последней записи первой страницы
+	mock.ExpectQuery(regexp.QuoteMeta(`
+		SELECT id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
+		FROM credentials WHERE user_id = $1 AND (title, id) > ($2, $3) ORDER BY title ASC, id ASC LIMIT $4
+	`)).WithArgs(userID, "Alpha", "cred1", 2).WillReturnRows(sqlmock.NewRows(columns).
+		AddRow("cred2", userID, "Beta", "l", "p", "m", "", "", false, nil, now, now, 1))
+
+	page.Token = next
+	creds, next, err = s.GetByUserID(context.Background(), userID, model.ListFilter{}, page)
+	require.NoError(t, err)
+	require.Len(t, creds, 1)
+	assert.Equal(t, "cred2", creds[0].ID)
+	assert.Empty(t, next)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresStorage_GetByUserID_InvalidPage(t *testing.T) {
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := postgres.NewCredentialStorage(db)
+
+	_, _, err = s.GetByUserID(context.Background(), "user123", model.ListFilter{}, model.PageRequest{Token: "broken"})
+	assert.ErrorIs(t, err, model.ErrInvalidPageToken)
+
+	_, _, err = s.GetByUserID(context.Background(), "user123", model.ListFilter{}, model.PageRequest{SortBy: "size"})
+	assert.Error(t, err)
+}
@@ -6,8 +6,11 @@
 //   - UserStorage       — управление пользователями.
 //   - TextDataStorage   — хранение произвольных текстовых данных.
 //   - BinaryDataStorage — хранение бинарных данных (ссылки на файлы).
+//   - ChunkStorage      — фрагменты файлов со счётчиками ссылок и манифесты файлов.
 //   - BankCardStorage   — хранение данных банковских карт.
 //   - CredentialStorage — хранение пар логин/пароль.
+//   - BatchStorage      — транзакция пакетных изменений с точками сохранения.
+//   - ChangeListener    — уведомления об изменениях записей через LISTEN/NOTIFY.
 //
 // Реализации используют библиотеку sqlx для удобной работы с SQL-запросами
 // и поддерживают:
@@ -0,0 +1,93 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// applyListFilter дополняет WHERE-часть запроса условиями фильтра по папке и тегам.
+//
+// query должен заканчиваться условием WHERE (без ORDER BY), args — уже
+// использованные позиционные аргументы. Новые плейсхолдеры нумеруются
+// продолжением args. Теги хранятся в колонке tags через запятую, поэтому
+// каждый тег фильтра ищется как отдельный элемент списка.
+func applyListFilter(query string, args []interface{}, filter model.ListFilter) (string, []interface{}) {
+	var sb strings.Builder
+	sb.WriteString(query)
+
+	if filter.Folder != "" {
+		args = append(args, filter.Folder)
+		fmt.Fprintf(&sb, " AND folder = $%d", len(args))
+	}
+	for _, tag := range filter.Tags {
+		args = append(args, tag)
+		fmt.Fprintf(&sb, " AND position(',' || $%d || ',' in ',' || tags || ',') > 0", len(args))
+	}
+
+	return sb.String(), args
+}
+
+// pageColumns сопоставляет поля сортировки с колонками таблиц записей.
+var pageColumns = map[model.SortField]string{
+	model.SortByCreated: "created_at",
+	model.SortByUpdated: "updated_at",
+	model.SortByTitle:   "title",
+}
+
+// applyPage дополняет запрос условием курсора, сортировкой и ограничением размера страницы.
+//
+// query должен заканчиваться условием WHERE (без ORDER BY), таблица должна
+// содержать колонку id. Сортировка выполняется по полю page.SortBy и затем по id,
+// поэтому порядок записей однозначен и курсор (значение поля, id) указывает
+// точную позицию в списке. Запрашивается на одну запись больше размера страницы,
+// чтобы определить наличие следующей страницы (см. trimPage).
+func applyPage(query string, args []interface{}, page model.PageRequest) (string, []interface{}, error) {
+	if err := page.Validate(); err != nil {
+		return "", nil, err
+	}
+	page = page.Normalize()
+	column := pageColumns[page.SortBy]
+
+	cursor, err := page.Cursor()
+	if err != nil {
+		return "", nil, err
+	}
+
+	op, dir := ">", "ASC"
+	if page.Desc {
+		op, dir = "<", "DESC"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(query)
+
+	if cursor != nil {
+		var value interface{} = cursor.Value
+		if page.SortBy != model.SortByTitle {
+			t, err := cursor.Time()
+			if err != nil {
+				return "", nil, err
+			}
+			value = t
+		}
+		args = append(args, value, cursor.ID)
+		fmt.Fprintf(&sb, " AND (%s, id) %s ($%d, $%d)", column, op, len(args)-1, len(args))
+	}
+
+	args = append(args, page.Size+1)
+	fmt.Fprintf(&sb, " ORDER BY %s %s, id %s LIMIT $%d", column, dir, dir, len(args))
+
+	return sb.String(), args, nil
+}
+
+// trimPage сообщает, вернул ли запрос, построенный applyPage, больше записей,
+// чем помещается на страницу, и возвращает количество записей текущей страницы.
+func trimPage(n int, page model.PageRequest) (int, bool) {
+	size := page.Normalize().Size
+	if n > size {
+		return size, true
+	}
+	return n, false
+}
@@ -0,0 +1,121 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// itemTables сопоставляет типы записей с таблицами, в которых они хранятся.
+var itemTables = map[model.ItemType]string{
+	model.ItemTypeCredential: "credentials",
+	model.ItemTypeBankCard:   "bank_cards",
+	model.ItemTypeTextData:   "text_data",
+	model.ItemTypeBinaryData: "binary_data",
+}
+
+// itemStorage — хранилище операций, общих для записей всех типов.
+type itemStorage struct {
+	db *sqlx.DB
+}
+
+// NewItemStorage создаёт новое хранилище общих операций над записями.
+// Принимает стандартный *sql.DB и оборачивает его в *sqlx.DB.
+func NewItemStorage(db *sql.DB) repository.ItemRepository {
+	sqlxDB := sqlx.NewDb(db, "pgx")
+	return &itemStorage{db: sqlxDB}
+}
+
+// SetFavorite устанавливает флаг избранного у записи пользователя.
+func (s *itemStorage) SetFavorite(ctx context.Context, userID string, itemType model.ItemType, id string, favorite bool) error {
+	table, err := itemTable(itemType)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`UPDATE %s SET favorite = $1 WHERE id = $2 AND user_id = $3`, table)
+	return s.execItem(ctx, itemType, id, query, favorite, id, userID)
+}
+
+// MarkAccessed сохраняет время последнего открытия записи пользователя.
+func (s *itemStorage) MarkAccessed(ctx context.Context, userID string, itemType model.ItemType, id string, at time.Time) error {
+	table, err := itemTable(itemType)
+	if err != nil {
+		return err
+	}
+	query := fmt.Sprintf(`UPDATE %s SET last_accessed_at = $1 WHERE id = $2 AND user_id = $3`, table)
+	return s.execItem(ctx, itemType, id, query, at, id, userID)
+}
+
+// ListFavoritesRecent возвращает избранные и открывавшиеся записи пользователя всех типов.
+func (s *itemStorage) ListFavoritesRecent(ctx context.Context, userID string, limit int) ([]model.ItemSummary, error) {
+	query := `
+		SELECT item_type, id, title, favorite, last_accessed_at FROM (
+			SELECT 'credential' AS item_type, id, title, favorite, last_accessed_at
+			FROM credentials WHERE user_id = $1 AND (favorite OR last_accessed_at IS NOT NULL)
+			UNION ALL
+			SELECT 'bank_card', id, title, favorite, last_accessed_at
+			FROM bank_cards WHERE user_id = $1 AND (favorite OR last_accessed_at IS NOT NULL)
+			UNION ALL
+			SELECT 'text_data', id, title, favorite, last_accessed_at
+			FROM text_data WHERE user_id = $1 AND (favorite OR last_accessed_at IS NOT NULL)
+			UNION ALL
+			SELECT 'binary_data', id, title, favorite, last_accessed_at
+			FROM binary_data WHERE user_id = $1 AND (favorite OR last_accessed_at IS NOT NULL)
+		) AS items
+		ORDER BY favorite DESC, last_accessed_at DESC NULLS LAST
+		LIMIT $2`
+
+	var items []model.ItemSummary
+	if err := s.db.SelectContext(ctx, &items, query, userID, limit); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// Usage возвращает число записей пользователя и объём его хранимых файлов.
+// Файлы из фрагментов учитываются по фрагментам: общий фрагмент нескольких
+// файлов занимает место один раз. Прежние версии файлов тоже занимают место.
+func (s *itemStorage) Usage(ctx context.Context, userID string) (*model.Usage, error) {
+	query := `
+		SELECT
+			(SELECT COALESCE(SUM(size), 0) FROM binary_data WHERE user_id = $1 AND NOT chunked) +
+			(SELECT COALESCE(SUM(size), 0) FROM binary_versions WHERE user_id = $1 AND NOT chunked) +
+			(SELECT COALESCE(SUM(size), 0) FROM binary_chunks WHERE user_id = $1) AS bytes,
+			(SELECT COUNT(*) FROM credentials WHERE user_id = $1) +
+			(SELECT COUNT(*) FROM bank_cards WHERE user_id = $1) +
+			(SELECT COUNT(*) FROM text_data WHERE user_id = $1) +
+			(SELECT COUNT(*) FROM binary_data WHERE user_id = $1) AS items`
+
+	var usage model.Usage
+	if err := s.db.GetContext(ctx, &usage, query, userID); err != nil {
+		return nil, err
+	}
+	return &usage, nil
+}
+
+// execItem выполняет UPDATE одной записи и проверяет, что она была найдена.
+func (s *itemStorage) execItem(ctx context.Context, itemType model.ItemType, id, query string, args ...interface{}) error {
+	res, err := s.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("%s with id %s not found", itemType, id)
+	}
+	return nil
+}
+
+// itemTable возвращает имя таблицы для указанного типа записи.
+func itemTable(itemType model.ItemType) (string, error) {
+	table, ok := itemTables[itemType]
+	if !ok {
+		return "", fmt.Errorf("unknown item type %q", string(itemType))
+	}
+	return table, nil
+}
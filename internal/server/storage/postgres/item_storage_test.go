@@ -0,0 +1,112 @@
+package postgres_test
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockItemDB(t *testing.T) (*sql.DB, sqlmock.Sqlmock, repository.ItemRepository) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open sqlmock: %v", err)
+	}
+	return db, mock, postgres.NewItemStorage(db)
+}
+
+func TestItemStorage_SetFavorite(t *testing.T) {
+	db, mock, repo := setupMockItemDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE bank_cards SET favorite = $1 WHERE id = $2 AND user_id = $3")).
+		WithArgs(true, "card1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.SetFavorite(context.Background(), "user1", model.ItemTypeBankCard, "card1", true)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemStorage_SetFavorite_NotFound(t *testing.T) {
+	db, mock, repo := setupMockItemDB(t)
+	defer db.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE credentials SET favorite = $1 WHERE id = $2 AND user_id = $3")).
+		WithArgs(false, "cred1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.SetFavorite(context.Background(), "user1", model.ItemTypeCredential, "cred1", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestItemStorage_SetFavorite_UnknownType(t *testing.T) {
+	db, _, repo := setupMockItemDB(t)
+	defer db.Close()
+
+	err := repo.SetFavorite(context.Background(), "user1", model.ItemType("users"), "id1", true)
+	assert.Error(t, err)
+}
+
+func TestItemStorage_MarkAccessed(t *testing.T) {
+	db, mock, repo := setupMockItemDB(t)
+	defer db.Close()
+
+	at := time.Now()
+	mock.ExpectExec(regexp.QuoteMeta("UPDATE text_data SET last_accessed_at = $1 WHERE id = $2 AND user_id = $3")).
+		WithArgs(at, "note1", "user1").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.MarkAccessed(context.Background(), "user1", model.ItemTypeTextData, "note1", at)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemStorage_ListFavoritesRecent(t *testing.T) {
+	db, mock, repo := setupMockItemDB(t)
+	defer db.Close()
+
+	accessed := time.Now()
+	rows := sqlmock.NewRows([]string{"item_type", "id", "title", "favorite", "last_accessed_at"}).
+		AddRow("credential", "cred1", "GitHub", true, nil).
+		AddRow("binary_data", "file1", "passport.pdf", false, accessed)
+
+	mock.ExpectQuery(`SELECT item_type, id, title, favorite, last_accessed_at FROM \(.*UNION ALL.*\) AS items ORDER BY favorite DESC, last_accessed_at DESC NULLS LAST LIMIT \$2`).
+		WithArgs("user1", 10).
+		WillReturnRows(rows)
+
+	items, err := repo.ListFavoritesRecent(context.Background(), "user1", 10)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, model.ItemTypeCredential, items[0].Type)
+	assert.True(t, items[0].Favorite)
+	assert.Nil(t, items[0].LastAccessedAt)
+	assert.Equal(t, model.ItemTypeBinaryData, items[1].Type)
+	require.NotNil(t, items[1].LastAccessedAt)
+	assert.WithinDuration(t, accessed, *items[1].LastAccessedAt, time.Second)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestItemStorage_Usage(t *testing.T) {
+	db, mock, repo := setupMockItemDB(t)
+	defer db.Close()
+
+	mock.ExpectQuery(`SELECT .*FROM binary_versions WHERE user_id = \$1 AND NOT chunked.*FROM binary_chunks WHERE user_id = \$1.* AS bytes,.*FROM credentials.* AS items`).
+		WithArgs("user1").
+		WillReturnRows(sqlmock.NewRows([]string{"bytes", "items"}).AddRow(int64(4096), int64(7)))
+
+	usage, err := repo.Usage(context.Background(), "user1")
+	require.NoError(t, err)
+	assert.Equal(t, int64(4096), usage.Bytes)
+	assert.Equal(t, int64(7), usage.Items)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
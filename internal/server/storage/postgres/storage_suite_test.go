@@ -0,0 +1,33 @@
+package postgres_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/storagetest"
+	"github.com/stretchr/testify/require"
+)
+
+// TestStorageSuite проверяет репозитории общим набором тестов на реальной
+// базе PostgreSQL. Строка подключения задаётся переменной окружения
+// TEST_DATABASE_DSN; без неё тест пропускается, но в CI (задана переменная
+// CI) завершается ошибкой, чтобы набор не пропускался там незаметно.
+func TestStorageSuite(t *testing.T) {
+	dsn := os.Getenv("TEST_DATABASE_DSN")
+	if dsn == "" {
+		if os.Getenv("CI") != "" {
+			t.Fatal("TEST_DATABASE_DSN must be set in CI")
+		}
+		t.Skip("TEST_DATABASE_DSN is not set")
+	}
+	storagetest.Run(t, func(t *testing.T) repository.StorageFactory {
+		db, err := postgres.Init(dsn)
+		require.NoError(t, err)
+		f := storage.NewPostgresFactory(db)
+		t.Cleanup(func() { _ = f.Close() })
+		return f
+	})
+}
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/google/uuid"
@@ -13,7 +14,7 @@ import (
 
 // textDataStorage — хранилище произвольных текстовых данных.
 type textDataStorage struct {
-	db *sqlx.DB
+	db sqlxExecutor
 }
 
 // NewTextDataStorage создаёт новое хранилище TextData.
@@ -28,12 +29,15 @@ func (s *textDataStorage) Create(ctx context.Context, data *model.TextData) erro
 	data.ID = uuid.NewString()
 	query := `
 		INSERT INTO text_data (
-			id, user_id, title, content, metadata, created_at, updated_at
+			id, user_id, title, content, metadata, folder, tags, created_at, updated_at
 		) VALUES (
-			:id, :user_id, :title, :content, :metadata, NOW(), NOW()
+			:id, :user_id, :title, :content, :metadata, :folder, :tags, NOW(), NOW()
 		)`
-	_, err := s.db.NamedExecContext(ctx, query, data)
-	return err
+	if _, err := s.db.NamedExecContext(ctx, query, data); err != nil {
+		return err
+	}
+	data.Version = 1 // значение по умолчанию столбца version
+	return nil
 }
 
 // GetByID возвращает полную запись TextData по id и userID.
@@ -48,23 +52,30 @@ func (s *textDataStorage) GetByID(ctx context.Context, userID, id string) (*mode
 }
 
 // Update обновляет существующую запись TextData.
+// Если data.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в data.
 func (s *textDataStorage) Update(ctx context.Context, data *model.TextData) error {
 	query := `
 		UPDATE text_data
 		SET title = :title,
 		    content = :content,
 		    metadata = :metadata,
+		    folder = :folder,
+		    tags = :tags,
+		    version = version + 1,
 		    updated_at = NOW()
-		WHERE id = :id AND user_id = :user_id`
-	res, err := s.db.NamedExecContext(ctx, query, data)
+		WHERE id = :id AND user_id = :user_id AND (CAST(:version AS BIGINT) = 0 OR version = :version)
+		RETURNING version, updated_at`
+	query, args, err := s.db.BindNamed(query, data)
 	if err != nil {
 		return err
 	}
-	rows, _ := res.RowsAffected()
-	if rows == 0 {
-		return fmt.Errorf("text data with id %s not found", data.ID)
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(&data.Version, &data.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return updateMissError(ctx, s.db, "text_data", data.UserID, data.ID, fmt.Errorf("text data with id %s not found", data.ID))
 	}
-	return nil
+	return err
 }
 
 // Delete удаляет запись TextData по id и userID.
@@ -80,17 +91,22 @@ func (s *textDataStorage) Delete(ctx context.Context, userID, id string) error {
 	return nil
 }
 
-// ListTitles возвращает список всех записей пользователя с ID и Title.
-func (s *textDataStorage) ListTitles(ctx context.Context, userID string) ([]*model.TextData, error) {
-	query := `
-		SELECT id, title
+// ListTitles возвращает страницу записей пользователя с ID, Title, Folder, Tags,
+// Favorite, LastAccessedAt и временем создания/обновления,
+// удовлетворяющих фильтру, и токен следующей страницы.
+func (s *textDataStorage) ListTitles(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	query, args := applyListFilter(`
+		SELECT id, title, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
 		FROM text_data
-		WHERE user_id = $1
-		ORDER BY created_at DESC
-	`
-	rows, err := s.db.QueryxContext(ctx, query, userID)
+		WHERE user_id = $1`, []interface{}{userID}, filter)
+	query, args, err := applyPage(query, args, page)
 	if err != nil {
-		return nil, err
+		return nil, "", err
+	}
+
+	rows, err := s.db.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, "", err
 	}
 	defer rows.Close()
 
@@ -98,9 +114,16 @@ func (s *textDataStorage) ListTitles(ctx context.Context, userID string) ([]*mod
 	for rows.Next() {
 		var data model.TextData
 		if err := rows.StructScan(&data); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		list = append(list, &data)
 	}
-	return list, nil
+
+	n, more := trimPage(len(list), page)
+	list = list[:n]
+	if !more {
+		return list, "", nil
+	}
+	last := list[n-1]
+	return list, page.NextToken(last.ID, last.Title, last.CreatedAt, last.UpdatedAt), nil
 }
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"regexp"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -36,7 +37,7 @@ func TestTextDataStorage_Create(t *testing.T) {
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO text_data`)).
-		WithArgs(sqlmock.AnyArg(), data.UserID, data.Title, data.Content, data.Metadata).
+		WithArgs(sqlmock.AnyArg(), data.UserID, data.Title, data.Content, data.Metadata, data.Folder, data.Tags).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
 	err := repo.Create(context.Background(), data)
@@ -79,12 +80,13 @@ func TestTextDataStorage_Update(t *testing.T) {
 		Metadata: "{}",
 	}
 
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE text_data`)).
-		WithArgs(data.Title, data.Content, data.Metadata, data.ID, data.UserID).
-		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE text_data`)).
+		WithArgs(data.Title, data.Content, data.Metadata, data.Folder, data.Tags, data.ID, data.UserID, data.Version, data.Version).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"}).AddRow(2, time.Now()))
 
 	err := repo.Update(context.Background(), data)
 	assert.NoError(t, err)
+	assert.Equal(t, int64(2), data.Version)
 }
 
 func TestTextDataStorage_Delete(t *testing.T) {
@@ -128,21 +130,40 @@ func TestTextDataStorage_ListTitles(t *testing.T) {
 		{ID: uuid.NewString(), Title: "Note 2"},
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title"}).
-		AddRow(list[0].ID, list[0].Title).
-		AddRow(list[1].ID, list[1].Title)
+	rows := sqlmock.NewRows([]string{"id", "title", "folder", "tags"}).
+		AddRow(list[0].ID, list[0].Title, "", "").
+		AddRow(list[1].ID, list[1].Title, "", "")
 
-	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title FROM text_data WHERE user_id = $1 ORDER BY created_at DESC")).
-		WithArgs(userID).
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, folder, tags, favorite, last_accessed_at, created_at, updated_at, version FROM text_data WHERE user_id = $1 ORDER BY created_at DESC, id DESC LIMIT $2")).
+		WithArgs(userID, model.DefaultPageSize+1).
 		WillReturnRows(rows)
 
-	result, err := repo.ListTitles(context.Background(), userID)
+	result, _, err := repo.ListTitles(context.Background(), userID, model.ListFilter{}, model.PageRequest{})
 	assert.NoError(t, err)
 	assert.Len(t, result, 2)
 	assert.Equal(t, list[0].Title, result[0].Title)
 	assert.Equal(t, list[1].Title, result[1].Title)
 }
 
+func TestTextDataStorage_ListTitles_WithFolder(t *testing.T) {
+	db, mock, repo := setupMockTextDataDB(t)
+	defer db.Close()
+
+	userID := uuid.NewString()
+	rows := sqlmock.NewRows([]string{"id", "title", "folder", "tags"}).
+		AddRow(uuid.NewString(), "Note 1", "Work", "tag")
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, title, folder, tags, favorite, last_accessed_at, created_at, updated_at, version FROM text_data WHERE user_id = $1 AND folder = $2 ORDER BY created_at DESC, id DESC LIMIT $3")).
+		WithArgs(userID, "Work", model.DefaultPageSize+1).
+		WillReturnRows(rows)
+
+	result, _, err := repo.ListTitles(context.Background(), userID, model.ListFilter{Folder: "Work"}, model.PageRequest{})
+	assert.NoError(t, err)
+	assert.Len(t, result, 1)
+	assert.Equal(t, "Work", result[0].Folder)
+	assert.Equal(t, model.Tags{"tag"}, result[0].Tags)
+}
+
 func TestTextDataStorage_Create_Error(t *testing.T) {
 	db, mock, repo := setupMockTextDataDB(t)
 	defer db.Close()
@@ -154,7 +175,7 @@ func TestTextDataStorage_Create_Error(t *testing.T) {
 	}
 
 	mock.ExpectExec(regexp.QuoteMeta(`INSERT INTO text_data`)).
-		WithArgs(sqlmock.AnyArg(), data.UserID, data.Title, data.Content, data.Metadata).
+		WithArgs(sqlmock.AnyArg(), data.UserID, data.Title, data.Content, data.Metadata, data.Folder, data.Tags).
 		WillReturnError(errors.New("insert failed"))
 
 	err := repo.Create(context.Background(), data)
@@ -189,9 +210,12 @@ func TestTextDataStorage_Update_NotFound(t *testing.T) {
 		Content: []byte("content"),
 	}
 
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE text_data`)).
-		WithArgs(data.Title, data.Content, data.Metadata, data.ID, data.UserID).
-		WillReturnResult(sqlmock.NewResult(0, 0)) // 0 rows affected
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE text_data`)).
+		WithArgs(data.Title, data.Content, data.Metadata, data.Folder, data.Tags, data.ID, data.UserID, data.Version, data.Version).
+		WillReturnRows(sqlmock.NewRows([]string{"version", "updated_at"})) // 0 rows affected
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT EXISTS(SELECT 1 FROM text_data WHERE id = $1 AND user_id = $2)`)).
+		WithArgs(data.ID, data.UserID).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
 
 	err := repo.Update(context.Background(), data)
 	assert.Error(t, err)
@@ -209,8 +233,8 @@ func TestTextDataStorage_Update_Error(t *testing.T) {
 		Content: []byte("content"),
 	}
 
-	mock.ExpectExec(regexp.QuoteMeta(`UPDATE text_data`)).
-		WithArgs(data.Title, data.Content, data.Metadata, data.ID, data.UserID).
+	mock.ExpectQuery(regexp.QuoteMeta(`UPDATE text_data`)).
+		WithArgs(data.Title, data.Content, data.Metadata, data.Folder, data.Tags, data.ID, data.UserID, data.Version, data.Version).
 		WillReturnError(errors.New("update failed"))
 
 	err := repo.Update(context.Background(), data)
@@ -0,0 +1,30 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// queryRower — общий интерфейс *sql.DB, *sqlx.DB и транзакций для выполнения однострочных запросов.
+type queryRower interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// updateMissError определяет причину, по которой UPDATE с проверкой версии не затронул строк:
+// если запись пользователя userID существует, значит не совпала версия
+// (model.ErrVersionConflict), иначе возвращается notFound — в том числе для
+// записи другого пользователя.
+func updateMissError(ctx context.Context, db queryRower, table, userID, id string, notFound error) error {
+	var exists bool
+	query := fmt.Sprintf("SELECT EXISTS(SELECT 1 FROM %s WHERE id = $1 AND user_id = $2)", table)
+	if err := db.QueryRowContext(ctx, query, id, userID).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return model.ErrVersionConflict
+	}
+	return notFound
+}
@@ -5,7 +5,9 @@ import (
 	"fmt"
 
 	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/memory"
 	"github.com/ryabkov82/gophkeeper/internal/server/storage/postgres"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/sqlite"
 )
 
 // postgresFactory — реализация StorageFactory для работы с PostgreSQL.
@@ -16,6 +18,12 @@ type postgresFactory struct {
 	bankCardRepo   repository.BankCardRepository
 	textDataRepo   repository.TextDataRepository
 	binaryDataRepo repository.BinaryDataRepository
+	chunkRepo      repository.ChunkRepository
+	versionRepo    repository.BinaryVersionRepository
+	itemRepo       repository.ItemRepository
+	changeRepo     repository.ChangeRepository
+	changeFeed     *postgres.ChangeListener
+	batchRepo      repository.BatchRepository
 }
 
 // NewPostgresFactory создаёт фабрику postgresFactory с репозиториями,
@@ -34,6 +42,12 @@ func NewPostgresFactory(db *sql.DB) repository.StorageFactory {
 		bankCardRepo:   postgres.NewBankCardStorage(db),
 		textDataRepo:   postgres.NewTextDataStorage(db),
 		binaryDataRepo: postgres.NewBinaryDataStorage(db),
+		chunkRepo:      postgres.NewChunkStorage(db),
+		versionRepo:    postgres.NewBinaryVersionStorage(db),
+		itemRepo:       postgres.NewItemStorage(db),
+		changeRepo:     postgres.NewChangeStorage(db),
+		changeFeed:     postgres.NewChangeListener(db),
+		batchRepo:      postgres.NewBatchStorage(db),
 	}
 }
 
@@ -62,8 +76,41 @@ func (f *postgresFactory) BinaryData() repository.BinaryDataRepository {
 	return f.binaryDataRepo
 }
 
-// Close закрывает соединение с базой данных.
+// Chunk возвращает репозиторий фрагментов файлов и манифестов.
+func (f *postgresFactory) Chunk() repository.ChunkRepository {
+	return f.chunkRepo
+}
+
+// BinaryVersion возвращает репозиторий прежних версий содержимого файлов.
+func (f *postgresFactory) BinaryVersion() repository.BinaryVersionRepository {
+	return f.versionRepo
+}
+
+// Item возвращает репозиторий общих операций над записями (избранное, недавние).
+func (f *postgresFactory) Item() repository.ItemRepository {
+	return f.itemRepo
+}
+
+// Change возвращает репозиторий журнала изменений записей.
+func (f *postgresFactory) Change() repository.ChangeRepository {
+	return f.changeRepo
+}
+
+// ChangeFeed возвращает источник уведомлений об изменениях записей (LISTEN/NOTIFY).
+func (f *postgresFactory) ChangeFeed() repository.ChangeFeed {
+	return f.changeFeed
+}
+
+// Batch возвращает репозиторий пакетных изменений записей в одной транзакции.
+func (f *postgresFactory) Batch() repository.BatchRepository {
+	return f.batchRepo
+}
+
+// Close останавливает прослушивание уведомлений и закрывает соединение с базой данных.
 func (f *postgresFactory) Close() error {
+	if f.changeFeed != nil {
+		_ = f.changeFeed.Close()
+	}
 	if f.db != nil {
 		return f.db.Close()
 	}
@@ -73,8 +120,9 @@ func (f *postgresFactory) Close() error {
 // NewStorageFactory создает фабрику репозиториев для указанного драйвера БД.
 //
 // Параметры:
-//   - driver: имя драйвера ("postgres", "pgx", "inmemory" и т.п.)
-//   - db: подключение к базе данных (*sql.DB) — обязательно для SQL-реализаций.
+//   - driver: имя драйвера ("postgres", "pgx", "sqlite", "sqlite3", "memory")
+//   - db: подключение к базе данных (*sql.DB) — обязательно для SQL-реализаций;
+//     для "memory" не используется, фабрика получает новое пустое хранилище в памяти.
 //
 // Возвращает:
 //   - экземпляр StorageFactory с нужными реализациями репозиториев
@@ -83,6 +131,26 @@ func NewStorageFactory(driver string, db *sql.DB) (repository.StorageFactory, er
 	switch driver {
 	case "pgx", "postgres":
 		return NewPostgresFactory(db), nil
+	case "sqlite", "sqlite3":
+		return NewSQLiteFactory(db), nil
+	case "memory":
+		return NewMemoryFactory(memory.NewStore()), nil
+	default:
+		return nil, fmt.Errorf("неизвестный тип хранилища: %s", driver)
+	}
+}
+
+// InitDB открывает базу данных указанного драйвера и применяет к ней миграции.
+//
+// Параметры:
+//   - driver: имя драйвера (см. NewStorageFactory)
+//   - dsn: строка подключения PostgreSQL или путь к файлу SQLite
+func InitDB(driver, dsn string) (*sql.DB, error) {
+	switch driver {
+	case "pgx", "postgres":
+		return postgres.Init(dsn)
+	case "sqlite", "sqlite3":
+		return sqlite.Init(dsn)
 	default:
 		return nil, fmt.Errorf("неизвестный тип хранилища: %s", driver)
 	}
@@ -0,0 +1,431 @@
+// Package s3 содержит реализацию BinaryDataStorage, которая хранит бинарные
+// данные в S3-совместимом объектном хранилище (AWS S3, MinIO и т.п.).
+//
+// Save записывает поток multipart-загрузкой частями по PartSize байт, поэтому
+// файл не держится в памяти целиком. Load возвращает поток, который читает
+// объект потоковыми GET-запросами с заголовком Range и поддерживает перемотку.
+// Сессии загрузки с докачкой копятся в локальном промежуточном хранилище и
+// переносятся в S3 при CommitUpload.
+package s3
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/storage"
+)
+
+// DefaultPartSize — размер части multipart-загрузки по умолчанию.
+// S3 требует, чтобы все части, кроме последней, были не меньше 5 МиБ.
+const DefaultPartSize int64 = 8 << 20
+
+// Config содержит параметры подключения к S3-совместимому хранилищу.
+type Config struct {
+	Endpoint  string // адрес сервиса, например https://s3.amazonaws.com или http://localhost:9000
+	Region    string // регион подписи запросов (по умолчанию us-east-1)
+	Bucket    string // бакет для файлов; должен существовать
+	AccessKey string // идентификатор ключа доступа
+	SecretKey string // секретный ключ доступа
+	PartSize  int64  // размер части multipart-загрузки (0 — DefaultPartSize)
+
+	// HTTPClient — HTTP-клиент для запросов (nil — http.DefaultClient).
+	HTTPClient *http.Client
+}
+
+// binaryDataStorage реализует интерфейс storage.BinaryDataStorage
+// и сохраняет данные в S3-совместимом хранилище.
+type binaryDataStorage struct {
+	client   *client
+	partSize int64
+	staging  storage.BinaryDataStorage
+}
+
+// NewBinaryDataStorage создаёт хранилище в бакете cfg.Bucket. staging —
+// локальное хранилище, в котором копятся сессии загрузки с докачкой
+// (принятые части не отправляются в S3 до завершения сессии). Close
+// закрывает и staging.
+func NewBinaryDataStorage(cfg Config, staging storage.BinaryDataStorage) (storage.BinaryDataStorage, error) {
+	if cfg.Endpoint == "" {
+		return nil, errors.New("s3 endpoint is required")
+	}
+	if cfg.Bucket == "" {
+		return nil, errors.New("s3 bucket is required")
+	}
+	endpoint, err := url.Parse(cfg.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+	if endpoint.Scheme != "http" && endpoint.Scheme != "https" {
+		return nil, fmt.Errorf("invalid s3 endpoint scheme: %q", endpoint.Scheme)
+	}
+
+	region := cfg.Region
+	if region == "" {
+		region = "us-east-1"
+	}
+	partSize := cfg.PartSize
+	if partSize <= 0 {
+		partSize = DefaultPartSize
+	}
+	httpClient := cfg.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	return &binaryDataStorage{
+		client: &client{
+			endpoint:  endpoint,
+			region:    region,
+			bucket:    cfg.Bucket,
+			accessKey: cfg.AccessKey,
+			secretKey: cfg.SecretKey,
+			http:      httpClient,
+			now:       time.Now,
+		},
+		partSize: partSize,
+		staging:  staging,
+	}, nil
+}
+
+// Save сохраняет поток r объектом <userID>/<uuid>.bin. Данные размером не
+// больше одной части отправляются одним PUT, иначе — multipart-загрузкой;
+// при ошибке незавершённая загрузка отменяется.
+func (s *binaryDataStorage) Save(ctx context.Context, userID string, r io.Reader) (storagePath string, size int64, err error) {
+	key := path.Join(userID, uuid.New().String()+".bin")
+	buf := make([]byte, s.partSize)
+
+	n, readErr := io.ReadFull(r, buf)
+	if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+		if err := s.putObject(ctx, key, buf[:n]); err != nil {
+			return "", 0, err
+		}
+		return key, int64(n), nil
+	}
+	if readErr != nil {
+		return "", 0, fmt.Errorf("failed to read data: %w", readErr)
+	}
+
+	uploadID, err := s.createMultipartUpload(ctx, key)
+	if err != nil {
+		return "", 0, err
+	}
+	// Если что-то пойдёт не так, отменяем загрузку, чтобы S3 удалил части
+	defer func() {
+		if err != nil {
+			_ = s.abortMultipartUpload(context.WithoutCancel(ctx), key, uploadID)
+		}
+	}()
+
+	var parts []completedPart
+	for num := 1; n > 0; num++ {
+		etag, err := s.uploadPart(ctx, key, uploadID, num, buf[:n])
+		if err != nil {
+			return "", 0, err
+		}
+		parts = append(parts, completedPart{PartNumber: num, ETag: etag})
+		size += int64(n)
+
+		if readErr != nil {
+			// Последняя неполная часть уже отправлена
+			break
+		}
+		n, readErr = io.ReadFull(r, buf)
+		if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+			return "", 0, fmt.Errorf("failed to read data: %w", readErr)
+		}
+	}
+
+	if err := s.completeMultipartUpload(ctx, key, uploadID, parts); err != nil {
+		return "", 0, err
+	}
+	return key, size, nil
+}
+
+// Load возвращает поток для чтения объекта storagePath. Размер объекта
+// запрашивается сразу (HEAD), содержимое — при первом чтении.
+func (s *binaryDataStorage) Load(ctx context.Context, storagePath string) (io.ReadSeekCloser, error) {
+	resp, err := s.client.do(ctx, http.MethodHead, storagePath, nil, nil, nil, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open object: %w", err)
+	}
+	resp.Body.Close()
+
+	return &objectReader{
+		ctx:    ctx,
+		client: s.client,
+		key:    storagePath,
+		size:   resp.ContentLength,
+	}, nil
+}
+
+// Delete удаляет объект storagePath. Отсутствие объекта ошибкой не считается.
+func (s *binaryDataStorage) Delete(ctx context.Context, storagePath string) error {
+	if storagePath == "" {
+		// удалять нечего
+		return nil
+	}
+	resp, err := s.client.do(ctx, http.MethodDelete, storagePath, nil, nil, nil, 0)
+	if err != nil {
+		var respErr *ResponseError
+		if errors.As(err, &respErr) && respErr.StatusCode == http.StatusNotFound {
+			return nil
+		}
+		return fmt.Errorf("failed to delete object: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// UploadOffset возвращает объём данных сессии загрузки в промежуточном хранилище.
+func (s *binaryDataStorage) UploadOffset(ctx context.Context, userID, uploadID string) (int64, error) {
+	return s.staging.UploadOffset(ctx, userID, uploadID)
+}
+
+// UploadUsage возвращает объём незавершённых сессий загрузки пользователя
+// в промежуточном хранилище.
+func (s *binaryDataStorage) UploadUsage(ctx context.Context, userID string) (int64, error) {
+	return s.staging.UploadUsage(ctx, userID)
+}
+
+// AppendUpload дописывает данные в сессию загрузки в промежуточном хранилище.
+func (s *binaryDataStorage) AppendUpload(ctx context.Context, userID, uploadID string, offset int64, r io.Reader) (int64, error) {
+	return s.staging.AppendUpload(ctx, userID, uploadID, offset, r)
+}
+
+// CommitUpload завершает сессию загрузки: собранный в промежуточном
+// хранилище файл сохраняется в S3 (как при Save) и удаляется локально.
+func (s *binaryDataStorage) CommitUpload(ctx context.Context, userID, uploadID string) (storagePath string, size int64, err error) {
+	stagedPath, _, err := s.staging.CommitUpload(ctx, userID, uploadID)
+	if err != nil {
+		return "", 0, err
+	}
+	defer func() { _ = s.staging.Delete(context.WithoutCancel(ctx), stagedPath) }()
+
+	f, err := s.staging.Load(ctx, stagedPath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	return s.Save(ctx, userID, f)
+}
+
+// Close освобождает ресурсы промежуточного хранилища.
+func (s *binaryDataStorage) Close() {
+	s.staging.Close()
+}
+
+// listBucketResult — страница ответа ListObjectsV2.
+type listBucketResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		Size         int64     `xml:"Size"`
+		LastModified time.Time `xml:"LastModified"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// List перечисляет объекты бакета (ListObjectsV2 постранично) и вызывает
+// fn для каждого объекта *.bin. Сессии загрузки хранятся в промежуточном
+// хранилище и в список не входят.
+func (s *binaryDataStorage) List(ctx context.Context, fn func(obj storage.StoredObject) error) error {
+	token := ""
+	for {
+		query := url.Values{"list-type": {"2"}}
+		if token != "" {
+			query.Set("continuation-token", token)
+		}
+		resp, err := s.client.do(ctx, http.MethodGet, "", query, nil, nil, 0)
+		if err != nil {
+			return fmt.Errorf("failed to list objects: %w", err)
+		}
+		var page listBucketResult
+		err = xml.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("failed to decode object list: %w", err)
+		}
+
+		for _, obj := range page.Contents {
+			if path.Ext(obj.Key) != ".bin" {
+				continue
+			}
+			if err := fn(storage.StoredObject{Path: obj.Key, Size: obj.Size, ModTime: obj.LastModified}); err != nil {
+				return err
+			}
+		}
+		if !page.IsTruncated || page.NextContinuationToken == "" {
+			return nil
+		}
+		token = page.NextContinuationToken
+	}
+}
+
+// putObject сохраняет объект key одним запросом.
+func (s *binaryDataStorage) putObject(ctx context.Context, key string, data []byte) error {
+	resp, err := s.client.do(ctx, http.MethodPut, key, nil, nil, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return fmt.Errorf("failed to put object: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// initiateMultipartUploadResult — ответ на создание multipart-загрузки.
+type initiateMultipartUploadResult struct {
+	UploadID string `xml:"UploadId"`
+}
+
+// completedPart описывает отправленную часть multipart-загрузки.
+type completedPart struct {
+	PartNumber int    `xml:"PartNumber"`
+	ETag       string `xml:"ETag"`
+}
+
+// completeMultipartUpload — тело запроса завершения multipart-загрузки.
+type completeMultipartUpload struct {
+	XMLName xml.Name        `xml:"CompleteMultipartUpload"`
+	Parts   []completedPart `xml:"Part"`
+}
+
+// createMultipartUpload начинает multipart-загрузку объекта key.
+func (s *binaryDataStorage) createMultipartUpload(ctx context.Context, key string) (string, error) {
+	resp, err := s.client.do(ctx, http.MethodPost, key, url.Values{"uploads": {""}}, nil, nil, 0)
+	if err != nil {
+		return "", fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result initiateMultipartUploadResult
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", fmt.Errorf("failed to decode multipart upload: %w", err)
+	}
+	if result.UploadID == "" {
+		return "", errors.New("failed to create multipart upload: empty upload id")
+	}
+	return result.UploadID, nil
+}
+
+// uploadPart отправляет часть num multipart-загрузки и возвращает её ETag.
+func (s *binaryDataStorage) uploadPart(ctx context.Context, key, uploadID string, num int, data []byte) (string, error) {
+	query := url.Values{"partNumber": {strconv.Itoa(num)}, "uploadId": {uploadID}}
+	resp, err := s.client.do(ctx, http.MethodPut, key, query, nil, bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part %d: %w", num, err)
+	}
+	resp.Body.Close()
+	return resp.Header.Get("ETag"), nil
+}
+
+// completeMultipartUpload собирает объект из отправленных частей. S3 может
+// вернуть ошибку в теле ответа со статусом 200, поэтому тело разбирается.
+func (s *binaryDataStorage) completeMultipartUpload(ctx context.Context, key, uploadID string, parts []completedPart) error {
+	body, err := xml.Marshal(completeMultipartUpload{Parts: parts})
+	if err != nil {
+		return err
+	}
+	header := http.Header{"Content-Type": {"application/xml"}}
+	resp, err := s.client.do(ctx, http.MethodPost, key, url.Values{"uploadId": {uploadID}}, header, bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return fmt.Errorf("failed to complete multipart upload: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result errorBody
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to decode multipart upload result: %w", err)
+	}
+	if result.XMLName.Local == "Error" {
+		return fmt.Errorf("failed to complete multipart upload: %w",
+			&ResponseError{StatusCode: resp.StatusCode, Code: result.Code, Message: result.Message})
+	}
+	return nil
+}
+
+// abortMultipartUpload отменяет multipart-загрузку и удаляет её части.
+func (s *binaryDataStorage) abortMultipartUpload(ctx context.Context, key, uploadID string) error {
+	resp, err := s.client.do(ctx, http.MethodDelete, key, url.Values{"uploadId": {uploadID}}, nil, nil, 0)
+	if err != nil {
+		return fmt.Errorf("failed to abort multipart upload: %w", err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// objectReader читает объект потоковыми GET-запросами. Запрос с заголовком
+// Range открывается при первом чтении после создания или перемотки.
+type objectReader struct {
+	ctx    context.Context
+	client *client
+	key    string
+	size   int64
+	pos    int64
+	body   io.ReadCloser
+}
+
+// Read читает очередную порцию объекта с текущей позиции.
+func (o *objectReader) Read(p []byte) (int, error) {
+	if o.pos >= o.size {
+		return 0, io.EOF
+	}
+	if o.body == nil {
+		header := http.Header{"Range": {"bytes=" + strconv.FormatInt(o.pos, 10) + "-"}}
+		resp, err := o.client.do(o.ctx, http.MethodGet, o.key, nil, header, nil, 0)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get object: %w", err)
+		}
+		o.body = resp.Body
+	}
+
+	n, err := o.body.Read(p)
+	o.pos += int64(n)
+	if err == io.EOF && o.pos < o.size {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+// Seek меняет позицию чтения; открытый GET-запрос при этом закрывается.
+func (o *objectReader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = o.pos + offset
+	case io.SeekEnd:
+		abs = o.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("negative position")
+	}
+	if abs != o.pos && o.body != nil {
+		o.body.Close()
+		o.body = nil
+	}
+	o.pos = abs
+	return abs, nil
+}
+
+// Close закрывает открытый GET-запрос.
+func (o *objectReader) Close() error {
+	if o.body == nil {
+		return nil
+	}
+	err := o.body.Close()
+	o.body = nil
+	return err
+}
@@ -0,0 +1,461 @@
+package s3_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/google/uuid"
+	domainstorage "github.com/ryabkov82/gophkeeper/internal/domain/storage"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/filesystem"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/s3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testBucket    = "gophkeeper"
+	testAccessKey = "test-access"
+	listPage      = 2 // объектов на странице ListObjectsV2
+)
+
+// fakeS3 — упрощённый in-process S3: объекты, multipart-загрузки и
+// ranged GET в path-style адресации одного бакета.
+type fakeS3 struct {
+	mu       sync.Mutex
+	objects  map[string][]byte
+	uploads  map[string]map[int][]byte
+	nextID   int
+	minPart  int
+	requests []string
+
+	// completeError — вернуть ошибку в теле ответа на завершение загрузки.
+	completeError bool
+}
+
+func newFakeS3(t *testing.T, minPart int) (*fakeS3, *httptest.Server) {
+	f := &fakeS3{
+		objects: make(map[string][]byte),
+		uploads: make(map[string]map[int][]byte),
+		minPart: minPart,
+	}
+	srv := httptest.NewServer(f)
+	t.Cleanup(srv.Close)
+	return f, srv
+}
+
+func writeS3Error(w http.ResponseWriter, status int, code string) {
+	w.WriteHeader(status)
+	fmt.Fprintf(w, "<Error><Code>%s</Code><Message>%s</Message></Error>", code, code)
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential="+testAccessKey+"/") ||
+		r.Header.Get("X-Amz-Date") == "" {
+		writeS3Error(w, http.StatusForbidden, "AccessDenied")
+		return
+	}
+	if r.Method == http.MethodGet && r.URL.Path == "/"+testBucket {
+		f.listObjects(w, r)
+		return
+	}
+	prefix := "/" + testBucket + "/"
+	if !strings.HasPrefix(r.URL.Path, prefix) {
+		writeS3Error(w, http.StatusNotFound, "NoSuchBucket")
+		return
+	}
+	key := strings.TrimPrefix(r.URL.Path, prefix)
+	q := r.URL.Query()
+	body, _ := io.ReadAll(r.Body)
+
+	switch {
+	case r.Method == http.MethodPost && q.Has("uploads"):
+		f.requests = append(f.requests, "create")
+		f.nextID++
+		id := "upload-" + strconv.Itoa(f.nextID)
+		f.uploads[id] = make(map[int][]byte)
+		fmt.Fprintf(w, "<InitiateMultipartUploadResult><UploadId>%s</UploadId></InitiateMultipartUploadResult>", id)
+
+	case r.Method == http.MethodPut && q.Has("partNumber"):
+		f.requests = append(f.requests, "part")
+		parts, ok := f.uploads[q.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		num, _ := strconv.Atoi(q.Get("partNumber"))
+		parts[num] = body
+		w.Header().Set("ETag", fmt.Sprintf("\"etag-%d\"", num))
+
+	case r.Method == http.MethodPost && q.Has("uploadId"):
+		f.requests = append(f.requests, "complete")
+		parts, ok := f.uploads[q.Get("uploadId")]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchUpload")
+			return
+		}
+		if f.completeError {
+			writeS3Error(w, http.StatusOK, "InternalError")
+			return
+		}
+		var req struct {
+			Parts []struct {
+				PartNumber int
+				ETag       string
+			} `xml:"Part"`
+		}
+		if err := xml.Unmarshal(body, &req); err != nil {
+			writeS3Error(w, http.StatusBadRequest, "MalformedXML")
+			return
+		}
+		var obj []byte
+		for i, p := range req.Parts {
+			data := parts[p.PartNumber]
+			if p.ETag != fmt.Sprintf("\"etag-%d\"", p.PartNumber) ||
+				(i < len(req.Parts)-1 && len(data) < f.minPart) {
+				writeS3Error(w, http.StatusBadRequest, "InvalidPart")
+				return
+			}
+			obj = append(obj, data...)
+		}
+		f.objects[key] = obj
+		delete(f.uploads, q.Get("uploadId"))
+		fmt.Fprint(w, "<CompleteMultipartUploadResult></CompleteMultipartUploadResult>")
+
+	case r.Method == http.MethodDelete && q.Has("uploadId"):
+		f.requests = append(f.requests, "abort")
+		delete(f.uploads, q.Get("uploadId"))
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPut:
+		f.requests = append(f.requests, "put")
+		f.objects[key] = body
+
+	case r.Method == http.MethodHead:
+		data, ok := f.objects[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Length", strconv.Itoa(len(data)))
+
+	case r.Method == http.MethodGet:
+		f.requests = append(f.requests, "get "+r.Header.Get("Range"))
+		data, ok := f.objects[key]
+		if !ok {
+			writeS3Error(w, http.StatusNotFound, "NoSuchKey")
+			return
+		}
+		var from int
+		if rng := r.Header.Get("Range"); rng != "" {
+			from, _ = strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(rng, "bytes="), "-"))
+			w.WriteHeader(http.StatusPartialContent)
+		}
+		_, _ = w.Write(data[from:])
+
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeS3Error(w, http.StatusMethodNotAllowed, "MethodNotAllowed")
+	}
+}
+
+// listObjects отвечает на ListObjectsV2 страницами по listPage объектов
+// в порядке ключей; токен продолжения — последний отданный ключ.
+func (f *fakeS3) listObjects(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("list-type") != "2" {
+		writeS3Error(w, http.StatusBadRequest, "InvalidArgument")
+		return
+	}
+	f.requests = append(f.requests, "list")
+	keys := make([]string, 0, len(f.objects))
+	for key := range f.objects {
+		if key > q.Get("continuation-token") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	fmt.Fprint(w, "<ListBucketResult>")
+	for i, key := range keys {
+		if i == listPage {
+			fmt.Fprintf(w, "<IsTruncated>true</IsTruncated><NextContinuationToken>%s</NextContinuationToken>", keys[i-1])
+			break
+		}
+		fmt.Fprintf(w, "<Contents><Key>%s</Key><Size>%d</Size><LastModified>2025-01-02T03:04:05.000Z</LastModified></Contents>", key, len(f.objects[key]))
+	}
+	fmt.Fprint(w, "</ListBucketResult>")
+}
+
+func (f *fakeS3) object(key string) ([]byte, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	data, ok := f.objects[key]
+	return data, ok
+}
+
+func (f *fakeS3) pendingUploads() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.uploads)
+}
+
+func newTestStorage(t *testing.T, srv *httptest.Server, partSize int64) (domainstorage.BinaryDataStorage, string) {
+	stagingDir := t.TempDir()
+	staging := filesystem.NewBinaryDataStorage(stagingDir, time.Hour, time.Hour)
+	st, err := s3.NewBinaryDataStorage(s3.Config{
+		Endpoint:  srv.URL,
+		Bucket:    testBucket,
+		AccessKey: testAccessKey,
+		SecretKey: "test-secret",
+		PartSize:  partSize,
+	}, staging)
+	require.NoError(t, err)
+	t.Cleanup(st.Close)
+	return st, stagingDir
+}
+
+func TestNewBinaryDataStorage_Validation(t *testing.T) {
+	_, err := s3.NewBinaryDataStorage(s3.Config{Bucket: testBucket}, nil)
+	assert.Error(t, err)
+
+	_, err = s3.NewBinaryDataStorage(s3.Config{Endpoint: "http://localhost:9000"}, nil)
+	assert.Error(t, err)
+
+	_, err = s3.NewBinaryDataStorage(s3.Config{Endpoint: "localhost:9000", Bucket: testBucket}, nil)
+	assert.Error(t, err)
+}
+
+func TestBinaryDataStorage_SaveLoadDelete(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t, 16)
+	st, _ := newTestStorage(t, srv, 16)
+
+	data := []byte("hello world")
+
+	storagePath, size, err := st.Save(ctx, "user123", bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(storagePath, "user123/"))
+	assert.True(t, strings.HasSuffix(storagePath, ".bin"))
+	assert.Equal(t, int64(len(data)), size)
+	assert.Equal(t, []string{"put"}, fake.requests, "данные меньше части отправляются одним PUT")
+
+	rc, err := st.Load(ctx, storagePath)
+	require.NoError(t, err)
+	loaded, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, data, loaded)
+	require.NoError(t, rc.Close())
+
+	require.NoError(t, st.Delete(ctx, storagePath))
+	_, ok := fake.object(storagePath)
+	assert.False(t, ok, "объект должен быть удалён")
+
+	// Повторное удаление и пустой путь ошибкой не считаются
+	assert.NoError(t, st.Delete(ctx, storagePath))
+	assert.NoError(t, st.Delete(ctx, ""))
+}
+
+func TestBinaryDataStorage_MultipartSave(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t, 16)
+	st, _ := newTestStorage(t, srv, 16)
+
+	data := bytes.Repeat([]byte("0123456789"), 10) // 100 байт — 7 частей
+
+	// OneByteReader проверяет, что части собираются из коротких чтений
+	storagePath, size, err := st.Save(ctx, "user1", iotest.OneByteReader(bytes.NewReader(data)))
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), size)
+
+	stored, ok := fake.object(storagePath)
+	require.True(t, ok)
+	assert.Equal(t, data, stored)
+	assert.Equal(t, []string{"create", "part", "part", "part", "part", "part", "part", "part", "complete"}, fake.requests)
+	assert.Zero(t, fake.pendingUploads())
+}
+
+func TestBinaryDataStorage_MultipartSaveExactParts(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t, 16)
+	st, _ := newTestStorage(t, srv, 16)
+
+	data := bytes.Repeat([]byte("a"), 32)
+
+	storagePath, size, err := st.Save(ctx, "user1", bytes.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, int64(32), size)
+
+	stored, _ := fake.object(storagePath)
+	assert.Equal(t, data, stored)
+	assert.Equal(t, []string{"create", "part", "part", "complete"}, fake.requests)
+}
+
+func TestBinaryDataStorage_SaveAbortsOnReadError(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t, 16)
+	st, _ := newTestStorage(t, srv, 16)
+
+	r := io.MultiReader(bytes.NewReader(make([]byte, 40)), iotest.ErrReader(errors.New("connection reset")))
+
+	_, _, err := st.Save(ctx, "user1", r)
+	require.Error(t, err)
+	assert.Equal(t, "abort", fake.requests[len(fake.requests)-1])
+	assert.Zero(t, fake.pendingUploads(), "незавершённая загрузка должна быть отменена")
+	assert.Empty(t, fake.objects)
+}
+
+func TestBinaryDataStorage_CompleteErrorInBody(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t, 16)
+	fake.completeError = true
+	st, _ := newTestStorage(t, srv, 16)
+
+	_, _, err := st.Save(ctx, "user1", bytes.NewReader(make([]byte, 40)))
+	require.Error(t, err)
+
+	var respErr *s3.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	assert.Equal(t, "InternalError", respErr.Code)
+	assert.Zero(t, fake.pendingUploads())
+}
+
+func TestBinaryDataStorage_LoadSeek(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t, 16)
+	st, _ := newTestStorage(t, srv, 1024)
+
+	data := []byte("0123456789abcdef")
+	storagePath, _, err := st.Save(ctx, "user1", bytes.NewReader(data))
+	require.NoError(t, err)
+
+	rc, err := st.Load(ctx, storagePath)
+	require.NoError(t, err)
+	defer rc.Close()
+
+	size, err := rc.Seek(0, io.SeekEnd)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), size)
+
+	_, err = rc.Seek(10, io.SeekStart)
+	require.NoError(t, err)
+	tail, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "abcdef", string(tail))
+	assert.Contains(t, fake.requests, "get bytes=10-")
+}
+
+func TestBinaryDataStorage_LoadNonExistentObject(t *testing.T) {
+	ctx := context.Background()
+	_, srv := newFakeS3(t, 16)
+	st, _ := newTestStorage(t, srv, 16)
+
+	_, err := st.Load(ctx, "user1/missing.bin")
+	require.Error(t, err)
+
+	var respErr *s3.ResponseError
+	require.ErrorAs(t, err, &respErr)
+	assert.Equal(t, http.StatusNotFound, respErr.StatusCode)
+}
+
+func TestBinaryDataStorage_ResumableUpload(t *testing.T) {
+	ctx := context.Background()
+	fake, srv := newFakeS3(t, 16)
+	st, stagingDir := newTestStorage(t, srv, 16)
+
+	userID := "user1"
+	uploadID := uuid.NewString()
+	data := bytes.Repeat([]byte("x"), 50)
+
+	off, err := st.UploadOffset(ctx, userID, uploadID)
+	require.NoError(t, err)
+	assert.Zero(t, off)
+
+	// Первая попытка обрывается после 20 байт
+	r := io.MultiReader(bytes.NewReader(data[:20]), iotest.ErrReader(errors.New("connection reset")))
+	_, err = st.AppendUpload(ctx, userID, uploadID, 0, r)
+	require.Error(t, err)
+
+	off, err = st.UploadOffset(ctx, userID, uploadID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), off)
+	assert.Empty(t, fake.requests, "до завершения сессии в S3 ничего не отправляется")
+
+	_, err = st.AppendUpload(ctx, userID, uploadID, off, bytes.NewReader(data[off:]))
+	require.NoError(t, err)
+
+	storagePath, size, err := st.CommitUpload(ctx, userID, uploadID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(len(data)), size)
+
+	stored, ok := fake.object(storagePath)
+	require.True(t, ok)
+	assert.Equal(t, data, stored)
+
+	// Промежуточные файлы удалены
+	entries, err := listFiles(stagingDir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func listFiles(dir string) ([]string, error) {
+	var files []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			files = append(files, path)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func TestBinaryDataStorage_List(t *testing.T) {
+	fake, srv := newFakeS3(t, 0)
+	st, _ := newTestStorage(t, srv, 1024)
+	ctx := context.Background()
+
+	want := map[string]int64{}
+	for i := 1; i <= 5; i++ {
+		key, size, err := st.Save(ctx, "user1", bytes.NewReader(bytes.Repeat([]byte("x"), i)))
+		require.NoError(t, err)
+		want[key] = size
+	}
+	fake.mu.Lock()
+	fake.objects["user1/notes.txt"] = []byte("not a data file")
+	fake.mu.Unlock()
+
+	got := map[string]int64{}
+	err := st.List(ctx, func(obj domainstorage.StoredObject) error {
+		got[obj.Path] = obj.Size
+		assert.Equal(t, 2025, obj.ModTime.Year())
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	stop := errors.New("stop")
+	assert.ErrorIs(t, st.List(ctx, func(domainstorage.StoredObject) error { return stop }), stop)
+}
@@ -0,0 +1,192 @@
+package s3
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	// unsignedPayload — значение x-amz-content-sha256 для запросов, тело
+	// которых не входит в подпись (потоковая передача частей файла).
+	unsignedPayload = "UNSIGNED-PAYLOAD"
+	// amzDateFormat — формат времени заголовка X-Amz-Date.
+	amzDateFormat = "20060102T150405Z"
+	// signAlgorithm — алгоритм подписи AWS Signature Version 4.
+	signAlgorithm = "AWS4-HMAC-SHA256"
+)
+
+// ResponseError — ошибка, которую вернул S3-совместимый сервер.
+type ResponseError struct {
+	StatusCode int    // HTTP-статус ответа
+	Code       string // код ошибки S3 (например, NoSuchKey)
+	Message    string // описание ошибки от сервера
+}
+
+// Error реализует интерфейс error.
+func (e *ResponseError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("s3: status %d", e.StatusCode)
+	}
+	return fmt.Sprintf("s3: status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// errorBody — XML-тело ответа с ошибкой.
+type errorBody struct {
+	XMLName xml.Name
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+// client выполняет подписанные (AWS Signature V4) запросы к S3-совместимому
+// API. Объекты адресуются в стиле path-style: <endpoint>/<bucket>/<key>,
+// который поддерживают и AWS S3, и MinIO, и прочие совместимые сервисы.
+type client struct {
+	endpoint  *url.URL
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	http      *http.Client
+	now       func() time.Time
+}
+
+// do отправляет подписанный запрос к объекту key. Ответ со статусом 3xx и
+// выше преобразуется в *ResponseError. Вызвавший код обязан закрыть тело ответа.
+func (c *client) do(ctx context.Context, method, key string, query url.Values, header http.Header, body io.Reader, size int64) (*http.Response, error) {
+	u := c.endpoint.JoinPath(c.bucket, key)
+	u.RawQuery = canonicalQuery(query)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	if body != nil {
+		req.ContentLength = size
+	}
+	c.sign(req, unsignedPayload, c.now())
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		return nil, readError(resp)
+	}
+	return resp, nil
+}
+
+// readError разбирает тело ответа с ошибкой (у HEAD-запросов тела нет).
+func readError(resp *http.Response) error {
+	respErr := &ResponseError{StatusCode: resp.StatusCode}
+	var body errorBody
+	if err := xml.NewDecoder(io.LimitReader(resp.Body, 64<<10)).Decode(&body); err == nil {
+		respErr.Code = body.Code
+		respErr.Message = body.Message
+	}
+	return respErr
+}
+
+// sign подписывает запрос по схеме AWS Signature V4. В подпись входят
+// заголовок Host и все заголовки, уже установленные в req.
+func (c *client) sign(req *http.Request, payloadHash string, t time.Time) {
+	amzDate := t.UTC().Format(amzDateFormat)
+	date := amzDate[:8]
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	values := map[string]string{"host": host}
+	names := []string{"host"}
+	for k, v := range req.Header {
+		name := strings.ToLower(k)
+		trimmed := make([]string, len(v))
+		for i := range v {
+			trimmed[i] = strings.Join(strings.Fields(v[i]), " ")
+		}
+		values[name] = strings.Join(trimmed, ",")
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + values[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + c.region + "/s3/aws4_request"
+	requestHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := signAlgorithm + "\n" + amzDate + "\n" + scope + "\n" + hex.EncodeToString(requestHash[:])
+
+	signingKey := hmacSHA256([]byte("AWS4"+c.secretKey), date)
+	signingKey = hmacSHA256(signingKey, c.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", signAlgorithm+
+		" Credential="+c.accessKey+"/"+scope+
+		",SignedHeaders="+signedHeaders+
+		",Signature="+signature)
+}
+
+// hmacSHA256 вычисляет HMAC-SHA256 от data с ключом key.
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+// canonicalQuery кодирует параметры запроса в каноническом для подписи виде:
+// параметры отсортированы по имени, пробелы кодируются как %20.
+func canonicalQuery(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		vals := append([]string(nil), query[k]...)
+		sort.Strings(vals)
+		for _, v := range vals {
+			parts = append(parts, escape(k)+"="+escape(v))
+		}
+	}
+	return strings.Join(parts, "&")
+}
+
+// escape кодирует строку по RFC 3986, как того требует Signature V4.
+func escape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
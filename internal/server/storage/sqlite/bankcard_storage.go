@@ -0,0 +1,122 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+type bankCardStorage struct {
+	db sqlxExecutor
+}
+
+// NewBankCardStorage создаёт новое хранилище банковских карт.
+// Принимает стандартный *sql.DB и оборачивает его в *sqlx.DB.
+func NewBankCardStorage(db *sql.DB) repository.BankCardRepository {
+	return &bankCardStorage{db: sqlx.NewDb(db, DriverName)}
+}
+
+// Create сохраняет новую банковскую карту в базе данных.
+func (s *bankCardStorage) Create(ctx context.Context, card *model.BankCard) error {
+	card.ID = uuid.NewString()
+	card.CreatedAt = now()
+	card.UpdatedAt = card.CreatedAt
+	query := `
+		INSERT INTO bank_cards (
+			id, user_id, title, cardholder_name, card_number, expiry_date, cvv, metadata, folder, tags, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :title, :cardholder_name, :card_number, :expiry_date, :cvv, :metadata, :folder, :tags, :created_at, :updated_at
+		)`
+	if _, err := s.db.NamedExecContext(ctx, query, card); err != nil {
+		return err
+	}
+	card.Version = 1 // значение по умолчанию столбца version
+	return nil
+}
+
+// GetByID возвращает банковскую карту по её идентификатору.
+func (s *bankCardStorage) GetByID(ctx context.Context, id string) (*model.BankCard, error) {
+	var card model.BankCard
+	err := s.db.GetContext(ctx, &card, "SELECT * FROM bank_cards WHERE id = ?", id)
+	if err != nil {
+		return nil, err
+	}
+	return &card, nil
+}
+
+// GetByUser возвращает страницу банковских карт указанного пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *bankCardStorage) GetByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.BankCard, string, error) {
+	var cards []model.BankCard
+	query, args := applyListFilter("SELECT * FROM bank_cards WHERE user_id = ?", []interface{}{userID}, filter)
+	query, args, err := applyPage(query, args, page)
+	if err != nil {
+		return nil, "", err
+	}
+	if err := s.db.SelectContext(ctx, &cards, query, args...); err != nil {
+		return nil, "", err
+	}
+
+	n, more := trimPage(len(cards), page)
+	cards = cards[:n]
+	if !more {
+		return cards, "", nil
+	}
+	last := cards[n-1]
+	return cards, page.NextToken(last.ID, last.Title, last.CreatedAt, last.UpdatedAt), nil
+}
+
+// Update обновляет данные существующей банковской карты.
+// Если card.Version не равна нулю, карта обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в card.
+func (s *bankCardStorage) Update(ctx context.Context, card *model.BankCard) error {
+	updated := *card
+	updated.UpdatedAt = now()
+	query := `
+		UPDATE bank_cards
+		SET title = :title,
+		    cardholder_name = :cardholder_name,
+		    card_number = :card_number,
+		    expiry_date = :expiry_date,
+		    cvv = :cvv,
+		    metadata = :metadata,
+		    folder = :folder,
+		    tags = :tags,
+		    version = version + 1,
+		    updated_at = :updated_at
+		WHERE id = :id AND user_id = :user_id AND (:version = 0 OR version = :version)
+		RETURNING version`
+	query, args, err := s.db.BindNamed(query, &updated)
+	if err != nil {
+		return err
+	}
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(&card.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return updateMissError(ctx, s.db, "bank_cards", card.UserID, card.ID, fmt.Errorf("bank card with id %s not found", card.ID))
+	}
+	if err != nil {
+		return err
+	}
+	card.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+// Delete удаляет банковскую карту из базы по идентификатору.
+func (s *bankCardStorage) Delete(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM bank_cards WHERE id = ?", id)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("bank card with id %s not found", id)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// sqlExecutor — общий интерфейс *sql.DB и *sql.Tx, используемый хранилищами
+// на database/sql, чтобы они могли работать как с пулом, так и в транзакции.
+type sqlExecutor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// sqlxExecutor — общий интерфейс *sqlx.DB и *sqlx.Tx для хранилищ на sqlx.
+type sqlxExecutor interface {
+	sqlExecutor
+	BindNamed(query string, arg any) (string, []any, error)
+	NamedExecContext(ctx context.Context, query string, arg any) (sql.Result, error)
+	GetContext(ctx context.Context, dest any, query string, args ...any) error
+	SelectContext(ctx context.Context, dest any, query string, args ...any) error
+}
+
+// batchStorage выполняет изменения записей разных типов в одной транзакции.
+type batchStorage struct {
+	db *sqlx.DB
+}
+
+// NewBatchStorage создаёт хранилище пакетных изменений записей.
+// Принимает стандартный *sql.DB и оборачивает его в *sqlx.DB.
+func NewBatchStorage(db *sql.DB) repository.BatchRepository {
+	return &batchStorage{db: sqlx.NewDb(db, DriverName)}
+}
+
+// WithinTx выполняет fn в транзакции и фиксирует её, если fn не вернула ошибку.
+// Журнал изменений заполняется триггерами в той же транзакции.
+func (s *batchStorage) WithinTx(ctx context.Context, fn func(tx repository.BatchTx) error) error {
+	return withinTx(ctx, s.db, func(tx *sqlx.Tx) error {
+		return fn(&batchTx{tx: tx})
+	})
+}
+
+// batchTx — репозитории записей, привязанные к транзакции пакета.
+type batchTx struct {
+	tx         *sqlx.Tx
+	savepoints int
+}
+
+// Credential возвращает репозиторий учётных данных в рамках транзакции.
+func (t *batchTx) Credential() repository.CredentialRepository {
+	return &CredentialStorage{db: t.tx}
+}
+
+// BankCard возвращает репозиторий банковских карт в рамках транзакции.
+func (t *batchTx) BankCard() repository.BankCardRepository {
+	return &bankCardStorage{db: t.tx}
+}
+
+// TextData возвращает репозиторий текстовых данных в рамках транзакции.
+func (t *batchTx) TextData() repository.TextDataRepository {
+	return &textDataStorage{db: t.tx}
+}
+
+// Savepoint выполняет fn между SAVEPOINT и RELEASE; при ошибке fn изменения
+// откатываются до точки сохранения, и пакет продолжается со следующей операции.
+func (t *batchTx) Savepoint(ctx context.Context, fn func() error) error {
+	t.savepoints++
+	name := fmt.Sprintf("batch_op_%d", t.savepoints)
+	if _, err := t.tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return err
+	}
+	if err := fn(); err != nil {
+		if _, rerr := t.tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rerr != nil {
+			return rerr
+		}
+		return err
+	}
+	_, err := t.tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name)
+	return err
+}
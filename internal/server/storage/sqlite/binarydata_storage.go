@@ -0,0 +1,148 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// binaryDataStorage — хранилище бинарных данных.
+type binaryDataStorage struct {
+	db sqlxExecutor
+}
+
+// NewBinaryDataStorage создаёт новое хранилище BinaryData.
+func NewBinaryDataStorage(db *sql.DB) repository.BinaryDataRepository {
+	return &binaryDataStorage{db: sqlx.NewDb(db, DriverName)}
+}
+
+// Save сохраняет новую запись бинарных данных.
+func (s *binaryDataStorage) Save(ctx context.Context, data *model.BinaryData) error {
+	data.ID = uuid.NewString()
+	data.CreatedAt = now()
+	data.UpdatedAt = data.CreatedAt
+	query := `
+		INSERT INTO binary_data (
+			id, user_id, title, storage_path, client_path, size, chunked, checksum, plain_checksum, archive,
+			metadata, folder, tags, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :title, :storage_path, :client_path, :size, :chunked, :checksum, :plain_checksum, :archive,
+			:metadata, :folder, :tags, :created_at, :updated_at
+		)`
+	if _, err := s.db.NamedExecContext(ctx, query, data); err != nil {
+		return err
+	}
+	data.Version = 1 // значение по умолчанию столбца version
+	return nil
+}
+
+// Update изменяет метаданные и пути хранения бинарных данных.
+// Если data.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в data.
+func (s *binaryDataStorage) Update(ctx context.Context, data *model.BinaryData) error {
+	updated := *data
+	updated.UpdatedAt = now()
+	query := `
+		UPDATE binary_data
+		SET title = :title,
+			storage_path = :storage_path,
+			client_path = :client_path,
+			size = :size,
+			chunked = :chunked,
+			checksum = :checksum,
+			plain_checksum = :plain_checksum,
+			archive = :archive,
+			metadata = :metadata,
+			folder = :folder,
+			tags = :tags,
+			version = version + 1,
+			updated_at = :updated_at
+		WHERE id = :id AND user_id = :user_id AND (:version = 0 OR version = :version)
+		RETURNING version`
+
+	query, args, err := s.db.BindNamed(query, &updated)
+	if err != nil {
+		return err
+	}
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(&data.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return updateMissError(ctx, s.db, "binary_data", data.UserID, data.ID, fmt.Errorf("binary data with id %s not found", data.ID))
+	}
+	if err != nil {
+		return err
+	}
+	data.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+// GetByID возвращает запись бинарных данных по id и userID.
+func (s *binaryDataStorage) GetByID(ctx context.Context, userID, id string) (*model.BinaryData, error) {
+	var data model.BinaryData
+	query := `SELECT * FROM binary_data WHERE id = ? AND user_id = ?`
+	err := s.db.GetContext(ctx, &data, query, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// ListByUser возвращает страницу бинарных данных конкретного пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *binaryDataStorage) ListByUser(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.BinaryData, string, error) {
+	query, args := applyListFilter(`SELECT * FROM binary_data WHERE user_id = ?`, []interface{}{userID}, filter)
+	query, args, err := applyPage(query, args, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var list []*model.BinaryData
+	if err := s.db.SelectContext(ctx, &list, query, args...); err != nil {
+		return nil, "", err
+	}
+
+	n, more := trimPage(len(list), page)
+	list = list[:n]
+	if !more {
+		return list, "", nil
+	}
+	last := list[n-1]
+	return list, page.NextToken(last.ID, last.Title, last.CreatedAt, last.UpdatedAt), nil
+}
+
+// StoredRefs возвращает пути файлов и контрольные суммы всех записей и
+// прежних версий, содержимое которых хранится одним файлом.
+func (s *binaryDataStorage) StoredRefs(ctx context.Context) ([]model.StoredRef, error) {
+	var refs []model.StoredRef
+	query := `
+		SELECT user_id, id, storage_path, checksum, FALSE AS version
+		FROM binary_data
+		WHERE storage_path <> '' AND NOT chunked
+		UNION ALL
+		SELECT user_id, id, storage_path, checksum, TRUE AS version
+		FROM binary_versions
+		WHERE storage_path <> '' AND NOT chunked`
+	if err := s.db.SelectContext(ctx, &refs, query); err != nil {
+		return nil, err
+	}
+	return refs, nil
+}
+
+// Delete удаляет запись по id и userID.
+func (s *binaryDataStorage) Delete(ctx context.Context, userID, id string) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM binary_data WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("binary data with id %s not found", id)
+	}
+	return nil
+}
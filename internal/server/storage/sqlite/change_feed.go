@@ -0,0 +1,148 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/pkg/logger"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/changefeed"
+	"go.uber.org/zap"
+)
+
+// changePollInterval — период опроса журнала изменений.
+const changePollInterval = 250 * time.Millisecond
+
+// changePollBatch — наибольшее число изменений, читаемых за один опрос.
+const changePollBatch = 1000
+
+// ChangeFeed раздаёт подписчикам уведомления об изменениях записей.
+//
+// В SQLite нет LISTEN/NOTIFY, поэтому журнал изменений опрашивается по
+// общему номеру feed_id, который триггеры присваивают каждому изменению.
+// Запись журнала хранит только последнее изменение записи, поэтому
+// несколько изменений одной записи между опросами дают одно уведомление —
+// клиенту этого достаточно, чтобы запросить изменения по курсору.
+// Опрос запускается при первой подписке и останавливается в Close.
+type ChangeFeed struct {
+	db  *sql.DB
+	hub *changefeed.Hub
+
+	once   sync.Once
+	last   int64 // последний опубликованный feed_id; -1 — ещё не прочитан
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewChangeFeed создаёт источник уведомлений об изменениях.
+func NewChangeFeed(db *sql.DB) *ChangeFeed {
+	return &ChangeFeed{
+		db:   db,
+		hub:  changefeed.NewHub(),
+		last: -1,
+	}
+}
+
+// Subscribe подписывается на уведомления об изменениях записей пользователя.
+func (f *ChangeFeed) Subscribe(ctx context.Context, userID string) (<-chan model.ChangeEvent, error) {
+	f.once.Do(f.start)
+	return f.hub.Subscribe(ctx, userID)
+}
+
+// Close останавливает опрос и закрывает каналы подписчиков.
+func (f *ChangeFeed) Close() error {
+	f.once.Do(func() {}) // после Close опрос уже не запустится
+	if f.cancel != nil {
+		f.cancel()
+		<-f.done
+	}
+	f.hub.Close()
+	return nil
+}
+
+// start запоминает текущую позицию журнала и запускает фоновый опрос.
+// Изменения, сделанные до первой подписки, не публикуются.
+func (f *ChangeFeed) start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = cancel
+	f.done = make(chan struct{})
+	if err := f.seek(ctx); err != nil {
+		logger.Log.Warn("change feed: failed to read journal position", zap.Error(err))
+	}
+	go f.run(ctx)
+}
+
+// seek устанавливает позицию опроса на конец журнала.
+func (f *ChangeFeed) seek(ctx context.Context) error {
+	var last int64
+	err := f.db.QueryRowContext(ctx, `SELECT COALESCE(MAX(feed_id), 0) FROM change_log`).Scan(&last)
+	if err != nil {
+		return err
+	}
+	f.last = last
+	return nil
+}
+
+// run опрашивает журнал, пока не отменён ctx.
+func (f *ChangeFeed) run(ctx context.Context) {
+	defer close(f.done)
+	ticker := time.NewTicker(changePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		var err error
+		if f.last < 0 {
+			err = f.seek(ctx)
+		} else {
+			err = f.poll(ctx)
+		}
+		if err != nil && ctx.Err() == nil {
+			logger.Log.Warn("change feed poll failed", zap.Error(err))
+		}
+	}
+}
+
+// poll публикует изменения журнала после последней прочитанной позиции.
+func (f *ChangeFeed) poll(ctx context.Context) error {
+	for {
+		n, err := f.pollBatch(ctx)
+		if err != nil || n < changePollBatch {
+			return err
+		}
+	}
+}
+
+// pollBatch публикует не больше changePollBatch изменений и возвращает их число.
+func (f *ChangeFeed) pollBatch(ctx context.Context) (int, error) {
+	rows, err := f.db.QueryContext(ctx, `
+		SELECT feed_id, user_id, seq, item_type, item_id, deleted
+		FROM change_log
+		WHERE feed_id > ?
+		ORDER BY feed_id
+		LIMIT ?`, f.last, changePollBatch)
+	if err != nil {
+		return 0, err
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		var feedID int64
+		var ev model.ChangeEvent
+		if err := rows.Scan(&feedID, &ev.UserID, &ev.Seq, &ev.Type, &ev.ItemID, &ev.Deleted); err != nil {
+			return n, err
+		}
+		f.hub.Publish(ev)
+		f.last = feedID
+		n++
+	}
+	return n, rows.Err()
+}
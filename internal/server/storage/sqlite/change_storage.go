@@ -0,0 +1,134 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// changeStorage — хранилище для чтения журнала изменений (таблица change_log).
+//
+// Журнал заполняется триггерами на таблицах записей, поэтому код,
+// изменяющий записи, о нём не знает.
+type changeStorage struct {
+	db *sqlx.DB
+}
+
+// NewChangeStorage создаёт новое хранилище журнала изменений.
+// Принимает стандартный *sql.DB и оборачивает его в *sqlx.DB.
+func NewChangeStorage(db *sql.DB) repository.ChangeRepository {
+	return &changeStorage{db: sqlx.NewDb(db, DriverName)}
+}
+
+// ListChanges возвращает изменения пользователя после since вместе с содержимым записей.
+//
+// Журнал и записи читаются в одной транзакции, поэтому содержимое записей
+// соответствует тому же снимку, что и журнал.
+func (s *changeStorage) ListChanges(ctx context.Context, userID string, since int64, limit int) ([]model.Change, error) {
+	tx, err := s.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }() // после Commit откат ничего не делает
+
+	var changes []model.Change
+	err = tx.SelectContext(ctx, &changes, `
+		SELECT seq, item_type, item_id, deleted, changed_at
+		FROM change_log
+		WHERE user_id = ? AND seq > ?
+		ORDER BY seq
+		LIMIT ?`, userID, since, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, nil
+	}
+
+	// Загружаем содержимое записей только тех типов, что встретились в порции
+	upto := changes[len(changes)-1].Seq
+	loaded := make(map[model.ItemType]bool)
+	for _, ch := range changes {
+		if ch.Deleted || loaded[ch.Type] {
+			continue
+		}
+		loaded[ch.Type] = true
+		if err := s.loadItems(ctx, tx, changes, ch.Type, userID, since, upto); err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, tx.Commit()
+}
+
+// changedItemsQuery выбирает записи таблицы, изменения которых попали в диапазон (since, upto].
+const changedItemsQuery = `
+	SELECT %s FROM %s t
+	JOIN change_log l ON l.item_id = t.id AND l.item_type = ?
+	WHERE l.user_id = ? AND l.seq > ? AND l.seq <= ? AND NOT l.deleted`
+
+// loadItems загружает записи указанного типа и прикрепляет их к соответствующим изменениям.
+func (s *changeStorage) loadItems(ctx context.Context, tx *sqlx.Tx, changes []model.Change, itemType model.ItemType, userID string, since, upto int64) error {
+	table, err := itemTable(itemType)
+	if err != nil {
+		return err
+	}
+	args := []interface{}{string(itemType), userID, since, upto}
+
+	byID := make(map[string]*model.Change, len(changes))
+	for i := range changes {
+		if changes[i].Type == itemType && !changes[i].Deleted {
+			byID[changes[i].ItemID] = &changes[i]
+		}
+	}
+
+	switch itemType {
+	case model.ItemTypeCredential:
+		creds, err := selectCredentials(ctx, tx, fmt.Sprintf(changedItemsQuery,
+			"t.id, t.user_id, t.title, t.login, t.password, t.metadata, t.folder, t.tags, t.favorite, t.last_accessed_at, t.created_at, t.updated_at, t.version",
+			table), args...)
+		if err != nil {
+			return err
+		}
+		for i := range creds {
+			if ch, ok := byID[creds[i].ID]; ok {
+				ch.Credential = &creds[i]
+			}
+		}
+	case model.ItemTypeBankCard:
+		var cards []model.BankCard
+		if err := tx.SelectContext(ctx, &cards, fmt.Sprintf(changedItemsQuery, "t.*", table), args...); err != nil {
+			return err
+		}
+		for i := range cards {
+			if ch, ok := byID[cards[i].ID]; ok {
+				ch.BankCard = &cards[i]
+			}
+		}
+	case model.ItemTypeTextData:
+		var texts []model.TextData
+		if err := tx.SelectContext(ctx, &texts, fmt.Sprintf(changedItemsQuery, "t.*", table), args...); err != nil {
+			return err
+		}
+		for i := range texts {
+			if ch, ok := byID[texts[i].ID]; ok {
+				ch.TextData = &texts[i]
+			}
+		}
+	case model.ItemTypeBinaryData:
+		var files []model.BinaryData
+		if err := tx.SelectContext(ctx, &files, fmt.Sprintf(changedItemsQuery, "t.*", table), args...); err != nil {
+			return err
+		}
+		for i := range files {
+			if ch, ok := byID[files[i].ID]; ok {
+				ch.BinaryData = &files[i]
+			}
+		}
+	}
+	return nil
+}
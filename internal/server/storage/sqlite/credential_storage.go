@@ -0,0 +1,184 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// credentialColumns — столбцы учётных данных в порядке сканирования (см. scanCredential).
+const credentialColumns = `id, user_id, title, login, password, metadata, folder, tags, favorite, last_accessed_at, created_at, updated_at, version`
+
+// CredentialStorage реализует интерфейс CredentialRepository для SQLite.
+type CredentialStorage struct {
+	db sqlExecutor
+}
+
+// NewCredentialStorage создаёт новый экземпляр CredentialStorage.
+func NewCredentialStorage(db *sql.DB) *CredentialStorage {
+	return &CredentialStorage{db: db}
+}
+
+// Create сохраняет новую запись учётных данных
+func (s *CredentialStorage) Create(ctx context.Context, cred *model.Credential) error {
+	query := `
+		INSERT INTO credentials (id, user_id, title, login, password, metadata, folder, tags, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+	ts := now()
+	_, err := s.db.ExecContext(ctx, query,
+		cred.ID,
+		cred.UserID,
+		cred.Title,
+		cred.Login,
+		cred.Password,
+		cred.Metadata,
+		cred.Folder,
+		cred.Tags,
+		ts,
+		ts,
+	)
+	if err != nil {
+		return err
+	}
+	cred.CreatedAt, cred.UpdatedAt = ts, ts
+	cred.Version = 1 // значение по умолчанию столбца version
+	return nil
+}
+
+// GetByID возвращает запись по ID
+func (s *CredentialStorage) GetByID(ctx context.Context, id string) (*model.Credential, error) {
+	row := s.db.QueryRowContext(ctx, `SELECT `+credentialColumns+` FROM credentials WHERE id = ?`, id)
+	cred, err := scanCredential(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.New("credential not found")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return cred, nil
+}
+
+// GetByUserID возвращает страницу записей пользователя, удовлетворяющих фильтру,
+// и токен следующей страницы (пустой, если страница последняя).
+func (s *CredentialStorage) GetByUserID(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]model.Credential, string, error) {
+	query, args := applyListFilter(`SELECT `+credentialColumns+` FROM credentials WHERE user_id = ?`,
+		[]interface{}{userID}, filter)
+	query, args, err := applyPage(query, args, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	creds, err := selectCredentials(ctx, s.db, query, args...)
+	if err != nil {
+		return nil, "", err
+	}
+
+	n, more := trimPage(len(creds), page)
+	creds = creds[:n]
+	if !more {
+		return creds, "", nil
+	}
+	last := creds[n-1]
+	return creds, page.NextToken(last.ID, last.Title, last.CreatedAt, last.UpdatedAt), nil
+}
+
+// Update изменяет существующую запись.
+// Если cred.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в cred.
+func (s *CredentialStorage) Update(ctx context.Context, cred *model.Credential) error {
+	query := `
+		UPDATE credentials
+		SET title = ?, login = ?, password = ?, metadata = ?, folder = ?, tags = ?,
+		    version = version + 1, updated_at = ?
+		WHERE id = ? AND user_id = ? AND (? = 0 OR version = ?)
+		RETURNING version`
+	ts := now()
+	err := s.db.QueryRowContext(ctx, query,
+		cred.Title,
+		cred.Login,
+		cred.Password,
+		cred.Metadata,
+		cred.Folder,
+		cred.Tags,
+		ts,
+		cred.ID,
+		cred.UserID,
+		cred.Version,
+		cred.Version,
+	).Scan(&cred.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return updateMissError(ctx, s.db, "credentials", cred.UserID, cred.ID, errors.New("credential not found"))
+	}
+	if err != nil {
+		return err
+	}
+	cred.UpdatedAt = ts
+	return nil
+}
+
+// Delete удаляет запись по ID
+func (s *CredentialStorage) Delete(ctx context.Context, id string) error {
+	res, err := s.db.ExecContext(ctx, `DELETE FROM credentials WHERE id = ?`, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("credential not found")
+	}
+	return nil
+}
+
+// rowScanner — общий интерфейс *sql.Row и *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+// scanCredential сканирует столбцы credentialColumns.
+// Модель Credential не размечена тегами db, поэтому поля сканируются явно.
+func scanCredential(row rowScanner) (*model.Credential, error) {
+	var cred model.Credential
+	if err := row.Scan(
+		&cred.ID,
+		&cred.UserID,
+		&cred.Title,
+		&cred.Login,
+		&cred.Password,
+		&cred.Metadata,
+		&cred.Folder,
+		&cred.Tags,
+		&cred.Favorite,
+		&cred.LastAccessedAt,
+		&cred.CreatedAt,
+		&cred.UpdatedAt,
+		&cred.Version,
+	); err != nil {
+		return nil, err
+	}
+	return &cred, nil
+}
+
+// selectCredentials выполняет запрос и сканирует учётные данные.
+func selectCredentials(ctx context.Context, db sqlExecutor, query string, args ...interface{}) ([]model.Credential, error) {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var creds []model.Credential
+	for rows.Next() {
+		cred, err := scanCredential(rows)
+		if err != nil {
+			return nil, err
+		}
+		creds = append(creds, *cred)
+	}
+	return creds, rows.Err()
+}
@@ -0,0 +1,22 @@
+// Package sqlite предоставляет реализацию хранилища данных для GophKeeper
+// на основе SQLite — для однопользовательских и небольших установок, которым
+// не нужен отдельный сервер PostgreSQL.
+//
+// Репозитории повторяют поведение пакета postgres:
+//
+//   - UserStorage       — управление пользователями.
+//   - TextDataStorage   — хранение произвольных текстовых данных.
+//   - BinaryDataStorage — хранение бинарных данных (ссылки на файлы).
+//   - ChunkStorage      — фрагменты файлов со счётчиками ссылок и манифесты файлов.
+//   - BankCardStorage   — хранение данных банковских карт.
+//   - CredentialStorage — хранение пар логин/пароль.
+//   - BatchStorage      — транзакция пакетных изменений с точками сохранения.
+//   - ChangeFeed        — уведомления об изменениях записей опросом журнала.
+//
+// Схема ведётся собственными миграциями (см. migrations.RunSQLiteMigrations),
+// журнал изменений заполняется триггерами. Драйвер modernc.org/sqlite написан
+// на чистом Go, поэтому хранилище работает в сборках без cgo. Время хранится
+// в UTC в текстовом формате SQLite (параметр _time_format=sqlite), поэтому все
+// значения времени перед записью приводятся к UTC: тогда сравнение строк
+// совпадает со сравнением моментов.
+package sqlite
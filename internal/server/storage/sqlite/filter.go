@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// applyListFilter дополняет WHERE-часть запроса условиями фильтра по папке и тегам.
+//
+// query должен заканчиваться условием WHERE (без ORDER BY), args — уже
+// использованные аргументы. Теги хранятся в колонке tags через запятую,
+// поэтому каждый тег фильтра ищется как отдельный элемент списка.
+func applyListFilter(query string, args []interface{}, filter model.ListFilter) (string, []interface{}) {
+	var sb strings.Builder
+	sb.WriteString(query)
+
+	if filter.Folder != "" {
+		args = append(args, filter.Folder)
+		sb.WriteString(" AND folder = ?")
+	}
+	for _, tag := range filter.Tags {
+		args = append(args, tag)
+		sb.WriteString(" AND instr(',' || tags || ',', ',' || ? || ',') > 0")
+	}
+
+	return sb.String(), args
+}
+
+// pageColumns сопоставляет поля сортировки с колонками таблиц записей.
+var pageColumns = map[model.SortField]string{
+	model.SortByCreated: "created_at",
+	model.SortByUpdated: "updated_at",
+	model.SortByTitle:   "title",
+}
+
+// applyPage дополняет запрос условием курсора, сортировкой и ограничением размера страницы.
+//
+// Устроена так же, как одноимённая функция пакета postgres: сортировка по полю
+// page.SortBy и затем по id, запрашивается на одну запись больше размера
+// страницы (см. trimPage).
+func applyPage(query string, args []interface{}, page model.PageRequest) (string, []interface{}, error) {
+	if err := page.Validate(); err != nil {
+		return "", nil, err
+	}
+	page = page.Normalize()
+	column := pageColumns[page.SortBy]
+
+	cursor, err := page.Cursor()
+	if err != nil {
+		return "", nil, err
+	}
+
+	op, dir := ">", "ASC"
+	if page.Desc {
+		op, dir = "<", "DESC"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(query)
+
+	if cursor != nil {
+		var value interface{} = cursor.Value
+		if page.SortBy != model.SortByTitle {
+			t, err := cursor.Time()
+			if err != nil {
+				return "", nil, err
+			}
+			value = t.UTC()
+		}
+		args = append(args, value, cursor.ID)
+		fmt.Fprintf(&sb, " AND (%s, id) %s (?, ?)", column, op)
+	}
+
+	args = append(args, page.Size+1)
+	fmt.Fprintf(&sb, " ORDER BY %s %s, id %s LIMIT ?", column, dir, dir)
+
+	return sb.String(), args, nil
+}
+
+// trimPage сообщает, вернул ли запрос, построенный applyPage, больше записей,
+// чем помещается на страницу, и возвращает количество записей текущей страницы.
+func trimPage(n int, page model.PageRequest) (int, bool) {
+	size := page.Normalize().Size
+	if n > size {
+		return size, true
+	}
+	return n, false
+}
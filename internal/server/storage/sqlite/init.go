@@ -0,0 +1,70 @@
+package sqlite
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/ryabkov82/gophkeeper/internal/migrations"
+
+	// Драйвер на чистом Go: сервер и клиент собираются без cgo
+	_ "modernc.org/sqlite"
+)
+
+// DriverName — имя драйвера database/sql для SQLite.
+const DriverName = "sqlite"
+
+// defaultParams — параметры подключения, добавляемые к DSN, если они не заданы явно:
+// проверка внешних ключей (нужна для каскадного удаления), ожидание
+// блокировки вместо немедленной ошибки, журнал WAL для параллельного чтения,
+// захват блокировки записи в начале транзакции, чтобы транзакция,
+// читающая перед записью, не завершалась ошибкой при конкурентной записи,
+// и запись времени в текстовом формате SQLite.
+var defaultParams = []string{
+	"_pragma=foreign_keys(1)",
+	"_pragma=busy_timeout(5000)",
+	"_pragma=journal_mode(WAL)",
+	"_txlock=immediate",
+	"_time_format=sqlite",
+}
+
+// Init открывает базу данных SQLite по пути или DSN и применяет миграции.
+func Init(dsn string) (*sql.DB, error) {
+	db, err := sql.Open(DriverName, withDefaultParams(dsn))
+	if err != nil {
+		return nil, fmt.Errorf("db open failed: %w", err)
+	}
+
+	if err := db.Ping(); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("db ping failed: %w", err)
+	}
+
+	if err := migrations.RunSQLiteMigrations(db); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("run migrations: %w", err)
+	}
+
+	return db, nil
+}
+
+// withDefaultParams дополняет dsn параметрами defaultParams, которых в нём нет.
+func withDefaultParams(dsn string) string {
+	sep := "?"
+	if strings.Contains(dsn, "?") {
+		sep = "&"
+	}
+	for _, p := range defaultParams {
+		// Прагмы различаются по имени прагмы, остальные параметры — по имени параметра
+		name := p[:strings.IndexByte(p, '=')+1]
+		if i := strings.IndexByte(p, '('); i >= 0 {
+			name = p[:i+1]
+		}
+		if strings.Contains(dsn, "?"+name) || strings.Contains(dsn, "&"+name) {
+			continue
+		}
+		dsn += sep + p
+		sep = "&"
+	}
+	return dsn
+}
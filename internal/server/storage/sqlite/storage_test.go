@@ -0,0 +1,39 @@
+package sqlite_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/sqlite"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/storagetest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageSuite(t *testing.T) {
+	storagetest.Run(t, func(t *testing.T) repository.StorageFactory {
+		db, err := sqlite.Init(filepath.Join(t.TempDir(), "keeper.db"))
+		require.NoError(t, err)
+		f := storage.NewSQLiteFactory(db)
+		t.Cleanup(func() { _ = f.Close() })
+		return f
+	})
+}
+
+func TestInit_Reopen(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keeper.db")
+	db, err := sqlite.Init(path)
+	require.NoError(t, err)
+	require.NoError(t, sqlite.NewUserStorage(db).CreateUser(context.Background(), "alice", "hash", "salt"))
+	require.NoError(t, db.Close())
+
+	// повторное открытие не применяет миграции заново и сохраняет данные
+	db, err = sqlite.Init(path + "?_pragma=busy_timeout(1000)")
+	require.NoError(t, err)
+	defer db.Close()
+	user, err := sqlite.NewUserStorage(db).GetUserByLogin(context.Background(), "alice")
+	require.NoError(t, err)
+	require.NotNil(t, user)
+}
@@ -0,0 +1,126 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+)
+
+// textDataStorage — хранилище произвольных текстовых данных.
+type textDataStorage struct {
+	db sqlxExecutor
+}
+
+// NewTextDataStorage создаёт новое хранилище TextData.
+// Принимает стандартный *sql.DB и оборачивает его в *sqlx.DB.
+func NewTextDataStorage(db *sql.DB) repository.TextDataRepository {
+	return &textDataStorage{db: sqlx.NewDb(db, DriverName)}
+}
+
+// Create сохраняет новую запись TextData.
+func (s *textDataStorage) Create(ctx context.Context, data *model.TextData) error {
+	data.ID = uuid.NewString()
+	data.CreatedAt = now()
+	data.UpdatedAt = data.CreatedAt
+	query := `
+		INSERT INTO text_data (
+			id, user_id, title, content, metadata, folder, tags, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :title, :content, :metadata, :folder, :tags, :created_at, :updated_at
+		)`
+	if _, err := s.db.NamedExecContext(ctx, query, data); err != nil {
+		return err
+	}
+	data.Version = 1 // значение по умолчанию столбца version
+	return nil
+}
+
+// GetByID возвращает полную запись TextData по id и userID.
+func (s *textDataStorage) GetByID(ctx context.Context, userID, id string) (*model.TextData, error) {
+	var data model.TextData
+	query := `SELECT * FROM text_data WHERE id = ? AND user_id = ?`
+	err := s.db.GetContext(ctx, &data, query, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &data, nil
+}
+
+// Update обновляет существующую запись TextData.
+// Если data.Version не равна нулю, запись обновляется только при совпадении версии,
+// иначе возвращается model.ErrVersionConflict. Новые версия и время обновления
+// записываются в data.
+func (s *textDataStorage) Update(ctx context.Context, data *model.TextData) error {
+	updated := *data
+	updated.UpdatedAt = now()
+	query := `
+		UPDATE text_data
+		SET title = :title,
+		    content = :content,
+		    metadata = :metadata,
+		    folder = :folder,
+		    tags = :tags,
+		    version = version + 1,
+		    updated_at = :updated_at
+		WHERE id = :id AND user_id = :user_id AND (:version = 0 OR version = :version)
+		RETURNING version`
+	query, args, err := s.db.BindNamed(query, &updated)
+	if err != nil {
+		return err
+	}
+	err = s.db.QueryRowContext(ctx, query, args...).Scan(&data.Version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return updateMissError(ctx, s.db, "text_data", data.UserID, data.ID, fmt.Errorf("text data with id %s not found", data.ID))
+	}
+	if err != nil {
+		return err
+	}
+	data.UpdatedAt = updated.UpdatedAt
+	return nil
+}
+
+// Delete удаляет запись TextData по id и userID.
+func (s *textDataStorage) Delete(ctx context.Context, userID, id string) error {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM text_data WHERE id = ? AND user_id = ?", id, userID)
+	if err != nil {
+		return err
+	}
+	rows, _ := res.RowsAffected()
+	if rows == 0 {
+		return fmt.Errorf("text data with id %s not found", id)
+	}
+	return nil
+}
+
+// ListTitles возвращает страницу записей пользователя с ID, Title, Folder, Tags,
+// Favorite, LastAccessedAt и временем создания/обновления,
+// удовлетворяющих фильтру, и токен следующей страницы.
+func (s *textDataStorage) ListTitles(ctx context.Context, userID string, filter model.ListFilter, page model.PageRequest) ([]*model.TextData, string, error) {
+	query, args := applyListFilter(`
+		SELECT id, title, folder, tags, favorite, last_accessed_at, created_at, updated_at, version
+		FROM text_data
+		WHERE user_id = ?`, []interface{}{userID}, filter)
+	query, args, err := applyPage(query, args, page)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var list []*model.TextData
+	if err := s.db.SelectContext(ctx, &list, query, args...); err != nil {
+		return nil, "", err
+	}
+
+	n, more := trimPage(len(list), page)
+	list = list[:n]
+	if !more {
+		return list, "", nil
+	}
+	last := list[n-1]
+	return list, page.NextToken(last.ID, last.Title, last.CreatedAt, last.UpdatedAt), nil
+}
@@ -0,0 +1,54 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+)
+
+// UserStorage реализует хранилище пользователей с использованием SQLite.
+type UserStorage struct {
+	db *sql.DB
+}
+
+// NewUserStorage создаёт новый экземпляр UserStorage.
+func NewUserStorage(db *sql.DB) *UserStorage {
+	return &UserStorage{db: db}
+}
+
+// CreateUser сохраняет нового пользователя в базе данных.
+// Идентификатор пользователя формируется приложением.
+//
+// Возвращает ошибку, если пользователь не может быть добавлен
+// (например, логин уже существует или возникает ошибка SQL).
+func (s *UserStorage) CreateUser(ctx context.Context, login, hash, salt string) error {
+	query := `
+		INSERT INTO users (id, login, password_hash, salt)
+		VALUES (?, ?, ?, ?)`
+	_, err := s.db.ExecContext(ctx, query, uuid.NewString(), login, hash, salt)
+	return err
+}
+
+// GetUserByLogin находит пользователя по логину.
+//
+// Возвращает (nil, nil), если пользователь не существует.
+func (s *UserStorage) GetUserByLogin(ctx context.Context, login string) (*model.User, error) {
+	query := `
+		SELECT id, login, password_hash, salt
+		FROM users
+		WHERE login = ?`
+	row := s.db.QueryRowContext(ctx, query, login)
+
+	var user model.User
+	err := row.Scan(&user.ID, &user.Login, &user.PasswordHash, &user.Salt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
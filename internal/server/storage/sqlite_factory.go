@@ -0,0 +1,109 @@
+package storage
+
+import (
+	"database/sql"
+
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/ryabkov82/gophkeeper/internal/server/storage/sqlite"
+)
+
+// sqliteFactory — реализация StorageFactory для работы с SQLite.
+type sqliteFactory struct {
+	db             *sql.DB
+	userRepo       repository.UserRepository
+	credentialRepo repository.CredentialRepository
+	bankCardRepo   repository.BankCardRepository
+	textDataRepo   repository.TextDataRepository
+	binaryDataRepo repository.BinaryDataRepository
+	chunkRepo      repository.ChunkRepository
+	versionRepo    repository.BinaryVersionRepository
+	itemRepo       repository.ItemRepository
+	changeRepo     repository.ChangeRepository
+	changeFeed     *sqlite.ChangeFeed
+	batchRepo      repository.BatchRepository
+}
+
+// NewSQLiteFactory создаёт фабрику sqliteFactory с репозиториями,
+// подключенными к указанной базе данных SQLite (см. sqlite.Init).
+func NewSQLiteFactory(db *sql.DB) repository.StorageFactory {
+	return &sqliteFactory{
+		db:             db,
+		userRepo:       sqlite.NewUserStorage(db),
+		credentialRepo: sqlite.NewCredentialStorage(db),
+		bankCardRepo:   sqlite.NewBankCardStorage(db),
+		textDataRepo:   sqlite.NewTextDataStorage(db),
+		binaryDataRepo: sqlite.NewBinaryDataStorage(db),
+		chunkRepo:      sqlite.NewChunkStorage(db),
+		versionRepo:    sqlite.NewBinaryVersionStorage(db),
+		itemRepo:       sqlite.NewItemStorage(db),
+		changeRepo:     sqlite.NewChangeStorage(db),
+		changeFeed:     sqlite.NewChangeFeed(db),
+		batchRepo:      sqlite.NewBatchStorage(db),
+	}
+}
+
+// User возвращает репозиторий для работы с пользователями.
+func (f *sqliteFactory) User() repository.UserRepository {
+	return f.userRepo
+}
+
+// Credential возвращает репозиторий для работы с Credential.
+func (f *sqliteFactory) Credential() repository.CredentialRepository {
+	return f.credentialRepo
+}
+
+// BankCard возвращает репозиторий для работы с BankCard.
+func (f *sqliteFactory) BankCard() repository.BankCardRepository {
+	return f.bankCardRepo
+}
+
+// TextData возвращает репозиторий для работы с TextData.
+func (f *sqliteFactory) TextData() repository.TextDataRepository {
+	return f.textDataRepo
+}
+
+// BinaryData возвращает репозиторий для работы с BinaryData.
+func (f *sqliteFactory) BinaryData() repository.BinaryDataRepository {
+	return f.binaryDataRepo
+}
+
+// Chunk возвращает репозиторий фрагментов файлов и манифестов.
+func (f *sqliteFactory) Chunk() repository.ChunkRepository {
+	return f.chunkRepo
+}
+
+// BinaryVersion возвращает репозиторий прежних версий содержимого файлов.
+func (f *sqliteFactory) BinaryVersion() repository.BinaryVersionRepository {
+	return f.versionRepo
+}
+
+// Item возвращает репозиторий общих операций над записями (избранное, недавние).
+func (f *sqliteFactory) Item() repository.ItemRepository {
+	return f.itemRepo
+}
+
+// Change возвращает репозиторий журнала изменений записей.
+func (f *sqliteFactory) Change() repository.ChangeRepository {
+	return f.changeRepo
+}
+
+// ChangeFeed возвращает источник уведомлений об изменениях записей (опрос журнала).
+func (f *sqliteFactory) ChangeFeed() repository.ChangeFeed {
+	return f.changeFeed
+}
+
+// Batch возвращает репозиторий пакетных изменений записей в одной транзакции.
+func (f *sqliteFactory) Batch() repository.BatchRepository {
+	return f.batchRepo
+}
+
+// Close останавливает опрос журнала и закрывает соединение с базой данных.
+func (f *sqliteFactory) Close() error {
+	if f.changeFeed != nil {
+		_ = f.changeFeed.Close()
+	}
+	if f.db != nil {
+		return f.db.Close()
+	}
+	return nil
+}
@@ -0,0 +1,618 @@
+// Package storagetest содержит общий набор тестов репозиториев, которым
+// проверяется каждая реализация repository.StorageFactory. Набор описывает
+// поведение, на которое полагаются сервисы: версии записей, постраничный
+// вывод, журнал изменений, счётчики ссылок фрагментов и т.п.
+//
+// Реализация подключает набор в своих тестах:
+//
+//	func TestStorageSuite(t *testing.T) {
+//		storagetest.Run(t, func(t *testing.T) repository.StorageFactory { ... })
+//	}
+//
+// Каждый тест создаёт собственных пользователей с уникальными логинами,
+// поэтому набор можно запускать на общей базе данных.
+package storagetest
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/ryabkov82/gophkeeper/internal/domain/model"
+	"github.com/ryabkov82/gophkeeper/internal/domain/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// FactoryFunc возвращает фабрику репозиториев для теста; освобождение
+// ресурсов фабрики регистрируется через t.Cleanup.
+type FactoryFunc func(t *testing.T) repository.StorageFactory
+
+// Run запускает весь набор тестов для фабрик, созданных newFactory.
+func Run(t *testing.T, newFactory FactoryFunc) {
+	tests := []struct {
+		name string
+		fn   func(t *testing.T, f repository.StorageFactory)
+	}{
+		{"User", testUser},
+		{"Credential", testCredential},
+		{"BankCard", testBankCard},
+		{"TextData", testTextData},
+		{"BinaryData", testBinaryData},
+		{"Pagination", testPagination},
+		{"Chunks", testChunks},
+		{"BinaryVersions", testBinaryVersions},
+		{"Items", testItems},
+		{"Changes", testChanges},
+		{"ChangeFeed", testChangeFeed},
+		{"Batch", testBatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.fn(t, newFactory(t))
+		})
+	}
+}
+
+// newUser создаёт пользователя с уникальным логином и возвращает его идентификатор.
+func newUser(t *testing.T, f repository.StorageFactory) string {
+	t.Helper()
+	ctx := context.Background()
+	login := "user-" + uuid.NewString()
+	require.NoError(t, f.User().CreateUser(ctx, login, "hash", "salt"))
+	user, err := f.User().GetUserByLogin(ctx, login)
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	return user.ID
+}
+
+// chunkID возвращает идентификатор фрагмента — SHA-256 строки s.
+func chunkID(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func testUser(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	login := "user-" + uuid.NewString()
+	require.NoError(t, f.User().CreateUser(ctx, login, "hash", "salt"))
+
+	user, err := f.User().GetUserByLogin(ctx, login)
+	require.NoError(t, err)
+	require.NotNil(t, user)
+	assert.NotEmpty(t, user.ID)
+	assert.Equal(t, login, user.Login)
+	assert.Equal(t, "hash", user.PasswordHash)
+	assert.Equal(t, "salt", user.Salt)
+
+	assert.Error(t, f.User().CreateUser(ctx, login, "other", "salt"), "логин уникален")
+
+	missing, err := f.User().GetUserByLogin(ctx, "missing-"+uuid.NewString())
+	require.NoError(t, err)
+	assert.Nil(t, missing)
+}
+
+func testCredential(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	otherID := newUser(t, f)
+	repo := f.Credential()
+
+	cred := &model.Credential{
+		ID: uuid.NewString(), UserID: userID, Title: "GitHub", Login: "octo", Password: "secret",
+		Metadata: "meta", Folder: "work", Tags: model.Tags{"dev", "git"},
+	}
+	require.NoError(t, repo.Create(ctx, cred))
+	assert.EqualValues(t, 1, cred.Version)
+
+	got, err := repo.GetByID(ctx, cred.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "octo", got.Login)
+	assert.Equal(t, model.Tags{"dev", "git"}, got.Tags)
+	assert.Nil(t, got.LastAccessedAt)
+	assert.False(t, got.CreatedAt.IsZero())
+
+	got.Password = "new-secret"
+	require.NoError(t, repo.Update(ctx, got))
+	assert.EqualValues(t, 2, got.Version)
+
+	stale := *got
+	stale.Version = 1
+	assert.ErrorIs(t, repo.Update(ctx, &stale), model.ErrVersionConflict)
+
+	forced := *got
+	forced.Version = 0 // без проверки версии
+	require.NoError(t, repo.Update(ctx, &forced))
+	assert.EqualValues(t, 3, forced.Version)
+
+	foreign := *got
+	foreign.UserID = otherID
+	foreign.Version = 3
+	err = repo.Update(ctx, &foreign)
+	require.Error(t, err, "запись другого пользователя не обновляется")
+	assert.NotErrorIs(t, err, model.ErrVersionConflict)
+
+	missing := &model.Credential{ID: uuid.NewString(), UserID: userID}
+	err = repo.Update(ctx, missing)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, model.ErrVersionConflict)
+
+	list, next, err := repo.GetByUserID(ctx, userID, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, next)
+	require.Len(t, list, 1)
+	assert.Equal(t, "new-secret", list[0].Password)
+
+	require.NoError(t, repo.Delete(ctx, cred.ID))
+	assert.Error(t, repo.Delete(ctx, cred.ID))
+	_, err = repo.GetByID(ctx, cred.ID)
+	assert.Error(t, err)
+}
+
+func testBankCard(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	otherID := newUser(t, f)
+	repo := f.BankCard()
+
+	card := &model.BankCard{
+		UserID: userID, Title: "Visa", CardholderName: "IVAN", CardNumber: "4111",
+		ExpiryDate: "12/30", CVV: "123", Folder: "bank", Tags: model.Tags{"visa"},
+	}
+	require.NoError(t, repo.Create(ctx, card))
+	require.NotEmpty(t, card.ID)
+	assert.EqualValues(t, 1, card.Version)
+
+	got, err := repo.GetByID(ctx, card.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "4111", got.CardNumber)
+	assert.Equal(t, model.Tags{"visa"}, got.Tags)
+
+	got.CVV = "456"
+	require.NoError(t, repo.Update(ctx, got))
+	assert.EqualValues(t, 2, got.Version)
+	assert.False(t, got.UpdatedAt.Before(got.CreatedAt))
+
+	got.Version = 1
+	assert.ErrorIs(t, repo.Update(ctx, got), model.ErrVersionConflict)
+
+	foreign := *got
+	foreign.UserID = otherID
+	foreign.Version = 0
+	err = repo.Update(ctx, &foreign)
+	require.Error(t, err, "запись другого пользователя не обновляется")
+	assert.NotErrorIs(t, err, model.ErrVersionConflict)
+
+	list, _, err := repo.GetByUser(ctx, userID, model.ListFilter{Tags: []string{"visa"}}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "456", list[0].CVV)
+
+	list, _, err = repo.GetByUser(ctx, userID, model.ListFilter{Tags: []string{"vis"}}, model.PageRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, list, "тег ищется целиком, а не подстрокой")
+
+	require.NoError(t, repo.Delete(ctx, card.ID))
+	assert.Error(t, repo.Delete(ctx, card.ID))
+}
+
+func testTextData(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	otherID := newUser(t, f)
+	repo := f.TextData()
+
+	data := &model.TextData{UserID: userID, Title: "note", Content: []byte{0, 1, 2, 255}, Folder: "notes"}
+	require.NoError(t, repo.Create(ctx, data))
+
+	got, err := repo.GetByID(ctx, userID, data.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 1, 2, 255}, got.Content)
+
+	_, err = repo.GetByID(ctx, otherID, data.ID)
+	assert.Error(t, err, "запись другого пользователя недоступна")
+
+	got.Content = []byte("updated")
+	require.NoError(t, repo.Update(ctx, got))
+	assert.EqualValues(t, 2, got.Version)
+
+	foreign := *got
+	foreign.UserID = otherID
+	foreign.Version = 1
+	err = repo.Update(ctx, &foreign)
+	require.Error(t, err)
+	assert.NotErrorIs(t, err, model.ErrVersionConflict, "чужая запись выглядит как отсутствующая")
+
+	titles, _, err := repo.ListTitles(ctx, userID, model.ListFilter{Folder: "notes"}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, titles, 1)
+	assert.Equal(t, "note", titles[0].Title)
+	assert.Empty(t, titles[0].Content)
+	assert.EqualValues(t, 2, titles[0].Version)
+
+	titles, _, err = repo.ListTitles(ctx, userID, model.ListFilter{Folder: "other"}, model.PageRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, titles)
+
+	assert.Error(t, repo.Delete(ctx, otherID, data.ID))
+	require.NoError(t, repo.Delete(ctx, userID, data.ID))
+}
+
+func testBinaryData(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	repo := f.BinaryData()
+
+	data := &model.BinaryData{
+		UserID: userID, Title: "photo", StoragePath: "u/photo", ClientPath: "/tmp/photo.jpg",
+		Size: 42, Checksum: "abc",
+	}
+	require.NoError(t, repo.Save(ctx, data))
+
+	got, err := repo.GetByID(ctx, userID, data.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 42, got.Size)
+	assert.Equal(t, "abc", got.Checksum)
+
+	got.Size = 100
+	got.StoragePath = "u/photo2"
+	require.NoError(t, repo.Update(ctx, got))
+	assert.EqualValues(t, 2, got.Version)
+
+	list, _, err := repo.ListByUser(ctx, userID, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.EqualValues(t, 100, list[0].Size)
+
+	refs, err := repo.StoredRefs(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, refs, model.StoredRef{UserID: userID, ID: data.ID, StoragePath: "u/photo2", Checksum: "abc"})
+
+	require.NoError(t, repo.Delete(ctx, userID, data.ID))
+	assert.Error(t, repo.Delete(ctx, userID, data.ID))
+}
+
+func testPagination(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	repo := f.BankCard()
+
+	var titles []string
+	for i := 0; i < 5; i++ {
+		title := fmt.Sprintf("card-%d", i)
+		titles = append(titles, title)
+		require.NoError(t, repo.Create(ctx, &model.BankCard{UserID: userID, Title: title}))
+		time.Sleep(2 * time.Millisecond) // различимое время создания
+	}
+
+	collect := func(page model.PageRequest) []string {
+		var got []string
+		for {
+			cards, next, err := repo.GetByUser(ctx, userID, model.ListFilter{}, page)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(cards), page.Size)
+			for _, c := range cards {
+				got = append(got, c.Title)
+			}
+			if next == "" {
+				return got
+			}
+			page.Token = next
+		}
+	}
+
+	assert.Equal(t, titles, collect(model.PageRequest{Size: 2, SortBy: model.SortByCreated}))
+	assert.Equal(t, []string{"card-4", "card-3", "card-2", "card-1", "card-0"},
+		collect(model.PageRequest{Size: 2, SortBy: model.SortByCreated, Desc: true}))
+	assert.Equal(t, titles, collect(model.PageRequest{Size: 3, SortBy: model.SortByTitle}))
+	assert.Equal(t, titles, collect(model.PageRequest{Size: 1, SortBy: model.SortByUpdated}))
+}
+
+func testChunks(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	repo := f.Chunk()
+
+	a, b, c := chunkID("a"), chunkID("b"), chunkID("c")
+	for i, id := range []string{a, b, c} {
+		added, err := repo.Add(ctx, &model.Chunk{UserID: userID, ID: id, StoragePath: "chunks/" + id, Size: int64(10 * (i + 1))})
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+	added, err := repo.Add(ctx, &model.Chunk{UserID: userID, ID: a, StoragePath: "chunks/" + a, Size: 10})
+	require.NoError(t, err)
+	assert.False(t, added, "фрагмент уже зарегистрирован")
+
+	sizes, err := repo.Sizes(ctx, userID, []string{a, c, chunkID("missing")})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{a: 10, c: 30}, sizes)
+
+	chunk, err := repo.Get(ctx, userID, b)
+	require.NoError(t, err)
+	assert.EqualValues(t, 20, chunk.Size)
+	assert.Zero(t, chunk.RefCount)
+
+	// два файла с общим фрагментом a
+	first := &model.BinaryData{UserID: userID, Title: "first", Chunked: true, Size: 30}
+	require.NoError(t, repo.CreateFile(ctx, first, []string{a, b, a}))
+	second := &model.BinaryData{UserID: userID, Title: "second", Chunked: true, Size: 10}
+	require.NoError(t, repo.CreateFile(ctx, second, []string{a}))
+
+	manifest, err := repo.Manifest(ctx, userID, first.ID)
+	require.NoError(t, err)
+	assert.Equal(t, []model.ChunkRef{{ID: a, Size: 10}, {ID: b, Size: 20}, {ID: a, Size: 10}}, manifest)
+
+	chunk, err = repo.Get(ctx, userID, a)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, chunk.RefCount)
+
+	// замена манифеста освобождает b, но не общий a
+	first.Version = 0
+	released, err := repo.UpdateFile(ctx, first, []string{a, c})
+	require.NoError(t, err)
+	require.Len(t, released, 1)
+	assert.Equal(t, b, released[0].ID)
+	assert.Equal(t, "chunks/"+b, released[0].StoragePath)
+	assert.False(t, released[0].CreatedAt.IsZero())
+
+	usage, err := f.Item().Usage(ctx, userID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 40, usage.Bytes, "фрагменты a и c учитываются по разу")
+	assert.EqualValues(t, 2, usage.Items)
+
+	refs, err := repo.StoredRefs(ctx)
+	require.NoError(t, err)
+	assert.Contains(t, refs, model.StoredRef{UserID: userID, ID: a, StoragePath: "chunks/" + a, Chunk: true})
+
+	released, err = repo.DeleteFile(ctx, userID, first.ID)
+	require.NoError(t, err)
+	require.Len(t, released, 1)
+	assert.Equal(t, c, released[0].ID)
+
+	released, err = repo.DeleteFile(ctx, userID, second.ID)
+	require.NoError(t, err)
+	require.Len(t, released, 1)
+	assert.Equal(t, a, released[0].ID)
+
+	_, err = repo.DeleteFile(ctx, userID, second.ID)
+	assert.Error(t, err)
+}
+
+func testBinaryVersions(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	repo := f.BinaryVersion()
+
+	data := &model.BinaryData{UserID: userID, Title: "doc", StoragePath: "u/doc", Size: 5}
+	require.NoError(t, f.BinaryData().Save(ctx, data))
+
+	a, b := chunkID("va"), chunkID("vb")
+	for _, id := range []string{a, b} {
+		_, err := f.Chunk().Add(ctx, &model.Chunk{UserID: userID, ID: id, StoragePath: "chunks/" + id, Size: 7})
+		require.NoError(t, err)
+	}
+
+	var saved []*model.BinaryVersion
+	for i, v := range []*model.BinaryVersion{
+		{StoragePath: "u/doc.v1", Size: 3},
+		{Chunked: true, Size: 7},
+		{Chunked: true, Size: 7},
+	} {
+		v.BinaryID, v.UserID = data.ID, userID
+		v.UpdatedAt = time.Now().Add(time.Duration(i-10) * time.Minute)
+		var ids []string
+		if v.Chunked {
+			ids = []string{a, b}[i-1 : i]
+		}
+		require.NoError(t, repo.Save(ctx, v, ids))
+		saved = append(saved, v)
+		time.Sleep(2 * time.Millisecond)
+	}
+
+	list, err := repo.List(ctx, userID, data.ID)
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.Equal(t, saved[2].ID, list[0].ID, "последняя версия первой")
+
+	got, err := repo.Get(ctx, userID, data.ID, saved[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "u/doc.v1", got.StoragePath)
+	assert.WithinDuration(t, saved[0].UpdatedAt, got.UpdatedAt, time.Millisecond)
+
+	_, err = repo.Get(ctx, userID, data.ID, uuid.NewString())
+	assert.ErrorIs(t, err, model.ErrBinaryVersionNotFound)
+
+	manifest, err := repo.Manifest(ctx, userID, saved[1].ID)
+	require.NoError(t, err)
+	assert.Equal(t, []model.ChunkRef{{ID: a, Size: 7}}, manifest)
+
+	pruned, released, err := repo.Prune(ctx, userID, data.ID, 1)
+	require.NoError(t, err)
+	require.Len(t, pruned, 2)
+	assert.ElementsMatch(t, []string{saved[0].ID, saved[1].ID}, []string{pruned[0].ID, pruned[1].ID})
+	require.Len(t, released, 1)
+	assert.Equal(t, a, released[0].ID)
+
+	released, err = repo.Delete(ctx, userID, saved[2].ID)
+	require.NoError(t, err)
+	require.Len(t, released, 1)
+	assert.Equal(t, b, released[0].ID)
+
+	_, err = repo.Delete(ctx, userID, saved[2].ID)
+	assert.ErrorIs(t, err, model.ErrBinaryVersionNotFound)
+}
+
+func testItems(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	repo := f.Item()
+
+	card := &model.BankCard{UserID: userID, Title: "card"}
+	require.NoError(t, f.BankCard().Create(ctx, card))
+	text := &model.TextData{UserID: userID, Title: "text", Content: []byte("x")}
+	require.NoError(t, f.TextData().Create(ctx, text))
+	file := &model.BinaryData{UserID: userID, Title: "file", StoragePath: "u/f", Size: 11}
+	require.NoError(t, f.BinaryData().Save(ctx, file))
+	require.NoError(t, f.BinaryData().Save(ctx, &model.BinaryData{UserID: userID, Title: "hidden"}))
+
+	require.NoError(t, repo.SetFavorite(ctx, userID, model.ItemTypeBinaryData, file.ID, true))
+	at := time.Now().Add(-time.Hour)
+	require.NoError(t, repo.MarkAccessed(ctx, userID, model.ItemTypeBankCard, card.ID, at))
+	require.NoError(t, repo.MarkAccessed(ctx, userID, model.ItemTypeTextData, text.ID, at.Add(time.Minute)))
+
+	assert.Error(t, repo.SetFavorite(ctx, userID, model.ItemTypeCredential, uuid.NewString(), true))
+	assert.Error(t, repo.SetFavorite(ctx, userID, model.ItemType("unknown"), card.ID, true))
+
+	items, err := repo.ListFavoritesRecent(ctx, userID, 10)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Equal(t, []string{"file", "text", "card"}, []string{items[0].Title, items[1].Title, items[2].Title})
+	assert.True(t, items[0].Favorite)
+	assert.Nil(t, items[0].LastAccessedAt)
+	require.NotNil(t, items[2].LastAccessedAt)
+	assert.WithinDuration(t, at, *items[2].LastAccessedAt, time.Millisecond)
+
+	items, err = repo.ListFavoritesRecent(ctx, userID, 1)
+	require.NoError(t, err)
+	assert.Len(t, items, 1)
+
+	usage, err := repo.Usage(ctx, userID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 11, usage.Bytes)
+	assert.EqualValues(t, 4, usage.Items)
+}
+
+func testChanges(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+	repo := f.Change()
+
+	cred := &model.Credential{ID: uuid.NewString(), UserID: userID, Title: "c", Login: "l", Password: "p"}
+	require.NoError(t, f.Credential().Create(ctx, cred))
+	card := &model.BankCard{UserID: userID, Title: "card"}
+	require.NoError(t, f.BankCard().Create(ctx, card))
+	text := &model.TextData{UserID: userID, Title: "text", Content: []byte("x")}
+	require.NoError(t, f.TextData().Create(ctx, text))
+	file := &model.BinaryData{UserID: userID, Title: "file"}
+	require.NoError(t, f.BinaryData().Save(ctx, file))
+
+	changes, err := repo.ListChanges(ctx, userID, 0, 100)
+	require.NoError(t, err)
+	require.Len(t, changes, 4)
+	for i, ch := range changes {
+		assert.EqualValues(t, i+1, ch.Seq)
+		assert.False(t, ch.Deleted)
+		assert.False(t, ch.ChangedAt.IsZero())
+	}
+	require.NotNil(t, changes[0].Credential)
+	assert.Equal(t, "l", changes[0].Credential.Login)
+	require.NotNil(t, changes[1].BankCard)
+	require.NotNil(t, changes[2].TextData)
+	assert.Equal(t, []byte("x"), changes[2].TextData.Content)
+	require.NotNil(t, changes[3].BinaryData)
+
+	// фиксация открытия записи изменением не считается
+	require.NoError(t, f.Item().MarkAccessed(ctx, userID, model.ItemTypeBankCard, card.ID, time.Now()))
+	changes, err = repo.ListChanges(ctx, userID, 4, 100)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	// изменение избранного и удаление попадают в журнал; запись журнала одна на объект
+	require.NoError(t, f.Item().SetFavorite(ctx, userID, model.ItemTypeBankCard, card.ID, true))
+	require.NoError(t, f.TextData().Delete(ctx, userID, text.ID))
+	changes, err = repo.ListChanges(ctx, userID, 4, 100)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, model.Change{Seq: 5, Type: model.ItemTypeBankCard, ItemID: card.ID}, withoutContent(changes[0]))
+	require.NotNil(t, changes[0].BankCard)
+	assert.True(t, changes[0].BankCard.Favorite)
+	assert.Equal(t, model.Change{Seq: 6, Type: model.ItemTypeTextData, ItemID: text.ID, Deleted: true}, withoutContent(changes[1]))
+	assert.Nil(t, changes[1].TextData)
+
+	changes, err = repo.ListChanges(ctx, userID, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.EqualValues(t, 1, changes[0].Seq)
+
+	// изменения других пользователей не видны
+	changes, err = repo.ListChanges(ctx, newUser(t, f), 0, 100)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+// withoutContent возвращает изменение без времени и содержимого записи.
+func withoutContent(ch model.Change) model.Change {
+	return model.Change{Seq: ch.Seq, Type: ch.Type, ItemID: ch.ItemID, Deleted: ch.Deleted}
+}
+
+func testChangeFeed(t *testing.T, f repository.StorageFactory) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	userID := newUser(t, f)
+	otherID := newUser(t, f)
+
+	events, err := f.ChangeFeed().Subscribe(ctx, userID)
+	require.NoError(t, err)
+
+	require.NoError(t, f.TextData().Create(ctx, &model.TextData{UserID: otherID, Title: "other", Content: []byte("x")}))
+	text := &model.TextData{UserID: userID, Title: "mine", Content: []byte("x")}
+	require.NoError(t, f.TextData().Create(ctx, text))
+
+	select {
+	case ev := <-events:
+		assert.Equal(t, model.ChangeEvent{UserID: userID, Seq: 1, Type: model.ItemTypeTextData, ItemID: text.ID}, ev)
+	case <-ctx.Done():
+		t.Fatal("change event was not delivered")
+	}
+}
+
+func testBatch(t *testing.T, f repository.StorageFactory) {
+	ctx := context.Background()
+	userID := newUser(t, f)
+
+	var kept, failed *model.TextData
+	err := f.Batch().WithinTx(ctx, func(tx repository.BatchTx) error {
+		kept = &model.TextData{UserID: userID, Title: "kept", Content: []byte("x")}
+		require.NoError(t, tx.Savepoint(ctx, func() error {
+			return tx.TextData().Create(ctx, kept)
+		}))
+
+		failed = &model.TextData{UserID: userID, Title: "rolled back", Content: []byte("y")}
+		err := tx.Savepoint(ctx, func() error {
+			if err := tx.TextData().Create(ctx, failed); err != nil {
+				return err
+			}
+			return tx.Credential().Update(ctx, &model.Credential{ID: uuid.NewString(), UserID: userID})
+		})
+		require.Error(t, err)
+
+		card := &model.BankCard{UserID: userID, Title: "card"}
+		return tx.Savepoint(ctx, func() error { return tx.BankCard().Create(ctx, card) })
+	})
+	require.NoError(t, err)
+
+	_, err = f.TextData().GetByID(ctx, userID, kept.ID)
+	require.NoError(t, err)
+	_, err = f.TextData().GetByID(ctx, userID, failed.ID)
+	assert.Error(t, err, "изменения неудачной операции откатываются")
+
+	changes, err := f.Change().ListChanges(ctx, userID, 0, 100)
+	require.NoError(t, err)
+	assert.Len(t, changes, 2)
+
+	// ошибка fn откатывает весь пакет
+	err = f.Batch().WithinTx(ctx, func(tx repository.BatchTx) error {
+		if err := tx.TextData().Create(ctx, &model.TextData{UserID: userID, Title: "lost", Content: []byte("z")}); err != nil {
+			return err
+		}
+		return fmt.Errorf("abort")
+	})
+	require.EqualError(t, err, "abort")
+	titles, _, err := f.TextData().ListTitles(ctx, userID, model.ListFilter{}, model.PageRequest{})
+	require.NoError(t, err)
+	assert.Len(t, titles, 1)
+}